@@ -64,6 +64,9 @@ var ErrTxGenerationFailed = errors.New("transaction generation failed")
 // ErrValidationEmptyTxHash signals that an empty tx hash was provided
 var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 
+// ErrValidationEmptyTransactionsSequence signals that an empty sequence of transactions was provided
+var ErrValidationEmptyTransactionsSequence = errors.New("transactions sequence is empty")
+
 // ErrValidationEmptySCRHash signals that provided smart contract result hash is empty
 var ErrValidationEmptySCRHash = errors.New("SCRHash is empty")
 
@@ -130,6 +133,9 @@ var ErrCannotCreateGinWebServer = errors.New("cannot create gin web server")
 // ErrNilFacadeHandler signals that a nil facade handler has been provided
 var ErrNilFacadeHandler = errors.New("nil facade handler")
 
+// ErrNilMetricsRegistry signals that a nil metrics registry has been provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
+
 // ErrFacadeWrongTypeAssertion signals that a type conversion to a facade type failed
 var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
 
@@ -183,3 +189,54 @@ var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting
 
 // ErrRecursiveRelayedTxIsNotAllowed signals that recursive relayed tx is not allowed
 var ErrRecursiveRelayedTxIsNotAllowed = errors.New("recursive relayed tx is not allowed")
+
+// ErrGetHeartbeatHistory signals that an error occurred while getting the heartbeat history of a public key
+var ErrGetHeartbeatHistory = errors.New("error getting the heartbeat history")
+
+// ErrGetUptime signals that an error occurred while getting the uptime for an epoch
+var ErrGetUptime = errors.New("error getting the uptime")
+
+// ErrGetValidatorsPerformance signals that an error occurred while getting the validators performance
+var ErrGetValidatorsPerformance = errors.New("error getting the validators performance")
+
+// ErrGetP2PMessageTraces signals that an error occurred while getting the p2p messages traces
+var ErrGetP2PMessageTraces = errors.New("error getting the p2p messages traces")
+
+// ErrReplayTransaction signals that an error occurred while re-executing a historical transaction
+var ErrReplayTransaction = errors.New("re-executing transaction failed")
+
+// ErrGetGuardianRegistrationChallenge signals an error issuing a registration challenge on the guardian co-signing service
+var ErrGetGuardianRegistrationChallenge = errors.New("getting guardian registration challenge failed")
+
+// ErrRegisterGuardedAccount signals an error registering an account on the guardian co-signing service
+var ErrRegisterGuardedAccount = errors.New("registering guarded account failed")
+
+// ErrVerifyGuardianCode signals an error verifying a code on the guardian co-signing service
+var ErrVerifyGuardianCode = errors.New("verifying guardian code failed")
+
+// ErrSetGuardianSpendingPolicy signals an error setting a spending policy on the guardian co-signing service
+var ErrSetGuardianSpendingPolicy = errors.New("setting guardian spending policy failed")
+
+// ErrCoSignTransaction signals an error co-signing a transaction on the guardian co-signing service
+var ErrCoSignTransaction = errors.New("co-signing transaction failed")
+
+// ErrGetGovernanceProposals signals an error fetching the governance proposals from the index
+var ErrGetGovernanceProposals = errors.New("getting governance proposals failed")
+
+// ErrGetGovernanceProposal signals an error fetching a governance proposal from the index
+var ErrGetGovernanceProposal = errors.New("getting governance proposal failed")
+
+// ErrGetGovernanceProposalVotes signals an error fetching the votes of a governance proposal from the index
+var ErrGetGovernanceProposalVotes = errors.New("getting governance proposal votes failed")
+
+// ErrInvalidProposalNonce signals that an invalid governance proposal nonce was provided
+var ErrInvalidProposalNonce = errors.New("invalid proposal nonce")
+
+// ErrSubscribeToEvents signals an error subscribing to the node events
+var ErrSubscribeToEvents = errors.New("subscribing to events failed")
+
+// ErrInvalidEventsSubscriptionsConfig signals that an invalid events subscriptions config was provided
+var ErrInvalidEventsSubscriptionsConfig = errors.New("invalid events subscriptions config")
+
+// ErrInvalidJSONRPCMaxBatchSize signals that an invalid maximum JSON-RPC batch size was provided
+var ErrInvalidJSONRPCMaxBatchSize = errors.New("invalid maximum json-rpc batch size")
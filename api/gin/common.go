@@ -12,10 +12,21 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/logs"
+	"github.com/multiversx/mx-chain-go/api/openapi"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"gopkg.in/go-playground/validator.v8"
 )
 
+const (
+	openAPIPackage = "openapi"
+	openAPIRoute   = "/openapi.json"
+	openAPITitle   = "MultiversX node REST API"
+	openAPIVersion = "1.0.0"
+
+	forwardedForHeader = "X-Forwarded-For"
+)
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
@@ -38,6 +49,9 @@ func checkArgs(args ArgsNewWebServer) error {
 	if check.IfNil(args.Facade) {
 		return fmt.Errorf("%w: %s", apiErrors.ErrCannotCreateGinWebServer, apiErrors.ErrNilFacadeHandler.Error())
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return fmt.Errorf("%w: %s", apiErrors.ErrCannotCreateGinWebServer, apiErrors.ErrNilMetricsRegistry.Error())
+	}
 
 	return nil
 }
@@ -99,3 +113,41 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func isOpenAPIRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return openapi.IsEndpointOpen(routesConfig, openAPIPackage, openAPIRoute)
+}
+
+// registerOpenAPIRoute serves the OpenAPI document of the endpoints opened in the provided config. The document is
+// generated once, as the routes config can not change while the web server is running
+func registerOpenAPIRoute(ws *gin.Engine, groups map[string]shared.GroupHandler, apiConfig config.ApiRoutesConfig) {
+	endpointsHandlers := make(map[string]openapi.EndpointsHandler, len(groups))
+	for groupName, groupHandler := range groups {
+		endpointsHandler, ok := groupHandler.(openapi.EndpointsHandler)
+		if !ok {
+			log.Warn("gin API group does not provide its endpoints, skipping it from the OpenAPI document", "group name", groupName)
+			continue
+		}
+
+		endpointsHandlers[groupName] = endpointsHandler
+	}
+
+	document := openapi.GenerateDocument(openapi.ArgsGenerateDocument{
+		Title:     openAPITitle,
+		Version:   openAPIVersion,
+		Groups:    endpointsHandlers,
+		APIConfig: apiConfig,
+	})
+
+	ws.GET(openAPIRoute, func(c *gin.Context) {
+		c.JSON(http.StatusOK, document)
+	})
+}
+
+// configureClientIP makes gin read the client IP address from the X-Forwarded-For header only for the requests
+// received from the trusted proxies. No proxy is trusted if the provided list is empty
+func configureClientIP(ws *gin.Engine, clientIPConfig config.ApiClientIPConfig) error {
+	ws.RemoteIPHeaders = []string{forwardedForHeader}
+
+	return ws.SetTrustedProxies(clientIPConfig.TrustedProxies)
+}
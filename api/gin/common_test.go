@@ -18,6 +18,7 @@ func TestCommon_checkArgs(t *testing.T) {
 		Facade:          nil,
 		ApiConfig:       config.ApiRoutesConfig{},
 		AntiFloodConfig: config.WebServerAntifloodConfig{},
+		MetricsRegistry: &testscommon.MetricsRegistryStub{},
 	}
 	err := checkArgs(args)
 	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
@@ -27,6 +28,7 @@ func TestCommon_checkArgs(t *testing.T) {
 		PprofEnabled:                false,
 		P2PPrometheusMetricsEnabled: false,
 		StatusMetricsHandler:        &testscommon.StatusMetricsStub{},
+		MetricsRegistry:             &testscommon.MetricsRegistryStub{},
 	})
 	require.NoError(t, err)
 	err = checkArgs(args)
@@ -53,3 +55,23 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	require.True(t, isLogRouteEnabled(routesConfig))
 	require.False(t, isLogRouteEnabled(config.ApiRoutesConfig{}))
 }
+
+func TestCommon_isOpenAPIRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, isOpenAPIRouteEnabled(config.ApiRoutesConfig{}))
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"openapi": {
+				Routes: []config.RouteConfig{
+					{Name: "/openapi.json", Open: false},
+				},
+			},
+		},
+	}
+	require.False(t, isOpenAPIRouteEnabled(routesConfig))
+
+	routesConfig.APIPackages["openapi"].Routes[0].Open = true
+	require.True(t, isOpenAPIRouteEnabled(routesConfig))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -24,13 +25,22 @@ import (
 
 var log = logger.GetOrCreate("api/gin")
 
-const prometheusMetricsRoute = "/debug/metrics/prometheus"
+const (
+	prometheusMetricsRoute = "/debug/metrics/prometheus"
+	jsonRPCGroupName       = "rpc"
+	jsonRPCCallsPrefix     = "/rpc/jsonrpc"
+	eventsGroupName        = "events"
+	eventsSubscribeRoute   = "/events/subscribe"
+)
 
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
 type ArgsNewWebServer struct {
 	Facade          shared.FacadeHandler
 	ApiConfig       config.ApiRoutesConfig
 	AntiFloodConfig config.WebServerAntifloodConfig
+	EventsConfig    config.EventsSubscriptionsConfig
+	MetricsRegistry common.MetricsRegistry
+	TracingEnabled  bool
 }
 
 type webServer struct {
@@ -38,8 +48,12 @@ type webServer struct {
 	facade          shared.FacadeHandler
 	apiConfig       config.ApiRoutesConfig
 	antiFloodConfig config.WebServerAntifloodConfig
+	eventsConfig    config.EventsSubscriptionsConfig
+	metricsRegistry common.MetricsRegistry
+	tracingEnabled  bool
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
+	jsonRPCCalls    []shared.MiddlewareProcessor
 	cancelFunc      func()
 }
 
@@ -53,7 +67,10 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 	return &webServer{
 		facade:          args.Facade,
 		antiFloodConfig: args.AntiFloodConfig,
+		eventsConfig:    args.EventsConfig,
 		apiConfig:       args.ApiConfig,
+		metricsRegistry: args.MetricsRegistry,
+		tracingEnabled:  args.TracingEnabled,
 	}, nil
 }
 
@@ -100,6 +117,11 @@ func (ws *webServer) StartHttpServer() error {
 	engine = gin.Default()
 	engine.Use(cors.Default())
 
+	err := configureClientIP(engine, ws.apiConfig.ClientIP)
+	if err != nil {
+		return err
+	}
+
 	processors, err := ws.createMiddlewareLimiters()
 	if err != nil {
 		return err
@@ -174,6 +196,30 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["hardfork"] = hardforkGroup
 
+	guardianGroup, err := groups.NewGuardianGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["guardian"] = guardianGroup
+
+	governanceGroup, err := groups.NewGovernanceGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["governance"] = governanceGroup
+
+	eventsGroup, err := groups.NewEventsGroup(ws.facade, ws.eventsConfig)
+	if err != nil {
+		return err
+	}
+	groupsMap[eventsGroupName] = eventsGroup
+
+	jsonRPCGroup, err := groups.NewJSONRPCGroup(ws.facade, ws.jsonRPCCalls, ws.antiFloodConfig.JSONRPCMaxBatchSize)
+	if err != nil {
+		return err
+	}
+	groupsMap[jsonRPCGroupName] = jsonRPCGroup
+
 	networkGroup, err := groups.NewNetworkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -222,6 +268,10 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		groupHandler.RegisterRoutes(ginGroup, ws.apiConfig)
 	}
 
+	if isOpenAPIRouteEnabled(ws.apiConfig) {
+		registerOpenAPIRoute(ginRouter, ws.groups, ws.apiConfig)
+	}
+
 	if isLogRouteEnabled(ws.apiConfig) {
 		marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
@@ -238,26 +288,47 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 
 func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
+	// each JSON-RPC call is traced, measured and counted against the source throttler and the clients quotas on its
+	// own, so the calls of a batch are visible and a batch request cannot be used to go around the limits. The global
+	// throttler only limits the simultaneous HTTP requests
+	ws.jsonRPCCalls = make([]shared.MiddlewareProcessor, 0)
+
+	// the tracing middleware comes first, so the server spans cover the time spent in the other middlewares as well
+	if ws.tracingEnabled {
+		tracingMiddleware := middleware.NewTracingMiddleware()
+		middlewares = append(middlewares, tracingMiddleware)
+		ws.jsonRPCCalls = append(ws.jsonRPCCalls, tracingMiddleware)
+	}
+
+	metricsMiddleware, err := middleware.NewMetricsMiddleware(ws.metricsRegistry)
+	if err != nil {
+		return nil, err
+	}
+	middlewares = append(middlewares, metricsMiddleware)
+	ws.jsonRPCCalls = append(ws.jsonRPCCalls, metricsMiddleware.WithEndpointPrefix(jsonRPCCallsPrefix))
 
 	if ws.apiConfig.Logging.LoggingEnabled {
 		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(ws.apiConfig.Logging.ThresholdInMicroSeconds) * time.Microsecond)
 		middlewares = append(middlewares, responseLoggerMiddleware)
 	}
 
+	var ctx context.Context
+	ctx, ws.cancelFunc = context.WithCancel(context.Background())
+
 	if ws.antiFloodConfig.WebServerAntifloodEnabled {
 		sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
 		if err != nil {
 			return nil, err
 		}
 
-		var ctx context.Context
-		ctx, ws.cancelFunc = context.WithCancel(context.Background())
-
-		go ws.sourceLimiterReset(ctx, sourceLimiter)
+		sourceResetInterval := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+		go limiterReset(ctx, sourceLimiter, sourceResetInterval, "WS source limiter")
 
 		middlewares = append(middlewares, sourceLimiter)
+		ws.jsonRPCCalls = append(ws.jsonRPCCalls, sourceLimiter)
 
-		globalLimiter, err := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests)
+		// the events subscribers are limited by the events subscriptions config, so they do not starve the other requests
+		globalLimiter, err := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests, eventsSubscribeRoute)
 		if err != nil {
 			return nil, err
 		}
@@ -265,18 +336,31 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		middlewares = append(middlewares, globalLimiter)
 	}
 
+	// the authenticator comes after the throttlers, so the credentials guessing attempts are throttled as well
+	if ws.apiConfig.Authentication.Enabled {
+		authenticator, err := middleware.NewAuthenticator(ws.apiConfig.Authentication)
+		if err != nil {
+			return nil, err
+		}
+
+		quotaResetInterval := time.Second * time.Duration(ws.apiConfig.Authentication.QuotaResetIntervalInSec)
+		go limiterReset(ctx, authenticator, quotaResetInterval, "WS clients quotas")
+
+		middlewares = append(middlewares, authenticator)
+		ws.jsonRPCCalls = append(ws.jsonRPCCalls, authenticator)
+	}
+
 	return middlewares, nil
 }
 
-func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+func limiterReset(ctx context.Context, reset resetHandler, betweenResetDuration time.Duration, name string) {
 	for {
 		select {
 		case <-time.After(betweenResetDuration):
-			log.Trace("calling reset on WS source limiter")
+			log.Trace("calling reset", "limiter", name)
 			reset.Reset()
 		case <-ctx.Done():
-			log.Debug("closing nodeFacade.sourceLimiterReset go routine")
+			log.Debug("closing limiter reset go routine", "limiter", name)
 			return
 		}
 	}
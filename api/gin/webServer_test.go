@@ -1,19 +1,24 @@
 package gin
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/openapi"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -45,7 +50,16 @@ func createMockArgsNewWebServer() ArgsNewWebServer {
 			SimultaneousRequests:         1,
 			SameSourceRequests:           1,
 			SameSourceResetIntervalInSec: 1,
+			JSONRPCMaxBatchSize:          10,
 		},
+		EventsConfig: config.EventsSubscriptionsConfig{
+			MaxMessageSizeInBytes: 1024,
+			PingIntervalInSec:     1,
+			PongTimeoutInSec:      2,
+			WriteTimeoutInSec:     1,
+		},
+		MetricsRegistry: &testscommon.MetricsRegistryStub{},
+		TracingEnabled:  true,
 	}
 }
 
@@ -63,6 +77,17 @@ func TestNewGinWebServerHandler(t *testing.T) {
 		require.True(t, strings.Contains(err.Error(), apiErrors.ErrNilFacadeHandler.Error()))
 		require.Nil(t, ws)
 	})
+	t.Run("nil metrics registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.MetricsRegistry = nil
+
+		ws, err := NewGinWebServerHandler(args)
+		require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+		require.True(t, strings.Contains(err.Error(), apiErrors.ErrNilMetricsRegistry.Error()))
+		require.Nil(t, ws)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -96,6 +121,24 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		require.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 	})
+	t.Run("invalid trusted proxy should error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.ClientIP.TrustedProxies = []string{"not an address"}
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.NotNil(t, err)
+	})
+	t.Run("createMiddlewareLimiters returns error due to middleware.NewAuthenticator error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.Authentication.Enabled = true
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.Equal(t, middleware.ErrInvalidQuotaResetInterval, err)
+	})
 	t.Run("createMiddlewareLimiters returns error due to middleware.NewGlobalThrottler error", func(t *testing.T) {
 		args := createMockArgsNewWebServer()
 		args.AntiFloodConfig.SimultaneousRequests = 0
@@ -203,3 +246,64 @@ func TestWebServer_CloseWithDisabledServerShouldNotPanic(t *testing.T) {
 	err = ws.Close()
 	assert.Nil(t, err)
 }
+
+func TestWebServer_EndpointsShouldHaveDescriptions(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := NewGinWebServerHandler(createMockArgsNewWebServer())
+	err := ws.createGroups()
+	require.Nil(t, err)
+
+	for groupName, groupHandler := range ws.groups {
+		endpointsHandler, ok := groupHandler.(openapi.EndpointsHandler)
+		require.True(t, ok, "group %s does not provide its endpoints", groupName)
+
+		for _, endpoint := range endpointsHandler.GetEndpoints() {
+			assert.NotEmpty(t, endpoint.Description, "route /%s%s has no description", groupName, endpoint.Path)
+		}
+	}
+}
+
+func TestWebServer_OpenAPIDocumentShouldHoldTheOpenedRoutes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewWebServer()
+	args.ApiConfig.APIPackages = map[string]config.APIPackageConfig{
+		"openapi": {Routes: []config.RouteConfig{{Name: "/openapi.json", Open: true}}},
+		"node": {Routes: []config.RouteConfig{
+			{Name: "/status", Open: true},
+			{Name: "/metrics", Open: false},
+		}},
+		"transaction": {Routes: []config.RouteConfig{{Name: "/send", Open: true}}},
+	}
+	ws, _ := NewGinWebServerHandler(args)
+	err := ws.createGroups()
+	require.Nil(t, err)
+
+	engine := gin.New()
+	ws.registerRoutes(engine)
+
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	document := &openapi.Document{}
+	err = json.Unmarshal(resp.Body.Bytes(), document)
+	require.Nil(t, err)
+	require.Equal(t, openapi.Version, document.OpenAPI)
+	require.Len(t, document.Paths, 2)
+
+	status := document.Paths["/node/status"]["get"]
+	require.NotNil(t, status)
+	require.Equal(t, []string{"node"}, status.Tags)
+	require.Equal(t, "getNodeStatus", status.OperationID)
+	require.NotEmpty(t, status.Summary)
+
+	send := document.Paths["/transaction/send"]["post"]
+	require.NotNil(t, send)
+	require.True(t, send.RequestBody.Required)
+	require.Contains(t, send.RequestBody.Content["application/json"].Schema.Properties, "receiver")
+	data := send.Responses["200"].Content["application/json"].Schema.Properties["data"]
+	require.Equal(t, "string", data.Properties["txHash"].Type)
+}
@@ -100,79 +100,110 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    getAccountPath,
-			Method:  http.MethodGet,
-			Handler: ag.getAccount,
+			Path:         getAccountPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getAccount,
+			Description:  "returns the account of the given address",
+			ResponseData: gin.H{"account": api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getAccountsPath,
-			Method:  http.MethodPost,
-			Handler: ag.getAccounts,
+			Path:         getAccountsPath,
+			Method:       http.MethodPost,
+			Handler:      ag.getAccounts,
+			Description:  "returns the accounts of the given addresses",
+			RequestData:  []string{},
+			ResponseData: gin.H{"accounts": map[string]*api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getBalancePath,
-			Method:  http.MethodGet,
-			Handler: ag.getBalance,
+			Path:         getBalancePath,
+			Method:       http.MethodGet,
+			Handler:      ag.getBalance,
+			Description:  "returns the EGLD balance of the given address",
+			ResponseData: gin.H{"balance": "", "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getUsernamePath,
-			Method:  http.MethodGet,
-			Handler: ag.getUsername,
+			Path:         getUsernamePath,
+			Method:       http.MethodGet,
+			Handler:      ag.getUsername,
+			Description:  "returns the username of the given address",
+			ResponseData: gin.H{"username": "", "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getCodeHashPath,
-			Method:  http.MethodGet,
-			Handler: ag.getCodeHash,
+			Path:         getCodeHashPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getCodeHash,
+			Description:  "returns the code hash of the given address",
+			ResponseData: gin.H{"codeHash": []byte{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getKeyPath,
-			Method:  http.MethodGet,
-			Handler: ag.getValueForKey,
+			Path:         getKeyPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getValueForKey,
+			Description:  "returns the value stored under the given hex key in the data trie of the given address",
+			ResponseData: gin.H{"value": "", "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getKeysPath,
-			Method:  http.MethodGet,
-			Handler: ag.getKeyValuePairs,
+			Path:         getKeysPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getKeyValuePairs,
+			Description:  "returns all the hex key-value pairs stored in the data trie of the given address",
+			ResponseData: gin.H{"pairs": map[string]string{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getESDTBalancePath,
-			Method:  http.MethodGet,
-			Handler: ag.getESDTBalance,
+			Path:         getESDTBalancePath,
+			Method:       http.MethodGet,
+			Handler:      ag.getESDTBalance,
+			Description:  "returns the balance of the given fungible ESDT token held by the given address",
+			ResponseData: gin.H{"tokenData": esdtTokenData{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getESDTNFTDataPath,
-			Method:  http.MethodGet,
-			Handler: ag.getESDTNFTData,
+			Path:         getESDTNFTDataPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getESDTNFTData,
+			Description:  "returns the data of the given NFT, SFT or META ESDT nonce held by the given address",
+			ResponseData: gin.H{"tokenData": ESDTNFTTokenData{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getESDTTokensPath,
-			Method:  http.MethodGet,
-			Handler: ag.getAllESDTData,
+			Path:         getESDTTokensPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getAllESDTData,
+			Description:  "returns all the ESDT tokens held by the given address",
+			ResponseData: gin.H{"esdts": map[string]*ESDTNFTTokenData{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getRegisteredNFTsPath,
-			Method:  http.MethodGet,
-			Handler: ag.getNFTTokenIDsRegisteredByAddress,
+			Path:         getRegisteredNFTsPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getNFTTokenIDsRegisteredByAddress,
+			Description:  "returns the NFT collections issued by the given address",
+			ResponseData: gin.H{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getESDTTokensWithRolePath,
-			Method:  http.MethodGet,
-			Handler: ag.getESDTTokensWithRole,
+			Path:         getESDTTokensWithRolePath,
+			Method:       http.MethodGet,
+			Handler:      ag.getESDTTokensWithRole,
+			Description:  "returns the ESDT tokens on which the given address holds the given role",
+			ResponseData: gin.H{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getESDTsRolesPath,
-			Method:  http.MethodGet,
-			Handler: ag.getESDTsRoles,
+			Path:         getESDTsRolesPath,
+			Method:       http.MethodGet,
+			Handler:      ag.getESDTsRoles,
+			Description:  "returns the roles held by the given address, grouped by ESDT token",
+			ResponseData: gin.H{"roles": map[string][]string{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getGuardianData,
-			Method:  http.MethodGet,
-			Handler: ag.getGuardianData,
+			Path:         getGuardianData,
+			Method:       http.MethodGet,
+			Handler:      ag.getGuardianData,
+			Description:  "returns the active and the pending guardians of the given address",
+			ResponseData: gin.H{"guardianData": api.GuardianData{}, "blockInfo": api.BlockInfo{}},
 		},
 		{
-			Path:    getDataTrieMigrationStatusPath,
-			Method:  http.MethodGet,
-			Handler: ag.isDataTrieMigrated,
+			Path:         getDataTrieMigrationStatusPath,
+			Method:       http.MethodGet,
+			Handler:      ag.isDataTrieMigrated,
+			Description:  "returns true if the data trie of the given address has been migrated to the latest version",
+			ResponseData: gin.H{"isMigrated": false},
 		},
 	}
 	ag.endpoints = endpoints
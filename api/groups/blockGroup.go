@@ -56,29 +56,39 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    getBlockByNoncePath,
-			Method:  http.MethodGet,
-			Handler: bg.getBlockByNonce,
+			Path:         getBlockByNoncePath,
+			Method:       http.MethodGet,
+			Handler:      bg.getBlockByNonce,
+			Description:  "returns the block with the given nonce",
+			ResponseData: gin.H{"block": api.Block{}},
 		},
 		{
-			Path:    getBlockByHashPath,
-			Method:  http.MethodGet,
-			Handler: bg.getBlockByHash,
+			Path:         getBlockByHashPath,
+			Method:       http.MethodGet,
+			Handler:      bg.getBlockByHash,
+			Description:  "returns the block with the given hex hash",
+			ResponseData: gin.H{"block": api.Block{}},
 		},
 		{
-			Path:    getBlockByRoundPath,
-			Method:  http.MethodGet,
-			Handler: bg.getBlockByRound,
+			Path:         getBlockByRoundPath,
+			Method:       http.MethodGet,
+			Handler:      bg.getBlockByRound,
+			Description:  "returns the block proposed in the given round",
+			ResponseData: gin.H{"block": api.Block{}},
 		},
 		{
-			Path:    getAlteredAccountsByNonce,
-			Method:  http.MethodGet,
-			Handler: bg.getAlteredAccountsByNonce,
+			Path:         getAlteredAccountsByNonce,
+			Method:       http.MethodGet,
+			Handler:      bg.getAlteredAccountsByNonce,
+			Description:  "returns the accounts altered by the block with the given nonce",
+			ResponseData: gin.H{"accounts": []*alteredAccount.AlteredAccount{}},
 		},
 		{
-			Path:    getAlteredAccountsByHash,
-			Method:  http.MethodGet,
-			Handler: bg.getAlteredAccountsByHash,
+			Path:         getAlteredAccountsByHash,
+			Method:       http.MethodGet,
+			Handler:      bg.getAlteredAccountsByHash,
+			Description:  "returns the accounts altered by the block with the given hex hash",
+			ResponseData: gin.H{"accounts": []*alteredAccount.AlteredAccount{}},
 		},
 	}
 	bg.endpoints = endpoints
@@ -0,0 +1,282 @@
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	subscribeToEventsPath = "/subscribe"
+	errorMessageType      = "error"
+)
+
+// eventsFacadeHandler defines the methods to be implemented by a facade for handling the events subscriptions
+type eventsFacadeHandler interface {
+	SubscribeToEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	IsInterfaceNil() bool
+}
+
+type eventsGroup struct {
+	*baseGroup
+	facade         eventsFacadeHandler
+	mutFacade      sync.RWMutex
+	upgrader       websocket.Upgrader
+	maxMessageSize int64
+	pingInterval   time.Duration
+	pongTimeout    time.Duration
+	writeTimeout   time.Duration
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facade eventsFacadeHandler, cfg config.EventsSubscriptionsConfig) (*eventsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for events group", errors.ErrNilFacadeHandler)
+	}
+	err := checkEventsSubscriptionsConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	eg := &eventsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: newOriginChecker(cfg.AllowedOrigins),
+		},
+		maxMessageSize: cfg.MaxMessageSizeInBytes,
+		pingInterval:   time.Duration(cfg.PingIntervalInSec) * time.Second,
+		pongTimeout:    time.Duration(cfg.PongTimeoutInSec) * time.Second,
+		writeTimeout:   time.Duration(cfg.WriteTimeoutInSec) * time.Second,
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:        subscribeToEventsPath,
+			Method:      http.MethodGet,
+			Handler:     eg.subscribe,
+			Description: "upgrades the connection to a websocket pushing the blocks, the transactions status changes and the smart contract events matching the filter sent by the client",
+		},
+	}
+	eg.endpoints = endpoints
+
+	return eg, nil
+}
+
+func checkEventsSubscriptionsConfig(cfg config.EventsSubscriptionsConfig) error {
+	if cfg.MaxMessageSizeInBytes < 1 {
+		return fmt.Errorf("%w, MaxMessageSizeInBytes should be positive, provided %d",
+			errors.ErrInvalidEventsSubscriptionsConfig, cfg.MaxMessageSizeInBytes)
+	}
+	if cfg.PingIntervalInSec < 1 {
+		return fmt.Errorf("%w, PingIntervalInSec should be positive, provided %d",
+			errors.ErrInvalidEventsSubscriptionsConfig, cfg.PingIntervalInSec)
+	}
+	if cfg.PongTimeoutInSec <= cfg.PingIntervalInSec {
+		return fmt.Errorf("%w, PongTimeoutInSec should be greater than PingIntervalInSec, provided %d",
+			errors.ErrInvalidEventsSubscriptionsConfig, cfg.PongTimeoutInSec)
+	}
+	if cfg.WriteTimeoutInSec < 1 {
+		return fmt.Errorf("%w, WriteTimeoutInSec should be positive, provided %d",
+			errors.ErrInvalidEventsSubscriptionsConfig, cfg.WriteTimeoutInSec)
+	}
+
+	return nil
+}
+
+// newOriginChecker allows the requests without the Origin header, as the non-browser clients do not send it, the ones
+// coming from the node's own origin and the ones coming from the provided origins. Any other web page is rejected, so
+// it can not open subscriptions on behalf of its visitors
+func newOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	allowed := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[strings.ToLower(strings.TrimSuffix(origin, "/"))] = struct{}{}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 {
+			return true
+		}
+
+		originURL, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(originURL.Host, r.Host) {
+			return true
+		}
+
+		_, ok := allowed[strings.ToLower(origin)]
+		return ok
+	}
+}
+
+// subscribe upgrades the connection to a websocket and pushes the blocks, the transactions status changes and the
+// smart contract events matching the subscription filter. The first message sent by the client holds the filter,
+// while each of the following ones replaces it. The subscriber is pinged periodically and its connection is closed if
+// it does not answer, so a dead peer does not keep its subscription slot
+func (eg *eventsGroup) subscribe(c *gin.Context) {
+	conn, err := eg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("events subscription: cannot upgrade connection", "error", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	conn.SetReadLimit(eg.maxMessageSize)
+	conn.SetPongHandler(func(_ string) error {
+		return eg.extendReadDeadline(conn)
+	})
+	err = eg.extendReadDeadline(conn)
+	if err != nil {
+		return
+	}
+
+	filter := common.EventsSubscriptionFilter{}
+	err = conn.ReadJSON(&filter)
+	if err != nil {
+		eg.writeErrorMessage(conn, err)
+		return
+	}
+
+	subscription, err := eg.getFacade().SubscribeToEvents(filter)
+	if err != nil {
+		eg.writeErrorMessage(conn, fmt.Errorf("%s: %w", errors.ErrSubscribeToEvents.Error(), err))
+		return
+	}
+	defer subscription.Close()
+
+	chUpdateErrors := make(chan error, 1)
+	chConnectionClosed := make(chan struct{})
+	go eg.readFilterUpdates(conn, subscription, chUpdateErrors, chConnectionClosed)
+
+	pingTicker := time.NewTicker(eg.pingInterval)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case <-chConnectionClosed:
+			return
+		case <-pingTicker.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eg.writeTimeout))
+			if err != nil {
+				log.Debug("events subscription: cannot ping the subscriber", "error", err)
+				return
+			}
+		case err = <-chUpdateErrors:
+			eg.writeErrorMessage(conn, err)
+		case message, ok := <-subscription.Messages():
+			if !ok {
+				eg.writeErrorMessage(conn, fmt.Errorf("%s: subscription ended", errors.ErrSubscribeToEvents.Error()))
+				return
+			}
+
+			err = eg.writeJSON(conn, message)
+			if err != nil {
+				log.Debug("events subscription: cannot write message", "error", err)
+				return
+			}
+		}
+	}
+}
+
+func (eg *eventsGroup) extendReadDeadline(conn *websocket.Conn) error {
+	return conn.SetReadDeadline(time.Now().Add(eg.pongTimeout))
+}
+
+// readFilterUpdates applies the filters received on the connection until the connection is closed. The errors are
+// forwarded to the writing go routine, as the websocket connection does not support concurrent writers
+func (eg *eventsGroup) readFilterUpdates(
+	conn *websocket.Conn,
+	subscription common.EventsSubscription,
+	chUpdateErrors chan<- error,
+	chConnectionClosed chan<- struct{},
+) {
+	defer close(chConnectionClosed)
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		err = eg.extendReadDeadline(conn)
+		if err != nil {
+			return
+		}
+
+		filter := common.EventsSubscriptionFilter{}
+		err = json.Unmarshal(data, &filter)
+		if err == nil {
+			err = subscription.UpdateFilter(filter)
+		}
+		if err != nil {
+			select {
+			case chUpdateErrors <- err:
+			default:
+			}
+		}
+	}
+}
+
+func (eg *eventsGroup) writeErrorMessage(conn *websocket.Conn, err error) {
+	errWrite := eg.writeJSON(conn, &common.EventsSubscriptionMessage{
+		Type: errorMessageType,
+		Data: err.Error(),
+	})
+	if errWrite != nil {
+		log.Debug("events subscription: cannot write error message", "error", errWrite)
+	}
+}
+
+func (eg *eventsGroup) writeJSON(conn *websocket.Conn, message interface{}) error {
+	err := conn.SetWriteDeadline(time.Now().Add(eg.writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	return conn.WriteJSON(message)
+}
+
+func (eg *eventsGroup) getFacade() eventsFacadeHandler {
+	eg.mutFacade.RLock()
+	defer eg.mutFacade.RUnlock()
+
+	return eg.facade
+}
+
+// UpdateFacade will update the facade
+func (eg *eventsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(eventsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	eg.mutFacade.Lock()
+	eg.facade = castFacade
+	eg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eg *eventsGroup) IsInterfaceNil() bool {
+	return eg == nil
+}
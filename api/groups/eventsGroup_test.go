@@ -0,0 +1,390 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+type eventsSubscriptionStub struct {
+	chMessages    chan *common.EventsSubscriptionMessage
+	mutFilters    sync.Mutex
+	filters       []common.EventsSubscriptionFilter
+	updateErr     error
+	chClosed      chan struct{}
+	closeOnce     sync.Once
+	chFilterAdded chan struct{}
+}
+
+func newEventsSubscriptionStub(filter common.EventsSubscriptionFilter) *eventsSubscriptionStub {
+	return &eventsSubscriptionStub{
+		chMessages:    make(chan *common.EventsSubscriptionMessage, 10),
+		filters:       []common.EventsSubscriptionFilter{filter},
+		chClosed:      make(chan struct{}),
+		chFilterAdded: make(chan struct{}, 10),
+	}
+}
+
+func (ess *eventsSubscriptionStub) Messages() <-chan *common.EventsSubscriptionMessage {
+	return ess.chMessages
+}
+
+func (ess *eventsSubscriptionStub) UpdateFilter(filter common.EventsSubscriptionFilter) error {
+	if ess.updateErr != nil {
+		return ess.updateErr
+	}
+
+	ess.mutFilters.Lock()
+	ess.filters = append(ess.filters, filter)
+	ess.mutFilters.Unlock()
+	ess.chFilterAdded <- struct{}{}
+
+	return nil
+}
+
+func (ess *eventsSubscriptionStub) Close() {
+	ess.closeOnce.Do(func() {
+		close(ess.chClosed)
+	})
+}
+
+func (ess *eventsSubscriptionStub) getFilters() []common.EventsSubscriptionFilter {
+	ess.mutFilters.Lock()
+	defer ess.mutFilters.Unlock()
+
+	return ess.filters
+}
+
+func dialEventsSubscription(t *testing.T, facade *mock.FacadeStub) (*websocket.Conn, func()) {
+	conn, closeConn, err := dialEventsSubscriptionWithConfig(facade, getEventsSubscriptionsConfig(), nil)
+	require.NoError(t, err)
+
+	return conn, closeConn
+}
+
+func dialEventsSubscriptionWithConfig(
+	facade *mock.FacadeStub,
+	cfg config.EventsSubscriptionsConfig,
+	header http.Header,
+) (*websocket.Conn, func(), error) {
+	eg, err := groups.NewEventsGroup(facade, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ws := startWebServer(eg, "events", getEventsRoutesConfig())
+	server := httptest.NewServer(ws)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/events/subscribe"
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	if err != nil {
+		server.Close()
+		return nil, nil, err
+	}
+
+	return conn, func() {
+		_ = conn.Close()
+		server.Close()
+	}, nil
+}
+
+func TestNewEventsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(nil, getEventsSubscriptionsConfig())
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, eg)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		cfg := getEventsSubscriptionsConfig()
+		cfg.MaxMessageSizeInBytes = 0
+		eg, err := groups.NewEventsGroup(&mock.FacadeStub{}, cfg)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidEventsSubscriptionsConfig))
+		require.Nil(t, eg)
+
+		cfg = getEventsSubscriptionsConfig()
+		cfg.PingIntervalInSec = 0
+		eg, err = groups.NewEventsGroup(&mock.FacadeStub{}, cfg)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidEventsSubscriptionsConfig))
+		require.Nil(t, eg)
+
+		cfg = getEventsSubscriptionsConfig()
+		cfg.PongTimeoutInSec = cfg.PingIntervalInSec
+		eg, err = groups.NewEventsGroup(&mock.FacadeStub{}, cfg)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidEventsSubscriptionsConfig))
+		require.Nil(t, eg)
+
+		cfg = getEventsSubscriptionsConfig()
+		cfg.WriteTimeoutInSec = 0
+		eg, err = groups.NewEventsGroup(&mock.FacadeStub{}, cfg)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidEventsSubscriptionsConfig))
+		require.Nil(t, eg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(&mock.FacadeStub{}, getEventsSubscriptionsConfig())
+		require.NoError(t, err)
+		require.NotNil(t, eg)
+	})
+}
+
+func TestEventsGroup_subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should write the error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+				return nil, expectedErr
+			},
+		}
+		conn, closeConn := dialEventsSubscription(t, facade)
+		defer closeConn()
+
+		require.NoError(t, conn.WriteJSON(common.EventsSubscriptionFilter{Blocks: true}))
+
+		message := &common.EventsSubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(message))
+		require.Equal(t, "error", message.Type)
+		require.Contains(t, message.Data, apiErrors.ErrSubscribeToEvents.Error())
+		require.Contains(t, message.Data, expectedErr.Error())
+	})
+	t.Run("should push the messages and update the filter", func(t *testing.T) {
+		t.Parallel()
+
+		providedFilter := common.EventsSubscriptionFilter{
+			Blocks:       true,
+			Transactions: []string{"aa"},
+			Events: []*common.SCEventSubscriptionFilter{
+				{Identifier: "transfer", Topics: [][]byte{[]byte("topic")}},
+			},
+		}
+		var subscription *eventsSubscriptionStub
+		chSubscribed := make(chan struct{})
+		facade := &mock.FacadeStub{
+			SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+				subscription = newEventsSubscriptionStub(filter)
+				close(chSubscribed)
+				return subscription, nil
+			},
+		}
+		conn, closeConn := dialEventsSubscription(t, facade)
+		defer closeConn()
+
+		require.NoError(t, conn.WriteJSON(providedFilter))
+		<-chSubscribed
+
+		subscription.chMessages <- &common.EventsSubscriptionMessage{
+			Type: "block",
+			Data: &common.SubscriptionBlock{Hash: "aa", Nonce: 5},
+		}
+		message := &common.EventsSubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(message))
+		require.Equal(t, "block", message.Type)
+		require.Equal(t, map[string]interface{}{"hash": "aa", "nonce": float64(5), "shardID": float64(0)}, message.Data)
+
+		updatedFilter := common.EventsSubscriptionFilter{FinalizedBlocks: true}
+		require.NoError(t, conn.WriteJSON(updatedFilter))
+		<-subscription.chFilterAdded
+		require.Equal(t, []common.EventsSubscriptionFilter{providedFilter, updatedFilter}, subscription.getFilters())
+
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("not a filter")))
+		require.NoError(t, conn.ReadJSON(message))
+		require.Equal(t, "error", message.Type)
+
+		_ = conn.Close()
+		<-subscription.chClosed
+	})
+	t.Run("message larger than the limit should close the connection", func(t *testing.T) {
+		t.Parallel()
+
+		subscribeCalled := false
+		facade := &mock.FacadeStub{
+			SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+				subscribeCalled = true
+				return newEventsSubscriptionStub(filter), nil
+			},
+		}
+		cfg := getEventsSubscriptionsConfig()
+		cfg.MaxMessageSizeInBytes = 64
+		conn, closeConn, err := dialEventsSubscriptionWithConfig(facade, cfg, nil)
+		require.NoError(t, err)
+		defer closeConn()
+
+		filter := common.EventsSubscriptionFilter{Transactions: []string{strings.Repeat("a", 128)}}
+		require.NoError(t, conn.WriteJSON(filter))
+
+		_, _, err = conn.ReadMessage()
+		require.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig))
+		require.False(t, subscribeCalled)
+	})
+	t.Run("subscriber not answering the pings should be disconnected", func(t *testing.T) {
+		t.Parallel()
+
+		var subscription *eventsSubscriptionStub
+		chSubscribed := make(chan struct{})
+		facade := &mock.FacadeStub{
+			SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+				subscription = newEventsSubscriptionStub(filter)
+				close(chSubscribed)
+				return subscription, nil
+			},
+		}
+		conn, closeConn := dialEventsSubscription(t, facade)
+		defer closeConn()
+
+		// the client does not read, so it never answers the pings
+		require.NoError(t, conn.WriteJSON(common.EventsSubscriptionFilter{Blocks: true}))
+		<-chSubscribed
+
+		select {
+		case <-subscription.chClosed:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timeout while waiting for the subscription to be closed")
+		}
+	})
+	t.Run("subscriber answering the pings should stay connected", func(t *testing.T) {
+		t.Parallel()
+
+		var subscription *eventsSubscriptionStub
+		chSubscribed := make(chan struct{})
+		facade := &mock.FacadeStub{
+			SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+				subscription = newEventsSubscriptionStub(filter)
+				close(chSubscribed)
+				return subscription, nil
+			},
+		}
+		conn, closeConn := dialEventsSubscription(t, facade)
+		defer closeConn()
+
+		require.NoError(t, conn.WriteJSON(common.EventsSubscriptionFilter{Blocks: true}))
+		<-chSubscribed
+
+		// reading makes the client answer the pings, for longer than the pong timeout
+		go func() {
+			time.Sleep(3 * time.Second)
+			subscription.chMessages <- &common.EventsSubscriptionMessage{Type: "block"}
+		}()
+		message := &common.EventsSubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(message))
+		require.Equal(t, "block", message.Type)
+	})
+	t.Run("ended subscription should write the error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+				subscription := newEventsSubscriptionStub(filter)
+				close(subscription.chMessages)
+				return subscription, nil
+			},
+		}
+		conn, closeConn := dialEventsSubscription(t, facade)
+		defer closeConn()
+
+		require.NoError(t, conn.WriteJSON(common.EventsSubscriptionFilter{Blocks: true}))
+
+		message := &common.EventsSubscriptionMessage{}
+		require.NoError(t, conn.ReadJSON(message))
+		require.Equal(t, "error", message.Type)
+		require.Contains(t, message.Data, "subscription ended")
+	})
+}
+
+func TestEventsGroup_CheckOrigin(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+			return newEventsSubscriptionStub(filter), nil
+		},
+	}
+	cfg := getEventsSubscriptionsConfig()
+	cfg.AllowedOrigins = []string{"https://explorer.multiversx.com/"}
+
+	t.Run("unknown origin should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{"Origin": []string{"https://evil.com"}}
+		conn, _, err := dialEventsSubscriptionWithConfig(facade, cfg, header)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		require.Nil(t, conn)
+	})
+	t.Run("allowed origin should work", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{"Origin": []string{"https://Explorer.multiversx.com"}}
+		_, closeConn, err := dialEventsSubscriptionWithConfig(facade, cfg, header)
+		require.NoError(t, err)
+		closeConn()
+	})
+	t.Run("missing origin should work", func(t *testing.T) {
+		t.Parallel()
+
+		_, closeConn, err := dialEventsSubscriptionWithConfig(facade, cfg, nil)
+		require.NoError(t, err)
+		closeConn()
+	})
+}
+
+func TestEventsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	eg, _ := groups.NewEventsGroup(&mock.FacadeStub{}, getEventsSubscriptionsConfig())
+
+	err := eg.UpdateFacade(nil)
+	require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = eg.UpdateFacade("wrong type")
+	require.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = eg.UpdateFacade(&mock.FacadeStub{})
+	require.NoError(t, err)
+}
+
+func TestEventsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	eg, _ := groups.NewEventsGroup(nil, getEventsSubscriptionsConfig())
+	require.True(t, eg.IsInterfaceNil())
+
+	eg, _ = groups.NewEventsGroup(&mock.FacadeStub{}, getEventsSubscriptionsConfig())
+	require.False(t, eg.IsInterfaceNil())
+}
+
+func getEventsSubscriptionsConfig() config.EventsSubscriptionsConfig {
+	return config.EventsSubscriptionsConfig{
+		MaxMessageSizeInBytes: 1024,
+		PingIntervalInSec:     1,
+		PongTimeoutInSec:      2,
+		WriteTimeoutInSec:     1,
+	}
+}
+
+func getEventsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/subscribe", Open: true},
+				},
+			},
+		},
+	}
+}
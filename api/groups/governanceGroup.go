@@ -0,0 +1,159 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	getGovernanceProposalsPath     = "/proposals"
+	getGovernanceProposalPath      = "/proposal/:nonce"
+	getGovernanceProposalVotesPath = "/proposal/:nonce/votes"
+)
+
+// governanceFacadeHandler defines the methods to be implemented by a facade for handling governance requests
+type governanceFacadeHandler interface {
+	GetGovernanceProposals() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposal, error)
+	GetGovernanceProposalVotes(nonce uint64) (*common.GovernanceProposalVotes, error)
+	IsInterfaceNil() bool
+}
+
+type governanceGroup struct {
+	*baseGroup
+	facade    governanceFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewGovernanceGroup returns a new instance of governanceGroup
+func NewGovernanceGroup(facade governanceFacadeHandler) (*governanceGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for governance group", errors.ErrNilFacadeHandler)
+	}
+
+	gg := &governanceGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:         getGovernanceProposalsPath,
+			Method:       http.MethodGet,
+			Handler:      gg.getProposals,
+			Description:  "returns all the indexed governance proposals, with their status and tallies",
+			ResponseData: gin.H{"proposals": []*common.GovernanceProposal{}},
+		},
+		{
+			Path:         getGovernanceProposalPath,
+			Method:       http.MethodGet,
+			Handler:      gg.getProposal,
+			Description:  "returns the governance proposal with the given nonce",
+			ResponseData: gin.H{"proposal": common.GovernanceProposal{}},
+		},
+		{
+			Path:         getGovernanceProposalVotesPath,
+			Method:       http.MethodGet,
+			Handler:      gg.getProposalVotes,
+			Description:  "returns the direct and the delegated votes cast on the governance proposal with the given nonce",
+			ResponseData: gin.H{"votes": []*common.GovernanceVote{}, "delegatedVotes": []*common.GovernanceVote{}},
+		},
+	}
+	gg.endpoints = endpoints
+
+	return gg, nil
+}
+
+// getProposals returns all the governance proposals found in the governance index
+func (gg *governanceGroup) getProposals(c *gin.Context) {
+	start := time.Now()
+	proposals, err := gg.getFacade().GetGovernanceProposals()
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetGovernanceProposals")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceProposals, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"proposals": proposals})
+}
+
+// getProposal returns the governance proposal with the provided nonce, including its tallies and status
+func (gg *governanceGroup) getProposal(c *gin.Context) {
+	nonce, err := getProposalNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetGovernanceProposal, errors.ErrInvalidProposalNonce)
+		return
+	}
+
+	start := time.Now()
+	proposal, err := gg.getFacade().GetGovernanceProposal(nonce)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetGovernanceProposal")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceProposal, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"proposal": proposal})
+}
+
+// getProposalVotes returns the direct and the delegated votes cast on the governance proposal with the provided nonce
+func (gg *governanceGroup) getProposalVotes(c *gin.Context) {
+	nonce, err := getProposalNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetGovernanceProposalVotes, errors.ErrInvalidProposalNonce)
+		return
+	}
+
+	start := time.Now()
+	votes, err := gg.getFacade().GetGovernanceProposalVotes(nonce)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetGovernanceProposalVotes")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetGovernanceProposalVotes, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"votes": votes.Votes, "delegatedVotes": votes.DelegatedVotes})
+}
+
+func getProposalNonce(c *gin.Context) (uint64, error) {
+	return strconv.ParseUint(c.Param("nonce"), 10, 64)
+}
+
+func (gg *governanceGroup) getFacade() governanceFacadeHandler {
+	gg.mutFacade.RLock()
+	defer gg.mutFacade.RUnlock()
+
+	return gg.facade
+}
+
+// UpdateFacade will update the facade
+func (gg *governanceGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(governanceFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	gg.mutFacade.Lock()
+	gg.facade = castFacade
+	gg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gg *governanceGroup) IsInterfaceNil() bool {
+	return gg == nil
+}
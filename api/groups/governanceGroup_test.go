@@ -0,0 +1,280 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type governanceProposalsResponse struct {
+	Data struct {
+		Proposals []*common.GovernanceProposal `json:"proposals"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalResponse struct {
+	Data struct {
+		Proposal *common.GovernanceProposal `json:"proposal"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalVotesResponse struct {
+	Data  common.GovernanceProposalVotes `json:"data"`
+	Error string                         `json:"error"`
+	Code  string                         `json:"code"`
+}
+
+func TestNewGovernanceGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		gg, err := groups.NewGovernanceGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, gg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		gg, err := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, gg)
+	})
+}
+
+func TestGovernanceGroup_getProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposal, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetGovernanceProposals.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proposals := []*common.GovernanceProposal{
+			{Nonce: 1, Status: "passed", Yes: "100"},
+			{Nonce: 2, Status: "active", No: "50"},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposal, error) {
+				return proposals, nil
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, proposals, response.Data.Proposals)
+	})
+}
+
+func TestGovernanceGroup_getProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidProposalNonce.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposal, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetGovernanceProposal.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proposal := &common.GovernanceProposal{
+			Nonce:            3,
+			CommitHash:       "hash",
+			Status:           "ended",
+			ParameterChanges: map[string]string{"stakingNodePrice": "2500"},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalCalled: func(nonce uint64) (*common.GovernanceProposal, error) {
+				assert.Equal(t, uint64(3), nonce)
+				return proposal, nil
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, proposal, response.Data.Proposal)
+	})
+}
+
+func TestGovernanceGroup_getProposalVotes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/-1/votes", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrInvalidProposalNonce.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalVotesCalled: func(nonce uint64) (*common.GovernanceProposalVotes, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/3/votes", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetGovernanceProposalVotes.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		votes := &common.GovernanceProposalVotes{
+			Votes: []*common.GovernanceVote{
+				{Voter: "erd1voter", Option: "yes", Stake: "1000", VotingPower: "100", TxHash: "aa"},
+			},
+			DelegatedVotes: []*common.GovernanceVote{
+				{Voter: "erd1delegator", Option: "no", Stake: "400", VotingPower: "40", DelegatedBy: "erd1delegation", TxHash: "bb"},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetGovernanceProposalVotesCalled: func(nonce uint64) (*common.GovernanceProposalVotes, error) {
+				assert.Equal(t, uint64(3), nonce)
+				return votes, nil
+			},
+		}
+		gg, _ := groups.NewGovernanceGroup(facade)
+		ws := startWebServer(gg, "governance", getGovernanceRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/governance/proposal/3/votes", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalVotesResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *votes, response.Data)
+	})
+}
+
+func TestGovernanceGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	gg, _ := groups.NewGovernanceGroup(&mock.FacadeStub{})
+
+	err := gg.UpdateFacade(nil)
+	require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = gg.UpdateFacade("wrong type")
+	require.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = gg.UpdateFacade(&mock.FacadeStub{})
+	require.NoError(t, err)
+}
+
+func TestGovernanceGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	gg, _ := groups.NewGovernanceGroup(nil)
+	require.True(t, gg.IsInterfaceNil())
+
+	gg, _ = groups.NewGovernanceGroup(&mock.FacadeStub{})
+	require.False(t, gg.IsInterfaceNil())
+}
+
+func getGovernanceRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"governance": {
+				Routes: []config.RouteConfig{
+					{Name: "/proposals", Open: true},
+					{Name: "/proposal/:nonce", Open: true},
+					{Name: "/proposal/:nonce/votes", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,346 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/external"
+)
+
+const (
+	registrationChallengePath     = "/registration-challenge"
+	registerGuardedAccountPath    = "/register"
+	verifyGuardianCodePath        = "/verify-code"
+	setGuardianSpendingPolicyPath = "/spending-policy"
+	coSignTransactionPath         = "/co-sign-transaction"
+)
+
+// guardianFacadeHandler defines the methods to be implemented by a facade for handling guardian co-signing requests
+type guardianFacadeHandler interface {
+	GetGuardianRegistrationChallenge(address string) (*common.GuardianRegistrationChallenge, error)
+	RegisterGuardedAccount(address string, signature string) (*common.GuardianRegistration, error)
+	VerifyGuardianCode(address string, code string) error
+	SetGuardianSpendingPolicy(address string, code string, policy *common.GuardianSpendingPolicy) error
+	CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error)
+	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
+	IsInterfaceNil() bool
+}
+
+type guardianGroup struct {
+	*baseGroup
+	facade    guardianFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewGuardianGroup returns a new instance of guardianGroup
+func NewGuardianGroup(facade guardianFacadeHandler) (*guardianGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for guardian group", errors.ErrNilFacadeHandler)
+	}
+
+	gg := &guardianGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:         registrationChallengePath,
+			Method:       http.MethodPost,
+			Handler:      gg.getRegistrationChallenge,
+			Description:  "issues the single use challenge to be signed by the account owner on registration",
+			ResponseData: gin.H{"challenge": common.GuardianRegistrationChallenge{}},
+		},
+		{
+			Path:        registerGuardedAccountPath,
+			Method:      http.MethodPost,
+			Handler:     gg.registerGuardedAccount,
+			Description: "registers an account on the co-signing service and returns its TOTP secret and the guardian address",
+		},
+		{
+			Path:         verifyGuardianCodePath,
+			Method:       http.MethodPost,
+			Handler:      gg.verifyGuardianCode,
+			Description:  "confirms the registration of an account on the co-signing service with a valid TOTP code",
+			ResponseData: gin.H{"confirmed": true},
+		},
+		{
+			Path:        setGuardianSpendingPolicyPath,
+			Method:      http.MethodPost,
+			Handler:     gg.setGuardianSpendingPolicy,
+			Description: "sets the spending policy enforced by the co-signing service for a registered account",
+		},
+		{
+			Path:         coSignTransactionPath,
+			Method:       http.MethodPost,
+			Handler:      gg.coSignTransaction,
+			Description:  "co-signs the given guarded transaction, if the TOTP code is valid and the spending policy is met",
+			ResponseData: gin.H{"transaction": transaction.FrontendTransaction{}},
+		},
+	}
+	gg.endpoints = endpoints
+
+	return gg, nil
+}
+
+// RegistrationChallengeRequest represents the structure of a request for a registration challenge
+type RegistrationChallengeRequest struct {
+	Address string `json:"address"`
+}
+
+// RegisterGuardedAccountRequest represents the structure of a request for registering an account on the co-signing service
+type RegisterGuardedAccountRequest struct {
+	Address   string `json:"address"`
+	Signature string `json:"signature"`
+}
+
+// GuardianCodeRequest represents the structure of a request for confirming the registration of an account
+type GuardianCodeRequest struct {
+	Address string `json:"address"`
+	Code    string `json:"code"`
+}
+
+// GuardianSpendingPolicyRequest represents the structure of a request for setting the spending policy of an account
+type GuardianSpendingPolicyRequest struct {
+	Address string                         `json:"address"`
+	Code    string                         `json:"code"`
+	Policy  *common.GuardianSpendingPolicy `json:"policy"`
+}
+
+// CoSignTransactionRequest represents the structure of a request for co-signing a guarded transaction
+type CoSignTransactionRequest struct {
+	Transaction transaction.FrontendTransaction `json:"transaction"`
+	Code        string                          `json:"code"`
+}
+
+// getRegistrationChallenge issues a registration challenge on the guardian co-signing service
+func (gg *guardianGroup) getRegistrationChallenge(c *gin.Context) {
+	var request = RegistrationChallengeRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		gg.respondWithValidationError(c, err)
+		return
+	}
+
+	start := time.Now()
+	challenge, err := gg.getFacade().GetGuardianRegistrationChallenge(request.Address)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetGuardianRegistrationChallenge")
+	if err != nil {
+		gg.respondWithInternalError(c, errors.ErrGetGuardianRegistrationChallenge, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"challenge": challenge},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// registerGuardedAccount registers an account on the guardian co-signing service
+func (gg *guardianGroup) registerGuardedAccount(c *gin.Context) {
+	var request = RegisterGuardedAccountRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		gg.respondWithValidationError(c, err)
+		return
+	}
+
+	start := time.Now()
+	registration, err := gg.getFacade().RegisterGuardedAccount(request.Address, request.Signature)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: RegisterGuardedAccount")
+	if err != nil {
+		gg.respondWithInternalError(c, errors.ErrRegisterGuardedAccount, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"registration": registration},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// verifyGuardianCode confirms the registration of an account on the guardian co-signing service
+func (gg *guardianGroup) verifyGuardianCode(c *gin.Context) {
+	var request = GuardianCodeRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		gg.respondWithValidationError(c, err)
+		return
+	}
+
+	start := time.Now()
+	err = gg.getFacade().VerifyGuardianCode(request.Address, request.Code)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: VerifyGuardianCode")
+	if err != nil {
+		gg.respondWithInternalError(c, errors.ErrVerifyGuardianCode, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"confirmed": true},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// setGuardianSpendingPolicy replaces the spending policy of an account registered on the guardian co-signing service
+func (gg *guardianGroup) setGuardianSpendingPolicy(c *gin.Context) {
+	var request = GuardianSpendingPolicyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		gg.respondWithValidationError(c, err)
+		return
+	}
+
+	start := time.Now()
+	err = gg.getFacade().SetGuardianSpendingPolicy(request.Address, request.Code, request.Policy)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SetGuardianSpendingPolicy")
+	if err != nil {
+		gg.respondWithInternalError(c, errors.ErrSetGuardianSpendingPolicy, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"policy": request.Policy},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// coSignTransaction returns the provided transaction, together with the guardian signature
+func (gg *guardianGroup) coSignTransaction(c *gin.Context) {
+	var request = CoSignTransactionRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		gg.respondWithValidationError(c, err)
+		return
+	}
+
+	ftx := request.Transaction
+	txArgs := &external.ArgsCreateTransaction{
+		Nonce:            ftx.Nonce,
+		Value:            ftx.Value,
+		Receiver:         ftx.Receiver,
+		ReceiverUsername: ftx.ReceiverUsername,
+		Sender:           ftx.Sender,
+		SenderUsername:   ftx.SenderUsername,
+		GasPrice:         ftx.GasPrice,
+		GasLimit:         ftx.GasLimit,
+		DataField:        ftx.Data,
+		SignatureHex:     ftx.Signature,
+		ChainID:          ftx.ChainID,
+		Version:          ftx.Version,
+		Options:          ftx.Options,
+		Guardian:         ftx.GuardianAddr,
+		GuardianSigHex:   ftx.GuardianSignature,
+		Relayer:          ftx.RelayerAddr,
+		RelayerSigHex:    ftx.RelayerSignature,
+	}
+	start := time.Now()
+	tx, _, err := gg.getFacade().CreateTransaction(txArgs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	guardianSignature, err := gg.getFacade().CoSignTransaction(tx, request.Code)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CoSignTransaction")
+	if err != nil {
+		gg.respondWithInternalError(c, errors.ErrCoSignTransaction, err)
+		return
+	}
+
+	ftx.GuardianSignature = hex.EncodeToString(guardianSignature)
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transaction": ftx},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (gg *guardianGroup) respondWithValidationError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusBadRequest,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			Code:  shared.ReturnCodeRequestError,
+		},
+	)
+}
+
+func (gg *guardianGroup) respondWithInternalError(c *gin.Context, baseErr error, err error) {
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", baseErr.Error(), err.Error()),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
+}
+
+func (gg *guardianGroup) getFacade() guardianFacadeHandler {
+	gg.mutFacade.RLock()
+	defer gg.mutFacade.RUnlock()
+
+	return gg.facade
+}
+
+// UpdateFacade will update the facade
+func (gg *guardianGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(guardianFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	gg.mutFacade.Lock()
+	gg.facade = castFacade
+	gg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gg *guardianGroup) IsInterfaceNil() bool {
+	return gg == nil
+}
@@ -0,0 +1,437 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type guardianRegistrationChallengeResponse struct {
+	Data struct {
+		Challenge common.GuardianRegistrationChallenge `json:"challenge"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type guardianRegistrationResponse struct {
+	Data struct {
+		Registration common.GuardianRegistration `json:"registration"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type coSignedTransactionResponse struct {
+	Data struct {
+		Transaction transaction.FrontendTransaction `json:"transaction"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewGuardianGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		gg, err := groups.NewGuardianGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, gg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		gg, err := groups.NewGuardianGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, gg)
+	})
+}
+
+func TestGuardianGroup_getRegistrationChallenge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGuardianGroup(&mock.FacadeStub{})
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/guardian/registration-challenge", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetGuardianRegistrationChallengeCalled: func(address string) (*common.GuardianRegistrationChallenge, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.RegistrationChallengeRequest{Address: "erd1"})
+		req, _ := http.NewRequest("POST", "/guardian/registration-challenge", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrGetGuardianRegistrationChallenge.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		challenge := &common.GuardianRegistrationChallenge{
+			Challenge: "aabb",
+			Message:   "uid:register:erd1:aabb",
+			ExpiresAt: 1700000300,
+		}
+		facade := &mock.FacadeStub{
+			GetGuardianRegistrationChallengeCalled: func(address string) (*common.GuardianRegistrationChallenge, error) {
+				assert.Equal(t, "erd1", address)
+				return challenge, nil
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.RegistrationChallengeRequest{Address: "erd1"})
+		req, _ := http.NewRequest("POST", "/guardian/registration-challenge", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := guardianRegistrationChallengeResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *challenge, response.Data.Challenge)
+	})
+}
+
+func TestGuardianGroup_registerGuardedAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		gg, _ := groups.NewGuardianGroup(&mock.FacadeStub{})
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/guardian/register", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RegisterGuardedAccountCalled: func(address string, signature string) (*common.GuardianRegistration, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.RegisterGuardedAccountRequest{Address: "erd1", Signature: "aa"})
+		req, _ := http.NewRequest("POST", "/guardian/register", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrRegisterGuardedAccount.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		registration := &common.GuardianRegistration{
+			GuardianAddress: "erd1guardian",
+			ServiceUID:      "uid",
+			OTPSecret:       "SECRET",
+		}
+		facade := &mock.FacadeStub{
+			RegisterGuardedAccountCalled: func(address string, signature string) (*common.GuardianRegistration, error) {
+				assert.Equal(t, "erd1", address)
+				assert.Equal(t, "aa", signature)
+				return registration, nil
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.RegisterGuardedAccountRequest{Address: "erd1", Signature: "aa"})
+		req, _ := http.NewRequest("POST", "/guardian/register", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := guardianRegistrationResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, *registration, response.Data.Registration)
+	})
+}
+
+func TestGuardianGroup_verifyGuardianCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyGuardianCodeCalled: func(address string, code string) error {
+				return expectedErr
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.GuardianCodeRequest{Address: "erd1", Code: "123456"})
+		req, _ := http.NewRequest("POST", "/guardian/verify-code", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrVerifyGuardianCode.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			VerifyGuardianCodeCalled: func(address string, code string) error {
+				wasCalled = true
+				assert.Equal(t, "erd1", address)
+				assert.Equal(t, "123456", code)
+				return nil
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.GuardianCodeRequest{Address: "erd1", Code: "123456"})
+		req, _ := http.NewRequest("POST", "/guardian/verify-code", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestGuardianGroup_setGuardianSpendingPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := &common.GuardianSpendingPolicy{
+		DailyLimits:      map[string]string{"EGLD": "1000"},
+		AllowedReceivers: []string{"erd1receiver"},
+	}
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SetGuardianSpendingPolicyCalled: func(address string, code string, policy *common.GuardianSpendingPolicy) error {
+				return expectedErr
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.GuardianSpendingPolicyRequest{Address: "erd1", Code: "123456", Policy: policy})
+		req, _ := http.NewRequest("POST", "/guardian/spending-policy", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrSetGuardianSpendingPolicy.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SetGuardianSpendingPolicyCalled: func(address string, code string, providedPolicy *common.GuardianSpendingPolicy) error {
+				assert.Equal(t, "erd1", address)
+				assert.Equal(t, "123456", code)
+				assert.Equal(t, policy, providedPolicy)
+				return nil
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(&groups.GuardianSpendingPolicyRequest{Address: "erd1", Code: "123456", Policy: policy})
+		req, _ := http.NewRequest("POST", "/guardian/spending-policy", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+func TestGuardianGroup_coSignTransaction(t *testing.T) {
+	t.Parallel()
+
+	request := &groups.CoSignTransactionRequest{
+		Transaction: transaction.FrontendTransaction{
+			Sender:       "erd1sender",
+			Receiver:     "erd1receiver",
+			Value:        "10",
+			Signature:    "aabb",
+			GuardianAddr: "erd1guardian",
+			RelayerAddr:  "erd1relayer",
+			Version:      2,
+			Options:      2,
+		},
+		Code: "123456",
+	}
+
+	t.Run("create transaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/guardian/co-sign-transaction", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrTxGenerationFailed.Error())
+	})
+	t.Run("co-sign error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				return &transaction.Transaction{}, nil, nil
+			},
+			CoSignTransactionCalled: func(tx *transaction.Transaction, code string) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/guardian/co-sign-transaction", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrCoSignTransaction.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		createdTx := &transaction.Transaction{Nonce: 7}
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error) {
+				assert.Equal(t, request.Transaction.Sender, txArgs.Sender)
+				assert.Equal(t, request.Transaction.GuardianAddr, txArgs.Guardian)
+				assert.Equal(t, request.Transaction.RelayerAddr, txArgs.Relayer)
+				return createdTx, nil, nil
+			},
+			CoSignTransactionCalled: func(tx *transaction.Transaction, code string) ([]byte, error) {
+				assert.Equal(t, createdTx, tx)
+				assert.Equal(t, request.Code, code)
+				return []byte{0xca, 0xfe}, nil
+			},
+		}
+		gg, _ := groups.NewGuardianGroup(facade)
+		ws := startWebServer(gg, "guardian", getGuardianRoutesConfig())
+
+		buff, _ := json.Marshal(request)
+		req, _ := http.NewRequest("POST", "/guardian/co-sign-transaction", bytes.NewBuffer(buff))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := coSignedTransactionResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "cafe", response.Data.Transaction.GuardianSignature)
+		assert.Equal(t, request.Transaction.Signature, response.Data.Transaction.Signature)
+	})
+}
+
+func TestGuardianGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	gg, _ := groups.NewGuardianGroup(&mock.FacadeStub{})
+
+	err := gg.UpdateFacade(nil)
+	require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = gg.UpdateFacade("wrong type")
+	require.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = gg.UpdateFacade(&mock.FacadeStub{})
+	require.NoError(t, err)
+}
+
+func TestGuardianGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	gg, _ := groups.NewGuardianGroup(nil)
+	require.True(t, gg.IsInterfaceNil())
+
+	gg, _ = groups.NewGuardianGroup(&mock.FacadeStub{})
+	require.False(t, gg.IsInterfaceNil())
+}
+
+func getGuardianRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"guardian": {
+				Routes: []config.RouteConfig{
+					{Name: "/registration-challenge", Open: true},
+					{Name: "/register", Open: true},
+					{Name: "/verify-code", Open: true},
+					{Name: "/spending-policy", Open: true},
+					{Name: "/co-sign-transaction", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -43,9 +43,11 @@ func NewHardforkGroup(facade hardforkFacadeHandler) (*hardforkGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    triggerPath,
-			Method:  http.MethodPost,
-			Handler: hg.triggerHandler,
+			Path:         triggerPath,
+			Method:       http.MethodPost,
+			Handler:      hg.triggerHandler,
+			Description:  "triggers the hardfork process",
+			ResponseData: gin.H{"status": ""},
 		},
 	}
 	hg.endpoints = endpoints
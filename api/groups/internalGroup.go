@@ -69,89 +69,114 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    getRawMetaBlockByNoncePath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawMetaBlockByNonce,
+			Path:         getRawMetaBlockByNoncePath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawMetaBlockByNonce,
+			Description:  "returns the marshalled meta block with the given nonce",
+			ResponseData: gin.H{"block": []byte{}},
 		},
 		{
-			Path:    getRawMetaBlockByHashPath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawMetaBlockByHash,
+			Path:         getRawMetaBlockByHashPath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawMetaBlockByHash,
+			Description:  "returns the marshalled meta block with the given hex hash",
+			ResponseData: gin.H{"block": []byte{}},
 		},
 		{
-			Path:    getRawMetaBlockByRoundPath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawMetaBlockByRound,
+			Path:         getRawMetaBlockByRoundPath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawMetaBlockByRound,
+			Description:  "returns the marshalled meta block proposed in the given round",
+			ResponseData: gin.H{"block": []byte{}},
 		},
 		{
-			Path:    getRawStartOfEpochMetaBlockPath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawStartOfEpochMetaBlock,
+			Path:         getRawStartOfEpochMetaBlockPath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawStartOfEpochMetaBlock,
+			Description:  "returns the marshalled start of epoch meta block of the given epoch",
+			ResponseData: gin.H{"block": []byte{}},
 		},
 		{
-			Path:    getRawShardBlockByNoncePath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawShardBlockByNonce,
+			Path:         getRawShardBlockByNoncePath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawShardBlockByNonce,
+			Description:  "returns the marshalled shard block with the given nonce",
+			ResponseData: gin.H{"block": []byte{}},
 		},
 		{
-			Path:    getRawShardBlockByHashPath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawShardBlockByHash,
+			Path:         getRawShardBlockByHashPath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawShardBlockByHash,
+			Description:  "returns the marshalled shard block with the given hex hash",
+			ResponseData: gin.H{"block": []byte{}},
 		},
 		{
-			Path:    getRawShardBlockByRoundPath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawShardBlockByRound,
+			Path:         getRawShardBlockByRoundPath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawShardBlockByRound,
+			Description:  "returns the marshalled shard block proposed in the given round",
+			ResponseData: gin.H{"block": []byte{}},
 		},
 		{
-			Path:    getJSONMetaBlockByNoncePath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONMetaBlockByNonce,
+			Path:        getJSONMetaBlockByNoncePath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONMetaBlockByNonce,
+			Description: "returns the meta block with the given nonce, as stored internally",
 		},
 		{
-			Path:    getJSONMetaBlockByHashPath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONMetaBlockByHash,
+			Path:        getJSONMetaBlockByHashPath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONMetaBlockByHash,
+			Description: "returns the meta block with the given hex hash, as stored internally",
 		},
 		{
-			Path:    getJSONMetaBlockByRoundPath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONMetaBlockByRound,
+			Path:        getJSONMetaBlockByRoundPath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONMetaBlockByRound,
+			Description: "returns the meta block proposed in the given round, as stored internally",
 		},
 		{
-			Path:    getJSONStartOfEpochMetaBlockPath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONStartOfEpochMetaBlock,
+			Path:        getJSONStartOfEpochMetaBlockPath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONStartOfEpochMetaBlock,
+			Description: "returns the start of epoch meta block of the given epoch, as stored internally",
 		},
 		{
-			Path:    getJSONShardBlockByNoncePath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONShardBlockByNonce,
+			Path:        getJSONShardBlockByNoncePath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONShardBlockByNonce,
+			Description: "returns the shard block with the given nonce, as stored internally",
 		},
 		{
-			Path:    getJSONShardBlockByHashPath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONShardBlockByHash,
+			Path:        getJSONShardBlockByHashPath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONShardBlockByHash,
+			Description: "returns the shard block with the given hex hash, as stored internally",
 		},
 		{
-			Path:    getJSONShardBlockByRoundPath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONShardBlockByRound,
+			Path:        getJSONShardBlockByRoundPath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONShardBlockByRound,
+			Description: "returns the shard block proposed in the given round, as stored internally",
 		},
 		{
-			Path:    getRawMiniBlockByHashPath,
-			Method:  http.MethodGet,
-			Handler: ib.getRawMiniBlockByHash,
+			Path:         getRawMiniBlockByHashPath,
+			Method:       http.MethodGet,
+			Handler:      ib.getRawMiniBlockByHash,
+			Description:  "returns the marshalled miniblock with the given hex hash, from the given epoch",
+			ResponseData: gin.H{"miniblock": []byte{}},
 		},
 		{
-			Path:    getJSONMiniBlockByHashPath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONMiniBlockByHash,
+			Path:        getJSONMiniBlockByHashPath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONMiniBlockByHash,
+			Description: "returns the miniblock with the given hex hash, from the given epoch, as stored internally",
 		},
 		{
-			Path:    getJSONStartOfEpochValidatorsInfoPath,
-			Method:  http.MethodGet,
-			Handler: ib.getJSONStartOfEpochValidatorsInfo,
+			Path:        getJSONStartOfEpochValidatorsInfoPath,
+			Method:      http.MethodGet,
+			Handler:     ib.getJSONStartOfEpochValidatorsInfo,
+			Description: "returns the validators info saved at the start of the given epoch",
 		},
 	}
 	ib.endpoints = endpoints
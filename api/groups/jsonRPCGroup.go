@@ -0,0 +1,232 @@
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/jsonrpc"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	jsonRPCPath       = "/jsonrpc"
+	openRPCSchemaPath = "/openrpc"
+	jsonRPCTitle      = "MultiversX node JSON-RPC API"
+	jsonRPCVersion    = "1.0.0"
+)
+
+// jsonRPCFacadeHandler defines the methods to be implemented by a facade for serving the JSON-RPC methods
+type jsonRPCFacadeHandler interface {
+	addressFacadeHandler
+	blockFacadeHandler
+	transactionFacadeHandler
+	vmValuesFacadeHandler
+	networkFacadeHandler
+}
+
+type jsonRPCDispatcher interface {
+	HandleRequest(source *http.Request, body []byte) interface{}
+	Schema() json.RawMessage
+	IsInterfaceNil() bool
+}
+
+type jsonRPCGroup struct {
+	*baseGroup
+	restGroups      map[string]shared.GroupHandler
+	callMiddlewares []shared.MiddlewareProcessor
+	maxBatchSize    uint32
+	dispatcher      jsonRPCDispatcher
+	mutDispatcher   sync.RWMutex
+}
+
+// NewJSONRPCGroup returns a new instance of jsonRPCGroup. The JSON-RPC methods are served by the handlers of the REST
+// groups they are mapped onto, so the requests are validated exactly as their REST counterparts. The provided
+// middlewares are applied on each JSON-RPC call, so that every call of a batch is traced, measured, throttled and
+// authorized on its own
+func NewJSONRPCGroup(facade jsonRPCFacadeHandler, callMiddlewares []shared.MiddlewareProcessor, maxBatchSize uint32) (*jsonRPCGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for json-rpc group", errors.ErrNilFacadeHandler)
+	}
+	if maxBatchSize == 0 {
+		return nil, fmt.Errorf("%w for json-rpc group, provided %d", errors.ErrInvalidJSONRPCMaxBatchSize, maxBatchSize)
+	}
+
+	restGroups, err := createJSONRPCRestGroups(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	jg := &jsonRPCGroup{
+		baseGroup:       &baseGroup{},
+		restGroups:      restGroups,
+		callMiddlewares: callMiddlewares,
+		maxBatchSize:    maxBatchSize,
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:        jsonRPCPath,
+			Method:      http.MethodPost,
+			Handler:     jg.handleJSONRPC,
+			Description: "handles single or batch JSON-RPC 2.0 requests, each method being served by the REST endpoint it is mapped onto",
+		},
+		{
+			Path:         openRPCSchemaPath,
+			Method:       http.MethodGet,
+			Handler:      jg.getOpenRPCSchema,
+			Description:  "returns the OpenRPC document describing the JSON-RPC methods",
+			ResponseData: gin.H{"schema": jsonrpc.OpenRPCDocument{}},
+		},
+	}
+	jg.endpoints = endpoints
+
+	return jg, nil
+}
+
+func createJSONRPCRestGroups(facade jsonRPCFacadeHandler) (map[string]shared.GroupHandler, error) {
+	addressGroup, err := NewAddressGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+	blockGroup, err := NewBlockGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+	transactionGroup, err := NewTransactionGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+	vmValuesGroup, err := NewVmValuesGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+	networkGroup, err := NewNetworkGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]shared.GroupHandler{
+		jsonRPCAddressGroup:     addressGroup,
+		jsonRPCBlockGroup:       blockGroup,
+		jsonRPCTransactionGroup: transactionGroup,
+		jsonRPCVMValuesGroup:    vmValuesGroup,
+		jsonRPCNetworkGroup:     networkGroup,
+	}, nil
+}
+
+// RegisterRoutes will register the JSON-RPC endpoints to the given web server. The REST routes closed in the provided
+// config are closed for the JSON-RPC methods mapped onto them as well
+func (jg *jsonRPCGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	restEngine := gin.New()
+	// the calls are sent with the client address already resolved by the web server, so no header is trusted
+	err := restEngine.SetTrustedProxies(nil)
+	if err != nil {
+		log.Error("cannot configure the json-rpc handler, json-rpc endpoints will not be registered", "error", err)
+		return
+	}
+	for idx, callMiddleware := range jg.callMiddlewares {
+		if check.IfNil(callMiddleware) {
+			log.Error("got nil json-rpc middleware processor, skipping it...", "index", idx)
+			continue
+		}
+
+		restEngine.Use(callMiddleware.MiddlewareHandlerFunc())
+	}
+	for groupName, groupHandler := range jg.restGroups {
+		groupHandler.RegisterRoutes(restEngine.Group("/"+groupName), apiConfig)
+	}
+
+	dispatcher, err := jsonrpc.NewDispatcher(jsonrpc.ArgsDispatcher{
+		Handler:      restEngine,
+		Methods:      jsonRPCMethods(),
+		MaxBatchSize: int(jg.maxBatchSize),
+		Title:        jsonRPCTitle,
+		Version:      jsonRPCVersion,
+	})
+	if err != nil {
+		log.Error("cannot create the json-rpc dispatcher, json-rpc endpoints will not be registered", "error", err)
+		return
+	}
+
+	jg.mutDispatcher.Lock()
+	jg.dispatcher = dispatcher
+	jg.mutDispatcher.Unlock()
+
+	jg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// handleJSONRPC handles a single or a batch JSON-RPC 2.0 request
+func (jg *jsonRPCGroup) handleJSONRPC(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrInvalidJSONRequest, err)
+		return
+	}
+
+	// the calls are sent from the client address, so the same per source limits apply to each call of a batch
+	source := c.Request.Clone(c.Request.Context())
+	source.RemoteAddr = net.JoinHostPort(c.ClientIP(), "0")
+	// the trace of the request is already held by its context, so the calls spans become children of the request span
+	for _, field := range otel.GetTextMapPropagator().Fields() {
+		source.Header.Del(field)
+	}
+
+	response := jg.getDispatcher().HandleRequest(source, body)
+	if response == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// getOpenRPCSchema returns the OpenRPC document describing the JSON-RPC methods
+func (jg *jsonRPCGroup) getOpenRPCSchema(c *gin.Context) {
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"schema": jg.getDispatcher().Schema()},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func (jg *jsonRPCGroup) getDispatcher() jsonRPCDispatcher {
+	jg.mutDispatcher.RLock()
+	defer jg.mutDispatcher.RUnlock()
+
+	return jg.dispatcher
+}
+
+// UpdateFacade will update the facade of the REST groups serving the JSON-RPC methods
+func (jg *jsonRPCGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	_, ok := newFacade.(jsonRPCFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	for _, groupHandler := range jg.restGroups {
+		err := groupHandler.UpdateFacade(newFacade)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jg *jsonRPCGroup) IsInterfaceNil() bool {
+	return jg == nil
+}
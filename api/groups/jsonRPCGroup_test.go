@@ -0,0 +1,469 @@
+package groups_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/jsonrpc"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/statusHandler/prometheusMetrics"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const jsonRPCMaxBatchSize = 10
+
+type openRPCSchemaResponse struct {
+	Data struct {
+		Schema jsonrpc.OpenRPCDocument `json:"schema"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestNewJSONRPCGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		jg, err := groups.NewJSONRPCGroup(nil, nil, jsonRPCMaxBatchSize)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, jg)
+	})
+
+	t.Run("invalid max batch size", func(t *testing.T) {
+		jg, err := groups.NewJSONRPCGroup(&mock.FacadeStub{}, nil, 0)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidJSONRPCMaxBatchSize))
+		require.Nil(t, jg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		jg, err := groups.NewJSONRPCGroup(&mock.FacadeStub{}, nil, jsonRPCMaxBatchSize)
+		require.NoError(t, err)
+		require.NotNil(t, jg)
+	})
+}
+
+func postJSONRPC(t *testing.T, facade *mock.FacadeStub, apiConfig config.ApiRoutesConfig, body string) *httptest.ResponseRecorder {
+	jg, err := groups.NewJSONRPCGroup(facade, nil, jsonRPCMaxBatchSize)
+	require.NoError(t, err)
+
+	ws := startWebServer(jg, "rpc", apiConfig)
+	req, _ := http.NewRequest(http.MethodPost, "/rpc/jsonrpc", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestJSONRPCGroup_handleJSONRPC(t *testing.T) {
+	t.Parallel()
+
+	t.Run("method should be served by the REST handler", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				require.Equal(t, "erd1alice", address)
+				require.Equal(t, uint64(37), options.BlockNonce.Value)
+				return big.NewInt(100), api.BlockInfo{Nonce: 37}, nil
+			},
+		}
+		resp := postJSONRPC(t, facade, getJSONRPCRoutesConfig(),
+			`{"jsonrpc":"2.0","id":7,"method":"address_getBalance","params":{"address":"erd1alice","blockNonce":37}}`)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		response := &jsonrpc.Response{}
+		loadResponse(resp.Body, response)
+		require.Nil(t, response.Error)
+		require.Equal(t, json.RawMessage("7"), response.ID)
+		require.JSONEq(t, `{"balance":"100","blockInfo":{"nonce":37}}`, string(response.Result))
+	})
+	t.Run("REST validation error should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		resp := postJSONRPC(t, &mock.FacadeStub{}, getJSONRPCRoutesConfig(),
+			`{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice", "not a nonce"]}`)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		response := &jsonrpc.Response{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, jsonrpc.CodeInvalidParams, response.Error.Code)
+		require.Contains(t, response.Error.Data, apiErrors.ErrGetBalance.Error())
+	})
+	t.Run("method mapped onto a closed route should not be found", func(t *testing.T) {
+		t.Parallel()
+
+		resp := postJSONRPC(t, &mock.FacadeStub{}, getJSONRPCRoutesConfig(),
+			`{"jsonrpc":"2.0","id":1,"method":"block_getByNonce","params":[1]}`)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		response := &jsonrpc.Response{}
+		loadResponse(resp.Body, response)
+		require.Equal(t, jsonrpc.CodeMethodNotFound, response.Error.Code)
+	})
+	t.Run("batch should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+				return big.NewInt(1), api.BlockInfo{}, nil
+			},
+		}
+		resp := postJSONRPC(t, facade, getJSONRPCRoutesConfig(), `[
+			{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice"]},
+			{"jsonrpc":"2.0","id":2,"method":"unknown"}
+		]`)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		responses := make([]*jsonrpc.Response, 0)
+		loadResponse(resp.Body, &responses)
+		require.Len(t, responses, 2)
+		require.Nil(t, responses[0].Error)
+		require.Equal(t, jsonrpc.CodeMethodNotFound, responses[1].Error.Code)
+	})
+	t.Run("notification should return no content", func(t *testing.T) {
+		t.Parallel()
+
+		resp := postJSONRPC(t, &mock.FacadeStub{}, getJSONRPCRoutesConfig(),
+			`{"jsonrpc":"2.0","method":"address_getBalance","params":["erd1alice"]}`)
+		require.Equal(t, http.StatusNoContent, resp.Code)
+	})
+}
+
+func TestJSONRPCGroup_getOpenRPCSchema(t *testing.T) {
+	t.Parallel()
+
+	jg, _ := groups.NewJSONRPCGroup(&mock.FacadeStub{}, nil, jsonRPCMaxBatchSize)
+	ws := startWebServer(jg, "rpc", getJSONRPCRoutesConfig())
+
+	req, _ := http.NewRequest(http.MethodGet, "/rpc/openrpc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	response := &openRPCSchemaResponse{}
+	loadResponse(resp.Body, response)
+	require.Equal(t, jsonrpc.OpenRPCVersion, response.Data.Schema.OpenRPC)
+
+	methodNames := make([]string, 0, len(response.Data.Schema.Methods))
+	for _, method := range response.Data.Schema.Methods {
+		methodNames = append(methodNames, method.Name)
+	}
+	expectedMethods := []string{
+		"address_getAccount", "address_getBalance", "address_getESDTTokens", "address_getESDTBalance",
+		"address_getESDTNFTData", "block_getByNonce", "block_getByHash", "transaction_get", "transaction_send",
+		"transaction_simulate", "transaction_cost", "vm_query", "network_getConfig", "network_getStatus",
+		"network_getESDTSupply", jsonrpc.DiscoverMethod,
+	}
+	require.Equal(t, expectedMethods, methodNames)
+}
+
+func TestJSONRPCGroup_BatchCallsShouldBeThrottled(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			numCalls++
+			return big.NewInt(1), api.BlockInfo{}, nil
+		},
+	}
+	sourceThrottler, _ := middleware.NewSourceThrottler(2)
+	jg, err := groups.NewJSONRPCGroup(facade, []shared.MiddlewareProcessor{sourceThrottler}, jsonRPCMaxBatchSize)
+	require.NoError(t, err)
+
+	ws := startWebServer(jg, "rpc", getJSONRPCRoutesConfig())
+	sendBatch := func(remoteAddr string) []jsonrpc.Response {
+		req, _ := http.NewRequest(http.MethodPost, "/rpc/jsonrpc", bytes.NewBufferString(`[
+			{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice"]},
+			{"jsonrpc":"2.0","id":2,"method":"address_getBalance","params":["erd1alice"]},
+			{"jsonrpc":"2.0","id":3,"method":"address_getBalance","params":["erd1alice"]}
+		]`))
+		req.RemoteAddr = remoteAddr
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		responses := make([]jsonrpc.Response, 0)
+		loadResponse(resp.Body, &responses)
+		require.Len(t, responses, 3)
+
+		return responses
+	}
+
+	responses := sendBatch("10.0.0.1:1000")
+	require.Nil(t, responses[0].Error)
+	require.Nil(t, responses[1].Error)
+	require.NotNil(t, responses[2].Error)
+	require.Equal(t, jsonrpc.CodeServerBusy, responses[2].Error.Code)
+	require.Equal(t, 2, numCalls)
+
+	// the calls are counted for the client address, so another client is not affected
+	responses = sendBatch("10.0.0.2:1000")
+	require.Nil(t, responses[0].Error)
+	require.Nil(t, responses[1].Error)
+	require.NotNil(t, responses[2].Error)
+	require.Equal(t, 4, numCalls)
+
+	sourceThrottler.Reset()
+	responses = sendBatch("10.0.0.1:1000")
+	require.Nil(t, responses[0].Error)
+	require.Equal(t, 6, numCalls)
+}
+
+func TestJSONRPCGroup_BatchCallsShouldBeAuthorizedOnTheirRoutes(t *testing.T) {
+	t.Parallel()
+
+	adminKeyHash := sha256.Sum256([]byte("admin-key"))
+	authenticator, _ := middleware.NewAuthenticator(config.ApiAuthenticationConfig{
+		Enabled:                 true,
+		AllowAnonymous:          true,
+		QuotaResetIntervalInSec: 60,
+		APIKeys: []config.ApiKeyConfig{
+			{Name: "admin", KeyHash: hex.EncodeToString(adminKeyHash[:]), Role: "admin"},
+		},
+		RestrictedRoutes: []config.ApiRestrictedRouteConfig{
+			{Path: "/address", Roles: []string{"admin"}},
+		},
+	})
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			return big.NewInt(1), api.BlockInfo{}, nil
+		},
+	}
+	jg, err := groups.NewJSONRPCGroup(facade, []shared.MiddlewareProcessor{authenticator}, jsonRPCMaxBatchSize)
+	require.NoError(t, err)
+
+	// the outer route is not restricted, so only the route each method is mapped onto decides the access
+	ws := startWebServer(jg, "rpc", getJSONRPCRoutesConfig())
+	sendBatch := func(apiKey string) []jsonrpc.Response {
+		req, _ := http.NewRequest(http.MethodPost, "/rpc/jsonrpc", bytes.NewBufferString(`[
+			{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice"]},
+			{"jsonrpc":"2.0","id":2,"method":"rpc.discover"}
+		]`))
+		req.RemoteAddr = "10.0.0.1:1000"
+		if len(apiKey) > 0 {
+			req.Header.Set(middleware.APIKeyHeader, apiKey)
+		}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		responses := make([]jsonrpc.Response, 0)
+		loadResponse(resp.Body, &responses)
+		require.Len(t, responses, 2)
+
+		return responses
+	}
+
+	t.Run("anonymous client should not call the restricted methods", func(t *testing.T) {
+		t.Parallel()
+
+		responses := sendBatch("")
+		require.NotNil(t, responses[0].Error)
+		require.Equal(t, jsonrpc.CodeForbidden, responses[0].Error.Code)
+		require.Nil(t, responses[1].Error)
+	})
+	t.Run("invalid credentials should not call any method", func(t *testing.T) {
+		t.Parallel()
+
+		responses := sendBatch("wrong-key")
+		require.NotNil(t, responses[0].Error)
+		require.Equal(t, jsonrpc.CodeUnauthorized, responses[0].Error.Code)
+		require.Nil(t, responses[1].Error)
+	})
+	t.Run("client with the required role should call the restricted methods", func(t *testing.T) {
+		t.Parallel()
+
+		responses := sendBatch("admin-key")
+		require.Nil(t, responses[0].Error)
+		require.JSONEq(t, `{"balance":"1","blockInfo":{}}`, string(responses[0].Result))
+		require.Nil(t, responses[1].Error)
+	})
+}
+
+func TestJSONRPCGroup_BatchShouldRespectTheMaxBatchSize(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			numCalls++
+			return big.NewInt(1), api.BlockInfo{}, nil
+		},
+	}
+	jg, err := groups.NewJSONRPCGroup(facade, nil, 1)
+	require.NoError(t, err)
+
+	ws := startWebServer(jg, "rpc", getJSONRPCRoutesConfig())
+	req, _ := http.NewRequest(http.MethodPost, "/rpc/jsonrpc", bytes.NewBufferString(`[
+		{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice"]},
+		{"jsonrpc":"2.0","id":2,"method":"address_getBalance","params":["erd1bob"]}
+	]`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &jsonrpc.Response{}
+	loadResponse(resp.Body, response)
+	require.Equal(t, jsonrpc.CodeInvalidRequest, response.Error.Code)
+	require.Zero(t, numCalls)
+}
+
+func TestJSONRPCGroup_BatchCallsShouldBeMeasured(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			return big.NewInt(1), api.BlockInfo{}, nil
+		},
+	}
+	registry := prometheusMetrics.NewPrometheusRegistry()
+	metricsMiddleware, _ := middleware.NewMetricsMiddleware(registry)
+	callMiddlewares := []shared.MiddlewareProcessor{metricsMiddleware.WithEndpointPrefix("/rpc/jsonrpc")}
+	jg, err := groups.NewJSONRPCGroup(facade, callMiddlewares, jsonRPCMaxBatchSize)
+	require.NoError(t, err)
+
+	ws := startWebServer(jg, "rpc", getJSONRPCRoutesConfig())
+	req, _ := http.NewRequest(http.MethodPost, "/rpc/jsonrpc", bytes.NewBufferString(`[
+		{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice"]},
+		{"jsonrpc":"2.0","id":2,"method":"address_getBalance","params":["erd1bob"]}
+	]`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	metrics, err := registry.PrometheusString()
+	require.NoError(t, err)
+	require.Contains(t, metrics, `erd_api_requests_total{endpoint="/rpc/jsonrpc/address/:address/balance",method="GET",result="200"} 2`)
+}
+
+func TestJSONRPCGroup_BatchCallsShouldBeTracedUnderTheRequestSpan(t *testing.T) {
+	// not parallel, as the tracer provider is set as the global one
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	}()
+
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			return big.NewInt(1), api.BlockInfo{}, nil
+		},
+	}
+	tracingMiddleware := middleware.NewTracingMiddleware()
+	jg, err := groups.NewJSONRPCGroup(facade, []shared.MiddlewareProcessor{tracingMiddleware}, jsonRPCMaxBatchSize)
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(tracingMiddleware.MiddlewareHandlerFunc())
+	jg.RegisterRoutes(ws.Group("rpc"), getJSONRPCRoutesConfig())
+
+	req, _ := http.NewRequest(http.MethodPost, "/rpc/jsonrpc", bytes.NewBufferString(`[
+		{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice"]},
+		{"jsonrpc":"2.0","id":2,"method":"address_getBalance","params":["erd1bob"]}
+	]`))
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var requestSpan sdktrace.ReadOnlySpan
+	callSpans := make([]sdktrace.ReadOnlySpan, 0)
+	for _, span := range recorder.Ended() {
+		if span.Name() == "POST /rpc/jsonrpc" {
+			requestSpan = span
+			continue
+		}
+		callSpans = append(callSpans, span)
+	}
+	require.NotNil(t, requestSpan)
+	require.Equal(t, "00f067aa0ba902b7", requestSpan.Parent().SpanID().String())
+	require.Len(t, callSpans, 2)
+	for _, span := range callSpans {
+		require.Equal(t, "GET /address/:address/balance", span.Name())
+		require.Equal(t, requestSpan.SpanContext().SpanID(), span.Parent().SpanID())
+	}
+}
+
+func TestJSONRPCGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			return big.NewInt(1), api.BlockInfo{}, nil
+		},
+	}
+	jg, _ := groups.NewJSONRPCGroup(facade, nil, jsonRPCMaxBatchSize)
+
+	err := jg.UpdateFacade(nil)
+	require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = jg.UpdateFacade("wrong type")
+	require.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	newFacade := &mock.FacadeStub{
+		GetBalanceCalled: func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
+			return big.NewInt(2), api.BlockInfo{}, nil
+		},
+	}
+	err = jg.UpdateFacade(newFacade)
+	require.NoError(t, err)
+
+	ws := startWebServer(jg, "rpc", getJSONRPCRoutesConfig())
+	req, _ := http.NewRequest(http.MethodPost, "/rpc/jsonrpc",
+		bytes.NewBufferString(`{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1alice"]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &jsonrpc.Response{}
+	loadResponse(resp.Body, response)
+	require.JSONEq(t, `{"balance":"2","blockInfo":{}}`, string(response.Result))
+}
+
+func TestJSONRPCGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	jg, _ := groups.NewJSONRPCGroup(nil, nil, jsonRPCMaxBatchSize)
+	require.True(t, jg.IsInterfaceNil())
+
+	jg, _ = groups.NewJSONRPCGroup(&mock.FacadeStub{}, nil, jsonRPCMaxBatchSize)
+	require.False(t, jg.IsInterfaceNil())
+}
+
+func getJSONRPCRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"rpc": {
+				Routes: []config.RouteConfig{
+					{Name: "/jsonrpc", Open: true},
+					{Name: "/openrpc", Open: true},
+				},
+			},
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address/balance", Open: true},
+				},
+			},
+		},
+	}
+}
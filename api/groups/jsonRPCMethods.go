@@ -0,0 +1,210 @@
+package groups
+
+import (
+	"net/http"
+
+	"github.com/multiversx/mx-chain-go/api/jsonrpc"
+)
+
+const (
+	jsonRPCAddressGroup     = "address"
+	jsonRPCBlockGroup       = "block"
+	jsonRPCTransactionGroup = "transaction"
+	jsonRPCVMValuesGroup    = "vm-values"
+	jsonRPCNetworkGroup     = "network"
+)
+
+func addressParam() jsonrpc.ParamDescriptor {
+	return jsonrpc.ParamDescriptor{
+		Name:        "address",
+		Description: "the bech32 address of the account",
+		Type:        jsonrpc.TypeString,
+		Location:    jsonrpc.ParamInPath,
+		Required:    true,
+	}
+}
+
+func accountQueryParams() []jsonrpc.ParamDescriptor {
+	return []jsonrpc.ParamDescriptor{
+		{Name: urlParamOnFinalBlock, Description: "query the account on the final block", Type: jsonrpc.TypeBoolean, Location: jsonrpc.ParamInQuery},
+		{Name: urlParamOnStartOfEpoch, Description: "query the account on the start of the given epoch", Type: jsonrpc.TypeInteger, Location: jsonrpc.ParamInQuery},
+		{Name: urlParamBlockNonce, Description: "query the account on the block with the given nonce", Type: jsonrpc.TypeInteger, Location: jsonrpc.ParamInQuery},
+		{Name: urlParamBlockHash, Description: "query the account on the block with the given hex hash", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInQuery},
+		{Name: urlParamBlockRootHash, Description: "query the account on the given hex root hash", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInQuery},
+		{Name: urlParamHintEpoch, Description: "the epoch of the provided root hash", Type: jsonrpc.TypeInteger, Location: jsonrpc.ParamInQuery},
+	}
+}
+
+func accountMethodParams(params ...jsonrpc.ParamDescriptor) []jsonrpc.ParamDescriptor {
+	methodParams := append([]jsonrpc.ParamDescriptor{addressParam()}, params...)
+
+	return append(methodParams, accountQueryParams()...)
+}
+
+func blockQueryParams() []jsonrpc.ParamDescriptor {
+	return []jsonrpc.ParamDescriptor{
+		{Name: urlParamWithTxs, Description: "include the transactions of the block", Type: jsonrpc.TypeBoolean, Location: jsonrpc.ParamInQuery},
+		{Name: urlParamWithLogs, Description: "include the logs of the transactions", Type: jsonrpc.TypeBoolean, Location: jsonrpc.ParamInQuery},
+	}
+}
+
+func transactionBodyParam() jsonrpc.ParamDescriptor {
+	return jsonrpc.ParamDescriptor{
+		Name:        "transaction",
+		Description: "the transaction, as accepted by the REST API",
+		Type:        jsonrpc.TypeObject,
+		Location:    jsonrpc.ParamInBody,
+		Required:    true,
+	}
+}
+
+// jsonRPCMethods returns the JSON-RPC methods, each one mapped onto a REST endpoint of the node
+func jsonRPCMethods() []*jsonrpc.MethodDescriptor {
+	return []*jsonrpc.MethodDescriptor{
+		{
+			Name:       "address_getAccount",
+			Summary:    "returns the account of the given address",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCAddressGroup + getAccountPath,
+			Params: accountMethodParams(jsonrpc.ParamDescriptor{
+				Name: urlParamWithKeys, Description: "include the key-value pairs of the account", Type: jsonrpc.TypeBoolean, Location: jsonrpc.ParamInQuery,
+			}),
+			ResultName: "account",
+		},
+		{
+			Name:       "address_getBalance",
+			Summary:    "returns the EGLD balance of the given address",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCAddressGroup + getBalancePath,
+			Params:     accountMethodParams(),
+			ResultName: "balance",
+		},
+		{
+			Name:       "address_getESDTTokens",
+			Summary:    "returns all the ESDT tokens held by the given address",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCAddressGroup + getESDTTokensPath,
+			Params:     accountMethodParams(),
+			ResultName: "esdts",
+		},
+		{
+			Name:       "address_getESDTBalance",
+			Summary:    "returns the balance of the given ESDT token held by the given address",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCAddressGroup + getESDTBalancePath,
+			Params: accountMethodParams(jsonrpc.ParamDescriptor{
+				Name: "tokenIdentifier", Description: "the ESDT token identifier", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInPath, Required: true,
+			}),
+			ResultName: "tokenData",
+		},
+		{
+			Name:       "address_getESDTNFTData",
+			Summary:    "returns the data of the given NFT, SFT or META ESDT held by the given address",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCAddressGroup + getESDTNFTDataPath,
+			Params: accountMethodParams(
+				jsonrpc.ParamDescriptor{
+					Name: "tokenIdentifier", Description: "the collection identifier", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInPath, Required: true,
+				},
+				jsonrpc.ParamDescriptor{
+					Name: "nonce", Description: "the nonce of the token", Type: jsonrpc.TypeInteger, Location: jsonrpc.ParamInPath, Required: true,
+				},
+			),
+			ResultName: "tokenData",
+		},
+		{
+			Name:       "block_getByNonce",
+			Summary:    "returns the block with the given nonce",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCBlockGroup + getBlockByNoncePath,
+			Params: append([]jsonrpc.ParamDescriptor{
+				{Name: "nonce", Description: "the block nonce", Type: jsonrpc.TypeInteger, Location: jsonrpc.ParamInPath, Required: true},
+			}, blockQueryParams()...),
+			ResultName: "block",
+		},
+		{
+			Name:       "block_getByHash",
+			Summary:    "returns the block with the given hash",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCBlockGroup + getBlockByHashPath,
+			Params: append([]jsonrpc.ParamDescriptor{
+				{Name: "hash", Description: "the hex block hash", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInPath, Required: true},
+			}, blockQueryParams()...),
+			ResultName: "block",
+		},
+		{
+			Name:       "transaction_get",
+			Summary:    "returns the transaction with the given hash",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCTransactionGroup + getTransactionPath,
+			Params: []jsonrpc.ParamDescriptor{
+				{Name: "txhash", Description: "the hex transaction hash", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInPath, Required: true},
+				{Name: queryParamWithResults, Description: "include the smart contract results and the logs", Type: jsonrpc.TypeBoolean, Location: jsonrpc.ParamInQuery},
+			},
+			ResultName: "transaction",
+		},
+		{
+			Name:       "transaction_send",
+			Summary:    "sends the given transaction and returns its hash",
+			HTTPMethod: http.MethodPost,
+			Path:       "/" + jsonRPCTransactionGroup + sendTransactionPath,
+			Params:     []jsonrpc.ParamDescriptor{transactionBodyParam()},
+			ResultName: "txHash",
+		},
+		{
+			Name:       "transaction_simulate",
+			Summary:    "simulates the execution of the given transaction",
+			HTTPMethod: http.MethodPost,
+			Path:       "/" + jsonRPCTransactionGroup + simulateTransactionPath,
+			Params: []jsonrpc.ParamDescriptor{
+				transactionBodyParam(),
+				{Name: queryParamCheckSignature, Description: "verify the transaction signature", Type: jsonrpc.TypeBoolean, Location: jsonrpc.ParamInQuery},
+			},
+			ResultName: "simulationResults",
+		},
+		{
+			Name:       "transaction_cost",
+			Summary:    "estimates the gas limit needed by the given transaction",
+			HTTPMethod: http.MethodPost,
+			Path:       "/" + jsonRPCTransactionGroup + costPath,
+			Params:     []jsonrpc.ParamDescriptor{transactionBodyParam()},
+			ResultName: "cost",
+		},
+		{
+			Name:       "vm_query",
+			Summary:    "executes a smart contract view function",
+			HTTPMethod: http.MethodPost,
+			Path:       "/" + jsonRPCVMValuesGroup + queryPath,
+			Params: []jsonrpc.ParamDescriptor{
+				{Name: "query", Description: "the query, as accepted by the vm-values REST API", Type: jsonrpc.TypeObject, Location: jsonrpc.ParamInBody, Required: true},
+				{Name: urlParamBlockNonce, Description: "execute the query on the block with the given nonce", Type: jsonrpc.TypeInteger, Location: jsonrpc.ParamInQuery},
+				{Name: urlParamBlockHash, Description: "execute the query on the block with the given hex hash", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInQuery},
+			},
+			ResultName: "vmOutput",
+		},
+		{
+			Name:       "network_getConfig",
+			Summary:    "returns the network configuration",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCNetworkGroup + getConfigPath,
+			ResultName: "config",
+		},
+		{
+			Name:       "network_getStatus",
+			Summary:    "returns the network status of the node's shard",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCNetworkGroup + getStatusPath,
+			ResultName: "status",
+		},
+		{
+			Name:       "network_getESDTSupply",
+			Summary:    "returns the supply of the given ESDT token",
+			HTTPMethod: http.MethodGet,
+			Path:       "/" + jsonRPCNetworkGroup + getESDTSupplyPath,
+			Params: []jsonrpc.ParamDescriptor{
+				{Name: "token", Description: "the ESDT token identifier", Type: jsonrpc.TypeString, Location: jsonrpc.ParamInPath, Required: true},
+			},
+			ResultName: "supply",
+		},
+	}
+}
@@ -80,79 +80,103 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    getConfigPath,
-			Method:  http.MethodGet,
-			Handler: ng.getNetworkConfig,
+			Path:         getConfigPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getNetworkConfig,
+			Description:  "returns the network configuration metrics",
+			ResponseData: gin.H{"config": map[string]interface{}{}},
 		},
 		{
-			Path:    getStatusPath,
-			Method:  http.MethodGet,
-			Handler: ng.getNetworkStatus,
+			Path:         getStatusPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getNetworkStatus,
+			Description:  "returns the network status metrics of the node's shard",
+			ResponseData: gin.H{"status": map[string]interface{}{}},
 		},
 		{
-			Path:    economicsPath,
-			Method:  http.MethodGet,
-			Handler: ng.economicsMetrics,
+			Path:         economicsPath,
+			Method:       http.MethodGet,
+			Handler:      ng.economicsMetrics,
+			Description:  "returns the economics metrics, such as the total supply and the staked value",
+			ResponseData: gin.H{"metrics": map[string]interface{}{}},
 		},
 		{
-			Path:    enableEpochsPath,
-			Method:  http.MethodGet,
-			Handler: ng.getEnableEpochs,
+			Path:         enableEpochsPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getEnableEpochs,
+			Description:  "returns the activation epochs of the protocol features",
+			ResponseData: gin.H{"enableEpochs": map[string]interface{}{}},
 		},
 		{
-			Path:    getESDTsPath,
-			Method:  http.MethodGet,
-			Handler: ng.getHandlerFuncForEsdt(""),
+			Path:         getESDTsPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getHandlerFuncForEsdt(""),
+			Description:  "returns the identifiers of all the issued ESDT tokens",
+			ResponseData: gin.H{"tokens": []string{}},
 		},
 		{
-			Path:    getFFTsPath,
-			Method:  http.MethodGet,
-			Handler: ng.getHandlerFuncForEsdt(core.FungibleESDT),
+			Path:         getFFTsPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getHandlerFuncForEsdt(core.FungibleESDT),
+			Description:  "returns the identifiers of the issued fungible ESDT tokens",
+			ResponseData: gin.H{"tokens": []string{}},
 		},
 		{
-			Path:    getSFTsPath,
-			Method:  http.MethodGet,
-			Handler: ng.getHandlerFuncForEsdt(core.SemiFungibleESDT),
+			Path:         getSFTsPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getHandlerFuncForEsdt(core.SemiFungibleESDT),
+			Description:  "returns the identifiers of the issued semi-fungible ESDT tokens",
+			ResponseData: gin.H{"tokens": []string{}},
 		},
 		{
-			Path:    getNFTsPath,
-			Method:  http.MethodGet,
-			Handler: ng.getHandlerFuncForEsdt(core.NonFungibleESDT),
+			Path:         getNFTsPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getHandlerFuncForEsdt(core.NonFungibleESDT),
+			Description:  "returns the identifiers of the issued non-fungible ESDT tokens",
+			ResponseData: gin.H{"tokens": []string{}},
 		},
 		{
-			Path:    directStakedInfoPath,
-			Method:  http.MethodGet,
-			Handler: ng.directStakedInfo,
+			Path:        directStakedInfoPath,
+			Method:      http.MethodGet,
+			Handler:     ng.directStakedInfo,
+			Description: "returns the directly staked values of all the stakers",
 		},
 		{
-			Path:    delegatedInfoPath,
-			Method:  http.MethodGet,
-			Handler: ng.delegatedInfo,
+			Path:        delegatedInfoPath,
+			Method:      http.MethodGet,
+			Handler:     ng.delegatedInfo,
+			Description: "returns the values delegated by all the delegators",
 		},
 		{
-			Path:    getESDTSupplyPath,
-			Method:  http.MethodGet,
-			Handler: ng.getESDTTokenSupply,
+			Path:         getESDTSupplyPath,
+			Method:       http.MethodGet,
+			Handler:      ng.getESDTTokenSupply,
+			Description:  "returns the supply, the minted and the burned values of the given ESDT token",
+			ResponseData: api.ESDTSupply{},
 		},
 		{
-			Path:    ratingsPath,
-			Method:  http.MethodGet,
-			Handler: ng.getRatingsConfig,
+			Path:        ratingsPath,
+			Method:      http.MethodGet,
+			Handler:     ng.getRatingsConfig,
+			Description: "returns the ratings configuration",
 		},
 		{
-			Path:    genesisNodesConfigPath,
-			Method:  http.MethodGet,
-			Handler: ng.getGenesisNodesConfig,
+			Path:        genesisNodesConfigPath,
+			Method:      http.MethodGet,
+			Handler:     ng.getGenesisNodesConfig,
+			Description: "returns the eligible and the waiting nodes of the genesis",
 		},
 		{
-			Path:    genesisBalances,
-			Method:  http.MethodGet,
-			Handler: ng.getGenesisBalances,
+			Path:        genesisBalances,
+			Method:      http.MethodGet,
+			Handler:     ng.getGenesisBalances,
+			Description: "returns the balances of the genesis accounts",
 		},
 		{
-			Path:    gasConfigPath,
-			Method:  http.MethodGet,
-			Handler: ng.getGasConfig,
+			Path:        gasConfigPath,
+			Method:      http.MethodGet,
+			Handler:     ng.getGasConfig,
+			Description: "returns the built-in functions and the system smart contracts gas costs",
 		},
 	}
 	ng.endpoints = endpoints
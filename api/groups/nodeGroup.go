@@ -2,6 +2,7 @@ package groups
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"sync"
 
@@ -20,6 +21,10 @@ const (
 	pidQueryParam             = "pid"
 	debugPath                 = "/debug"
 	heartbeatStatusPath       = "/heartbeatstatus"
+	heartbeatHistoryPath      = "/heartbeat-history/:key"
+	uptimePath                = "/uptime/:epoch"
+	p2pMessageTracesPath      = "/p2p-traces"
+	p2pMessageTracesStream    = "/p2p-traces/stream"
 	metricsPath               = "/metrics"
 	p2pStatusPath             = "/p2pstatus"
 	peerInfoPath              = "/peerinfo"
@@ -27,6 +32,7 @@ const (
 	epochStartDataForEpoch    = "/epoch-start/:epoch"
 	bootstrapStatusPath       = "/bootstrapstatus"
 	connectedPeersRatingsPath = "/connected-peers-ratings"
+	peersReputationPath       = "/peers-reputation"
 	managedKeys               = "/managed-keys"
 	loadedKeys                = "/loaded-keys"
 	managedKeysCount          = "/managed-keys/count"
@@ -38,11 +44,17 @@ const (
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
 type nodeFacadeHandler interface {
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
+	GetHeartbeatHistory(pubKey string) (*data.PubKeyHeartbeatHistory, error)
+	GetUptime(epoch uint32) ([]data.PubKeyUptime, error)
+	GetP2PMessageTraces() ([]*common.P2PMessageTrace, error)
+	SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error)
 	StatusMetrics() external.StatusMetricsHandler
+	MetricsRegistry() common.MetricsRegistry
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetPeersReputation() ([]*common.PeerReputationAPIResponse, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
 	GetLoadedKeys() []string
@@ -77,79 +89,134 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    heartbeatStatusPath,
-			Method:  http.MethodGet,
-			Handler: ng.heartbeatStatus,
+			Path:        heartbeatStatusPath,
+			Method:      http.MethodGet,
+			Handler:     ng.heartbeatStatus,
+			Description: "returns the heartbeat messages of the nodes in the network",
 		},
 		{
-			Path:    statusPath,
-			Method:  http.MethodGet,
-			Handler: ng.statusMetrics,
+			Path:        heartbeatHistoryPath,
+			Method:      http.MethodGet,
+			Handler:     ng.heartbeatHistory,
+			Description: "returns the heartbeat history of the given public key: online intervals, version, shard and identity changes and uptime per epoch",
 		},
 		{
-			Path:    p2pStatusPath,
-			Method:  http.MethodGet,
-			Handler: ng.p2pStatusMetrics,
+			Path:        uptimePath,
+			Method:      http.MethodGet,
+			Handler:     ng.uptime,
+			Description: "returns the uptime percentages of the monitored public keys in the given epoch",
 		},
 		{
-			Path:    metricsPath,
-			Method:  http.MethodGet,
-			Handler: ng.prometheusMetrics,
+			Path:        p2pMessageTracesPath,
+			Method:      http.MethodGet,
+			Handler:     ng.p2pMessageTraces,
+			Description: "returns the latest sampled p2p messages traces",
 		},
 		{
-			Path:    debugPath,
-			Method:  http.MethodPost,
-			Handler: ng.queryDebug,
+			Path:        p2pMessageTracesStream,
+			Method:      http.MethodGet,
+			Handler:     ng.p2pMessageTracesStream,
+			Description: "streams, as server-sent events, the sampled p2p messages traces as they are recorded",
 		},
 		{
-			Path:    peerInfoPath,
-			Method:  http.MethodGet,
-			Handler: ng.peerInfo,
+			Path:         statusPath,
+			Method:       http.MethodGet,
+			Handler:      ng.statusMetrics,
+			Description:  "returns all the metrics stored inside the node",
+			ResponseData: gin.H{"metrics": map[string]interface{}{}},
 		},
 		{
-			Path:    epochStartDataForEpoch,
-			Method:  http.MethodGet,
-			Handler: ng.epochStartDataForEpoch,
+			Path:         p2pStatusPath,
+			Method:       http.MethodGet,
+			Handler:      ng.p2pStatusMetrics,
+			Description:  "returns the p2p metrics of the node",
+			ResponseData: gin.H{"metrics": map[string]interface{}{}},
 		},
 		{
-			Path:    bootstrapStatusPath,
-			Method:  http.MethodGet,
-			Handler: ng.bootstrapMetrics,
+			Path:        metricsPath,
+			Method:      http.MethodGet,
+			Handler:     ng.prometheusMetrics,
+			Description: "returns all the metrics stored inside the node, in the Prometheus format",
 		},
 		{
-			Path:    connectedPeersRatingsPath,
-			Method:  http.MethodGet,
-			Handler: ng.connectedPeersRatings,
+			Path:        debugPath,
+			Method:      http.MethodPost,
+			Handler:     ng.queryDebug,
+			Description: "returns the debug information after the query has been interpreted",
 		},
 		{
-			Path:    managedKeysCount,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeysCount,
+			Path:        peerInfoPath,
+			Method:      http.MethodGet,
+			Handler:     ng.peerInfo,
+			Description: "returns the information about the given pid, public key or peer address",
 		},
 		{
-			Path:    managedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeys,
+			Path:        epochStartDataForEpoch,
+			Method:      http.MethodGet,
+			Handler:     ng.epochStartDataForEpoch,
+			Description: "returns the epoch start data of the given epoch",
 		},
 		{
-			Path:    loadedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.loadedKeys,
+			Path:         bootstrapStatusPath,
+			Method:       http.MethodGet,
+			Handler:      ng.bootstrapMetrics,
+			Description:  "returns the bootstrap metrics of the node",
+			ResponseData: gin.H{"metrics": map[string]interface{}{}},
 		},
 		{
-			Path:    eligibleManagedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeysEligible,
+			Path:         connectedPeersRatingsPath,
+			Method:       http.MethodGet,
+			Handler:      ng.connectedPeersRatings,
+			Description:  "returns the ratings of the connected peers",
+			ResponseData: gin.H{"ratings": map[string]string{}},
 		},
 		{
-			Path:    waitingManagedKeys,
-			Method:  http.MethodGet,
-			Handler: ng.managedKeysWaiting,
+			Path:        peersReputationPath,
+			Method:      http.MethodGet,
+			Handler:     ng.peersReputation,
+			Description: "returns the reputation of the connected peers",
 		},
 		{
-			Path:    epochsLeftInWaiting,
-			Method:  http.MethodGet,
-			Handler: ng.waitingEpochsLeft,
+			Path:         managedKeysCount,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeysCount,
+			Description:  "returns the number of keys managed by the node",
+			ResponseData: gin.H{"count": 0},
+		},
+		{
+			Path:         managedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeys,
+			Description:  "returns the keys managed by the node",
+			ResponseData: gin.H{"managedKeys": []string{}},
+		},
+		{
+			Path:         loadedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.loadedKeys,
+			Description:  "returns the keys loaded by the node",
+			ResponseData: gin.H{"loadedKeys": []string{}},
+		},
+		{
+			Path:         eligibleManagedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeysEligible,
+			Description:  "returns the eligible keys managed by the node",
+			ResponseData: gin.H{"eligibleKeys": []string{}},
+		},
+		{
+			Path:         waitingManagedKeys,
+			Method:       http.MethodGet,
+			Handler:      ng.managedKeysWaiting,
+			Description:  "returns the waiting keys managed by the node",
+			ResponseData: gin.H{"waitingKeys": []string{}},
+		},
+		{
+			Path:         epochsLeftInWaiting,
+			Method:       http.MethodGet,
+			Handler:      ng.waitingEpochsLeft,
+			Description:  "returns the number of epochs the given public key will remain in the waiting list",
+			ResponseData: gin.H{"epochsLeft": 0},
 		},
 	}
 	ng.endpoints = endpoints
@@ -182,6 +249,71 @@ func (ng *nodeGroup) heartbeatStatus(c *gin.Context) {
 	)
 }
 
+// heartbeatHistory returns the heartbeat history of the provided public key
+func (ng *nodeGroup) heartbeatHistory(c *gin.Context) {
+	publicKey := c.Param("key")
+	history, err := ng.getFacade().GetHeartbeatHistory(publicKey)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetHeartbeatHistory, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"history": history})
+}
+
+// uptime returns the uptime of the monitored public keys in the provided epoch
+func (ng *nodeGroup) uptime(c *gin.Context) {
+	epoch, err := getQueryParamEpoch(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	uptime, err := ng.getFacade().GetUptime(epoch)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetUptime, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"uptime": uptime})
+}
+
+// p2pMessageTraces returns the latest sampled p2p messages traces
+func (ng *nodeGroup) p2pMessageTraces(c *gin.Context) {
+	traces, err := ng.getFacade().GetP2PMessageTraces()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetP2PMessageTraces, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"traces": traces})
+}
+
+// p2pMessageTracesStream streams, as server-sent events, the sampled p2p messages traces as they are recorded
+func (ng *nodeGroup) p2pMessageTracesStream(c *gin.Context) {
+	chTraces, unsubscribe, err := ng.getFacade().SubscribeP2PMessageTraces()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetP2PMessageTraces, err)
+		return
+	}
+	defer unsubscribe()
+
+	clientGone := c.Request.Context().Done()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-clientGone:
+			return false
+		case trace, ok := <-chTraces:
+			if !ok {
+				return false
+			}
+
+			c.SSEvent("trace", trace)
+			return true
+		}
+	})
+}
+
 // statusMetrics returns the node statistics exported by an StatusMetricsHandler without p2p statistics
 func (ng *nodeGroup) statusMetrics(c *gin.Context) {
 	nodeFacade := ng.getFacade()
@@ -336,9 +468,22 @@ func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 		return
 	}
 
+	registeredMetrics, err := ng.getFacade().MetricsRegistry().PrometheusString()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
 	c.String(
 		http.StatusOK,
-		metrics,
+		metrics+registeredMetrics,
 	)
 }
 
@@ -392,6 +537,31 @@ func (ng *nodeGroup) connectedPeersRatings(c *gin.Context) {
 	)
 }
 
+// peersReputation returns the reputation entries tracked by the node
+func (ng *nodeGroup) peersReputation(c *gin.Context) {
+	peers, err := ng.getFacade().GetPeersReputation()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"peers": peers},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // managedKeysCount returns the node's number of managed keys
 func (ng *nodeGroup) managedKeysCount(c *gin.Context) {
 	count := ng.getFacade().GetManagedKeysCount()
@@ -14,6 +14,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
@@ -348,6 +349,409 @@ func TestNodeGroup_GetConnectedPeersRatings(t *testing.T) {
 	})
 }
 
+func TestNodeGroup_GetPeersReputation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetPeersReputationCalled: func() ([]*common.PeerReputationAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/peers-reputation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedPeers := []*common.PeerReputationAPIResponse{
+			{
+				PeerID:      "pid1",
+				Score:       -20,
+				NumEvents:   2,
+				BannedUntil: 1700000000,
+				Reasons: []*common.PeerReputationReason{
+					{
+						Source:     common.PeerReputationSourceAntiflood,
+						Reason:     "flooding",
+						ScoreDelta: -10,
+						Timestamp:  1690000000,
+					},
+				},
+			},
+			{
+				PublicKey: "abba",
+				IsAllowed: true,
+			},
+		}
+		facade := mock.FacadeStub{
+			GetPeersReputationCalled: func() ([]*common.PeerReputationAPIResponse, error) {
+				return providedPeers, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/peers-reputation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type peersReputationResponse struct {
+			Data struct {
+				Peers []*common.PeerReputationAPIResponse `json:"peers"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &peersReputationResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedPeers, response.Data.Peers)
+	})
+}
+
+func TestNodeGroup_GetHeartbeatHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetHeartbeatHistoryCalled: func(pubKey string) (*data.PubKeyHeartbeatHistory, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/heartbeat-history/abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedHistory := &data.PubKeyHeartbeatHistory{
+			PublicKey:       "abcd",
+			FirstSeen:       1000,
+			LastSeen:        2000,
+			VersionNumber:   "v1.0.0",
+			ComputedShardID: 1,
+			Identity:        "identity",
+			OnlineIntervals: []*data.OnlineInterval{
+				{
+					Start: 1000,
+					End:   2000,
+				},
+			},
+			Events: []*data.PubKeyHistoryEvent{
+				{
+					Timestamp: 1500,
+					Type:      "version",
+					OldValue:  "v0.9.0",
+					NewValue:  "v1.0.0",
+				},
+			},
+			Uptime: []*data.EpochUptime{
+				{
+					Epoch:            2,
+					OnlineSeconds:    1000,
+					MonitoredSeconds: 1000,
+					UptimePercentage: 100,
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetHeartbeatHistoryCalled: func(pubKey string) (*data.PubKeyHeartbeatHistory, error) {
+				assert.Equal(t, "abcd", pubKey)
+				return providedHistory, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/heartbeat-history/abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type heartbeatHistoryResponse struct {
+			Data struct {
+				History *data.PubKeyHeartbeatHistory `json:"history"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &heartbeatHistoryResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedHistory, response.Data.History)
+	})
+}
+
+func TestNodeGroup_GetUptime(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetUptimeCalled: func(epoch uint32) ([]data.PubKeyUptime, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/uptime/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetUptimeCalled: func(epoch uint32) ([]data.PubKeyUptime, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/uptime/2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedUptime := []data.PubKeyUptime{
+			{
+				PublicKey: "abcd",
+				EpochUptime: data.EpochUptime{
+					Epoch:            2,
+					OnlineSeconds:    50,
+					MonitoredSeconds: 100,
+					UptimePercentage: 50,
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetUptimeCalled: func(epoch uint32) ([]data.PubKeyUptime, error) {
+				assert.Equal(t, uint32(2), epoch)
+				return providedUptime, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/uptime/2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type uptimeResponse struct {
+			Data struct {
+				Uptime []data.PubKeyUptime `json:"uptime"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &uptimeResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedUptime, response.Data.Uptime)
+	})
+}
+
+func TestNodeGroup_GetP2PMessageTraces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetP2PMessageTracesCalled: func() ([]*common.P2PMessageTrace, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/p2p-traces", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetP2PMessageTraces.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedTraces := []*common.P2PMessageTrace{
+			{
+				Topic:              "transactions_0_1",
+				Originator:         "originator",
+				Size:               100,
+				ProcessingDuration: 5,
+				Result:             "accepted",
+			},
+		}
+		facade := mock.FacadeStub{
+			GetP2PMessageTracesCalled: func() ([]*common.P2PMessageTrace, error) {
+				return providedTraces, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/p2p-traces", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type tracesResponse struct {
+			Data struct {
+				Traces []*common.P2PMessageTrace `json:"traces"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := &tracesResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedTraces, response.Data.Traces)
+	})
+}
+
+func TestNodeGroup_StreamP2PMessageTraces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			SubscribeP2PMessageTracesCalled: func() (<-chan *common.P2PMessageTrace, func(), error) {
+				return nil, nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/p2p-traces/stream", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should stream the traces until the channel is closed", func(t *testing.T) {
+		t.Parallel()
+
+		chTraces := make(chan *common.P2PMessageTrace, 2)
+		chTraces <- &common.P2PMessageTrace{Topic: "topic0"}
+		chTraces <- &common.P2PMessageTrace{Topic: "topic1"}
+		close(chTraces)
+
+		unsubscribeCalled := atomic.Flag{}
+		facade := mock.FacadeStub{
+			SubscribeP2PMessageTracesCalled: func() (<-chan *common.P2PMessageTrace, func(), error) {
+				return chTraces, func() { unsubscribeCalled.SetValue(true) }, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		server := httptest.NewServer(startWebServer(nodeGroup, "node", getNodeRoutesConfig()))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/node/p2p-traces/stream")
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 2, strings.Count(string(body), "event:trace"))
+		assert.True(t, strings.Contains(string(body), `"topic":"topic0"`))
+		assert.True(t, strings.Contains(string(body), `"topic":"topic1"`))
+		assert.True(t, unsubscribeCalled.IsSet())
+	})
+}
+
 func TestStatusMetrics_ShouldDisplayNonP2pMetrics(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-details-key"
@@ -692,10 +1096,18 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	val := uint64(37)
 	statusMetricsProvider.SetUInt64Value(key, val)
 
+	registeredMetric := "erd_block_processing_duration_seconds_count{result=\"success\",shard=\"0\"} 1\n"
 	facade := mock.FacadeStub{}
 	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
 		return statusMetricsProvider
 	}
+	facade.MetricsRegistryHandler = func() common.MetricsRegistry {
+		return &testscommon.MetricsRegistryStub{
+			PrometheusStringCalled: func() (string, error) {
+				return registeredMetric, nil
+			},
+		}
+	}
 
 	nodeGroup, err := groups.NewNodeGroup(&facade)
 	require.NoError(t, err)
@@ -712,6 +1124,38 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 
 	keyAndValueFoundInResponse := strings.Contains(respStr, key) && strings.Contains(respStr, fmt.Sprintf("%d", val))
 	assert.True(t, keyAndValueFoundInResponse)
+	assert.True(t, strings.HasSuffix(respStr, registeredMetric))
+}
+
+func TestPrometheusMetrics_ShouldReturnErrorIfMetricsRegistryReturnsError(t *testing.T) {
+	facade := mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{}
+		},
+		MetricsRegistryHandler: func() common.MetricsRegistry {
+			return &testscommon.MetricsRegistryStub{
+				PrometheusStringCalled: func() (string, error) {
+					return "", expectedErr
+				},
+			}
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, expectedErr.Error(), response.Error)
 }
 
 func TestNodeGroup_ManagedKeysCount(t *testing.T) {
@@ -1007,6 +1451,9 @@ func TestNodeGroup_UpdateFacade(t *testing.T) {
 		facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
 			return statusMetricsProvider
 		}
+		facade.MetricsRegistryHandler = func() common.MetricsRegistry {
+			return &testscommon.MetricsRegistryStub{}
+		}
 
 		nodeGroup, err := groups.NewNodeGroup(&facade)
 		require.NoError(t, err)
@@ -1081,6 +1528,11 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/epoch-start/:epoch", Open: true},
 					{Name: "/bootstrapstatus", Open: true},
 					{Name: "/connected-peers-ratings", Open: true},
+					{Name: "/peers-reputation", Open: true},
+					{Name: "/heartbeat-history/:key", Open: true},
+					{Name: "/uptime/:epoch", Open: true},
+					{Name: "/p2p-traces", Open: true},
+					{Name: "/p2p-traces/stream", Open: true},
 					{Name: "/managed-keys/count", Open: true},
 					{Name: "/managed-keys", Open: true},
 					{Name: "/loaded-keys", Open: true},
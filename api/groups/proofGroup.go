@@ -55,9 +55,11 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    getProofPath,
-			Method:  http.MethodGet,
-			Handler: pg.getProof,
+			Path:         getProofPath,
+			Method:       http.MethodGet,
+			Handler:      pg.getProof,
+			Description:  "returns the Merkle proof of the given address for the given root hash",
+			ResponseData: gin.H{"proof": []string{}, "value": ""},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofEndpoint, facade),
@@ -66,9 +68,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			},
 		},
 		{
-			Path:    getProofDataTriePath,
-			Method:  http.MethodGet,
-			Handler: pg.getProofDataTrie,
+			Path:        getProofDataTriePath,
+			Method:      http.MethodGet,
+			Handler:     pg.getProofDataTrie,
+			Description: "returns the Merkle proofs of the given address and of the given key from its data trie, for the given root hash",
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieEndpoint, facade),
@@ -77,9 +80,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			},
 		},
 		{
-			Path:    getProofCurrentRootHashPath,
-			Method:  http.MethodGet,
-			Handler: pg.getProofCurrentRootHash,
+			Path:        getProofCurrentRootHashPath,
+			Method:      http.MethodGet,
+			Handler:     pg.getProofCurrentRootHash,
+			Description: "returns the Merkle proof of the given address for the current root hash",
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofCurrentRootHashEndpoint, facade),
@@ -88,9 +92,11 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			},
 		},
 		{
-			Path:    verifyProofPath,
-			Method:  http.MethodPost,
-			Handler: pg.verifyProof,
+			Path:         verifyProofPath,
+			Method:       http.MethodPost,
+			Handler:      pg.verifyProof,
+			Description:  "verifies the given Merkle proof for the given root hash and address",
+			ResponseData: gin.H{"ok": false},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyProofEndpoint, facade),
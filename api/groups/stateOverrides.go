@@ -0,0 +1,105 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// AccountStateOverrideRequest represents the values, provided through the API, which will temporarily replace the
+// ones from the state of an account. Storage keys and values, as well as the code, are hex encoded
+type AccountStateOverrideRequest struct {
+	Balance      string            `json:"balance,omitempty"`
+	ESDTBalances map[string]string `json:"esdtBalances,omitempty"`
+	Storage      map[string]string `json:"storage,omitempty"`
+	Code         string            `json:"code,omitempty"`
+}
+
+func createStateOverrides(
+	requests map[string]*AccountStateOverrideRequest,
+	decodeAddress func(address string) ([]byte, error),
+) (common.StateOverrides, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	overrides := make(common.StateOverrides, len(requests))
+	for address, request := range requests {
+		if request == nil {
+			continue
+		}
+
+		addressBytes, err := decodeAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid address: %s", address, err.Error())
+		}
+
+		override, err := createAccountStateOverride(request)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state override for address %s: %w", address, err)
+		}
+
+		overrides[string(addressBytes)] = override
+	}
+
+	return overrides, nil
+}
+
+func createAccountStateOverride(request *AccountStateOverrideRequest) (*common.AccountStateOverride, error) {
+	override := &common.AccountStateOverride{}
+
+	var err error
+	if len(request.Balance) > 0 {
+		override.Balance, err = parseNonNegativeBigInt(request.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("%w for balance", err)
+		}
+	}
+
+	if len(request.ESDTBalances) > 0 {
+		override.ESDTBalances = make(map[string]*big.Int, len(request.ESDTBalances))
+	}
+	for tokenID, value := range request.ESDTBalances {
+		override.ESDTBalances[tokenID], err = parseNonNegativeBigInt(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w for token %s", err, tokenID)
+		}
+	}
+
+	if len(request.Storage) > 0 {
+		override.Storage = make(map[string][]byte, len(request.Storage))
+	}
+	for key, value := range request.Storage {
+		keyBytes, errDecode := hex.DecodeString(key)
+		if errDecode != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex storage key: %w", key, errDecode)
+		}
+
+		valueBytes, errDecode := hex.DecodeString(value)
+		if errDecode != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex storage value: %w", value, errDecode)
+		}
+
+		override.Storage[string(keyBytes)] = valueBytes
+	}
+
+	if len(request.Code) > 0 {
+		override.Code, err = hex.DecodeString(request.Code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex code: %w", err)
+		}
+	}
+
+	return override, nil
+}
+
+func parseNonNegativeBigInt(value string) (*big.Int, error) {
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || result.Sign() < 0 {
+		return nil, fmt.Errorf("'%s' is not a valid non-negative number", value)
+	}
+
+	return result, nil
+}
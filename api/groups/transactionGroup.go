@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"net/http"
@@ -24,15 +25,21 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateTxsSequenceEndpoint      = "/transaction/simulate-sequence"
+	traceTransactionEndpoint         = "/transaction/trace"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	getScrsByTxHashEndpoint          = "/transaction/scrs-by-tx-hash/:txhash"
+	replayTransactionEndpoint        = "/transaction/:txhash/replay"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateTxsSequencePath          = "/simulate-sequence"
+	traceTransactionPath             = "/trace"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
 	getScrsByTxHashPath              = "/scrs-by-tx-hash/:txhash"
+	replayTransactionPath            = "/:txhash/replay"
 	getTransactionsPool              = "/pool"
 
 	queryParamWithResults    = "withResults"
@@ -47,18 +54,22 @@ const (
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
 type transactionFacadeHandler interface {
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
-	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransaction(ctx context.Context, tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
-	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SendBulkTransactions(ctx context.Context, txs []*transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	ReplayTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -82,9 +93,12 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    sendTransactionPath,
-			Method:  http.MethodPost,
-			Handler: tg.sendTransaction,
+			Path:         sendTransactionPath,
+			Method:       http.MethodPost,
+			Handler:      tg.sendTransaction,
+			Description:  "validates and propagates the given transaction, returning its hash",
+			RequestData:  transaction.FrontendTransaction{},
+			ResponseData: gin.H{"txHash": ""},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendTransactionEndpoint, facade),
@@ -93,9 +107,12 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			},
 		},
 		{
-			Path:    simulateTransactionPath,
-			Method:  http.MethodPost,
-			Handler: tg.simulateTransaction,
+			Path:         simulateTransactionPath,
+			Method:       http.MethodPost,
+			Handler:      tg.simulateTransaction,
+			Description:  "simulates the execution of the given transaction, without propagating it",
+			RequestData:  TransactionSimulationRequest{},
+			ResponseData: gin.H{"result": txSimData.SimulationResultsWithVMOutput{}},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTransactionEndpoint, facade),
@@ -104,14 +121,46 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			},
 		},
 		{
-			Path:    costPath,
-			Method:  http.MethodPost,
-			Handler: tg.computeTransactionGasLimit,
+			Path:         simulateTxsSequencePath,
+			Method:       http.MethodPost,
+			Handler:      tg.simulateTransactionsSequence,
+			Description:  "simulates the execution of the given transactions sequence, each one on the state altered by the previous ones",
+			RequestData:  []*transaction.FrontendTransaction{},
+			ResponseData: gin.H{"results": []*txSimData.SimulationResultsWithVMOutput{}},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTxsSequenceEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:         traceTransactionPath,
+			Method:       http.MethodPost,
+			Handler:      tg.traceTransaction,
+			Description:  "simulates the execution of the given transaction, returning the call tree of the triggered smart contract executions",
+			RequestData:  TransactionSimulationRequest{},
+			ResponseData: gin.H{"result": txSimData.SimulationResultsWithVMOutput{}},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:         costPath,
+			Method:       http.MethodPost,
+			Handler:      tg.computeTransactionGasLimit,
+			Description:  "estimates the gas limit needed by the given transaction",
+			RequestData:  TransactionSimulationRequest{},
+			ResponseData: transaction.CostResponse{},
 		},
 		{
-			Path:    getTransactionsPool,
-			Method:  http.MethodGet,
-			Handler: tg.getTransactionsPool,
+			Path:        getTransactionsPool,
+			Method:      http.MethodGet,
+			Handler:     tg.getTransactionsPool,
+			Description: "returns the transactions from the pool, optionally filtered by sender",
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionPath, facade),
@@ -120,9 +169,12 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			},
 		},
 		{
-			Path:    sendMultiplePath,
-			Method:  http.MethodPost,
-			Handler: tg.sendMultipleTransactions,
+			Path:         sendMultiplePath,
+			Method:       http.MethodPost,
+			Handler:      tg.sendMultipleTransactions,
+			Description:  "validates and propagates the given transactions, returning their hashes",
+			RequestData:  []*transaction.FrontendTransaction{},
+			ResponseData: gin.H{"txsSent": 0, "txsHashes": map[int]string{}},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendMultipleTransactionsEndpoint, facade),
@@ -131,9 +183,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			},
 		},
 		{
-			Path:    getTransactionPath,
-			Method:  http.MethodGet,
-			Handler: tg.getTransaction,
+			Path:         getTransactionPath,
+			Method:       http.MethodGet,
+			Handler:      tg.getTransaction,
+			Description:  "returns the transaction with the given hex hash",
+			ResponseData: gin.H{"transaction": transaction.ApiTransactionResult{}},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionEndpoint, facade),
@@ -142,9 +196,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			},
 		},
 		{
-			Path:    getScrsByTxHashPath,
-			Method:  http.MethodGet,
-			Handler: tg.getScrsByTxHash,
+			Path:         getScrsByTxHashPath,
+			Method:       http.MethodGet,
+			Handler:      tg.getScrsByTxHash,
+			Description:  "returns the smart contract results generated by the transaction with the given hex hash",
+			ResponseData: gin.H{"scrs": []*transaction.ApiSmartContractResult{}},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getScrsByTxHashEndpoint, facade),
@@ -152,6 +208,19 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:         replayTransactionPath,
+			Method:       http.MethodGet,
+			Handler:      tg.replayTransaction,
+			Description:  "replays the execution of the transaction with the given hex hash on the state it was executed against",
+			ResponseData: gin.H{"result": txSimData.SimulationResultsWithVMOutput{}},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(replayTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -168,10 +237,34 @@ type TxResponse struct {
 	Timestamp   uint64 `json:"timestamp"`
 }
 
+// TransactionSimulationRequest represents the structure of a simulation or cost request, holding the transaction
+// together with the optional state overrides the transaction should be executed against
+type TransactionSimulationRequest struct {
+	transaction.FrontendTransaction
+	StateOverrides map[string]*AccountStateOverrideRequest `json:"stateOverrides,omitempty"`
+}
+
+// TransactionsSequenceSimulationRequest represents the structure of a transactions sequence simulation request, holding
+// the ordered transactions together with the optional state overrides applied before the first one of them
+type TransactionsSequenceSimulationRequest struct {
+	Transactions   []transaction.FrontendTransaction       `json:"transactions"`
+	StateOverrides map[string]*AccountStateOverrideRequest `json:"stateOverrides,omitempty"`
+}
+
 // simulateTransaction will receive a transaction from the client and will simulate its execution and return the results
 func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
-	var ftx = transaction.FrontendTransaction{}
-	err := c.ShouldBindJSON(&ftx)
+	tg.doSimulateTransaction(c, false)
+}
+
+// traceTransaction will receive a transaction from the client and will simulate its execution, returning the results
+// together with the call tree of the triggered smart contract executions
+func (tg *transactionGroup) traceTransaction(c *gin.Context) {
+	tg.doSimulateTransaction(c, true)
+}
+
+func (tg *transactionGroup) doSimulateTransaction(c *gin.Context, withTrace bool) {
+	var request = TransactionSimulationRequest{}
+	err := c.ShouldBindJSON(&request)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -197,7 +290,20 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, txHash, err := tg.createTransaction(&ftx)
+	stateOverrides, err := createStateOverrides(request.StateOverrides, tg.getFacade().DecodeAddressPubkey)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tx, txHash, err := tg.createTransaction(&request.FrontendTransaction)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -226,8 +332,14 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	}
 
 	start = time.Now()
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
+	var executionResults *txSimData.SimulationResultsWithVMOutput
+	if withTrace {
+		executionResults, err = tg.getFacade().TraceTransactionExecution(tx, stateOverrides)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: TraceTransactionExecution")
+	} else {
+		executionResults, err = tg.getFacade().SimulateTransactionExecution(c.Request.Context(), tx, stateOverrides)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -251,6 +363,116 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateTransactionsSequence will receive an ordered list of transactions from the client and will simulate their
+// execution one after another, each transaction seeing the effects of the previous ones
+func (tg *transactionGroup) simulateTransactionsSequence(c *gin.Context) {
+	var request = TransactionsSequenceSimulationRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	ftxs := request.Transactions
+	if len(ftxs) == 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTransactionsSequence.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	stateOverrides, err := createStateOverrides(request.StateOverrides, tg.getFacade().DecodeAddressPubkey)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(ftxs))
+	txsHashes := make([]string, 0, len(ftxs))
+	for idx := range ftxs {
+		tx, txHash, errCreate := tg.createTransaction(&ftxs[idx])
+		if errCreate == nil {
+			errCreate = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s for transaction at index %d", errors.ErrTxGenerationFailed.Error(), errCreate.Error(), idx),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	start := time.Now()
+	executionResults, err := tg.getFacade().SimulateTransactionsSequence(c.Request.Context(), txs, stateOverrides)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionsSequence")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, result := range executionResults {
+		if idx < len(txsHashes) {
+			result.Hash = txsHashes[idx]
+		}
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"results": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var ftx = transaction.FrontendTransaction{}
@@ -281,7 +503,7 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	}
 
 	start := time.Now()
-	err = tg.getFacade().ValidateTransaction(tx)
+	err = tg.getFacade().ValidateTransaction(c.Request.Context(), tx)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransaction")
 	if err != nil {
 		c.JSON(
@@ -296,7 +518,7 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	}
 
 	start = time.Now()
-	_, err = tg.getFacade().SendBulkTransactions([]*transaction.Transaction{tx})
+	_, err = tg.getFacade().SendBulkTransactions(c.Request.Context(), []*transaction.Transaction{tx})
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SendBulkTransactions")
 	if err != nil {
 		c.JSON(
@@ -351,7 +573,7 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 			continue
 		}
 
-		err = tg.getFacade().ValidateTransaction(tx)
+		err = tg.getFacade().ValidateTransaction(c.Request.Context(), tx)
 		if err != nil {
 			continue
 		}
@@ -361,7 +583,7 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	}
 
 	start = time.Now()
-	numOfSentTxs, err := tg.getFacade().SendBulkTransactions(txs)
+	numOfSentTxs, err := tg.getFacade().SendBulkTransactions(c.Request.Context(), txs)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SendBulkTransactions")
 	if err != nil {
 		c.JSON(
@@ -492,10 +714,63 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	)
 }
 
+// replayTransaction re-executes, with tracing, a historical transaction against the state it was executed on
+func (tg *transactionGroup) replayTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	executionResults, err := tg.getFacade().ReplayTransaction(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ReplayTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrReplayTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // computeTransactionGasLimit returns how many gas units a transaction wil consume
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
-	var ftx transaction.FrontendTransaction
-	err := c.ShouldBindJSON(&ftx)
+	var request TransactionSimulationRequest
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	stateOverrides, err := createStateOverrides(request.StateOverrides, tg.getFacade().DecodeAddressPubkey)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -508,7 +783,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 		return
 	}
 
-	tx, _, err := tg.createTransaction(&ftx)
+	tx, _, err := tg.createTransaction(&request.FrontendTransaction)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -522,7 +797,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	}
 
 	start := time.Now()
-	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx)
+	cost, err := tg.getFacade().ComputeTransactionGasLimit(c.Request.Context(), tx, stateOverrides)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ComputeTransactionGasLimit")
 	if err != nil {
 		c.JSON(
@@ -735,6 +1010,8 @@ func (tg *transactionGroup) createTransaction(receivedTx *transaction.FrontendTr
 		Options:          receivedTx.Options,
 		Guardian:         receivedTx.GuardianAddr,
 		GuardianSigHex:   receivedTx.GuardianSignature,
+		Relayer:          receivedTx.RelayerAddr,
+		RelayerSigHex:    receivedTx.RelayerSignature,
 	}
 	start := time.Now()
 	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
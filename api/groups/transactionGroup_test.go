@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -21,6 +22,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -69,6 +71,26 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type traceTxResponseData struct {
+	Result *txSimData.SimulationResultsWithVMOutput `json:"result"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
+type simulateTxsSequenceResponseData struct {
+	Results []*txSimData.SimulationResultsWithVMOutput `json:"results"`
+}
+
+type simulateTxsSequenceResponse struct {
+	Data  simulateTxsSequenceResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -340,6 +362,45 @@ func TestTransactionGroup_sendTransaction(t *testing.T) {
 		assert.Empty(t, response.Error)
 		assert.Equal(t, hexTxHash, response.Data.TxHash)
 	})
+	t.Run("should forward the relayer fields", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx := &dataTx.FrontendTransaction{
+			Sender:           sender,
+			Receiver:         receiver,
+			Value:            value,
+			Signature:        signature,
+			RelayerAddr:      "erd1relayer",
+			RelayerSignature: "aabb",
+		}
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				assert.Equal(t, relayedTx.RelayerAddr, txArgs.Relayer)
+				assert.Equal(t, relayedTx.RelayerSignature, txArgs.RelayerSigHex)
+				txHash, _ := hex.DecodeString(hexTxHash)
+				return nil, txHash, nil
+			},
+			SendBulkTransactionsHandler: func(txs []*dataTx.Transaction) (u uint64, err error) {
+				return 1, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+		}
+
+		jsonBytes, _ := json.Marshal(relayedTx)
+		response := &sendSingleTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/send",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, hexTxHash, response.Data.TxHash)
+	})
 }
 
 func TestTransactionsGroup_getSCRsByTxHash(t *testing.T) {
@@ -554,7 +615,7 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, expectedErr
 			},
-			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*dataTx.CostResponse, error) {
 				require.Fail(t, "should not have been called")
 				return nil, nil
 			},
@@ -576,7 +637,7 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, nil
 			},
-			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*dataTx.CostResponse, error) {
 				return nil, expectedErr
 			},
 		}
@@ -599,7 +660,7 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return &dataTx.Transaction{}, nil, nil
 			},
-			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*dataTx.CostResponse, error) {
 				return &dataTx.CostResponse{
 					GasUnits:      expectedGasLimit,
 					ReturnMessage: "",
@@ -671,7 +732,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
 				return expectedErr
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
 				require.Fail(t, "should have not been called")
 				return nil, nil
 			},
@@ -696,7 +757,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
 				return nil
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
 				return nil, expectedErr
 			},
 		}
@@ -716,7 +777,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		processTxWasCalled := false
 
 		facade := &mock.FacadeStub{
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
 				processTxWasCalled = true
 				return &txSimData.SimulationResultsWithVMOutput{
 					SimulationResults: dataTx.SimulationResults{
@@ -760,6 +821,384 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		assert.True(t, processTxWasCalled)
 		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
 	})
+	t.Run("invalid state overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		request := &groups.TransactionSimulationRequest{
+			StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+				hex.EncodeToString([]byte("address")): {Balance: "-1"},
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate",
+			"POST",
+			request,
+			http.StatusBadRequest,
+			apiErrors.ErrValidation,
+		)
+	})
+	t.Run("should pass the state overrides", func(t *testing.T) {
+		t.Parallel()
+
+		providedAddress := []byte("address")
+		facade := &mock.FacadeStub{
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+				expectedOverrides := common.StateOverrides{
+					string(providedAddress): &common.AccountStateOverride{
+						Balance: big.NewInt(1000),
+						Storage: map[string][]byte{"key": []byte("value")},
+					},
+				}
+				assert.Equal(t, expectedOverrides, stateOverrides)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+		}
+
+		request := &groups.TransactionSimulationRequest{
+			StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+				string(providedAddress): {
+					Balance: "1000",
+					Storage: map[string]string{hex.EncodeToString([]byte("key")): hex.EncodeToString([]byte("value"))},
+				},
+			},
+		}
+		jsonBytes, _ := json.Marshal(request)
+
+		response := &simulateTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	})
+}
+
+func TestTransactionGroup_traceTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/trace", &dataTx.FrontendTransaction{}))
+	t.Run("invalid param transaction should error", testTransactionGroupErrorScenario("/transaction/trace", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("TraceTransactionExecution error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			TraceTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/trace",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+			TraceTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+					},
+					ExecutionTrace: &tracing.ExecutionTrace{
+						Calls: []*tracing.CallFrame{
+							{
+								Type:     tracing.CallFrameType,
+								Function: "foo",
+								GasUsed:  100,
+							},
+						},
+					},
+				}, nil
+			},
+		}
+
+		jsonBytes, _ := json.Marshal(&dataTx.FrontendTransaction{})
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/trace",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		require.NotNil(t, response.Data.Result)
+		require.NotNil(t, response.Data.Result.ExecutionTrace)
+		require.Equal(t, 1, len(response.Data.Result.ExecutionTrace.Calls))
+		assert.Equal(t, "foo", response.Data.Result.ExecutionTrace.Calls[0].Function)
+		assert.Equal(t, uint64(100), response.Data.Result.ExecutionTrace.Calls[0].GasUsed)
+		assert.Equal(t, hex.EncodeToString([]byte("hash")), response.Data.Result.Hash)
+	})
+}
+
+func TestTransactionGroup_replayTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/0101/replay", nil))
+	t.Run("ReplayTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReplayTransactionHandler: func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/0101/replay",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			apiErrors.ErrReplayTransaction,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReplayTransactionHandler: func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, "0101", txHash)
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+						Hash:   txHash,
+					},
+					ExecutionTrace: &tracing.ExecutionTrace{
+						Calls: []*tracing.CallFrame{
+							{
+								Type:     tracing.CallFrameType,
+								Function: "foo",
+							},
+						},
+					},
+				}, nil
+			},
+		}
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/0101/replay",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		require.NotNil(t, response.Data.Result)
+		assert.Equal(t, "0101", response.Data.Result.Hash)
+		require.NotNil(t, response.Data.Result.ExecutionTrace)
+		require.Equal(t, 1, len(response.Data.Result.ExecutionTrace.Calls))
+		assert.Equal(t, "foo", response.Data.Result.ExecutionTrace.Calls[0].Function)
+	})
+}
+
+func TestTransactionGroup_simulateTransactionsSequence(t *testing.T) {
+	t.Parallel()
+
+	request := &groups.TransactionsSequenceSimulationRequest{
+		Transactions: []dataTx.FrontendTransaction{{Nonce: 0}, {Nonce: 1}},
+	}
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/simulate-sequence", request))
+	t.Run("invalid param transactions should error", testTransactionGroupErrorScenario("/transaction/simulate-sequence", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("empty sequence should error", testTransactionGroupErrorScenario("/transaction/simulate-sequence", "POST", &groups.TransactionsSequenceSimulationRequest{}, http.StatusBadRequest, apiErrors.ErrValidationEmptyTransactionsSequence))
+	t.Run("invalid param checkSignature should error", testTransactionGroupErrorScenario("/transaction/simulate-sequence?checkSignature=not-bool", "POST", request, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("ValidateTransactionForSimulation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: txArgs.Nonce}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				if tx.Nonce == 1 {
+					return expectedErr
+				}
+				return nil
+			},
+			SimulateTransactionsSequenceCalled: func(txs []*dataTx.Transaction, _ common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate-sequence",
+			"POST",
+			request,
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("SimulateTransactionsSequence error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			SimulateTransactionsSequenceCalled: func(txs []*dataTx.Transaction, _ common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate-sequence",
+			"POST",
+			request,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: txArgs.Nonce}, []byte{byte(txArgs.Nonce)}, nil
+			},
+			SimulateTransactionsSequenceCalled: func(txs []*dataTx.Transaction, _ common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, 2, len(txs))
+				require.Equal(t, uint64(0), txs[0].Nonce)
+				require.Equal(t, uint64(1), txs[1].Nonce)
+
+				return []*txSimData.SimulationResultsWithVMOutput{
+					{
+						SimulationResults: dataTx.SimulationResults{Status: dataTx.TxStatusSuccess},
+						GasUnits:          50000,
+					},
+					{
+						SimulationResults: dataTx.SimulationResults{Status: dataTx.TxStatusFail, FailReason: "reason"},
+					},
+				}, nil
+			},
+		}
+
+		jsonBytes, _ := json.Marshal(request)
+		response := &simulateTxsSequenceResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate-sequence",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		require.Equal(t, 2, len(response.Data.Results))
+		assert.Equal(t, "00", response.Data.Results[0].Hash)
+		assert.Equal(t, uint64(50000), response.Data.Results[0].GasUnits)
+		assert.Equal(t, "01", response.Data.Results[1].Hash)
+		assert.Equal(t, "reason", response.Data.Results[1].FailReason)
+	})
+	t.Run("invalid state overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			SimulateTransactionsSequenceCalled: func(txs []*dataTx.Transaction, _ common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		requestWithOverrides := &groups.TransactionsSequenceSimulationRequest{
+			Transactions: request.Transactions,
+			StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+				hex.EncodeToString([]byte("address")): {Balance: "-1"},
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate-sequence",
+			"POST",
+			requestWithOverrides,
+			http.StatusBadRequest,
+			apiErrors.ErrValidation,
+		)
+	})
+	t.Run("should pass the state overrides", func(t *testing.T) {
+		t.Parallel()
+
+		providedAddress := []byte("address")
+		facade := &mock.FacadeStub{
+			DecodeAddressPubkeyCalled: func(pk string) ([]byte, error) {
+				return []byte(pk), nil
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: txArgs.Nonce}, []byte{byte(txArgs.Nonce)}, nil
+			},
+			SimulateTransactionsSequenceCalled: func(txs []*dataTx.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+				expectedOverrides := common.StateOverrides{
+					string(providedAddress): &common.AccountStateOverride{
+						Balance: big.NewInt(1000),
+					},
+				}
+				assert.Equal(t, expectedOverrides, stateOverrides)
+				return []*txSimData.SimulationResultsWithVMOutput{{}, {}}, nil
+			},
+		}
+
+		requestWithOverrides := &groups.TransactionsSequenceSimulationRequest{
+			Transactions: request.Transactions,
+			StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+				string(providedAddress): {Balance: "1000"},
+			},
+		}
+		jsonBytes, _ := json.Marshal(requestWithOverrides)
+
+		response := &simulateTxsSequenceResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate-sequence",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, 2, len(response.Data.Results))
+	})
 }
 
 func TestTransactionGroup_getTransactionsPool(t *testing.T) {
@@ -1192,7 +1631,10 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-sequence", Open: true},
+					{Name: "/trace", Open: true},
 					{Name: "/scrs-by-tx-hash/:txhash", Open: true},
+					{Name: "/:txhash/replay", Open: true},
 				},
 			},
 		},
@@ -1,8 +1,11 @@
 package groups
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/gin-gonic/gin"
@@ -14,14 +17,41 @@ import (
 )
 
 const (
-	statisticsPath = "/statistics"
-	auctionPath    = "/auction"
+	statisticsPath  = "/statistics"
+	auctionPath     = "/auction"
+	performancePath = "/performance"
+
+	queryParamStartEpoch = "startEpoch"
+	queryParamEndEpoch   = "endEpoch"
+	queryParamFormat     = "format"
+	formatCSV            = "csv"
+	formatJSON           = "json"
 )
 
+var performanceCSVHeader = []string{
+	"epoch",
+	"publicKey",
+	"shardId",
+	"list",
+	"numLeaderSuccess",
+	"numLeaderFailure",
+	"numValidatorSuccess",
+	"numValidatorFailure",
+	"numValidatorIgnoredSignatures",
+	"numSelectedInSuccessBlocks",
+	"startRating",
+	"endRating",
+	"accumulatedFees",
+	"rewardAddress",
+	"rewardAddressRewards",
+	"estimatedRewards",
+}
+
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
+	GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error)
 	IsInterfaceNil() bool
 }
 
@@ -44,14 +74,22 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    statisticsPath,
-			Method:  http.MethodGet,
-			Handler: ng.statistics,
+			Path:        statisticsPath,
+			Method:      http.MethodGet,
+			Handler:     ng.statistics,
+			Description: "returns the rating and the leader and validator statistics of all the validators",
+		},
+		{
+			Path:        auctionPath,
+			Method:      http.MethodGet,
+			Handler:     ng.auction,
+			Description: "returns the auction list of the staking queue",
 		},
 		{
-			Path:    auctionPath,
-			Method:  http.MethodGet,
-			Handler: ng.auction,
+			Path:        performancePath,
+			Method:      http.MethodGet,
+			Handler:     ng.performance,
+			Description: "returns the per epoch performance of the given validators",
 		},
 	}
 	ng.endpoints = endpoints
@@ -109,6 +147,89 @@ func (vg *validatorGroup) auction(c *gin.Context) {
 	)
 }
 
+// performance will return the validators performance for each epoch in the requested range, as JSON or CSV
+func (vg *validatorGroup) performance(c *gin.Context) {
+	startEpoch, err := getQueryParamUint32(c, queryParamStartEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, queryParamStartEpoch))
+		return
+	}
+	endEpoch, err := getQueryParamUint32(c, queryParamEndEpoch)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, queryParamEndEpoch))
+		return
+	}
+	format := c.Request.URL.Query().Get(queryParamFormat)
+	if len(format) > 0 && format != formatJSON && format != formatCSV {
+		shared.RespondWithValidationError(c, errors.ErrValidation, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, queryParamFormat))
+		return
+	}
+
+	performance, err := vg.getFacade().GetValidatorsPerformance(startEpoch, endEpoch)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetValidatorsPerformance, err)
+		return
+	}
+
+	if format != formatCSV {
+		shared.RespondWithSuccess(c, gin.H{"performance": performance})
+		return
+	}
+
+	buff, err := performanceToCSV(performance)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetValidatorsPerformance, err)
+		return
+	}
+
+	fileName := fmt.Sprintf("validators-performance-%d-%d.csv", startEpoch, endEpoch)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fileName))
+	c.Data(http.StatusOK, "text/csv", buff)
+}
+
+func performanceToCSV(performance []*common.ValidatorEpochPerformance) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	writer := csv.NewWriter(buff)
+
+	err := writer.Write(performanceCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range performance {
+		err = writer.Write([]string{
+			strconv.FormatUint(uint64(p.Epoch), 10),
+			p.PublicKey,
+			strconv.FormatUint(uint64(p.ShardId), 10),
+			p.List,
+			strconv.FormatUint(uint64(p.NumLeaderSuccess), 10),
+			strconv.FormatUint(uint64(p.NumLeaderFailure), 10),
+			strconv.FormatUint(uint64(p.NumValidatorSuccess), 10),
+			strconv.FormatUint(uint64(p.NumValidatorFailure), 10),
+			strconv.FormatUint(uint64(p.NumValidatorIgnoredSignatures), 10),
+			strconv.FormatUint(uint64(p.NumSelectedInSuccessBlocks), 10),
+			strconv.FormatFloat(float64(p.StartRating), 'f', -1, 32),
+			strconv.FormatFloat(float64(p.EndRating), 'f', -1, 32),
+			p.AccumulatedFees,
+			p.RewardAddress,
+			p.RewardAddressRewards,
+			p.EstimatedRewards,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+
+	return buff.Bytes(), writer.Error()
+}
+
+func getQueryParamUint32(c *gin.Context, name string) (uint32, error) {
+	value, err := strconv.ParseUint(c.Request.URL.Query().Get(name), 10, 32)
+	return uint32(value), err
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
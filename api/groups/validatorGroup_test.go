@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/validator"
@@ -256,6 +257,133 @@ func TestAuctionList_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, response.Data.Result, auctionListToReturn)
 }
 
+func TestValidatorGroup_Performance(t *testing.T) {
+	t.Parallel()
+
+	providedPerformance := []*common.ValidatorEpochPerformance{
+		{
+			Epoch:                3,
+			PublicKey:            "pk0",
+			List:                 "eligible",
+			NumLeaderSuccess:     2,
+			NumValidatorSuccess:  10,
+			StartRating:          50,
+			EndRating:            50.5,
+			AccumulatedFees:      "10",
+			RewardAddress:        "erd1",
+			RewardAddressRewards: "300",
+			EstimatedRewards:     "100",
+		},
+	}
+	createFacade := func() *mock.FacadeStub {
+		return &mock.FacadeStub{
+			GetValidatorsPerformanceCalled: func(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+				assert.Equal(t, uint32(3), startEpoch)
+				assert.Equal(t, uint32(4), endEpoch)
+				return providedPerformance, nil
+			},
+		}
+	}
+
+	t.Run("invalid query params should error", func(t *testing.T) {
+		t.Parallel()
+
+		urls := []string{
+			"/validator/performance?endEpoch=4",
+			"/validator/performance?startEpoch=3",
+			"/validator/performance?startEpoch=a&endEpoch=4",
+			"/validator/performance?startEpoch=3&endEpoch=4&format=xml",
+		}
+		for _, url := range urls {
+			validatorGroup, err := groups.NewValidatorGroup(createFacade())
+			require.NoError(t, err)
+
+			ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+			req, _ := http.NewRequest("GET", url, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := shared.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), url)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetValidatorsPerformanceCalled: func(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+				return nil, expectedErr
+			},
+		}
+		validatorGroup, err := groups.NewValidatorGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/performance?startEpoch=3&endEpoch=4", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetValidatorsPerformance.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work with json", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(createFacade())
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/performance?startEpoch=3&endEpoch=4", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type performanceResponse struct {
+			Data struct {
+				Performance []*common.ValidatorEpochPerformance `json:"performance"`
+			} `json:"data"`
+			Error string `json:"error"`
+			Code  string `json:"code"`
+		}
+		response := performanceResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, providedPerformance, response.Data.Performance)
+	})
+	t.Run("should work with csv", func(t *testing.T) {
+		t.Parallel()
+
+		validatorGroup, err := groups.NewValidatorGroup(createFacade())
+		require.NoError(t, err)
+
+		ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/validator/performance?startEpoch=3&endEpoch=4&format=csv", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/csv", resp.Header().Get("Content-Type"))
+		assert.True(t, strings.Contains(resp.Header().Get("Content-Disposition"), "validators-performance-3-4.csv"))
+
+		lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+		require.Equal(t, 2, len(lines))
+		assert.True(t, strings.HasPrefix(lines[0], "epoch,publicKey,shardId,list,"))
+		assert.Equal(t, "3,pk0,0,eligible,2,0,10,0,0,0,50,50.5,10,erd1,300,100", lines[1])
+	})
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -263,6 +391,7 @@ func getValidatorRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
 					{Name: "/auction", Open: true},
+					{Name: "/performance", Open: true},
 				},
 			},
 		},
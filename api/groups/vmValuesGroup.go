@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -27,7 +28,7 @@ const (
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
-	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
+	ExecuteSCQuery(ctx context.Context, query *process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -51,24 +52,36 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
-			Path:    hexPath,
-			Method:  http.MethodPost,
-			Handler: vvg.getHex,
+			Path:         hexPath,
+			Method:       http.MethodPost,
+			Handler:      vvg.getHex,
+			Description:  "executes the given smart contract view function and returns its first result, hex encoded",
+			RequestData:  VMValueRequest{},
+			ResponseData: gin.H{"data": "", "blockInfo": apiData.BlockInfo{}},
 		},
 		{
-			Path:    stringPath,
-			Method:  http.MethodPost,
-			Handler: vvg.getString,
+			Path:         stringPath,
+			Method:       http.MethodPost,
+			Handler:      vvg.getString,
+			Description:  "executes the given smart contract view function and returns its first result, as string",
+			RequestData:  VMValueRequest{},
+			ResponseData: gin.H{"data": "", "blockInfo": apiData.BlockInfo{}},
 		},
 		{
-			Path:    intPath,
-			Method:  http.MethodPost,
-			Handler: vvg.getInt,
+			Path:         intPath,
+			Method:       http.MethodPost,
+			Handler:      vvg.getInt,
+			Description:  "executes the given smart contract view function and returns its first result, as big integer string",
+			RequestData:  VMValueRequest{},
+			ResponseData: gin.H{"data": "", "blockInfo": apiData.BlockInfo{}},
 		},
 		{
-			Path:    queryPath,
-			Method:  http.MethodPost,
-			Handler: vvg.executeQuery,
+			Path:         queryPath,
+			Method:       http.MethodPost,
+			Handler:      vvg.executeQuery,
+			Description:  "executes the given smart contract view function and returns the whole VM output",
+			RequestData:  VMValueRequest{},
+			ResponseData: gin.H{"data": vm.VMOutputApi{}, "blockInfo": apiData.BlockInfo{}},
 		},
 	}
 	vvg.endpoints = endpoints
@@ -85,6 +98,8 @@ type VMValueRequest struct {
 	Args           []string `json:"args"`
 	SameScState    bool     `json:"sameScState"`
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
+
+	StateOverrides map[string]*AccountStateOverrideRequest `json:"stateOverrides,omitempty"`
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -146,7 +161,7 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", apiData.BlockInfo{}, err
 	}
 
-	vmOutputApi, blockInfo, err := vvg.getFacade().ExecuteSCQuery(command)
+	vmOutputApi, blockInfo, err := vvg.getFacade().ExecuteSCQuery(context.Request.Context(), command)
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
 	}
@@ -215,6 +230,11 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 		scQuery.CallValue = callValue
 	}
 
+	scQuery.StateOverrides, err = createStateOverrides(request.StateOverrides, vvg.getFacade().DecodeAddressPubkey)
+	if err != nil {
+		return nil, err
+	}
+
 	return scQuery, nil
 }
 
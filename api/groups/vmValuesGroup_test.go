@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -209,6 +210,45 @@ func TestQuery(t *testing.T) {
 		require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 		require.Equal(t, providedBlockInfo, response.BlockInfo)
 	})
+	t.Run("should work - state overrides", func(t *testing.T) {
+		t.Parallel()
+
+		expectedOverrides := common.StateOverrides{
+			string(dummyScAddressBytes()): &common.AccountStateOverride{
+				ESDTBalances: map[string]*big.Int{"TKN-abcdef": big.NewInt(37)},
+				Code:         []byte("code"),
+			},
+		}
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				require.Equal(t, expectedOverrides, query.StateOverrides)
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				}, api.BlockInfo{}, nil
+			},
+		}
+		request := groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+				dummyScAddress: {
+					ESDTBalances: map[string]string{"TKN-abcdef": "37"},
+					Code:         hex.EncodeToString([]byte("code")),
+				},
+			},
+		}
+
+		response := vmOutputResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "", response.Error)
+	})
+}
+
+func dummyScAddressBytes() []byte {
+	address, _ := hex.DecodeString(dummyScAddress)
+	return address
 }
 
 func testQueryShouldWork(t *testing.T, url string, facade shared.FacadeHandler) {
@@ -313,6 +353,30 @@ func TestAllRoutes_WhenBadAddressShouldErr(t *testing.T) {
 	requireErrorOnAllRoutes(t, &facade, request, errExpected)
 }
 
+func TestAllRoutes_WhenBadStateOverridesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("is not a valid hex storage key")
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+			return &vm.VMOutputApi{}, api.BlockInfo{}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+		StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+			dummyScAddress: {
+				Storage: map[string]string{"not hex": "00"},
+			},
+		},
+	}
+
+	requireErrorOnAllRoutes(t, &facade, request, errExpected)
+}
+
 func TestAllRoutes_WhenBadArgumentsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,432 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+)
+
+// DiscoverMethod is the OpenRPC service discovery method, returning the schema of the served methods
+const DiscoverMethod = "rpc.discover"
+
+const restReturnCodeSuccess = "successful"
+const restReturnCodeRequestError = "bad_request"
+const restReturnCodeSystemBusy = "system_busy"
+
+var nullID = json.RawMessage("null")
+
+// ArgsDispatcher holds the arguments needed to create a new JSON-RPC dispatcher
+type ArgsDispatcher struct {
+	Handler      http.Handler
+	Methods      []*MethodDescriptor
+	MaxBatchSize int
+	Title        string
+	Version      string
+}
+
+type restResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+type dispatcher struct {
+	handler      http.Handler
+	methods      map[string]*MethodDescriptor
+	maxBatchSize int
+	schema       json.RawMessage
+}
+
+// NewDispatcher creates a JSON-RPC dispatcher which serves each method by calling its REST endpoint on the provided
+// handler, so the REST validation and error handling are applied unchanged
+func NewDispatcher(args ArgsDispatcher) (*dispatcher, error) {
+	if args.Handler == nil {
+		return nil, ErrNilHandler
+	}
+	if args.MaxBatchSize < 1 {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidMaxBatchSize, args.MaxBatchSize)
+	}
+
+	methods := make(map[string]*MethodDescriptor, len(args.Methods))
+	for _, method := range args.Methods {
+		err := checkMethodDescriptor(method)
+		if err != nil {
+			return nil, err
+		}
+
+		_, exists := methods[method.Name]
+		if exists || method.Name == DiscoverMethod {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedMethod, method.Name)
+		}
+		methods[method.Name] = method
+	}
+
+	schema, err := json.Marshal(GenerateOpenRPCDocument(args.Title, args.Version, args.Methods))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dispatcher{
+		handler:      args.Handler,
+		methods:      methods,
+		maxBatchSize: args.MaxBatchSize,
+		schema:       schema,
+	}, nil
+}
+
+func checkMethodDescriptor(method *MethodDescriptor) error {
+	if method == nil {
+		return ErrNilMethodDescriptor
+	}
+	if len(method.Name) == 0 {
+		return ErrEmptyMethodName
+	}
+	if len(method.HTTPMethod) == 0 || !strings.HasPrefix(method.Path, "/") {
+		return fmt.Errorf("%w for method %s", ErrInvalidMethodEndpoint, method.Name)
+	}
+
+	numBodyParams := 0
+	for _, param := range method.Params {
+		if len(param.Name) == 0 {
+			return fmt.Errorf("%w for method %s: empty name", ErrInvalidParamDescriptor, method.Name)
+		}
+
+		switch param.Location {
+		case ParamInPath:
+			if !hasPathSegment(method.Path, param.Name) {
+				return fmt.Errorf("%w for method %s: %s is not a path segment", ErrInvalidParamDescriptor, method.Name, param.Name)
+			}
+			if !param.Required {
+				return fmt.Errorf("%w for method %s: path parameter %s should be required", ErrInvalidParamDescriptor, method.Name, param.Name)
+			}
+		case ParamInQuery:
+		case ParamInBody:
+			numBodyParams++
+		default:
+			return fmt.Errorf("%w for method %s: unknown location of %s", ErrInvalidParamDescriptor, method.Name, param.Name)
+		}
+	}
+	if numBodyParams > 1 {
+		return fmt.Errorf("%w for method %s: more than one body parameter", ErrInvalidParamDescriptor, method.Name)
+	}
+
+	return nil
+}
+
+func hasPathSegment(path string, name string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == ":"+name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HandleRequest handles a single or a batch JSON-RPC request and returns the response to be written. A nil response
+// is returned when the request only holds notifications. Each call is sent to the REST handler with the context, the
+// headers and the remote address of the source request, so every call of a batch is throttled and authorized on its own
+func (d *dispatcher) HandleRequest(source *http.Request, body []byte) interface{} {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		return d.handleBatch(source, body)
+	}
+
+	request := &Request{}
+	err := json.Unmarshal(body, request)
+	if err != nil {
+		return newErrorResponse(nullID, CodeParseError, "parse error", err.Error())
+	}
+
+	response := d.handleSingle(source, request)
+	if response == nil {
+		return nil
+	}
+
+	return response
+}
+
+func (d *dispatcher) handleBatch(source *http.Request, body []byte) interface{} {
+	batch := make([]json.RawMessage, 0)
+	err := json.Unmarshal(body, &batch)
+	if err != nil {
+		return newErrorResponse(nullID, CodeParseError, "parse error", err.Error())
+	}
+	if len(batch) == 0 {
+		return newErrorResponse(nullID, CodeInvalidRequest, "invalid request", "empty batch")
+	}
+	if len(batch) > d.maxBatchSize {
+		return newErrorResponse(nullID, CodeInvalidRequest, "invalid request",
+			fmt.Sprintf("batch holds %d requests, maximum allowed is %d", len(batch), d.maxBatchSize))
+	}
+
+	responses := make([]*Response, 0, len(batch))
+	for _, rawRequest := range batch {
+		request := &Request{}
+		err = json.Unmarshal(rawRequest, request)
+		if err != nil {
+			responses = append(responses, newErrorResponse(nullID, CodeInvalidRequest, "invalid request", err.Error()))
+			continue
+		}
+
+		response := d.handleSingle(source, request)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		return nil
+	}
+
+	return responses
+}
+
+func (d *dispatcher) handleSingle(source *http.Request, request *Request) *Response {
+	id := request.ID
+	isNotification := id == nil
+	if isNotification {
+		id = nullID
+	}
+
+	if request.JSONRPC != Version || len(request.Method) == 0 {
+		return newErrorResponse(id, CodeInvalidRequest, "invalid request",
+			fmt.Sprintf("expected jsonrpc %s and a method name", Version))
+	}
+
+	result, rpcErr := d.call(source, request)
+	if isNotification {
+		return nil
+	}
+	if rpcErr != nil {
+		return &Response{
+			JSONRPC: Version,
+			ID:      id,
+			Error:   rpcErr,
+		}
+	}
+
+	return &Response{
+		JSONRPC: Version,
+		ID:      id,
+		Result:  result,
+	}
+}
+
+func (d *dispatcher) call(source *http.Request, request *Request) (json.RawMessage, *Error) {
+	if request.Method == DiscoverMethod {
+		return d.schema, nil
+	}
+
+	method, ok := d.methods[request.Method]
+	if !ok {
+		return nil, &Error{Code: CodeMethodNotFound, Message: "method not found", Data: request.Method}
+	}
+
+	params, err := namedParams(method, request.Params)
+	if err != nil {
+		return nil, &Error{Code: CodeInvalidParams, Message: "invalid params", Data: err.Error()}
+	}
+
+	httpRequest, err := buildHTTPRequest(source, method, params)
+	if err != nil {
+		return nil, &Error{Code: CodeInvalidParams, Message: "invalid params", Data: err.Error()}
+	}
+
+	recorder := httptest.NewRecorder()
+	d.handler.ServeHTTP(recorder, httpRequest)
+
+	return processRESTResponse(method, recorder)
+}
+
+// namedParams returns the request parameters keyed by name, mapping the positional ones in the descriptor order
+func namedParams(method *MethodDescriptor, rawParams json.RawMessage) (map[string]json.RawMessage, error) {
+	params := make(map[string]json.RawMessage)
+	rawParams = bytes.TrimSpace(rawParams)
+	if len(rawParams) == 0 || bytes.Equal(rawParams, nullID) {
+		return params, nil
+	}
+
+	if rawParams[0] == '[' {
+		positional := make([]json.RawMessage, 0)
+		err := json.Unmarshal(rawParams, &positional)
+		if err != nil {
+			return nil, err
+		}
+		if len(positional) > len(method.Params) {
+			return nil, fmt.Errorf("too many params, expected at most %d", len(method.Params))
+		}
+		for idx, value := range positional {
+			params[method.Params[idx].Name] = value
+		}
+
+		return params, nil
+	}
+
+	err := json.Unmarshal(rawParams, &params)
+	if err != nil {
+		return nil, fmt.Errorf("params should be an array or an object: %w", err)
+	}
+	for name := range params {
+		if !hasParam(method, name) {
+			return nil, fmt.Errorf("unknown param %s", name)
+		}
+	}
+
+	return params, nil
+}
+
+func hasParam(method *MethodDescriptor, name string) bool {
+	for _, param := range method.Params {
+		if param.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func buildHTTPRequest(source *http.Request, method *MethodDescriptor, params map[string]json.RawMessage) (*http.Request, error) {
+	pathSegments := strings.Split(method.Path, "/")
+	query := url.Values{}
+	var body []byte
+	for _, param := range method.Params {
+		value, ok := params[param.Name]
+		if !ok || bytes.Equal(value, nullID) {
+			if param.Required {
+				return nil, fmt.Errorf("missing param %s", param.Name)
+			}
+			continue
+		}
+
+		if param.Location == ParamInBody {
+			if len(value) == 0 || value[0] != '{' {
+				return nil, fmt.Errorf("param %s should be an object", param.Name)
+			}
+			body = value
+			continue
+		}
+
+		textValue, err := scalarToString(value)
+		if err != nil {
+			return nil, fmt.Errorf("param %s: %w", param.Name, err)
+		}
+		if param.Location == ParamInPath {
+			replacePathSegment(pathSegments, param.Name, url.PathEscape(textValue))
+			continue
+		}
+		query.Set(param.Name, textValue)
+	}
+
+	target := strings.Join(pathSegments, "/")
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	httpRequest, err := http.NewRequestWithContext(source.Context(), method.HTTPMethod, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.RemoteAddr = source.RemoteAddr
+	httpRequest.Header = source.Header.Clone()
+	if httpRequest.Header == nil {
+		httpRequest.Header = make(http.Header)
+	}
+	httpRequest.Header.Del("Content-Length")
+	httpRequest.Header.Del("Content-Type")
+	if len(body) > 0 {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+
+	return httpRequest, nil
+}
+
+func replacePathSegment(pathSegments []string, name string, value string) {
+	for idx, segment := range pathSegments {
+		if segment == ":"+name {
+			pathSegments[idx] = value
+		}
+	}
+}
+
+// scalarToString converts a JSON string, number or boolean into the text sent on the REST request, leaving the
+// value validation to the REST handler
+func scalarToString(value json.RawMessage) (string, error) {
+	if len(value) > 0 && value[0] == '"' {
+		text := ""
+		err := json.Unmarshal(value, &text)
+		return text, err
+	}
+
+	var scalar interface{}
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	err := decoder.Decode(&scalar)
+	if err != nil {
+		return "", err
+	}
+
+	switch typedValue := scalar.(type) {
+	case json.Number:
+		return typedValue.String(), nil
+	case bool:
+		return fmt.Sprintf("%t", typedValue), nil
+	default:
+		return "", fmt.Errorf("expected a string, a number or a boolean")
+	}
+}
+
+func processRESTResponse(method *MethodDescriptor, recorder *httptest.ResponseRecorder) (json.RawMessage, *Error) {
+	if recorder.Code == http.StatusNotFound {
+		return nil, &Error{Code: CodeMethodNotFound, Message: "method not found", Data: fmt.Sprintf("%s is disabled", method.Name)}
+	}
+
+	response := &restResponse{}
+	err := json.Unmarshal(recorder.Body.Bytes(), response)
+	if err != nil {
+		return nil, &Error{Code: CodeInternalError, Message: "internal error", Data: err.Error()}
+	}
+
+	switch {
+	case recorder.Code == http.StatusUnauthorized:
+		return nil, &Error{Code: CodeUnauthorized, Message: "unauthorized", Data: response.Error}
+	case recorder.Code == http.StatusForbidden:
+		return nil, &Error{Code: CodeForbidden, Message: "forbidden", Data: response.Error}
+	case recorder.Code == http.StatusOK && response.Code == restReturnCodeSuccess:
+		if len(response.Data) == 0 {
+			return nullID, nil
+		}
+		return response.Data, nil
+	case response.Code == restReturnCodeRequestError:
+		return nil, &Error{Code: CodeInvalidParams, Message: "invalid params", Data: response.Error}
+	case response.Code == restReturnCodeSystemBusy:
+		return nil, &Error{Code: CodeServerBusy, Message: "server busy", Data: response.Error}
+	default:
+		return nil, &Error{Code: CodeServerError, Message: "server error", Data: response.Error}
+	}
+}
+
+func newErrorResponse(id json.RawMessage, code int, message string, data interface{}) *Response {
+	return &Response{
+		JSONRPC: Version,
+		ID:      id,
+		Error: &Error{
+			Code:    code,
+			Message: message,
+			Data:    data,
+		},
+	}
+}
+
+// Schema returns the OpenRPC document describing the served methods
+func (d *dispatcher) Schema() json.RawMessage {
+	return d.schema
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *dispatcher) IsInterfaceNil() bool {
+	return d == nil
+}
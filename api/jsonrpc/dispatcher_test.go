@@ -0,0 +1,405 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	method     string
+	uri        string
+	body       string
+	header     http.Header
+	remoteAddr string
+}
+
+type handlerStub struct {
+	requests []*recordedRequest
+	status   int
+	response string
+}
+
+func (hs *handlerStub) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, _ := io.ReadAll(request.Body)
+	hs.requests = append(hs.requests, &recordedRequest{
+		method:     request.Method,
+		uri:        request.URL.RequestURI(),
+		body:       string(body),
+		header:     request.Header,
+		remoteAddr: request.RemoteAddr,
+	})
+
+	writer.WriteHeader(hs.status)
+	_, _ = writer.Write([]byte(hs.response))
+}
+
+func newHandlerStub() *handlerStub {
+	return &handlerStub{
+		status:   http.StatusOK,
+		response: `{"data":{"balance":"10"},"error":"","code":"successful"}`,
+	}
+}
+
+func createTestMethods() []*MethodDescriptor {
+	return []*MethodDescriptor{
+		{
+			Name:       "address_getBalance",
+			HTTPMethod: http.MethodGet,
+			Path:       "/address/:address/balance",
+			Params: []ParamDescriptor{
+				{Name: "address", Type: TypeString, Location: ParamInPath, Required: true},
+				{Name: "blockNonce", Type: TypeInteger, Location: ParamInQuery},
+				{Name: "onFinalBlock", Type: TypeBoolean, Location: ParamInQuery},
+			},
+			ResultName: "balance",
+		},
+		{
+			Name:       "transaction_send",
+			HTTPMethod: http.MethodPost,
+			Path:       "/transaction/send",
+			Params: []ParamDescriptor{
+				{Name: "transaction", Type: TypeObject, Location: ParamInBody, Required: true},
+			},
+			ResultName: "txHash",
+		},
+	}
+}
+
+func createMockArgsDispatcher(handler http.Handler) ArgsDispatcher {
+	return ArgsDispatcher{
+		Handler:      handler,
+		Methods:      createTestMethods(),
+		MaxBatchSize: 2,
+		Title:        "test",
+		Version:      "1.0.0",
+	}
+}
+
+func createSourceRequest() *http.Request {
+	return httptest.NewRequest(http.MethodPost, "/rpc/jsonrpc", nil)
+}
+
+func handleRequest(t *testing.T, d *dispatcher, body string) *Response {
+	response, ok := d.HandleRequest(createSourceRequest(), []byte(body)).(*Response)
+	require.True(t, ok)
+
+	return response
+}
+
+func TestNewDispatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDispatcher(nil)
+		d, err := NewDispatcher(args)
+		require.Equal(t, ErrNilHandler, err)
+		require.Nil(t, d)
+	})
+	t.Run("invalid max batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDispatcher(newHandlerStub())
+		args.MaxBatchSize = 0
+		d, err := NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrInvalidMaxBatchSize))
+		require.Nil(t, d)
+	})
+	t.Run("nil method should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDispatcher(newHandlerStub())
+		args.Methods = append(args.Methods, nil)
+		d, err := NewDispatcher(args)
+		require.Equal(t, ErrNilMethodDescriptor, err)
+		require.Nil(t, d)
+	})
+	t.Run("empty method name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDispatcher(newHandlerStub())
+		args.Methods[0].Name = ""
+		d, err := NewDispatcher(args)
+		require.Equal(t, ErrEmptyMethodName, err)
+		require.Nil(t, d)
+	})
+	t.Run("duplicated method should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDispatcher(newHandlerStub())
+		args.Methods[1].Name = args.Methods[0].Name
+		d, err := NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrDuplicatedMethod))
+		require.Nil(t, d)
+
+		args = createMockArgsDispatcher(newHandlerStub())
+		args.Methods[1].Name = DiscoverMethod
+		d, err = NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrDuplicatedMethod))
+		require.Nil(t, d)
+	})
+	t.Run("invalid endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDispatcher(newHandlerStub())
+		args.Methods[0].Path = "address"
+		d, err := NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrInvalidMethodEndpoint))
+		require.Nil(t, d)
+	})
+	t.Run("invalid params should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDispatcher(newHandlerStub())
+		args.Methods[0].Params[0].Name = "addr"
+		d, err := NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrInvalidParamDescriptor))
+		require.Nil(t, d)
+
+		args = createMockArgsDispatcher(newHandlerStub())
+		args.Methods[0].Params[0].Required = false
+		d, err = NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrInvalidParamDescriptor))
+		require.Nil(t, d)
+
+		args = createMockArgsDispatcher(newHandlerStub())
+		args.Methods[0].Params[1].Location = "header"
+		d, err = NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrInvalidParamDescriptor))
+		require.Nil(t, d)
+
+		args = createMockArgsDispatcher(newHandlerStub())
+		args.Methods[1].Params = append(args.Methods[1].Params, ParamDescriptor{Name: "other", Location: ParamInBody})
+		d, err = NewDispatcher(args)
+		require.True(t, errors.Is(err, ErrInvalidParamDescriptor))
+		require.Nil(t, d)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewDispatcher(createMockArgsDispatcher(newHandlerStub()))
+		require.NoError(t, err)
+		require.False(t, d.IsInterfaceNil())
+	})
+}
+
+func TestDispatcher_HandleRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("named params should be mapped onto the REST request", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+
+		response := handleRequest(t, d, `{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":{"address":"erd1 x","blockNonce":37,"onFinalBlock":true}}`)
+		require.Nil(t, response.Error)
+		require.Equal(t, json.RawMessage("1"), response.ID)
+		require.JSONEq(t, `{"balance":"10"}`, string(response.Result))
+
+		require.Len(t, handler.requests, 1)
+		require.Equal(t, http.MethodGet, handler.requests[0].method)
+		require.Equal(t, "/address/erd1%20x/balance?blockNonce=37&onFinalBlock=true", handler.requests[0].uri)
+	})
+	t.Run("positional params should be mapped in the descriptor order", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+
+		response := handleRequest(t, d, `{"jsonrpc":"2.0","id":"a","method":"address_getBalance","params":["erd1",null,"true"]}`)
+		require.Nil(t, response.Error)
+		require.Equal(t, json.RawMessage(`"a"`), response.ID)
+		require.Equal(t, "/address/erd1/balance?onFinalBlock=true", handler.requests[0].uri)
+	})
+	t.Run("body param should be sent as the request body", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+
+		response := handleRequest(t, d, `{"jsonrpc":"2.0","id":1,"method":"transaction_send","params":[{"nonce":1}]}`)
+		require.Nil(t, response.Error)
+		require.Equal(t, http.MethodPost, handler.requests[0].method)
+		require.Equal(t, "/transaction/send", handler.requests[0].uri)
+		require.Equal(t, `{"nonce":1}`, handler.requests[0].body)
+
+		response = handleRequest(t, d, `{"jsonrpc":"2.0","id":1,"method":"transaction_send","params":["tx"]}`)
+		require.Equal(t, CodeInvalidParams, response.Error.Code)
+	})
+	t.Run("invalid params should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+
+		requests := []string{
+			`{"jsonrpc":"2.0","id":1,"method":"address_getBalance"}`,
+			`{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":{"unknown":1}}`,
+			`{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1",1,true,4]}`,
+			`{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":{"address":{"a":1}}}`,
+			`{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":"erd1"}`,
+		}
+		for _, request := range requests {
+			response := handleRequest(t, d, request)
+			require.Equal(t, CodeInvalidParams, response.Error.Code, request)
+		}
+		require.Empty(t, handler.requests)
+	})
+	t.Run("REST errors should be mapped onto JSON-RPC errors", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+		request := `{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1"]}`
+
+		handler.status = http.StatusBadRequest
+		handler.response = `{"data":null,"error":"invalid address","code":"bad_request"}`
+		response := handleRequest(t, d, request)
+		require.Equal(t, &Error{Code: CodeInvalidParams, Message: "invalid params", Data: "invalid address"}, response.Error)
+
+		handler.status = http.StatusTooManyRequests
+		handler.response = `{"data":null,"error":"too many requests","code":"system_busy"}`
+		response = handleRequest(t, d, request)
+		require.Equal(t, CodeServerBusy, response.Error.Code)
+
+		handler.status = http.StatusUnauthorized
+		handler.response = `{"data":null,"error":"missing credentials","code":"bad_request"}`
+		response = handleRequest(t, d, request)
+		require.Equal(t, &Error{Code: CodeUnauthorized, Message: "unauthorized", Data: "missing credentials"}, response.Error)
+
+		handler.status = http.StatusForbidden
+		handler.response = `{"data":null,"error":"forbidden","code":"bad_request"}`
+		response = handleRequest(t, d, request)
+		require.Equal(t, &Error{Code: CodeForbidden, Message: "forbidden", Data: "forbidden"}, response.Error)
+
+		handler.status = http.StatusInternalServerError
+		handler.response = `{"data":null,"error":"internal","code":"internal_issue"}`
+		response = handleRequest(t, d, request)
+		require.Equal(t, CodeServerError, response.Error.Code)
+
+		handler.status = http.StatusNotFound
+		handler.response = "404 page not found"
+		response = handleRequest(t, d, request)
+		require.Equal(t, CodeMethodNotFound, response.Error.Code)
+
+		handler.status = http.StatusOK
+		handler.response = "not a json"
+		response = handleRequest(t, d, request)
+		require.Equal(t, CodeInternalError, response.Error.Code)
+	})
+	t.Run("invalid requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := NewDispatcher(createMockArgsDispatcher(newHandlerStub()))
+
+		response := handleRequest(t, d, `{"jsonrpc":"2.0","id":1`)
+		require.Equal(t, CodeParseError, response.Error.Code)
+		require.Equal(t, nullID, response.ID)
+
+		response = handleRequest(t, d, `{"jsonrpc":"1.0","id":1,"method":"address_getBalance"}`)
+		require.Equal(t, CodeInvalidRequest, response.Error.Code)
+
+		response = handleRequest(t, d, `{"jsonrpc":"2.0","id":1,"method":"unknown"}`)
+		require.Equal(t, CodeMethodNotFound, response.Error.Code)
+	})
+	t.Run("notification should not return a response", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+
+		response := d.HandleRequest(createSourceRequest(), []byte(`{"jsonrpc":"2.0","method":"address_getBalance","params":["erd1"]}`))
+		require.Nil(t, response)
+		require.Len(t, handler.requests, 1)
+
+		responseWithNullID := handleRequest(t, d, `{"jsonrpc":"2.0","id":null,"method":"address_getBalance","params":["erd1"]}`)
+		require.Equal(t, nullID, responseWithNullID.ID)
+	})
+	t.Run("batch should return the responses of the requests", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+
+		response := d.HandleRequest(createSourceRequest(), []byte(`[
+			{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1"]},
+			{"jsonrpc":"2.0","method":"address_getBalance","params":["erd1"]}
+		]`))
+		responses, ok := response.([]*Response)
+		require.True(t, ok)
+		require.Len(t, responses, 1)
+		require.Equal(t, json.RawMessage("1"), responses[0].ID)
+		require.Len(t, handler.requests, 2)
+
+		response = d.HandleRequest(createSourceRequest(), []byte(`[1, {"jsonrpc":"2.0","id":2,"method":"unknown"}]`))
+		responses, ok = response.([]*Response)
+		require.True(t, ok)
+		require.Len(t, responses, 2)
+		require.Equal(t, CodeInvalidRequest, responses[0].Error.Code)
+		require.Equal(t, CodeMethodNotFound, responses[1].Error.Code)
+
+		response = d.HandleRequest(createSourceRequest(), []byte(`[{"jsonrpc":"2.0","method":"unknown"}]`))
+		require.Nil(t, response)
+	})
+	t.Run("calls should keep the remote address and the headers of the source request", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newHandlerStub()
+		d, _ := NewDispatcher(createMockArgsDispatcher(handler))
+
+		source := createSourceRequest()
+		source.RemoteAddr = "10.0.0.1:1234"
+		source.Header.Set("X-API-Key", "key")
+		source.Header.Set("Content-Type", "text/plain")
+		source.Header.Set("Content-Length", "100")
+		response := d.HandleRequest(source, []byte(`[
+			{"jsonrpc":"2.0","id":1,"method":"address_getBalance","params":["erd1"]},
+			{"jsonrpc":"2.0","id":2,"method":"transaction_send","params":[{"nonce":1}]}
+		]`))
+		require.NotNil(t, response)
+		require.Len(t, handler.requests, 2)
+		for _, request := range handler.requests {
+			require.Equal(t, "10.0.0.1:1234", request.remoteAddr)
+			require.Equal(t, "key", request.header.Get("X-API-Key"))
+			require.Empty(t, request.header.Get("Content-Length"))
+		}
+		require.Empty(t, handler.requests[0].header.Get("Content-Type"))
+		require.Equal(t, "application/json", handler.requests[1].header.Get("Content-Type"))
+	})
+	t.Run("invalid batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := NewDispatcher(createMockArgsDispatcher(newHandlerStub()))
+
+		response := handleRequest(t, d, `[`)
+		require.Equal(t, CodeParseError, response.Error.Code)
+
+		response = handleRequest(t, d, `[]`)
+		require.Equal(t, CodeInvalidRequest, response.Error.Code)
+
+		response = handleRequest(t, d, `[1, 2, 3]`)
+		require.Equal(t, CodeInvalidRequest, response.Error.Code)
+	})
+	t.Run("discover should return the OpenRPC document", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := NewDispatcher(createMockArgsDispatcher(newHandlerStub()))
+
+		response := handleRequest(t, d, `{"jsonrpc":"2.0","id":1,"method":"rpc.discover"}`)
+		require.Nil(t, response.Error)
+		require.Equal(t, d.Schema(), response.Result)
+
+		document := &OpenRPCDocument{}
+		err := json.Unmarshal(response.Result, document)
+		require.NoError(t, err)
+		require.Len(t, document.Methods, 3)
+	})
+}
@@ -0,0 +1,24 @@
+package jsonrpc
+
+import "errors"
+
+// ErrNilHandler signals that a nil http handler has been provided
+var ErrNilHandler = errors.New("nil http handler")
+
+// ErrNilMethodDescriptor signals that a nil method descriptor has been provided
+var ErrNilMethodDescriptor = errors.New("nil method descriptor")
+
+// ErrEmptyMethodName signals that a method descriptor without a name has been provided
+var ErrEmptyMethodName = errors.New("empty method name")
+
+// ErrDuplicatedMethod signals that the same method has been registered twice
+var ErrDuplicatedMethod = errors.New("duplicated method")
+
+// ErrInvalidMethodEndpoint signals that a method descriptor holds an invalid HTTP endpoint
+var ErrInvalidMethodEndpoint = errors.New("invalid method endpoint")
+
+// ErrInvalidParamDescriptor signals that a method descriptor holds an invalid parameter descriptor
+var ErrInvalidParamDescriptor = errors.New("invalid parameter descriptor")
+
+// ErrInvalidMaxBatchSize signals that an invalid maximum batch size has been provided
+var ErrInvalidMaxBatchSize = errors.New("invalid maximum batch size")
@@ -0,0 +1,93 @@
+package jsonrpc
+
+// OpenRPCVersion is the version of the OpenRPC specification the generated documents comply with
+const OpenRPCVersion = "1.2.6"
+
+// OpenRPCDocument defines an OpenRPC document describing the served methods
+type OpenRPCDocument struct {
+	OpenRPC string           `json:"openrpc"`
+	Info    OpenRPCInfo      `json:"info"`
+	Methods []*OpenRPCMethod `json:"methods"`
+}
+
+// OpenRPCInfo defines the metadata of an OpenRPC document
+type OpenRPCInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenRPCMethod defines a method of an OpenRPC document
+type OpenRPCMethod struct {
+	Name           string                      `json:"name"`
+	Summary        string                      `json:"summary,omitempty"`
+	Description    string                      `json:"description,omitempty"`
+	ParamStructure string                      `json:"paramStructure"`
+	Params         []*OpenRPCContentDescriptor `json:"params"`
+	Result         *OpenRPCContentDescriptor   `json:"result"`
+}
+
+// OpenRPCContentDescriptor defines a parameter or a result of an OpenRPC method
+type OpenRPCContentDescriptor struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Required    bool          `json:"required,omitempty"`
+	Schema      OpenRPCSchema `json:"schema"`
+}
+
+// OpenRPCSchema defines the JSON schema of an OpenRPC content descriptor
+type OpenRPCSchema struct {
+	Type string `json:"type,omitempty"`
+}
+
+// GenerateOpenRPCDocument generates the OpenRPC document of the provided methods, including the rpc.discover one
+func GenerateOpenRPCDocument(title string, version string, methods []*MethodDescriptor) *OpenRPCDocument {
+	document := &OpenRPCDocument{
+		OpenRPC: OpenRPCVersion,
+		Info: OpenRPCInfo{
+			Title:   title,
+			Version: version,
+		},
+		Methods: make([]*OpenRPCMethod, 0, len(methods)+1),
+	}
+
+	for _, method := range methods {
+		if method == nil {
+			continue
+		}
+
+		openRPCMethod := &OpenRPCMethod{
+			Name:           method.Name,
+			Summary:        method.Summary,
+			Description:    method.Description,
+			ParamStructure: "either",
+			Params:         make([]*OpenRPCContentDescriptor, 0, len(method.Params)),
+			Result: &OpenRPCContentDescriptor{
+				Name:   method.ResultName,
+				Schema: OpenRPCSchema{Type: string(TypeObject)},
+			},
+		}
+		for _, param := range method.Params {
+			openRPCMethod.Params = append(openRPCMethod.Params, &OpenRPCContentDescriptor{
+				Name:        param.Name,
+				Description: param.Description,
+				Required:    param.Required,
+				Schema:      OpenRPCSchema{Type: string(param.Type)},
+			})
+		}
+
+		document.Methods = append(document.Methods, openRPCMethod)
+	}
+
+	document.Methods = append(document.Methods, &OpenRPCMethod{
+		Name:           DiscoverMethod,
+		Summary:        "returns the OpenRPC document describing the served methods",
+		ParamStructure: "either",
+		Params:         make([]*OpenRPCContentDescriptor, 0),
+		Result: &OpenRPCContentDescriptor{
+			Name:   "openrpcDocument",
+			Schema: OpenRPCSchema{Type: string(TypeObject)},
+		},
+	})
+
+	return document
+}
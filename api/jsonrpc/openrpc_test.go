@@ -0,0 +1,34 @@
+package jsonrpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateOpenRPCDocument(t *testing.T) {
+	t.Parallel()
+
+	methods := createTestMethods()
+	methods[0].Summary = "returns the balance"
+	methods = append(methods, nil)
+
+	document := GenerateOpenRPCDocument("title", "1.0.0", methods)
+	require.Equal(t, OpenRPCVersion, document.OpenRPC)
+	require.Equal(t, OpenRPCInfo{Title: "title", Version: "1.0.0"}, document.Info)
+	require.Len(t, document.Methods, 3)
+
+	require.Equal(t, &OpenRPCMethod{
+		Name:           "address_getBalance",
+		Summary:        "returns the balance",
+		ParamStructure: "either",
+		Params: []*OpenRPCContentDescriptor{
+			{Name: "address", Required: true, Schema: OpenRPCSchema{Type: "string"}},
+			{Name: "blockNonce", Schema: OpenRPCSchema{Type: "integer"}},
+			{Name: "onFinalBlock", Schema: OpenRPCSchema{Type: "boolean"}},
+		},
+		Result: &OpenRPCContentDescriptor{Name: "balance", Schema: OpenRPCSchema{Type: "object"}},
+	}, document.Methods[0])
+	require.Equal(t, "transaction_send", document.Methods[1].Name)
+	require.Equal(t, DiscoverMethod, document.Methods[2].Name)
+}
@@ -0,0 +1,88 @@
+package jsonrpc
+
+import "encoding/json"
+
+// Version is the JSON-RPC protocol version handled by the dispatcher
+const Version = "2.0"
+
+// the error codes defined by the JSON-RPC 2.0 specification, along with the server errors used by the dispatcher
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeServerError    = -32000
+	CodeServerBusy     = -32001
+	CodeUnauthorized   = -32002
+	CodeForbidden      = -32003
+)
+
+// ParamLocation defines where a method parameter is placed in the underlying REST request
+type ParamLocation string
+
+const (
+	// ParamInPath marks a parameter that replaces the :name segment of the REST path
+	ParamInPath ParamLocation = "path"
+	// ParamInQuery marks a parameter sent as an URL query parameter of the REST request
+	ParamInQuery ParamLocation = "query"
+	// ParamInBody marks a parameter sent as the JSON body of the REST request
+	ParamInBody ParamLocation = "body"
+)
+
+// ParamType defines the JSON schema type of a method parameter
+type ParamType string
+
+const (
+	// TypeString defines a string parameter
+	TypeString ParamType = "string"
+	// TypeInteger defines an integer parameter
+	TypeInteger ParamType = "integer"
+	// TypeBoolean defines a boolean parameter
+	TypeBoolean ParamType = "boolean"
+	// TypeObject defines an object parameter
+	TypeObject ParamType = "object"
+)
+
+// ParamDescriptor describes a parameter of a JSON-RPC method
+type ParamDescriptor struct {
+	Name        string
+	Description string
+	Type        ParamType
+	Location    ParamLocation
+	Required    bool
+}
+
+// MethodDescriptor describes a JSON-RPC method and the REST endpoint it is mapped onto
+type MethodDescriptor struct {
+	Name        string
+	Summary     string
+	HTTPMethod  string
+	Path        string
+	Params      []ParamDescriptor
+	ResultName  string
+	Description string
+}
+
+// Request defines a JSON-RPC request. A request without an id is a notification
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response defines a JSON-RPC response
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// Error defines a JSON-RPC error object
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
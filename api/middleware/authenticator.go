@@ -0,0 +1,256 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	// APIKeyHeader is the header holding the API key of the client
+	APIKeyHeader = "X-API-Key"
+
+	// ClientNameContextKey is the gin context key holding the name of the authenticated client
+	ClientNameContextKey = "apiClientName"
+
+	// ClientRoleContextKey is the gin context key holding the role of the authenticated client
+	ClientRoleContextKey = "apiClientRole"
+
+	bearerPrefix = "Bearer "
+)
+
+type apiClient struct {
+	name        string
+	role        string
+	maxRequests uint32
+}
+
+type restrictedRoute struct {
+	path  string
+	roles map[string]struct{}
+}
+
+// authenticator is a middleware which identifies the API clients by their API keys or JSON web tokens, enforcing
+// the per client quotas and the roles required by the restricted routes
+type authenticator struct {
+	allowAnonymous   bool
+	apiKeys          map[string]*apiClient
+	jwt              *jwtVerifier
+	jwtRoleClaim     string
+	jwtMaxRequests   uint32
+	restrictedRoutes []*restrictedRoute
+
+	mutRequests    sync.Mutex
+	clientRequests map[string]uint32
+}
+
+// NewAuthenticator creates a new instance of an authenticator
+func NewAuthenticator(cfg config.ApiAuthenticationConfig) (*authenticator, error) {
+	if cfg.QuotaResetIntervalInSec == 0 {
+		return nil, ErrInvalidQuotaResetInterval
+	}
+
+	a := &authenticator{
+		allowAnonymous: cfg.AllowAnonymous,
+		apiKeys:        make(map[string]*apiClient, len(cfg.APIKeys)),
+		jwtRoleClaim:   cfg.JWT.RoleClaim,
+		jwtMaxRequests: cfg.JWT.MaxRequests,
+		clientRequests: make(map[string]uint32),
+	}
+
+	for _, key := range cfg.APIKeys {
+		err := a.addAPIKey(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.JWT.Enabled {
+		if len(cfg.JWT.Secret) == 0 {
+			return nil, ErrEmptyJWTSecret
+		}
+		a.jwt = &jwtVerifier{
+			secret:   []byte(cfg.JWT.Secret),
+			issuer:   cfg.JWT.Issuer,
+			audience: cfg.JWT.Audience,
+			getTime:  time.Now,
+		}
+	}
+
+	for _, route := range cfg.RestrictedRoutes {
+		if !strings.HasPrefix(route.Path, "/") || len(route.Roles) == 0 {
+			return nil, fmt.Errorf("%w for path %s", ErrInvalidRestrictedRoute, route.Path)
+		}
+
+		roles := make(map[string]struct{}, len(route.Roles))
+		for _, role := range route.Roles {
+			roles[role] = struct{}{}
+		}
+		a.restrictedRoutes = append(a.restrictedRoutes, &restrictedRoute{
+			path:  strings.TrimSuffix(route.Path, "/"),
+			roles: roles,
+		})
+	}
+
+	return a, nil
+}
+
+func (a *authenticator) addAPIKey(key config.ApiKeyConfig) error {
+	if len(key.Name) == 0 {
+		return ErrEmptyAPIKeyName
+	}
+
+	keyHash, err := hex.DecodeString(key.KeyHash)
+	if err != nil || len(keyHash) != sha256.Size {
+		return fmt.Errorf("%w for key %s", ErrInvalidAPIKeyHash, key.Name)
+	}
+
+	normalizedHash := hex.EncodeToString(keyHash)
+	_, exists := a.apiKeys[normalizedHash]
+	if exists {
+		return fmt.Errorf("%w for key %s", ErrDuplicatedAPIKey, key.Name)
+	}
+
+	a.apiKeys[normalizedHash] = &apiClient{
+		name:        key.Name,
+		role:        key.Role,
+		maxRequests: key.MaxRequests,
+	}
+
+	return nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (a *authenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client, err := a.identifyClient(c.Request)
+		if err != nil {
+			abortWithError(c, http.StatusUnauthorized, err, shared.ReturnCodeRequestError)
+			return
+		}
+		if client == nil && !a.allowAnonymous {
+			abortWithError(c, http.StatusUnauthorized, ErrMissingCredentials, shared.ReturnCodeRequestError)
+			return
+		}
+
+		if !a.isAllowed(client, c.Request.URL.Path) {
+			abortWithError(c, http.StatusForbidden, ErrForbidden, shared.ReturnCodeRequestError)
+			return
+		}
+
+		if client == nil {
+			c.Next()
+			return
+		}
+
+		if a.isQuotaReached(client) {
+			err = fmt.Errorf("%w for client %s", ErrTooManyRequests, client.name)
+			abortWithError(c, http.StatusTooManyRequests, err, shared.ReturnCodeSystemBusy)
+			return
+		}
+
+		c.Set(ClientNameContextKey, client.name)
+		c.Set(ClientRoleContextKey, client.role)
+		c.Next()
+	}
+}
+
+// identifyClient returns the client identified by the request credentials or nil if the request holds no credentials
+func (a *authenticator) identifyClient(request *http.Request) (*apiClient, error) {
+	apiKey := request.Header.Get(APIKeyHeader)
+	if len(apiKey) > 0 {
+		keyHash := sha256.Sum256([]byte(apiKey))
+		client, ok := a.apiKeys[hex.EncodeToString(keyHash[:])]
+		if !ok {
+			return nil, ErrInvalidAPIKey
+		}
+
+		return client, nil
+	}
+
+	authorization := request.Header.Get("Authorization")
+	if a.jwt == nil || !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, nil
+	}
+
+	claims, err := a.jwt.verify(strings.TrimPrefix(authorization, bearerPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	subject, _ := claims["sub"].(string)
+	role, _ := claims[a.jwtRoleClaim].(string)
+
+	return &apiClient{
+		name:        "jwt:" + subject,
+		role:        role,
+		maxRequests: a.jwtMaxRequests,
+	}, nil
+}
+
+func (a *authenticator) isAllowed(client *apiClient, path string) bool {
+	for _, route := range a.restrictedRoutes {
+		isRestricted := path == route.path || strings.HasPrefix(path, route.path+"/")
+		if !isRestricted {
+			continue
+		}
+		if client == nil {
+			return false
+		}
+
+		_, hasRole := route.roles[client.role]
+		if !hasRole {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a *authenticator) isQuotaReached(client *apiClient) bool {
+	if client.maxRequests == 0 {
+		return false
+	}
+
+	a.mutRequests.Lock()
+	defer a.mutRequests.Unlock()
+
+	requests := a.clientRequests[client.name]
+	if requests >= client.maxRequests {
+		return true
+	}
+	a.clientRequests[client.name]++
+
+	return false
+}
+
+// Reset resets all accumulated per client counters
+func (a *authenticator) Reset() {
+	a.mutRequests.Lock()
+	a.clientRequests = make(map[string]uint32)
+	a.mutRequests.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *authenticator) IsInterfaceNil() bool {
+	return a == nil
+}
+
+func abortWithError(c *gin.Context, status int, err error, code shared.ReturnCode) {
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  code,
+		},
+	)
+}
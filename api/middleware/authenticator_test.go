@@ -0,0 +1,309 @@
+package middleware_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminKey  = "admin-secret-key"
+	userKey   = "user-secret-key"
+	jwtSecret = "jwt-secret"
+)
+
+func hashKey(key string) string {
+	keyHash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(keyHash[:])
+}
+
+func createAuthenticationConfig() config.ApiAuthenticationConfig {
+	return config.ApiAuthenticationConfig{
+		Enabled:                 true,
+		AllowAnonymous:          true,
+		QuotaResetIntervalInSec: 60,
+		APIKeys: []config.ApiKeyConfig{
+			{Name: "admin", KeyHash: hashKey(adminKey), Role: "admin"},
+			{Name: "user", KeyHash: hashKey(userKey), Role: "user", MaxRequests: 2},
+		},
+		JWT: config.ApiJWTConfig{
+			Enabled:     true,
+			Secret:      jwtSecret,
+			Issuer:      "issuer",
+			Audience:    "observers",
+			RoleClaim:   "role",
+			MaxRequests: 1,
+		},
+		RestrictedRoutes: []config.ApiRestrictedRouteConfig{
+			{Path: "/node/debug", Roles: []string{"admin"}},
+			{Path: "/hardfork", Roles: []string{"admin"}},
+		},
+	}
+}
+
+func createToken(secret string, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func createValidClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":  "explorer",
+		"iss":  "issuer",
+		"aud":  []string{"observers"},
+		"role": "user",
+		"exp":  time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func startNodeServerAuthenticator(t *testing.T, cfg config.ApiAuthenticationConfig) (*gin.Engine, reseter) {
+	authenticator, err := middleware.NewAuthenticator(cfg)
+	require.Nil(t, err)
+
+	ws := gin.New()
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"client": c.GetString(middleware.ClientNameContextKey)})
+	}
+	ws.GET("/node/status", handler)
+	ws.GET("/node/debug", handler)
+	ws.POST("/hardfork/trigger", handler)
+
+	return ws, authenticator
+}
+
+func doAuthenticatedRequest(ws *gin.Engine, method string, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid quota reset interval should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.QuotaResetIntervalInSec = 0
+
+		a, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(a))
+		assert.Equal(t, middleware.ErrInvalidQuotaResetInterval, err)
+	})
+	t.Run("empty API key name should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.APIKeys[0].Name = ""
+
+		a, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(a))
+		assert.Equal(t, middleware.ErrEmptyAPIKeyName, err)
+	})
+	t.Run("invalid API key hash should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.APIKeys[0].KeyHash = "abcd"
+
+		a, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(a))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidAPIKeyHash))
+	})
+	t.Run("duplicated API key should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.APIKeys[1].KeyHash = cfg.APIKeys[0].KeyHash
+
+		a, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(a))
+		assert.True(t, errors.Is(err, middleware.ErrDuplicatedAPIKey))
+	})
+	t.Run("empty JWT secret should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.JWT.Secret = ""
+
+		a, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(a))
+		assert.Equal(t, middleware.ErrEmptyJWTSecret, err)
+	})
+	t.Run("invalid restricted route should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.RestrictedRoutes[0].Roles = nil
+
+		a, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(a))
+		assert.True(t, errors.Is(err, middleware.ErrInvalidRestrictedRoute))
+	})
+	t.Run("should work", func(t *testing.T) {
+		a, err := middleware.NewAuthenticator(createAuthenticationConfig())
+		assert.False(t, check.IfNil(a))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAuthenticator_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerAuthenticator(t, createAuthenticationConfig())
+
+	resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", map[string]string{middleware.APIKeyHeader: adminKey})
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"client":"admin"`)
+
+	resp = doAuthenticatedRequest(ws, http.MethodGet, "/node/status", map[string]string{middleware.APIKeyHeader: "wrong key"})
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Contains(t, resp.Body.String(), middleware.ErrInvalidAPIKey.Error())
+}
+
+func TestAuthenticator_AnonymousRequests(t *testing.T) {
+	t.Parallel()
+
+	t.Run("allowed anonymous should serve the not restricted routes", func(t *testing.T) {
+		ws, _ := startNodeServerAuthenticator(t, createAuthenticationConfig())
+
+		resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		resp = doAuthenticatedRequest(ws, http.MethodGet, "/node/debug", nil)
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+	})
+	t.Run("not allowed anonymous should error", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.AllowAnonymous = false
+		ws, _ := startNodeServerAuthenticator(t, cfg)
+
+		resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Contains(t, resp.Body.String(), middleware.ErrMissingCredentials.Error())
+	})
+}
+
+func TestAuthenticator_RestrictedRoutes(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerAuthenticator(t, createAuthenticationConfig())
+
+	resp := doAuthenticatedRequest(ws, http.MethodPost, "/hardfork/trigger", map[string]string{middleware.APIKeyHeader: userKey})
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.Contains(t, resp.Body.String(), string(shared.ReturnCodeRequestError))
+
+	resp = doAuthenticatedRequest(ws, http.MethodPost, "/hardfork/trigger", map[string]string{middleware.APIKeyHeader: adminKey})
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = doAuthenticatedRequest(ws, http.MethodGet, "/node/debug", map[string]string{middleware.APIKeyHeader: adminKey})
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestAuthenticator_QuotaAndReset(t *testing.T) {
+	t.Parallel()
+
+	ws, resetHandler := startNodeServerAuthenticator(t, createAuthenticationConfig())
+	headers := map[string]string{middleware.APIKeyHeader: userKey}
+
+	for i := 0; i < 2; i++ {
+		resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", headers)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+	resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", headers)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Contains(t, resp.Body.String(), string(shared.ReturnCodeSystemBusy))
+
+	// the keys without quota are not limited
+	for i := 0; i < 5; i++ {
+		resp = doAuthenticatedRequest(ws, http.MethodGet, "/node/status", map[string]string{middleware.APIKeyHeader: adminKey})
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	resetHandler.Reset()
+	resp = doAuthenticatedRequest(ws, http.MethodGet, "/node/status", headers)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestAuthenticator_JWT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid token should work", func(t *testing.T) {
+		ws, _ := startNodeServerAuthenticator(t, createAuthenticationConfig())
+		headers := map[string]string{"Authorization": "Bearer " + createToken(jwtSecret, createValidClaims())}
+
+		resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", headers)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Contains(t, resp.Body.String(), `"client":"jwt:explorer"`)
+
+		resp = doAuthenticatedRequest(ws, http.MethodGet, "/node/status", headers)
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+	t.Run("role claim should be used by the restricted routes", func(t *testing.T) {
+		ws, _ := startNodeServerAuthenticator(t, createAuthenticationConfig())
+
+		claims := createValidClaims()
+		resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/debug",
+			map[string]string{"Authorization": "Bearer " + createToken(jwtSecret, claims)})
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+
+		claims["role"] = "admin"
+		resp = doAuthenticatedRequest(ws, http.MethodGet, "/node/debug",
+			map[string]string{"Authorization": "Bearer " + createToken(jwtSecret, claims)})
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("invalid tokens should error", func(t *testing.T) {
+		ws, _ := startNodeServerAuthenticator(t, createAuthenticationConfig())
+
+		expired := createValidClaims()
+		expired["exp"] = time.Now().Add(-time.Minute).Unix()
+		notYetValid := createValidClaims()
+		notYetValid["nbf"] = time.Now().Add(time.Hour).Unix()
+		wrongIssuer := createValidClaims()
+		wrongIssuer["iss"] = "other"
+		wrongAudience := createValidClaims()
+		wrongAudience["aud"] = "other"
+		missingSubject := createValidClaims()
+		delete(missingSubject, "sub")
+
+		tokens := []string{
+			"not a token",
+			createToken("wrong secret", createValidClaims()),
+			createToken(jwtSecret, expired),
+			createToken(jwtSecret, notYetValid),
+			createToken(jwtSecret, wrongIssuer),
+			createToken(jwtSecret, wrongAudience),
+			createToken(jwtSecret, missingSubject),
+		}
+		for _, token := range tokens {
+			resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", map[string]string{"Authorization": "Bearer " + token})
+			assert.Equal(t, http.StatusUnauthorized, resp.Code, token)
+			assert.Contains(t, resp.Body.String(), middleware.ErrInvalidToken.Error())
+		}
+	})
+	t.Run("disabled JWT should treat the bearer token as anonymous", func(t *testing.T) {
+		cfg := createAuthenticationConfig()
+		cfg.JWT.Enabled = false
+		ws, _ := startNodeServerAuthenticator(t, cfg)
+
+		headers := map[string]string{"Authorization": "Bearer " + createToken(jwtSecret, createValidClaims())}
+		resp := doAuthenticatedRequest(ws, http.MethodGet, "/node/status", headers)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Contains(t, resp.Body.String(), `"client":""`)
+	})
+}
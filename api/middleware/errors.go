@@ -7,3 +7,39 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidClientAddress signals that the client address could not be extracted from the request
+var ErrInvalidClientAddress = errors.New("invalid client address")
+
+// ErrEmptyAPIKeyName signals that an API key with an empty name was provided
+var ErrEmptyAPIKeyName = errors.New("empty API key name")
+
+// ErrInvalidAPIKeyHash signals that an invalid API key hash was provided
+var ErrInvalidAPIKeyHash = errors.New("invalid API key hash, expected the hex encoded sha256 of the key")
+
+// ErrDuplicatedAPIKey signals that the same API key was provided more than once
+var ErrDuplicatedAPIKey = errors.New("duplicated API key")
+
+// ErrEmptyJWTSecret signals that JWT authentication was enabled without a secret
+var ErrEmptyJWTSecret = errors.New("empty JWT secret")
+
+// ErrInvalidRestrictedRoute signals that an invalid restricted route was provided
+var ErrInvalidRestrictedRoute = errors.New("invalid restricted route")
+
+// ErrInvalidQuotaResetInterval signals that an invalid quota reset interval was provided
+var ErrInvalidQuotaResetInterval = errors.New("invalid quota reset interval")
+
+// ErrMissingCredentials signals that the request does not hold any credentials
+var ErrMissingCredentials = errors.New("missing credentials")
+
+// ErrInvalidAPIKey signals that the provided API key is not accepted
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// ErrInvalidToken signals that the provided JSON web token is not valid
+var ErrInvalidToken = errors.New("invalid token")
+
+// ErrForbidden signals that the client is not allowed to access the requested route
+var ErrForbidden = errors.New("forbidden")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry has been provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
@@ -16,18 +16,27 @@ var log = logger.GetOrCreate("api/middleware")
 // globalThrottler is a middleware global limiter used to limit total number of simultaneous requests
 type globalThrottler struct {
 	queue            chan struct{}
+	excludedPaths    map[string]struct{}
 	mutDebugRequests sync.Mutex
 	debugRequests    map[string]int
 }
 
-// NewGlobalThrottler creates a new instance of a globalThrottler
-func NewGlobalThrottler(maxConnections uint32) (*globalThrottler, error) {
+// NewGlobalThrottler creates a new instance of a globalThrottler. The requests on the excluded paths are not limited,
+// as they open long-lived connections which are limited by the components serving them (e.g. the events subscriptions)
+// and would otherwise hold a slot for their whole lifetime
+func NewGlobalThrottler(maxConnections uint32, excludedPaths ...string) (*globalThrottler, error) {
 	if maxConnections == 0 {
 		return nil, ErrInvalidMaxNumRequests
 	}
 
+	excluded := make(map[string]struct{}, len(excludedPaths))
+	for _, path := range excludedPaths {
+		excluded[path] = struct{}{}
+	}
+
 	return &globalThrottler{
 		queue:         make(chan struct{}, maxConnections),
+		excludedPaths: excluded,
 		debugRequests: make(map[string]int),
 	}, nil
 }
@@ -36,6 +45,11 @@ func NewGlobalThrottler(maxConnections uint32) (*globalThrottler, error) {
 func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		path := c.Request.URL.Path
+		_, isExcluded := gt.excludedPaths[path]
+		if isExcluded {
+			c.Next()
+			return
+		}
 
 		select {
 		case gt.queue <- struct{}{}:
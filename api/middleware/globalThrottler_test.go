@@ -112,3 +112,31 @@ func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestGlobalThrottler_ExcludedPathShouldNotHoldASlot(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	chExcludedStarted := make(chan struct{})
+	ws := gin.New()
+	globalThrottler, _ := middleware.NewGlobalThrottler(1, "/events/subscribe")
+	ws.Use(globalThrottler.MiddlewareHandlerFunc())
+	ws.GET("/events/subscribe", func(c *gin.Context) {
+		close(chExcludedStarted)
+		<-chRelease
+	})
+	ws.GET("/address/:address/balance", func(c *gin.Context) {})
+
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "/events/subscribe", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+	<-chExcludedStarted
+	defer close(chRelease)
+
+	req, _ := http.NewRequest(http.MethodGet, "/address/testAddress/balance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
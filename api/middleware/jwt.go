@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const jwtAlgorithmHS256 = "HS256"
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+}
+
+// jwtVerifier checks the HS256 signed JSON web tokens and returns their claims
+type jwtVerifier struct {
+	secret   []byte
+	issuer   string
+	audience string
+	getTime  func() time.Time
+}
+
+func (jv *jwtVerifier) verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	header := &jwtHeader{}
+	err := decodeJWTPart(parts[0], header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err.Error())
+	}
+	if header.Algorithm != jwtAlgorithmHS256 {
+		return nil, fmt.Errorf("%w: unsupported algorithm %s", ErrInvalidToken, header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err.Error())
+	}
+	mac := hmac.New(sha256.New, jv.secret)
+	_, _ = mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, fmt.Errorf("%w: invalid signature", ErrInvalidToken)
+	}
+
+	claims := make(map[string]interface{})
+	err = decodeJWTPart(parts[1], &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err.Error())
+	}
+
+	err = jv.checkClaims(claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err.Error())
+	}
+
+	return claims, nil
+}
+
+func (jv *jwtVerifier) checkClaims(claims map[string]interface{}) error {
+	now := jv.getTime().Unix()
+	expiration, ok := claims["exp"].(float64)
+	if ok && now >= int64(expiration) {
+		return fmt.Errorf("token expired")
+	}
+	notBefore, ok := claims["nbf"].(float64)
+	if ok && now < int64(notBefore) {
+		return fmt.Errorf("token not valid yet")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if len(subject) == 0 {
+		return fmt.Errorf("missing sub claim")
+	}
+	if len(jv.issuer) > 0 && claims["iss"] != jv.issuer {
+		return fmt.Errorf("invalid iss claim")
+	}
+	if len(jv.audience) > 0 && !hasAudience(claims["aud"], jv.audience) {
+		return fmt.Errorf("invalid aud claim")
+	}
+
+	return nil
+}
+
+// hasAudience checks the aud claim, which can be either a string or an array of strings
+func hasAudience(claim interface{}, audience string) bool {
+	switch value := claim.(type) {
+	case string:
+		return value == audience
+	case []interface{}:
+		for _, item := range value {
+			if item == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func decodeJWTPart(part string, value interface{}) error {
+	buff, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, value)
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	apiRequestsMetric        = "erd_api_requests_total"
+	apiRequestDurationMetric = "erd_api_request_duration_seconds"
+	unmatchedEndpoint        = "unmatched"
+)
+
+type metricsMiddleware struct {
+	requests        common.CounterMetric
+	requestDuration common.HistogramMetric
+	endpointPrefix  string
+}
+
+// NewMetricsMiddleware returns a new instance of metricsMiddleware, which counts the requests and measures their
+// latency, by endpoint. The endpoint is the registered route (e.g. /address/:address) so the labels values are bounded
+func NewMetricsMiddleware(registry common.MetricsRegistry) (*metricsMiddleware, error) {
+	if check.IfNil(registry) {
+		return nil, ErrNilMetricsRegistry
+	}
+
+	requests, err := registry.RegisterCounter(
+		apiRequestsMetric,
+		"Number of API requests, by endpoint, method and response status code",
+		"endpoint", "method", "result",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	requestDuration, err := registry.RegisterHistogram(
+		apiRequestDurationMetric,
+		"Time spent serving the API requests, by endpoint and method",
+		nil,
+		"endpoint", "method",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metricsMiddleware{
+		requests:        requests,
+		requestDuration: requestDuration,
+	}, nil
+}
+
+// WithEndpointPrefix returns a metrics middleware updating the same metrics, which prefixes the endpoints with the
+// provided value. It is used for the JSON-RPC calls, so they are not mistaken for the REST requests they are served by
+func (mm *metricsMiddleware) WithEndpointPrefix(prefix string) *metricsMiddleware {
+	return &metricsMiddleware{
+		requests:        mm.requests,
+		requestDuration: mm.requestDuration,
+		endpointPrefix:  prefix,
+	}
+}
+
+// MiddlewareHandlerFunc updates the request metrics after the request was served
+func (mm *metricsMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startTime := time.Now()
+		c.Next()
+
+		endpoint := c.FullPath()
+		if len(endpoint) == 0 {
+			endpoint = unmatchedEndpoint
+		}
+		endpoint = mm.endpointPrefix + endpoint
+		method := c.Request.Method
+
+		mm.requestDuration.Observe(time.Since(startTime).Seconds(), endpoint, method)
+		mm.requests.Add(1, endpoint, method, strconv.Itoa(c.Writer.Status()))
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mm *metricsMiddleware) IsInterfaceNil() bool {
+	return mm == nil
+}
@@ -0,0 +1,98 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/statusHandler/prometheusMetrics"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		mm, err := middleware.NewMetricsMiddleware(nil)
+		assert.Equal(t, middleware.ErrNilMetricsRegistry, err)
+		assert.True(t, check.IfNil(mm))
+	})
+	t.Run("registration error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		registry := &testscommon.MetricsRegistryStub{
+			RegisterHistogramCalled: func(name string, help string, buckets []float64, labelNames ...string) (common.HistogramMetric, error) {
+				return nil, expectedErr
+			},
+		}
+		mm, err := middleware.NewMetricsMiddleware(registry)
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, check.IfNil(mm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mm, err := middleware.NewMetricsMiddleware(&testscommon.MetricsRegistryStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(mm))
+	})
+}
+
+func TestMetricsMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheusMetrics.NewPrometheusRegistry()
+	mm, _ := middleware.NewMetricsMiddleware(registry)
+
+	ws := gin.New()
+	ws.Use(mm.MiddlewareHandlerFunc())
+	ws.GET("/address/:address", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	for _, path := range []string{"/address/erd1a", "/address/erd1b", "/missing"} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+	}
+
+	metrics, err := registry.PrometheusString()
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(metrics, `erd_api_requests_total{endpoint="/address/:address",method="GET",result="200"} 2`))
+	assert.True(t, strings.Contains(metrics, `erd_api_requests_total{endpoint="unmatched",method="GET",result="404"} 1`))
+	assert.True(t, strings.Contains(metrics, `erd_api_request_duration_seconds_count{endpoint="/address/:address",method="GET"} 2`))
+}
+
+func TestMetricsMiddleware_WithEndpointPrefix(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheusMetrics.NewPrometheusRegistry()
+	mm, _ := middleware.NewMetricsMiddleware(registry)
+	prefixed := mm.WithEndpointPrefix("/rpc/jsonrpc")
+	assert.False(t, check.IfNil(prefixed))
+
+	ws := gin.New()
+	ws.Use(prefixed.MiddlewareHandlerFunc())
+	ws.GET("/address/:address", func(c *gin.Context) {
+		c.JSON(http.StatusOK, nil)
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/address/erd1a", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	metrics, err := registry.PrometheusString()
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(metrics, `erd_api_requests_total{endpoint="/rpc/jsonrpc/address/:address",method="GET",result="200"} 1`))
+}
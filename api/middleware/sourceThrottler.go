@@ -2,7 +2,6 @@ package middleware
 
 import (
 	"fmt"
-	"net"
 	"net/http"
 	"sync"
 
@@ -33,13 +32,14 @@ func NewSourceThrottler(maxNumRequests uint32) (*sourceThrottler, error) {
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
-		if err != nil {
+		// the client IP is read from the X-Forwarded-For header only if the request comes from a trusted proxy
+		remoteAddr := c.ClientIP()
+		if len(remoteAddr) == 0 {
 			c.AbortWithStatusJSON(
 				http.StatusInternalServerError,
 				shared.GenericAPIResponse{
 					Data:  nil,
-					Error: err.Error(),
+					Error: fmt.Sprintf("%s: %s", ErrInvalidClientAddress.Error(), c.Request.RemoteAddr),
 					Code:  shared.ReturnCodeInternalError,
 				},
 			)
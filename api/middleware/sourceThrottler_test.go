@@ -132,3 +132,31 @@ func makeRequestSourceThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestSourceThrottler_ShouldUseForwardedForOnlyFromTrustedProxies(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerSourceThrottler(func(c *gin.Context) {}, 1)
+	ws.RemoteIPHeaders = []string{"X-Forwarded-For"}
+	err := ws.SetTrustedProxies([]string{"10.0.0.1"})
+	assert.Nil(t, err)
+
+	doRequest := func(remoteAddr string, forwardedFor string) int {
+		req, _ := http.NewRequest("GET", "/address/testAddress/balance", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp.Code
+	}
+
+	// distinct clients behind the trusted proxy are throttled separately
+	assert.Equal(t, http.StatusOK, doRequest("10.0.0.1:8080", "1.1.1.1"))
+	assert.Equal(t, http.StatusOK, doRequest("10.0.0.1:8080", "2.2.2.2"))
+	assert.Equal(t, http.StatusTooManyRequests, doRequest("10.0.0.1:8080", "1.1.1.1"))
+
+	// the header is ignored when the request does not come from a trusted proxy
+	assert.Equal(t, http.StatusOK, doRequest("3.3.3.3:8080", "4.4.4.4"))
+	assert.Equal(t, http.StatusTooManyRequests, doRequest("3.3.3.3:8080", "5.5.5.5"))
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/common/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type tracingMiddleware struct{}
+
+// NewTracingMiddleware returns a new instance of tracingMiddleware
+func NewTracingMiddleware() *tracingMiddleware {
+	return &tracingMiddleware{}
+}
+
+// MiddlewareHandlerFunc starts the server span of each request, continuing the trace of the W3C traceparent header, if
+// any. The span is held by the request context, so the handlers can start its children
+func (tm *tracingMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+		route := c.FullPath()
+		spanName := fmt.Sprintf("HTTP %s", c.Request.Method)
+		if len(route) > 0 {
+			spanName = fmt.Sprintf("%s %s", c.Request.Method, route)
+		}
+
+		ctx, span := tracing.Tracer().Start(
+			ctx,
+			spanName,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethod(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.HTTPTarget(c.Request.URL.Path),
+				semconv.HTTPClientIP(c.ClientIP()),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tm *tracingMiddleware) IsInterfaceNil() bool {
+	return tm == nil
+}
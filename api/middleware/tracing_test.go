@@ -0,0 +1,72 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/common/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewTracingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tm := middleware.NewTracingMiddleware()
+	assert.False(t, check.IfNil(tm))
+}
+
+func TestTracingMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	// not parallel, as the tracer provider is set as the global one
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ws := gin.New()
+	ws.Use(middleware.NewTracingMiddleware().MiddlewareHandlerFunc())
+	ws.POST("/transaction/send", func(c *gin.Context) {
+		_, span := tracing.StartSpan(c.Request.Context(), "facade.SendBulkTransactions")
+		span.End()
+		c.JSON(http.StatusOK, nil)
+	})
+	ws.GET("/vm-values/:address", func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, nil)
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/transaction/send", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	child, server := spans[0], spans[1]
+	require.Equal(t, "POST /transaction/send", server.Name())
+	require.Equal(t, trace.SpanKindServer, server.SpanKind())
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext().TraceID().String())
+	require.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+	require.Equal(t, codes.Unset, server.Status().Code)
+	require.Equal(t, server.SpanContext().SpanID(), child.Parent().SpanID())
+
+	req, _ = http.NewRequest(http.MethodGet, "/vm-values/erd1", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	spans = recorder.Ended()
+	require.Len(t, spans, 3)
+	require.Equal(t, "GET /vm-values/:address", spans[2].Name())
+	require.False(t, spans[2].Parent().IsValid())
+	require.Equal(t, codes.Error, spans[2].Status().Code)
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 
@@ -25,6 +26,19 @@ type FacadeStub struct {
 	ShouldErrorStart                            bool
 	ShouldErrorStop                             bool
 	GetHeartbeatsHandler                        func() ([]data.PubKeyHeartbeat, error)
+	GetHeartbeatHistoryCalled                   func(pubKey string) (*data.PubKeyHeartbeatHistory, error)
+	GetP2PMessageTracesCalled                   func() ([]*common.P2PMessageTrace, error)
+	GetGuardianRegistrationChallengeCalled      func(address string) (*common.GuardianRegistrationChallenge, error)
+	RegisterGuardedAccountCalled                func(address string, signature string) (*common.GuardianRegistration, error)
+	VerifyGuardianCodeCalled                    func(address string, code string) error
+	SetGuardianSpendingPolicyCalled             func(address string, code string, policy *common.GuardianSpendingPolicy) error
+	CoSignTransactionCalled                     func(tx *transaction.Transaction, code string) ([]byte, error)
+	GetGovernanceProposalsCalled                func() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposalCalled                 func(nonce uint64) (*common.GovernanceProposal, error)
+	GetGovernanceProposalVotesCalled            func(nonce uint64) (*common.GovernanceProposalVotes, error)
+	SubscribeToEventsCalled                     func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	SubscribeP2PMessageTracesCalled             func() (<-chan *common.P2PMessageTrace, func(), error)
+	GetUptimeCalled                             func(epoch uint32) ([]data.PubKeyUptime, error)
 	GetBalanceCalled                            func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
 	GetAccountCalled                            func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
 	GetAccountsCalled                           func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
@@ -36,20 +50,25 @@ type FacadeStub struct {
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
+	MetricsRegistryHandler                      func() common.MetricsRegistry
 	ValidatorStatisticsHandler                  func() (map[string]*validator.ValidatorStatistics, error)
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	NodeConfigCalled                            func() map[string]interface{}
 	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                        func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetGuardianDataCalled                       func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetworkCalled func() (string, error)
+	GetPeersReputationCalled                    func() ([]*common.PeerReputationAPIResponse, error)
 	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecutionHandler            func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	ReplayTransactionHandler                    func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsSequenceCalled          func(txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -97,6 +116,7 @@ type FacadeStub struct {
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
+	GetValidatorsPerformanceCalled              func(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error)
 	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
 }
 
@@ -214,6 +234,123 @@ func (f *FacadeStub) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return nil, nil
 }
 
+// GetHeartbeatHistory -
+func (f *FacadeStub) GetHeartbeatHistory(pubKey string) (*data.PubKeyHeartbeatHistory, error) {
+	if f.GetHeartbeatHistoryCalled != nil {
+		return f.GetHeartbeatHistoryCalled(pubKey)
+	}
+
+	return nil, nil
+}
+
+// GetP2PMessageTraces -
+func (f *FacadeStub) GetP2PMessageTraces() ([]*common.P2PMessageTrace, error) {
+	if f.GetP2PMessageTracesCalled != nil {
+		return f.GetP2PMessageTracesCalled()
+	}
+
+	return nil, nil
+}
+
+// SubscribeP2PMessageTraces -
+func (f *FacadeStub) SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error) {
+	if f.SubscribeP2PMessageTracesCalled != nil {
+		return f.SubscribeP2PMessageTracesCalled()
+	}
+
+	return nil, func() {}, nil
+}
+
+// GetGuardianRegistrationChallenge -
+func (f *FacadeStub) GetGuardianRegistrationChallenge(address string) (*common.GuardianRegistrationChallenge, error) {
+	if f.GetGuardianRegistrationChallengeCalled != nil {
+		return f.GetGuardianRegistrationChallengeCalled(address)
+	}
+
+	return &common.GuardianRegistrationChallenge{}, nil
+}
+
+// RegisterGuardedAccount -
+func (f *FacadeStub) RegisterGuardedAccount(address string, signature string) (*common.GuardianRegistration, error) {
+	if f.RegisterGuardedAccountCalled != nil {
+		return f.RegisterGuardedAccountCalled(address, signature)
+	}
+
+	return &common.GuardianRegistration{}, nil
+}
+
+// VerifyGuardianCode -
+func (f *FacadeStub) VerifyGuardianCode(address string, code string) error {
+	if f.VerifyGuardianCodeCalled != nil {
+		return f.VerifyGuardianCodeCalled(address, code)
+	}
+
+	return nil
+}
+
+// SetGuardianSpendingPolicy -
+func (f *FacadeStub) SetGuardianSpendingPolicy(address string, code string, policy *common.GuardianSpendingPolicy) error {
+	if f.SetGuardianSpendingPolicyCalled != nil {
+		return f.SetGuardianSpendingPolicyCalled(address, code, policy)
+	}
+
+	return nil
+}
+
+// CoSignTransaction -
+func (f *FacadeStub) CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error) {
+	if f.CoSignTransactionCalled != nil {
+		return f.CoSignTransactionCalled(tx, code)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposal, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposalVotes -
+func (f *FacadeStub) GetGovernanceProposalVotes(nonce uint64) (*common.GovernanceProposalVotes, error) {
+	if f.GetGovernanceProposalVotesCalled != nil {
+		return f.GetGovernanceProposalVotesCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// SubscribeToEvents -
+func (f *FacadeStub) SubscribeToEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	if f.SubscribeToEventsCalled != nil {
+		return f.SubscribeToEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetUptime -
+func (f *FacadeStub) GetUptime(epoch uint32) ([]data.PubKeyUptime, error) {
+	if f.GetUptimeCalled != nil {
+		return f.GetUptimeCalled(epoch)
+	}
+
+	return nil, nil
+}
+
 // GetBalance is the mock implementation of a handler's GetBalance method
 func (f *FacadeStub) GetBalance(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error) {
 	if f.GetBalanceCalled != nil {
@@ -340,16 +477,43 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (f *FacadeStub) SimulateTransactionExecution(_ context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if f.SimulateTransactionExecutionHandler != nil {
-		return f.SimulateTransactionExecutionHandler(tx)
+		return f.SimulateTransactionExecutionHandler(tx, stateOverrides)
+	}
+
+	return nil, nil
+}
+
+// TraceTransactionExecution is the mock implementation of a handler's TraceTransactionExecution method
+func (f *FacadeStub) TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if f.TraceTransactionExecutionHandler != nil {
+		return f.TraceTransactionExecutionHandler(tx, stateOverrides)
+	}
+
+	return nil, nil
+}
+
+// ReplayTransaction is the mock implementation of a handler's ReplayTransaction method
+func (f *FacadeStub) ReplayTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if f.ReplayTransactionHandler != nil {
+		return f.ReplayTransactionHandler(txHash)
+	}
+
+	return nil, nil
+}
+
+// SimulateTransactionsSequence -
+func (f *FacadeStub) SimulateTransactionsSequence(_ context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+	if f.SimulateTransactionsSequenceCalled != nil {
+		return f.SimulateTransactionsSequenceCalled(txs, stateOverrides)
 	}
 
 	return nil, nil
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
-func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
+func (f *FacadeStub) SendBulkTransactions(_ context.Context, txs []*transaction.Transaction) (uint64, error) {
 	if f.SendBulkTransactionsHandler != nil {
 		return f.SendBulkTransactionsHandler(txs)
 	}
@@ -358,7 +522,7 @@ func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint6
 }
 
 // ValidateTransaction -
-func (f *FacadeStub) ValidateTransaction(tx *transaction.Transaction) error {
+func (f *FacadeStub) ValidateTransaction(_ context.Context, tx *transaction.Transaction) error {
 	if f.ValidateTransactionHandler != nil {
 		return f.ValidateTransactionHandler(tx)
 	}
@@ -393,8 +557,17 @@ func (f *FacadeStub) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse
 	return nil, nil
 }
 
+// GetValidatorsPerformance -
+func (f *FacadeStub) GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+	if f.GetValidatorsPerformanceCalled != nil {
+		return f.GetValidatorsPerformanceCalled(startEpoch, endEpoch)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
-func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+func (f *FacadeStub) ExecuteSCQuery(_ context.Context, query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
 	if f.ExecuteSCQueryHandler != nil {
 		return f.ExecuteSCQueryHandler(query)
 	}
@@ -411,6 +584,15 @@ func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	return nil
 }
 
+// MetricsRegistry is the mock implementation for the MetricsRegistry
+func (f *FacadeStub) MetricsRegistry() common.MetricsRegistry {
+	if f.MetricsRegistryHandler != nil {
+		return f.MetricsRegistryHandler()
+	}
+
+	return nil
+}
+
 // GetTotalStakedValue -
 func (f *FacadeStub) GetTotalStakedValue() (*api.StakeValues, error) {
 	if f.GetTotalStakedValueHandler != nil {
@@ -439,9 +621,9 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 }
 
 // ComputeTransactionGasLimit -
-func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (f *FacadeStub) ComputeTransactionGasLimit(_ context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
 	if f.ComputeTransactionGasLimitHandler != nil {
-		return f.ComputeTransactionGasLimitHandler(tx)
+		return f.ComputeTransactionGasLimitHandler(tx, stateOverrides)
 	}
 
 	return nil, nil
@@ -496,6 +678,15 @@ func (f *FacadeStub) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return "", nil
 }
 
+// GetPeersReputation -
+func (f *FacadeStub) GetPeersReputation() ([]*common.PeerReputationAPIResponse, error) {
+	if f.GetPeersReputationCalled != nil {
+		return f.GetPeersReputationCalled()
+	}
+
+	return make([]*common.PeerReputationAPIResponse, 0), nil
+}
+
 // GetEpochStartDataAPI -
 func (f *FacadeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	return f.GetEpochStartDataAPICalled(epoch)
@@ -0,0 +1,169 @@
+package openapi
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+// Version is the version of the OpenAPI specification the generated documents comply with
+const Version = "3.0.3"
+
+const jsonContentType = "application/json"
+
+// ArgsGenerateDocument holds the arguments needed to generate an OpenAPI document
+type ArgsGenerateDocument struct {
+	Title     string
+	Version   string
+	Groups    map[string]EndpointsHandler
+	APIConfig config.ApiRoutesConfig
+}
+
+// GenerateDocument generates the OpenAPI document of the endpoints opened in the provided API routes config. Each
+// operation is derived from the endpoint handler data: its description, path parameters and request/response samples
+func GenerateDocument(args ArgsGenerateDocument) *Document {
+	document := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   args.Title,
+			Version: args.Version,
+		},
+		Paths: make(map[string]PathItem),
+	}
+
+	groupNames := make([]string, 0, len(args.Groups))
+	for groupName := range args.Groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	for _, groupName := range groupNames {
+		group := args.Groups[groupName]
+		if group == nil {
+			continue
+		}
+
+		for _, endpoint := range group.GetEndpoints() {
+			if !IsEndpointOpen(args.APIConfig, groupName, endpoint.Path) {
+				continue
+			}
+
+			path, operation := createOperation(groupName, endpoint)
+			pathItem, ok := document.Paths[path]
+			if !ok {
+				pathItem = make(PathItem)
+				document.Paths[path] = pathItem
+			}
+			pathItem[strings.ToLower(endpoint.Method)] = operation
+		}
+	}
+
+	return document
+}
+
+// IsEndpointOpen returns true if the endpoint of the provided group is opened in the API routes config
+func IsEndpointOpen(apiConfig config.ApiRoutesConfig, groupName string, path string) bool {
+	group, ok := apiConfig.APIPackages[groupName]
+	if !ok {
+		return false
+	}
+
+	for _, route := range group.Routes {
+		if route.Name == path {
+			return route.Open
+		}
+	}
+
+	return false
+}
+
+func createOperation(groupName string, endpoint *shared.EndpointHandlerData) (string, *Operation) {
+	segments := strings.Split("/"+groupName+endpoint.Path, "/")
+	operationID := strings.ToLower(endpoint.Method)
+	parameters := make([]*Parameter, 0)
+	for idx, segment := range segments {
+		if !strings.HasPrefix(segment, ":") {
+			operationID += toIdentifier(segment)
+			continue
+		}
+
+		name := strings.TrimPrefix(segment, ":")
+		segments[idx] = "{" + name + "}"
+		isAlreadyNamed := idx > 0 && segments[idx-1] == "by-"+name
+		if !isAlreadyNamed {
+			operationID += "By" + toIdentifier(name)
+		}
+		parameters = append(parameters, &Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	operation := &Operation{
+		Tags:        []string{groupName},
+		Summary:     endpoint.Description,
+		OperationID: operationID,
+		Parameters:  parameters,
+		Responses: map[string]*Response{
+			"200": {
+				Description: "successful response",
+				Content:     jsonContent(envelopeSchema(SchemaOf(endpoint.ResponseData))),
+			},
+			"default": {
+				Description: "error response, holding the error message and the code",
+				Content:     jsonContent(envelopeSchema(&Schema{})),
+			},
+		},
+	}
+
+	hasBody := endpoint.Method == http.MethodPost || endpoint.Method == http.MethodPut
+	if hasBody {
+		requestSchema := &Schema{Type: "object"}
+		if endpoint.RequestData != nil {
+			requestSchema = SchemaOf(endpoint.RequestData)
+		}
+		operation.RequestBody = &RequestBody{
+			Required: endpoint.RequestData != nil,
+			Content:  jsonContent(requestSchema),
+		}
+	}
+
+	return strings.Join(segments, "/"), operation
+}
+
+// envelopeSchema describes the shared.GenericAPIResponse holding the provided data
+func envelopeSchema(data *Schema) *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data":  data,
+			"error": {Type: "string"},
+			"code":  {Type: "string"},
+		},
+	}
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{
+		jsonContentType: {Schema: schema},
+	}
+}
+
+// toIdentifier converts a path segment such as by-nonce into ByNonce
+func toIdentifier(segment string) string {
+	words := strings.FieldsFunc(segment, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+
+	identifier := ""
+	for _, word := range words {
+		identifier += strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return identifier
+}
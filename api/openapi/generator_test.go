@@ -0,0 +1,120 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+type endpointsHandlerStub struct {
+	endpoints []*shared.EndpointHandlerData
+}
+
+func (ehs *endpointsHandlerStub) GetEndpoints() []*shared.EndpointHandlerData {
+	return ehs.endpoints
+}
+
+type sendRequest struct {
+	Receiver string `json:"receiver"`
+}
+
+func createTestGroups() map[string]EndpointsHandler {
+	return map[string]EndpointsHandler{
+		"block": &endpointsHandlerStub{
+			endpoints: []*shared.EndpointHandlerData{
+				{
+					Path:         "/by-nonce/:nonce",
+					Method:       http.MethodGet,
+					Description:  "returns the block",
+					ResponseData: gin.H{"nonce": uint64(0)},
+				},
+				{
+					Path:        "/by-hash/:hash",
+					Method:      http.MethodGet,
+					Description: "returns the block by hash",
+				},
+			},
+		},
+		"transaction": &endpointsHandlerStub{
+			endpoints: []*shared.EndpointHandlerData{
+				{
+					Path:        "/send",
+					Method:      http.MethodPost,
+					Description: "sends the transaction",
+					RequestData: sendRequest{},
+				},
+				{
+					Path:        "/cost",
+					Method:      http.MethodPost,
+					Description: "computes the cost",
+				},
+			},
+		},
+		"missing": nil,
+	}
+}
+
+func createTestAPIConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"block": {Routes: []config.RouteConfig{
+				{Name: "/by-nonce/:nonce", Open: true},
+				{Name: "/by-hash/:hash", Open: false},
+			}},
+			"transaction": {Routes: []config.RouteConfig{
+				{Name: "/send", Open: true},
+				{Name: "/cost", Open: true},
+			}},
+		},
+	}
+}
+
+func TestIsEndpointOpen(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createTestAPIConfig()
+	require.True(t, IsEndpointOpen(apiConfig, "block", "/by-nonce/:nonce"))
+	require.False(t, IsEndpointOpen(apiConfig, "block", "/by-hash/:hash"))
+	require.False(t, IsEndpointOpen(apiConfig, "block", "/by-round/:round"))
+	require.False(t, IsEndpointOpen(apiConfig, "node", "/status"))
+}
+
+func TestGenerateDocument(t *testing.T) {
+	t.Parallel()
+
+	document := GenerateDocument(ArgsGenerateDocument{
+		Title:     "title",
+		Version:   "1.0.0",
+		Groups:    createTestGroups(),
+		APIConfig: createTestAPIConfig(),
+	})
+	require.Equal(t, Version, document.OpenAPI)
+	require.Equal(t, Info{Title: "title", Version: "1.0.0"}, document.Info)
+	require.Len(t, document.Paths, 3)
+	require.NotContains(t, document.Paths, "/block/by-hash/{hash}")
+
+	getBlock := document.Paths["/block/by-nonce/{nonce}"]["get"]
+	require.Equal(t, []string{"block"}, getBlock.Tags)
+	require.Equal(t, "returns the block", getBlock.Summary)
+	require.Equal(t, "getBlockByNonce", getBlock.OperationID)
+	require.Equal(t, []*Parameter{{Name: "nonce", In: "path", Required: true, Schema: &Schema{Type: "string"}}}, getBlock.Parameters)
+	require.Nil(t, getBlock.RequestBody)
+	data := getBlock.Responses["200"].Content[jsonContentType].Schema.Properties["data"]
+	require.Equal(t, &Schema{Type: "object", Properties: map[string]*Schema{"nonce": {Type: "integer"}}}, data)
+	require.NotNil(t, getBlock.Responses["default"])
+
+	send := document.Paths["/transaction/send"]["post"]
+	require.Equal(t, "postTransactionSend", send.OperationID)
+	require.Empty(t, send.Parameters)
+	require.True(t, send.RequestBody.Required)
+	require.Equal(t, &Schema{Type: "object", Properties: map[string]*Schema{"receiver": {Type: "string"}}},
+		send.RequestBody.Content[jsonContentType].Schema)
+
+	cost := document.Paths["/transaction/cost"]["post"]
+	require.False(t, cost.RequestBody.Required)
+	require.Equal(t, &Schema{Type: "object"}, cost.RequestBody.Content[jsonContentType].Schema)
+}
@@ -0,0 +1,8 @@
+package openapi
+
+import "github.com/multiversx/mx-chain-go/api/shared"
+
+// EndpointsHandler defines a gin API group able to provide its endpoints
+type EndpointsHandler interface {
+	GetEndpoints() []*shared.EndpointHandlerData
+}
@@ -0,0 +1,154 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	bigIntType        = reflect.TypeOf(big.Int{})
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// SchemaOf returns the JSON schema of the provided sample value. The maps of interfaces, such as gin.H, are described
+// by their keys and the types of the held values, while all the other values are described by their types
+func SchemaOf(sample interface{}) *Schema {
+	if sample == nil {
+		return &Schema{}
+	}
+
+	return schemaOfValue(reflect.ValueOf(sample), make(map[reflect.Type]struct{}))
+}
+
+func schemaOfValue(value reflect.Value, inProgress map[reflect.Type]struct{}) *Schema {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return schemaOfType(value.Type(), inProgress)
+		}
+		value = value.Elem()
+	}
+
+	isMapOfInterfaces := value.Kind() == reflect.Map && value.Type().Elem().Kind() == reflect.Interface
+	if !isMapOfInterfaces || value.Len() == 0 {
+		return schemaOfType(value.Type(), inProgress)
+	}
+
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema, value.Len()),
+	}
+	iterator := value.MapRange()
+	for iterator.Next() {
+		schema.Properties[iterator.Key().String()] = schemaOfValue(iterator.Value(), inProgress)
+	}
+
+	return schema
+}
+
+func schemaOfType(valueType reflect.Type, inProgress map[reflect.Type]struct{}) *Schema {
+	for valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	switch valueType {
+	case bigIntType:
+		return &Schema{Type: "integer"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	if implements(valueType, jsonMarshalerType) {
+		return &Schema{}
+	}
+	if implements(valueType, textMarshalerType) {
+		return &Schema{Type: "string"}
+	}
+
+	switch valueType.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if valueType.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: schemaOfType(valueType.Elem(), inProgress)}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: schemaOfType(valueType.Elem(), inProgress)}
+	case reflect.Struct:
+		return schemaOfStruct(valueType, inProgress)
+	default:
+		return &Schema{}
+	}
+}
+
+func implements(valueType reflect.Type, interfaceType reflect.Type) bool {
+	return valueType.Implements(interfaceType) || reflect.PtrTo(valueType).Implements(interfaceType)
+}
+
+// schemaOfStruct describes the exported fields of a struct as they are marshalled by encoding/json. A recursive type
+// is described as a plain object once it is reached again
+func schemaOfStruct(structType reflect.Type, inProgress map[reflect.Type]struct{}) *Schema {
+	_, isRecursive := inProgress[structType]
+	if isRecursive {
+		return &Schema{Type: "object"}
+	}
+	inProgress[structType] = struct{}{}
+	defer delete(inProgress, structType)
+
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	addStructProperties(schema, structType, inProgress)
+
+	return schema
+}
+
+func addStructProperties(schema *Schema, structType reflect.Type, inProgress map[reflect.Type]struct{}) {
+	for idx := 0; idx < structType.NumField(); idx++ {
+		field := structType.Field(idx)
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		isEmbeddedStruct := field.Anonymous && len(name) == 0 && fieldType.Kind() == reflect.Struct
+		if isEmbeddedStruct {
+			addStructProperties(schema, fieldType, inProgress)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		schema.Properties[name] = schemaOfType(field.Type, inProgress)
+	}
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+
+	return strings.Split(tag, ",")[0], false
+}
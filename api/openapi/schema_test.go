@@ -0,0 +1,87 @@
+package openapi
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddedData struct {
+	Embedded string `json:"embedded"`
+}
+
+type recursiveData struct {
+	Children []*recursiveData `json:"children"`
+}
+
+type sampleData struct {
+	embeddedData
+	Name       string            `json:"name"`
+	Value      *big.Int          `json:"value"`
+	Timestamp  time.Time         `json:"timestamp"`
+	Code       []byte            `json:"code"`
+	Flags      []bool            `json:"flags,omitempty"`
+	Metadata   map[string]uint32 `json:"metadata"`
+	Ratio      float64           `json:"ratio"`
+	Any        interface{}       `json:"any"`
+	Recursive  recursiveData     `json:"recursive"`
+	NoTag      int
+	Skipped    string `json:"-"`
+	unexported string
+}
+
+func TestSchemaOf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sample should return an empty schema", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, &Schema{}, SchemaOf(nil))
+	})
+	t.Run("struct should be described by its json fields", func(t *testing.T) {
+		t.Parallel()
+
+		schema := SchemaOf(&sampleData{})
+		require.Equal(t, &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"embedded":  {Type: "string"},
+				"name":      {Type: "string"},
+				"value":     {Type: "integer"},
+				"timestamp": {Type: "string", Format: "date-time"},
+				"code":      {Type: "string", Format: "byte"},
+				"flags":     {Type: "array", Items: &Schema{Type: "boolean"}},
+				"metadata":  {Type: "object", AdditionalProperties: &Schema{Type: "integer"}},
+				"ratio":     {Type: "number"},
+				"any":       {},
+				"recursive": {
+					Type: "object",
+					Properties: map[string]*Schema{
+						"children": {Type: "array", Items: &Schema{Type: "object"}},
+					},
+				},
+				"NoTag": {Type: "integer"},
+			},
+		}, schema)
+	})
+	t.Run("map of interfaces should be described by its values", func(t *testing.T) {
+		t.Parallel()
+
+		schema := SchemaOf(gin.H{"txHash": "", "count": 0, "inner": gin.H{"ok": false}, "empty": gin.H{}})
+		require.Equal(t, &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"txHash": {Type: "string"},
+				"count":  {Type: "integer"},
+				"inner": {
+					Type:       "object",
+					Properties: map[string]*Schema{"ok": {Type: "boolean"}},
+				},
+				"empty": {Type: "object", AdditionalProperties: &Schema{}},
+			},
+		}, schema)
+	})
+}
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/gin-gonic/gin"
@@ -94,29 +95,48 @@ type FacadeHandler interface {
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
 	StatusMetrics() external.StatusMetricsHandler
+	MetricsRegistry() common.MetricsRegistry
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
+	GetHeartbeatHistory(pubKey string) (*data.PubKeyHeartbeatHistory, error)
+	GetUptime(epoch uint32) ([]data.PubKeyUptime, error)
+	GetP2PMessageTraces() ([]*common.P2PMessageTrace, error)
+	SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error)
+	GetGuardianRegistrationChallenge(address string) (*common.GuardianRegistrationChallenge, error)
+	RegisterGuardedAccount(address string, signature string) (*common.GuardianRegistration, error)
+	VerifyGuardianCode(address string, code string) error
+	SetGuardianSpendingPolicy(address string, code string, policy *common.GuardianSpendingPolicy) error
+	CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposal, error)
+	GetGovernanceProposalVotes(nonce uint64) (*common.GovernanceProposalVotes, error)
+	SubscribeToEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetPeersReputation() ([]*common.PeerReputationAPIResponse, error)
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
-	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransaction(ctx context.Context, tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
-	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SendBulkTransactions(ctx context.Context, txs []*transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	ReplayTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
-	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error)
+	ExecuteSCQuery(ctx context.Context, query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
@@ -24,12 +24,16 @@ type AdditionalMiddleware struct {
 	Position   MiddlewarePosition
 }
 
-// EndpointHandlerData holds the items needed for creating a new gin HTTP endpoint
+// EndpointHandlerData holds the items needed for creating a new gin HTTP endpoint. The description and the optional
+// request and response data samples are used for generating the OpenAPI document of the endpoint
 type EndpointHandlerData struct {
 	Path                  string
 	Method                string
 	Handler               gin.HandlerFunc
 	AdditionalMiddlewares []AdditionalMiddleware
+	Description           string
+	RequestData           interface{}
+	ResponseData          interface{}
 }
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
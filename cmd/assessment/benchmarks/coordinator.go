@@ -53,15 +53,21 @@ func (c *coordinator) RunAllTests() *TestResults {
 			log.Error("error running benchmark", "name", b.Name(), "error", err)
 			lastErr = err
 		}
-		cumulative += elapsed
 
-		testResult.Results = append(testResult.Results,
-			SingleResult{
-				Duration: elapsed,
-				Name:     b.Name(),
-				Error:    err,
-			},
-		)
+		result := SingleResult{
+			Duration: elapsed,
+			Name:     b.Name(),
+			Error:    err,
+		}
+		throughputBenchmark, isThroughputBenchmark := b.(ThroughputBenchmarkRunner)
+		if isThroughputBenchmark {
+			result.NumOperations = throughputBenchmark.NumOperations()
+			result.Requirement = throughputBenchmark.Requirement()
+		} else {
+			cumulative += elapsed
+		}
+
+		testResult.Results = append(testResult.Results, result)
 	}
 
 	testResult.Error = lastErr
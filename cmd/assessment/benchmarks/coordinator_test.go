@@ -97,3 +97,39 @@ func TestCoordinator_RunAllShouldWork(t *testing.T) {
 	assert.Equal(t, time.Duration(3), result.Results[1].Duration)
 	assert.True(t, result.EnoughComputingPower)
 }
+
+func TestCoordinator_RunAllShouldNotSumThroughputBenchmarks(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewCoordinator([]BenchmarkRunner{
+		&mock.BenchmarkStub{
+			RunCalled: func() (time.Duration, error) {
+				return 2, nil
+			},
+		},
+		&mock.ThroughputBenchmarkStub{
+			BenchmarkStub: mock.BenchmarkStub{
+				RunCalled: func() (time.Duration, error) {
+					return time.Second, nil
+				},
+			},
+			NumOperationsCalled: func() uint64 {
+				return 100
+			},
+			RequirementCalled: func() string {
+				return RequirementDiskRandomReads
+			},
+		},
+	})
+
+	result := c.RunAllTests()
+	require.NotNil(t, result)
+	assert.Nil(t, result.Error)
+	assert.Equal(t, time.Duration(2), result.TotalDuration)
+	require.Equal(t, 2, len(result.Results))
+	assert.Equal(t, uint64(0), result.Results[0].NumOperations)
+	assert.Empty(t, result.Results[0].Requirement)
+	assert.Equal(t, uint64(100), result.Results[1].NumOperations)
+	assert.Equal(t, RequirementDiskRandomReads, result.Results[1].Requirement)
+	assert.Equal(t, float64(100), result.Results[1].OperationsPerSecond())
+}
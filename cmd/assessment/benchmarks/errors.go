@@ -10,3 +10,18 @@ var ErrNilBenchmark = errors.New("nil benchmark")
 
 // ErrFileDoesNotExist signals that the required file does not exist
 var ErrFileDoesNotExist = errors.New("file does not exist")
+
+// ErrInvalidNumOperations signals that an invalid number of operations was provided
+var ErrInvalidNumOperations = errors.New("invalid number of operations")
+
+// ErrInvalidValueSize signals that an invalid value size was provided
+var ErrInvalidValueSize = errors.New("invalid value size")
+
+// ErrUnknownStorageOperation signals that an unknown storage operation was provided
+var ErrUnknownStorageOperation = errors.New("unknown storage operation")
+
+// ErrUnknownSignatureType signals that an unknown signature type was provided
+var ErrUnknownSignatureType = errors.New("unknown signature type")
+
+// ErrInvalidSignature signals that a signature failed the verification
+var ErrInvalidSignature = errors.New("invalid signature")
@@ -6,8 +6,9 @@ import (
 	"github.com/multiversx/mx-chain-go/cmd/assessment/benchmarks"
 )
 
-// CreateBenchmarksList creates the list of benchmarks
-func CreateBenchmarksList(testDataDirectory string) []benchmarks.BenchmarkRunner {
+// CreateBenchmarksList creates the list of benchmarks. The storage benchmarks will write their data inside the
+// provided storage directory, which should be on the same disk as the node's working directory
+func CreateBenchmarksList(testDataDirectory string, storageDirectory string) []benchmarks.BenchmarkRunner {
 	list := make([]benchmarks.BenchmarkRunner, 0)
 
 	list = append(list, createFibBenchmark(testDataDirectory))
@@ -25,6 +26,12 @@ func CreateBenchmarksList(testDataDirectory string) []benchmarks.BenchmarkRunner
 	list = append(list, createDelegation(testDataDirectory))
 	list = append(list, createErc20InC(testDataDirectory))
 	list = append(list, createErc20InRust(testDataDirectory))
+	list = append(list, createStorageSequentialWrites(storageDirectory))
+	list = append(list, createStorageRandomWrites(storageDirectory))
+	list = append(list, createStorageRandomReads(storageDirectory))
+	list = append(list, createTrieCommit(storageDirectory))
+	list = append(list, createEd25519Verifications())
+	list = append(list, createBLSVerifications())
 
 	return list
 }
@@ -221,3 +228,70 @@ func createErc20InRust(testDataDirectory string) benchmarks.BenchmarkRunner {
 
 	return benchmarks.NewErc20Benchmark(arg)
 }
+
+func createStorageSequentialWrites(storageDirectory string) benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgStorageBenchmark{
+		Name:          "LevelDB sequential writes",
+		Directory:     storageDirectory,
+		Operation:     benchmarks.StorageSequentialWrite,
+		NumOperations: 100000,
+		ValueSize:     1024,
+	}
+
+	return benchmarks.NewStorageBenchmark(arg)
+}
+
+func createStorageRandomWrites(storageDirectory string) benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgStorageBenchmark{
+		Name:          "LevelDB random writes",
+		Directory:     storageDirectory,
+		Operation:     benchmarks.StorageRandomWrite,
+		NumOperations: 100000,
+		ValueSize:     1024,
+	}
+
+	return benchmarks.NewStorageBenchmark(arg)
+}
+
+func createStorageRandomReads(storageDirectory string) benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgStorageBenchmark{
+		Name:          "LevelDB random reads",
+		Directory:     storageDirectory,
+		Operation:     benchmarks.StorageRandomRead,
+		NumOperations: 100000,
+		ValueSize:     1024,
+	}
+
+	return benchmarks.NewStorageBenchmark(arg)
+}
+
+func createTrieCommit(storageDirectory string) benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgTrieCommitBenchmark{
+		Name:                "Accounts trie commit",
+		Directory:           storageDirectory,
+		NumBatches:          10,
+		NumAccountsPerBatch: 10000,
+	}
+
+	return benchmarks.NewTrieCommitBenchmark(arg)
+}
+
+func createEd25519Verifications() benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgSignatureBenchmark{
+		Name:             "Signature verification",
+		SignatureType:    benchmarks.SignatureEd25519,
+		NumVerifications: 20000,
+	}
+
+	return benchmarks.NewSignatureBenchmark(arg)
+}
+
+func createBLSVerifications() benchmarks.BenchmarkRunner {
+	arg := benchmarks.ArgSignatureBenchmark{
+		Name:             "Signature verification",
+		SignatureType:    benchmarks.SignatureBLS,
+		NumVerifications: 1000,
+	}
+
+	return benchmarks.NewSignatureBenchmark(arg)
+}
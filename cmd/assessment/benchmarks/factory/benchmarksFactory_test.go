@@ -7,7 +7,7 @@ import (
 )
 
 func TestCreateBenchmarksList(t *testing.T) {
-	list := CreateBenchmarksList("../testdata")
+	list := CreateBenchmarksList("../testdata", t.TempDir())
 
-	assert.Equal(t, 15, len(list))
+	assert.Equal(t, 21, len(list))
 }
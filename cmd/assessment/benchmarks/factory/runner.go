@@ -9,10 +9,10 @@ type runner struct {
 }
 
 // NewRunner is a wrapper over the coordinator implementation that will assemble all the defined benchmarks
-func NewRunner(testDataDirectory string) (*runner, error) {
+func NewRunner(testDataDirectory string, storageDirectory string) (*runner, error) {
 	r := &runner{}
 
-	list := CreateBenchmarksList(testDataDirectory)
+	list := CreateBenchmarksList(testDataDirectory, storageDirectory)
 
 	var err error
 	r.coordinator, err = benchmarks.NewCoordinator(list)
@@ -0,0 +1,240 @@
+package benchmarks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/klauspost/cpuid/v2"
+	"github.com/multiversx/mx-chain-core-go/display"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	// RequirementDiskSequentialWrites is the requirement checked against the disk sequential writes throughput
+	RequirementDiskSequentialWrites = "disk sequential writes per second"
+	// RequirementDiskRandomWrites is the requirement checked against the disk random writes throughput
+	RequirementDiskRandomWrites = "disk random writes per second"
+	// RequirementDiskRandomReads is the requirement checked against the disk random reads throughput
+	RequirementDiskRandomReads = "disk random reads per second"
+	// RequirementTrieCommittedAccounts is the requirement checked against the trie commit throughput
+	RequirementTrieCommittedAccounts = "trie committed accounts per second"
+	// RequirementEd25519Verifications is the requirement checked against the ed25519 verifications throughput
+	RequirementEd25519Verifications = "ed25519 verifications per second"
+	// RequirementBLSVerifications is the requirement checked against the BLS verifications throughput
+	RequirementBLSVerifications = "BLS verifications per second"
+
+	cpuFlagsRequirement       = "CPU flags"
+	computingPowerRequirement = "CPU benchmarks total seconds"
+	passedMarker              = "PASS"
+	failedMarker              = "FAIL"
+	notCheckedMarker          = "-"
+)
+
+// RequirementCheck holds a measured throughput together with the minimum values required for each node type
+type RequirementCheck struct {
+	Requirement  string
+	Measured     float64
+	MinValidator uint64
+	MinArchive   uint64
+}
+
+// PassesValidator returns true if the measured throughput meets the validator requirement
+func (rc *RequirementCheck) PassesValidator() bool {
+	return rc.Measured >= float64(rc.MinValidator)
+}
+
+// PassesArchive returns true if the measured throughput meets the archive requirement
+func (rc *RequirementCheck) PassesArchive() bool {
+	return rc.Measured >= float64(rc.MinArchive)
+}
+
+// HardwareReport represents the pass/fail report of the benchmarks results against the hardware requirements
+type HardwareReport struct {
+	MissingCPUFlags      []string
+	EnoughComputingPower bool
+	TotalDuration        float64
+	Checks               []RequirementCheck
+	Error                error
+}
+
+// CheckCPUFlags returns the CPU flags, out of the required ones, that the host does not support
+func CheckCPUFlags(cpuFlags []string) ([]string, error) {
+	missingFlags := make([]string, 0)
+	for _, cpuFlag := range cpuFlags {
+		featureID := cpuid.ParseFeature(cpuFlag)
+		if featureID == cpuid.UNKNOWN {
+			return nil, fmt.Errorf("CPU Flags: cpu flag %s not found", cpuFlag)
+		}
+
+		if !cpuid.CPU.Supports(featureID) {
+			missingFlags = append(missingFlags, cpuFlag)
+		}
+	}
+
+	return missingFlags, nil
+}
+
+// NewHardwareReport checks the benchmarks results against the [HardwareRequirements] section of the node's config.
+// A requirement set to 0 is not checked
+func NewHardwareReport(
+	requirements config.HardwareRequirementsConfig,
+	results *TestResults,
+	missingCPUFlags []string,
+) *HardwareReport {
+	report := &HardwareReport{
+		MissingCPUFlags:      missingCPUFlags,
+		EnoughComputingPower: results.EnoughComputingPower,
+		TotalDuration:        results.TotalDuration.Seconds(),
+		Checks:               make([]RequirementCheck, 0),
+		Error:                results.Error,
+	}
+
+	for _, result := range results.Results {
+		if len(result.Requirement) == 0 {
+			continue
+		}
+
+		report.Checks = append(report.Checks, RequirementCheck{
+			Requirement:  result.Requirement,
+			Measured:     result.OperationsPerSecond(),
+			MinValidator: getMinThroughput(requirements.Validator, result.Requirement),
+			MinArchive:   getMinThroughput(requirements.Archive, result.Requirement),
+		})
+	}
+
+	return report
+}
+
+func getMinThroughput(thresholds config.HardwareThresholdsConfig, requirement string) uint64 {
+	switch requirement {
+	case RequirementDiskSequentialWrites:
+		return thresholds.MinDiskSequentialWritesPerSecond
+	case RequirementDiskRandomWrites:
+		return thresholds.MinDiskRandomWritesPerSecond
+	case RequirementDiskRandomReads:
+		return thresholds.MinDiskRandomReadsPerSecond
+	case RequirementTrieCommittedAccounts:
+		return thresholds.MinTrieCommittedAccountsPerSecond
+	case RequirementEd25519Verifications:
+		return thresholds.MinEd25519VerificationsPerSecond
+	case RequirementBLSVerifications:
+		return thresholds.MinBLSVerificationsPerSecond
+	default:
+		return 0
+	}
+}
+
+// IsSuitableForValidator returns true if all the validator requirements are met
+func (hr *HardwareReport) IsSuitableForValidator() bool {
+	if hr.Error != nil || len(hr.MissingCPUFlags) > 0 || !hr.EnoughComputingPower {
+		return false
+	}
+
+	for _, check := range hr.Checks {
+		if !check.PassesValidator() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuitableForArchive returns true if all the archive requirements are met. An archive node should also meet
+// the validator requirements, as it processes the same blocks
+func (hr *HardwareReport) IsSuitableForArchive() bool {
+	if !hr.IsSuitableForValidator() {
+		return false
+	}
+
+	for _, check := range hr.Checks {
+		if !check.PassesArchive() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Recommendation returns the node type recommended for the host
+func (hr *HardwareReport) Recommendation() string {
+	if hr.Error != nil {
+		return "The recommended node type can not be determined due to encountered errors"
+	}
+	if hr.IsSuitableForArchive() {
+		return "The host is suitable for running both validator and archive (full history) nodes"
+	}
+	if hr.IsSuitableForValidator() {
+		return "The host is suitable for running validator and observer nodes, but not archive (full history) nodes"
+	}
+
+	return "The host does not meet the hardware requirements for running a validator node"
+}
+
+// ToDisplayTable will output the report as an ASCII table
+func (hr *HardwareReport) ToDisplayTable() string {
+	hdr := []string{"Requirement", "Measured", "Validator minimum", "Validator", "Archive minimum", "Archive"}
+	rows := hr.ToStrings()
+	lines := make([]*display.LineData, 0, len(rows))
+	for i, row := range rows {
+		lines = append(lines, display.NewLineData(i == 1, row))
+	}
+
+	tbl, err := display.CreateTableString(hdr, lines)
+	if err != nil {
+		return fmt.Sprintf("[ERR:%s]", err)
+	}
+
+	return tbl
+}
+
+// ToStrings will return the report as strings (to be easily written, e.g. in a file)
+func (hr *HardwareReport) ToStrings() [][]string {
+	cpuFlagsMeasured := "all supported"
+	if len(hr.MissingCPUFlags) > 0 {
+		cpuFlagsMeasured = "missing " + strings.Join(hr.MissingCPUFlags, ", ")
+	}
+	cpuFlagsMarker := passFailMarker(len(hr.MissingCPUFlags) == 0)
+	computingPowerMarker := passFailMarker(hr.EnoughComputingPower)
+	maxTotalDuration := fmt.Sprintf("max %0.3f", ThresholdEnoughComputingPower.Seconds())
+
+	result := [][]string{
+		{cpuFlagsRequirement, cpuFlagsMeasured, "", cpuFlagsMarker, "", cpuFlagsMarker},
+		{computingPowerRequirement, fmt.Sprintf("%0.3f", hr.TotalDuration), maxTotalDuration, computingPowerMarker, maxTotalDuration, computingPowerMarker},
+	}
+	for _, check := range hr.Checks {
+		result = append(result, []string{
+			check.Requirement,
+			fmt.Sprintf("%0.0f", check.Measured),
+			thresholdAsString(check.MinValidator),
+			checkMarker(check.MinValidator, check.PassesValidator()),
+			thresholdAsString(check.MinArchive),
+			checkMarker(check.MinArchive, check.PassesArchive()),
+		})
+	}
+
+	return result
+}
+
+func thresholdAsString(threshold uint64) string {
+	if threshold == 0 {
+		return notCheckedMarker
+	}
+
+	return fmt.Sprintf("%d", threshold)
+}
+
+func checkMarker(threshold uint64, passed bool) string {
+	if threshold == 0 {
+		return notCheckedMarker
+	}
+
+	return passFailMarker(passed)
+}
+
+func passFailMarker(passed bool) string {
+	if passed {
+		return passedMarker
+	}
+
+	return failedMarker
+}
@@ -0,0 +1,134 @@
+package benchmarks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createHardwareRequirements() config.HardwareRequirementsConfig {
+	return config.HardwareRequirementsConfig{
+		Validator: config.HardwareThresholdsConfig{
+			MinDiskRandomReadsPerSecond:      100,
+			MinEd25519VerificationsPerSecond: 1000,
+		},
+		Archive: config.HardwareThresholdsConfig{
+			MinDiskRandomReadsPerSecond:      500,
+			MinEd25519VerificationsPerSecond: 1000,
+		},
+	}
+}
+
+func createTestResultsWithThroughputs(diskRandomReads uint64, ed25519Verifications uint64) *TestResults {
+	return &TestResults{
+		TotalDuration:        time.Second,
+		EnoughComputingPower: true,
+		Results: []SingleResult{
+			{Duration: time.Second, Name: "cpu"},
+			{Duration: time.Second, Name: "reads", NumOperations: diskRandomReads, Requirement: RequirementDiskRandomReads},
+			{Duration: time.Second, Name: "verifications", NumOperations: ed25519Verifications, Requirement: RequirementEd25519Verifications},
+			{Duration: time.Second, Name: "writes", NumOperations: 10, Requirement: RequirementDiskRandomWrites},
+		},
+	}
+}
+
+func TestCheckCPUFlags(t *testing.T) {
+	t.Parallel()
+
+	missingFlags, err := CheckCPUFlags([]string{"not a flag"})
+	assert.NotNil(t, err)
+	assert.Nil(t, missingFlags)
+
+	missingFlags, err = CheckCPUFlags(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, missingFlags)
+}
+
+func TestNewHardwareReport(t *testing.T) {
+	t.Parallel()
+
+	report := NewHardwareReport(createHardwareRequirements(), createTestResultsWithThroughputs(200, 2000), nil)
+
+	expectedChecks := []RequirementCheck{
+		{Requirement: RequirementDiskRandomReads, Measured: 200, MinValidator: 100, MinArchive: 500},
+		{Requirement: RequirementEd25519Verifications, Measured: 2000, MinValidator: 1000, MinArchive: 1000},
+		{Requirement: RequirementDiskRandomWrites, Measured: 10, MinValidator: 0, MinArchive: 0},
+	}
+	assert.Equal(t, expectedChecks, report.Checks)
+	assert.Equal(t, float64(1), report.TotalDuration)
+	assert.True(t, report.EnoughComputingPower)
+}
+
+func TestHardwareReport_Recommendation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("archive requirements met", func(t *testing.T) {
+		t.Parallel()
+
+		report := NewHardwareReport(createHardwareRequirements(), createTestResultsWithThroughputs(600, 2000), nil)
+		assert.True(t, report.IsSuitableForValidator())
+		assert.True(t, report.IsSuitableForArchive())
+		assert.True(t, strings.Contains(report.Recommendation(), "both validator and archive"))
+	})
+	t.Run("only validator requirements met", func(t *testing.T) {
+		t.Parallel()
+
+		report := NewHardwareReport(createHardwareRequirements(), createTestResultsWithThroughputs(200, 2000), nil)
+		assert.True(t, report.IsSuitableForValidator())
+		assert.False(t, report.IsSuitableForArchive())
+		assert.True(t, strings.Contains(report.Recommendation(), "but not archive"))
+	})
+	t.Run("validator requirements not met", func(t *testing.T) {
+		t.Parallel()
+
+		report := NewHardwareReport(createHardwareRequirements(), createTestResultsWithThroughputs(600, 500), nil)
+		assert.False(t, report.IsSuitableForValidator())
+		assert.False(t, report.IsSuitableForArchive())
+		assert.True(t, strings.Contains(report.Recommendation(), "does not meet"))
+	})
+	t.Run("missing CPU flags", func(t *testing.T) {
+		t.Parallel()
+
+		report := NewHardwareReport(createHardwareRequirements(), createTestResultsWithThroughputs(600, 2000), []string{"SSE42"})
+		assert.False(t, report.IsSuitableForValidator())
+		assert.False(t, report.IsSuitableForArchive())
+	})
+	t.Run("not enough computing power", func(t *testing.T) {
+		t.Parallel()
+
+		results := createTestResultsWithThroughputs(600, 2000)
+		results.EnoughComputingPower = false
+		report := NewHardwareReport(createHardwareRequirements(), results, nil)
+		assert.False(t, report.IsSuitableForValidator())
+	})
+	t.Run("benchmark error", func(t *testing.T) {
+		t.Parallel()
+
+		results := createTestResultsWithThroughputs(600, 2000)
+		results.Error = errors.New("expected error")
+		report := NewHardwareReport(createHardwareRequirements(), results, nil)
+		assert.False(t, report.IsSuitableForValidator())
+		assert.True(t, strings.Contains(report.Recommendation(), "can not be determined"))
+	})
+}
+
+func TestHardwareReport_ToStrings(t *testing.T) {
+	t.Parallel()
+
+	report := NewHardwareReport(createHardwareRequirements(), createTestResultsWithThroughputs(200, 2000), []string{"SSE42"})
+
+	data := report.ToStrings()
+	require.Equal(t, 5, len(data))
+	assert.Equal(t, []string{cpuFlagsRequirement, "missing SSE42", "", failedMarker, "", failedMarker}, data[0])
+	assert.Equal(t, []string{RequirementDiskRandomReads, "200", "100", passedMarker, "500", failedMarker}, data[2])
+	assert.Equal(t, []string{RequirementDiskRandomWrites, "10", notCheckedMarker, notCheckedMarker, notCheckedMarker, notCheckedMarker}, data[4])
+
+	tbl := report.ToDisplayTable()
+	assert.True(t, strings.Contains(tbl, RequirementEd25519Verifications))
+	assert.False(t, strings.Contains(tbl, "[ERR:"))
+}
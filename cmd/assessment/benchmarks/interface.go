@@ -8,3 +8,11 @@ type BenchmarkRunner interface {
 	Name() string
 	IsInterfaceNil() bool
 }
+
+// ThroughputBenchmarkRunner defines a benchmark executing a fixed number of operations, so that its result can be
+// expressed as a throughput and checked against the hardware requirements
+type ThroughputBenchmarkRunner interface {
+	BenchmarkRunner
+	NumOperations() uint64
+	Requirement() string
+}
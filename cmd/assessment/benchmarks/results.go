@@ -12,11 +12,23 @@ const totalMarker = "TOTAL"
 // SingleResult contains the output data after a benchmark run
 type SingleResult struct {
 	time.Duration
-	Name  string
-	Error error
+	Name          string
+	Error         error
+	NumOperations uint64
+	Requirement   string
 }
 
-// TestResults represents the output structure containing the test results data
+// OperationsPerSecond returns the throughput of a benchmark executing a fixed number of operations
+func (sr *SingleResult) OperationsPerSecond() float64 {
+	if sr.NumOperations == 0 || sr.Duration <= 0 {
+		return 0
+	}
+
+	return float64(sr.NumOperations) / sr.Seconds()
+}
+
+// TestResults represents the output structure containing the test results data. The total duration only sums the
+// CPU bound benchmarks, the throughput benchmarks being checked against the hardware requirements instead
 type TestResults struct {
 	TotalDuration        time.Duration
 	Error                error
@@ -26,7 +38,7 @@ type TestResults struct {
 
 // ToDisplayTable will output the contained data as an ASCII table
 func (tr *TestResults) ToDisplayTable() string {
-	hdr := []string{"Benchmark", "Time in seconds", "Error"}
+	hdr := []string{"Benchmark", "Time in seconds", "Operations per second", "Error"}
 	lines := make([]*display.LineData, 0, len(tr.Results)+1)
 	for i, res := range tr.Results {
 		lines = append(lines, display.NewLineData(
@@ -34,6 +46,7 @@ func (tr *TestResults) ToDisplayTable() string {
 			[]string{
 				res.Name,
 				tr.secondsAsString(res.Seconds()),
+				tr.throughputAsString(res),
 				tr.errToString(res.Error),
 			},
 		))
@@ -45,6 +58,7 @@ func (tr *TestResults) ToDisplayTable() string {
 			totalMarker,
 			tr.secondsAsString(tr.TotalDuration.Seconds()),
 			"",
+			"",
 		},
 	))
 
@@ -60,6 +74,14 @@ func (tr *TestResults) secondsAsString(seconds float64) string {
 	return fmt.Sprintf("%0.3f", seconds)
 }
 
+func (tr *TestResults) throughputAsString(sr SingleResult) string {
+	if sr.NumOperations == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%0.0f", sr.OperationsPerSecond())
+}
+
 // ToStrings will return the contained data as strings (to be easily written, e.g. in a file)
 func (tr *TestResults) ToStrings() [][]string {
 	result := make([][]string, 0)
@@ -68,6 +90,7 @@ func (tr *TestResults) ToStrings() [][]string {
 		result = append(result, []string{
 			sr.Name,
 			tr.secondsAsString(sr.Seconds()),
+			tr.throughputAsString(sr),
 			tr.errToString(sr.Error),
 		})
 	}
@@ -76,6 +99,7 @@ func (tr *TestResults) ToStrings() [][]string {
 		totalMarker,
 		tr.secondsAsString(tr.TotalDuration.Seconds()),
 		"",
+		"",
 	})
 
 	return result
@@ -33,13 +33,20 @@ func TestTestResults_ToDisplayTable(t *testing.T) {
 				Name:     "test 3",
 				Error:    nil,
 			},
+			{
+				Duration:      time.Second * 5,
+				Name:          "test 4",
+				Error:         nil,
+				NumOperations: 1000,
+				Requirement:   RequirementDiskRandomWrites,
+			},
 		},
 	}
 
 	tbl := tr.ToDisplayTable()
 	fmt.Println(tbl)
 
-	stringsToContain := []string{totalMarker, errFound.Error(), "test 1", "test 2", "test 3", "1.000", "2.000", "3.000", "4.000"}
+	stringsToContain := []string{totalMarker, errFound.Error(), "test 1", "test 2", "test 3", "test 4", "1.000", "2.000", "3.000", "4.000", "5.000", "200"}
 	for _, str := range stringsToContain {
 		assert.True(t, strings.Contains(tbl, str), "string %s not contained", str)
 	}
@@ -88,3 +95,16 @@ func TestTestResults_ToStrings(t *testing.T) {
 		assert.True(t, found, "string %s not contained", str)
 	}
 }
+
+func TestSingleResult_OperationsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	sr := &SingleResult{Duration: time.Second * 2}
+	assert.Equal(t, float64(0), sr.OperationsPerSecond())
+
+	sr = &SingleResult{NumOperations: 100}
+	assert.Equal(t, float64(0), sr.OperationsPerSecond())
+
+	sr = &SingleResult{Duration: time.Second * 2, NumOperations: 100}
+	assert.Equal(t, float64(50), sr.OperationsPerSecond())
+}
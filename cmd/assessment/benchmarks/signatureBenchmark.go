@@ -0,0 +1,122 @@
+package benchmarks
+
+import (
+	"fmt"
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	ed25519SingleSig "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSingleSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+const (
+	// SignatureEd25519 will verify ed25519 signatures, as used when signing transactions
+	SignatureEd25519 = "ed25519"
+	// SignatureBLS will verify BLS signatures, as used by the consensus
+	SignatureBLS = "BLS"
+
+	numSignedMessages = 16
+	signedMessageSize = 256
+)
+
+// ArgSignatureBenchmark is the signature verification type benchmark argument used in constructor
+type ArgSignatureBenchmark struct {
+	Name             string
+	SignatureType    string
+	NumVerifications int
+}
+
+type signatureBenchmark struct {
+	name             string
+	signatureType    string
+	numVerifications int
+}
+
+// NewSignatureBenchmark creates a new benchmark measuring the single signature verifications
+func NewSignatureBenchmark(arg ArgSignatureBenchmark) *signatureBenchmark {
+	return &signatureBenchmark{
+		name:             arg.Name,
+		signatureType:    arg.SignatureType,
+		numVerifications: arg.NumVerifications,
+	}
+}
+
+// Run returns the time needed for the benchmark to be run
+func (sb *signatureBenchmark) Run() (time.Duration, error) {
+	if sb.numVerifications < 1 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidNumOperations, sb.numVerifications)
+	}
+
+	keyGen, signer, err := createSigningComponents(sb.signatureType)
+	if err != nil {
+		return 0, err
+	}
+
+	privateKey, publicKey := keyGen.GeneratePair()
+	messages, err := createRandomValues(numSignedMessages, signedMessageSize)
+	if err != nil {
+		return 0, err
+	}
+
+	signatures := make([][]byte, 0, len(messages))
+	for _, message := range messages {
+		signature, errSign := signer.Sign(privateKey, message)
+		if errSign != nil {
+			return 0, errSign
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	start := time.Now()
+	for i := 0; i < sb.numVerifications; i++ {
+		index := i % len(messages)
+		err = signer.Verify(publicKey, messages[index], signatures[index])
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+func createSigningComponents(signatureType string) (crypto.KeyGenerator, crypto.SingleSigner, error) {
+	switch signatureType {
+	case SignatureEd25519:
+		return signing.NewKeyGenerator(ed25519.NewEd25519()), &ed25519SingleSig.Ed25519Signer{}, nil
+	case SignatureBLS:
+		return signing.NewKeyGenerator(mcl.NewSuiteBLS12()), mclSingleSig.NewBlsSigner(), nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnknownSignatureType, signatureType)
+	}
+}
+
+// NumOperations returns the number of signature verifications executed by the benchmark
+func (sb *signatureBenchmark) NumOperations() uint64 {
+	return uint64(sb.numVerifications)
+}
+
+// Requirement returns the hardware requirement checked against the benchmark's throughput
+func (sb *signatureBenchmark) Requirement() string {
+	switch sb.signatureType {
+	case SignatureEd25519:
+		return RequirementEd25519Verifications
+	case SignatureBLS:
+		return RequirementBLSVerifications
+	default:
+		return ""
+	}
+}
+
+// Name returns the benchmark's name
+func (sb *signatureBenchmark) Name() string {
+	return fmt.Sprintf("%s, %d %s verifications", sb.name, sb.numVerifications, sb.signatureType)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sb *signatureBenchmark) IsInterfaceNil() bool {
+	return sb == nil
+}
@@ -0,0 +1,69 @@
+package benchmarks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureBenchmark_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of verifications should error", func(t *testing.T) {
+		t.Parallel()
+
+		sb := NewSignatureBenchmark(ArgSignatureBenchmark{
+			Name:             "signature",
+			SignatureType:    SignatureEd25519,
+			NumVerifications: 0,
+		})
+
+		elapsed, err := sb.Run()
+		assert.True(t, errors.Is(err, ErrInvalidNumOperations))
+		assert.Zero(t, elapsed)
+	})
+	t.Run("unknown signature type should error", func(t *testing.T) {
+		t.Parallel()
+
+		sb := NewSignatureBenchmark(ArgSignatureBenchmark{
+			Name:             "signature",
+			SignatureType:    "unknown",
+			NumVerifications: 10,
+		})
+
+		elapsed, err := sb.Run()
+		assert.True(t, errors.Is(err, ErrUnknownSignatureType))
+		assert.Zero(t, elapsed)
+		assert.Empty(t, sb.Requirement())
+	})
+
+	signatureTypes := map[string]string{
+		SignatureEd25519: RequirementEd25519Verifications,
+		SignatureBLS:     RequirementBLSVerifications,
+	}
+	for signatureType, requirement := range signatureTypes {
+		signatureType := signatureType
+		requirement := requirement
+		t.Run(signatureType+" should work", func(t *testing.T) {
+			t.Parallel()
+
+			sb := NewSignatureBenchmark(ArgSignatureBenchmark{
+				Name:             "signature",
+				SignatureType:    signatureType,
+				NumVerifications: 20,
+			})
+			assert.False(t, check.IfNil(sb))
+
+			elapsed, err := sb.Run()
+			require.Nil(t, err)
+			assert.True(t, elapsed > 0)
+			assert.Equal(t, uint64(20), sb.NumOperations())
+			assert.Equal(t, requirement, sb.Requirement())
+			assert.True(t, strings.Contains(sb.Name(), "20 "+signatureType+" verifications"))
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package benchmarks
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+)
+
+const (
+	// StorageSequentialWrite will write values with increasing keys
+	StorageSequentialWrite = "sequential write"
+	// StorageRandomWrite will write values with random keys
+	StorageRandomWrite = "random write"
+	// StorageRandomRead will read, in random order, values previously written with random keys
+	StorageRandomRead = "random read"
+
+	storageKeyLength   = 32
+	batchDelaySeconds  = 2
+	maxBatchSize       = 45000
+	maxOpenFiles       = 10
+	storageDirPattern  = "assessment-storage-*"
+	storageDbDirectory = "db"
+)
+
+// ArgStorageBenchmark is the storage type benchmark argument used in constructor
+type ArgStorageBenchmark struct {
+	Name          string
+	Directory     string
+	Operation     string
+	NumOperations int
+	ValueSize     int
+}
+
+type storageBenchmark struct {
+	name          string
+	directory     string
+	operation     string
+	numOperations int
+	valueSize     int
+}
+
+// NewStorageBenchmark creates a new benchmark measuring the disk through the LevelDB persister used by the node. The
+// database is created in a temporary folder inside the provided directory, removed after the benchmark ends
+func NewStorageBenchmark(arg ArgStorageBenchmark) *storageBenchmark {
+	return &storageBenchmark{
+		name:          arg.Name,
+		directory:     arg.Directory,
+		operation:     arg.Operation,
+		numOperations: arg.NumOperations,
+		valueSize:     arg.ValueSize,
+	}
+}
+
+// Run returns the time needed for the benchmark to be run
+func (sb *storageBenchmark) Run() (time.Duration, error) {
+	if sb.numOperations < 1 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidNumOperations, sb.numOperations)
+	}
+	if sb.valueSize < 1 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidValueSize, sb.valueSize)
+	}
+
+	tempDir, err := os.MkdirTemp(sb.directory, storageDirPattern)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+
+	dbPath := filepath.Join(tempDir, storageDbDirectory)
+	switch sb.operation {
+	case StorageSequentialWrite:
+		return sb.measureWrites(dbPath, createSequentialKeys(sb.numOperations))
+	case StorageRandomWrite:
+		return sb.measureWrites(dbPath, createRandomKeys(sb.numOperations))
+	case StorageRandomRead:
+		return sb.measureRandomReads(dbPath)
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnknownStorageOperation, sb.operation)
+	}
+}
+
+// measureWrites includes the persister closing, so that all the batched writes reach the disk
+func (sb *storageBenchmark) measureWrites(dbPath string, keys [][]byte) (time.Duration, error) {
+	values, err := createRandomValues(len(keys), sb.valueSize)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	err = writeToNewPersister(dbPath, keys, values)
+
+	return time.Since(start), err
+}
+
+// measureRandomReads reopens the persister after populating it, so that the reads are not served from the batch
+func (sb *storageBenchmark) measureRandomReads(dbPath string) (time.Duration, error) {
+	keys := createRandomKeys(sb.numOperations)
+	values, err := createRandomValues(len(keys), sb.valueSize)
+	if err != nil {
+		return 0, err
+	}
+
+	err = writeToNewPersister(dbPath, keys, values)
+	if err != nil {
+		return 0, err
+	}
+
+	persister, err := database.NewSerialDB(dbPath, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	shuffleKeys(keys)
+	start := time.Now()
+	for _, key := range keys {
+		_, err = persister.Get(key)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return time.Since(start), nil
+}
+
+func writeToNewPersister(dbPath string, keys [][]byte, values [][]byte) error {
+	persister, err := database.NewSerialDB(dbPath, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return err
+	}
+
+	err = putAll(persister, keys, values)
+	if err != nil {
+		_ = persister.Close()
+		return err
+	}
+
+	return persister.Close()
+}
+
+func putAll(persister storage.Persister, keys [][]byte, values [][]byte) error {
+	for i := range keys {
+		err := persister.Put(keys[i], values[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createSequentialKeys(numKeys int) [][]byte {
+	keys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		key := make([]byte, storageKeyLength)
+		binary.BigEndian.PutUint64(key[storageKeyLength-8:], uint64(i))
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func createRandomKeys(numKeys int) [][]byte {
+	keys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		key := make([]byte, storageKeyLength)
+		// the index guarantees the keys are unique
+		binary.BigEndian.PutUint64(key[storageKeyLength-8:], uint64(i))
+		_, _ = rand.Read(key[:storageKeyLength-8])
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func createRandomValues(numValues int, valueSize int) ([][]byte, error) {
+	buff := make([]byte, numValues*valueSize)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, 0, numValues)
+	for i := 0; i < numValues; i++ {
+		values = append(values, buff[i*valueSize:(i+1)*valueSize])
+	}
+
+	return values, nil
+}
+
+func shuffleKeys(keys [][]byte) {
+	for i := len(keys) - 1; i > 0; i-- {
+		j := int(randomUint64() % uint64(i+1))
+		keys[i], keys[j] = keys[j], keys[i]
+	}
+}
+
+func randomUint64() uint64 {
+	buff := make([]byte, 8)
+	_, _ = rand.Read(buff)
+
+	return binary.BigEndian.Uint64(buff)
+}
+
+// NumOperations returns the number of storage operations executed by the benchmark
+func (sb *storageBenchmark) NumOperations() uint64 {
+	return uint64(sb.numOperations)
+}
+
+// Requirement returns the hardware requirement checked against the benchmark's throughput
+func (sb *storageBenchmark) Requirement() string {
+	switch sb.operation {
+	case StorageSequentialWrite:
+		return RequirementDiskSequentialWrites
+	case StorageRandomWrite:
+		return RequirementDiskRandomWrites
+	case StorageRandomRead:
+		return RequirementDiskRandomReads
+	default:
+		return ""
+	}
+}
+
+// Name returns the benchmark's name
+func (sb *storageBenchmark) Name() string {
+	return fmt.Sprintf("%s, %d x %d bytes", sb.name, sb.numOperations, sb.valueSize)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sb *storageBenchmark) IsInterfaceNil() bool {
+	return sb == nil
+}
@@ -0,0 +1,109 @@
+package benchmarks
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgStorageBenchmark(tb testing.TB, operation string) ArgStorageBenchmark {
+	return ArgStorageBenchmark{
+		Name:          "storage",
+		Directory:     tb.TempDir(),
+		Operation:     operation,
+		NumOperations: 1000,
+		ValueSize:     128,
+	}
+}
+
+func TestStorageBenchmark_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of operations should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createArgStorageBenchmark(t, StorageRandomWrite)
+		arg.NumOperations = 0
+		sb := NewStorageBenchmark(arg)
+
+		elapsed, err := sb.Run()
+		assert.True(t, errors.Is(err, ErrInvalidNumOperations))
+		assert.Zero(t, elapsed)
+	})
+	t.Run("invalid value size should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createArgStorageBenchmark(t, StorageRandomWrite)
+		arg.ValueSize = 0
+		sb := NewStorageBenchmark(arg)
+
+		elapsed, err := sb.Run()
+		assert.True(t, errors.Is(err, ErrInvalidValueSize))
+		assert.Zero(t, elapsed)
+	})
+	t.Run("unknown operation should error", func(t *testing.T) {
+		t.Parallel()
+
+		sb := NewStorageBenchmark(createArgStorageBenchmark(t, "unknown"))
+
+		elapsed, err := sb.Run()
+		assert.True(t, errors.Is(err, ErrUnknownStorageOperation))
+		assert.Zero(t, elapsed)
+		assert.Empty(t, sb.Requirement())
+	})
+
+	operations := map[string]string{
+		StorageSequentialWrite: RequirementDiskSequentialWrites,
+		StorageRandomWrite:     RequirementDiskRandomWrites,
+		StorageRandomRead:      RequirementDiskRandomReads,
+	}
+	for operation, requirement := range operations {
+		operation := operation
+		requirement := requirement
+		t.Run(operation+" should work", func(t *testing.T) {
+			t.Parallel()
+
+			arg := createArgStorageBenchmark(t, operation)
+			sb := NewStorageBenchmark(arg)
+			assert.False(t, check.IfNil(sb))
+
+			elapsed, err := sb.Run()
+			require.Nil(t, err)
+			assert.True(t, elapsed > 0)
+			assert.Equal(t, uint64(1000), sb.NumOperations())
+			assert.Equal(t, requirement, sb.Requirement())
+			assert.True(t, strings.Contains(sb.Name(), "1000 x 128 bytes"))
+
+			// the temporary database should be removed
+			entries, err := os.ReadDir(arg.Directory)
+			require.Nil(t, err)
+			assert.Empty(t, entries)
+		})
+	}
+}
+
+func TestCreateRandomKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := createRandomKeys(100)
+	require.Equal(t, 100, len(keys))
+
+	uniqueKeys := make(map[string]struct{})
+	for _, key := range keys {
+		assert.Equal(t, storageKeyLength, len(key))
+		uniqueKeys[string(key)] = struct{}{}
+	}
+	assert.Equal(t, 100, len(uniqueKeys))
+
+	shuffleKeys(keys)
+	assert.Equal(t, 100, len(keys))
+	for _, key := range keys {
+		_, found := uniqueKeys[string(key)]
+		assert.True(t, found)
+	}
+}
@@ -0,0 +1,177 @@
+package benchmarks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/enablers"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const (
+	accountValueSize     = 128
+	maxTrieLevelInMemory = 5
+	trieDirPattern       = "assessment-trie-*"
+)
+
+// ArgTrieCommitBenchmark is the trie commit type benchmark argument used in constructor
+type ArgTrieCommitBenchmark struct {
+	Name                string
+	Directory           string
+	NumBatches          int
+	NumAccountsPerBatch int
+}
+
+type trieCommitBenchmark struct {
+	name                string
+	directory           string
+	numBatches          int
+	numAccountsPerBatch int
+}
+
+// NewTrieCommitBenchmark creates a new benchmark measuring the accounts trie updates and commits on a synthetic state.
+// Each batch of accounts is committed, as it would happen at the end of each block. The trie is persisted in a
+// temporary folder inside the provided directory, removed after the benchmark ends
+func NewTrieCommitBenchmark(arg ArgTrieCommitBenchmark) *trieCommitBenchmark {
+	return &trieCommitBenchmark{
+		name:                arg.Name,
+		directory:           arg.Directory,
+		numBatches:          arg.NumBatches,
+		numAccountsPerBatch: arg.NumAccountsPerBatch,
+	}
+}
+
+// Run returns the time needed for the benchmark to be run
+func (tcb *trieCommitBenchmark) Run() (time.Duration, error) {
+	if tcb.numBatches < 1 || tcb.numAccountsPerBatch < 1 {
+		return 0, fmt.Errorf("%w: %d batches of %d accounts", ErrInvalidNumOperations, tcb.numBatches, tcb.numAccountsPerBatch)
+	}
+
+	tempDir, err := os.MkdirTemp(tcb.directory, trieDirPattern)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+
+	tr, trieStorage, err := createTrie(filepath.Join(tempDir, storageDbDirectory))
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tr.Close()
+	}()
+
+	elapsed, err := tcb.updateAndCommit(tr)
+	if err != nil {
+		_ = trieStorage.Close()
+		return 0, err
+	}
+
+	// closing the trie storage writes the remaining batched trie nodes on the disk
+	start := time.Now()
+	err = trieStorage.Close()
+
+	return elapsed + time.Since(start), err
+}
+
+func (tcb *trieCommitBenchmark) updateAndCommit(tr common.Trie) (time.Duration, error) {
+	elapsed := time.Duration(0)
+	for i := 0; i < tcb.numBatches; i++ {
+		keys := createRandomKeys(tcb.numAccountsPerBatch)
+		values, errCreate := createRandomValues(len(keys), accountValueSize)
+		if errCreate != nil {
+			return 0, errCreate
+		}
+
+		start := time.Now()
+		for j := range keys {
+			err := tr.Update(keys[j], values[j])
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		err := tr.Commit()
+		if err != nil {
+			return 0, err
+		}
+		elapsed += time.Since(start)
+	}
+
+	return elapsed, nil
+}
+
+func createTrie(dbPath string) (common.Trie, common.StorageManager, error) {
+	persister, err := database.NewSerialDB(dbPath, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	args := trie.NewTrieStorageManagerArgs{
+		MainStorer:  persister,
+		Marshalizer: marshaller,
+		Hasher:      hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			PruningBufferLen:      1000,
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 1,
+		},
+		IdleProvider:   disabled.NewProcessStatusHandler(),
+		Identifier:     dataRetriever.UserAccountsUnit.String(),
+		StatsCollector: disabledStatistics.NewStateStatistics(),
+	}
+	trieStorageManager, err := trie.NewTrieStorageManager(args)
+	if err != nil {
+		_ = persister.Close()
+		return nil, nil, err
+	}
+
+	enableEpochsHandler, err := enablers.NewEnableEpochsHandler(config.EnableEpochs{}, forking.NewGenericEpochNotifier())
+	if err != nil {
+		_ = trieStorageManager.Close()
+		return nil, nil, err
+	}
+
+	tr, err := trie.NewTrie(trieStorageManager, marshaller, hasher, enableEpochsHandler, maxTrieLevelInMemory)
+	if err != nil {
+		_ = trieStorageManager.Close()
+		return nil, nil, err
+	}
+
+	return tr, trieStorageManager, nil
+}
+
+// NumOperations returns the number of accounts updated and committed by the benchmark
+func (tcb *trieCommitBenchmark) NumOperations() uint64 {
+	return uint64(tcb.numBatches * tcb.numAccountsPerBatch)
+}
+
+// Requirement returns the hardware requirement checked against the benchmark's throughput
+func (tcb *trieCommitBenchmark) Requirement() string {
+	return RequirementTrieCommittedAccounts
+}
+
+// Name returns the benchmark's name
+func (tcb *trieCommitBenchmark) Name() string {
+	return fmt.Sprintf("%s, %d batches of %d accounts", tcb.name, tcb.numBatches, tcb.numAccountsPerBatch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tcb *trieCommitBenchmark) IsInterfaceNil() bool {
+	return tcb == nil
+}
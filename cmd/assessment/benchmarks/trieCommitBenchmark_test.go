@@ -0,0 +1,54 @@
+package benchmarks
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrieCommitBenchmark_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		tcb := NewTrieCommitBenchmark(ArgTrieCommitBenchmark{
+			Name:                "trie",
+			Directory:           t.TempDir(),
+			NumBatches:          2,
+			NumAccountsPerBatch: 0,
+		})
+
+		elapsed, err := tcb.Run()
+		assert.True(t, errors.Is(err, ErrInvalidNumOperations))
+		assert.Zero(t, elapsed)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		tcb := NewTrieCommitBenchmark(ArgTrieCommitBenchmark{
+			Name:                "trie",
+			Directory:           directory,
+			NumBatches:          3,
+			NumAccountsPerBatch: 100,
+		})
+		assert.False(t, check.IfNil(tcb))
+
+		elapsed, err := tcb.Run()
+		require.Nil(t, err)
+		assert.True(t, elapsed > 0)
+		assert.Equal(t, uint64(300), tcb.NumOperations())
+		assert.Equal(t, RequirementTrieCommittedAccounts, tcb.Requirement())
+		assert.True(t, strings.Contains(tcb.Name(), "3 batches of 100 accounts"))
+
+		entries, err := os.ReadDir(directory)
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/cmd/assessment/benchmarks"
 	"github.com/multiversx/mx-chain-go/cmd/assessment/benchmarks/factory"
 	"github.com/multiversx/mx-chain-go/common/hostParameters"
+	"github.com/multiversx/mx-chain-go/config"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
@@ -43,6 +44,19 @@ VERSION:
 		Usage: "The output file format where benchmarks will be written in csv format.",
 		Value: "./output-" + hostPlaceholder + "-" + timestampPlaceholder + ".csv",
 	}
+	// configurationFile defines a flag for the node's config file, holding the hardware requirements
+	configurationFile = cli.StringFlag{
+		Name:  "config",
+		Usage: "The node's main configuration file. The benchmarks results are checked against its [HardwareRequirements] section.",
+		Value: "../node/config/config.toml",
+	}
+	// storageDirectory defines a flag for the directory used by the storage benchmarks
+	storageDirectory = cli.StringFlag{
+		Name: "storage-directory",
+		Usage: "The directory in which the storage benchmarks will temporarily write their data. It should be on the " +
+			"same disk as the node's working directory.",
+		Value: ".",
+	}
 
 	log = logger.GetOrCreate("main")
 )
@@ -60,6 +74,8 @@ func main() {
 		"produces anonymized host parameters along with a list of benchmarks results. More details can be found in the README.md file."
 	app.Flags = []cli.Flag{
 		outputFile,
+		configurationFile,
+		storageDirectory,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -84,6 +100,17 @@ func startAssessment(c *cli.Context, version string, machineID string) error {
 	outputFileName = strings.Replace(outputFileName, hostPlaceholder, machineID, 1)
 	outputFileName = strings.Replace(outputFileName, timestampPlaceholder, fmt.Sprintf("%d", time.Now().Unix()), 1)
 
+	generalConfig := &config.Config{}
+	err := core.LoadTomlFile(generalConfig, c.GlobalString(configurationFile.Name))
+	if err != nil {
+		return err
+	}
+
+	missingCPUFlags, err := benchmarks.CheckCPUFlags(generalConfig.HardwareRequirements.CPUFlags)
+	if err != nil {
+		return err
+	}
+
 	log.Info("Saving benchmarks result", "file", outputFileName)
 	log.Info("Starting host assessment process...")
 	sw := core.NewStopWatch()
@@ -94,7 +121,7 @@ func startAssessment(c *cli.Context, version string, machineID string) error {
 	}()
 	log.Info("Benchmark in progress. Please wait!")
 
-	run, err := factory.NewRunner("./testdata")
+	run, err := factory.NewRunner("./testdata", c.GlobalString(storageDirectory.Name))
 	if err != nil {
 		return err
 	}
@@ -108,7 +135,11 @@ func startAssessment(c *cli.Context, version string, machineID string) error {
 
 	printFinalResult(benchmarkResult)
 
-	err = saveToFile(hostInfo, benchmarkResult, outputFileName)
+	report := benchmarks.NewHardwareReport(generalConfig.HardwareRequirements, benchmarkResult, missingCPUFlags)
+	log.Info("Hardware requirements report:\n" + report.ToDisplayTable())
+	printRecommendation(report)
+
+	err = saveToFile(hostInfo, benchmarkResult, report, outputFileName)
 
 	return err
 }
@@ -129,7 +160,21 @@ func printFinalResult(results *benchmarks.TestResults) {
 		"obtained", results.TotalDuration)
 }
 
-func saveToFile(hi *hostParameters.HostInfo, results *benchmarks.TestResults, outputFileName string) error {
+func printRecommendation(report *benchmarks.HardwareReport) {
+	if report.IsSuitableForValidator() {
+		log.Info(report.Recommendation())
+		return
+	}
+
+	log.Error(report.Recommendation())
+}
+
+func saveToFile(
+	hi *hostParameters.HostInfo,
+	results *benchmarks.TestResults,
+	report *benchmarks.HardwareReport,
+	outputFileName string,
+) error {
 	buff := bytes.NewBuffer(make([]byte, 0))
 	csvWriter := csv.NewWriter(buff)
 	err := csvWriter.WriteAll(hi.ToStrings())
@@ -140,6 +185,10 @@ func saveToFile(hi *hostParameters.HostInfo, results *benchmarks.TestResults, ou
 	if err != nil {
 		return err
 	}
+	err = csvWriter.WriteAll(report.ToStrings())
+	if err != nil {
+		return err
+	}
 
 	return os.WriteFile(outputFileName, buff.Bytes(), core.FileModeReadWrite)
 }
@@ -0,0 +1,31 @@
+package mock
+
+// ThroughputBenchmarkStub -
+type ThroughputBenchmarkStub struct {
+	BenchmarkStub
+	NumOperationsCalled func() uint64
+	RequirementCalled   func() string
+}
+
+// NumOperations -
+func (tbs *ThroughputBenchmarkStub) NumOperations() uint64 {
+	if tbs.NumOperationsCalled != nil {
+		return tbs.NumOperationsCalled()
+	}
+
+	return 0
+}
+
+// Requirement -
+func (tbs *ThroughputBenchmarkStub) Requirement() string {
+	if tbs.RequirementCalled != nil {
+		return tbs.RequirementCalled()
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (tbs *ThroughputBenchmarkStub) IsInterfaceNil() bool {
+	return tbs == nil
+}
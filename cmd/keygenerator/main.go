@@ -3,9 +3,11 @@ package main
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -20,17 +22,24 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
 	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
+	"github.com/multiversx/mx-chain-go/cmd/keygenerator/wallet"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
 
 type cfg struct {
-	numKeys       int
-	keyType       string
-	consoleOut    bool
-	noSplit       bool
-	prefixPattern string
-	shardIDByte   int
+	numKeys              int
+	keyType              string
+	consoleOut           bool
+	noSplit              bool
+	prefixPattern        string
+	shardIDByte          int
+	withMnemonic         bool
+	mnemonicFile         string
+	accountIndex         uint
+	addressIndex         uint
+	keystore             bool
+	keystorePasswordFile string
 }
 
 const validatorType = "validator"
@@ -123,11 +132,57 @@ VERSION:
 		Value:       -1,
 		Destination: &argsConfig.shardIDByte,
 	}
+	// withMnemonic is the flag that, if active, will derive the wallet keys from a newly generated mnemonic
+	withMnemonic = cli.BoolFlag{
+		Name: "with-mnemonic",
+		Usage: "Boolean option that will generate a new BIP39 mnemonic and derive the wallet keys from it. The mnemonic " +
+			"is saved in the mnemonic.txt file or printed on the console. Only available for the wallet key type",
+		Destination: &argsConfig.withMnemonic,
+	}
+	// mnemonicFile defines a flag for the file containing the mnemonic the wallet keys will be derived from
+	mnemonicFile = cli.StringFlag{
+		Name: "mnemonic-file",
+		Usage: "Path to a file containing an existing BIP39 mnemonic. The wallet keys will be re-derived from it, " +
+			"for example to audit the addresses of a wallet. Only available for the wallet key type",
+		Value:       "",
+		Destination: &argsConfig.mnemonicFile,
+	}
+	// accountIndex defines a flag for the account index of the derivation path
+	accountIndex = cli.UintFlag{
+		Name:        "account-index",
+		Usage:       "The account index used when deriving the wallet keys along the m/44'/508'/account'/0'/address' path",
+		Value:       0,
+		Destination: &argsConfig.accountIndex,
+	}
+	// addressIndex defines a flag for the first address index of the derivation path
+	addressIndex = cli.UintFlag{
+		Name: "address-index",
+		Usage: "The address index of the first derived wallet key, along the m/44'/508'/account'/0'/address' path. " +
+			"The following keys will use the next address indexes",
+		Value:       0,
+		Destination: &argsConfig.addressIndex,
+	}
+	// keystore is the flag that, if active, will output the wallet keys as password-encrypted JSON keystores
+	keystore = cli.BoolFlag{
+		Name: "keystore",
+		Usage: "Boolean option that will output the wallet keys as password-encrypted JSON keystores, compatible with " +
+			"the MultiversX wallets, instead of PEM files. Each keystore is saved in a file named after the address",
+		Destination: &argsConfig.keystore,
+	}
+	// keystorePasswordFile defines a flag for the file containing the password of the keystores
+	keystorePasswordFile = cli.StringFlag{
+		Name:        "keystore-password-file",
+		Usage:       "Path to a file containing the password used to encrypt the keystores. Required with the keystore option",
+		Value:       "",
+		Destination: &argsConfig.keystorePasswordFile,
+	}
 	argsConfig = &cfg{}
 
 	walletKeyFilenameTemplate    = "walletKey%s.pem"
 	validatorKeyFilenameTemplate = "validatorKey%s.pem"
 	p2pKeyFilenameTemplate       = "p2pKey%s.pem"
+	mnemonicFilenameTemplate     = "mnemonic%s.txt"
+	keystoreFilenameTemplate     = "%s.json"
 
 	log = logger.GetOrCreate("keygenerator")
 
@@ -155,6 +210,12 @@ func main() {
 		noSplit,
 		shardIDByte,
 		keyPrefix,
+		withMnemonic,
+		mnemonicFile,
+		accountIndex,
+		addressIndex,
+		keystore,
+		keystorePasswordFile,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -170,14 +231,180 @@ func main() {
 }
 
 func process() error {
-	validatorKeys, walletKeys, p2pKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
+	var validatorKeys, walletKeys, p2pKeys []key
+	var err error
+	if argsConfig.withMnemonic || len(argsConfig.mnemonicFile) > 0 {
+		walletKeys, err = deriveWalletKeys(argsConfig)
+	} else {
+		validatorKeys, walletKeys, p2pKeys, err = generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
+	}
 	if err != nil {
 		return err
 	}
 
+	if argsConfig.keystore {
+		if len(validatorKeys)+len(p2pKeys) > 0 {
+			return fmt.Errorf("the keystore output is only available for the %s and %s key types", walletType, minedWalletPrefixKeys)
+		}
+
+		return outputKeystores(walletKeys, argsConfig.keystorePasswordFile, argsConfig.consoleOut)
+	}
+
 	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit)
 }
 
+func deriveWalletKeys(config *cfg) ([]key, error) {
+	if config.numKeys < 1 {
+		return nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
+	}
+	if config.keyType != walletType {
+		return nil, fmt.Errorf("the keys can be derived from a mnemonic only for the %s key type", walletType)
+	}
+	if config.withMnemonic && len(config.mnemonicFile) > 0 {
+		return nil, fmt.Errorf("the with-mnemonic and mnemonic-file options can not be used together")
+	}
+
+	mnemonic, err := loadOrGenerateMnemonic(config)
+	if err != nil {
+		return nil, err
+	}
+
+	txSigningGenerator := signing.NewKeyGenerator(ed25519.NewEd25519())
+	walletKeys := make([]key, 0, config.numKeys)
+	for i := 0; i < config.numKeys; i++ {
+		index := uint64(config.addressIndex) + uint64(i)
+		if index > math.MaxUint32 || uint64(config.accountIndex) > math.MaxUint32 {
+			return nil, wallet.ErrInvalidDerivationIndex
+		}
+
+		seed, errDerive := wallet.DeriveSecretKey(mnemonic, uint32(config.accountIndex), uint32(index))
+		if errDerive != nil {
+			return nil, errDerive
+		}
+
+		walletKeys, err = createKeyFromSeed(txSigningGenerator, seed, walletKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return walletKeys, nil
+}
+
+func loadOrGenerateMnemonic(config *cfg) (string, error) {
+	if len(config.mnemonicFile) > 0 {
+		mnemonicBytes, err := os.ReadFile(config.mnemonicFile)
+		if err != nil {
+			return "", err
+		}
+
+		return wallet.NormalizeMnemonic(string(mnemonicBytes)), nil
+	}
+
+	mnemonic, err := wallet.GenerateMnemonic()
+	if err != nil {
+		return "", err
+	}
+
+	if config.consoleOut {
+		log.Info("Mnemonic (keep it secret, anyone knowing it controls the derived wallets):\n\n" + mnemonic + "\n")
+		return mnemonic, nil
+	}
+
+	return mnemonic, saveMnemonic(mnemonic)
+}
+
+func saveMnemonic(mnemonic string) error {
+	folder, err := generateFolder(0, 1, true)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(folder, mnemonicFilenameTemplate)
+	backupFileIfExists(filename)
+	filename = fmt.Sprintf(filename, "")
+
+	return os.WriteFile(filename, []byte(mnemonic+"\n"), core.FileModeUserReadWrite)
+}
+
+func createKeyFromSeed(keyGen crypto.KeyGenerator, seed []byte, list []key) ([]key, error) {
+	sk, err := keyGen.PrivateKeyFromByteArray(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	skBytes, err := sk.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(list, key{skBytes: skBytes, pkBytes: pkBytes}), nil
+}
+
+func outputKeystores(walletKeys []key, passwordFile string, consoleOut bool) error {
+	if len(walletKeys) == 0 {
+		return fmt.Errorf("internal error: no keys to save")
+	}
+	if len(passwordFile) == 0 {
+		return fmt.Errorf("the keystore-password-file option is required with the keystore option")
+	}
+
+	passwordBytes, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return err
+	}
+	password := strings.TrimRight(string(passwordBytes), "\r\n")
+	if len(password) == 0 {
+		return fmt.Errorf("empty keystore password")
+	}
+
+	for _, k := range walletKeys {
+		err = outputKeystore(k, password, consoleOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func outputKeystore(k key, password string, consoleOut bool) error {
+	address, err := walletPubKeyConverter.Encode(k.pkBytes)
+	if err != nil {
+		return err
+	}
+
+	// the wallet keys hold the ed25519 seed followed by the public key
+	keyFile, err := wallet.EncryptSecretKey(k.skBytes[:wallet.SecretKeyLength], k.pkBytes, address, password)
+	if err != nil {
+		return err
+	}
+
+	keyFileBytes, err := json.MarshalIndent(keyFile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if consoleOut {
+		log.Info("Keystore for " + address + ":\n\n" + string(keyFileBytes) + "\n")
+		return nil
+	}
+
+	folder, err := generateFolder(0, 1, true)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(folder, fmt.Sprintf(keystoreFilenameTemplate, address))
+
+	return os.WriteFile(filename, keyFileBytes, core.FileModeUserReadWrite)
+}
+
 func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]key, []key, []key, error) {
 	if numKeys < 1 {
 		return nil, nil, nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
@@ -0,0 +1,18 @@
+package wallet
+
+import "errors"
+
+// ErrInvalidMnemonic signals that the provided mnemonic is not a valid BIP39 mnemonic
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// ErrInvalidSecretKeyLength signals that the secret key does not have the expected length
+var ErrInvalidSecretKeyLength = errors.New("invalid secret key length")
+
+// ErrInvalidPassword signals that the keystore could not be decrypted with the provided password
+var ErrInvalidPassword = errors.New("invalid password")
+
+// ErrUnsupportedKeystore signals that the keystore uses a version, cipher or key derivation function not supported
+var ErrUnsupportedKeystore = errors.New("unsupported keystore")
+
+// ErrInvalidDerivationIndex signals that a derivation index is out of the hardened indexes range
+var ErrInvalidDerivationIndex = errors.New("invalid derivation index")
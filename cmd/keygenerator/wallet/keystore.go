@@ -0,0 +1,224 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keystoreVersion    = 4
+	keystoreKind       = "secretKey"
+	keystoreCipher     = "aes-128-ctr"
+	keystoreKdf        = "scrypt"
+	scryptN            = 4096
+	scryptR            = 8
+	scryptP            = 1
+	scryptDkLen        = 32
+	saltLength         = 32
+	encryptionKeyLen   = 16
+	uuidLength         = 16
+	uuidVersion4       = 0x40
+	uuidVariantRFC4122 = 0x80
+)
+
+// KeyFile is the password-encrypted JSON keystore of a wallet secret key, in the format used by the MultiversX wallets
+type KeyFile struct {
+	Version int        `json:"version"`
+	Kind    string     `json:"kind"`
+	ID      string     `json:"id"`
+	Address string     `json:"address"`
+	Bech32  string     `json:"bech32"`
+	Crypto  CryptoData `json:"crypto"`
+}
+
+// CryptoData holds the encrypted secret key together with the parameters needed to decrypt it
+type CryptoData struct {
+	Ciphertext   string       `json:"ciphertext"`
+	CipherParams CipherParams `json:"cipherparams"`
+	Cipher       string       `json:"cipher"`
+	KDF          string       `json:"kdf"`
+	KDFParams    KDFParams    `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+// CipherParams holds the parameters of the cipher
+type CipherParams struct {
+	IV string `json:"iv"`
+}
+
+// KDFParams holds the parameters of the scrypt key derivation function
+type KDFParams struct {
+	DkLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+}
+
+// EncryptSecretKey encrypts the 32 bytes ed25519 secret key with the provided password. The key used for encryption is
+// derived with scrypt, the secret key is encrypted with AES-128-CTR and authenticated with HMAC-SHA256. As the
+// MultiversX wallets do, the encrypted content is the secret key followed by the public key
+func EncryptSecretKey(secretKey []byte, publicKey []byte, bech32Address string, password string) (*KeyFile, error) {
+	if len(secretKey) != SecretKeyLength {
+		return nil, fmt.Errorf("%w, expected %d, got %d", ErrInvalidSecretKeyLength, SecretKeyLength, len(secretKey))
+	}
+
+	plaintext := make([]byte, 0, len(secretKey)+len(publicKey))
+	plaintext = append(plaintext, secretKey...)
+	plaintext = append(plaintext, publicKey...)
+
+	salt, err := generateRandomBytes(saltLength)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := generateRandomBytes(aes.BlockSize)
+	if err != nil {
+		return nil, err
+	}
+	id, err := generateUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	kdfParams := KDFParams{
+		DkLen: scryptDkLen,
+		Salt:  hex.EncodeToString(salt),
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+	}
+	derivedKey, err := deriveKey(password, salt, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := applyCipher(derivedKey[:encryptionKeyLen], iv, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyFile{
+		Version: keystoreVersion,
+		Kind:    keystoreKind,
+		ID:      id,
+		Address: hex.EncodeToString(publicKey),
+		Bech32:  bech32Address,
+		Crypto: CryptoData{
+			Ciphertext:   hex.EncodeToString(ciphertext),
+			CipherParams: CipherParams{IV: hex.EncodeToString(iv)},
+			Cipher:       keystoreCipher,
+			KDF:          keystoreKdf,
+			KDFParams:    kdfParams,
+			MAC:          hex.EncodeToString(computeMac(derivedKey, ciphertext)),
+		},
+	}, nil
+}
+
+// DecryptSecretKey decrypts the 32 bytes secret key stored in the keystore, using the provided password
+func DecryptSecretKey(keyFile *KeyFile, password string) ([]byte, error) {
+	if keyFile == nil {
+		return nil, fmt.Errorf("%w: nil key file", ErrUnsupportedKeystore)
+	}
+	// the kind field is missing from the keystores created by the older wallets
+	isSecretKeyKind := len(keyFile.Kind) == 0 || keyFile.Kind == keystoreKind
+	if keyFile.Version != keystoreVersion || !isSecretKeyKind {
+		return nil, fmt.Errorf("%w: version %d, kind %s", ErrUnsupportedKeystore, keyFile.Version, keyFile.Kind)
+	}
+	if keyFile.Crypto.Cipher != keystoreCipher || keyFile.Crypto.KDF != keystoreKdf {
+		return nil, fmt.Errorf("%w: cipher %s, kdf %s", ErrUnsupportedKeystore, keyFile.Crypto.Cipher, keyFile.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(keyFile.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := hex.DecodeString(keyFile.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := hex.DecodeString(keyFile.Crypto.Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := hex.DecodeString(keyFile.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := deriveKey(password, salt, keyFile.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(derivedKey) < encryptionKeyLen*2 {
+		return nil, fmt.Errorf("%w: derived key length %d", ErrUnsupportedKeystore, len(derivedKey))
+	}
+	if !hmac.Equal(mac, computeMac(derivedKey, ciphertext)) {
+		return nil, ErrInvalidPassword
+	}
+
+	plaintext, err := applyCipher(derivedKey[:encryptionKeyLen], iv, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if len(plaintext) < SecretKeyLength {
+		return nil, fmt.Errorf("%w, expected at least %d, got %d", ErrInvalidSecretKeyLength, SecretKeyLength, len(plaintext))
+	}
+
+	return plaintext[:SecretKeyLength], nil
+}
+
+func deriveKey(password string, salt []byte, params KDFParams) ([]byte, error) {
+	return scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DkLen)
+}
+
+// applyCipher both encrypts and decrypts, as the CTR mode is symmetric
+func applyCipher(key []byte, iv []byte, input []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != block.BlockSize() {
+		return nil, fmt.Errorf("%w: iv length %d", ErrUnsupportedKeystore, len(iv))
+	}
+
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+
+	return output, nil
+}
+
+func computeMac(derivedKey []byte, ciphertext []byte) []byte {
+	mac := hmac.New(sha256.New, derivedKey[encryptionKeyLen:encryptionKeyLen*2])
+	_, _ = mac.Write(ciphertext)
+
+	return mac.Sum(nil)
+}
+
+func generateRandomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+func generateUUID() (string, error) {
+	id, err := generateRandomBytes(uuidLength)
+	if err != nil {
+		return "", err
+	}
+
+	id[6] = id[6]&0x0f | uuidVersion4
+	id[8] = id[8]&0x3f | uuidVariantRFC4122
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]), nil
+}
@@ -0,0 +1,134 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretKey = "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9"
+	testPublicKey = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+	testBech32    = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testPassword  = "password"
+)
+
+// aliceKeystore is the keystore of the alice test wallet, as generated by the MultiversX wallets
+const aliceKeystore = `{
+	"version": 4,
+	"id": "0dc10c02-b59b-4bac-9710-6b2cfa4284ba",
+	"address": "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1",
+	"bech32": "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+	"crypto": {
+		"ciphertext": "4c41ef6fdfd52c39b1585a875eb3c86d30a315642d0e35bb8205b6372c1882f135441099b11ff76345a6f3a930b5665aaf9f7325a32c8ccd60081c797aa2d538",
+		"cipherparams": {"iv": "033182afaa1ebaafcde9ccc68a5eac31"},
+		"cipher": "aes-128-ctr",
+		"kdf": "scrypt",
+		"kdfparams": {"dklen": 32, "salt": "4903bd0e7880baa04fc4f886518ac5c672cdc745a6bd13dcec2b6c12e9bffe8d", "n": 4096, "r": 8, "p": 1},
+		"mac": "5b4a6f14ab74ba7ca23db6847e28447f0e6a7724ba9664cf425df707a84f5a8b"
+	}
+}`
+
+func createTestKeyFile(tb testing.TB) *KeyFile {
+	secretKey, _ := hex.DecodeString(testSecretKey)
+	publicKey, _ := hex.DecodeString(testPublicKey)
+
+	keyFile, err := EncryptSecretKey(secretKey, publicKey, testBech32, testPassword)
+	require.Nil(tb, err)
+
+	return keyFile
+}
+
+func TestEncryptSecretKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid secret key length should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile, err := EncryptSecretKey(make([]byte, 64), make([]byte, 32), testBech32, testPassword)
+		assert.True(t, errors.Is(err, ErrInvalidSecretKeyLength))
+		assert.Nil(t, keyFile)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile := createTestKeyFile(t)
+		assert.Equal(t, 4, keyFile.Version)
+		assert.Equal(t, "secretKey", keyFile.Kind)
+		assert.Equal(t, 36, len(keyFile.ID))
+		assert.Equal(t, testPublicKey, keyFile.Address)
+		assert.Equal(t, testBech32, keyFile.Bech32)
+		assert.Equal(t, "aes-128-ctr", keyFile.Crypto.Cipher)
+		assert.Equal(t, "scrypt", keyFile.Crypto.KDF)
+		assert.Equal(t, KDFParams{DkLen: 32, Salt: keyFile.Crypto.KDFParams.Salt, N: 4096, R: 8, P: 1}, keyFile.Crypto.KDFParams)
+		assert.Equal(t, 128, len(keyFile.Crypto.Ciphertext))
+
+		otherKeyFile := createTestKeyFile(t)
+		assert.NotEqual(t, keyFile.ID, otherKeyFile.ID)
+		assert.NotEqual(t, keyFile.Crypto.KDFParams.Salt, otherKeyFile.Crypto.KDFParams.Salt)
+		assert.NotEqual(t, keyFile.Crypto.Ciphertext, otherKeyFile.Crypto.Ciphertext)
+	})
+}
+
+func TestDecryptSecretKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DecryptSecretKey(nil, testPassword)
+		assert.True(t, errors.Is(err, ErrUnsupportedKeystore))
+		assert.Nil(t, secretKey)
+	})
+	t.Run("unsupported version or cipher should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile := createTestKeyFile(t)
+		keyFile.Version = 3
+		secretKey, err := DecryptSecretKey(keyFile, testPassword)
+		assert.True(t, errors.Is(err, ErrUnsupportedKeystore))
+		assert.Nil(t, secretKey)
+
+		keyFile = createTestKeyFile(t)
+		keyFile.Crypto.Cipher = "aes-128-cbc"
+		secretKey, err = DecryptSecretKey(keyFile, testPassword)
+		assert.True(t, errors.Is(err, ErrUnsupportedKeystore))
+		assert.Nil(t, secretKey)
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DecryptSecretKey(createTestKeyFile(t), "wrong password")
+		assert.Equal(t, ErrInvalidPassword, err)
+		assert.Nil(t, secretKey)
+	})
+	t.Run("should decrypt the keystore of a reference wallet", func(t *testing.T) {
+		t.Parallel()
+
+		keyFile := &KeyFile{}
+		err := json.Unmarshal([]byte(aliceKeystore), keyFile)
+		require.Nil(t, err)
+
+		secretKey, err := DecryptSecretKey(keyFile, testPassword)
+		require.Nil(t, err)
+		assert.Equal(t, testSecretKey, hex.EncodeToString(secretKey))
+	})
+	t.Run("should work after a JSON round trip", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := json.Marshal(createTestKeyFile(t))
+		require.Nil(t, err)
+
+		keyFile := &KeyFile{}
+		err = json.Unmarshal(buff, keyFile)
+		require.Nil(t, err)
+
+		secretKey, err := DecryptSecretKey(keyFile, testPassword)
+		require.Nil(t, err)
+		assert.Equal(t, testSecretKey, hex.EncodeToString(secretKey))
+	})
+}
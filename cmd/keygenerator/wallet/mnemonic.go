@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	mnemonicEntropyBitSize = 256
+	hardenedKeyOffset      = 0x80000000
+	ed25519CurveSeed       = "ed25519 seed"
+	purposeIndex           = 44
+	coinTypeIndex          = 508
+	changeIndex            = 0
+
+	// SecretKeyLength is the length of the ed25519 secret key (seed) derived from a mnemonic
+	SecretKeyLength = 32
+)
+
+// GenerateMnemonic generates a new random 24 words BIP39 mnemonic
+func GenerateMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBitSize)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// NormalizeMnemonic removes the extra white spaces from the mnemonic and converts it to lower case
+func NormalizeMnemonic(mnemonic string) string {
+	return strings.ToLower(strings.Join(strings.Fields(mnemonic), " "))
+}
+
+// DeriveSecretKey derives the ed25519 secret key of a wallet from the provided mnemonic, along the
+// m/44'/508'/account'/0'/addressIndex' path used by the MultiversX wallets
+func DeriveSecretKey(mnemonic string, account uint32, addressIndex uint32) ([]byte, error) {
+	if account >= hardenedKeyOffset || addressIndex >= hardenedKeyOffset {
+		return nil, ErrInvalidDerivationIndex
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(NormalizeMnemonic(mnemonic), "")
+	if err != nil {
+		return nil, ErrInvalidMnemonic
+	}
+
+	path := []uint32{purposeIndex, coinTypeIndex, account, changeIndex, addressIndex}
+
+	digest := computeHmacSha512([]byte(ed25519CurveSeed), seed)
+	key, chainCode := digest[:SecretKeyLength], digest[SecretKeyLength:]
+	for _, index := range path {
+		// ed25519 only supports hardened derivation, as described by SLIP-0010
+		data := make([]byte, 0, 1+SecretKeyLength+4)
+		data = append(data, 0)
+		data = append(data, key...)
+		data = binary.BigEndian.AppendUint32(data, index+hardenedKeyOffset)
+
+		digest = computeHmacSha512(chainCode, data)
+		key, chainCode = digest[:SecretKeyLength], digest[SecretKeyLength:]
+	}
+
+	return key, nil
+}
+
+func computeHmacSha512(key []byte, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	_, _ = mac.Write(data)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const testMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather " +
+	"prepare woman film husband gravity behind test tiger improve"
+
+func TestGenerateMnemonic(t *testing.T) {
+	t.Parallel()
+
+	mnemonic, err := GenerateMnemonic()
+	require.Nil(t, err)
+	assert.Equal(t, 24, len(strings.Fields(mnemonic)))
+	assert.True(t, bip39.IsMnemonicValid(mnemonic))
+
+	otherMnemonic, err := GenerateMnemonic()
+	require.Nil(t, err)
+	assert.NotEqual(t, mnemonic, otherMnemonic)
+}
+
+func TestNormalizeMnemonic(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "moral volcano peasant", NormalizeMnemonic(" Moral  volcano\n\tPEASANT \n"))
+}
+
+func TestDeriveSecretKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DeriveSecretKey("moral volcano peasant", 0, 0)
+		assert.Equal(t, ErrInvalidMnemonic, err)
+		assert.Nil(t, secretKey)
+
+		invalidChecksumMnemonic := strings.Replace(testMnemonic, "improve", "moral", 1)
+		secretKey, err = DeriveSecretKey(invalidChecksumMnemonic, 0, 0)
+		assert.Equal(t, ErrInvalidMnemonic, err)
+		assert.Nil(t, secretKey)
+	})
+	t.Run("invalid index should error", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DeriveSecretKey(testMnemonic, hardenedKeyOffset, 0)
+		assert.Equal(t, ErrInvalidDerivationIndex, err)
+		assert.Nil(t, secretKey)
+
+		secretKey, err = DeriveSecretKey(testMnemonic, 0, hardenedKeyOffset)
+		assert.Equal(t, ErrInvalidDerivationIndex, err)
+		assert.Nil(t, secretKey)
+	})
+	t.Run("should derive the wallet keys", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSecretKeys := []string{
+			"413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9",
+			"b8ca6f8203fb4b545a8e83c5384da033c415db155b53fb5b8eba7ff5a039d639",
+			"e253a571ca153dc2aee845819f74bcc9773b0586edead15a94cb7235a5027436",
+		}
+		for index, expectedSecretKey := range expectedSecretKeys {
+			secretKey, err := DeriveSecretKey(testMnemonic, 0, uint32(index))
+			require.Nil(t, err)
+			assert.Equal(t, expectedSecretKey, hex.EncodeToString(secretKey))
+		}
+	})
+	t.Run("mnemonic should be normalized", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey, err := DeriveSecretKey(" "+strings.ToUpper(testMnemonic)+"\n", 0, 0)
+		require.Nil(t, err)
+		assert.Equal(t, "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9", hex.EncodeToString(secretKey))
+	})
+	t.Run("different accounts should derive different keys", func(t *testing.T) {
+		t.Parallel()
+
+		secretKey0, err := DeriveSecretKey(testMnemonic, 0, 0)
+		require.Nil(t, err)
+		secretKey1, err := DeriveSecretKey(testMnemonic, 1, 0)
+		require.Nil(t, err)
+		assert.NotEqual(t, secretKey0, secretKey1)
+	})
+}
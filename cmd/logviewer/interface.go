@@ -0,0 +1,14 @@
+package main
+
+import "github.com/multiversx/mx-chain-go/cmd/logviewer/viewer"
+
+type lineFilterHandler interface {
+	ShouldDisplay(line *viewer.LogLine) bool
+	IsInterfaceNil() bool
+}
+
+type streamMergerHandler interface {
+	Add(line *viewer.LogLine)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -1,19 +1,24 @@
 package main
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/cmd/logviewer/viewer"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
@@ -34,6 +39,13 @@ type config struct {
 	useWss             bool
 	logWithCorrelation bool
 	logWithLoggerName  bool
+	loggerFilter       string
+	contentFilter      string
+	highlightFields    string
+	mergeWindowInMs    uint64
+	noColors           bool
+	replay             string
+	replaySpeed        float64
 }
 
 var (
@@ -52,10 +64,13 @@ VERSION:
    {{.Version}}
    {{end}}
 `
-	// address defines a flag for setting the address and port on which the node will listen for connections
+	// address defines a flag for setting the address(es) and port(s) on which the node(s) will listen for connections
 	address = cli.StringFlag{
-		Name:        "address",
-		Usage:       "Address and port number on which the application will try to connect to the mx-chain-go node",
+		Name: "address",
+		Usage: "Address and port number on which the application will try to connect to the mx-chain-go node. It can " +
+			"contain multiple comma-separated values, in which case the logs of all the nodes will be merged by timestamp. " +
+			"Each address can be prefixed by the name displayed for that node, for example: " +
+			"shard0=127.0.0.1:8080,meta=127.0.0.1:8081",
 		Value:       "127.0.0.1:8080",
 		Destination: &argsConfig.address,
 	}
@@ -72,7 +87,7 @@ VERSION:
 	// logFile is used when the log output needs to be logged in a file
 	logSaveFile = cli.BoolFlag{
 		Name:        "log-save",
-		Usage:       "Boolean option for enabling log saving. If set, it will automatically save all the logs into a file.",
+		Usage:       "Boolean option for enabling log saving. If set, it will automatically save all the received logs into a file per node, regardless of the filters.",
 		Destination: &argsConfig.logSave,
 	}
 	// useWss is used when the user require connection through wss
@@ -93,6 +108,57 @@ VERSION:
 		Usage:       "Boolean option for logger name in the logs.",
 		Destination: &argsConfig.logWithLoggerName,
 	}
+	// loggerFilter defines a flag for displaying only the lines of the matching loggers
+	loggerFilter = cli.StringFlag{
+		Name:        "logger-filter",
+		Usage:       "Regular expression that the logger name has to match in order for the line to be displayed, for example: ^process/(block|sync)",
+		Value:       "",
+		Destination: &argsConfig.loggerFilter,
+	}
+	// contentFilter defines a flag for displaying only the lines containing a message or a field that matches
+	contentFilter = cli.StringFlag{
+		Name: "content-filter",
+		Usage: "Regular expression that either the message or one of the fields, in the form key = value, has to " +
+			"match in order for the line to be displayed, for example: nonce = 1234$",
+		Value:       "",
+		Destination: &argsConfig.contentFilter,
+	}
+	// highlightFields defines a flag for the fields that are highlighted in the displayed lines
+	highlightFields = cli.StringFlag{
+		Name:        "highlight-fields",
+		Usage:       "Comma-separated list of the fields names that are highlighted, together with the correlation elements",
+		Value:       "shard,epoch,round",
+		Destination: &argsConfig.highlightFields,
+	}
+	// mergeWindow defines a flag for the time a line is held in order to be ordered by timestamp with the other nodes' lines
+	mergeWindow = cli.Uint64Flag{
+		Name:        "merge-window-in-ms",
+		Usage:       "The time, in milliseconds, a received line is held in order to be merged by timestamp with the lines received from the other nodes",
+		Value:       500,
+		Destination: &argsConfig.mergeWindowInMs,
+	}
+	// noColors defines a flag for disabling the ANSI colors
+	noColors = cli.BoolFlag{
+		Name:        "no-colors",
+		Usage:       "Boolean option for disabling the colors of the displayed lines",
+		Destination: &argsConfig.noColors,
+	}
+	// replay defines a flag for replaying saved log files instead of connecting to nodes
+	replay = cli.StringFlag{
+		Name: "replay",
+		Usage: "Comma-separated list of saved log files (as written by the node or by this application) which will be " +
+			"replayed instead of connecting to the node(s). Each file can be prefixed by the displayed name, for example: " +
+			"shard0=logs/shard0.log,meta=logs/meta.log",
+		Value:       "",
+		Destination: &argsConfig.replay,
+	}
+	// replaySpeed defines a flag for the speed the saved log files are replayed with
+	replaySpeed = cli.Float64Flag{
+		Name:        "replay-speed",
+		Usage:       "The speed the saved log files are replayed with, relative to the time passed between the lines. 0 outputs the lines as fast as possible",
+		Value:       0,
+		Destination: &argsConfig.replaySpeed,
+	}
 	// workingDirectory defines a flag for the path for the working directory.
 	workingDirectory = cli.StringFlag{
 		Name:        "working-directory",
@@ -103,12 +169,15 @@ VERSION:
 
 	argsConfig = &config{}
 
-	log           = logger.GetOrCreate("logviewer")
-	cliApp        *cli.App
-	webSocket     *websocket.Conn
-	fileForLogs   *os.File
-	marshalizer   marshal.Marshalizer
-	retryDuration = time.Second * 10
+	log              = logger.GetOrCreate("logviewer")
+	cliApp           *cli.App
+	marshalizer      marshal.Marshalizer
+	retryDuration    = time.Second * 10
+	fileNameReplacer = strings.NewReplacer(":", "_", "/", "_", "\\", "_")
+	fileFormatter    = viewer.NewLineFormatter(viewer.ArgsLineFormatter{
+		WithLoggerName:  true,
+		WithCorrelation: true,
+	})
 )
 
 func main() {
@@ -140,6 +209,13 @@ func initCliFlags() {
 		useWss,
 		logWithCorrelation,
 		logWithLoggerName,
+		loggerFilter,
+		contentFilter,
+		highlightFields,
+		mergeWindow,
+		noColors,
+		replay,
+		replaySpeed,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -166,17 +242,6 @@ func startLogViewer(ctx *cli.Context) error {
 		}
 	}
 
-	if argsConfig.logSave {
-		err = prepareLogFile()
-		if err != nil {
-			return err
-		}
-
-		defer func() {
-			_ = fileForLogs.Close()
-		}()
-	}
-
 	profile := &logger.Profile{
 		LogLevelPatterns: argsConfig.logLevel,
 		WithCorrelation:  argsConfig.logWithCorrelation,
@@ -188,36 +253,134 @@ func startLogViewer(ctx *cli.Context) error {
 		log.LogIfError(err)
 	}
 
-	go func() {
-		for {
-			webSocket, err = openWebSocket(argsConfig.address)
-			if err != nil {
-				log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration), "error", err.Error())
-				time.Sleep(retryDuration)
-				continue
-			}
+	// set this log's level to the lowest desired log level that matches received logs from mx-chain-go
+	lowestLogLevel := getLowestLogLevel(logLevels)
+	log.SetLevel(lowestLogLevel)
 
-			if customLogProfile {
-				err = sendProfile(webSocket, profile)
-			} else {
-				err = sendDefaultProfileIdentifier(webSocket)
-			}
-			log.LogIfError(err)
+	lineFilter, err := viewer.NewLineFilter(viewer.ArgsLineFilter{
+		LogLevelPatterns:  argsConfig.logLevel,
+		LoggerNamePattern: argsConfig.loggerFilter,
+		ContentPattern:    argsConfig.contentFilter,
+	})
+	if err != nil {
+		return err
+	}
 
-			listeningOnWebSocket()
-			time.Sleep(retryDuration)
+	if ctx.IsSet(replay.Name) {
+		return replayLogFiles(lineFilter)
+	}
+
+	sources, err := viewer.ParseSources(argsConfig.address)
+	if err != nil {
+		return err
+	}
+
+	output := createOutputHandler(lineFilter, sources)
+	mergeWindow := time.Duration(argsConfig.mergeWindowInMs) * time.Millisecond
+	if len(sources) == 1 {
+		// the lines of a single node are already ordered
+		mergeWindow = 0
+	}
+	merger, err := viewer.NewStreamMerger(viewer.ArgsStreamMerger{
+		Window: mergeWindow,
+		Output: output,
+	})
+	if err != nil {
+		return err
+	}
+
+	connections := make([]*nodeConnection, 0, len(sources))
+	defer func() {
+		for _, connection := range connections {
+			connection.closeLogFile()
 		}
 	}()
 
-	// set this log's level to the lowest desired log level that matches received logs from mx-chain-go
-	lowestLogLevel := getLowestLogLevel(logLevels)
-	log.SetLevel(lowestLogLevel)
+	for index, source := range sources {
+		connection := &nodeConnection{
+			source:      source,
+			sourceIndex: index,
+			merger:      merger,
+		}
+		if argsConfig.logSave {
+			err = connection.prepareLogFile()
+			if err != nil {
+				return err
+			}
+		}
 
-	waitForUserToTerminateApp(webSocket)
+		connections = append(connections, connection)
+	}
+
+	for _, connection := range connections {
+		go connection.connectAndListen(profile, customLogProfile)
+	}
+
+	waitForUserToTerminateApp(connections)
+	log.LogIfError(merger.Close())
+	log.Info("logviewer application stopped")
 
 	return nil
 }
 
+func createOutputHandler(lineFilter lineFilterHandler, sources []viewer.Source) func(line *viewer.LogLine) {
+	sourceNameLength := 0
+	for _, source := range sources {
+		if len(source.Name) > sourceNameLength {
+			sourceNameLength = len(source.Name)
+		}
+	}
+
+	formatter := viewer.NewLineFormatter(viewer.ArgsLineFormatter{
+		UseColors:        !argsConfig.noColors,
+		WithSourceName:   len(sources) > 1,
+		WithLoggerName:   argsConfig.logWithLoggerName,
+		WithCorrelation:  argsConfig.logWithCorrelation,
+		SourceNameLength: sourceNameLength,
+		HighlightFields:  strings.Split(argsConfig.highlightFields, ","),
+	})
+
+	return func(line *viewer.LogLine) {
+		if !lineFilter.ShouldDisplay(line) {
+			return
+		}
+
+		_, _ = os.Stdout.WriteString(formatter.Format(line))
+	}
+}
+
+func replayLogFiles(lineFilter lineFilterHandler) error {
+	sources, err := viewer.ParseSources(argsConfig.replay)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigs:
+			log.Info("terminating logviewer replay at user's signal...")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err = viewer.Replay(ctx, viewer.ArgsReplayer{
+		Sources: sources,
+		Speed:   argsConfig.replaySpeed,
+		Output:  createOutputHandler(lineFilter, sources),
+	})
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
 func getLowestLogLevel(logLevels []logger.LogLevel) logger.LogLevel {
 	lowest := logLevels[0]
 	for i := 1; i < len(logLevels); i++ {
@@ -229,11 +392,20 @@ func getLowestLogLevel(logLevels []logger.LogLevel) logger.LogLevel {
 	return lowest
 }
 
-func prepareLogFile() error {
+type nodeConnection struct {
+	source      viewer.Source
+	sourceIndex int
+	merger      streamMergerHandler
+	mutConn     sync.Mutex
+	conn        *websocket.Conn
+	fileForLogs *os.File
+}
+
+func (nc *nodeConnection) prepareLogFile() error {
 	logDirectory := filepath.Join(argsConfig.workingDir, defaultLogPath)
 	logsFile, err := core.CreateFile(
 		core.ArgCreateFileArgument{
-			Prefix:        "logviewer",
+			Prefix:        "logviewer-" + fileNameReplacer.Replace(nc.source.Name),
 			Directory:     logDirectory,
 			FileExtension: "log",
 		},
@@ -242,7 +414,95 @@ func prepareLogFile() error {
 		return err
 	}
 
-	return logger.AddLogObserver(logsFile, &logger.PlainFormatter{})
+	nc.fileForLogs = logsFile
+
+	return nil
+}
+
+func (nc *nodeConnection) closeLogFile() {
+	if nc.fileForLogs != nil {
+		_ = nc.fileForLogs.Close()
+	}
+}
+
+func (nc *nodeConnection) connectAndListen(profile *logger.Profile, customLogProfile bool) {
+	for {
+		conn, err := openWebSocket(nc.source.Value)
+		if err != nil {
+			log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration),
+				"node", nc.source.Name, "error", err.Error())
+			time.Sleep(retryDuration)
+			continue
+		}
+
+		nc.mutConn.Lock()
+		nc.conn = conn
+		nc.mutConn.Unlock()
+
+		if customLogProfile {
+			err = sendProfile(conn, profile)
+		} else {
+			err = sendDefaultProfileIdentifier(conn)
+		}
+		log.LogIfError(err)
+
+		nc.listeningOnWebSocket(conn)
+		time.Sleep(retryDuration)
+	}
+}
+
+func (nc *nodeConnection) listeningOnWebSocket(conn *websocket.Conn) {
+	for {
+		msgType, message, err := conn.ReadMessage()
+		if msgType == websocket.CloseMessage {
+			return
+		}
+		if err == nil {
+			nc.outputMessage(message)
+			continue
+		}
+
+		_, isConnectionClosed := err.(*websocket.CloseError)
+		if !isConnectionClosed {
+			log.Error(fmt.Sprintf("logviewer websocket error, retrying in %v...", retryDuration),
+				"node", nc.source.Name, "error", err.Error())
+		} else {
+			log.Error(fmt.Sprintf("logviewer websocket terminated by the server side, retrying in %v...", retryDuration),
+				"node", nc.source.Name, "error", err.Error())
+		}
+		return
+	}
+}
+
+func (nc *nodeConnection) outputMessage(message []byte) {
+	logLine := &logger.LogLineWrapper{}
+
+	err := marshalizer.Unmarshal(logLine, message)
+	if err != nil {
+		log.Debug("can not unmarshal received data", "node", nc.source.Name, "data", hex.EncodeToString(message))
+		return
+	}
+
+	line := viewer.NewLogLineFromWrapper(nc.source, nc.sourceIndex, logLine)
+	if nc.fileForLogs != nil {
+		// all the received lines are saved, regardless of the filters, so the files can be replayed afterward
+		_, err = nc.fileForLogs.WriteString(fileFormatter.Format(line))
+		log.LogIfError(err)
+	}
+
+	nc.merger.Add(line)
+}
+
+func (nc *nodeConnection) close() {
+	nc.mutConn.Lock()
+	defer nc.mutConn.Unlock()
+
+	if nc.conn == nil {
+		return
+	}
+
+	err := nc.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	log.LogIfError(err)
 }
 
 func openWebSocket(address string) (*websocket.Conn, error) {
@@ -279,63 +539,15 @@ func sendDefaultProfileIdentifier(conn *websocket.Conn) error {
 	return conn.WriteMessage(websocket.TextMessage, []byte(common.DefaultLogProfileIdentifier))
 }
 
-func listeningOnWebSocket() {
-	for {
-		msgType, message, err := webSocket.ReadMessage()
-		if msgType == websocket.CloseMessage {
-			return
-		}
-		if err == nil {
-			outputMessage(message)
-			continue
-		}
-
-		_, isConnectionClosed := err.(*websocket.CloseError)
-		if !isConnectionClosed {
-			log.Error("logviewer websocket error, retrying in %v...", "error", err.Error())
-		} else {
-			log.Error(fmt.Sprintf("logviewer websocket terminated by the server side, retrying in %v...", retryDuration), "error", err.Error())
-		}
-		return
-	}
-}
-
-func waitForUserToTerminateApp(conn *websocket.Conn) {
+func waitForUserToTerminateApp(connections []*nodeConnection) {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
 	<-sigs
 
 	log.Info("terminating logviewer app at user's signal...")
-	if conn != nil {
-		err := conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
-		log.LogIfError(err)
-		time.Sleep(time.Second)
-	}
-
-	log.Info("logviewer application stopped")
-}
-
-func outputMessage(message []byte) {
-	logLine := &logger.LogLineWrapper{}
-
-	err := marshalizer.Unmarshal(logLine, message)
-	if err != nil {
-		log.Debug("can not unmarshal received data", "data", hex.EncodeToString(message))
-		return
-	}
-
-	recoveredLogLine := &logger.LogLine{
-		LoggerName:  logLine.LoggerName,
-		Correlation: logLine.Correlation,
-		Message:     logLine.Message,
-		LogLevel:    logger.LogLevel(logLine.LogLevel),
-		Args:        make([]interface{}, len(logLine.Args)),
-		Timestamp:   time.Unix(0, logLine.Timestamp),
+	for _, connection := range connections {
+		connection.close()
 	}
-	for i, str := range logLine.Args {
-		recoveredLogLine.Args[i] = str
-	}
-
-	log.LogLine(recoveredLogLine)
+	time.Sleep(time.Second)
 }
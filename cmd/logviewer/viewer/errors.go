@@ -0,0 +1,24 @@
+package viewer
+
+import "errors"
+
+// ErrEmptySources signals that no source (node address or log file) has been provided
+var ErrEmptySources = errors.New("empty sources")
+
+// ErrEmptySourceValue signals that a source without the address or the file path has been provided
+var ErrEmptySourceValue = errors.New("empty source value")
+
+// ErrDuplicatedSourceName signals that the same name has been provided for more than one source
+var ErrDuplicatedSourceName = errors.New("duplicated source name")
+
+// ErrNilOutputHandler signals that a nil output handler has been provided
+var ErrNilOutputHandler = errors.New("nil output handler")
+
+// ErrInvalidMergeWindow signals that an invalid merge window has been provided
+var ErrInvalidMergeWindow = errors.New("invalid merge window")
+
+// ErrInvalidReplaySpeed signals that an invalid replay speed has been provided
+var ErrInvalidReplaySpeed = errors.New("invalid replay speed")
+
+// ErrInvalidLogLineFormat signals that a line read from a log file does not have the expected format
+var ErrInvalidLogLineFormat = errors.New("invalid log line format")
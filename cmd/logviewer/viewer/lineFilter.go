@@ -0,0 +1,106 @@
+package viewer
+
+import (
+	"regexp"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const matchAllPattern = "*"
+
+// ArgsLineFilter holds the arguments needed to create a new line filter
+type ArgsLineFilter struct {
+	LogLevelPatterns  string
+	LoggerNamePattern string
+	ContentPattern    string
+}
+
+type lineFilter struct {
+	logLevels     []logger.LogLevel
+	patterns      []string
+	loggerNameReg *regexp.Regexp
+	contentReg    *regexp.Regexp
+}
+
+// NewLineFilter creates a filter that selects the log lines by level, logger name and message or arguments content.
+// The log level patterns follow the same rules as the node's --log-level flag, while the logger name and the content
+// patterns are regular expressions. Empty patterns match all the lines
+func NewLineFilter(args ArgsLineFilter) (*lineFilter, error) {
+	lf := &lineFilter{}
+
+	var err error
+	if len(args.LogLevelPatterns) > 0 {
+		lf.logLevels, lf.patterns, err = logger.ParseLogLevelAndMatchingString(args.LogLevelPatterns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(args.LoggerNamePattern) > 0 {
+		lf.loggerNameReg, err = regexp.Compile(args.LoggerNamePattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(args.ContentPattern) > 0 {
+		lf.contentReg, err = regexp.Compile(args.ContentPattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lf, nil
+}
+
+// ShouldDisplay returns true if the provided log line passes all the filters
+func (lf *lineFilter) ShouldDisplay(line *LogLine) bool {
+	if line == nil {
+		return false
+	}
+	if line.LogLevel < lf.logLevelForLogger(line.LoggerName) {
+		return false
+	}
+	if lf.loggerNameReg != nil && !lf.loggerNameReg.MatchString(line.LoggerName) {
+		return false
+	}
+	if lf.contentReg != nil && !lf.contentMatches(line) {
+		return false
+	}
+
+	return true
+}
+
+// logLevelForLogger applies the patterns from left to right, as the logger subsystem does
+func (lf *lineFilter) logLevelForLogger(loggerName string) logger.LogLevel {
+	if len(lf.patterns) == 0 {
+		return logger.LogTrace
+	}
+
+	level := logger.LogInfo
+	for i, pattern := range lf.patterns {
+		if pattern == matchAllPattern || strings.Contains(loggerName, pattern) {
+			level = lf.logLevels[i]
+		}
+	}
+
+	return level
+}
+
+func (lf *lineFilter) contentMatches(line *LogLine) bool {
+	if lf.contentReg.MatchString(line.Message) {
+		return true
+	}
+
+	for i := 1; i < len(line.Args); i += 2 {
+		if lf.contentReg.MatchString(line.Args[i-1] + argsSeparator + line.Args[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lf *lineFilter) IsInterfaceNil() bool {
+	return lf == nil
+}
@@ -0,0 +1,104 @@
+package viewer
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLine(loggerName string, level logger.LogLevel, message string, args ...string) *LogLine {
+	return &LogLine{
+		LoggerName: loggerName,
+		LogLevel:   level,
+		Message:    message,
+		Args:       args,
+	}
+}
+
+func TestNewLineFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid log level patterns should error", func(t *testing.T) {
+		t.Parallel()
+
+		lf, err := NewLineFilter(ArgsLineFilter{LogLevelPatterns: "*:INVALID"})
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(lf))
+	})
+	t.Run("invalid logger name pattern should error", func(t *testing.T) {
+		t.Parallel()
+
+		lf, err := NewLineFilter(ArgsLineFilter{LoggerNamePattern: "process/("})
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(lf))
+	})
+	t.Run("invalid content pattern should error", func(t *testing.T) {
+		t.Parallel()
+
+		lf, err := NewLineFilter(ArgsLineFilter{ContentPattern: "[a-"})
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(lf))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lf, err := NewLineFilter(ArgsLineFilter{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(lf))
+	})
+}
+
+func TestLineFilter_ShouldDisplay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil line should not display", func(t *testing.T) {
+		t.Parallel()
+
+		lf, _ := NewLineFilter(ArgsLineFilter{})
+		assert.False(t, lf.ShouldDisplay(nil))
+	})
+	t.Run("no filters should display all lines", func(t *testing.T) {
+		t.Parallel()
+
+		lf, _ := NewLineFilter(ArgsLineFilter{})
+		assert.True(t, lf.ShouldDisplay(createLine("process/block", logger.LogTrace, "message")))
+	})
+	t.Run("log level patterns should apply from left to right", func(t *testing.T) {
+		t.Parallel()
+
+		lf, err := NewLineFilter(ArgsLineFilter{LogLevelPatterns: "*:INFO,process:DEBUG,process/sync:ERROR"})
+		require.Nil(t, err)
+
+		assert.False(t, lf.ShouldDisplay(createLine("p2p", logger.LogDebug, "message")))
+		assert.True(t, lf.ShouldDisplay(createLine("p2p", logger.LogInfo, "message")))
+		assert.True(t, lf.ShouldDisplay(createLine("process/block", logger.LogDebug, "message")))
+		assert.False(t, lf.ShouldDisplay(createLine("process/block", logger.LogTrace, "message")))
+		assert.False(t, lf.ShouldDisplay(createLine("process/sync", logger.LogWarning, "message")))
+		assert.True(t, lf.ShouldDisplay(createLine("process/sync", logger.LogError, "message")))
+	})
+	t.Run("logger name pattern", func(t *testing.T) {
+		t.Parallel()
+
+		lf, err := NewLineFilter(ArgsLineFilter{LoggerNamePattern: "^process/(block|sync)$"})
+		require.Nil(t, err)
+
+		assert.True(t, lf.ShouldDisplay(createLine("process/block", logger.LogInfo, "message")))
+		assert.True(t, lf.ShouldDisplay(createLine("process/sync", logger.LogInfo, "message")))
+		assert.False(t, lf.ShouldDisplay(createLine("process/block/preprocess", logger.LogInfo, "message")))
+		assert.False(t, lf.ShouldDisplay(createLine("p2p", logger.LogInfo, "message")))
+	})
+	t.Run("content pattern should match the message or the fields", func(t *testing.T) {
+		t.Parallel()
+
+		lf, err := NewLineFilter(ArgsLineFilter{ContentPattern: "(committed|nonce = 37$)"})
+		require.Nil(t, err)
+
+		assert.True(t, lf.ShouldDisplay(createLine("process/block", logger.LogInfo, "block committed")))
+		assert.True(t, lf.ShouldDisplay(createLine("process/block", logger.LogInfo, "proposed", "round", "3", "nonce", "37")))
+		assert.False(t, lf.ShouldDisplay(createLine("process/block", logger.LogInfo, "proposed", "nonce", "370")))
+		assert.False(t, lf.ShouldDisplay(createLine("process/block", logger.LogInfo, "proposed", "nonce")))
+	})
+}
@@ -0,0 +1,192 @@
+package viewer
+
+import (
+	"fmt"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// the plain output matches the one of the logger's PlainFormatter, so the saved files can be replayed afterward
+const (
+	timestampFormat                = "2006-01-02 15:04:05.000"
+	argsSeparator                  = " = "
+	sourceNameSuffix               = " | "
+	ellipsisString                 = ".."
+	bracketsLength                 = len("[]")
+	loggerNameFixedLength          = 20
+	correlationElementsFixedLength = 14
+	messageFixedLength             = 40
+
+	ansiReset     = "\033[0m"
+	ansiBold      = "\033[1m"
+	ansiGray      = "\033[0;37m"
+	ansiLightBlue = "\033[0;36m"
+	ansiGreen     = "\033[0;32m"
+	ansiYellow    = "\033[0;33m"
+	ansiRed       = "\033[0;31m"
+	ansiBlack     = "\033[0;30m"
+	ansiHighlight = "\033[1;35m"
+)
+
+// sourceColors are used, in order, to prefix the lines of each source
+var sourceColors = []string{
+	"\033[1;36m",
+	"\033[1;33m",
+	"\033[1;32m",
+	"\033[1;35m",
+	"\033[1;34m",
+	"\033[1;31m",
+	"\033[0;36m",
+	"\033[0;33m",
+	"\033[0;32m",
+	"\033[0;35m",
+	"\033[0;34m",
+	"\033[0;31m",
+}
+
+// ArgsLineFormatter holds the arguments needed to create a new line formatter
+type ArgsLineFormatter struct {
+	UseColors        bool
+	WithSourceName   bool
+	WithLoggerName   bool
+	WithCorrelation  bool
+	SourceNameLength int
+	HighlightFields  []string
+}
+
+type lineFormatter struct {
+	useColors        bool
+	withSourceName   bool
+	withLoggerName   bool
+	withCorrelation  bool
+	sourceNameLength int
+	highlightFields  map[string]struct{}
+}
+
+// NewLineFormatter creates a formatter able to output the log lines prefixed by their source name. The arguments
+// whose names are between the highlight fields (shard, epoch, round and so on) are highlighted, as are the
+// correlation elements
+func NewLineFormatter(args ArgsLineFormatter) *lineFormatter {
+	lf := &lineFormatter{
+		useColors:        args.UseColors,
+		withSourceName:   args.WithSourceName,
+		withLoggerName:   args.WithLoggerName,
+		withCorrelation:  args.WithCorrelation,
+		sourceNameLength: args.SourceNameLength,
+		highlightFields:  make(map[string]struct{}),
+	}
+	for _, field := range args.HighlightFields {
+		field = strings.ToLower(strings.TrimSpace(field))
+		if len(field) > 0 {
+			lf.highlightFields[field] = struct{}{}
+		}
+	}
+
+	return lf
+}
+
+// Format returns the text representation of the provided log line, ending in a new line
+func (lf *lineFormatter) Format(line *LogLine) string {
+	if line == nil {
+		return ""
+	}
+
+	builder := strings.Builder{}
+	if lf.withSourceName {
+		sourceName := padRight(line.SourceName, lf.sourceNameLength) + sourceNameSuffix
+		builder.WriteString(lf.colorize(sourceColors[line.SourceIndex%len(sourceColors)], sourceName))
+	}
+
+	levelColor := getLevelColor(line.LogLevel)
+	loggerName := ""
+	if lf.withLoggerName {
+		loggerName = formatLoggerName(line.LoggerName)
+	}
+	correlation := ""
+	if lf.withCorrelation {
+		correlation = lf.colorize(ansiHighlight, formatCorrelationElements(line))
+	}
+
+	builder.WriteString(fmt.Sprintf("%s[%s] %s %s %s %s\n",
+		lf.colorize(levelColor, line.LogLevel.String()),
+		line.Timestamp.Format(timestampFormat),
+		loggerName,
+		correlation,
+		padRight(line.Message, messageFixedLength),
+		lf.formatArgs(levelColor, line.Args),
+	))
+
+	return builder.String()
+}
+
+func (lf *lineFormatter) formatArgs(levelColor string, args []string) string {
+	builder := strings.Builder{}
+	for i := 1; i < len(args); i += 2 {
+		key, value := args[i-1], args[i]
+		_, shouldHighlight := lf.highlightFields[strings.ToLower(key)]
+		if shouldHighlight {
+			builder.WriteString(lf.colorize(ansiHighlight, key) + argsSeparator + lf.colorize(ansiBold, value) + " ")
+			continue
+		}
+
+		builder.WriteString(lf.colorize(levelColor, key) + argsSeparator + value + " ")
+	}
+
+	return builder.String()
+}
+
+func (lf *lineFormatter) colorize(color string, text string) string {
+	if !lf.useColors {
+		return text
+	}
+
+	return color + text + ansiReset
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lf *lineFormatter) IsInterfaceNil() bool {
+	return lf == nil
+}
+
+func getLevelColor(level logger.LogLevel) string {
+	switch level {
+	case logger.LogTrace:
+		return ansiGray
+	case logger.LogDebug:
+		return ansiLightBlue
+	case logger.LogInfo:
+		return ansiGreen
+	case logger.LogWarning:
+		return ansiYellow
+	case logger.LogError:
+		return ansiRed
+	default:
+		return ansiBlack
+	}
+}
+
+func formatLoggerName(name string) string {
+	maxLength := loggerNameFixedLength - bracketsLength
+	if len(name) > maxLength {
+		name = ellipsisString + name[len(name)-maxLength+len(ellipsisString):]
+	}
+
+	return padRight(fmt.Sprintf("[%s]", name), loggerNameFixedLength)
+}
+
+func formatCorrelationElements(line *LogLine) string {
+	correlation := line.Correlation
+	formattedElements := fmt.Sprintf("[%s/%d/%d/%s]", correlation.Shard, correlation.Epoch, correlation.Round, correlation.SubRound)
+
+	return padRight(formattedElements, correlationElementsFixedLength)
+}
+
+func padRight(str string, maxLength int) string {
+	paddingLength := maxLength - len(str)
+	if paddingLength > 0 {
+		return str + strings.Repeat(" ", paddingLength)
+	}
+
+	return str
+}
@@ -0,0 +1,84 @@
+package viewer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-logger-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFullLine() *LogLine {
+	return &LogLine{
+		SourceName:  "meta",
+		SourceIndex: 1,
+		LoggerName:  "process/block",
+		Correlation: proto.LogCorrelationMessage{Shard: "metachain", Epoch: 2, Round: 37, SubRound: "(END_ROUND)"},
+		Message:     "block committed",
+		LogLevel:    logger.LogInfo,
+		Args:        []string{"round", "37", "hash", "aabbcc"},
+		Timestamp:   time.Date(2024, 1, 25, 10, 15, 0, 123000000, time.Local),
+	}
+}
+
+func TestLineFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil line should return empty string", func(t *testing.T) {
+		t.Parallel()
+
+		lf := NewLineFormatter(ArgsLineFormatter{})
+		assert.Empty(t, lf.Format(nil))
+	})
+	t.Run("plain output should match the logger's plain formatter", func(t *testing.T) {
+		t.Parallel()
+
+		line := createFullLine()
+		lf := NewLineFormatter(ArgsLineFormatter{})
+		formatted := lf.Format(line)
+
+		wrapper := &logger.LogLineWrapper{}
+		wrapper.LoggerName = line.LoggerName
+		wrapper.Correlation = line.Correlation
+		wrapper.Message = line.Message
+		wrapper.LogLevel = int32(line.LogLevel)
+		wrapper.Args = line.Args
+		wrapper.Timestamp = line.Timestamp.UnixNano()
+		plainFormatter := &logger.PlainFormatter{}
+		assert.Equal(t, string(plainFormatter.Output(wrapper)), formatted)
+	})
+	t.Run("with source name, logger name and correlation", func(t *testing.T) {
+		t.Parallel()
+
+		lf := NewLineFormatter(ArgsLineFormatter{
+			WithSourceName:   true,
+			WithLoggerName:   true,
+			WithCorrelation:  true,
+			SourceNameLength: 6,
+		})
+		formatted := lf.Format(createFullLine())
+
+		expected := "meta   | INFO [2024-01-25 10:15:00.123] [process/block]      [metachain/2/37/(END_ROUND)] " +
+			"block committed                          round = 37 hash = aabbcc \n"
+		assert.Equal(t, expected, formatted)
+	})
+	t.Run("with colors should highlight the correlation fields", func(t *testing.T) {
+		t.Parallel()
+
+		lf := NewLineFormatter(ArgsLineFormatter{
+			UseColors:       true,
+			WithSourceName:  true,
+			WithCorrelation: true,
+			HighlightFields: []string{" Round", ""},
+		})
+		formatted := lf.Format(createFullLine())
+
+		require.True(t, strings.HasPrefix(formatted, sourceColors[1]+"meta"+sourceNameSuffix+ansiReset))
+		assert.Contains(t, formatted, ansiHighlight+"[metachain/2/37/(END_ROUND)]"+ansiReset)
+		assert.Contains(t, formatted, ansiHighlight+"round"+ansiReset+argsSeparator+ansiBold+"37"+ansiReset)
+		assert.Contains(t, formatted, ansiGreen+"hash"+ansiReset+argsSeparator+"aabbcc")
+	})
+}
@@ -0,0 +1,91 @@
+package viewer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// a plain log line looks like
+// INFO [2024-01-25 10:15:00.123] [process/block]      [0/12/3450/(END_ROUND)] message     key1 = value1 key2 = value2
+// where the logger name and the correlation elements are optional
+var (
+	levelAndTimestampReg = regexp.MustCompile(`^(TRACE|DEBUG|INFO|WARN|ERROR|NONE)\s*\[(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\.\d{3})]`)
+	correlationReg       = regexp.MustCompile(`^\[([^/\]\s]*)/(\d+)/(-?\d+)/([^\]\s]*)]`)
+	loggerNameReg        = regexp.MustCompile(`^\[([^\]]*)]`)
+	argKeyReg            = regexp.MustCompile(`(^|\s)([^\s=]+) = `)
+)
+
+// ParsePlainLogLine parses a line written by the logger's PlainFormatter, as found in the node's and the logviewer's
+// saved log files
+func ParsePlainLogLine(text string) (*LogLine, error) {
+	text = strings.TrimRight(text, "\r\n")
+
+	matches := levelAndTimestampReg.FindStringSubmatch(text)
+	if matches == nil {
+		return nil, ErrInvalidLogLineFormat
+	}
+
+	logLevel, err := logger.GetLogLevel(matches[1])
+	if err != nil {
+		return nil, err
+	}
+	timestamp, err := time.ParseInLocation(timestampFormat, matches[2], time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLogLineFormat, err.Error())
+	}
+
+	line := &LogLine{
+		LogLevel:  logLevel,
+		Timestamp: timestamp,
+		Args:      make([]string, 0),
+	}
+
+	remaining := strings.TrimLeft(text[len(matches[0]):], " ")
+	correlationMatches := correlationReg.FindStringSubmatch(remaining)
+	if correlationMatches == nil {
+		loggerNameMatches := loggerNameReg.FindStringSubmatch(remaining)
+		if loggerNameMatches != nil {
+			line.LoggerName = loggerNameMatches[1]
+			remaining = strings.TrimLeft(remaining[len(loggerNameMatches[0]):], " ")
+			correlationMatches = correlationReg.FindStringSubmatch(remaining)
+		}
+	}
+	if correlationMatches != nil {
+		line.Correlation.Shard = correlationMatches[1]
+		epoch, _ := strconv.ParseUint(correlationMatches[2], 10, 32)
+		line.Correlation.Epoch = uint32(epoch)
+		line.Correlation.Round, _ = strconv.ParseInt(correlationMatches[3], 10, 64)
+		line.Correlation.SubRound = correlationMatches[4]
+		remaining = strings.TrimLeft(remaining[len(correlationMatches[0]):], " ")
+	}
+
+	line.Message, line.Args = splitMessageAndArgs(remaining)
+
+	return line, nil
+}
+
+func splitMessageAndArgs(text string) (string, []string) {
+	args := make([]string, 0)
+	keysIndexes := argKeyReg.FindAllStringSubmatchIndex(text, -1)
+	if len(keysIndexes) == 0 {
+		return strings.TrimSpace(text), args
+	}
+
+	message := strings.TrimSpace(text[:keysIndexes[0][0]])
+	for i, indexes := range keysIndexes {
+		key := text[indexes[4]:indexes[5]]
+		valueEnd := len(text)
+		if i+1 < len(keysIndexes) {
+			valueEnd = keysIndexes[i+1][0]
+		}
+
+		args = append(args, key, strings.TrimSpace(text[indexes[1]:valueEnd]))
+	}
+
+	return message, args
+}
@@ -0,0 +1,73 @@
+package viewer
+
+import (
+	"testing"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-logger-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlainLogLine(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid lines should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidLines := []string{
+			"",
+			"continuation of a multi-line message",
+			"INFO [2024-01-25] message",
+			"INFO [2024-13-25 10:15:00.123] message",
+		}
+		for _, text := range invalidLines {
+			line, err := ParsePlainLogLine(text)
+			assert.NotNil(t, err, text)
+			assert.Nil(t, line, text)
+		}
+	})
+	t.Run("minimal line", func(t *testing.T) {
+		t.Parallel()
+
+		line, err := ParsePlainLogLine("DEBUG[2024-01-25 10:15:00.123]   message with spaces   \n")
+		require.Nil(t, err)
+
+		assert.Equal(t, logger.LogDebug, line.LogLevel)
+		assert.Equal(t, time.Date(2024, 1, 25, 10, 15, 0, 123000000, time.Local), line.Timestamp)
+		assert.Empty(t, line.LoggerName)
+		assert.Equal(t, proto.LogCorrelationMessage{}, line.Correlation)
+		assert.Equal(t, "message with spaces", line.Message)
+		assert.Empty(t, line.Args)
+	})
+	t.Run("line with correlation but without logger name", func(t *testing.T) {
+		t.Parallel()
+
+		line, err := ParsePlainLogLine("WARN [2024-01-25 10:15:00.123]  [0/2/-1/]      message  key = value ")
+		require.Nil(t, err)
+
+		assert.Equal(t, logger.LogWarning, line.LogLevel)
+		assert.Empty(t, line.LoggerName)
+		assert.Equal(t, proto.LogCorrelationMessage{Shard: "0", Epoch: 2, Round: -1}, line.Correlation)
+		assert.Equal(t, "message", line.Message)
+		assert.Equal(t, []string{"key", "value"}, line.Args)
+	})
+	t.Run("formatted line should be parsed back", func(t *testing.T) {
+		t.Parallel()
+
+		line := createFullLine()
+		line.SourceName = ""
+		line.SourceIndex = 0
+		line.LoggerName = "logger with spaces"
+		line.Args = []string{"round", "37", "empty", "", "list", "[a b]"}
+		lf := NewLineFormatter(ArgsLineFormatter{
+			WithLoggerName:  true,
+			WithCorrelation: true,
+		})
+
+		parsedLine, err := ParsePlainLogLine(lf.Format(line))
+		require.Nil(t, err)
+		assert.Equal(t, line, parsedLine)
+	})
+}
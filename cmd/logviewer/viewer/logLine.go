@@ -0,0 +1,84 @@
+package viewer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-logger-go/proto"
+)
+
+const sourceNameSeparator = "="
+
+// Source defines a node address or a saved log file, together with the name used when displaying its lines
+type Source struct {
+	Name  string
+	Value string
+}
+
+// LogLine is a log line received from a node or read from a saved log file
+type LogLine struct {
+	SourceName  string
+	SourceIndex int
+	LoggerName  string
+	Correlation proto.LogCorrelationMessage
+	Message     string
+	LogLevel    logger.LogLevel
+	Args        []string
+	Timestamp   time.Time
+}
+
+// NewLogLineFromWrapper creates a log line from the wrapper received on the node's log websocket
+func NewLogLineFromWrapper(source Source, sourceIndex int, wrapper *logger.LogLineWrapper) *LogLine {
+	return &LogLine{
+		SourceName:  source.Name,
+		SourceIndex: sourceIndex,
+		LoggerName:  wrapper.LoggerName,
+		Correlation: wrapper.Correlation,
+		Message:     wrapper.Message,
+		LogLevel:    logger.LogLevel(wrapper.LogLevel),
+		Args:        wrapper.Args,
+		Timestamp:   time.Unix(0, wrapper.Timestamp),
+	}
+}
+
+// ParseSources parses the comma separated list of sources. Each source can be prefixed by its display name, as in
+// name=value, otherwise the value itself is used as name
+func ParseSources(sources string) ([]Source, error) {
+	result := make([]Source, 0)
+	names := make(map[string]struct{})
+	for _, element := range strings.Split(sources, ",") {
+		element = strings.TrimSpace(element)
+		if len(element) == 0 {
+			continue
+		}
+
+		source := Source{
+			Name:  element,
+			Value: element,
+		}
+		splitElement := strings.SplitN(element, sourceNameSeparator, 2)
+		if len(splitElement) == 2 {
+			source.Name = strings.TrimSpace(splitElement[0])
+			source.Value = strings.TrimSpace(splitElement[1])
+		}
+		if len(source.Value) == 0 || len(source.Name) == 0 {
+			return nil, fmt.Errorf("%w for %s", ErrEmptySourceValue, element)
+		}
+
+		_, exists := names[source.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedSourceName, source.Name)
+		}
+		names[source.Name] = struct{}{}
+
+		result = append(result, source)
+	}
+
+	if len(result) == 0 {
+		return nil, ErrEmptySources
+	}
+
+	return result, nil
+}
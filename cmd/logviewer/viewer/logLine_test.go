@@ -0,0 +1,77 @@
+package viewer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-logger-go/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogLineFromWrapper(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Now()
+	wrapper := &logger.LogLineWrapper{}
+	wrapper.LoggerName = "process/block"
+	wrapper.Correlation = proto.LogCorrelationMessage{Shard: "0", Epoch: 2, Round: 37}
+	wrapper.Message = "message"
+	wrapper.LogLevel = int32(logger.LogWarning)
+	wrapper.Args = []string{"nonce", "10"}
+	wrapper.Timestamp = timestamp.UnixNano()
+
+	line := NewLogLineFromWrapper(Source{Name: "node"}, 3, wrapper)
+	assert.Equal(t, "node", line.SourceName)
+	assert.Equal(t, 3, line.SourceIndex)
+	assert.Equal(t, wrapper.LoggerName, line.LoggerName)
+	assert.Equal(t, wrapper.Correlation, line.Correlation)
+	assert.Equal(t, wrapper.Message, line.Message)
+	assert.Equal(t, logger.LogWarning, line.LogLevel)
+	assert.Equal(t, wrapper.Args, line.Args)
+	assert.True(t, timestamp.Equal(line.Timestamp))
+}
+
+func TestParseSources(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty sources should error", func(t *testing.T) {
+		t.Parallel()
+
+		sources, err := ParseSources(" , ")
+		assert.Equal(t, ErrEmptySources, err)
+		assert.Nil(t, sources)
+	})
+	t.Run("empty value should error", func(t *testing.T) {
+		t.Parallel()
+
+		sources, err := ParseSources("node=")
+		assert.True(t, errors.Is(err, ErrEmptySourceValue))
+		assert.Nil(t, sources)
+
+		sources, err = ParseSources("=127.0.0.1:8080")
+		assert.True(t, errors.Is(err, ErrEmptySourceValue))
+		assert.Nil(t, sources)
+	})
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		sources, err := ParseSources("node=127.0.0.1:8080,node=127.0.0.1:8081")
+		assert.True(t, errors.Is(err, ErrDuplicatedSourceName))
+		assert.Nil(t, sources)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sources, err := ParseSources("127.0.0.1:8080, meta = 127.0.0.1:8081")
+		require.Nil(t, err)
+
+		expectedSources := []Source{
+			{Name: "127.0.0.1:8080", Value: "127.0.0.1:8080"},
+			{Name: "meta", Value: "127.0.0.1:8081"},
+		}
+		assert.Equal(t, expectedSources, sources)
+	})
+}
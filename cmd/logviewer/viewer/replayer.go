@@ -0,0 +1,151 @@
+package viewer
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const maxLogLineSize = 1024 * 1024
+
+var log = logger.GetOrCreate("logviewer/viewer")
+
+// ArgsReplayer holds the arguments needed to replay saved log files
+type ArgsReplayer struct {
+	Sources []Source
+	Speed   float64
+	Output  func(line *LogLine)
+}
+
+type fileReader struct {
+	source       Source
+	sourceIndex  int
+	file         *os.File
+	scanner      *bufio.Scanner
+	current      *LogLine
+	skippedLines int
+}
+
+// Replay reads the provided log files and outputs their lines merged by timestamp. The files are expected to be
+// written by the logger's PlainFormatter, the lines that can not be parsed being skipped. A zero speed outputs the
+// lines as fast as possible, otherwise the time passed between the lines is divided by the speed
+func Replay(ctx context.Context, args ArgsReplayer) error {
+	if len(args.Sources) == 0 {
+		return ErrEmptySources
+	}
+	if args.Output == nil {
+		return ErrNilOutputHandler
+	}
+	if args.Speed < 0 {
+		return ErrInvalidReplaySpeed
+	}
+
+	readers := make([]*fileReader, 0, len(args.Sources))
+	defer func() {
+		for _, reader := range readers {
+			_ = reader.file.Close()
+			if reader.skippedLines > 0 {
+				log.Debug("skipped lines that could not be parsed", "file", reader.source.Value, "num lines", reader.skippedLines)
+			}
+		}
+	}()
+
+	for index, source := range args.Sources {
+		file, err := os.Open(source.Value)
+		if err != nil {
+			return err
+		}
+
+		reader := &fileReader{
+			source:      source,
+			sourceIndex: index,
+			file:        file,
+			scanner:     bufio.NewScanner(file),
+		}
+		reader.scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+		readers = append(readers, reader)
+
+		err = reader.advance()
+		if err != nil {
+			return err
+		}
+	}
+
+	var lastTimestamp time.Time
+	for {
+		next := nextReader(readers)
+		if next == nil {
+			return nil
+		}
+
+		line := next.current
+		err := waitBetweenLines(ctx, lastTimestamp, line.Timestamp, args.Speed)
+		if err != nil {
+			return err
+		}
+		lastTimestamp = line.Timestamp
+
+		args.Output(line)
+
+		err = next.advance()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// nextReader returns the reader holding the oldest line, the files being already sorted by timestamp
+func nextReader(readers []*fileReader) *fileReader {
+	var next *fileReader
+	for _, reader := range readers {
+		if reader.current == nil {
+			continue
+		}
+		if next == nil || reader.current.Timestamp.Before(next.current.Timestamp) {
+			next = reader
+		}
+	}
+
+	return next
+}
+
+func (fr *fileReader) advance() error {
+	fr.current = nil
+	for fr.scanner.Scan() {
+		line, err := ParsePlainLogLine(fr.scanner.Text())
+		if err != nil {
+			fr.skippedLines++
+			continue
+		}
+
+		line.SourceName = fr.source.Name
+		line.SourceIndex = fr.sourceIndex
+		fr.current = line
+
+		return nil
+	}
+
+	return fr.scanner.Err()
+}
+
+func waitBetweenLines(ctx context.Context, lastTimestamp time.Time, timestamp time.Time, speed float64) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if speed == 0 || lastTimestamp.IsZero() || !timestamp.After(lastTimestamp) {
+		return nil
+	}
+
+	timer := time.NewTimer(time.Duration(float64(timestamp.Sub(lastTimestamp)) / speed))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package viewer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLogFile(t *testing.T, name string, lines ...string) string {
+	path := filepath.Join(t.TempDir(), name)
+	content := ""
+	for _, line := range lines {
+		content += line + "\n"
+	}
+
+	err := os.WriteFile(path, []byte(content), os.ModePerm)
+	require.Nil(t, err)
+
+	return path
+}
+
+func TestReplay_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sources := []Source{{Name: "node", Value: "file.log"}}
+	output := func(line *LogLine) {}
+
+	err := Replay(context.Background(), ArgsReplayer{Output: output})
+	assert.Equal(t, ErrEmptySources, err)
+
+	err = Replay(context.Background(), ArgsReplayer{Sources: sources})
+	assert.Equal(t, ErrNilOutputHandler, err)
+
+	err = Replay(context.Background(), ArgsReplayer{Sources: sources, Output: output, Speed: -1})
+	assert.Equal(t, ErrInvalidReplaySpeed, err)
+
+	err = Replay(context.Background(), ArgsReplayer{Sources: sources, Output: output})
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestReplay_ShouldMergeTheFilesByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	shardFile := writeLogFile(t, "shard.log",
+		"INFO [2024-01-25 10:15:00.100] shard line 1",
+		"INFO [2024-01-25 10:15:00.300] shard line 2",
+		"   continuation line that is skipped",
+		"INFO [2024-01-25 10:15:00.500] shard line 3",
+	)
+	metaFile := writeLogFile(t, "meta.log",
+		"INFO [2024-01-25 10:15:00.000] meta line 1",
+		"INFO [2024-01-25 10:15:00.400] meta line 2",
+	)
+
+	recorder := &outputRecorder{}
+	err := Replay(context.Background(), ArgsReplayer{
+		Sources: []Source{
+			{Name: "shard", Value: shardFile},
+			{Name: "meta", Value: metaFile},
+		},
+		Output: recorder.output,
+	})
+	require.Nil(t, err)
+
+	expectedMessages := []string{
+		"meta line 1",
+		"shard line 1",
+		"shard line 2",
+		"meta line 2",
+		"shard line 3",
+	}
+	assert.Equal(t, expectedMessages, recorder.messages())
+	assert.Equal(t, "meta", recorder.lines[0].SourceName)
+	assert.Equal(t, 1, recorder.lines[0].SourceIndex)
+	assert.Equal(t, "shard", recorder.lines[1].SourceName)
+	assert.Equal(t, 0, recorder.lines[1].SourceIndex)
+}
+
+func TestReplay_ShouldKeepTheTimeBetweenLines(t *testing.T) {
+	t.Parallel()
+
+	file := writeLogFile(t, "node.log",
+		"INFO [2024-01-25 10:15:00.000] line 1",
+		"INFO [2024-01-25 10:15:00.200] line 2",
+	)
+
+	recorder := &outputRecorder{}
+	start := time.Now()
+	err := Replay(context.Background(), ArgsReplayer{
+		Sources: []Source{{Name: "node", Value: file}},
+		Speed:   2,
+		Output:  recorder.output,
+	})
+	require.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*100)
+	assert.Len(t, recorder.messages(), 2)
+}
+
+func TestReplay_ContextDoneShouldStop(t *testing.T) {
+	t.Parallel()
+
+	file := writeLogFile(t, "node.log",
+		"INFO [2024-01-25 10:15:00.000] line 1",
+		"INFO [2024-01-25 11:15:00.000] line 2",
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	recorder := &outputRecorder{}
+	go func() {
+		time.Sleep(time.Millisecond * 50)
+		cancel()
+	}()
+
+	err := Replay(ctx, ArgsReplayer{
+		Sources: []Source{{Name: "node", Value: file}},
+		Speed:   1,
+		Output:  recorder.output,
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []string{"line 1"}, recorder.messages())
+}
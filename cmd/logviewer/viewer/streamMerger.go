@@ -0,0 +1,168 @@
+package viewer
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+const minReleaseInterval = time.Millisecond * 10
+
+// ArgsStreamMerger holds the arguments needed to create a new stream merger
+type ArgsStreamMerger struct {
+	Window time.Duration
+	Output func(line *LogLine)
+}
+
+type pendingLine struct {
+	line       *LogLine
+	receivedAt time.Time
+	sequence   uint64
+}
+
+// pendingLines is a min-heap of the lines ordered by their timestamp. The lines with the same timestamp are kept in
+// the order they were received
+type pendingLines []*pendingLine
+
+// Len returns the number of pending lines
+func (pl pendingLines) Len() int { return len(pl) }
+
+// Less returns true if the line at index i should be released before the one at index j
+func (pl pendingLines) Less(i, j int) bool {
+	if pl[i].line.Timestamp.Equal(pl[j].line.Timestamp) {
+		return pl[i].sequence < pl[j].sequence
+	}
+
+	return pl[i].line.Timestamp.Before(pl[j].line.Timestamp)
+}
+
+// Swap swaps the lines at the provided indexes
+func (pl pendingLines) Swap(i, j int) { pl[i], pl[j] = pl[j], pl[i] }
+
+// Push adds a new line
+func (pl *pendingLines) Push(x interface{}) { *pl = append(*pl, x.(*pendingLine)) }
+
+// Pop removes the last line
+func (pl *pendingLines) Pop() interface{} {
+	old := *pl
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*pl = old[:n-1]
+
+	return item
+}
+
+type streamMerger struct {
+	window   time.Duration
+	output   func(line *LogLine)
+	mut      sync.Mutex
+	pending  pendingLines
+	sequence uint64
+	cancel   func()
+}
+
+// NewStreamMerger creates a merger that orders the lines received from several nodes by their timestamp. Each line is
+// held for the provided window, so the lines of the other nodes produced at the same time have the chance to arrive.
+// A zero window outputs the lines as they are added
+func NewStreamMerger(args ArgsStreamMerger) (*streamMerger, error) {
+	if args.Output == nil {
+		return nil, ErrNilOutputHandler
+	}
+	if args.Window < 0 {
+		return nil, ErrInvalidMergeWindow
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sm := &streamMerger{
+		window:  args.Window,
+		output:  args.Output,
+		pending: make(pendingLines, 0),
+		cancel:  cancel,
+	}
+	if sm.window > 0 {
+		go sm.processLoop(ctx)
+	}
+
+	return sm, nil
+}
+
+// Add adds a new log line to be merged
+func (sm *streamMerger) Add(line *LogLine) {
+	if line == nil {
+		return
+	}
+
+	sm.mut.Lock()
+	defer sm.mut.Unlock()
+
+	if sm.window == 0 {
+		sm.output(line)
+		return
+	}
+
+	sm.sequence++
+	heap.Push(&sm.pending, &pendingLine{
+		line:       line,
+		receivedAt: time.Now(),
+		sequence:   sm.sequence,
+	})
+}
+
+func (sm *streamMerger) processLoop(ctx context.Context) {
+	releaseInterval := sm.window / 4
+	if releaseInterval < minReleaseInterval {
+		releaseInterval = minReleaseInterval
+	}
+
+	timer := time.NewTimer(releaseInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(releaseInterval)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			sm.release(time.Now())
+		}
+	}
+}
+
+// release outputs, in timestamp order, the lines that were held for at least the merge window
+func (sm *streamMerger) release(now time.Time) {
+	sm.mut.Lock()
+	defer sm.mut.Unlock()
+
+	for len(sm.pending) > 0 {
+		first := sm.pending[0]
+		if now.Sub(first.receivedAt) < sm.window {
+			return
+		}
+
+		heap.Pop(&sm.pending)
+		sm.output(first.line)
+	}
+}
+
+// Close stops the merger and outputs all the pending lines
+func (sm *streamMerger) Close() error {
+	sm.cancel()
+
+	sm.mut.Lock()
+	defer sm.mut.Unlock()
+
+	for len(sm.pending) > 0 {
+		first := heap.Pop(&sm.pending).(*pendingLine)
+		sm.output(first.line)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *streamMerger) IsInterfaceNil() bool {
+	return sm == nil
+}
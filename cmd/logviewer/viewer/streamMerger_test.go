@@ -0,0 +1,159 @@
+package viewer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type outputRecorder struct {
+	mut   sync.Mutex
+	lines []*LogLine
+}
+
+func (or *outputRecorder) output(line *LogLine) {
+	or.mut.Lock()
+	or.lines = append(or.lines, line)
+	or.mut.Unlock()
+}
+
+func (or *outputRecorder) messages() []string {
+	or.mut.Lock()
+	defer or.mut.Unlock()
+
+	messages := make([]string, 0, len(or.lines))
+	for _, line := range or.lines {
+		messages = append(messages, line.Message)
+	}
+
+	return messages
+}
+
+func createTimedLine(message string, timestamp time.Time) *LogLine {
+	return &LogLine{
+		Message:   message,
+		Timestamp: timestamp,
+	}
+}
+
+func TestNewStreamMerger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil output should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, err := NewStreamMerger(ArgsStreamMerger{})
+		assert.Equal(t, ErrNilOutputHandler, err)
+		assert.True(t, check.IfNil(sm))
+	})
+	t.Run("negative window should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, err := NewStreamMerger(ArgsStreamMerger{
+			Window: -time.Second,
+			Output: func(line *LogLine) {},
+		})
+		assert.Equal(t, ErrInvalidMergeWindow, err)
+		assert.True(t, check.IfNil(sm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sm, err := NewStreamMerger(ArgsStreamMerger{
+			Window: time.Second,
+			Output: func(line *LogLine) {},
+		})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sm))
+		assert.Nil(t, sm.Close())
+	})
+}
+
+func TestStreamMerger_ZeroWindowShouldOutputDirectly(t *testing.T) {
+	t.Parallel()
+
+	recorder := &outputRecorder{}
+	sm, _ := NewStreamMerger(ArgsStreamMerger{
+		Output: recorder.output,
+	})
+
+	now := time.Now()
+	sm.Add(nil)
+	sm.Add(createTimedLine("second", now))
+	sm.Add(createTimedLine("first", now.Add(-time.Second)))
+	assert.Equal(t, []string{"second", "first"}, recorder.messages())
+}
+
+func TestStreamMerger_ShouldOrderTheLinesByTimestamp(t *testing.T) {
+	t.Parallel()
+
+	recorder := &outputRecorder{}
+	sm, _ := NewStreamMerger(ArgsStreamMerger{
+		Window: time.Hour,
+		Output: recorder.output,
+	})
+
+	now := time.Now()
+	sm.Add(createTimedLine("node0 line 1", now.Add(time.Millisecond)))
+	sm.Add(createTimedLine("node0 line 2", now.Add(time.Millisecond*3)))
+	sm.Add(createTimedLine("node1 line 1", now))
+	sm.Add(createTimedLine("node1 line 2", now.Add(time.Millisecond*3)))
+	sm.Add(createTimedLine("node1 line 3", now.Add(time.Millisecond*2)))
+
+	// the lines are held for the whole window
+	sm.release(time.Now())
+	assert.Empty(t, recorder.messages())
+
+	sm.release(time.Now().Add(time.Hour))
+	expectedMessages := []string{
+		"node1 line 1",
+		"node0 line 1",
+		"node1 line 3",
+		"node0 line 2",
+		"node1 line 2",
+	}
+	assert.Equal(t, expectedMessages, recorder.messages())
+}
+
+func TestStreamMerger_ShouldReleaseAfterTheWindow(t *testing.T) {
+	t.Parallel()
+
+	recorder := &outputRecorder{}
+	sm, _ := NewStreamMerger(ArgsStreamMerger{
+		Window: time.Millisecond * 50,
+		Output: recorder.output,
+	})
+	defer func() {
+		_ = sm.Close()
+	}()
+
+	now := time.Now()
+	sm.Add(createTimedLine("second", now))
+	sm.Add(createTimedLine("first", now.Add(-time.Millisecond)))
+
+	require.Eventually(t, func() bool {
+		return len(recorder.messages()) == 2
+	}, time.Second*2, time.Millisecond*10)
+	assert.Equal(t, []string{"first", "second"}, recorder.messages())
+}
+
+func TestStreamMerger_CloseShouldOutputThePendingLines(t *testing.T) {
+	t.Parallel()
+
+	recorder := &outputRecorder{}
+	sm, _ := NewStreamMerger(ArgsStreamMerger{
+		Window: time.Hour,
+		Output: recorder.output,
+	})
+
+	now := time.Now()
+	sm.Add(createTimedLine("second", now))
+	sm.Add(createTimedLine("first", now.Add(-time.Millisecond)))
+	err := sm.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first", "second"}, recorder.messages())
+}
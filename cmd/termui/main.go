@@ -6,6 +6,7 @@ import (
 	"os/signal"
 	"runtime"
 	"syscall"
+	"time"
 
 	"github.com/multiversx/mx-chain-go/cmd/termui/presenter"
 	"github.com/multiversx/mx-chain-go/cmd/termui/provider"
@@ -14,13 +15,22 @@ import (
 	"github.com/urfave/cli"
 )
 
+// numMissedFetchesForUnreachable is the number of fetch intervals after which a node not responding is flagged
+// as unreachable on the multi-node dashboard
+const numMissedFetchesForUnreachable = 5
+
 type config struct {
-	logWithCorrelation bool
-	logWithLoggerName  bool
-	useWss             bool
-	interval           int
-	address            string
-	logLevel           string
+	logWithCorrelation   bool
+	logWithLoggerName    bool
+	useWss               bool
+	interval             int
+	address              string
+	logLevel             string
+	nodes                string
+	maxNonceLag          uint64
+	maxMissedLeaderSlots uint64
+	minConnectedPeers    uint64
+	maxMemLoadPercent    uint64
 }
 
 var (
@@ -83,6 +93,45 @@ VERSION:
 		Usage:       "Will use wss instead of ws when creating the web socket",
 		Destination: &argsConfig.useWss,
 	}
+	// nodes defines a flag for the list of nodes displayed on the multi-node dashboard
+	nodes = cli.StringFlag{
+		Name: "nodes",
+		Usage: "Comma-separated list of nodes addresses. If set, the application will poll all these nodes and display " +
+			"them on a dashboard, instead of the single node view. Each address can be prefixed by the name displayed " +
+			"for that node, for example: validator1=127.0.0.1:8080,validator2=127.0.0.1:8081",
+		Value:       "",
+		Destination: &argsConfig.nodes,
+	}
+	// maxNonceLag defines a flag for the number of blocks a node can fall behind before being flagged on the dashboard
+	maxNonceLag = cli.Uint64Flag{
+		Name: "max-nonce-lag",
+		Usage: "The number of blocks a node can fall behind the highest nonce known in its shard before being flagged " +
+			"on the multi-node dashboard",
+		Value:       5,
+		Destination: &argsConfig.maxNonceLag,
+	}
+	// maxMissedLeaderSlots defines a flag for the number of missed leader slots tolerated on the dashboard
+	maxMissedLeaderSlots = cli.Uint64Flag{
+		Name: "max-missed-leader-slots",
+		Usage: "The number of rounds, since the node started, in which the node was leader without the proposed block " +
+			"being accepted, before being flagged on the multi-node dashboard",
+		Value:       5,
+		Destination: &argsConfig.maxMissedLeaderSlots,
+	}
+	// minConnectedPeers defines a flag for the minimum number of connected peers tolerated on the dashboard
+	minConnectedPeers = cli.Uint64Flag{
+		Name:        "min-peers",
+		Usage:       "The minimum number of connected peers under which a node is flagged on the multi-node dashboard",
+		Value:       10,
+		Destination: &argsConfig.minConnectedPeers,
+	}
+	// maxMemLoadPercent defines a flag for the memory load tolerated on the dashboard
+	maxMemLoadPercent = cli.Uint64Flag{
+		Name:        "max-memory-percent",
+		Usage:       "The memory load, in percents, over which a node is flagged on the multi-node dashboard",
+		Value:       90,
+		Destination: &argsConfig.maxMemLoadPercent,
+	}
 	argsConfig = &config{}
 
 	log    = logger.GetOrCreate("termui")
@@ -104,6 +153,10 @@ func main() {
 }
 
 func startTermuiViewer(ctx *cli.Context) error {
+	if ctx.IsSet(nodes.Name) {
+		return startTermuiDashboard()
+	}
+
 	nodeAddress := argsConfig.address
 	fetchIntervalFlagValue := argsConfig.interval
 
@@ -155,6 +208,54 @@ func startTermuiViewer(ctx *cli.Context) error {
 	return nil
 }
 
+func startTermuiDashboard() error {
+	nodesAddresses, err := provider.ParseNodesAddresses(argsConfig.nodes)
+	if err != nil {
+		return err
+	}
+
+	dashboardNodes := make([]presenter.DashboardNode, 0, len(nodesAddresses))
+	for _, node := range nodesAddresses {
+		presenterStatusHandler := presenter.NewPresenterStatusHandler()
+		statusMetricsProvider, errProvider := provider.NewStatusMetricsProvider(presenterStatusHandler, node.Address, argsConfig.interval)
+		if errProvider != nil {
+			return fmt.Errorf("%w for node %s", errProvider, node.Name)
+		}
+
+		statusMetricsProvider.StartUpdatingData()
+		dashboardNodes = append(dashboardNodes, presenter.DashboardNode{
+			Name:      node.Name,
+			Presenter: presenterStatusHandler,
+		})
+	}
+
+	thresholds := presenter.DashboardThresholds{
+		MaxNonceLag:          argsConfig.maxNonceLag,
+		MaxMissedLeaderSlots: argsConfig.maxMissedLeaderSlots,
+		MinConnectedPeers:    argsConfig.minConnectedPeers,
+		MaxMemLoadPercent:    argsConfig.maxMemLoadPercent,
+		MaxFetchDelay:        time.Duration(argsConfig.interval*numMissedFetchesForUnreachable) * time.Millisecond,
+	}
+	nodesDashboard, err := presenter.NewNodesDashboard(dashboardNodes, thresholds)
+	if err != nil {
+		return err
+	}
+
+	termuiConsole, err := termuic.NewTermuiDashboardConsole(nodesDashboard, argsConfig.interval)
+	if err != nil {
+		return err
+	}
+
+	err = termuiConsole.Start()
+	if err != nil {
+		return err
+	}
+
+	waitForUserToTerminateApp()
+
+	return nil
+}
+
 func initCliFlags() {
 	cliApp = cli.NewApp()
 	cli.AppHelpTemplate = nodeHelpTemplate
@@ -168,6 +269,11 @@ func initCliFlags() {
 		logWithLoggerName,
 		fetchIntervalInMilliseconds,
 		useWss,
+		nodes,
+		maxNonceLag,
+		maxMissedLeaderSlots,
+		minConnectedPeers,
+		maxMemLoadPercent,
 	}
 	cliApp.Authors = []cli.Author{
 		{
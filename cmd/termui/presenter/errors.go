@@ -0,0 +1,6 @@
+package presenter
+
+import "errors"
+
+// ErrEmptyDashboardNodes signals that no node has been provided for the multi-node dashboard
+var ErrEmptyDashboardNodes = errors.New("empty dashboard nodes")
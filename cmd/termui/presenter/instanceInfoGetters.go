@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/multiversx/mx-chain-go/cmd/termui/provider"
 	"github.com/multiversx/mx-chain-go/common"
 )
 
@@ -92,6 +93,11 @@ func (psh *PresenterStatusHandler) CheckSoftwareVersion() (bool, string) {
 	return true, latestStableVersion
 }
 
+// GetLastFetchTimestamp will return the unix timestamp, in milliseconds, of the last successful metrics fetch
+func (psh *PresenterStatusHandler) GetLastFetchTimestamp() uint64 {
+	return psh.getFromCacheAsUint64(provider.LastFetchTimestampMetric)
+}
+
 // GetNodeName will return node's display name
 func (psh *PresenterStatusHandler) GetNodeName() string {
 	nodeName := psh.getFromCacheAsString(common.MetricNodeDisplayName)
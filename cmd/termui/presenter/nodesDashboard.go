@@ -0,0 +1,167 @@
+package presenter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+const (
+	metachainShardName = "meta"
+	unknownShardName   = "?"
+)
+
+// DashboardThresholds holds the limits over which a node is flagged on the multi-node dashboard
+type DashboardThresholds struct {
+	MaxNonceLag          uint64
+	MaxMissedLeaderSlots uint64
+	MinConnectedPeers    uint64
+	MaxMemLoadPercent    uint64
+	MaxFetchDelay        time.Duration
+}
+
+// DashboardNode is a node displayed on the multi-node dashboard, together with the presenter holding its metrics
+type DashboardNode struct {
+	Name      string
+	Presenter view.Presenter
+}
+
+type nodesDashboard struct {
+	nodes          []DashboardNode
+	thresholds     DashboardThresholds
+	getTimeHandler func() time.Time
+}
+
+// NewNodesDashboard creates the presenter of the multi-node dashboard, which summarizes the metrics of each node
+// and flags the anomalies based on the provided thresholds
+func NewNodesDashboard(nodes []DashboardNode, thresholds DashboardThresholds) (*nodesDashboard, error) {
+	if len(nodes) == 0 {
+		return nil, ErrEmptyDashboardNodes
+	}
+	for _, node := range nodes {
+		if check.IfNil(node.Presenter) {
+			return nil, fmt.Errorf("%w for node %s", view.ErrNilPresenterInterface, node.Name)
+		}
+	}
+
+	return &nodesDashboard{
+		nodes:          nodes,
+		thresholds:     thresholds,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// GetNodesStatus returns the status of every node, in the order the nodes were provided
+func (nd *nodesDashboard) GetNodesStatus() []view.NodeStatus {
+	statuses := make([]view.NodeStatus, 0, len(nd.nodes))
+	for _, node := range nd.nodes {
+		statuses = append(statuses, nd.createNodeStatus(node))
+	}
+
+	// the nodes of the same shard are a better reference than the probable highest nonce of a node falling behind
+	highestNonces := make(map[string]uint64)
+	for _, status := range statuses {
+		if status.IsReachable && status.Nonce > highestNonces[status.Shard] {
+			highestNonces[status.Shard] = status.Nonce
+		}
+	}
+
+	for i := range statuses {
+		if !statuses[i].IsReachable {
+			continue
+		}
+
+		highestNonce := highestNonces[statuses[i].Shard]
+		if highestNonce > statuses[i].Nonce && highestNonce-statuses[i].Nonce > statuses[i].NonceLag {
+			statuses[i].NonceLag = highestNonce - statuses[i].Nonce
+		}
+		statuses[i].Alerts = nd.computeAlerts(statuses[i])
+	}
+
+	return statuses
+}
+
+func (nd *nodesDashboard) createNodeStatus(node DashboardNode) view.NodeStatus {
+	presenter := node.Presenter
+	status := view.NodeStatus{
+		Name:        node.Name,
+		Shard:       unknownShardName,
+		IsReachable: nd.isReachable(presenter.GetLastFetchTimestamp()),
+	}
+	if !status.IsReachable {
+		status.Alerts = []string{"unreachable"}
+		return status
+	}
+
+	status.Shard = getShardName(presenter.GetShardId())
+	status.PeerType = presenter.GetPeerType()
+	status.IsSyncing = presenter.GetIsSyncing() != 0
+	status.Nonce = presenter.GetNonce()
+	probableHighestNonce := presenter.GetProbableHighestNonce()
+	if probableHighestNonce > status.Nonce {
+		status.NonceLag = probableHighestNonce - status.Nonce
+	}
+
+	status.ConsensusParticipation = metricNotAvailable
+	countConsensus := presenter.GetCountConsensus()
+	if countConsensus > 0 {
+		participation := float64(presenter.GetCountConsensusAcceptedBlocks()) * 100 / float64(countConsensus)
+		status.ConsensusParticipation = fmt.Sprintf("%.1f%%", participation)
+	}
+
+	countLeader := presenter.GetCountLeader()
+	countAcceptedBlocks := presenter.GetCountAcceptedBlocks()
+	if countLeader > countAcceptedBlocks {
+		status.MissedLeaderSlots = countLeader - countAcceptedBlocks
+	}
+
+	status.NumConnectedPeers = presenter.GetNumConnectedPeers()
+	status.MemUsedByNode = presenter.GetMemUsedByNode()
+	status.MemLoadPercent = presenter.GetMemLoadPercent()
+
+	return status
+}
+
+func (nd *nodesDashboard) isReachable(lastFetchTimestamp uint64) bool {
+	if lastFetchTimestamp == 0 {
+		return false
+	}
+
+	lastFetch := time.UnixMilli(int64(lastFetchTimestamp))
+
+	return nd.getTimeHandler().Sub(lastFetch) <= nd.thresholds.MaxFetchDelay
+}
+
+func (nd *nodesDashboard) computeAlerts(status view.NodeStatus) []string {
+	alerts := make([]string, 0)
+	if status.NonceLag > nd.thresholds.MaxNonceLag {
+		alerts = append(alerts, fmt.Sprintf("behind by %d blocks", status.NonceLag))
+	}
+	if status.MissedLeaderSlots > nd.thresholds.MaxMissedLeaderSlots {
+		alerts = append(alerts, fmt.Sprintf("missed %d leader slots", status.MissedLeaderSlots))
+	}
+	if status.NumConnectedPeers < nd.thresholds.MinConnectedPeers {
+		alerts = append(alerts, fmt.Sprintf("only %d connected peers", status.NumConnectedPeers))
+	}
+	if status.MemLoadPercent > nd.thresholds.MaxMemLoadPercent {
+		alerts = append(alerts, fmt.Sprintf("memory load at %d%%", status.MemLoadPercent))
+	}
+
+	return alerts
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nd *nodesDashboard) IsInterfaceNil() bool {
+	return nd == nil
+}
+
+func getShardName(shardID uint64) string {
+	if shardID == uint64(core.MetachainShardId) {
+		return metachainShardName
+	}
+
+	return fmt.Sprintf("%d", shardID)
+}
@@ -0,0 +1,190 @@
+package presenter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/termui/provider"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var currentTime = time.Unix(1700000000, 0)
+
+func createDashboardThresholds() DashboardThresholds {
+	return DashboardThresholds{
+		MaxNonceLag:          5,
+		MaxMissedLeaderSlots: 2,
+		MinConnectedPeers:    10,
+		MaxMemLoadPercent:    90,
+		MaxFetchDelay:        time.Second * 5,
+	}
+}
+
+func createHealthyPresenter(shardID uint64, nonce uint64) *PresenterStatusHandler {
+	presenterStatusHandler := NewPresenterStatusHandler()
+	presenterStatusHandler.SetUInt64Value(provider.LastFetchTimestampMetric, uint64(currentTime.UnixMilli()))
+	presenterStatusHandler.SetUInt64Value(common.MetricShardId, shardID)
+	presenterStatusHandler.SetStringValue(common.MetricPeerType, "eligible")
+	presenterStatusHandler.SetUInt64Value(common.MetricNonce, nonce)
+	presenterStatusHandler.SetUInt64Value(common.MetricProbableHighestNonce, nonce)
+	presenterStatusHandler.SetUInt64Value(common.MetricNumConnectedPeers, 50)
+	presenterStatusHandler.SetUInt64Value(common.MetricMemUsedGolang, 1024)
+	presenterStatusHandler.SetUInt64Value(common.MetricMemLoadPercent, 40)
+
+	return presenterStatusHandler
+}
+
+func createNodesDashboard(tb testing.TB, nodes []DashboardNode) *nodesDashboard {
+	dashboard, err := NewNodesDashboard(nodes, createDashboardThresholds())
+	require.Nil(tb, err)
+	dashboard.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	return dashboard
+}
+
+func TestNewNodesDashboard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		dashboard, err := NewNodesDashboard(nil, createDashboardThresholds())
+		assert.Equal(t, ErrEmptyDashboardNodes, err)
+		assert.Nil(t, dashboard)
+	})
+	t.Run("nil presenter should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := []DashboardNode{
+			{Name: "node0", Presenter: NewPresenterStatusHandler()},
+			{Name: "node1"},
+		}
+		dashboard, err := NewNodesDashboard(nodes, createDashboardThresholds())
+		assert.True(t, errors.Is(err, view.ErrNilPresenterInterface))
+		assert.Nil(t, dashboard)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := []DashboardNode{{Name: "node0", Presenter: NewPresenterStatusHandler()}}
+		dashboard, err := NewNodesDashboard(nodes, createDashboardThresholds())
+		assert.Nil(t, err)
+		assert.False(t, dashboard.IsInterfaceNil())
+	})
+}
+
+func TestNodesDashboard_GetNodesStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("healthy node should not raise alerts", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := createHealthyPresenter(1, 100)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountConsensus, 8)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountConsensusAcceptedBlocks, 6)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountLeader, 3)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountAcceptedBlocks, 2)
+		dashboard := createNodesDashboard(t, []DashboardNode{{Name: "node0", Presenter: presenterStatusHandler}})
+
+		expectedStatus := view.NodeStatus{
+			Name:                   "node0",
+			Shard:                  "1",
+			PeerType:               "eligible",
+			IsReachable:            true,
+			Nonce:                  100,
+			ConsensusParticipation: "75.0%",
+			MissedLeaderSlots:      1,
+			NumConnectedPeers:      50,
+			MemUsedByNode:          1024,
+			MemLoadPercent:         40,
+			Alerts:                 make([]string, 0),
+		}
+		assert.Equal(t, []view.NodeStatus{expectedStatus}, dashboard.GetNodesStatus())
+	})
+	t.Run("node not fetched should be unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		dashboard := createNodesDashboard(t, []DashboardNode{{Name: "node0", Presenter: NewPresenterStatusHandler()}})
+
+		statuses := dashboard.GetNodesStatus()
+		require.Equal(t, 1, len(statuses))
+		assert.False(t, statuses[0].IsReachable)
+		assert.Equal(t, []string{"unreachable"}, statuses[0].Alerts)
+	})
+	t.Run("node with an old fetch should be unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := createHealthyPresenter(0, 100)
+		oldFetch := currentTime.Add(-time.Second * 6)
+		presenterStatusHandler.SetUInt64Value(provider.LastFetchTimestampMetric, uint64(oldFetch.UnixMilli()))
+		dashboard := createNodesDashboard(t, []DashboardNode{{Name: "node0", Presenter: presenterStatusHandler}})
+
+		statuses := dashboard.GetNodesStatus()
+		require.Equal(t, 1, len(statuses))
+		assert.False(t, statuses[0].IsReachable)
+		assert.Equal(t, unknownShardName, statuses[0].Shard)
+	})
+	t.Run("lag should be computed against the nodes of the same shard", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := []DashboardNode{
+			{Name: "node0", Presenter: createHealthyPresenter(0, 100)},
+			{Name: "node1", Presenter: createHealthyPresenter(0, 90)},
+			{Name: "node2", Presenter: createHealthyPresenter(1, 50)},
+			{Name: "node3", Presenter: createHealthyPresenter(0, 0)},
+		}
+		// an unreachable node should not be used as reference
+		nodes[3].Presenter.(*PresenterStatusHandler).SetUInt64Value(provider.LastFetchTimestampMetric, 0)
+		dashboard := createNodesDashboard(t, nodes)
+
+		statuses := dashboard.GetNodesStatus()
+		require.Equal(t, 4, len(statuses))
+		assert.Equal(t, uint64(0), statuses[0].NonceLag)
+		assert.Equal(t, uint64(10), statuses[1].NonceLag)
+		assert.Equal(t, []string{"behind by 10 blocks"}, statuses[1].Alerts)
+		assert.Equal(t, uint64(0), statuses[2].NonceLag)
+		assert.Empty(t, statuses[2].Alerts)
+	})
+	t.Run("lag should use the probable highest nonce if greater", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := createHealthyPresenter(0, 100)
+		presenterStatusHandler.SetUInt64Value(common.MetricProbableHighestNonce, 103)
+		dashboard := createNodesDashboard(t, []DashboardNode{{Name: "node0", Presenter: presenterStatusHandler}})
+
+		statuses := dashboard.GetNodesStatus()
+		require.Equal(t, 1, len(statuses))
+		assert.Equal(t, uint64(3), statuses[0].NonceLag)
+		assert.Empty(t, statuses[0].Alerts)
+	})
+	t.Run("thresholds exceeded should raise alerts", func(t *testing.T) {
+		t.Parallel()
+
+		presenterStatusHandler := createHealthyPresenter(uint64(core.MetachainShardId), 100)
+		presenterStatusHandler.SetUInt64Value(common.MetricProbableHighestNonce, 120)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountLeader, 5)
+		presenterStatusHandler.SetUInt64Value(common.MetricCountAcceptedBlocks, 1)
+		presenterStatusHandler.SetUInt64Value(common.MetricNumConnectedPeers, 3)
+		presenterStatusHandler.SetUInt64Value(common.MetricMemLoadPercent, 95)
+		dashboard := createNodesDashboard(t, []DashboardNode{{Name: "node0", Presenter: presenterStatusHandler}})
+
+		statuses := dashboard.GetNodesStatus()
+		require.Equal(t, 1, len(statuses))
+		assert.Equal(t, metachainShardName, statuses[0].Shard)
+		assert.Equal(t, metricNotAvailable, statuses[0].ConsensusParticipation)
+		expectedAlerts := []string{
+			"behind by 20 blocks",
+			"missed 4 leader slots",
+			"only 3 connected peers",
+			"memory load at 95%",
+		}
+		assert.Equal(t, expectedAlerts, statuses[0].Alerts)
+	})
+}
@@ -19,3 +19,9 @@ var ErrNilChanNodeIsStarting = errors.New("nil node starting channel")
 
 // ErrEmptyNodeURL signals that an empty URL for the node has been provided
 var ErrEmptyNodeURL = errors.New("empty node URL")
+
+// ErrEmptyNodesList signals that an empty list of nodes has been provided
+var ErrEmptyNodesList = errors.New("empty nodes list")
+
+// ErrDuplicatedNodeName signals that the same name has been provided for more than one node
+var ErrDuplicatedNodeName = errors.New("duplicated node name")
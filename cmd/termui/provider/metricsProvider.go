@@ -16,6 +16,8 @@ var log = logger.GetOrCreate("termui/provider")
 
 const (
 	AccountsSnapshotNumNodesMetric = "AccountsSnapshotNumNodesMetric"
+	// LastFetchTimestampMetric holds the unix timestamp, in milliseconds, of the last successful status metrics fetch
+	LastFetchTimestampMetric = "LastFetchTimestampMetric"
 
 	statusMetricsUrlSuffix          = "/node/status"
 	bootstrapStatusMetricsUrlSuffix = "/node/bootstrapstatus"
@@ -187,6 +189,7 @@ func (smp *StatusMetricsProvider) fetchAndApplyMetrics(metricsPath string) {
 	}
 
 	smp.applyMetricsToPresenter(metricsMap)
+	smp.presenter.SetUInt64Value(LastFetchTimestampMetric, uint64(time.Now().UnixMilli()))
 }
 
 func (smp *StatusMetricsProvider) loadMetricsFromApi(metricsPath string) (map[string]interface{}, error) {
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+const nodeNameSeparator = "="
+
+// NodeAddress defines a node polled by the multi-node dashboard
+type NodeAddress struct {
+	Name    string
+	Address string
+}
+
+// ParseNodesAddresses parses the comma separated list of nodes. Each address can be prefixed by the name displayed
+// on the dashboard, as in name=address, otherwise the address itself is used as name
+func ParseNodesAddresses(nodes string) ([]NodeAddress, error) {
+	result := make([]NodeAddress, 0)
+	names := make(map[string]struct{})
+	for _, element := range strings.Split(nodes, ",") {
+		element = strings.TrimSpace(element)
+		if len(element) == 0 {
+			continue
+		}
+
+		node := NodeAddress{
+			Name:    element,
+			Address: element,
+		}
+		splitElement := strings.SplitN(element, nodeNameSeparator, 2)
+		if len(splitElement) == 2 {
+			node.Name = strings.TrimSpace(splitElement[0])
+			node.Address = strings.TrimSpace(splitElement[1])
+		}
+		if len(node.Name) == 0 || len(node.Address) == 0 {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidAddressLength, element)
+		}
+
+		_, exists := names[node.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedNodeName, node.Name)
+		}
+		names[node.Name] = struct{}{}
+
+		result = append(result, node)
+	}
+
+	if len(result) == 0 {
+		return nil, ErrEmptyNodesList
+	}
+
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodesAddresses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty list should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodes, err := ParseNodesAddresses(" ,, ")
+		assert.Equal(t, ErrEmptyNodesList, err)
+		assert.Nil(t, nodes)
+	})
+	t.Run("empty name or address should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodes, err := ParseNodesAddresses("validator1=")
+		assert.True(t, errors.Is(err, ErrInvalidAddressLength))
+		assert.Nil(t, nodes)
+
+		nodes, err = ParseNodesAddresses("=127.0.0.1:8080")
+		assert.True(t, errors.Is(err, ErrInvalidAddressLength))
+		assert.Nil(t, nodes)
+	})
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodes, err := ParseNodesAddresses("127.0.0.1:8080,127.0.0.1:8080")
+		assert.True(t, errors.Is(err, ErrDuplicatedNodeName))
+		assert.Nil(t, nodes)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nodes, err := ParseNodesAddresses("127.0.0.1:8080, validator2 = http://10.0.0.2:8080")
+		require.Nil(t, err)
+
+		expectedNodes := []NodeAddress{
+			{Name: "127.0.0.1:8080", Address: "127.0.0.1:8080"},
+			{Name: "validator2", Address: "http://10.0.0.2:8080"},
+		}
+		assert.Equal(t, expectedNodes, nodes)
+	})
+}
@@ -13,3 +13,6 @@ var ErrNilGrid = errors.New("nil grid")
 
 // ErrInvalidRefreshTimeInMilliseconds signals that an invalid time in milliseconds was provided
 var ErrInvalidRefreshTimeInMilliseconds = errors.New("invalid refresh time in milliseconds")
+
+// ErrNilDashboardPresenter will be returned when a nil DashboardPresenter is passed as parameter
+var ErrNilDashboardPresenter = errors.New("nil dashboard presenter")
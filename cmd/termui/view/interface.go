@@ -65,6 +65,14 @@ type Presenter interface {
 	GetTrieSyncNumBytesReceived() uint64
 	GetTrieSyncProcessedPercentage() core.OptionalUint64
 
+	GetLastFetchTimestamp() uint64
+
 	InvalidateCache()
 	IsInterfaceNil() bool
 }
+
+// DashboardPresenter defines the methods that return the status of the nodes displayed on the multi-node dashboard
+type DashboardPresenter interface {
+	GetNodesStatus() []NodeStatus
+	IsInterfaceNil() bool
+}
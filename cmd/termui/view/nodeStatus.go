@@ -0,0 +1,18 @@
+package view
+
+// NodeStatus holds the summary of a node, as displayed on the multi-node dashboard
+type NodeStatus struct {
+	Name                   string
+	Shard                  string
+	PeerType               string
+	IsReachable            bool
+	IsSyncing              bool
+	Nonce                  uint64
+	NonceLag               uint64
+	ConsensusParticipation string
+	MissedLeaderSlots      uint64
+	NumConnectedPeers      uint64
+	MemUsedByNode          uint64
+	MemLoadPercent         uint64
+	Alerts                 []string
+}
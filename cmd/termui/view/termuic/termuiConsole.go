@@ -22,8 +22,9 @@ var log = logger.GetOrCreate("statushandler/view/termuic")
 
 // TermuiConsole data where is store data from handler
 type TermuiConsole struct {
-	presenter                 view.Presenter
 	consoleRender             TermuiRender
+	createRender              func(grid *termuiRenders.DrawableContainer) (TermuiRender, error)
+	invalidateCache           func()
 	grid                      *termuiRenders.DrawableContainer
 	mutRefresh                *sync.RWMutex
 	chanNodeIsStarting        chan struct{}
@@ -43,7 +44,10 @@ func NewTermuiConsole(presenter view.Presenter, refreshTimeInMilliseconds int, c
 	}
 
 	tc := TermuiConsole{
-		presenter:                 presenter,
+		createRender: func(grid *termuiRenders.DrawableContainer) (TermuiRender, error) {
+			return termuiRenders.NewWidgetsRender(presenter, grid)
+		},
+		invalidateCache:           presenter.InvalidateCache,
 		mutRefresh:                &sync.RWMutex{},
 		refreshTimeInMilliseconds: refreshTimeInMilliseconds,
 		chanNodeIsStarting:        chanNodeIsStarting,
@@ -52,6 +56,29 @@ func NewTermuiConsole(presenter view.Presenter, refreshTimeInMilliseconds int, c
 	return &tc, nil
 }
 
+// NewTermuiDashboardConsole method is used to return a new TermuiConsole structure which displays the multi-node dashboard
+func NewTermuiDashboardConsole(presenter view.DashboardPresenter, refreshTimeInMilliseconds int) (*TermuiConsole, error) {
+	if presenter == nil || presenter.IsInterfaceNil() {
+		return nil, view.ErrNilDashboardPresenter
+	}
+	if refreshTimeInMilliseconds < 1 {
+		return nil, view.ErrInvalidRefreshTimeInMilliseconds
+	}
+
+	tc := TermuiConsole{
+		createRender: func(grid *termuiRenders.DrawableContainer) (TermuiRender, error) {
+			return termuiRenders.NewDashboardRender(presenter, grid)
+		},
+		// the dashboard does not listen to the nodes' logs, so it is not notified when a node restarts
+		invalidateCache:           func() {},
+		mutRefresh:                &sync.RWMutex{},
+		refreshTimeInMilliseconds: refreshTimeInMilliseconds,
+		chanNodeIsStarting:        make(chan struct{}),
+	}
+
+	return &tc, nil
+}
+
 // Start method - will start termui console
 func (tc *TermuiConsole) Start() error {
 	go func() {
@@ -74,7 +101,7 @@ func (tc *TermuiConsole) eventLoop() {
 	}
 
 	var err error
-	tc.consoleRender, err = termuiRenders.NewWidgetsRender(tc.presenter, tc.grid)
+	tc.consoleRender, err = tc.createRender(tc.grid)
 	if err != nil {
 		log.Debug("nil console render", "error", err.Error())
 		return
@@ -101,7 +128,7 @@ func (tc *TermuiConsole) eventLoop() {
 		case e := <-uiEvents:
 			tc.processUiEvents(e, tc.refreshTimeInMilliseconds)
 		case <-tc.chanNodeIsStarting:
-			tc.presenter.InvalidateCache()
+			tc.invalidateCache()
 		}
 	}
 }
@@ -144,5 +171,5 @@ func (tc *TermuiConsole) refreshWindow(numMillisecondsRefreshTime int) {
 
 	tc.consoleRender.RefreshData(numMillisecondsRefreshTime)
 	ui.Clear()
-	ui.Render(tc.grid.TopLeft(), tc.grid.TopRight(), tc.grid.Bottom())
+	ui.Render(tc.grid.Items()...)
 }
@@ -0,0 +1,126 @@
+package termuiRenders
+
+import (
+	"fmt"
+	"strings"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+)
+
+const (
+	statusUnreachable = "unreachable"
+	statusSynced      = "synced"
+	noAnomaliesFound  = "No anomalies detected"
+)
+
+var dashboardHeader = []string{"Node", "Shard", "Peer type", "Status", "Nonce", "Lag", "Consensus", "Missed leader", "Peers", "Memory"}
+
+// DashboardRender will define the termui widgets of the multi-node dashboard
+type DashboardRender struct {
+	container *DrawableContainer
+	nodes     *widgets.Table
+	alerts    *widgets.List
+	presenter view.DashboardPresenter
+}
+
+// NewDashboardRender method will create a new DashboardRender that displays the status of several nodes in a table,
+// followed by the list of the detected anomalies
+func NewDashboardRender(presenter view.DashboardPresenter, grid *DrawableContainer) (*DashboardRender, error) {
+	if presenter == nil || presenter.IsInterfaceNil() {
+		return nil, view.ErrNilDashboardPresenter
+	}
+	if grid == nil {
+		return nil, view.ErrNilGrid
+	}
+
+	self := &DashboardRender{
+		presenter: presenter,
+		container: grid,
+	}
+	self.initWidgets()
+	self.setGrid()
+
+	return self, nil
+}
+
+func (dr *DashboardRender) initWidgets() {
+	dr.nodes = widgets.NewTable()
+	dr.nodes.Title = "Nodes"
+	dr.nodes.Rows = [][]string{dashboardHeader}
+	dr.nodes.RowSeparator = false
+	dr.nodes.TextAlignment = ui.AlignLeft
+
+	dr.alerts = widgets.NewList()
+	dr.alerts.Title = "Alerts"
+	dr.alerts.WrapText = true
+}
+
+func (dr *DashboardRender) setGrid() {
+	grid := ui.NewGrid()
+	grid.Set(
+		ui.NewRow(2.0/3, dr.nodes),
+		ui.NewRow(1.0/3, dr.alerts),
+	)
+
+	dr.container.SetTopHeight(0)
+	dr.container.SetBottom(grid)
+}
+
+// RefreshData method is used to prepare data that are displayed on container
+func (dr *DashboardRender) RefreshData(_ int) {
+	statuses := dr.presenter.GetNodesStatus()
+
+	rows := make([][]string, 0, len(statuses)+1)
+	rows = append(rows, dashboardHeader)
+	rowStyles := make(map[int]ui.Style)
+	rowStyles[0] = ui.NewStyle(ui.ColorWhite, ui.ColorClear, ui.ModifierBold)
+	alerts := make([]string, 0)
+	for i, status := range statuses {
+		rows = append(rows, createNodeRow(status))
+		if len(status.Alerts) == 0 {
+			continue
+		}
+
+		rowStyles[i+1] = ui.NewStyle(ui.ColorRed)
+		alerts = append(alerts, fmt.Sprintf("[%s](fg:red,mod:bold): %s", status.Name, strings.Join(status.Alerts, ", ")))
+	}
+	if len(alerts) == 0 {
+		alerts = append(alerts, fmt.Sprintf("[%s](fg:green)", noAnomaliesFound))
+	}
+
+	dr.nodes.Rows = rows
+	dr.nodes.RowStyles = rowStyles
+	dr.alerts.Rows = alerts
+}
+
+func createNodeRow(status view.NodeStatus) []string {
+	if !status.IsReachable {
+		return []string{status.Name, status.Shard, "", statusUnreachable, "", "", "", "", "", ""}
+	}
+
+	syncStatus := statusSynced
+	if status.IsSyncing {
+		syncStatus = statusSyncing
+	}
+
+	return []string{
+		status.Name,
+		status.Shard,
+		status.PeerType,
+		syncStatus,
+		fmt.Sprintf("%d", status.Nonce),
+		fmt.Sprintf("%d", status.NonceLag),
+		status.ConsensusParticipation,
+		fmt.Sprintf("%d", status.MissedLeaderSlots),
+		fmt.Sprintf("%d", status.NumConnectedPeers),
+		fmt.Sprintf("%s (%d%%)", core.ConvertBytes(status.MemUsedByNode), status.MemLoadPercent),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dr *DashboardRender) IsInterfaceNil() bool {
+	return dr == nil
+}
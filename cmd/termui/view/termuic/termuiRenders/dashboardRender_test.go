@@ -0,0 +1,41 @@
+package termuiRenders
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/cmd/termui/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNodeRow(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unreachable node should only display the name", func(t *testing.T) {
+		t.Parallel()
+
+		row := createNodeRow(view.NodeStatus{Name: "node0", Shard: "?"})
+		assert.Equal(t, []string{"node0", "?", "", statusUnreachable, "", "", "", "", "", ""}, row)
+		assert.Equal(t, len(dashboardHeader), len(row))
+	})
+	t.Run("reachable node should display all columns", func(t *testing.T) {
+		t.Parallel()
+
+		status := view.NodeStatus{
+			Name:                   "node0",
+			Shard:                  "meta",
+			PeerType:               "eligible",
+			IsReachable:            true,
+			IsSyncing:              true,
+			Nonce:                  100,
+			NonceLag:               2,
+			ConsensusParticipation: "75.0%",
+			MissedLeaderSlots:      1,
+			NumConnectedPeers:      50,
+			MemUsedByNode:          2048,
+			MemLoadPercent:         40,
+		}
+		row := createNodeRow(status)
+		expectedRow := []string{"node0", "meta", "eligible", statusSyncing, "100", "2", "75.0%", "1", "50", "2.00 KB (40%)"}
+		assert.Equal(t, expectedRow, row)
+	})
+}
@@ -11,6 +11,7 @@ type DrawableContainer struct {
 	topLeft   termui.Drawable
 	topRight  termui.Drawable
 	bottom    termui.Drawable
+	topHeight int
 	minHeight int
 	minWidth  int
 	maxWidth  int
@@ -19,10 +20,17 @@ type DrawableContainer struct {
 
 // NewDrawableContainer method is used to return a new NewDrawableContainer structure
 func NewDrawableContainer() *DrawableContainer {
-	dc := DrawableContainer{}
+	dc := DrawableContainer{
+		topHeight: topHeight,
+	}
 	return &dc
 }
 
+// SetTopHeight sets the height of the top drawables, the bottom one filling the remaining space
+func (imh *DrawableContainer) SetTopHeight(height int) {
+	imh.topHeight = height
+}
+
 // TopLeft gets the topLeft drawable
 func (imh *DrawableContainer) TopLeft() termui.Drawable {
 	return imh.topLeft
@@ -53,12 +61,14 @@ func (imh *DrawableContainer) SetBottom(drawable termui.Drawable) {
 	imh.bottom = drawable
 }
 
-// Items returns the containing items list
+// Items returns the containing items list, skipping the ones that were not set
 func (imh *DrawableContainer) Items() []termui.Drawable {
 	items := make([]termui.Drawable, 0)
-	items = append(items, imh.topLeft)
-	items = append(items, imh.topRight)
-	items = append(items, imh.bottom)
+	for _, item := range []termui.Drawable{imh.topLeft, imh.topRight, imh.bottom} {
+		if item != nil {
+			items = append(items, item)
+		}
+	}
 	return items
 }
 
@@ -70,15 +80,15 @@ func (imh *DrawableContainer) SetRectangle(startWidth, startHeight, termWidth, t
 	imh.minHeight = startHeight
 
 	if imh.topLeft != nil {
-		imh.topLeft.SetRect(startWidth, startHeight, imh.maxWidth/2, topHeight)
+		imh.topLeft.SetRect(startWidth, startHeight, imh.maxWidth/2, imh.topHeight)
 	}
 
 	if imh.topRight != nil {
-		imh.topRight.SetRect(imh.maxWidth/2, startHeight, imh.maxWidth, topHeight)
+		imh.topRight.SetRect(imh.maxWidth/2, startHeight, imh.maxWidth, imh.topHeight)
 	}
 
 	if imh.bottom != nil {
-		imh.bottom.SetRect(startWidth, topHeight, imh.maxWidth, imh.maxHeight)
+		imh.bottom.SetRect(startWidth, imh.topHeight, imh.maxWidth, imh.maxHeight)
 	}
 
 }
@@ -73,6 +73,10 @@ const MaxBulkTransactionSize = 1 << 18 // 256KB bulks
 // MaxTxsToRequest specifies the maximum number of txs to request
 const MaxTxsToRequest = 1000
 
+// BuiltInFunctionESDTSetLocalMintAllowance is the built in function called by the ESDT system SC to increase the amount
+// an address can still mint locally from a token with a supply policy
+const BuiltInFunctionESDTSetLocalMintAllowance = "ESDTSetLocalMintAllowance"
+
 // NodesSetupJsonFileName specifies the name of the json file which contains the setup of the nodes
 const NodesSetupJsonFileName = "nodesSetup.json"
 
@@ -94,6 +98,13 @@ const ConnectionTopic = "connection"
 // ValidatorInfoTopic is the topic used for validatorInfo signaling
 const ValidatorInfoTopic = "validatorInfo"
 
+// GasPriceModifierParameter is the name of the gas price modifier when changed through a governance parameter proposal
+const GasPriceModifierParameter = "economicsGasPriceModifier"
+
+// EconomicsParametersSeparator separates the names and the values of the economics parameters carried by the
+// reserved field of the epoch start meta blocks
+const EconomicsParametersSeparator = "@"
+
 // MetricCurrentRound is the metric for monitoring the current round of a node
 const MetricCurrentRound = "erd_current_round"
 
@@ -737,6 +748,18 @@ const (
 	// MetricFixRelayedMoveBalanceToNonPayableSCEnableEpoch represents the epoch when the fix for relayed move balance to non-payable sc is enabled
 	MetricFixRelayedMoveBalanceToNonPayableSCEnableEpoch = "erd_fix_relayed_move_balance_to_non_payable_sc_enable_epoch"
 
+	// MetricRelayedTransactionsV3EnableEpoch represents the epoch when relayed transactions v3 are enabled
+	MetricRelayedTransactionsV3EnableEpoch = "erd_relayed_transactions_v3_enable_epoch"
+
+	// MetricGovernanceParameterChangesEnableEpoch represents the epoch when governance parameter change proposals are enabled
+	MetricGovernanceParameterChangesEnableEpoch = "erd_governance_parameter_changes_enable_epoch"
+
+	// MetricDelegationLiquidStakingEnableEpoch represents the epoch when the delegation liquid staking tokens are enabled
+	MetricDelegationLiquidStakingEnableEpoch = "erd_delegation_liquid_staking_enable_epoch"
+
+	// MetricESDTSupplyPolicyEnableEpoch represents the epoch when the ESDT supply policies are enabled
+	MetricESDTSupplyPolicyEnableEpoch = "erd_esdt_supply_policy_enable_epoch"
+
 	// MetricMaxNodesChangeEnableEpoch holds configuration for changing the maximum number of nodes and the enabling epoch
 	MetricMaxNodesChangeEnableEpoch = "erd_max_nodes_change_enable_epoch"
 
@@ -857,6 +880,8 @@ const (
 	NetStatisticsOrder
 	// OldDatabaseCleanOrder defines the order in which oldDatabaseCleaner component is notified of a start of epoch event
 	OldDatabaseCleanOrder
+	// EconomicsDataOrder defines the order in which the economics data component is notified of a start of epoch event
+	EconomicsDataOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -967,6 +992,18 @@ const PublicKeyBlacklistDuration = time.Second * 7200
 // InvalidSigningBlacklistDuration defines the time to keep a peer id in blacklist if it signs a message with invalid signature
 const InvalidSigningBlacklistDuration = time.Second * 7200
 
+// PeerReputationSourceAntiflood represents the source of the peer reputation events reported by the antiflood components
+const PeerReputationSourceAntiflood = "antiflood"
+
+// PeerReputationSourceConsensus represents the source of the peer reputation events reported by the consensus components
+const PeerReputationSourceConsensus = "consensus"
+
+// PeerReputationSourcePeerHonesty represents the source of the peer reputation events reported by the peer honesty component
+const PeerReputationSourcePeerHonesty = "peer honesty"
+
+// PeerReputationBanPenalty represents the score change applied on the peer reputation each time a peer gets banned
+const PeerReputationBanPenalty = -10.0
+
 // MaxWaitingTimeToReceiveRequestedItem represents the maximum waiting time in seconds needed to receive the requested items
 const MaxWaitingTimeToReceiveRequestedItem = 5 * time.Second
 
@@ -1234,5 +1271,9 @@ const (
 	FixRelayedBaseCostFlag                             core.EnableEpochFlag = "FixRelayedBaseCostFlag"
 	MultiESDTNFTTransferAndExecuteByUserFlag           core.EnableEpochFlag = "MultiESDTNFTTransferAndExecuteByUserFlag"
 	FixRelayedMoveBalanceToNonPayableSCFlag            core.EnableEpochFlag = "FixRelayedMoveBalanceToNonPayableSCFlag"
+	RelayedTransactionsV3Flag                          core.EnableEpochFlag = "RelayedTransactionsV3Flag"
+	GovernanceParameterChangesFlag                     core.EnableEpochFlag = "GovernanceParameterChangesFlag"
+	DelegationLiquidStakingFlag                        core.EnableEpochFlag = "DelegationLiquidStakingFlag"
+	ESDTSupplyPolicyFlag                               core.EnableEpochFlag = "ESDTSupplyPolicyFlag"
 	// all new flags must be added to createAllFlagsMap method, as part of enableEpochsHandler allFlagsDefined
 )
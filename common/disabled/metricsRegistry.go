@@ -0,0 +1,56 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-go/common"
+
+type metricsRegistry struct {
+}
+
+// NewMetricsRegistry creates a new instance of disabled metrics registry
+func NewMetricsRegistry() *metricsRegistry {
+	return &metricsRegistry{}
+}
+
+// RegisterCounter returns a disabled counter
+func (mr *metricsRegistry) RegisterCounter(_ string, _ string, _ ...string) (common.CounterMetric, error) {
+	return &metric{}, nil
+}
+
+// RegisterGauge returns a disabled gauge
+func (mr *metricsRegistry) RegisterGauge(_ string, _ string, _ ...string) (common.GaugeMetric, error) {
+	return &metric{}, nil
+}
+
+// RegisterHistogram returns a disabled histogram
+func (mr *metricsRegistry) RegisterHistogram(_ string, _ string, _ []float64, _ ...string) (common.HistogramMetric, error) {
+	return &metric{}, nil
+}
+
+// PrometheusString returns an empty string
+func (mr *metricsRegistry) PrometheusString() (string, error) {
+	return "", nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *metricsRegistry) IsInterfaceNil() bool {
+	return mr == nil
+}
+
+type metric struct {
+}
+
+// Add does nothing
+func (m *metric) Add(_ float64, _ ...string) {
+}
+
+// Set does nothing
+func (m *metric) Set(_ float64, _ ...string) {
+}
+
+// Observe does nothing
+func (m *metric) Observe(_ float64, _ ...string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (m *metric) IsInterfaceNil() bool {
+	return m == nil
+}
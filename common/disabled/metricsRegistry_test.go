@@ -0,0 +1,34 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsRegistry_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	mr := NewMetricsRegistry()
+	assert.False(t, check.IfNil(mr))
+
+	require.NotPanics(t, func() {
+		counter, err := mr.RegisterCounter("counter", "help", "label")
+		require.Nil(t, err)
+		counter.Add(1, "value")
+
+		gauge, err := mr.RegisterGauge("gauge", "help")
+		require.Nil(t, err)
+		gauge.Set(1)
+
+		histogram, err := mr.RegisterHistogram("histogram", "help", nil, "label")
+		require.Nil(t, err)
+		histogram.Observe(1, "value")
+
+		metrics, err := mr.PrometheusString()
+		require.Nil(t, err)
+		require.Empty(t, metrics)
+	})
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"math/big"
+
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 )
 
@@ -91,3 +93,192 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// ValidatorEpochPerformance holds the performance of a validator BLS key during an epoch, as it was recorded by the
+// metachain at the end of that epoch. The rewards are paid per reward address, not per BLS key, so RewardAddressRewards
+// holds the rewards actually received by the reward address, shared by all its BLS keys, while EstimatedRewards is only
+// an estimation of the BLS key's part: its own leader fees plus a share of the rest of the reward address rewards,
+// proportional to its number of signed blocks
+type ValidatorEpochPerformance struct {
+	Epoch                         uint32  `json:"epoch"`
+	PublicKey                     string  `json:"publicKey"`
+	ShardId                       uint32  `json:"shardId"`
+	List                          string  `json:"list"`
+	NumLeaderSuccess              uint32  `json:"numLeaderSuccess"`
+	NumLeaderFailure              uint32  `json:"numLeaderFailure"`
+	NumValidatorSuccess           uint32  `json:"numValidatorSuccess"`
+	NumValidatorFailure           uint32  `json:"numValidatorFailure"`
+	NumValidatorIgnoredSignatures uint32  `json:"numValidatorIgnoredSignatures"`
+	NumSelectedInSuccessBlocks    uint32  `json:"numSelectedInSuccessBlocks"`
+	StartRating                   float32 `json:"startRating"`
+	EndRating                     float32 `json:"endRating"`
+	AccumulatedFees               string  `json:"accumulatedFees"`
+	RewardAddress                 string  `json:"rewardAddress"`
+	RewardAddressRewards          string  `json:"rewardAddressRewards"`
+	EstimatedRewards              string  `json:"estimatedRewards"`
+}
+
+// PeerReputationReason holds one of the latest events that changed the reputation of a peer
+type PeerReputationReason struct {
+	Source     string  `json:"source"`
+	Reason     string  `json:"reason"`
+	ScoreDelta float64 `json:"scoreDelta"`
+	Timestamp  int64   `json:"timestamp"`
+}
+
+// PeerReputationAPIResponse holds the reputation of a peer (identified either by its peer ID or by its public key)
+// for responding to API calls
+type PeerReputationAPIResponse struct {
+	PeerID      string                  `json:"pid,omitempty"`
+	PublicKey   string                  `json:"pubKey,omitempty"`
+	Score       float64                 `json:"score"`
+	NumEvents   uint32                  `json:"numEvents"`
+	BannedUntil int64                   `json:"bannedUntil"`
+	IsDenied    bool                    `json:"isDenied"`
+	IsAllowed   bool                    `json:"isAllowed"`
+	Reasons     []*PeerReputationReason `json:"reasons"`
+}
+
+// P2PMessageTrace holds the details of a p2p message sampled by the message tracer
+type P2PMessageTrace struct {
+	Topic              string `json:"topic"`
+	Originator         string `json:"originator"`
+	FromConnectedPeer  string `json:"fromConnectedPeer"`
+	SeqNo              string `json:"seqNo"`
+	Size               uint64 `json:"size"`
+	SentTimestamp      int64  `json:"sentTimestamp"`
+	ArrivalTimestamp   int64  `json:"arrivalTimestamp"`
+	ProcessingDuration int64  `json:"processingDuration"`
+	Result             string `json:"result"`
+	Error              string `json:"error,omitempty"`
+}
+
+// AccountStateOverride holds the values that will temporarily replace the ones from the state of an account
+// during an API simulation. Nil or empty fields leave the real values untouched
+type AccountStateOverride struct {
+	Balance      *big.Int
+	ESDTBalances map[string]*big.Int
+	Storage      map[string][]byte
+	Code         []byte
+}
+
+// StateOverrides maps the raw bytes of the overridden addresses to their state overrides
+type StateOverrides map[string]*AccountStateOverride
+
+// GuardianRegistration holds the data a user needs in order to set up the guardian co-signing service
+type GuardianRegistration struct {
+	GuardianAddress    string `json:"guardianAddress"`
+	ServiceUID         string `json:"serviceUID"`
+	OTPSecret          string `json:"otpSecret"`
+	OTPProvisioningURI string `json:"otpProvisioningURI"`
+}
+
+// GuardianRegistrationChallenge holds the challenge issued by the guardian co-signing service for a registration.
+// Message is the text to be signed (same format as the wallets use) and provided on registration
+type GuardianRegistrationChallenge struct {
+	Challenge string `json:"challenge"`
+	Message   string `json:"message"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// GuardianSpendingPolicy holds the limits checked by the guardian co-signing service before co-signing a transaction.
+// DailyLimits maps the token identifiers (EGLD for the native token) to the maximum amount that can be transferred
+// in a day (UTC), while AllowedReceivers, if not empty, restricts the transfers to the provided addresses
+type GuardianSpendingPolicy struct {
+	DailyLimits      map[string]string `json:"dailyLimits"`
+	AllowedReceivers []string          `json:"allowedReceivers"`
+}
+
+// GovernanceProposal holds the data of a governance proposal, as indexed from the governance system SC logs.
+// Status is one of pending, active, ended, passed or rejected, depending on the current epoch and on whether the
+// proposal was closed. The tallies hold the voting power cast for each option
+type GovernanceProposal struct {
+	Nonce            uint64            `json:"nonce"`
+	CommitHash       string            `json:"commitHash"`
+	Issuer           string            `json:"issuer"`
+	TxHash           string            `json:"txHash"`
+	StartVoteEpoch   uint64            `json:"startVoteEpoch"`
+	EndVoteEpoch     uint64            `json:"endVoteEpoch"`
+	Status           string            `json:"status"`
+	Yes              string            `json:"yes"`
+	No               string            `json:"no"`
+	Veto             string            `json:"veto"`
+	Abstain          string            `json:"abstain"`
+	NumVotes         uint64            `json:"numVotes"`
+	ParameterChanges map[string]string `json:"parameterChanges,omitempty"`
+	ExecutionEpoch   uint32            `json:"executionEpoch,omitempty"`
+}
+
+// GovernanceVote holds a vote cast on a governance proposal. DelegatedBy holds the address of the delegation
+// contract which cast the vote on behalf of the voter, if any
+type GovernanceVote struct {
+	Voter       string `json:"voter"`
+	Option      string `json:"option"`
+	Stake       string `json:"stake"`
+	VotingPower string `json:"votingPower"`
+	DelegatedBy string `json:"delegatedBy,omitempty"`
+	TxHash      string `json:"txHash"`
+}
+
+// GovernanceProposalVotes holds the votes cast on a governance proposal, split into the direct and the delegated ones
+type GovernanceProposalVotes struct {
+	Votes          []*GovernanceVote `json:"votes"`
+	DelegatedVotes []*GovernanceVote `json:"delegatedVotes"`
+}
+
+// EventsSubscriptionFilter defines what a client subscribed to the node events wants to receive. Transactions holds
+// the hex encoded hashes of the transactions whose status changes are followed
+type EventsSubscriptionFilter struct {
+	Blocks          bool                         `json:"blocks"`
+	FinalizedBlocks bool                         `json:"finalizedBlocks"`
+	RevertedBlocks  bool                         `json:"revertedBlocks"`
+	Transactions    []string                     `json:"transactions"`
+	Events          []*SCEventSubscriptionFilter `json:"events"`
+}
+
+// SCEventSubscriptionFilter selects smart contract events. Empty fields match any value, while the topics are matched
+// positionally, an empty topic matching any value on its position
+type SCEventSubscriptionFilter struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+}
+
+// EventsSubscriptionMessage is a message pushed to a client subscribed to the node events
+type EventsSubscriptionMessage struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// SubscriptionBlock holds the data pushed for new, finalized or reverted blocks
+type SubscriptionBlock struct {
+	Hash      string `json:"hash"`
+	ShardID   uint32 `json:"shardID"`
+	Nonce     uint64 `json:"nonce,omitempty"`
+	Round     uint64 `json:"round,omitempty"`
+	Epoch     uint32 `json:"epoch,omitempty"`
+	TimeStamp uint64 `json:"timestamp,omitempty"`
+	NumTxs    uint32 `json:"numTxs,omitempty"`
+}
+
+// SubscriptionTransactionStatus holds the data pushed when the status of a followed transaction changes
+type SubscriptionTransactionStatus struct {
+	Hash       string `json:"hash"`
+	Status     string `json:"status"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce,omitempty"`
+	ShardID    uint32 `json:"shardID"`
+}
+
+// SubscriptionSCEvent holds the data pushed for a smart contract event matching a subscription filter
+type SubscriptionSCEvent struct {
+	TxHash         string   `json:"txHash"`
+	Address        string   `json:"address"`
+	Identifier     string   `json:"identifier"`
+	Topics         [][]byte `json:"topics"`
+	Data           []byte   `json:"data"`
+	AdditionalData [][]byte `json:"additionalData,omitempty"`
+	BlockHash      string   `json:"blockHash"`
+	BlockNonce     uint64   `json:"blockNonce"`
+	ShardID        uint32   `json:"shardID"`
+}
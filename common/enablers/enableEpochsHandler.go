@@ -780,6 +780,30 @@ func (handler *enableEpochsHandler) createAllFlagsMap() {
 			},
 			activationEpoch: handler.enableEpochsConfig.FixRelayedMoveBalanceToNonPayableSCEnableEpoch,
 		},
+		common.RelayedTransactionsV3Flag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.RelayedTransactionsV3EnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.RelayedTransactionsV3EnableEpoch,
+		},
+		common.GovernanceParameterChangesFlag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.GovernanceParameterChangesEnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.GovernanceParameterChangesEnableEpoch,
+		},
+		common.DelegationLiquidStakingFlag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.DelegationLiquidStakingEnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.DelegationLiquidStakingEnableEpoch,
+		},
+		common.ESDTSupplyPolicyFlag: {
+			isActiveInEpoch: func(epoch uint32) bool {
+				return epoch >= handler.enableEpochsConfig.ESDTSupplyPolicyEnableEpoch
+			},
+			activationEpoch: handler.enableEpochsConfig.ESDTSupplyPolicyEnableEpoch,
+		},
 	}
 }
 
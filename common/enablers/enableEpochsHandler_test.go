@@ -123,6 +123,10 @@ func createEnableEpochsConfig() config.EnableEpochs {
 		MultiESDTNFTTransferAndExecuteByUserEnableEpoch:          106,
 		FixRelayedMoveBalanceToNonPayableSCEnableEpoch:           107,
 		UseGasBoundedShouldFailExecutionEnableEpoch:              108,
+		RelayedTransactionsV3EnableEpoch:                         109,
+		GovernanceParameterChangesEnableEpoch:                    110,
+		DelegationLiquidStakingEnableEpoch:                       111,
+		ESDTSupplyPolicyEnableEpoch:                              112,
 	}
 }
 
@@ -325,6 +329,10 @@ func TestEnableEpochsHandler_IsFlagEnabled(t *testing.T) {
 	require.True(t, handler.IsFlagEnabled(common.DynamicESDTFlag))
 	require.True(t, handler.IsFlagEnabled(common.FixRelayedBaseCostFlag))
 	require.True(t, handler.IsFlagEnabled(common.FixRelayedMoveBalanceToNonPayableSCFlag))
+	require.True(t, handler.IsFlagEnabled(common.RelayedTransactionsV3Flag))
+	require.True(t, handler.IsFlagEnabled(common.GovernanceParameterChangesFlag))
+	require.True(t, handler.IsFlagEnabled(common.DelegationLiquidStakingFlag))
+	require.True(t, handler.IsFlagEnabled(common.ESDTSupplyPolicyFlag))
 }
 
 func TestEnableEpochsHandler_GetActivationEpoch(t *testing.T) {
@@ -448,6 +456,10 @@ func TestEnableEpochsHandler_GetActivationEpoch(t *testing.T) {
 	require.Equal(t, cfg.FixRelayedBaseCostEnableEpoch, handler.GetActivationEpoch(common.FixRelayedBaseCostFlag))
 	require.Equal(t, cfg.MultiESDTNFTTransferAndExecuteByUserEnableEpoch, handler.GetActivationEpoch(common.MultiESDTNFTTransferAndExecuteByUserFlag))
 	require.Equal(t, cfg.FixRelayedMoveBalanceToNonPayableSCEnableEpoch, handler.GetActivationEpoch(common.FixRelayedMoveBalanceToNonPayableSCFlag))
+	require.Equal(t, cfg.RelayedTransactionsV3EnableEpoch, handler.GetActivationEpoch(common.RelayedTransactionsV3Flag))
+	require.Equal(t, cfg.GovernanceParameterChangesEnableEpoch, handler.GetActivationEpoch(common.GovernanceParameterChangesFlag))
+	require.Equal(t, cfg.DelegationLiquidStakingEnableEpoch, handler.GetActivationEpoch(common.DelegationLiquidStakingFlag))
+	require.Equal(t, cfg.ESDTSupplyPolicyEnableEpoch, handler.GetActivationEpoch(common.ESDTSupplyPolicyFlag))
 }
 
 func TestEnableEpochsHandler_IsInterfaceNil(t *testing.T) {
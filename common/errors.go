@@ -10,3 +10,6 @@ var ErrNilWasmChangeLocker = errors.New("nil wasm change locker")
 
 // ErrNilStateSyncNotifierSubscriber signals that a nil state sync notifier subscriber has been provided
 var ErrNilStateSyncNotifierSubscriber = errors.New("nil state sync notifier subscriber")
+
+// ErrInvalidMintAllowance signals that the saved mint allowance could not be decoded
+var ErrInvalidMintAllowance = errors.New("invalid mint allowance")
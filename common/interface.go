@@ -372,3 +372,41 @@ type ExecutionOrderGetter interface {
 	Len() int
 	IsInterfaceNil() bool
 }
+
+// EventsSubscription defines a subscription to the blocks, transactions and smart contract events processed by the node
+type EventsSubscription interface {
+	Messages() <-chan *EventsSubscriptionMessage
+	UpdateFilter(filter EventsSubscriptionFilter) error
+	Close()
+}
+
+// MetricsRegistry defines a component able to register typed and labelled metrics and to export them in the
+// Prometheus text format
+type MetricsRegistry interface {
+	RegisterCounter(name string, help string, labelNames ...string) (CounterMetric, error)
+	RegisterGauge(name string, help string, labelNames ...string) (GaugeMetric, error)
+	RegisterHistogram(name string, help string, buckets []float64, labelNames ...string) (HistogramMetric, error)
+	PrometheusString() (string, error)
+	IsInterfaceNil() bool
+}
+
+// CounterMetric defines a monotonically increasing metric. The label values should be provided in the same order as
+// the label names used at registration
+type CounterMetric interface {
+	Add(value float64, labelValues ...string)
+	IsInterfaceNil() bool
+}
+
+// GaugeMetric defines a metric that can arbitrarily go up and down. The label values should be provided in the same
+// order as the label names used at registration
+type GaugeMetric interface {
+	Set(value float64, labelValues ...string)
+	IsInterfaceNil() bool
+}
+
+// HistogramMetric defines a metric that samples observations in configurable buckets. The label values should be
+// provided in the same order as the label names used at registration
+type HistogramMetric interface {
+	Observe(value float64, labelValues ...string)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"math/big"
+)
+
+// MintAllowanceKeyIdentifier is the identifier used, after the protected key prefix, for the keys holding the local
+// mint allowance of an account for a token with a supply policy
+const MintAllowanceKeyIdentifier = "mintallowance"
+
+// mintAllowanceVersion is saved in front of the allowance, so that a zero allowance is never saved as an empty
+// value, which would delete the key
+const mintAllowanceVersion = byte(1)
+
+// MintAllowance holds the value an account can still mint locally and the max supply of the token
+type MintAllowance struct {
+	MaxSupply *big.Int
+	Value     *big.Int
+}
+
+// EncodeMintAllowance encodes the mint allowance as the version byte, the length prefixed max supply and the value
+func EncodeMintAllowance(allowance *MintAllowance) []byte {
+	maxSupply := allowance.MaxSupply.Bytes()
+	data := make([]byte, 0, 2+len(maxSupply)+len(allowance.Value.Bytes()))
+	data = append(data, mintAllowanceVersion, byte(len(maxSupply)))
+	data = append(data, maxSupply...)
+
+	return append(data, allowance.Value.Bytes()...)
+}
+
+// DecodeMintAllowance decodes a mint allowance saved with EncodeMintAllowance
+func DecodeMintAllowance(data []byte) (*MintAllowance, error) {
+	if len(data) < 2 || data[0] != mintAllowanceVersion {
+		return nil, ErrInvalidMintAllowance
+	}
+	valueStart := 2 + int(data[1])
+	if valueStart > len(data) {
+		return nil, ErrInvalidMintAllowance
+	}
+
+	return &MintAllowance{
+		MaxSupply: big.NewInt(0).SetBytes(data[2:valueStart]),
+		Value:     big.NewInt(0).SetBytes(data[valueStart:]),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeMintAllowance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		allowance, err := DecodeMintAllowance(nil)
+		assert.Nil(t, allowance)
+		assert.Equal(t, ErrInvalidMintAllowance, err)
+
+		allowance, err = DecodeMintAllowance([]byte{2, 0})
+		assert.Nil(t, allowance)
+		assert.Equal(t, ErrInvalidMintAllowance, err)
+
+		allowance, err = DecodeMintAllowance([]byte{mintAllowanceVersion, 5, 1})
+		assert.Nil(t, allowance)
+		assert.Equal(t, ErrInvalidMintAllowance, err)
+	})
+	t.Run("zero value should not encode as empty", func(t *testing.T) {
+		t.Parallel()
+
+		data := EncodeMintAllowance(&MintAllowance{MaxSupply: big.NewInt(1000), Value: big.NewInt(0)})
+		require.NotEmpty(t, data)
+
+		allowance, err := DecodeMintAllowance(data)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000), allowance.MaxSupply)
+		assert.Equal(t, big.NewInt(0), allowance.Value)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		maxSupply, _ := big.NewInt(0).SetString("1000000000000000000000000", 10)
+		data := EncodeMintAllowance(&MintAllowance{MaxSupply: maxSupply, Value: big.NewInt(123456)})
+
+		allowance, err := DecodeMintAllowance(data)
+		require.Nil(t, err)
+		assert.Equal(t, maxSupply, allowance.MaxSupply)
+		assert.Equal(t, big.NewInt(123456), allowance.Value)
+	})
+}
@@ -39,3 +39,22 @@ const MetricP2PNumReceiverPeers = "erd_p2p_num_receiver_peers"
 // MetricP2PPeakNumReceiverPeers represents the peak number of connected peer sent messages to the current peer
 // (and have been received by the current peer) in the amount of time
 const MetricP2PPeakNumReceiverPeers = "erd_p2p_peak_num_receiver_peers"
+
+// MetricP2PPeerMaxNumMessages represents the maximum number of messages currently accepted from a connected peer in the
+// amount of time. The value can be adjusted by the adaptive antiflood mode
+const MetricP2PPeerMaxNumMessages = "erd_p2p_peer_max_num_messages"
+
+// MetricP2PPeerMaxSizeMessages represents the maximum size of data (sum of all messages) currently accepted from a
+// connected peer in the amount of time. The value can be adjusted by the adaptive antiflood mode
+const MetricP2PPeerMaxSizeMessages = "erd_p2p_peer_max_size_messages"
+
+// MetricP2PTopicMaxNumMessages represents the maximum number of messages per second currently accepted from a
+// connected peer on a topic. The value can be adjusted by the adaptive antiflood mode
+const MetricP2PTopicMaxNumMessages = "erd_p2p_topic_max_num_messages"
+
+// MetricP2PNodeLoadPercent represents the node load as observed by the adaptive antiflood mode
+const MetricP2PNodeLoadPercent = "erd_p2p_node_load_percent"
+
+// MetricP2PQuotaPercent represents the percent of the configured antiflood quotas currently applied by the adaptive
+// antiflood mode
+const MetricP2PQuotaPercent = "erd_p2p_quota_percent"
@@ -0,0 +1,13 @@
+package common
+
+import "github.com/multiversx/mx-chain-core-go/data"
+
+// IsRelayedTxV3 returns true if the provided transaction carries a native relayer address
+func IsRelayedTxV3(tx data.TransactionHandler) bool {
+	relayedTx, ok := tx.(data.RelayedTransactionHandler)
+	if !ok {
+		return false
+	}
+
+	return len(relayedTx.GetRelayerAddr()) != 0
+}
@@ -0,0 +1,36 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should return false", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, common.IsRelayedTxV3(nil))
+	})
+	t.Run("transaction without relayer should return false", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, common.IsRelayedTxV3(&transaction.Transaction{}))
+		assert.False(t, common.IsRelayedTxV3(&smartContractResult.SmartContractResult{}))
+	})
+	t.Run("empty relayer address should return false", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, common.IsRelayedTxV3(&transaction.Transaction{RelayerAddr: []byte{}}))
+	})
+	t.Run("relayer address set should return true", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, common.IsRelayedTxV3(&transaction.Transaction{RelayerAddr: []byte("relayer")}))
+	})
+}
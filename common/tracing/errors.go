@@ -0,0 +1,15 @@
+package tracing
+
+import "errors"
+
+// ErrEmptyCollectorEndpoint signals that an empty collector endpoint was provided
+var ErrEmptyCollectorEndpoint = errors.New("empty collector endpoint")
+
+// ErrEmptyServiceName signals that an empty service name was provided
+var ErrEmptyServiceName = errors.New("empty service name")
+
+// ErrInvalidSamplingRatio signals that a sampling ratio outside the [0, 1] interval was provided
+var ErrInvalidSamplingRatio = errors.New("invalid sampling ratio")
+
+// ErrInvalidBatchingValue signals that an invalid spans batching value was provided
+var ErrInvalidBatchingValue = errors.New("invalid spans batching value")
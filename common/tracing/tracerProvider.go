@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const closeTimeout = 5 * time.Second
+
+// ArgsTracerProvider holds the arguments needed to create a new tracer provider
+type ArgsTracerProvider struct {
+	Config  config.TracingConfig
+	Version string
+}
+
+type tracerProvider struct {
+	provider *sdktrace.TracerProvider
+}
+
+// NewTracerProvider creates a tracer provider which batches the recorded spans and exports them to the configured
+// OTLP/gRPC collector. The created provider is set as the global one, together with the W3C trace context propagator,
+// so the spans started through StartSpan are recorded from now on
+func NewTracerProvider(args ArgsTracerProvider) (*tracerProvider, error) {
+	err := checkConfig(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	exporterOptions := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(args.Config.CollectorEndpoint),
+		otlptracegrpc.WithTimeout(time.Duration(args.Config.RequestTimeoutInSec) * time.Second),
+	}
+	if args.Config.InsecureConnection {
+		exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+	}
+
+	// the connection to the collector is established in the background, so a collector which is not yet reachable
+	// does not prevent the node from starting
+	exporter, err := otlptracegrpc.New(context.Background(), exporterOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceResource := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(args.Config.ServiceName),
+		semconv.ServiceVersion(args.Version),
+	)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(
+			exporter,
+			sdktrace.WithBatchTimeout(time.Duration(args.Config.BatchTimeoutInMilliseconds)*time.Millisecond),
+			sdktrace.WithMaxExportBatchSize(args.Config.MaxExportBatchSize),
+			sdktrace.WithMaxQueueSize(args.Config.MaxQueueSize),
+		),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(args.Config.SamplingRatio))),
+		sdktrace.WithResource(serviceResource),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return &tracerProvider{
+		provider: provider,
+	}, nil
+}
+
+func checkConfig(cfg config.TracingConfig) error {
+	if len(cfg.CollectorEndpoint) == 0 {
+		return ErrEmptyCollectorEndpoint
+	}
+	if len(cfg.ServiceName) == 0 {
+		return ErrEmptyServiceName
+	}
+	if cfg.SamplingRatio < 0 || cfg.SamplingRatio > 1 {
+		return ErrInvalidSamplingRatio
+	}
+	if cfg.BatchTimeoutInMilliseconds == 0 {
+		return fmt.Errorf("%w for BatchTimeoutInMilliseconds", ErrInvalidBatchingValue)
+	}
+	if cfg.MaxExportBatchSize <= 0 {
+		return fmt.Errorf("%w for MaxExportBatchSize", ErrInvalidBatchingValue)
+	}
+	if cfg.MaxQueueSize < cfg.MaxExportBatchSize {
+		return fmt.Errorf("%w for MaxQueueSize, it should not be lower than MaxExportBatchSize", ErrInvalidBatchingValue)
+	}
+
+	return nil
+}
+
+// Close exports the remaining spans and stops the tracer provider. The global tracer provider is reset, so no
+// span is recorded afterwards
+func (tp *tracerProvider) Close() error {
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	return tp.provider.Shutdown(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *tracerProvider) IsInterfaceNil() bool {
+	return tp == nil
+}
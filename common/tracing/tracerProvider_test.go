@@ -0,0 +1,177 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+type collectorStub struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	mut      sync.Mutex
+	requests []*coltracepb.ExportTraceServiceRequest
+}
+
+// Export -
+func (cs *collectorStub) Export(_ context.Context, request *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	cs.mut.Lock()
+	cs.requests = append(cs.requests, request)
+	cs.mut.Unlock()
+
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func (cs *collectorStub) getSpans() []*tracepb.Span {
+	cs.mut.Lock()
+	defer cs.mut.Unlock()
+
+	spans := make([]*tracepb.Span, 0)
+	for _, request := range cs.requests {
+		for _, resSpans := range request.ResourceSpans {
+			for _, scSpans := range resSpans.ScopeSpans {
+				spans = append(spans, scSpans.Spans...)
+			}
+		}
+	}
+
+	return spans
+}
+
+func startCollectorStub(t *testing.T) (*collectorStub, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	collector := &collectorStub{}
+	server := grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(server, collector)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return collector, listener.Addr().String()
+}
+
+func createTracingConfig(collectorEndpoint string) config.TracingConfig {
+	return config.TracingConfig{
+		Enabled:                    true,
+		CollectorEndpoint:          collectorEndpoint,
+		InsecureConnection:         true,
+		ServiceName:                "mx-chain-node",
+		SamplingRatio:              1,
+		BatchTimeoutInMilliseconds: 1000,
+		MaxExportBatchSize:         10,
+		MaxQueueSize:               100,
+		RequestTimeoutInSec:        1,
+	}
+}
+
+func TestNewTracerProvider_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		modify        func(cfg *config.TracingConfig)
+		expectedError error
+	}{
+		{"empty collector endpoint", func(cfg *config.TracingConfig) { cfg.CollectorEndpoint = "" }, ErrEmptyCollectorEndpoint},
+		{"empty service name", func(cfg *config.TracingConfig) { cfg.ServiceName = "" }, ErrEmptyServiceName},
+		{"negative sampling ratio", func(cfg *config.TracingConfig) { cfg.SamplingRatio = -0.1 }, ErrInvalidSamplingRatio},
+		{"sampling ratio above 1", func(cfg *config.TracingConfig) { cfg.SamplingRatio = 1.1 }, ErrInvalidSamplingRatio},
+		{"zero batch timeout", func(cfg *config.TracingConfig) { cfg.BatchTimeoutInMilliseconds = 0 }, ErrInvalidBatchingValue},
+		{"zero batch size", func(cfg *config.TracingConfig) { cfg.MaxExportBatchSize = 0 }, ErrInvalidBatchingValue},
+		{"queue lower than batch", func(cfg *config.TracingConfig) { cfg.MaxQueueSize = 5 }, ErrInvalidBatchingValue},
+	}
+
+	for _, tt := range tests {
+		cfg := createTracingConfig("localhost:4317")
+		tt.modify(&cfg)
+
+		tp, err := NewTracerProvider(ArgsTracerProvider{Config: cfg})
+		require.True(t, errors.Is(err, tt.expectedError), tt.name)
+		require.True(t, check.IfNil(tp), tt.name)
+	}
+}
+
+func TestTracerProvider_ShouldExportTheRecordedSpans(t *testing.T) {
+	// not parallel, as the tracer provider is set as the global one
+	collector, collectorEndpoint := startCollectorStub(t)
+
+	tp, err := NewTracerProvider(ArgsTracerProvider{
+		Config:  createTracingConfig(collectorEndpoint),
+		Version: "v1.0.0",
+	})
+	require.Nil(t, err)
+	require.False(t, check.IfNil(tp))
+
+	// a remote parent, received through the W3C traceparent header, should be continued
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	ctx, parent := StartSpan(ctx, "facade.SendBulkTransactions", attribute.Int("numTxs", 2))
+	_, child := StartSpan(ctx, "node.SendBulkTransactions")
+	child.AddEvent("added to the send pipe")
+	EndSpan(child, errors.New("expected error"))
+	EndSpan(parent, nil)
+
+	err = tp.Close()
+	require.Nil(t, err)
+
+	// the spans started after closing are no longer recorded
+	_, span := StartSpan(context.Background(), "not recorded")
+	require.False(t, span.IsRecording())
+	span.End()
+
+	spans := collector.getSpans()
+	require.Len(t, spans, 2)
+	exportedChild, exportedParent := spans[0], spans[1]
+
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hex.EncodeToString(exportedParent.TraceId))
+	require.Equal(t, "00f067aa0ba902b7", hex.EncodeToString(exportedParent.ParentSpanId))
+	require.Equal(t, "facade.SendBulkTransactions", exportedParent.Name)
+	require.Equal(t, tracepb.Span_SPAN_KIND_INTERNAL, exportedParent.Kind)
+	require.Equal(t, tracepb.Status_STATUS_CODE_UNSET, exportedParent.Status.GetCode())
+	require.Equal(t, "numTxs", exportedParent.Attributes[0].Key)
+	require.Equal(t, int64(2), exportedParent.Attributes[0].Value.GetIntValue())
+
+	require.Equal(t, exportedParent.TraceId, exportedChild.TraceId)
+	require.Equal(t, exportedParent.SpanId, exportedChild.ParentSpanId)
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, exportedChild.Status.GetCode())
+	require.Equal(t, "expected error", exportedChild.Status.GetMessage())
+	require.Len(t, exportedChild.Events, 2)
+	require.Equal(t, "added to the send pipe", exportedChild.Events[0].Name)
+	require.Equal(t, "exception", exportedChild.Events[1].Name)
+
+	collector.mut.Lock()
+	resourceAttributes := collector.requests[0].ResourceSpans[0].Resource.Attributes
+	scope := collector.requests[0].ResourceSpans[0].ScopeSpans[0].Scope
+	collector.mut.Unlock()
+	require.Equal(t, "service.name", resourceAttributes[0].Key)
+	require.Equal(t, "mx-chain-node", resourceAttributes[0].Value.GetStringValue())
+	require.Equal(t, tracerName, scope.Name)
+}
+
+func TestStartSpan_NilContextShouldWork(t *testing.T) {
+	t.Parallel()
+
+	//nolint
+	ctx, span := StartSpan(nil, "span")
+	require.NotNil(t, ctx)
+	EndSpan(span, nil)
+}
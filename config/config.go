@@ -138,6 +138,18 @@ type HeartbeatV2Config struct {
 	TimeBetweenConnectionsMetricsUpdateInSec         int64
 	TimeToReadDirectConnectionsInSec                 int64
 	PeerAuthenticationTimeBetweenChecksInSec         int64
+	History                                          HeartbeatHistoryConfig
+}
+
+// HeartbeatHistoryConfig will hold the configuration for the heartbeat history kept by the monitor
+type HeartbeatHistoryConfig struct {
+	Enabled                     bool
+	TimeBetweenSnapshotsInSec   int64
+	RetentionPeriodInSec        int64
+	MaxOnlineIntervalsPerPubKey uint32
+	MaxEventsPerPubKey          uint32
+	MaxEpochsPerPubKey          uint32
+	Storage                     StorageConfig
 }
 
 // Config will hold the entire application configuration parameters
@@ -228,6 +240,41 @@ type Config struct {
 	PeersRatingConfig   PeersRatingConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 	Redundancy          RedundancyConfig
+	PeerReputation      PeerReputationConfig
+	GuardianCoSigner    GuardianCoSignerConfig
+}
+
+// GuardianCoSignerConfig will hold the settings of the optional guardian co-signing service
+type GuardianCoSignerConfig struct {
+	Enabled                           bool
+	ServiceUID                        string
+	GuardianKeyPemFile                string
+	OTPIssuer                         string
+	OTPPeriodInSeconds                uint32
+	OTPAllowedSkewPeriods             uint32
+	MaxFailedCodeAttempts             uint32
+	FailedCodeAttemptsLockInSecs      uint32
+	RegistrationChallengeExpiryInSecs uint32
+	MaxPendingRegistrationChallenges  uint32
+	Storage                           StorageConfig
+}
+
+// PeerReputationConfig will hold settings related to the persisted peers reputation and the operator-managed access lists
+type PeerReputationConfig struct {
+	Enabled                  bool
+	PersistIntervalInSeconds uint32
+	MaxNumEntries            uint32
+	MaxNumReasonsPerPeer     uint32
+	Storage                  StorageConfig
+	DenyList                 PeerAccessListConfig
+	AllowList                PeerAccessListConfig
+}
+
+// PeerAccessListConfig will hold the peer IDs, IP addresses (or CIDR ranges) and BLS public keys of a static access list
+type PeerAccessListConfig struct {
+	PeerIDs    []string
+	IPs        []string
+	PublicKeys []string
 }
 
 // PeersRatingConfig will hold settings related to peers rating
@@ -262,7 +309,8 @@ type ResourceStatsConfig struct {
 
 // ValidatorStatisticsConfig will hold validator statistics specific settings
 type ValidatorStatisticsConfig struct {
-	CacheRefreshIntervalInSec uint32
+	CacheRefreshIntervalInSec     uint32
+	PerformanceReportMaxNumEpochs uint32
 }
 
 // MaxNodesChangeConfig defines a config change tuple, with a maximum number enabled in a certain epoch number
@@ -294,7 +342,19 @@ type GeneralSettingsConfig struct {
 
 // HardwareRequirementsConfig will hold the hardware requirements config
 type HardwareRequirementsConfig struct {
-	CPUFlags []string
+	CPUFlags  []string
+	Validator HardwareThresholdsConfig
+	Archive   HardwareThresholdsConfig
+}
+
+// HardwareThresholdsConfig will hold the minimum throughputs, as measured by the assessment tool, recommended for a node type
+type HardwareThresholdsConfig struct {
+	MinDiskSequentialWritesPerSecond  uint64
+	MinDiskRandomWritesPerSecond      uint64
+	MinDiskRandomReadsPerSecond       uint64
+	MinTrieCommittedAccountsPerSecond uint64
+	MinEd25519VerificationsPerSecond  uint64
+	MinBLSVerificationsPerSecond      uint64
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the node facade
@@ -329,14 +389,16 @@ type EndpointsThrottlersConfig struct {
 
 // WebServerAntifloodConfig will hold the anti-flooding parameters for the web server
 type WebServerAntifloodConfig struct {
-	WebServerAntifloodEnabled          bool
-	SimultaneousRequests               uint32
-	SameSourceRequests                 uint32
-	SameSourceResetIntervalInSec       uint32
-	TrieOperationsDeadlineMilliseconds uint32
-	GetAddressesBulkMaxSize            uint32
-	VmQueryDelayAfterStartInSec        uint32
-	EndpointsThrottlers                []EndpointsThrottlersConfig
+	WebServerAntifloodEnabled           bool
+	SimultaneousRequests                uint32
+	SameSourceRequests                  uint32
+	SameSourceResetIntervalInSec        uint32
+	TrieOperationsDeadlineMilliseconds  uint32
+	GetAddressesBulkMaxSize             uint32
+	SimulateTransactionsSequenceMaxSize uint32
+	JSONRPCMaxBatchSize                 uint32
+	VmQueryDelayAfterStartInSec         uint32
+	EndpointsThrottlers                 []EndpointsThrottlersConfig
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -377,6 +439,28 @@ type AntifloodConfig struct {
 	Cache                               CacheConfig
 	Topic                               TopicAntifloodConfig
 	TxAccumulator                       TxAccumulatorConfig
+	Adaptive                            AdaptiveAntifloodConfig
+}
+
+// AdaptiveAntifloodConfig will hold the parameters used to adjust the antiflood quotas based on the observed node load
+type AdaptiveAntifloodConfig struct {
+	Enabled                  bool
+	IntervalInSeconds        uint32
+	HighLoadThresholdPercent uint32
+	LowLoadThresholdPercent  uint32
+	DecreaseStepPercent      uint32
+	IncreaseStepPercent      uint32
+	MinQuotaPercent          uint32
+	MaxNumGoRoutines         uint32
+	TopicOverrides           []AdaptiveTopicOverrideConfig
+}
+
+// AdaptiveTopicOverrideConfig will hold the adaptive antiflood parameters specific to a topic
+type AdaptiveTopicOverrideConfig struct {
+	Topic                     string
+	MinMessagesPerSec         uint32
+	MaxMessagesPerSec         uint32
+	ThroughputThresholdPerSec uint64
 }
 
 // FloodPreventerConfig will hold all flood preventer parameters
@@ -478,6 +562,7 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	GovernanceIndexStorageConfig       StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -487,6 +572,17 @@ type DebugConfig struct {
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
 	Process             ProcessDebugConfig
+	P2PMessageTracing   P2PMessageTracingDebugConfig
+}
+
+// P2PMessageTracingDebugConfig will hold the p2p message tracing configuration
+type P2PMessageTracingDebugConfig struct {
+	Enabled          bool
+	SamplingRate     uint32
+	Topics           []string
+	CacheSize        int
+	StreamBufferSize int
+	DumpFilePath     string
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -541,8 +637,49 @@ type ProcessDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging        ApiLoggingConfig
+	ClientIP       ApiClientIPConfig
+	Authentication ApiAuthenticationConfig
+	APIPackages    map[string]APIPackageConfig
+}
+
+// ApiClientIPConfig holds the configuration related to the client IP extraction when running behind reverse proxies
+type ApiClientIPConfig struct {
+	TrustedProxies []string
+}
+
+// ApiAuthenticationConfig holds the configuration related to the API clients authentication
+type ApiAuthenticationConfig struct {
+	Enabled                 bool
+	AllowAnonymous          bool
+	QuotaResetIntervalInSec uint32
+	APIKeys                 []ApiKeyConfig
+	JWT                     ApiJWTConfig
+	RestrictedRoutes        []ApiRestrictedRouteConfig
+}
+
+// ApiKeyConfig holds the configuration of an API key
+type ApiKeyConfig struct {
+	Name        string
+	KeyHash     string
+	Role        string
+	MaxRequests uint32
+}
+
+// ApiJWTConfig holds the configuration related to the HS256 signed JSON web tokens
+type ApiJWTConfig struct {
+	Enabled     bool
+	Secret      string
+	Issuer      string
+	Audience    string
+	RoleClaim   string
+	MaxRequests uint32
+}
+
+// ApiRestrictedRouteConfig holds the roles allowed to access the routes starting with the provided path
+type ApiRestrictedRouteConfig struct {
+	Path  string
+	Roles []string
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
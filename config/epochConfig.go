@@ -122,6 +122,10 @@ type EnableEpochs struct {
 	FixRelayedBaseCostEnableEpoch                            uint32
 	MultiESDTNFTTransferAndExecuteByUserEnableEpoch          uint32
 	FixRelayedMoveBalanceToNonPayableSCEnableEpoch           uint32
+	RelayedTransactionsV3EnableEpoch                         uint32
+	GovernanceParameterChangesEnableEpoch                    uint32
+	DelegationLiquidStakingEnableEpoch                       uint32
+	ESDTSupplyPolicyEnableEpoch                              uint32
 	BLSMultiSignerEnableEpoch                                []MultiSignerConfig
 }
 
@@ -5,6 +5,8 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	EventsSubscriptions    EventsSubscriptionsConfig
+	Tracing                TracingConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -30,6 +32,33 @@ type EventNotifierConfig struct {
 	MarshallerType    string
 }
 
+// EventsSubscriptionsConfig will hold the configuration for the API subscriptions to blocks, transactions and events
+type EventsSubscriptionsConfig struct {
+	Enabled                        bool
+	MaxSubscribers                 int
+	SubscriberBufferSize           int
+	MaxTransactionsPerSubscription int
+	MaxEventFiltersPerSubscription int
+	MaxMessageSizeInBytes          int64
+	PingIntervalInSec              int
+	PongTimeoutInSec               int
+	WriteTimeoutInSec              int
+	AllowedOrigins                 []string
+}
+
+// TracingConfig will hold the configuration for the OpenTelemetry tracing of the API requests
+type TracingConfig struct {
+	Enabled                    bool
+	CollectorEndpoint          string
+	InsecureConnection         bool
+	ServiceName                string
+	SamplingRatio              float64
+	BatchTimeoutInMilliseconds uint32
+	MaxExportBatchSize         int
+	MaxQueueSize               int
+	RequestTimeoutInSec        uint32
+}
+
 // CovalentConfig will hold the configurations for covalent indexer
 type CovalentConfig struct {
 	Enabled              bool
@@ -47,11 +47,12 @@ type GovernanceSystemSCConfigV1 struct {
 // GovernanceSystemSCConfigActive defines the set of configuration values used by the governance
 // system smart contract once it activates
 type GovernanceSystemSCConfigActive struct {
-	ProposalCost     string
-	LostProposalFee  string
-	MinQuorum        float64
-	MinPassThreshold float64
-	MinVetoThreshold float64
+	ProposalCost                 string
+	LostProposalFee              string
+	MinQuorum                    float64
+	MinPassThreshold             float64
+	MinVetoThreshold             float64
+	ParameterChangeDelayInEpochs uint32
 }
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
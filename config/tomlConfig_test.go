@@ -884,6 +884,18 @@ func TestEnableEpochConfig(t *testing.T) {
 	# FixRelayedMoveBalanceToNonPayableSCEnableEpoch represents the epoch when the fix for relayed move balance to non payable sc will be enabled
     FixRelayedMoveBalanceToNonPayableSCEnableEpoch = 102
 
+    # RelayedTransactionsV3EnableEpoch represents the epoch when the relayed transactions v3 will be enabled
+    RelayedTransactionsV3EnableEpoch = 103
+
+    # GovernanceParameterChangesEnableEpoch represents the epoch when governance proposals carrying protocol parameter changes will be enabled
+    GovernanceParameterChangesEnableEpoch = 104
+
+    # DelegationLiquidStakingEnableEpoch represents the epoch when delegation contracts can opt in for a liquid staking token
+    DelegationLiquidStakingEnableEpoch = 105
+
+    # ESDTSupplyPolicyEnableEpoch represents the epoch when token owners can commit to a max supply and a mint schedule
+    ESDTSupplyPolicyEnableEpoch = 106
+
     # MaxNodesChangeEnableEpoch holds configuration for changing the maximum number of nodes and the enabling epoch
     MaxNodesChangeEnableEpoch = [
         { EpochEnable = 44, MaxNumNodes = 2169, NodesToShufflePerShard = 80 },
@@ -1004,6 +1016,10 @@ func TestEnableEpochConfig(t *testing.T) {
 			FixRelayedBaseCostEnableEpoch:                            100,
 			MultiESDTNFTTransferAndExecuteByUserEnableEpoch:          101,
 			FixRelayedMoveBalanceToNonPayableSCEnableEpoch:           102,
+			RelayedTransactionsV3EnableEpoch:                         103,
+			GovernanceParameterChangesEnableEpoch:                    104,
+			DelegationLiquidStakingEnableEpoch:                       105,
+			ESDTSupplyPolicyEnableEpoch:                              106,
 			MaxNodesChangeEnableEpoch: []MaxNodesChangeConfig{
 				{
 					EpochEnable:            44,
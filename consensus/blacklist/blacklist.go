@@ -6,6 +6,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -16,12 +17,14 @@ var durationSweepP2PBlacklist = time.Second * 5
 
 // PeerBlackListArgs defines the arguments needed for peer blacklist component
 type PeerBlackListArgs struct {
-	PeerCacher spos.PeerBlackListCacher
+	PeerCacher            spos.PeerBlackListCacher
+	PeerReputationHandler spos.PeerReputationHandler
 }
 
 type peerBlacklist struct {
-	peerCacher spos.PeerBlackListCacher
-	cancel     func()
+	peerCacher            spos.PeerBlackListCacher
+	peerReputationHandler spos.PeerReputationHandler
+	cancel                func()
 }
 
 // NewPeerBlacklist creates a new instance of peer blacklist
@@ -32,7 +35,8 @@ func NewPeerBlacklist(args PeerBlackListArgs) (*peerBlacklist, error) {
 	}
 
 	pb := &peerBlacklist{
-		peerCacher: args.PeerCacher,
+		peerCacher:            args.PeerCacher,
+		peerReputationHandler: args.PeerReputationHandler,
 	}
 
 	pb.startSweepingTimeCache()
@@ -44,17 +48,32 @@ func checkArgs(args PeerBlackListArgs) error {
 	if check.IfNil(args.PeerCacher) {
 		return spos.ErrNilPeerBlacklistCacher
 	}
+	if check.IfNil(args.PeerReputationHandler) {
+		return spos.ErrNilPeerReputationHandler
+	}
 
 	return nil
 }
 
-// IsPeerBlacklisted will check if specified peer is blacklisted
+// IsPeerBlacklisted will check if specified peer is blacklisted. A peer found in the operator-managed allow list
+// is never blacklisted while a peer found in the deny list is always blacklisted
 func (pb *peerBlacklist) IsPeerBlacklisted(peer core.PeerID) bool {
-	return pb.peerCacher.Has(peer)
+	if pb.peerReputationHandler.IsPeerIDAllowed(peer) {
+		return false
+	}
+
+	return pb.peerCacher.Has(peer) || pb.peerReputationHandler.IsPeerIDDenied(peer)
 }
 
 // BlacklistPeer will blacklist a peer for a certain amount of time
 func (pb *peerBlacklist) BlacklistPeer(peer core.PeerID, duration time.Duration) {
+	if pb.peerReputationHandler.IsPeerIDAllowed(peer) {
+		log.Debug("peer is in the allow list, will not blacklist",
+			"pid", peer.Pretty(),
+		)
+		return
+	}
+
 	peerIsBlacklisted := pb.peerCacher.Has(peer)
 
 	err := pb.peerCacher.Upsert(peer, duration)
@@ -73,6 +92,14 @@ func (pb *peerBlacklist) BlacklistPeer(peer core.PeerID, duration time.Duration)
 			"time", duration,
 		)
 	}
+
+	pb.peerReputationHandler.RecordPeerIDEvent(
+		peer,
+		common.PeerReputationSourceConsensus,
+		"invalid consensus message signature",
+		common.PeerReputationBanPenalty,
+		duration,
+	)
 }
 
 // startSweepingTimeCache will trigger the sweeping cache goroutine
@@ -6,15 +6,18 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus/blacklist"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
 
 func createMockPeerBlacklistArgs() blacklist.PeerBlackListArgs {
 	return blacklist.PeerBlackListArgs{
-		PeerCacher: &mock.PeerBlackListCacherStub{},
+		PeerCacher:            &mock.PeerBlackListCacherStub{},
+		PeerReputationHandler: &testscommon.PeerReputationHandlerStub{},
 	}
 }
 
@@ -32,6 +35,17 @@ func TestNewPeerBlacklist(t *testing.T) {
 		require.Equal(t, spos.ErrNilPeerBlacklistCacher, err)
 	})
 
+	t.Run("nil peer reputation handler, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPeerBlacklistArgs()
+		args.PeerReputationHandler = nil
+
+		pb, err := blacklist.NewPeerBlacklist(args)
+		require.Nil(t, pb)
+		require.Equal(t, spos.ErrNilPeerReputationHandler, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -91,6 +105,56 @@ func TestBlacklistPeer(t *testing.T) {
 	require.True(t, upsertWasCalled)
 }
 
+func TestBlacklistPeer_ShouldRecordReputationEvent(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerBlacklistArgs()
+
+	expPeer, _ := core.NewPeerID("peerID")
+	duration := 1 * time.Second
+
+	recordWasCalled := false
+	args.PeerReputationHandler = &testscommon.PeerReputationHandlerStub{
+		RecordPeerIDEventCalled: func(pid core.PeerID, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+			require.Equal(t, expPeer, pid)
+			require.Equal(t, common.PeerReputationSourceConsensus, source)
+			require.Equal(t, duration, banDuration)
+			recordWasCalled = true
+		},
+	}
+
+	pb, err := blacklist.NewPeerBlacklist(args)
+	require.Nil(t, err)
+
+	pb.BlacklistPeer(expPeer, duration)
+	require.True(t, recordWasCalled)
+}
+
+func TestBlacklistPeer_AllowedPeerShouldNotBlacklist(t *testing.T) {
+	t.Parallel()
+
+	args := createMockPeerBlacklistArgs()
+
+	expPeer, _ := core.NewPeerID("peerID")
+
+	args.PeerCacher = &mock.PeerBlackListCacherStub{
+		UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			require.Fail(t, "should have not called Upsert")
+			return nil
+		},
+	}
+	args.PeerReputationHandler = &testscommon.PeerReputationHandlerStub{
+		IsPeerIDAllowedCalled: func(pid core.PeerID) bool {
+			return pid == expPeer
+		},
+	}
+
+	pb, err := blacklist.NewPeerBlacklist(args)
+	require.Nil(t, err)
+
+	pb.BlacklistPeer(expPeer, time.Second)
+}
+
 func TestIsPeerBlacklisted(t *testing.T) {
 	t.Parallel()
 
@@ -114,3 +178,41 @@ func TestIsPeerBlacklisted(t *testing.T) {
 	require.True(t, isBlacklisted)
 	require.True(t, hasWasCalled)
 }
+
+func TestIsPeerBlacklisted_PeerReputation(t *testing.T) {
+	t.Parallel()
+
+	expPeer, _ := core.NewPeerID("peerID")
+
+	t.Run("denied peer should be blacklisted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPeerBlacklistArgs()
+		args.PeerReputationHandler = &testscommon.PeerReputationHandlerStub{
+			IsPeerIDDeniedCalled: func(pid core.PeerID) bool {
+				return pid == expPeer
+			},
+		}
+
+		pb, _ := blacklist.NewPeerBlacklist(args)
+		require.True(t, pb.IsPeerBlacklisted(expPeer))
+	})
+	t.Run("allowed peer should not be blacklisted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPeerBlacklistArgs()
+		args.PeerCacher = &mock.PeerBlackListCacherStub{
+			HasCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		}
+		args.PeerReputationHandler = &testscommon.PeerReputationHandlerStub{
+			IsPeerIDAllowedCalled: func(pid core.PeerID) bool {
+				return pid == expPeer
+			},
+		}
+
+		pb, _ := blacklist.NewPeerBlacklist(args)
+		require.False(t, pb.IsPeerBlacklisted(expPeer))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/ntp"
 )
@@ -15,4 +16,5 @@ type ArgChronology struct {
 	SyncTimer        ntp.SyncTimer
 	Watchdog         core.WatchdogTimer
 	AppStatusHandler core.AppStatusHandler
+	MetricsRegistry  common.MetricsRegistry
 }
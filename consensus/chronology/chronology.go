@@ -27,6 +27,12 @@ const srBeforeStartRound = -1
 const numRoundsToWaitBeforeSignalingChronologyStuck = 10
 const chronologyAlarmID = "chronology"
 
+const (
+	subroundDurationMetric = "erd_consensus_subround_duration_seconds"
+	subroundCompleted      = "completed"
+	subroundNotCompleted   = "not_completed"
+)
+
 // chronology defines the data needed by the chronology
 type chronology struct {
 	genesisTime time.Time
@@ -40,6 +46,7 @@ type chronology struct {
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	subroundDuration common.HistogramMetric
 	cancelFunc       func()
 
 	watchdog core.WatchdogTimer
@@ -53,11 +60,22 @@ func NewChronology(arg ArgChronology) (*chronology, error) {
 		return nil, err
 	}
 
+	subroundDuration, err := arg.MetricsRegistry.RegisterHistogram(
+		subroundDurationMetric,
+		"Time spent in each consensus subround, by subround name and by the completion of the subround job",
+		nil,
+		"subround", "result",
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	chr := chronology{
 		genesisTime:      arg.GenesisTime,
 		roundHandler:     arg.RoundHandler,
 		syncTimer:        arg.SyncTimer,
 		appStatusHandler: arg.AppStatusHandler,
+		subroundDuration: subroundDuration,
 		watchdog:         arg.Watchdog,
 	}
 
@@ -83,6 +101,9 @@ func checkNewChronologyParams(arg ArgChronology) error {
 	if check.IfNil(arg.AppStatusHandler) {
 		return ErrNilAppStatusHandler
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return ErrNilMetricsRegistry
+	}
 
 	return nil
 }
@@ -149,11 +170,14 @@ func (chr *chronology) startRound(ctx context.Context) {
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 	logger.SetCorrelationSubround(sr.Name())
 
+	startTime := time.Now()
 	if !sr.DoWork(ctx, chr.roundHandler) {
+		chr.subroundDuration.Observe(time.Since(startTime).Seconds(), sr.Name(), subroundNotCompleted)
 		chr.subroundId = srBeforeStartRound
 		return
 	}
 
+	chr.subroundDuration.Observe(time.Since(startTime).Seconds(), sr.Name(), subroundCompleted)
 	chr.subroundId = sr.Next()
 }
 
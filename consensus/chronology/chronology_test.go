@@ -1,13 +1,16 @@
 package chronology_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -73,6 +76,33 @@ func TestChronology_NewChronologyNilAppStatusHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, err, chronology.ErrNilAppStatusHandler)
 }
 
+func TestChronology_NewChronologyNilMetricsRegistryShouldFail(t *testing.T) {
+	t.Parallel()
+
+	arg := getDefaultChronologyArg()
+	arg.MetricsRegistry = nil
+	chr, err := chronology.NewChronology(arg)
+
+	assert.Nil(t, chr)
+	assert.Equal(t, err, chronology.ErrNilMetricsRegistry)
+}
+
+func TestChronology_NewChronologyMetricRegistrationFailsShouldFail(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := getDefaultChronologyArg()
+	arg.MetricsRegistry = &testscommon.MetricsRegistryStub{
+		RegisterHistogramCalled: func(name string, help string, buckets []float64, labelNames ...string) (common.HistogramMetric, error) {
+			return nil, expectedErr
+		},
+	}
+	chr, err := chronology.NewChronology(arg)
+
+	assert.Nil(t, chr)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestChronology_NewChronologyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -182,6 +212,43 @@ func TestChronology_StartRoundShouldWork(t *testing.T) {
 	assert.Equal(t, srm.Next(), chr.SubroundId())
 }
 
+func TestChronology_StartRoundShouldObserveTheSubroundDuration(t *testing.T) {
+	t.Parallel()
+
+	var observedLabels [][]string
+	arg := getDefaultChronologyArg()
+	arg.MetricsRegistry = &testscommon.MetricsRegistryStub{
+		RegisterHistogramCalled: func(name string, help string, buckets []float64, labelNames ...string) (common.HistogramMetric, error) {
+			return &testscommon.MetricStub{
+				ObserveCalled: func(value float64, labelValues ...string) {
+					observedLabels = append(observedLabels, labelValues)
+				},
+			}, nil
+		},
+	}
+	roundHandlerMock := &mock.RoundHandlerMock{}
+	roundHandlerMock.UpdateRound(roundHandlerMock.TimeStamp(), roundHandlerMock.TimeStamp().Add(roundHandlerMock.TimeDuration()))
+	arg.RoundHandler = roundHandlerMock
+	chr, _ := chronology.NewChronology(arg)
+
+	srm := initSubroundHandlerMock()
+	chr.AddSubround(srm)
+	chr.SetSubroundId(0)
+	chr.StartRound()
+
+	srm.DoWorkCalled = func(roundHandler consensus.RoundHandler) bool {
+		return true
+	}
+	chr.SetSubroundId(0)
+	chr.StartRound()
+
+	expectedLabels := [][]string{
+		{"(TEST)", "not_completed"},
+		{"(TEST)", "completed"},
+	}
+	assert.Equal(t, expectedLabels, observedLabels)
+}
+
 func TestChronology_UpdateRoundShouldInitRound(t *testing.T) {
 	t.Parallel()
 
@@ -318,6 +385,7 @@ func getDefaultChronologyArg() chronology.ArgChronology {
 		RoundHandler:     &mock.RoundHandlerMock{},
 		SyncTimer:        &mock.SyncTimerMock{},
 		AppStatusHandler: statusHandlerMock.NewAppStatusHandlerMock(),
+		MetricsRegistry:  &testscommon.MetricsRegistryStub{},
 		Watchdog:         &mock.WatchdogMock{},
 	}
 }
@@ -15,3 +15,6 @@ var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 
 // ErrNilWatchdog signals that a nil watchdog has been provided
 var ErrNilWatchdog = errors.New("nil watchdog")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry has been provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
@@ -243,3 +243,6 @@ var ErrNilFunctionHandler = errors.New("nil function handler")
 
 // ErrWrongHashForHeader signals that the hash of the header is not the expected one
 var ErrWrongHashForHeader = errors.New("wrong hash for header")
+
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
@@ -171,6 +171,14 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReputationHandler defines the peer reputation operations needed by the consensus components
+type PeerReputationHandler interface {
+	RecordPeerIDEvent(pid core.PeerID, source string, reason string, scoreDelta float64, banDuration time.Duration)
+	IsPeerIDDenied(pid core.PeerID) bool
+	IsPeerIDAllowed(pid core.PeerID) bool
+	IsInterfaceNil() bool
+}
+
 // SentSignaturesTracker defines a component able to handle sent signature from self
 type SentSignaturesTracker interface {
 	StartRound()
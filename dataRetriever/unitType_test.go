@@ -56,6 +56,9 @@ func TestUnitType_String(t *testing.T) {
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
 
+	ut = GovernanceIndexUnit
+	require.Equal(t, "GovernanceIndexUnit", ut.String())
+
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
 
@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 )
 
 var errorDisabledHistoryRepository = errors.New("history repository is disabled")
@@ -53,6 +54,21 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetGovernanceProposals returns an error
+func (nhr *nilHistoryRepository) GetGovernanceProposals() ([]*governance.Proposal, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetGovernanceProposal returns an error
+func (nhr *nilHistoryRepository) GetGovernanceProposal(_ uint64) (*governance.Proposal, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetGovernanceProposalVotes returns an error
+func (nhr *nilHistoryRepository) GetGovernanceProposalVotes(_ uint64) ([]*governance.Vote, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
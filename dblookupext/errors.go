@@ -13,6 +13,8 @@ var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilESDTSuppliesHandler = errors.New("nil esdt supplies handler")
 
+var errNilGovernanceIndexHandler = errors.New("nil governance index handler")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
@@ -37,7 +37,7 @@ func NewSuppliesProcessor(
 		return nil, core.ErrNilStore
 	}
 
-	logsGet := newLogsGetter(marshalizer, logsStorer)
+	logsGet := NewLogsGetter(marshalizer, logsStorer)
 	logsProc := newLogsProcessor(marshalizer, suppliesStorer)
 
 	return &suppliesProcessor{
@@ -70,7 +70,7 @@ func (sp *suppliesProcessor) RevertChanges(header data.HeaderHandler, body data.
 	sp.mutex.Lock()
 	defer sp.mutex.Unlock()
 
-	logsFromDB, err := sp.logsGet.getLogsBasedOnBody(body)
+	logsFromDB, err := sp.logsGet.GetLogsBasedOnBody(body)
 	if err != nil {
 		return err
 	}
@@ -15,7 +15,9 @@ type logsGetter struct {
 	marshalizer marshal.Marshalizer
 }
 
-func newLogsGetter(
+// NewLogsGetter creates a component able to fetch from storage the logs generated by the transactions and
+// smart contract results of a block body
+func NewLogsGetter(
 	marshalizer marshal.Marshalizer,
 	logsStorer storage.Storer,
 ) *logsGetter {
@@ -25,7 +27,8 @@ func newLogsGetter(
 	}
 }
 
-func (lg *logsGetter) getLogsBasedOnBody(blockBody data.BodyHandler) (map[string]*data.LogData, error) {
+// GetLogsBasedOnBody returns the logs stored for the transactions and smart contract results of the provided body
+func (lg *logsGetter) GetLogsBasedOnBody(blockBody data.BodyHandler) (map[string]*data.LogData, error) {
 	body, ok := blockBody.(*block.Body)
 	if !ok {
 		return nil, errCannotCastToBlockBody
@@ -36,7 +36,7 @@ func TestGetLogsBasedOnBody(t *testing.T) {
 		},
 	}
 
-	getter := newLogsGetter(marshalizer, storer)
+	getter := NewLogsGetter(marshalizer, storer)
 
 	blockBody := &block.Body{
 		MiniBlocks: []*block.MiniBlock{
@@ -54,7 +54,7 @@ func TestGetLogsBasedOnBody(t *testing.T) {
 		},
 	}
 
-	res, err := getter.getLogsBasedOnBody(blockBody)
+	res, err := getter.GetLogsBasedOnBody(blockBody)
 	require.Nil(t, err)
 	require.Len(t, res, 2)
 }
@@ -62,8 +62,8 @@ func TestGetLogsBasedOnBody(t *testing.T) {
 func TestGetLogsWrongBodyType(t *testing.T) {
 	t.Parallel()
 
-	getter := newLogsGetter(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{})
+	getter := NewLogsGetter(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{})
 
-	_, err := getter.getLogsBasedOnBody(nil)
+	_, err := getter.GetLogsBasedOnBody(nil)
 	require.Equal(t, errCannotCastToBlockBody, err)
 }
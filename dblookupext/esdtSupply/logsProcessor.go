@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/storage"
 )
 
@@ -33,12 +34,13 @@ func newLogsProcessor(
 		marshalizer:    marshalizer,
 		suppliesStorer: suppliesStorer,
 		fungibleOperations: map[string]struct{}{
-			core.BuiltInFunctionESDTLocalBurn:      {},
-			core.BuiltInFunctionESDTLocalMint:      {},
-			core.BuiltInFunctionESDTWipe:           {},
-			core.BuiltInFunctionESDTNFTCreate:      {},
-			core.BuiltInFunctionESDTNFTAddQuantity: {},
-			core.BuiltInFunctionESDTNFTBurn:        {},
+			core.BuiltInFunctionESDTLocalBurn:               {},
+			core.BuiltInFunctionESDTLocalMint:               {},
+			core.BuiltInFunctionESDTWipe:                    {},
+			core.BuiltInFunctionESDTNFTCreate:               {},
+			core.BuiltInFunctionESDTNFTAddQuantity:          {},
+			core.BuiltInFunctionESDTNFTBurn:                 {},
+			common.BuiltInFunctionESDTSetLocalMintAllowance: {},
 		},
 	}
 }
@@ -52,15 +54,19 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 		return nil
 	}
 
+	// the mint allowances are processed first, as the logs are not ordered and a local mint consumes the allowance
+	// only for the tokens whose supply policy is known
 	supplies := make(map[string]*SupplyESDT)
-	for _, logHandler := range logs {
-		if logHandler == nil || check.IfNil(logHandler.LogHandler) {
-			continue
-		}
-
-		errProc := lp.processLog(logHandler.LogHandler, supplies, isRevert)
-		if errProc != nil {
-			return errProc
+	for _, isMintAllowancePass := range []bool{true, false} {
+		for _, logHandler := range logs {
+			if logHandler == nil || check.IfNil(logHandler.LogHandler) {
+				continue
+			}
+
+			errProc := lp.processLog(logHandler.LogHandler, supplies, isRevert, isMintAllowancePass)
+			if errProc != nil {
+				return errProc
+			}
 		}
 	}
 
@@ -72,7 +78,7 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 	return lp.nonceProc.saveNonceInStorage(blockNonce)
 }
 
-func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) error {
+func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool, isMintAllowancePass bool) error {
 	for _, entryHandler := range txLog.GetLogEvents() {
 		if check.IfNil(entryHandler) {
 			continue
@@ -86,6 +92,10 @@ func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*
 		if lp.shouldIgnoreEvent(event) {
 			continue
 		}
+		isMintAllowanceEvent := string(event.Identifier) == common.BuiltInFunctionESDTSetLocalMintAllowance
+		if isMintAllowanceEvent != isMintAllowancePass {
+			continue
+		}
 
 		err := lp.processEvent(event, supplies, isRevert)
 		if err != nil {
@@ -117,6 +127,10 @@ func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[str
 		return nil
 	}
 
+	if string(txLog.Identifier) == common.BuiltInFunctionESDTSetLocalMintAllowance {
+		return lp.processMintAllowanceEvent(txLog, supplies, isRevert)
+	}
+
 	tokenIdentifier := txLog.Topics[0]
 	isESDTFungible := true
 	if len(txLog.Topics[1]) != 0 {
@@ -147,23 +161,59 @@ func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[str
 	return nil
 }
 
+// processMintAllowanceEvent tracks the max supply and the local mint allowance held by the accounts of this shard
+// for a token with a supply policy. The event topics are the token, an empty nonce, the allowance and the max supply
+func (lp *logsProcessor) processMintAllowanceEvent(txLog *transaction.Event, supplies map[string]*SupplyESDT, isRevert bool) error {
+	if len(txLog.Topics) < 4 {
+		return nil
+	}
+
+	tokenSupply, err := lp.getOrLoadTokenSupply(txLog.Topics[0], supplies)
+	if err != nil {
+		return err
+	}
+
+	if tokenSupply.MintAllowance == nil {
+		tokenSupply.MintAllowance = big.NewInt(0)
+	}
+	valueFromEvent := big.NewInt(0).SetBytes(txLog.Topics[2])
+	if isRevert {
+		tokenSupply.MintAllowance.Sub(tokenSupply.MintAllowance, valueFromEvent)
+		return nil
+	}
+
+	tokenSupply.MintAllowance.Add(tokenSupply.MintAllowance, valueFromEvent)
+	tokenSupply.MaxSupply = big.NewInt(0).SetBytes(txLog.Topics[3])
+
+	return nil
+}
+
 func (lp *logsProcessor) updateOrCreateTokenSupply(identifier []byte, valueFromEvent *big.Int, eventIdentifier string, supplies map[string]*SupplyESDT, isRevert bool) error {
+	tokenSupply, err := lp.getOrLoadTokenSupply(identifier, supplies)
+	if err != nil {
+		return err
+	}
+
+	lp.updateTokenSupply(tokenSupply, valueFromEvent, eventIdentifier, isRevert)
+
+	return nil
+}
+
+func (lp *logsProcessor) getOrLoadTokenSupply(identifier []byte, supplies map[string]*SupplyESDT) (*SupplyESDT, error) {
 	identifierStr := string(identifier)
 	tokenSupply, found := supplies[identifierStr]
 	if found {
-		lp.updateTokenSupply(tokenSupply, valueFromEvent, eventIdentifier, isRevert)
-		return nil
+		return tokenSupply, nil
 	}
 
 	supply, err := lp.getESDTSupply(identifier)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	supplies[identifierStr] = supply
-	lp.updateTokenSupply(supplies[identifierStr], valueFromEvent, eventIdentifier, isRevert)
 
-	return nil
+	return supply, nil
 }
 
 func (lp *logsProcessor) updateTokenSupply(tokenSupply *SupplyESDT, valueFromEvent *big.Int, eventIdentifier string, isRevert bool) {
@@ -192,6 +242,15 @@ func (lp *logsProcessor) updateTokenSupply(tokenSupply *SupplyESDT, valueFromEve
 		tokenSupply.Burned.Add(tokenSupply.Burned, negativeValueFromEvent)
 		tokenSupply.Supply.Add(tokenSupply.Supply, valueFromEvent)
 	}
+
+	// the local mints of a token with a supply policy consume the mint allowance
+	isLocalMintFromAllowance := eventIdentifier == core.BuiltInFunctionESDTLocalMint && tokenSupply.MintAllowance != nil
+	switch {
+	case isLocalMintFromAllowance && !isRevert:
+		tokenSupply.MintAllowance.Add(tokenSupply.MintAllowance, negativeValueFromEvent)
+	case isLocalMintFromAllowance && isRevert:
+		tokenSupply.MintAllowance.Add(tokenSupply.MintAllowance, valueFromEvent)
+	}
 }
 
 func (lp *logsProcessor) getESDTSupply(tokenIdentifier []byte) (*SupplyESDT, error) {
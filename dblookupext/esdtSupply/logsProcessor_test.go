@@ -8,7 +8,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
@@ -239,3 +241,62 @@ func TestMakePropertiesNotNil(t *testing.T) {
 	})
 
 }
+
+func TestProcessLogs_MintAllowance(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	logs := map[string]*data.LogData{
+		"txMint": {
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Identifier: []byte(core.BuiltInFunctionESDTLocalMint),
+						Topics: [][]byte{
+							token, big.NewInt(0).Bytes(), big.NewInt(30).Bytes(),
+						},
+					},
+				},
+			},
+		},
+		"txAllowance": {
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Identifier: []byte(common.BuiltInFunctionESDTSetLocalMintAllowance),
+						Topics: [][]byte{
+							token, big.NewInt(0).Bytes(), big.NewInt(100).Bytes(), big.NewInt(1000).Bytes(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	marshalizer := marshallerMock.MarshalizerMock{}
+	logsProc := newLogsProcessor(marshalizer, genericMocks.NewStorerMockWithErrKeyNotFound(0))
+
+	err := logsProc.processLogs(1, logs, false)
+	require.Nil(t, err)
+
+	expectedSupply := &SupplyESDT{
+		Supply:        big.NewInt(30),
+		Burned:        big.NewInt(0),
+		Minted:        big.NewInt(30),
+		MaxSupply:     big.NewInt(1000),
+		MintAllowance: big.NewInt(70),
+	}
+	supply, err := logsProc.getESDTSupply(token)
+	require.Nil(t, err)
+	require.Equal(t, expectedSupply, supply)
+
+	err = logsProc.processLogs(1, logs, true)
+	require.Nil(t, err)
+
+	expectedSupply.Supply = big.NewInt(0)
+	expectedSupply.Minted = big.NewInt(0)
+	expectedSupply.MintAllowance = big.NewInt(0)
+	supply, err = logsProc.getESDTSupply(token)
+	require.Nil(t, err)
+	require.Equal(t, expectedSupply, supply)
+}
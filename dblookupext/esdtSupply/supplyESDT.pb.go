@@ -33,6 +33,8 @@ type SupplyESDT struct {
 	Burned           *math_big.Int `protobuf:"bytes,2,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"burned"`
 	Minted           *math_big.Int `protobuf:"bytes,3,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"minted"`
 	RecomputedSupply bool          `protobuf:"varint,4,opt,name=RecomputedSupply,proto3" json:"recomputedSupply"`
+	MaxSupply        *math_big.Int `protobuf:"bytes,5,opt,name=MaxSupply,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"maxSupply"`
+	MintAllowance    *math_big.Int `protobuf:"bytes,6,opt,name=MintAllowance,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"mintAllowance"`
 }
 
 func (m *SupplyESDT) Reset()      { *m = SupplyESDT{} }
@@ -91,6 +93,20 @@ func (m *SupplyESDT) GetRecomputedSupply() bool {
 	return false
 }
 
+func (m *SupplyESDT) GetMaxSupply() *math_big.Int {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
+}
+
+func (m *SupplyESDT) GetMintAllowance() *math_big.Int {
+	if m != nil {
+		return m.MintAllowance
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SupplyESDT)(nil), "proto.SupplyESDT")
 }
@@ -98,28 +114,31 @@ func init() {
 func init() { proto.RegisterFile("supplyESDT.proto", fileDescriptor_173c6d56cc05b222) }
 
 var fileDescriptor_173c6d56cc05b222 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd1, 0x3f, 0x4e, 0xc3, 0x30,
-	0x14, 0x06, 0xf0, 0x98, 0xd2, 0x08, 0x59, 0x0c, 0x55, 0xc4, 0x50, 0x31, 0xbc, 0x54, 0x4c, 0x5d,
-	0x92, 0x0c, 0x8c, 0x2c, 0x28, 0xb4, 0x43, 0x07, 0x96, 0x94, 0x89, 0x2d, 0x7f, 0x4c, 0x6a, 0xa8,
-	0xe3, 0xc8, 0xb1, 0xab, 0xb2, 0x71, 0x04, 0x06, 0x0e, 0x81, 0x38, 0x09, 0x63, 0xc7, 0x4e, 0x85,
-	0xba, 0x0b, 0xea, 0xd4, 0x23, 0x20, 0x9c, 0x0a, 0x10, 0xac, 0x9d, 0x92, 0xef, 0xb3, 0xfd, 0x7e,
-	0x92, 0x8d, 0x5b, 0x95, 0x2a, 0xcb, 0xf1, 0x7d, 0x7f, 0xd8, 0xbb, 0xf2, 0x4b, 0xc1, 0x25, 0x77,
-	0x9a, 0xe6, 0x73, 0xec, 0xe5, 0x54, 0x8e, 0x54, 0xe2, 0xa7, 0x9c, 0x05, 0x39, 0xcf, 0x79, 0x60,
-	0xea, 0x44, 0xdd, 0x98, 0x64, 0x82, 0xf9, 0xab, 0x4f, 0x9d, 0x3c, 0x35, 0x30, 0x1e, 0x7e, 0x8f,
-	0x72, 0x6e, 0xb1, 0x5d, 0xa7, 0x36, 0xea, 0xa0, 0xee, 0x61, 0x18, 0xad, 0x17, 0x6e, 0x73, 0x12,
-	0x8f, 0x15, 0x79, 0x79, 0x73, 0xfb, 0x2c, 0x96, 0xa3, 0x20, 0xa1, 0xb9, 0x3f, 0x28, 0xe4, 0xd9,
-	0x2f, 0x87, 0xa9, 0xb1, 0xa4, 0x13, 0x22, 0xaa, 0x69, 0xc0, 0xa6, 0x5e, 0x3a, 0x8a, 0x69, 0xe1,
-	0xa5, 0x5c, 0x10, 0x2f, 0xe7, 0x41, 0x16, 0xcb, 0xd8, 0x0f, 0x69, 0x3e, 0x28, 0xe4, 0x45, 0x5c,
-	0x49, 0x22, 0xa2, 0xad, 0xe0, 0xdc, 0x61, 0x3b, 0x54, 0xa2, 0x20, 0x59, 0x7b, 0xcf, 0x58, 0xc3,
-	0xf5, 0xc2, 0xb5, 0x13, 0xd3, 0xec, 0x10, 0xab, 0x89, 0x2f, 0xec, 0x92, 0x16, 0x92, 0x64, 0xed,
-	0xc6, 0x0f, 0xc6, 0x4c, 0xb3, 0x43, 0xac, 0x26, 0x9c, 0x73, 0xdc, 0x8a, 0x48, 0xca, 0x59, 0xa9,
-	0x24, 0xc9, 0xb6, 0xf7, 0xb9, 0xdf, 0x41, 0xdd, 0x83, 0xf0, 0x68, 0xbd, 0x70, 0x5b, 0xe2, 0xcf,
-	0x5a, 0xf4, 0x6f, 0x77, 0xd8, 0x9b, 0x2d, 0xc1, 0x9a, 0x2f, 0xc1, 0xda, 0x2c, 0x01, 0x3d, 0x68,
-	0x40, 0xcf, 0x1a, 0xd0, 0xab, 0x06, 0x34, 0xd3, 0x80, 0xe6, 0x1a, 0xd0, 0xbb, 0x06, 0xf4, 0xa1,
-	0xc1, 0xda, 0x68, 0x40, 0x8f, 0x2b, 0xb0, 0x66, 0x2b, 0xb0, 0xe6, 0x2b, 0xb0, 0xae, 0x31, 0xa9,
-	0x32, 0x59, 0x4f, 0x49, 0x6c, 0xf3, 0xc6, 0xa7, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x29, 0x1e,
-	0x87, 0x1e, 0x2d, 0x02, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd2, 0xb1, 0x6e, 0x9b, 0x40,
+	0x18, 0x07, 0x70, 0xae, 0x2d, 0xa8, 0x3e, 0xd5, 0x92, 0x85, 0x3a, 0xa0, 0x0e, 0x87, 0xd5, 0xc9,
+	0x0b, 0x30, 0x74, 0xec, 0xd2, 0x52, 0x7b, 0xf0, 0xe0, 0x05, 0x57, 0x1d, 0xbc, 0x1d, 0x70, 0xc5,
+	0xb4, 0x1c, 0x87, 0xe0, 0x70, 0xdd, 0xcd, 0x2f, 0x10, 0x29, 0x8f, 0x11, 0xe5, 0x49, 0x32, 0x7a,
+	0xf4, 0x44, 0xe2, 0xf3, 0x12, 0x31, 0xf9, 0x11, 0x22, 0x1f, 0x4e, 0xec, 0x24, 0x2b, 0x13, 0x7c,
+	0xff, 0xe3, 0xe3, 0xf7, 0x7d, 0x08, 0xd8, 0x2b, 0xca, 0x2c, 0x4b, 0xfe, 0x8f, 0xa6, 0xc3, 0x9f,
+	0x76, 0x96, 0x33, 0xce, 0x74, 0x55, 0x5e, 0x3e, 0x59, 0x51, 0xcc, 0xe7, 0xa5, 0x6f, 0x07, 0x8c,
+	0x3a, 0x11, 0x8b, 0x98, 0x23, 0x63, 0xbf, 0xfc, 0x2d, 0x2b, 0x59, 0xc8, 0xbb, 0xa6, 0xeb, 0xf3,
+	0x85, 0x0a, 0xe1, 0xf4, 0xe9, 0x55, 0xfa, 0x1f, 0xa8, 0x35, 0x95, 0x01, 0xfa, 0x60, 0xf0, 0xc1,
+	0xf5, 0xea, 0xca, 0x54, 0x17, 0x38, 0x29, 0xc9, 0xf5, 0xad, 0x39, 0xa2, 0x98, 0xcf, 0x1d, 0x3f,
+	0x8e, 0xec, 0x71, 0xca, 0xbf, 0x9e, 0x39, 0xb4, 0x4c, 0x78, 0xbc, 0x20, 0x79, 0xb1, 0x74, 0xe8,
+	0xd2, 0x0a, 0xe6, 0x38, 0x4e, 0xad, 0x80, 0xe5, 0xc4, 0x8a, 0x98, 0x13, 0x62, 0x8e, 0x6d, 0x37,
+	0x8e, 0xc6, 0x29, 0xff, 0x81, 0x0b, 0x4e, 0x72, 0xef, 0x28, 0xe8, 0x7f, 0xa1, 0xe6, 0x96, 0x79,
+	0x4a, 0x42, 0xe3, 0x8d, 0xb4, 0xa6, 0x75, 0x65, 0x6a, 0xbe, 0x4c, 0x5a, 0xc4, 0x1a, 0xe2, 0x80,
+	0x4d, 0xe2, 0x94, 0x93, 0xd0, 0x78, 0x7b, 0xc2, 0xa8, 0x4c, 0x5a, 0xc4, 0x1a, 0x42, 0xff, 0x06,
+	0x7b, 0x1e, 0x09, 0x18, 0xcd, 0x4a, 0x4e, 0xc2, 0xe3, 0xf7, 0x7c, 0xd7, 0x07, 0x83, 0xf7, 0xee,
+	0xc7, 0xba, 0x32, 0x7b, 0xf9, 0x8b, 0x33, 0xef, 0xd5, 0xd3, 0x3a, 0x87, 0x9d, 0x09, 0x5e, 0x1e,
+	0x5b, 0x55, 0x39, 0xf1, 0xaf, 0xba, 0x32, 0x3b, 0xf4, 0x31, 0x6c, 0x6f, 0xe8, 0x13, 0xa4, 0xaf,
+	0x00, 0xec, 0x1e, 0x56, 0xf8, 0x9e, 0x24, 0xec, 0x1f, 0x4e, 0x03, 0x62, 0x68, 0x92, 0x9e, 0xd5,
+	0x95, 0xd9, 0xa5, 0xe7, 0x07, 0xed, 0xf1, 0xcf, 0x41, 0x77, 0xb8, 0xde, 0x22, 0x65, 0xb3, 0x45,
+	0xca, 0x7e, 0x8b, 0xc0, 0x4a, 0x20, 0x70, 0x25, 0x10, 0xb8, 0x11, 0x08, 0xac, 0x05, 0x02, 0x1b,
+	0x81, 0xc0, 0x9d, 0x40, 0xe0, 0x5e, 0x20, 0x65, 0x2f, 0x10, 0xb8, 0xdc, 0x21, 0x65, 0xbd, 0x43,
+	0xca, 0x66, 0x87, 0x94, 0x19, 0x24, 0x45, 0xc8, 0x9b, 0x45, 0x7c, 0x4d, 0xfe, 0xdc, 0x5f, 0x1e,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x8f, 0x75, 0x63, 0x26, 0x03, 0x00, 0x00,
 }
 
 func (this *SupplyESDT) Equal(that interface{}) bool {
@@ -162,18 +181,32 @@ func (this *SupplyESDT) Equal(that interface{}) bool {
 	if this.RecomputedSupply != that1.RecomputedSupply {
 		return false
 	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MaxSupply, that1.MaxSupply) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MintAllowance, that1.MintAllowance) {
+			return false
+		}
+	}
 	return true
 }
 func (this *SupplyESDT) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 10)
 	s = append(s, "&esdtSupply.SupplyESDT{")
 	s = append(s, "Supply: "+fmt.Sprintf("%#v", this.Supply)+",\n")
 	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
 	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
 	s = append(s, "RecomputedSupply: "+fmt.Sprintf("%#v", this.RecomputedSupply)+",\n")
+	s = append(s, "MaxSupply: "+fmt.Sprintf("%#v", this.MaxSupply)+",\n")
+	s = append(s, "MintAllowance: "+fmt.Sprintf("%#v", this.MintAllowance)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -205,6 +238,28 @@ func (m *SupplyESDT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.MintAllowance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MintAllowance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyESDT(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.MaxSupply)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MaxSupply, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSupplyESDT(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.RecomputedSupply {
 		i--
 		if m.RecomputedSupply {
@@ -286,6 +341,16 @@ func (m *SupplyESDT) Size() (n int) {
 	if m.RecomputedSupply {
 		n += 2
 	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.MaxSupply)
+		n += 1 + l + sovSupplyESDT(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.MintAllowance)
+		n += 1 + l + sovSupplyESDT(uint64(l))
+	}
 	return n
 }
 
@@ -304,6 +369,8 @@ func (this *SupplyESDT) String() string {
 		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
 		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
 		`RecomputedSupply:` + fmt.Sprintf("%v", this.RecomputedSupply) + `,`,
+		`MaxSupply:` + fmt.Sprintf("%v", this.MaxSupply) + `,`,
+		`MintAllowance:` + fmt.Sprintf("%v", this.MintAllowance) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -479,6 +546,82 @@ func (m *SupplyESDT) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RecomputedSupply = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyESDT
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyESDT
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyESDT
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MaxSupply = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyESDT
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSupplyESDT
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplyESDT
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MintAllowance = tmp
+				}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSupplyESDT(dAtA[iNdEx:])
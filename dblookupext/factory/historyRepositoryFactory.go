@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/disabled"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
@@ -84,6 +85,20 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	governanceIndexStorer, err := hpf.store.GetStorer(dataRetriever.GovernanceIndexUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	governanceIndexHandler, err := governance.NewGovernanceIndexer(
+		hpf.marshalizer,
+		governanceIndexStorer,
+		txLogsStorer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	roundHdrHashDataStorer, err := hpf.store.GetStorer(dataRetriever.RoundHdrHashDataUnit)
 	if err != nil {
 		return nil, err
@@ -120,6 +135,7 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		GovernanceIndexHandler:      governanceIndexHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
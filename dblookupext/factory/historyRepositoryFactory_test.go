@@ -82,6 +82,7 @@ func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T)
 
 	t.Run("missing ESDTSuppliesUnit", testWithMissingStorer(dataRetriever.ESDTSuppliesUnit))
 	t.Run("missing TxLogsUnit", testWithMissingStorer(dataRetriever.TxLogsUnit))
+	t.Run("missing GovernanceIndexUnit", testWithMissingStorer(dataRetriever.GovernanceIndexUnit))
 	t.Run("missing RoundHdrHashDataUnit", testWithMissingStorer(dataRetriever.RoundHdrHashDataUnit))
 	t.Run("missing MiniblocksMetadataUnit", testWithMissingStorer(dataRetriever.MiniblocksMetadataUnit))
 	t.Run("missing EpochByHashUnit", testWithMissingStorer(dataRetriever.EpochByHashUnit))
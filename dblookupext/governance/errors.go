@@ -0,0 +1,9 @@
+package governance
+
+import "errors"
+
+// ErrProposalNotFound signals that the requested proposal is not present in the governance index
+var ErrProposalNotFound = errors.New("governance proposal not found")
+
+// ErrVoteNotFound signals that an indexed vote is missing from the governance index
+var ErrVoteNotFound = errors.New("governance vote not found")
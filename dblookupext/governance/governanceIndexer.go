@@ -0,0 +1,171 @@
+package governance
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/governance")
+
+type logsGetter interface {
+	GetLogsBasedOnBody(blockBody data.BodyHandler) (map[string]*data.LogData, error)
+}
+
+type governanceIndexer struct {
+	logsGet        logsGetter
+	logsProc       *logsProcessor
+	governanceAddr []byte
+	mutex          sync.RWMutex
+}
+
+// NewGovernanceIndexer will create a new instance of the governance indexer, which builds an index of the
+// governance proposals and votes out of the logs generated by the governance system SC
+func NewGovernanceIndexer(
+	marshalizer marshal.Marshalizer,
+	indexStorer storage.Storer,
+	logsStorer storage.Storer,
+) (*governanceIndexer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(indexStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(logsStorer) {
+		return nil, core.ErrNilStore
+	}
+
+	// the index records are plain structures, so they are always stored as json, regardless of the node marshalizer
+	records := newRecordsStorer(&marshal.JsonMarshalizer{}, indexStorer)
+
+	return &governanceIndexer{
+		logsGet:        esdtSupply.NewLogsGetter(marshalizer, logsStorer),
+		logsProc:       newLogsProcessor(records),
+		governanceAddr: vm.GovernanceSCAddress,
+	}, nil
+}
+
+// ProcessLogs will index the governance events found in the provided logs
+func (gi *governanceIndexer) ProcessLogs(blockNonce uint64, logs []*data.LogData) error {
+	gi.mutex.Lock()
+	defer gi.mutex.Unlock()
+
+	governanceLogs := make([]*data.LogData, 0)
+	for _, logData := range logs {
+		if gi.isGovernanceLog(logData) {
+			governanceLogs = append(governanceLogs, logData)
+		}
+	}
+
+	return gi.logsProc.processLogs(blockNonce, governanceLogs, false)
+}
+
+// RevertChanges will revert the index changes based on the provided block body
+func (gi *governanceIndexer) RevertChanges(header data.HeaderHandler, body data.BodyHandler) error {
+	if check.IfNil(header) || check.IfNil(body) {
+		return nil
+	}
+
+	gi.mutex.Lock()
+	defer gi.mutex.Unlock()
+
+	logsFromDB, err := gi.logsGet.GetLogsBasedOnBody(body)
+	if err != nil {
+		return err
+	}
+
+	// the logs are provided in the order of the body, so that the logs processor undoes them in the reverse order
+	governanceLogs := make([]*data.LogData, 0, len(logsFromDB))
+	for _, txHash := range getBodyTxHashes(body) {
+		logData, found := logsFromDB[string(txHash)]
+		if found && gi.isGovernanceLog(logData) {
+			governanceLogs = append(governanceLogs, logData)
+			delete(logsFromDB, string(txHash))
+		}
+	}
+
+	return gi.logsProc.processLogs(header.GetNonce(), governanceLogs, true)
+}
+
+func getBodyTxHashes(body data.BodyHandler) [][]byte {
+	blockBody, ok := body.(*block.Body)
+	if !ok {
+		return nil
+	}
+
+	txHashes := make([][]byte, 0)
+	for _, mb := range blockBody.MiniBlocks {
+		txHashes = append(txHashes, mb.TxHashes...)
+	}
+
+	return txHashes
+}
+
+// the governance system SC logs are saved under the governance address, including the ones of the votes
+// cast by the delegation contracts, as those arrive as smart contract results on the metachain
+func (gi *governanceIndexer) isGovernanceLog(logData *data.LogData) bool {
+	if logData == nil || check.IfNil(logData.LogHandler) {
+		return false
+	}
+
+	return bytes.Equal(logData.GetAddress(), gi.governanceAddr)
+}
+
+// GetProposal returns the indexed proposal with the provided nonce
+func (gi *governanceIndexer) GetProposal(nonce uint64) (*Proposal, error) {
+	gi.mutex.RLock()
+	defer gi.mutex.RUnlock()
+
+	return gi.logsProc.records.getProposal(nonce)
+}
+
+// GetProposals returns all the indexed proposals, in the order of their nonces
+func (gi *governanceIndexer) GetProposals() ([]*Proposal, error) {
+	gi.mutex.RLock()
+	defer gi.mutex.RUnlock()
+
+	nonces, err := gi.logsProc.records.getProposalNonces()
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*Proposal, 0, len(nonces))
+	for _, nonce := range nonces {
+		proposal, errGet := gi.logsProc.records.getProposal(nonce)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
+// GetProposalVotes returns the votes cast on the proposal with the provided nonce, including the delegated ones
+func (gi *governanceIndexer) GetProposalVotes(nonce uint64) ([]*Vote, error) {
+	gi.mutex.RLock()
+	defer gi.mutex.RUnlock()
+
+	proposal, err := gi.logsProc.records.getProposal(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gi.logsProc.records.getVotes(proposal)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gi *governanceIndexer) IsInterfaceNil() bool {
+	return gi == nil
+}
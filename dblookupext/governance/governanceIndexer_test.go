@@ -0,0 +1,428 @@
+package governance
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	issuer         = bytes.Repeat([]byte{1}, 32)
+	voter          = bytes.Repeat([]byte{2}, 32)
+	delegator      = bytes.Repeat([]byte{3}, 32)
+	delegationSC   = bytes.Repeat([]byte{4}, 32)
+	testCommitHash = bytes.Repeat([]byte("a"), 40)
+)
+
+func createGovernanceLog(txHash string, address []byte, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash: txHash,
+		LogHandler: &transaction.Log{
+			Address: address,
+			Events:  events,
+		},
+	}
+}
+
+func createProposalLog() *data.LogData {
+	return createGovernanceLog("proposalTx", vm.GovernanceSCAddress, &transaction.Event{
+		Address:    issuer,
+		Identifier: []byte(parameterProposalIdentifier),
+		Topics: [][]byte{
+			big.NewInt(1).Bytes(), testCommitHash, big.NewInt(10).Bytes(), big.NewInt(12).Bytes(),
+			[]byte("stakingNodePrice"), []byte("2500"),
+		},
+	})
+}
+
+func createVoteLogs() []*data.LogData {
+	return []*data.LogData{
+		createGovernanceLog("voteTx", vm.GovernanceSCAddress, &transaction.Event{
+			Address:    voter,
+			Identifier: []byte(voteIdentifier),
+			Topics:     [][]byte{big.NewInt(1).Bytes(), []byte(yesOption), big.NewInt(1000).Bytes(), big.NewInt(100).Bytes()},
+		}),
+		createGovernanceLog("delegateVoteTx", vm.GovernanceSCAddress, &transaction.Event{
+			Address:    delegationSC,
+			Identifier: []byte(delegateVoteIdentifier),
+			Topics:     [][]byte{big.NewInt(1).Bytes(), []byte(noOption), delegator, big.NewInt(400).Bytes(), big.NewInt(40).Bytes()},
+		}),
+	}
+}
+
+func createCloseLog(passed string) *data.LogData {
+	return createGovernanceLog("closeTx", vm.GovernanceSCAddress,
+		&transaction.Event{
+			Address:    issuer,
+			Identifier: []byte(closeProposalIdentifier),
+			Topics:     [][]byte{testCommitHash, []byte(passed)},
+		},
+		&transaction.Event{
+			Address:    vm.GovernanceSCAddress,
+			Identifier: []byte(scheduleParameterChangesIdentifier),
+			Topics:     [][]byte{big.NewInt(1).Bytes(), testCommitHash, big.NewInt(15).Bytes()},
+		},
+	)
+}
+
+func TestNewGovernanceIndexer(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGovernanceIndexer(nil, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	_, err = NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, nil, &storageStubs.StorerStub{})
+	require.Equal(t, core.ErrNilStore, err)
+
+	_, err = NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{}, nil)
+	require.Equal(t, core.ErrNilStore, err)
+
+	indexer, err := NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	require.Nil(t, err)
+	require.False(t, indexer.IsInterfaceNil())
+}
+
+type storerRecorder struct {
+	mutex   sync.Mutex
+	data    map[string][]byte
+	putKeys []string
+}
+
+func createStorerRecorder() (*storerRecorder, *storageStubs.StorerStub) {
+	recorder := &storerRecorder{
+		data: make(map[string][]byte),
+	}
+
+	return recorder, &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+
+			value, found := recorder.data[string(key)]
+			if !found {
+				return nil, storage.ErrKeyNotFound
+			}
+
+			return value, nil
+		},
+		PutCalled: func(key, data []byte) error {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+
+			recorder.data[string(key)] = data
+			recorder.putKeys = append(recorder.putKeys, string(key))
+
+			return nil
+		},
+		RemoveCalled: func(key []byte) error {
+			recorder.mutex.Lock()
+			defer recorder.mutex.Unlock()
+
+			delete(recorder.data, string(key))
+
+			return nil
+		},
+	}
+}
+
+func (sr *storerRecorder) resetPutKeys() {
+	sr.mutex.Lock()
+	sr.putKeys = nil
+	sr.mutex.Unlock()
+}
+
+func createVoteLog(txHash string, votingPower int64) *data.LogData {
+	return createGovernanceLog(txHash, vm.GovernanceSCAddress, &transaction.Event{
+		Address:    voter,
+		Identifier: []byte(voteIdentifier),
+		Topics:     [][]byte{big.NewInt(1).Bytes(), []byte(yesOption), big.NewInt(votingPower * 10).Bytes(), big.NewInt(votingPower).Bytes()},
+	})
+}
+
+func createBody(logsStorer storage.Storer, marshaller marshal.Marshalizer, logs ...*data.LogData) *block.Body {
+	txHashes := make([][]byte, 0, len(logs))
+	for _, logData := range logs {
+		logBytes, _ := marshaller.Marshal(logData.LogHandler)
+		_ = logsStorer.Put([]byte(logData.TxHash), logBytes)
+		txHashes = append(txHashes, []byte(logData.TxHash))
+	}
+
+	return &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: txHashes}}}
+}
+
+func TestGovernanceIndexer_ProcessLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("proposal, votes and close should be indexed", func(t *testing.T) {
+		t.Parallel()
+
+		indexer, _ := NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, testscommon.CreateMemUnit(), testscommon.CreateMemUnit())
+
+		err := indexer.ProcessLogs(1, []*data.LogData{createProposalLog()})
+		require.Nil(t, err)
+		err = indexer.ProcessLogs(2, createVoteLogs())
+		require.Nil(t, err)
+
+		proposal, err := indexer.GetProposal(1)
+		require.Nil(t, err)
+		require.Equal(t, string(testCommitHash), proposal.CommitHash)
+		require.Equal(t, issuer, proposal.Issuer)
+		require.Equal(t, uint64(10), proposal.StartVoteEpoch)
+		require.Equal(t, uint64(12), proposal.EndVoteEpoch)
+		require.Equal(t, map[string]string{"stakingNodePrice": "2500"}, proposal.ParameterChanges)
+		require.Equal(t, big.NewInt(100), proposal.Yes)
+		require.Equal(t, big.NewInt(40), proposal.No)
+		require.Equal(t, uint64(2), proposal.NumVotes)
+		require.False(t, proposal.Closed)
+
+		votes, err := indexer.GetProposalVotes(1)
+		require.Nil(t, err)
+		require.Len(t, votes, 2)
+		require.Equal(t, voter, votes[0].Voter)
+		require.Nil(t, votes[0].DelegatedBy)
+		require.Equal(t, delegator, votes[1].Voter)
+		require.Equal(t, delegationSC, votes[1].DelegatedBy)
+		require.Equal(t, big.NewInt(400), votes[1].Stake)
+
+		err = indexer.ProcessLogs(3, []*data.LogData{createCloseLog("true")})
+		require.Nil(t, err)
+
+		proposals, err := indexer.GetProposals()
+		require.Nil(t, err)
+		require.Len(t, proposals, 1)
+		require.True(t, proposals[0].Closed)
+		require.True(t, proposals[0].Passed)
+		require.Equal(t, uint32(15), proposals[0].ExecutionEpoch)
+	})
+	t.Run("already processed block should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		indexer, _ := NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, testscommon.CreateMemUnit(), testscommon.CreateMemUnit())
+
+		_ = indexer.ProcessLogs(1, []*data.LogData{createProposalLog()})
+		_ = indexer.ProcessLogs(2, createVoteLogs())
+		err := indexer.ProcessLogs(2, createVoteLogs())
+		require.Nil(t, err)
+
+		proposal, _ := indexer.GetProposal(1)
+		require.Equal(t, uint64(2), proposal.NumVotes)
+		require.Equal(t, big.NewInt(100), proposal.Yes)
+	})
+	t.Run("logs of other addresses should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		indexer, _ := NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, testscommon.CreateMemUnit(), testscommon.CreateMemUnit())
+
+		proposalLog := createProposalLog()
+		proposalLog.LogHandler.(*transaction.Log).Address = delegationSC
+		err := indexer.ProcessLogs(1, []*data.LogData{proposalLog, nil})
+		require.Nil(t, err)
+
+		proposals, err := indexer.GetProposals()
+		require.Nil(t, err)
+		require.Empty(t, proposals)
+	})
+	t.Run("indexing a vote should not rewrite the previous votes", func(t *testing.T) {
+		t.Parallel()
+
+		recorder, indexStorer := createStorerRecorder()
+		indexer, _ := NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, indexStorer, testscommon.CreateMemUnit())
+
+		_ = indexer.ProcessLogs(1, []*data.LogData{createProposalLog()})
+		numVotes := 50
+		for i := 0; i < numVotes; i++ {
+			err := indexer.ProcessLogs(uint64(i+2), []*data.LogData{createVoteLog(fmt.Sprintf("voteTx%d", i), 1)})
+			require.Nil(t, err)
+		}
+
+		recorder.resetPutKeys()
+		err := indexer.ProcessLogs(uint64(numVotes+2), []*data.LogData{createVoteLog("lastVoteTx", 1)})
+		require.Nil(t, err)
+
+		expectedKeys := []string{
+			string(voteKey(1, uint64(numVotes))),
+			string(voteIndexKey(1, &Vote{TxHash: []byte("lastVoteTx"), Voter: voter})),
+			string(proposalKey(1)),
+			processedBlockKey,
+		}
+		require.Equal(t, expectedKeys, recorder.putKeys)
+
+		votes, err := indexer.GetProposalVotes(1)
+		require.Nil(t, err)
+		require.Len(t, votes, numVotes+1)
+		require.Equal(t, []byte("voteTx0"), votes[0].TxHash)
+		require.Equal(t, []byte("lastVoteTx"), votes[numVotes].TxHash)
+	})
+	t.Run("votes of not indexed proposals should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		indexer, _ := NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, testscommon.CreateMemUnit(), testscommon.CreateMemUnit())
+
+		err := indexer.ProcessLogs(1, createVoteLogs())
+		require.Nil(t, err)
+
+		_, err = indexer.GetProposalVotes(1)
+		require.Equal(t, ErrProposalNotFound, err)
+	})
+}
+
+func TestGovernanceIndexer_RevertChanges(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshallerMock.MarshalizerMock{}
+	logsStorer := testscommon.CreateMemUnit()
+	indexer, _ := NewGovernanceIndexer(marshaller, testscommon.CreateMemUnit(), logsStorer)
+
+	saveLogs := func(logs ...*data.LogData) *block.Body {
+		txHashes := make([][]byte, 0, len(logs))
+		for _, logData := range logs {
+			logBytes, _ := marshaller.Marshal(logData.LogHandler)
+			_ = logsStorer.Put([]byte(logData.TxHash), logBytes)
+			txHashes = append(txHashes, []byte(logData.TxHash))
+		}
+
+		return &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: txHashes}}}
+	}
+
+	_ = indexer.ProcessLogs(1, []*data.LogData{createProposalLog()})
+	voteLogs := createVoteLogs()
+	_ = indexer.ProcessLogs(2, voteLogs)
+	closeLog := createCloseLog("false")
+	_ = indexer.ProcessLogs(3, []*data.LogData{closeLog})
+
+	err := indexer.RevertChanges(&block.MetaBlock{Nonce: 3}, saveLogs(closeLog))
+	require.Nil(t, err)
+	proposal, _ := indexer.GetProposal(1)
+	require.False(t, proposal.Closed)
+	require.Zero(t, proposal.ExecutionEpoch)
+
+	err = indexer.RevertChanges(&block.MetaBlock{Nonce: 2}, saveLogs(voteLogs...))
+	require.Nil(t, err)
+	proposal, _ = indexer.GetProposal(1)
+	require.Zero(t, proposal.NumVotes)
+	require.Zero(t, proposal.Yes.Sign())
+	require.Zero(t, proposal.No.Sign())
+	votes, _ := indexer.GetProposalVotes(1)
+	require.Empty(t, votes)
+
+	err = indexer.RevertChanges(&block.MetaBlock{Nonce: 1}, saveLogs(createProposalLog()))
+	require.Nil(t, err)
+	_, err = indexer.GetProposal(1)
+	require.Equal(t, ErrProposalNotFound, err)
+	proposals, _ := indexer.GetProposals()
+	require.Empty(t, proposals)
+}
+
+func TestGovernanceIndexer_RevertChangesShouldUndoTheEventsInReverseOrder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("votes of the reverted block should be removed without moving the other votes", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller := &marshallerMock.MarshalizerMock{}
+		logsStorer := testscommon.CreateMemUnit()
+		recorder, indexStorer := createStorerRecorder()
+		indexer, _ := NewGovernanceIndexer(marshaller, indexStorer, logsStorer)
+
+		_ = indexer.ProcessLogs(1, []*data.LogData{createProposalLog()})
+		_ = indexer.ProcessLogs(2, []*data.LogData{createVoteLog("voteTx0", 1)})
+		blockLogs := []*data.LogData{createVoteLog("voteTx1", 2), createVoteLog("voteTx2", 4), createVoteLog("voteTx3", 8)}
+		_ = indexer.ProcessLogs(3, blockLogs)
+
+		recorder.resetPutKeys()
+		err := indexer.RevertChanges(&block.MetaBlock{Nonce: 3}, createBody(logsStorer, marshaller, blockLogs...))
+		require.Nil(t, err)
+
+		for _, key := range recorder.putKeys {
+			require.False(t, strings.HasPrefix(key, votesPrefix), "vote %s should not have been rewritten", key)
+		}
+		proposal, _ := indexer.GetProposal(1)
+		require.Equal(t, uint64(1), proposal.NumVotes)
+		require.Equal(t, big.NewInt(1), proposal.Yes)
+		votes, _ := indexer.GetProposalVotes(1)
+		require.Len(t, votes, 1)
+		require.Equal(t, []byte("voteTx0"), votes[0].TxHash)
+	})
+	t.Run("a block with the proposal, its votes and its closing should be fully reverted", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller := &marshallerMock.MarshalizerMock{}
+		logsStorer := testscommon.CreateMemUnit()
+		recorder, indexStorer := createStorerRecorder()
+		indexer, _ := NewGovernanceIndexer(marshaller, indexStorer, logsStorer)
+
+		blockLogs := append([]*data.LogData{createProposalLog()}, createVoteLogs()...)
+		blockLogs = append(blockLogs, createCloseLog("true"))
+		_ = indexer.ProcessLogs(1, blockLogs)
+		proposal, _ := indexer.GetProposal(1)
+		require.True(t, proposal.Closed)
+
+		err := indexer.RevertChanges(&block.MetaBlock{Nonce: 1}, createBody(logsStorer, marshaller, blockLogs...))
+		require.Nil(t, err)
+
+		_, err = indexer.GetProposal(1)
+		require.Equal(t, ErrProposalNotFound, err)
+		remainingKeys := make([]string, 0)
+		for key := range recorder.data {
+			remainingKeys = append(remainingKeys, key)
+		}
+		sort.Strings(remainingKeys)
+		require.Equal(t, []string{processedBlockKey, proposalNoncesKey}, remainingKeys)
+	})
+}
+
+func TestGovernanceIndexer_GetProposalStorerError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := storage.ErrClosingPersisters
+	indexer, _ := NewGovernanceIndexer(&marshallerMock.MarshalizerMock{}, &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}, &storageStubs.StorerStub{})
+
+	_, err := indexer.GetProposal(1)
+	require.Equal(t, expectedErr, err)
+
+	_, err = indexer.GetProposals()
+	require.Equal(t, expectedErr, err)
+}
+
+func TestGovernanceIndexer_ProcessLogsAfterRevert(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshallerMock.MarshalizerMock{}
+	logsStorer := testscommon.CreateMemUnit()
+	indexer, _ := NewGovernanceIndexer(marshaller, testscommon.CreateMemUnit(), logsStorer)
+
+	proposalLog := createProposalLog()
+	logBytes, _ := marshaller.Marshal(proposalLog.LogHandler)
+	_ = logsStorer.Put([]byte(proposalLog.TxHash), logBytes)
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte(proposalLog.TxHash)}}}}
+
+	_ = indexer.ProcessLogs(1, []*data.LogData{proposalLog})
+	err := indexer.RevertChanges(&block.MetaBlock{Nonce: 1}, body)
+	require.Nil(t, err)
+
+	// the block replacing the reverted one should be indexed
+	err = indexer.ProcessLogs(1, []*data.LogData{proposalLog})
+	require.Nil(t, err)
+	proposals, _ := indexer.GetProposals()
+	require.Len(t, proposals, 1)
+}
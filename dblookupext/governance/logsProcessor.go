@@ -0,0 +1,274 @@
+package governance
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+)
+
+// identifiers of the events logged by the governance system SC
+const (
+	proposalIdentifier                 = "proposal"
+	parameterProposalIdentifier        = "parameterProposal"
+	voteIdentifier                     = "vote"
+	delegateVoteIdentifier             = "delegateVote"
+	closeProposalIdentifier            = "closeProposal"
+	scheduleParameterChangesIdentifier = "scheduleParameterChanges"
+)
+
+// vote options, as accepted by the governance system SC
+const (
+	yesOption     = "yes"
+	noOption      = "no"
+	vetoOption    = "veto"
+	abstainOption = "abstain"
+)
+
+type logsProcessor struct {
+	records *recordsStorer
+}
+
+func newLogsProcessor(records *recordsStorer) *logsProcessor {
+	return &logsProcessor{
+		records: records,
+	}
+}
+
+// processLogs indexes the events of the provided logs, in the order of their execution. On revert, the logs should be
+// provided in the same order and the events are undone in the reverse order
+func (lp *logsProcessor) processLogs(blockNonce uint64, logs []*data.LogData, isRevert bool) error {
+	shouldProcess, err := lp.shouldProcessBlock(blockNonce, isRevert)
+	if err != nil {
+		return err
+	}
+	if !shouldProcess {
+		return nil
+	}
+
+	if isRevert {
+		err = lp.revertEvents(logs)
+	} else {
+		err = lp.processEvents(logs)
+	}
+	if err != nil {
+		return err
+	}
+
+	// after a revert the previous block becomes the last processed one, so that the block replacing the reverted
+	// one gets indexed and several consecutive blocks can be reverted
+	if isRevert {
+		return lp.records.saveProcessedBlockNonce(blockNonce - 1)
+	}
+
+	return lp.records.saveProcessedBlockNonce(blockNonce)
+}
+
+func (lp *logsProcessor) processEvents(logs []*data.LogData) error {
+	for _, logData := range logs {
+		for _, event := range logData.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			err := lp.processEvent(event, []byte(logData.TxHash), false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (lp *logsProcessor) revertEvents(logs []*data.LogData) error {
+	for i := len(logs) - 1; i >= 0; i-- {
+		events := logs[i].GetLogEvents()
+		for j := len(events) - 1; j >= 0; j-- {
+			if check.IfNil(events[j]) {
+				continue
+			}
+
+			err := lp.processEvent(events[j], []byte(logs[i].TxHash), true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (lp *logsProcessor) shouldProcessBlock(blockNonce uint64, isRevert bool) (bool, error) {
+	processedNonce, err := lp.records.getProcessedBlockNonce()
+	if err != nil {
+		return false, err
+	}
+
+	if isRevert {
+		return blockNonce == processedNonce, nil
+	}
+
+	return blockNonce > processedNonce, nil
+}
+
+func (lp *logsProcessor) processEvent(event data.EventHandler, txHash []byte, isRevert bool) error {
+	topics := event.GetTopics()
+
+	switch string(event.GetIdentifier()) {
+	case proposalIdentifier, parameterProposalIdentifier:
+		if len(topics) < 4 {
+			return nil
+		}
+		return lp.processProposal(event, txHash, isRevert)
+	case voteIdentifier:
+		if len(topics) < 4 {
+			return nil
+		}
+		vote := &Vote{
+			TxHash:      txHash,
+			Voter:       event.GetAddress(),
+			Option:      string(topics[1]),
+			Stake:       big.NewInt(0).SetBytes(topics[2]),
+			VotingPower: big.NewInt(0).SetBytes(topics[3]),
+		}
+		return lp.processVote(bytesToUint64(topics[0]), vote, isRevert)
+	case delegateVoteIdentifier:
+		if len(topics) < 5 {
+			return nil
+		}
+		vote := &Vote{
+			TxHash:      txHash,
+			Voter:       topics[2],
+			Option:      string(topics[1]),
+			Stake:       big.NewInt(0).SetBytes(topics[3]),
+			VotingPower: big.NewInt(0).SetBytes(topics[4]),
+			DelegatedBy: event.GetAddress(),
+		}
+		return lp.processVote(bytesToUint64(topics[0]), vote, isRevert)
+	case closeProposalIdentifier:
+		if len(topics) < 2 {
+			return nil
+		}
+		return lp.processCloseProposal(string(topics[0]), string(topics[1]) == "true", isRevert)
+	case scheduleParameterChangesIdentifier:
+		if len(topics) < 3 {
+			return nil
+		}
+		return lp.processScheduleParameterChanges(bytesToUint64(topics[0]), uint32(bytesToUint64(topics[2])), isRevert)
+	default:
+		return nil
+	}
+}
+
+// processProposal handles the proposal events, having as topics the nonce, the commit hash, the start and end
+// vote epochs and, for the parameter proposals, the pairs of parameter names and values
+func (lp *logsProcessor) processProposal(event data.EventHandler, txHash []byte, isRevert bool) error {
+	topics := event.GetTopics()
+	proposal := &Proposal{
+		Nonce:          bytesToUint64(topics[0]),
+		CommitHash:     string(topics[1]),
+		Issuer:         event.GetAddress(),
+		TxHash:         txHash,
+		StartVoteEpoch: bytesToUint64(topics[2]),
+		EndVoteEpoch:   bytesToUint64(topics[3]),
+		Yes:            big.NewInt(0),
+		No:             big.NewInt(0),
+		Veto:           big.NewInt(0),
+		Abstain:        big.NewInt(0),
+	}
+
+	if isRevert {
+		return lp.records.removeProposal(proposal)
+	}
+
+	changes := topics[4:]
+	if len(changes) > 0 {
+		proposal.ParameterChanges = make(map[string]string, len(changes)/2)
+	}
+	for i := 0; i+1 < len(changes); i += 2 {
+		proposal.ParameterChanges[string(changes[i])] = string(changes[i+1])
+	}
+
+	return lp.records.addProposal(proposal)
+}
+
+func (lp *logsProcessor) processVote(nonce uint64, vote *Vote, isRevert bool) error {
+	proposal, err := lp.records.getProposal(nonce)
+	if err == ErrProposalNotFound {
+		log.Debug("governance index: vote for a proposal created before indexing started", "nonce", nonce)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	index, found, err := lp.records.getVoteIndex(nonce, vote)
+	if err != nil {
+		return err
+	}
+
+	tally := proposal.tallyForOption(vote.Option)
+	if isRevert {
+		if !found {
+			return nil
+		}
+
+		err = lp.records.removeVote(proposal, vote, index)
+		if tally != nil {
+			tally.Sub(tally, vote.VotingPower)
+		}
+	} else {
+		if found {
+			return nil
+		}
+
+		err = lp.records.addVote(proposal, vote)
+		if tally != nil {
+			tally.Add(tally, vote.VotingPower)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return lp.records.saveProposal(proposal)
+}
+
+func (lp *logsProcessor) processCloseProposal(commitHash string, passed bool, isRevert bool) error {
+	nonce, found, err := lp.records.getProposalNonceByCommitHash(commitHash)
+	if err != nil || !found {
+		return err
+	}
+
+	proposal, err := lp.records.getProposal(nonce)
+	if err != nil {
+		return err
+	}
+
+	proposal.Closed = !isRevert
+	proposal.Passed = passed && !isRevert
+
+	return lp.records.saveProposal(proposal)
+}
+
+func (lp *logsProcessor) processScheduleParameterChanges(nonce uint64, executionEpoch uint32, isRevert bool) error {
+	proposal, err := lp.records.getProposal(nonce)
+	if err == ErrProposalNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	proposal.ExecutionEpoch = executionEpoch
+	if isRevert {
+		proposal.ExecutionEpoch = 0
+	}
+
+	return lp.records.saveProposal(proposal)
+}
+
+func bytesToUint64(buff []byte) uint64 {
+	return big.NewInt(0).SetBytes(buff).Uint64()
+}
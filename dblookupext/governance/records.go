@@ -0,0 +1,52 @@
+package governance
+
+import "math/big"
+
+// Proposal holds the indexed data of a governance proposal, as resulted from the governance system SC logs
+type Proposal struct {
+	Nonce            uint64            `json:"nonce"`
+	CommitHash       string            `json:"commitHash"`
+	Issuer           []byte            `json:"issuer"`
+	TxHash           []byte            `json:"txHash"`
+	StartVoteEpoch   uint64            `json:"startVoteEpoch"`
+	EndVoteEpoch     uint64            `json:"endVoteEpoch"`
+	ParameterChanges map[string]string `json:"parameterChanges,omitempty"`
+	Yes              *big.Int          `json:"yes"`
+	No               *big.Int          `json:"no"`
+	Veto             *big.Int          `json:"veto"`
+	Abstain          *big.Int          `json:"abstain"`
+	NumVotes         uint64            `json:"numVotes"`
+	Closed           bool              `json:"closed"`
+	Passed           bool              `json:"passed"`
+	ExecutionEpoch   uint32            `json:"executionEpoch,omitempty"`
+}
+
+// Vote holds an indexed vote cast on a governance proposal. DelegatedBy is set only for the votes cast by
+// a delegation contract on behalf of one of its delegators
+type Vote struct {
+	TxHash      []byte   `json:"txHash"`
+	Voter       []byte   `json:"voter"`
+	Option      string   `json:"option"`
+	Stake       *big.Int `json:"stake"`
+	VotingPower *big.Int `json:"votingPower"`
+	DelegatedBy []byte   `json:"delegatedBy,omitempty"`
+}
+
+type processedBlockNonce struct {
+	Nonce uint64 `json:"nonce"`
+}
+
+func (p *Proposal) tallyForOption(option string) *big.Int {
+	switch option {
+	case yesOption:
+		return p.Yes
+	case noOption:
+		return p.No
+	case vetoOption:
+		return p.Veto
+	case abstainOption:
+		return p.Abstain
+	default:
+		return nil
+	}
+}
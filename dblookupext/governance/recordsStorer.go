@@ -0,0 +1,303 @@
+package governance
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	processedBlockKey = "processed-block"
+	proposalNoncesKey = "proposal-nonces"
+	proposalPrefix    = "proposal-"
+	votesPrefix       = "votes-"
+	voteIndexPrefix   = "vote-index-"
+	commitHashPrefix  = "commit-"
+)
+
+type recordsStorer struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+}
+
+func newRecordsStorer(marshalizer marshal.Marshalizer, storer storage.Storer) *recordsStorer {
+	return &recordsStorer{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+func (rs *recordsStorer) getProcessedBlockNonce() (uint64, error) {
+	processedBlock := &processedBlockNonce{}
+	found, err := rs.get([]byte(processedBlockKey), processedBlock)
+	if err != nil || !found {
+		return 0, err
+	}
+
+	return processedBlock.Nonce, nil
+}
+
+func (rs *recordsStorer) saveProcessedBlockNonce(nonce uint64) error {
+	return rs.put([]byte(processedBlockKey), &processedBlockNonce{Nonce: nonce})
+}
+
+func (rs *recordsStorer) getProposal(nonce uint64) (*Proposal, error) {
+	proposal := &Proposal{}
+	found, err := rs.get(proposalKey(nonce), proposal)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrProposalNotFound
+	}
+
+	return proposal, nil
+}
+
+func (rs *recordsStorer) saveProposal(proposal *Proposal) error {
+	return rs.put(proposalKey(proposal.Nonce), proposal)
+}
+
+// addProposal saves a new proposal, together with the records used to find it by nonce and by commit hash
+func (rs *recordsStorer) addProposal(proposal *Proposal) error {
+	err := rs.saveProposal(proposal)
+	if err != nil {
+		return err
+	}
+
+	nonceBytes := big.NewInt(0).SetUint64(proposal.Nonce).Bytes()
+	err = rs.storer.Put(commitHashKey(proposal.CommitHash), nonceBytes)
+	if err != nil {
+		return err
+	}
+
+	nonces, err := rs.getProposalNonces()
+	if err != nil {
+		return err
+	}
+	for _, nonce := range nonces {
+		if nonce == proposal.Nonce {
+			return nil
+		}
+	}
+
+	nonces = append(nonces, proposal.Nonce)
+	sort.Slice(nonces, func(i, j int) bool {
+		return nonces[i] < nonces[j]
+	})
+
+	return rs.put([]byte(proposalNoncesKey), nonces)
+}
+
+// removeProposal removes all the records of a proposal, including its votes
+func (rs *recordsStorer) removeProposal(proposal *Proposal) error {
+	savedProposal, err := rs.getProposal(proposal.Nonce)
+	if err == ErrProposalNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for index := savedProposal.NumVotes; index > 0; index-- {
+		err = rs.removeLastVote(savedProposal)
+		if err != nil {
+			return err
+		}
+	}
+
+	nonces, err := rs.getProposalNonces()
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]uint64, 0, len(nonces))
+	for _, nonce := range nonces {
+		if nonce != proposal.Nonce {
+			remaining = append(remaining, nonce)
+		}
+	}
+
+	err = rs.put([]byte(proposalNoncesKey), remaining)
+	if err != nil {
+		return err
+	}
+
+	err = rs.storer.Remove(commitHashKey(proposal.CommitHash))
+	if err != nil {
+		return err
+	}
+
+	return rs.storer.Remove(proposalKey(proposal.Nonce))
+}
+
+func (rs *recordsStorer) getProposalNonces() ([]uint64, error) {
+	nonces := make([]uint64, 0)
+	_, err := rs.get([]byte(proposalNoncesKey), &nonces)
+	if err != nil {
+		return nil, err
+	}
+
+	return nonces, nil
+}
+
+func (rs *recordsStorer) getProposalNonceByCommitHash(commitHash string) (uint64, bool, error) {
+	nonceBytes, err := rs.storer.Get(commitHashKey(commitHash))
+	if err == storage.ErrKeyNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return big.NewInt(0).SetBytes(nonceBytes).Uint64(), true, nil
+}
+
+// getVotes returns the votes of the provided proposal, in the order they were indexed
+func (rs *recordsStorer) getVotes(proposal *Proposal) ([]*Vote, error) {
+	votes := make([]*Vote, 0, proposal.NumVotes)
+	for index := uint64(0); index < proposal.NumVotes; index++ {
+		vote, err := rs.getVote(proposal.Nonce, index)
+		if err != nil {
+			return nil, err
+		}
+
+		votes = append(votes, vote)
+	}
+
+	return votes, nil
+}
+
+func (rs *recordsStorer) getVote(nonce uint64, index uint64) (*Vote, error) {
+	vote := &Vote{}
+	found, err := rs.get(voteKey(nonce, index), vote)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrVoteNotFound
+	}
+
+	return vote, nil
+}
+
+func (rs *recordsStorer) getVoteIndex(nonce uint64, vote *Vote) (uint64, bool, error) {
+	indexBytes, err := rs.storer.Get(voteIndexKey(nonce, vote))
+	if err == storage.ErrKeyNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return big.NewInt(0).SetBytes(indexBytes).Uint64(), true, nil
+}
+
+// addVote saves the vote under its own key, after the already indexed votes of the proposal, so that indexing a vote
+// does not depend on the number of votes. The caller should save the proposal, as its number of votes is incremented
+func (rs *recordsStorer) addVote(proposal *Proposal, vote *Vote) error {
+	err := rs.putVote(proposal.Nonce, proposal.NumVotes, vote)
+	if err != nil {
+		return err
+	}
+
+	proposal.NumVotes++
+
+	return nil
+}
+
+// removeVote removes the provided vote, the last indexed vote taking its place. As the events of a block are reverted
+// in the reverse order, the removed vote is usually the last one. The caller should save the proposal, as its number
+// of votes is decremented
+func (rs *recordsStorer) removeVote(proposal *Proposal, vote *Vote, index uint64) error {
+	lastIndex := proposal.NumVotes - 1
+	if index != lastIndex {
+		lastVote, err := rs.getVote(proposal.Nonce, lastIndex)
+		if err != nil {
+			return err
+		}
+
+		err = rs.putVote(proposal.Nonce, index, lastVote)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := rs.storer.Remove(voteIndexKey(proposal.Nonce, vote))
+	if err != nil {
+		return err
+	}
+
+	err = rs.storer.Remove(voteKey(proposal.Nonce, lastIndex))
+	if err != nil {
+		return err
+	}
+
+	proposal.NumVotes--
+
+	return nil
+}
+
+func (rs *recordsStorer) removeLastVote(proposal *Proposal) error {
+	lastIndex := proposal.NumVotes - 1
+	lastVote, err := rs.getVote(proposal.Nonce, lastIndex)
+	if err != nil {
+		return err
+	}
+
+	return rs.removeVote(proposal, lastVote, lastIndex)
+}
+
+func (rs *recordsStorer) putVote(nonce uint64, index uint64, vote *Vote) error {
+	err := rs.put(voteKey(nonce, index), vote)
+	if err != nil {
+		return err
+	}
+
+	indexBytes := big.NewInt(0).SetUint64(index).Bytes()
+
+	return rs.storer.Put(voteIndexKey(nonce, vote), indexBytes)
+}
+
+func (rs *recordsStorer) get(key []byte, obj interface{}) (bool, error) {
+	buff, err := rs.storer.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, rs.marshalizer.Unmarshal(obj, buff)
+}
+
+func (rs *recordsStorer) put(key []byte, obj interface{}) error {
+	buff, err := rs.marshalizer.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return rs.storer.Put(key, buff)
+}
+
+func proposalKey(nonce uint64) []byte {
+	return []byte(proposalPrefix + strconv.FormatUint(nonce, 10))
+}
+
+func voteKey(nonce uint64, index uint64) []byte {
+	return []byte(votesPrefix + strconv.FormatUint(nonce, 10) + "-" + strconv.FormatUint(index, 10))
+}
+
+// voteIndexKey identifies a vote by its transaction, voter and delegation contract
+func voteIndexKey(nonce uint64, vote *Vote) []byte {
+	return []byte(voteIndexPrefix + strconv.FormatUint(nonce, 10) + "-" + hex.EncodeToString(vote.TxHash) + "-" +
+		hex.EncodeToString(vote.Voter) + "-" + hex.EncodeToString(vote.DelegatedBy))
+}
+
+func commitHashKey(commitHash string) []byte {
+	return []byte(commitHashPrefix + commitHash)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common/logging"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -38,6 +39,7 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	GovernanceIndexHandler      GovernanceIndexHandler
 }
 
 type historyRepository struct {
@@ -51,6 +53,7 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	governanceIndexHandler     GovernanceIndexHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -94,6 +97,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
+	if check.IfNil(arguments.GovernanceIndexHandler) {
+		return nil, errNilGovernanceIndexHandler
+	}
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
@@ -117,6 +123,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
+		governanceIndexHandler:                       arguments.GovernanceIndexHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
 }
@@ -178,6 +185,11 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.governanceIndexHandler.ProcessLogs(blockHeader.GetNonce(), logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -480,7 +492,12 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	return hr.governanceIndexHandler.RevertChanges(blockHeader, blockBody)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +505,21 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetGovernanceProposals will return all the governance proposals from the index
+func (hr *historyRepository) GetGovernanceProposals() ([]*governance.Proposal, error) {
+	return hr.governanceIndexHandler.GetProposals()
+}
+
+// GetGovernanceProposal will return the governance proposal with the given nonce from the index
+func (hr *historyRepository) GetGovernanceProposal(nonce uint64) (*governance.Proposal, error) {
+	return hr.governanceIndexHandler.GetProposal(nonce)
+}
+
+// GetGovernanceProposalVotes will return the votes cast on the governance proposal with the given nonce
+func (hr *historyRepository) GetGovernanceProposalVotes(nonce uint64) ([]*governance.Vote, error) {
+	return hr.governanceIndexHandler.GetProposalVotes(nonce)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/common/mock"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -26,6 +27,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 			return nil, storage.ErrKeyNotFound
 		},
 	}, &storageStubs.StorerStub{})
+	gi, _ := governance.NewGovernanceIndexer(&mock.MarshalizerMock{}, genericMocks.NewStorerMockWithErrKeyNotFound(epoch), &storageStubs.StorerStub{})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -37,6 +39,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
+		GovernanceIndexHandler:      gi,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
 	}
 
@@ -88,6 +91,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.GovernanceIndexHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilGovernanceIndexHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
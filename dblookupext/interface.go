@@ -4,6 +4,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -27,6 +28,9 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetGovernanceProposals() ([]*governance.Proposal, error)
+	GetGovernanceProposal(nonce uint64) (*governance.Proposal, error)
+	GetGovernanceProposalVotes(nonce uint64) ([]*governance.Vote, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -47,3 +51,13 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// GovernanceIndexHandler defines the interface of a component indexing the governance proposals and votes
+type GovernanceIndexHandler interface {
+	ProcessLogs(blockNonce uint64, logs []*data.LogData) error
+	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
+	GetProposals() ([]*governance.Proposal, error)
+	GetProposal(nonce uint64) (*governance.Proposal, error)
+	GetProposalVotes(nonce uint64) ([]*governance.Vote, error)
+	IsInterfaceNil() bool
+}
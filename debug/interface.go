@@ -3,6 +3,10 @@ package debug
 import (
 	"bytes"
 	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
 )
 
 // QueryHandler defines the behavior of a queryable debug handler
@@ -27,3 +31,14 @@ type GoRoutineHandler interface {
 	FirstOccurrence() time.Time
 	StackTrace() string
 }
+
+// P2PMessageTracer defines the behavior of a component able to trace the p2p messages processed by the interceptors
+// and to provide the recorded traces
+type P2PMessageTracer interface {
+	TraceMessage(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error)
+	GetTraces() []*common.P2PMessageTrace
+	Subscribe() (uint64, <-chan *common.P2PMessageTrace)
+	Unsubscribe(id uint64)
+	Close() error
+	IsInterfaceNil() bool
+}
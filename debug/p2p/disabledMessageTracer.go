@@ -0,0 +1,25 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+type disabledMessageTracer struct {
+}
+
+// NewDisabledMessageTracer returns a message tracer that does not record anything
+func NewDisabledMessageTracer() *disabledMessageTracer {
+	return &disabledMessageTracer{}
+}
+
+// TraceMessage does nothing
+func (dmt *disabledMessageTracer) TraceMessage(_ string, _ p2p.MessageP2P, _ core.PeerID, _ time.Time, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dmt *disabledMessageTracer) IsInterfaceNil() bool {
+	return dmt == nil
+}
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 )
@@ -39,3 +40,7 @@ func (pd *p2pDebugger) GetClonedMetric(topic string) *metric {
 
 	return &clonedMetric
 }
+
+func (mt *messageTracer) SetTimeHandler(handler func() time.Time) {
+	mt.getTimeHandler = handler
+}
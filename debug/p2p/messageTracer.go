@@ -0,0 +1,290 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+const (
+	minSamplingRate     = 1
+	minCacheSize        = 1
+	minStreamBufferSize = 1
+	wildcardCharacter   = "*"
+
+	// TraceResultAccepted is the result of a traced message that was accepted by the interceptor
+	TraceResultAccepted = "accepted"
+	// TraceResultRejected is the result of a traced message that was rejected by the interceptor
+	TraceResultRejected = "rejected"
+)
+
+type messageTracer struct {
+	samplingRate     uint32
+	topics           []string
+	cacheSize        int
+	streamBufferSize int
+
+	mutTraces sync.RWMutex
+	traces    []*common.P2PMessageTrace
+	nextIndex int
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[uint64]chan *common.P2PMessageTrace
+	lastSubscriber uint64
+	isClosed       bool
+
+	isDumpEnabled bool
+	mutDump       sync.Mutex
+	dumpWriter    io.WriteCloser
+
+	getTimeHandler func() time.Time
+}
+
+// NewMessageTracer creates a new p2p message tracer based on the provided config
+func NewMessageTracer(cfg config.P2PMessageTracingDebugConfig) (*messageTracer, error) {
+	err := checkMessageTracerConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mt := &messageTracer{
+		samplingRate:     cfg.SamplingRate,
+		topics:           cfg.Topics,
+		cacheSize:        cfg.CacheSize,
+		streamBufferSize: cfg.StreamBufferSize,
+		traces:           make([]*common.P2PMessageTrace, 0, cfg.CacheSize),
+		subscribers:      make(map[uint64]chan *common.P2PMessageTrace),
+		getTimeHandler:   time.Now,
+	}
+
+	if len(cfg.DumpFilePath) > 0 {
+		mt.dumpWriter, err = os.OpenFile(cfg.DumpFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, core.FileModeUserReadWrite)
+		if err != nil {
+			return nil, fmt.Errorf("%w while opening the p2p message traces dump file", err)
+		}
+		mt.isDumpEnabled = true
+	}
+
+	return mt, nil
+}
+
+func checkMessageTracerConfig(cfg config.P2PMessageTracingDebugConfig) error {
+	if cfg.SamplingRate < minSamplingRate {
+		return fmt.Errorf("%w for SamplingRate, provided %d, minimum %d", debug.ErrInvalidValue, cfg.SamplingRate, minSamplingRate)
+	}
+	if cfg.CacheSize < minCacheSize {
+		return fmt.Errorf("%w for CacheSize, provided %d, minimum %d", debug.ErrInvalidValue, cfg.CacheSize, minCacheSize)
+	}
+	if cfg.StreamBufferSize < minStreamBufferSize {
+		return fmt.Errorf("%w for StreamBufferSize, provided %d, minimum %d", debug.ErrInvalidValue, cfg.StreamBufferSize, minStreamBufferSize)
+	}
+
+	return nil
+}
+
+// TraceMessage records the provided message if it was sampled. The sampling is deterministic, based on the message
+// originator and sequence number, so that different nodes trace the same messages
+func (mt *messageTracer) TraceMessage(
+	topic string,
+	message p2p.MessageP2P,
+	fromConnectedPeer core.PeerID,
+	arrivalTime time.Time,
+	err error,
+) {
+	if message == nil {
+		return
+	}
+	if !mt.isTopicTraced(topic) || !mt.isSampled(message) {
+		return
+	}
+
+	trace := &common.P2PMessageTrace{
+		Topic:              topic,
+		Originator:         message.Peer().Pretty(),
+		FromConnectedPeer:  fromConnectedPeer.Pretty(),
+		SeqNo:              hex.EncodeToString(message.SeqNo()),
+		Size:               uint64(len(message.Data())),
+		SentTimestamp:      message.Timestamp(),
+		ArrivalTimestamp:   arrivalTime.UnixMilli(),
+		ProcessingDuration: mt.getTimeHandler().Sub(arrivalTime).Microseconds(),
+		Result:             TraceResultAccepted,
+	}
+	if err != nil {
+		trace.Result = TraceResultRejected
+		trace.Error = err.Error()
+	}
+
+	mt.addTrace(trace)
+	mt.notifySubscribers(trace)
+	mt.dumpTrace(trace)
+}
+
+func (mt *messageTracer) isTopicTraced(topic string) bool {
+	if len(mt.topics) == 0 {
+		return true
+	}
+
+	for _, t := range mt.topics {
+		if t == topic {
+			return true
+		}
+		if !strings.Contains(t, wildcardCharacter) {
+			continue
+		}
+
+		topicWithoutWildcard := strings.Replace(t, wildcardCharacter, "", 1)
+		if strings.Contains(topic, topicWithoutWildcard) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (mt *messageTracer) isSampled(message p2p.MessageP2P) bool {
+	if mt.samplingRate == minSamplingRate {
+		return true
+	}
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(message.From())
+	_, _ = hasher.Write(message.SeqNo())
+
+	return hasher.Sum32()%mt.samplingRate == 0
+}
+
+func (mt *messageTracer) addTrace(trace *common.P2PMessageTrace) {
+	mt.mutTraces.Lock()
+	defer mt.mutTraces.Unlock()
+
+	if len(mt.traces) < mt.cacheSize {
+		mt.traces = append(mt.traces, trace)
+		return
+	}
+
+	mt.traces[mt.nextIndex] = trace
+	mt.nextIndex = (mt.nextIndex + 1) % mt.cacheSize
+}
+
+func (mt *messageTracer) notifySubscribers(trace *common.P2PMessageTrace) {
+	mt.mutSubscribers.RLock()
+	defer mt.mutSubscribers.RUnlock()
+
+	for _, ch := range mt.subscribers {
+		select {
+		case ch <- trace:
+		default:
+			// slow subscriber, the trace is dropped
+		}
+	}
+}
+
+func (mt *messageTracer) dumpTrace(trace *common.P2PMessageTrace) {
+	if !mt.isDumpEnabled {
+		return
+	}
+
+	buff, err := json.Marshal(trace)
+	if err != nil {
+		log.Debug("messageTracer.dumpTrace: can not marshal trace", "error", err)
+		return
+	}
+
+	mt.mutDump.Lock()
+	defer mt.mutDump.Unlock()
+
+	if mt.dumpWriter == nil {
+		return
+	}
+
+	_, err = mt.dumpWriter.Write(append(buff, '\n'))
+	if err != nil {
+		log.Debug("messageTracer.dumpTrace: can not write trace", "error", err)
+	}
+}
+
+// GetTraces returns the latest recorded traces, the oldest one being first
+func (mt *messageTracer) GetTraces() []*common.P2PMessageTrace {
+	mt.mutTraces.RLock()
+	defer mt.mutTraces.RUnlock()
+
+	traces := make([]*common.P2PMessageTrace, 0, len(mt.traces))
+	for i := 0; i < len(mt.traces); i++ {
+		trace := *mt.traces[(mt.nextIndex+i)%len(mt.traces)]
+		traces = append(traces, &trace)
+	}
+
+	return traces
+}
+
+// Subscribe returns a channel on which all new traces will be pushed and the subscription ID that should be used
+// when unsubscribing. The channel is closed on unsubscribe or when the tracer is closed
+func (mt *messageTracer) Subscribe() (uint64, <-chan *common.P2PMessageTrace) {
+	mt.mutSubscribers.Lock()
+	defer mt.mutSubscribers.Unlock()
+
+	ch := make(chan *common.P2PMessageTrace, mt.streamBufferSize)
+	if mt.isClosed {
+		close(ch)
+		return 0, ch
+	}
+
+	mt.lastSubscriber++
+	mt.subscribers[mt.lastSubscriber] = ch
+
+	return mt.lastSubscriber, ch
+}
+
+// Unsubscribe removes the subscription with the provided ID, closing its channel
+func (mt *messageTracer) Unsubscribe(id uint64) {
+	mt.mutSubscribers.Lock()
+	defer mt.mutSubscribers.Unlock()
+
+	ch, ok := mt.subscribers[id]
+	if !ok {
+		return
+	}
+
+	delete(mt.subscribers, id)
+	close(ch)
+}
+
+// Close closes all subscriptions and the dump file, if opened
+func (mt *messageTracer) Close() error {
+	mt.mutSubscribers.Lock()
+	for id, ch := range mt.subscribers {
+		delete(mt.subscribers, id)
+		close(ch)
+	}
+	mt.isClosed = true
+	mt.mutSubscribers.Unlock()
+
+	mt.mutDump.Lock()
+	defer mt.mutDump.Unlock()
+
+	if mt.dumpWriter == nil {
+		return nil
+	}
+
+	err := mt.dumpWriter.Close()
+	mt.dumpWriter = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mt *messageTracer) IsInterfaceNil() bool {
+	return mt == nil
+}
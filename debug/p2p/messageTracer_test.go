@@ -0,0 +1,280 @@
+package p2p
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockMessageTracerConfig() config.P2PMessageTracingDebugConfig {
+	return config.P2PMessageTracingDebugConfig{
+		Enabled:          true,
+		SamplingRate:     1,
+		CacheSize:        10,
+		StreamBufferSize: 10,
+	}
+}
+
+func createMockMessage(seqNo int) *p2pmocks.P2PMessageMock {
+	return &p2pmocks.P2PMessageMock{
+		FromField:      []byte("from"),
+		DataField:      []byte("data"),
+		SeqNoField:     []byte(fmt.Sprintf("%d", seqNo)),
+		PeerField:      "originator",
+		TimestampField: 1000,
+	}
+}
+
+func TestNewMessageTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid sampling rate should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockMessageTracerConfig()
+		cfg.SamplingRate = 0
+		mt, err := NewMessageTracer(cfg)
+		assert.True(t, check.IfNil(mt))
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "SamplingRate"))
+	})
+	t.Run("invalid cache size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockMessageTracerConfig()
+		cfg.CacheSize = 0
+		mt, err := NewMessageTracer(cfg)
+		assert.True(t, check.IfNil(mt))
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "CacheSize"))
+	})
+	t.Run("invalid stream buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockMessageTracerConfig()
+		cfg.StreamBufferSize = 0
+		mt, err := NewMessageTracer(cfg)
+		assert.True(t, check.IfNil(mt))
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "StreamBufferSize"))
+	})
+	t.Run("invalid dump file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockMessageTracerConfig()
+		cfg.DumpFilePath = filepath.Join(t.TempDir(), "missing directory", "traces.json")
+		mt, err := NewMessageTracer(cfg)
+		assert.True(t, check.IfNil(mt))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mt, err := NewMessageTracer(createMockMessageTracerConfig())
+		assert.False(t, check.IfNil(mt))
+		assert.Nil(t, err)
+		assert.Nil(t, mt.Close())
+	})
+}
+
+func TestMessageTracer_TraceMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil message should not trace", func(t *testing.T) {
+		t.Parallel()
+
+		mt, _ := NewMessageTracer(createMockMessageTracerConfig())
+		mt.TraceMessage("topic", nil, "pid", time.Now(), nil)
+		assert.Equal(t, 0, len(mt.GetTraces()))
+	})
+	t.Run("should record the message details", func(t *testing.T) {
+		t.Parallel()
+
+		mt, _ := NewMessageTracer(createMockMessageTracerConfig())
+		arrivalTime := time.Unix(10, 0)
+		mt.SetTimeHandler(func() time.Time {
+			return arrivalTime.Add(time.Millisecond * 3)
+		})
+
+		expectedErr := errors.New("expected error")
+		mt.TraceMessage("topic", createMockMessage(0), "pid", arrivalTime, nil)
+		mt.TraceMessage("topic", createMockMessage(1), "pid", arrivalTime, expectedErr)
+
+		traces := mt.GetTraces()
+		require.Equal(t, 2, len(traces))
+		expectedTrace := &common.P2PMessageTrace{
+			Topic:              "topic",
+			Originator:         core.PeerID("originator").Pretty(),
+			FromConnectedPeer:  core.PeerID("pid").Pretty(),
+			SeqNo:              "30",
+			Size:               4,
+			SentTimestamp:      1000,
+			ArrivalTimestamp:   10000,
+			ProcessingDuration: 3000,
+			Result:             TraceResultAccepted,
+		}
+		assert.Equal(t, expectedTrace, traces[0])
+		assert.Equal(t, TraceResultRejected, traces[1].Result)
+		assert.Equal(t, expectedErr.Error(), traces[1].Error)
+	})
+	t.Run("should only trace the configured topics", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockMessageTracerConfig()
+		cfg.Topics = []string{"transactions_*", "shardBlocks_0_META"}
+		mt, _ := NewMessageTracer(cfg)
+
+		mt.TraceMessage("transactions_0_1", createMockMessage(0), "pid", time.Now(), nil)
+		mt.TraceMessage("shardBlocks_0_META", createMockMessage(1), "pid", time.Now(), nil)
+		mt.TraceMessage("shardBlocks_1_META", createMockMessage(2), "pid", time.Now(), nil)
+		mt.TraceMessage("unsignedTransactions_0", createMockMessage(3), "pid", time.Now(), nil)
+
+		traces := mt.GetTraces()
+		require.Equal(t, 2, len(traces))
+		assert.Equal(t, "transactions_0_1", traces[0].Topic)
+		assert.Equal(t, "shardBlocks_0_META", traces[1].Topic)
+	})
+	t.Run("should sample messages deterministically", func(t *testing.T) {
+		t.Parallel()
+
+		numMessages := 1000
+		cfg := createMockMessageTracerConfig()
+		cfg.SamplingRate = 10
+		cfg.CacheSize = numMessages
+		mt1, _ := NewMessageTracer(cfg)
+		mt2, _ := NewMessageTracer(cfg)
+
+		for i := 0; i < numMessages; i++ {
+			mt1.TraceMessage("topic", createMockMessage(i), "pid1", time.Now(), nil)
+			mt2.TraceMessage("topic", createMockMessage(i), "pid2", time.Now(), nil)
+		}
+
+		traces1 := mt1.GetTraces()
+		traces2 := mt2.GetTraces()
+		assert.True(t, len(traces1) > 0)
+		assert.True(t, len(traces1) < numMessages)
+		require.Equal(t, len(traces1), len(traces2))
+		for i := range traces1 {
+			assert.Equal(t, traces1[i].SeqNo, traces2[i].SeqNo)
+		}
+	})
+}
+
+func TestMessageTracer_GetTracesShouldReturnLatestTracesInOrder(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockMessageTracerConfig()
+	cfg.CacheSize = 3
+	mt, _ := NewMessageTracer(cfg)
+
+	for i := 0; i < 5; i++ {
+		mt.TraceMessage(fmt.Sprintf("topic%d", i), createMockMessage(i), "pid", time.Now(), nil)
+	}
+
+	traces := mt.GetTraces()
+	require.Equal(t, 3, len(traces))
+	assert.Equal(t, "topic2", traces[0].Topic)
+	assert.Equal(t, "topic3", traces[1].Topic)
+	assert.Equal(t, "topic4", traces[2].Topic)
+}
+
+func TestMessageTracer_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("subscribers should receive the new traces", func(t *testing.T) {
+		t.Parallel()
+
+		mt, _ := NewMessageTracer(createMockMessageTracerConfig())
+		id, chTraces := mt.Subscribe()
+
+		mt.TraceMessage("topic", createMockMessage(0), "pid", time.Now(), nil)
+
+		select {
+		case trace := <-chTraces:
+			assert.Equal(t, "topic", trace.Topic)
+		case <-time.After(time.Second):
+			assert.Fail(t, "timeout while waiting for the trace")
+		}
+
+		mt.Unsubscribe(id)
+		_, ok := <-chTraces
+		assert.False(t, ok)
+
+		mt.TraceMessage("topic", createMockMessage(1), "pid", time.Now(), nil)
+		mt.Unsubscribe(id)
+	})
+	t.Run("slow subscribers should not block tracing", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockMessageTracerConfig()
+		cfg.StreamBufferSize = 1
+		mt, _ := NewMessageTracer(cfg)
+		_, chTraces := mt.Subscribe()
+
+		for i := 0; i < 5; i++ {
+			mt.TraceMessage("topic", createMockMessage(i), "pid", time.Now(), nil)
+		}
+
+		assert.Equal(t, 1, len(chTraces))
+		assert.Equal(t, 5, len(mt.GetTraces()))
+	})
+	t.Run("close should close all subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		mt, _ := NewMessageTracer(createMockMessageTracerConfig())
+		_, chTraces1 := mt.Subscribe()
+		_, chTraces2 := mt.Subscribe()
+
+		assert.Nil(t, mt.Close())
+
+		_, ok := <-chTraces1
+		assert.False(t, ok)
+		_, ok = <-chTraces2
+		assert.False(t, ok)
+
+		_, chTraces3 := mt.Subscribe()
+		_, ok = <-chTraces3
+		assert.False(t, ok)
+	})
+}
+
+func TestMessageTracer_DumpFile(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockMessageTracerConfig()
+	cfg.DumpFilePath = filepath.Join(t.TempDir(), "traces.json")
+	mt, err := NewMessageTracer(cfg)
+	require.Nil(t, err)
+
+	mt.TraceMessage("topic0", createMockMessage(0), "pid", time.Now(), nil)
+	mt.TraceMessage("topic1", createMockMessage(1), "pid", time.Now(), nil)
+	require.Nil(t, mt.Close())
+
+	mt.TraceMessage("topic2", createMockMessage(2), "pid", time.Now(), nil)
+
+	buff, err := os.ReadFile(cfg.DumpFilePath)
+	require.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(buff)), "\n")
+	require.Equal(t, 2, len(lines))
+	for i, line := range lines {
+		trace := &common.P2PMessageTrace{}
+		err = json.Unmarshal([]byte(line), trace)
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("topic%d", i), trace.Topic)
+	}
+}
@@ -142,6 +142,11 @@ func (sdp *stakingDataProvider) GetNodeStakedTopUp(blsKey []byte) (*big.Int, err
 func (sdp *stakingDataProvider) PrepareStakingData(validatorsMap state.ShardValidatorsInfoMapHandler) error {
 	sdp.Clean()
 
+	err := sdp.loadNodePrice()
+	if err != nil {
+		return err
+	}
+
 	for _, validator := range validatorsMap.GetAllValidatorsInfo() {
 		err := sdp.loadDataForBlsKey(validator)
 		if err != nil {
@@ -377,6 +382,47 @@ func (sdp *stakingDataProvider) checkAndFillOwnerValidatorAuctionData(
 	return nil
 }
 
+// loadNodePrice refreshes the node price from the validator system smart contract, as it can be changed through
+// a governance parameter proposal
+func (sdp *stakingDataProvider) loadNodePrice() error {
+	if !sdp.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		return nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  vm.EndOfEpochAddress,
+			CallValue:   big.NewInt(0),
+			GasProvided: math.MaxInt64,
+			Arguments:   make([][]byte, 0),
+		},
+		RecipientAddr: vm.ValidatorSCAddress,
+		Function:      "getNodePrice",
+	}
+
+	vmOutput, err := sdp.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w, error: %v message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if len(vmOutput.ReturnData) != 1 {
+		return fmt.Errorf("%w, getNodePrice function should have returned exactly one value", epochStart.ErrExecutingSystemScCode)
+	}
+
+	nodePrice := big.NewInt(0).SetBytes(vmOutput.ReturnData[0])
+	if nodePrice.Cmp(big.NewInt(0)) <= 0 {
+		return epochStart.ErrInvalidMinNodePrice
+	}
+
+	sdp.mutStakingData.Lock()
+	sdp.minNodePrice = nodePrice
+	sdp.mutStakingData.Unlock()
+
+	return nil
+}
+
 func (sdp *stakingDataProvider) getOwnerInfoFromSC(owner string) (*ownerInfoSC, error) {
 	ownerAddressBytes := []byte(owner)
 
@@ -463,6 +463,43 @@ func TestStakingDataProvider_PrepareStakingDataForRewards(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestStakingDataProvider_PrepareStakingDataWithGovernanceNodePrice(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	governanceNodePrice := big.NewInt(2000)
+	totalStaked := big.NewInt(5000)
+	args := createStakingDataProviderArgs()
+	args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceParameterChangesFlag)
+	nodePriceError := error(nil)
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			switch input.Function {
+			case "getNodePrice":
+				require.Equal(t, vm.ValidatorSCAddress, input.RecipientAddr)
+				return &vmcommon.VMOutput{ReturnData: [][]byte{governanceNodePrice.Bytes()}}, nodePriceError
+			case "getOwner":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{owner}}, nil
+			case "getTotalStakedTopUpStakedBlsKeys":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(1000).Bytes(), totalStaked.Bytes(), big.NewInt(2).Bytes()}}, nil
+			}
+			return nil, errors.New("unexpected call")
+		},
+	}
+	sdp, _ := NewStakingDataProvider(args)
+
+	validatorsMap := state.NewShardValidatorsInfoMap()
+	_ = validatorsMap.Add(&state.ValidatorInfo{PublicKey: []byte("blsKey"), ShardId: 0, List: string(common.EligibleList)})
+	err := sdp.PrepareStakingData(validatorsMap)
+	require.Nil(t, err)
+	require.Equal(t, governanceNodePrice, sdp.minNodePrice)
+	require.Equal(t, big.NewInt(500), sdp.GetTotalTopUpStakeEligibleNodes())
+
+	nodePriceError = errors.New("node price error")
+	err = sdp.PrepareStakingData(validatorsMap)
+	require.Equal(t, nodePriceError, err)
+}
+
 func TestStakingDataProvider_FillValidatorInfo(t *testing.T) {
 	t.Parallel()
 
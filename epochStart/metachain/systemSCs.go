@@ -1,6 +1,7 @@
 package metachain
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 
@@ -75,6 +76,7 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		common.StakingV2Flag,
 		common.ESDTFlagInSpecificEpochOnly,
 		common.GovernanceFlag,
+		common.GovernanceParameterChangesFlag,
 		common.SaveJailedAlwaysFlag,
 		common.StakingV4Step1Flag,
 		common.StakingV4Step2Flag,
@@ -136,6 +138,13 @@ func (s *systemSCProcessor) processWithNewFlags(
 		}
 	}
 
+	if s.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		err := s.executeGovernanceParameterChanges(header.GetEpoch())
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.enableEpochsHandler.IsFlagEnabled(common.StakingV4Step1Flag) {
 		err := s.unStakeAllNodesFromQueue()
 		if err != nil {
@@ -250,6 +259,57 @@ func (s *systemSCProcessor) updateToGovernanceV2() error {
 	return nil
 }
 
+func (s *systemSCProcessor) executeGovernanceParameterChanges(epoch uint32) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.EndOfEpochAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{big.NewInt(int64(epoch)).Bytes()},
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "executePendingParameterChanges",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return fmt.Errorf("%w when executing governance parameter changes", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("got return code %s when executing governance parameter changes", vmOutput.ReturnCode)
+	}
+
+	return s.processSCOutputAccounts(vmOutput)
+}
+
+// GetEconomicsParameters returns the economics parameters changed through governance, as pairs of name and value
+// joined by the economics parameters separator. The result is empty if no economics parameter was changed
+func (s *systemSCProcessor) GetEconomicsParameters() ([]byte, error) {
+	if !s.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		return nil, nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.GovernanceSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  make([][]byte, 0),
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "viewEconomicsParameters",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return nil, fmt.Errorf("%w when getting the governance economics parameters", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("got return code %s when getting the governance economics parameters", vmOutput.ReturnCode)
+	}
+	if len(vmOutput.ReturnData) == 0 {
+		return nil, nil
+	}
+
+	return bytes.Join(vmOutput.ReturnData, []byte(common.EconomicsParametersSeparator)), nil
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (s *systemSCProcessor) IsInterfaceNil() bool {
 	return s == nil
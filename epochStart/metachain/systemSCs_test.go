@@ -34,6 +34,7 @@ import (
 	metaProcess "github.com/multiversx/mx-chain-go/process/factory/metachain"
 	"github.com/multiversx/mx-chain-go/process/peer"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
 	disabledState "github.com/multiversx/mx-chain-go/state/disabled"
@@ -841,6 +842,7 @@ func createFullArgumentsForSystemSCProcessing(enableEpochsConfig config.EnableEp
 		GasSchedule:              gasScheduleNotifier,
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	defaults.FillGasMapInternal(gasSchedule, 1)
@@ -987,6 +989,7 @@ func TestSystemSCProcessor_ProcessSystemSmartContractInitDelegationMgr(t *testin
 		args.EnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
 			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
 				if flag == common.GovernanceFlagInSpecificEpochOnly ||
+					flag == common.GovernanceParameterChangesFlag ||
 					flag == common.StakingV4Step1Flag ||
 					flag == common.StakingV4Step2Flag ||
 					flag == common.SwitchHysteresisForMinNodesFlagInSpecificEpochOnly ||
@@ -1075,6 +1078,7 @@ func TestSystemSCProcessor_ProcessSystemSmartContractInitGovernance(t *testing.T
 		args.EnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
 			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
 				if flag == common.GovernanceFlagInSpecificEpochOnly ||
+					flag == common.GovernanceParameterChangesFlag ||
 					flag == common.StakingV4Step1Flag ||
 					flag == common.StakingV4Step2Flag ||
 					flag == common.SwitchHysteresisForMinNodesFlagInSpecificEpochOnly ||
@@ -1155,6 +1159,137 @@ func TestSystemSCProcessor_ProcessSystemSmartContractInitGovernance(t *testing.T
 	})
 }
 
+func TestSystemSCProcessor_ProcessSystemSmartContractGovernanceParameterChanges(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("flag active", func(t *testing.T) {
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == common.GovernanceParameterChangesFlag
+			},
+		}
+		runSmartContractCallCalled := false
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				runSmartContractCallCalled = true
+				require.Equal(t, vm.EndOfEpochAddress, input.CallerAddr)
+				require.Equal(t, vm.GovernanceSCAddress, input.RecipientAddr)
+				require.Equal(t, "executePendingParameterChanges", input.Function)
+				require.Equal(t, [][]byte{big.NewInt(37).Bytes()}, input.Arguments)
+
+				return &vmcommon.VMOutput{}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+		require.NotNil(t, processor)
+
+		validatorsInfo := state.NewShardValidatorsInfoMap()
+		err := processor.ProcessSystemSmartContract(validatorsInfo, &block.Header{Epoch: 37})
+		require.Nil(t, err)
+		require.True(t, runSmartContractCallCalled)
+	})
+	t.Run("contract call errors, should error", func(t *testing.T) {
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == common.GovernanceParameterChangesFlag
+			},
+		}
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+		require.NotNil(t, processor)
+
+		validatorsInfo := state.NewShardValidatorsInfoMap()
+		err := processor.ProcessSystemSmartContract(validatorsInfo, &block.Header{})
+		require.ErrorIs(t, err, expectedErr)
+		require.Contains(t, err.Error(), "governance parameter changes")
+	})
+	t.Run("contract call returns user error, should error", func(t *testing.T) {
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return flag == common.GovernanceParameterChangesFlag
+			},
+		}
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+		require.NotNil(t, processor)
+
+		validatorsInfo := state.NewShardValidatorsInfoMap()
+		err := processor.ProcessSystemSmartContract(validatorsInfo, &block.Header{})
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "governance parameter changes")
+	})
+}
+
+func TestSystemSCProcessor_GetEconomicsParameters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should return empty", func(t *testing.T) {
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				require.Fail(t, "should have not called")
+				return nil, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		economicsParameters, err := processor.GetEconomicsParameters()
+		require.Nil(t, err)
+		require.Nil(t, economicsParameters)
+	})
+	t.Run("contract call returns user error, should error", func(t *testing.T) {
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceParameterChangesFlag)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		economicsParameters, err := processor.GetEconomicsParameters()
+		require.NotNil(t, err)
+		require.Nil(t, economicsParameters)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgsForSystemSCProcessor()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceParameterChangesFlag)
+		returnData := [][]byte(nil)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				require.Equal(t, vm.GovernanceSCAddress, input.CallerAddr)
+				require.Equal(t, vm.GovernanceSCAddress, input.RecipientAddr)
+				require.Equal(t, "viewEconomicsParameters", input.Function)
+
+				return &vmcommon.VMOutput{ReturnData: returnData}, nil
+			},
+		}
+		processor, _ := NewSystemSCProcessor(args)
+
+		economicsParameters, err := processor.GetEconomicsParameters()
+		require.Nil(t, err)
+		require.Nil(t, economicsParameters)
+
+		returnData = [][]byte{[]byte(common.GasPriceModifierParameter), []byte("0.5")}
+		economicsParameters, err = processor.GetEconomicsParameters()
+		require.Nil(t, err)
+		require.Equal(t, []byte("economicsGasPriceModifier@0.5"), economicsParameters)
+	})
+}
+
 func TestSystemSCProcessor_ProcessDelegationRewardsNothingToExecute(t *testing.T) {
 	t.Parallel()
 
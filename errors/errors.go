@@ -166,6 +166,9 @@ var ErrNilEconomicsHandler = errors.New("nil economics handler")
 // ErrNilOutportHandler signals that a nil outport handler has been provided
 var ErrNilOutportHandler = errors.New("nil outport handler")
 
+// ErrNilEventsSubscriptionsHandler signals that a nil events subscriptions handler has been provided
+var ErrNilEventsSubscriptionsHandler = errors.New("nil events subscriptions handler")
+
 // ErrNilEpochNotifier signals that a nil epoch notifier has been provided
 var ErrNilEpochNotifier = errors.New("nil epoch notifier")
 
@@ -265,6 +268,9 @@ var ErrNilPeerBlackListHandler = errors.New("nil peer black list handler")
 // ErrNilPeerHonestyHandler signals that a nil peer honesty handler was provided
 var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler was provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
 // ErrNilPeerShardMapper signals that a nil peer shard mapper was provided
 var ErrNilPeerShardMapper = errors.New("nil peer shard mapper")
 
@@ -529,6 +535,9 @@ var ErrNilStatusMetrics = errors.New("nil status metrics")
 // ErrNilPersistentHandler signals that a nil persistent handler was provided
 var ErrNilPersistentHandler = errors.New("nil persistent handler")
 
+// ErrNilMetricsRegistry signals that a nil metrics registry was provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
+
 // ErrNilGenesisNodesSetupHandler signals that a nil genesis nodes setup handler has been provided
 var ErrNilGenesisNodesSetupHandler = errors.New("nil genesis nodes setup handler")
 
@@ -44,5 +44,11 @@ var ErrEmptyGasConfigs = errors.New("empty gas configs")
 // ErrTooManyAddressesInBulk signals that there are too many addresses present in a bulk request
 var ErrTooManyAddressesInBulk = errors.New("too many addresses in the bulk request")
 
+// ErrTooManyTransactionsInSequence signals that there are too many transactions present in a simulation sequence request
+var ErrTooManyTransactionsInSequence = errors.New("too many transactions in the simulation sequence request")
+
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry was provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
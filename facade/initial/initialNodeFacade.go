@@ -1,6 +1,7 @@
 package initial
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
@@ -32,12 +33,14 @@ type ArgInitialNodeFacade struct {
 	PprofEnabled                bool
 	P2PPrometheusMetricsEnabled bool
 	StatusMetricsHandler        external.StatusMetricsHandler
+	MetricsRegistry             common.MetricsRegistry
 }
 
 // initialNodeFacade represents a facade with no functionality
 type initialNodeFacade struct {
 	apiInterface                string
 	statusMetricsHandler        external.StatusMetricsHandler
+	metricsRegistry             common.MetricsRegistry
 	pprofEnabled                bool
 	p2pPrometheusMetricsEnabled bool
 }
@@ -47,6 +50,9 @@ func NewInitialNodeFacade(args ArgInitialNodeFacade) (*initialNodeFacade, error)
 	if check.IfNil(args.StatusMetricsHandler) {
 		return nil, facade.ErrNilStatusMetrics
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, facade.ErrNilMetricsRegistry
+	}
 
 	initialStatusMetrics, err := NewInitialStatusMetricsProvider(args.StatusMetricsHandler)
 	if err != nil {
@@ -56,6 +62,7 @@ func NewInitialNodeFacade(args ArgInitialNodeFacade) (*initialNodeFacade, error)
 	return &initialNodeFacade{
 		apiInterface:                args.ApiInterface,
 		statusMetricsHandler:        initialStatusMetrics,
+		metricsRegistry:             args.MetricsRegistry,
 		pprofEnabled:                args.PprofEnabled,
 		p2pPrometheusMetricsEnabled: args.P2PPrometheusMetricsEnabled,
 	}, nil
@@ -142,7 +149,7 @@ func (inf *initialNodeFacade) CreateTransaction(_ *external.ArgsCreateTransactio
 }
 
 // ValidateTransaction returns error
-func (inf *initialNodeFacade) ValidateTransaction(_ *transaction.Transaction) error {
+func (inf *initialNodeFacade) ValidateTransaction(_ context.Context, _ *transaction.Transaction) error {
 	return errNodeStarting
 }
 
@@ -156,18 +163,38 @@ func (inf *initialNodeFacade) ValidatorStatisticsApi() (map[string]*validator.Va
 	return nil, errNodeStarting
 }
 
+// GetValidatorsPerformance returns nil and error
+func (inf *initialNodeFacade) GetValidatorsPerformance(_ uint32, _ uint32) ([]*common.ValidatorEpochPerformance, error) {
+	return nil, errNodeStarting
+}
+
 // AuctionListApi returns nil and error
 func (inf *initialNodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error) {
 	return nil, errNodeStarting
 }
 
 // SendBulkTransactions returns 0 and error
-func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction) (uint64, error) {
+func (inf *initialNodeFacade) SendBulkTransactions(_ context.Context, _ []*transaction.Transaction) (uint64, error) {
 	return uint64(0), errNodeStarting
 }
 
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ context.Context, _ *transaction.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nil, errNodeStarting
+}
+
+// TraceTransactionExecution returns nil and error
+func (inf *initialNodeFacade) TraceTransactionExecution(_ *transaction.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nil, errNodeStarting
+}
+
+// ReplayTransaction returns nil and error
+func (inf *initialNodeFacade) ReplayTransaction(_ string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nil, errNodeStarting
+}
+
+// SimulateTransactionsSequence returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsSequence(_ context.Context, _ []*transaction.Transaction, _ common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
 	return nil, errNodeStarting
 }
 
@@ -177,7 +204,7 @@ func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.Api
 }
 
 // ComputeTransactionGasLimit returns 0 and error
-func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction) (*transaction.CostResponse, error) {
+func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ context.Context, _ *transaction.Transaction, _ common.StateOverrides) (*transaction.CostResponse, error) {
 	return nil, errNodeStarting
 }
 
@@ -206,18 +233,88 @@ func (inf *initialNodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return nil, errNodeStarting
 }
 
+// GetHeartbeatHistory returns nil and error
+func (inf *initialNodeFacade) GetHeartbeatHistory(_ string) (*data.PubKeyHeartbeatHistory, error) {
+	return nil, errNodeStarting
+}
+
+// GetUptime returns nil and error
+func (inf *initialNodeFacade) GetUptime(_ uint32) ([]data.PubKeyUptime, error) {
+	return nil, errNodeStarting
+}
+
+// GetP2PMessageTraces returns nil and error
+func (inf *initialNodeFacade) GetP2PMessageTraces() ([]*common.P2PMessageTrace, error) {
+	return nil, errNodeStarting
+}
+
+// SubscribeP2PMessageTraces returns nil and error
+func (inf *initialNodeFacade) SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error) {
+	return nil, nil, errNodeStarting
+}
+
+// GetGuardianRegistrationChallenge returns nil and error
+func (inf *initialNodeFacade) GetGuardianRegistrationChallenge(_ string) (*common.GuardianRegistrationChallenge, error) {
+	return nil, errNodeStarting
+}
+
+// RegisterGuardedAccount returns nil and error
+func (inf *initialNodeFacade) RegisterGuardedAccount(_ string, _ string) (*common.GuardianRegistration, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyGuardianCode returns error
+func (inf *initialNodeFacade) VerifyGuardianCode(_ string, _ string) error {
+	return errNodeStarting
+}
+
+// SetGuardianSpendingPolicy returns error
+func (inf *initialNodeFacade) SetGuardianSpendingPolicy(_ string, _ string, _ *common.GuardianSpendingPolicy) error {
+	return errNodeStarting
+}
+
+// CoSignTransaction returns nil and error
+func (inf *initialNodeFacade) CoSignTransaction(_ *transaction.Transaction, _ string) ([]byte, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ uint64) (*common.GovernanceProposal, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposalVotes returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposalVotes(_ uint64) (*common.GovernanceProposalVotes, error) {
+	return nil, errNodeStarting
+}
+
+// SubscribeToEvents returns nil and error
+func (inf *initialNodeFacade) SubscribeToEvents(_ common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	return nil, errNodeStarting
+}
+
 // StatusMetrics will return nil
 func (inf *initialNodeFacade) StatusMetrics() external.StatusMetricsHandler {
 	return inf.statusMetricsHandler
 }
 
+// MetricsRegistry will return the registry holding the typed Prometheus metrics of the node
+func (inf *initialNodeFacade) MetricsRegistry() common.MetricsRegistry {
+	return inf.metricsRegistry
+}
+
 // GetTotalStakedValue returns nil and error
 func (inf *initialNodeFacade) GetTotalStakedValue() (*api.StakeValues, error) {
 	return nil, errNodeStarting
 }
 
 // ExecuteSCQuery returns nil and error
-func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+func (inf *initialNodeFacade) ExecuteSCQuery(_ context.Context, _ *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
@@ -261,6 +358,11 @@ func (inf *initialNodeFacade) GetConnectedPeersRatingsOnMainNetwork() (string, e
 	return "", errNodeStarting
 }
 
+// GetPeersReputation returns nil and error
+func (inf *initialNodeFacade) GetPeersReputation() ([]*common.PeerReputationAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetEpochStartDataAPI returns nil and error
 func (inf *initialNodeFacade) GetEpochStartDataAPI(_ uint32) (*common.EpochStartDataAPI, error) {
 	return nil, errNodeStarting
@@ -1,10 +1,12 @@
 package initial
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -17,6 +19,7 @@ func createInitialNodeFacadeArgs() ArgInitialNodeFacade {
 		PprofEnabled:                true,
 		P2PPrometheusMetricsEnabled: false,
 		StatusMetricsHandler:        &testscommon.StatusMetricsStub{},
+		MetricsRegistry:             &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -32,6 +35,15 @@ func TestInitialNodeFacade(t *testing.T) {
 		assert.Equal(t, facade.ErrNilStatusMetrics, err)
 		assert.Nil(t, inf)
 	})
+	t.Run("nil metrics registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createInitialNodeFacadeArgs()
+		args.MetricsRegistry = nil
+		inf, err := NewInitialNodeFacade(args)
+		assert.Equal(t, facade.ErrNilMetricsRegistry, err)
+		assert.Nil(t, inf)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -85,7 +97,7 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, n2)
 	assert.Equal(t, errNodeStarting, err)
 
-	err = inf.ValidateTransaction(nil)
+	err = inf.ValidateTransaction(context.Background(), nil)
 	assert.Equal(t, errNodeStarting, err)
 
 	err = inf.ValidateTransactionForSimulation(nil, false)
@@ -99,19 +111,31 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, v2)
 	assert.Equal(t, errNodeStarting, err)
 
-	u1, err := inf.SendBulkTransactions(nil)
+	u1, err := inf.SendBulkTransactions(context.Background(), nil)
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	u2, err := inf.SimulateTransactionExecution(context.Background(), nil, nil)
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	simResults, err := inf.SimulateTransactionsSequence(context.Background(), nil, nil)
+	assert.Nil(t, simResults)
+	assert.Equal(t, errNodeStarting, err)
+
+	traceResults, err := inf.TraceTransactionExecution(nil, nil)
+	assert.Nil(t, traceResults)
+	assert.Equal(t, errNodeStarting, err)
+
+	replayResults, err := inf.ReplayTransaction("")
+	assert.Nil(t, replayResults)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
 
-	resp, err := inf.ComputeTransactionGasLimit(nil)
+	resp, err := inf.ComputeTransactionGasLimit(context.Background(), nil, nil)
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -126,7 +150,7 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	sm := inf.StatusMetrics()
 	assert.NotNil(t, sm)
 
-	vo, _, err := inf.ExecuteSCQuery(nil)
+	vo, _, err := inf.ExecuteSCQuery(context.Background(), nil)
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -276,6 +300,49 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, "", ratings)
 	assert.Equal(t, errNodeStarting, err)
 
+	peersReputation, err := inf.GetPeersReputation()
+	assert.Nil(t, peersReputation)
+	assert.Equal(t, errNodeStarting, err)
+
+	heartbeatHistory, err := inf.GetHeartbeatHistory("")
+	assert.Nil(t, heartbeatHistory)
+	assert.Equal(t, errNodeStarting, err)
+
+	uptime, err := inf.GetUptime(0)
+	assert.Nil(t, uptime)
+	assert.Equal(t, errNodeStarting, err)
+
+	validatorsPerformance, err := inf.GetValidatorsPerformance(0, 1)
+	assert.Nil(t, validatorsPerformance)
+	assert.Equal(t, errNodeStarting, err)
+
+	p2pMessageTraces, err := inf.GetP2PMessageTraces()
+	assert.Nil(t, p2pMessageTraces)
+	assert.Equal(t, errNodeStarting, err)
+
+	chP2PMessageTraces, unsubscribe, err := inf.SubscribeP2PMessageTraces()
+	assert.Nil(t, chP2PMessageTraces)
+	assert.Nil(t, unsubscribe)
+	assert.Equal(t, errNodeStarting, err)
+
+	guardianRegistration, err := inf.RegisterGuardedAccount("", "")
+	assert.Nil(t, guardianRegistration)
+	assert.Equal(t, errNodeStarting, err)
+
+	err = inf.VerifyGuardianCode("", "")
+	assert.Equal(t, errNodeStarting, err)
+
+	err = inf.SetGuardianSpendingPolicy("", "", nil)
+	assert.Equal(t, errNodeStarting, err)
+
+	guardianSignature, err := inf.CoSignTransaction(nil, "")
+	assert.Nil(t, guardianSignature)
+	assert.Equal(t, errNodeStarting, err)
+
+	eventsSubscription, err := inf.SubscribeToEvents(common.EventsSubscriptionFilter{})
+	assert.Nil(t, eventsSubscription)
+	assert.Equal(t, errNodeStarting, err)
+
 	epochStartData, err := inf.GetEpochStartDataAPI(0)
 	assert.Nil(t, epochStartData)
 	assert.Equal(t, errNodeStarting, err)
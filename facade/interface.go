@@ -66,11 +66,11 @@ type NodeHandler interface {
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 
 	// ValidateTransaction will validate a transaction
-	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransaction(ctx context.Context, tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 
 	// SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
-	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
+	SendBulkTransactions(ctx context.Context, txs []*transaction.Transaction) (uint64, error)
 
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
@@ -85,6 +85,19 @@ type NodeHandler interface {
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
+	GetHeartbeatHistory(pubKey string) (*data.PubKeyHeartbeatHistory, error)
+	GetUptime(epoch uint32) ([]data.PubKeyUptime, error)
+	GetP2PMessageTraces() ([]*common.P2PMessageTrace, error)
+	SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error)
+	GetGuardianRegistrationChallenge(address string) (*common.GuardianRegistrationChallenge, error)
+	RegisterGuardedAccount(address string, signature string) (*common.GuardianRegistration, error)
+	VerifyGuardianCode(address string, code string) error
+	SetGuardianSpendingPolicy(address string, code string, policy *common.GuardianSpendingPolicy) error
+	CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(nonce uint64) (*common.GovernanceProposal, error)
+	GetGovernanceProposalVotes(nonce uint64) (*common.GovernanceProposalVotes, error)
+	SubscribeToEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
 
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
@@ -102,6 +115,7 @@ type NodeHandler interface {
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
+	GetPeersReputation() ([]*common.PeerReputationAPIResponse, error)
 
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 
@@ -119,13 +133,18 @@ type TransactionSimulatorProcessor interface {
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
-	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ExecuteSCQuery(ctx context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ComputeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
+	SimulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	ReplayTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error)
 	StatusMetrics() external.StatusMetricsHandler
+	MetricsRegistry() common.MetricsRegistry
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
+	GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
@@ -18,11 +18,16 @@ import (
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	MetricsRegistryCalled                       func() common.MetricsRegistry
+	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecutionHandler            func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	ReplayTransactionHandler                    func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsSequenceCalled          func(txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
+	GetValidatorsPerformanceCalled              func(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error)
 	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -108,7 +113,7 @@ func (ars *ApiResolverStub) GetAlteredAccountsForBlock(options api.GetAlteredAcc
 }
 
 // ExecuteSCQuery -
-func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+func (ars *ApiResolverStub) ExecuteSCQuery(_ context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	if ars.ExecuteSCQueryHandler != nil {
 		return ars.ExecuteSCQueryHandler(query)
 	}
@@ -125,19 +130,52 @@ func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	return nil
 }
 
+// MetricsRegistry -
+func (ars *ApiResolverStub) MetricsRegistry() common.MetricsRegistry {
+	if ars.MetricsRegistryCalled != nil {
+		return ars.MetricsRegistryCalled()
+	}
+
+	return nil
+}
+
 // ComputeTransactionGasLimit -
-func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (ars *ApiResolverStub) ComputeTransactionGasLimit(_ context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
 	if ars.ComputeTransactionGasLimitHandler != nil {
-		return ars.ComputeTransactionGasLimitHandler(tx)
+		return ars.ComputeTransactionGasLimitHandler(tx, stateOverrides)
 	}
 
 	return nil, nil
 }
 
 // SimulateTransactionExecution -
-func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (ars *ApiResolverStub) SimulateTransactionExecution(_ context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if ars.SimulateTransactionExecutionHandler != nil {
-		return ars.SimulateTransactionExecutionHandler(tx)
+		return ars.SimulateTransactionExecutionHandler(tx, stateOverrides)
+	}
+	return nil, nil
+}
+
+// TraceTransactionExecution -
+func (ars *ApiResolverStub) TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if ars.TraceTransactionExecutionHandler != nil {
+		return ars.TraceTransactionExecutionHandler(tx, stateOverrides)
+	}
+	return nil, nil
+}
+
+// ReplayTransaction -
+func (ars *ApiResolverStub) ReplayTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if ars.ReplayTransactionHandler != nil {
+		return ars.ReplayTransactionHandler(txHash)
+	}
+	return nil, nil
+}
+
+// SimulateTransactionsSequence -
+func (ars *ApiResolverStub) SimulateTransactionsSequence(_ context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+	if ars.SimulateTransactionsSequenceCalled != nil {
+		return ars.SimulateTransactionsSequenceCalled(txs, stateOverrides)
 	}
 	return nil, nil
 }
@@ -169,6 +207,15 @@ func (ars *ApiResolverStub) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nil, nil
 }
 
+// GetValidatorsPerformance -
+func (ars *ApiResolverStub) GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+	if ars.GetValidatorsPerformanceCalled != nil {
+		return ars.GetValidatorsPerformanceCalled(startEpoch, endEpoch)
+	}
+
+	return nil, nil
+}
+
 // GetInternalShardBlockByNonce -
 func (ars *ApiResolverStub) GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
 	if ars.GetInternalShardBlockByNonceCalled != nil {
@@ -32,6 +32,19 @@ type NodeStub struct {
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
+	GetHeartbeatHistoryCalled                      func(pubKey string) (*data.PubKeyHeartbeatHistory, error)
+	GetP2PMessageTracesCalled                      func() ([]*common.P2PMessageTrace, error)
+	GetGuardianRegistrationChallengeCalled         func(address string) (*common.GuardianRegistrationChallenge, error)
+	RegisterGuardedAccountCalled                   func(address string, signature string) (*common.GuardianRegistration, error)
+	VerifyGuardianCodeCalled                       func(address string, code string) error
+	SetGuardianSpendingPolicyCalled                func(address string, code string, policy *common.GuardianSpendingPolicy) error
+	CoSignTransactionCalled                        func(tx *transaction.Transaction, code string) ([]byte, error)
+	GetGovernanceProposalsCalled                   func() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposalCalled                    func(nonce uint64) (*common.GovernanceProposal, error)
+	GetGovernanceProposalVotesCalled               func(nonce uint64) (*common.GovernanceProposalVotes, error)
+	SubscribeToEventsCalled                        func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	SubscribeP2PMessageTracesCalled                func() (<-chan *common.P2PMessageTrace, func(), error)
+	GetUptimeCalled                                func(epoch uint32) ([]data.PubKeyUptime, error)
 	ValidatorStatisticsApiCalled                   func() (map[string]*validator.ValidatorStatistics, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
@@ -40,6 +53,7 @@ type NodeStub struct {
 	GetGuardianDataCalled                          func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetworkCalled    func() (string, error)
+	GetPeersReputationCalled                       func() ([]*common.PeerReputationAPIResponse, error)
 	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                              func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
@@ -155,7 +169,7 @@ func (ns *NodeStub) CreateTransaction(txArgs *external.ArgsCreateTransaction) (*
 }
 
 // ValidateTransaction -
-func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
+func (ns *NodeStub) ValidateTransaction(_ context.Context, tx *transaction.Transaction) error {
 	if ns.ValidateTransactionHandler != nil {
 		return ns.ValidateTransactionHandler(tx)
 	}
@@ -173,7 +187,7 @@ func (ns *NodeStub) ValidateTransactionForSimulation(tx *transaction.Transaction
 }
 
 // SendBulkTransactions -
-func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
+func (ns *NodeStub) SendBulkTransactions(_ context.Context, txs []*transaction.Transaction) (uint64, error) {
 	if ns.SendBulkTransactionsHandler != nil {
 		return ns.SendBulkTransactionsHandler(txs)
 	}
@@ -217,6 +231,123 @@ func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	return nil
 }
 
+// GetHeartbeatHistory -
+func (ns *NodeStub) GetHeartbeatHistory(pubKey string) (*data.PubKeyHeartbeatHistory, error) {
+	if ns.GetHeartbeatHistoryCalled != nil {
+		return ns.GetHeartbeatHistoryCalled(pubKey)
+	}
+
+	return nil, nil
+}
+
+// GetP2PMessageTraces -
+func (ns *NodeStub) GetP2PMessageTraces() ([]*common.P2PMessageTrace, error) {
+	if ns.GetP2PMessageTracesCalled != nil {
+		return ns.GetP2PMessageTracesCalled()
+	}
+
+	return nil, nil
+}
+
+// SubscribeP2PMessageTraces -
+func (ns *NodeStub) SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error) {
+	if ns.SubscribeP2PMessageTracesCalled != nil {
+		return ns.SubscribeP2PMessageTracesCalled()
+	}
+
+	return nil, func() {}, nil
+}
+
+// GetGuardianRegistrationChallenge -
+func (ns *NodeStub) GetGuardianRegistrationChallenge(address string) (*common.GuardianRegistrationChallenge, error) {
+	if ns.GetGuardianRegistrationChallengeCalled != nil {
+		return ns.GetGuardianRegistrationChallengeCalled(address)
+	}
+
+	return &common.GuardianRegistrationChallenge{}, nil
+}
+
+// RegisterGuardedAccount -
+func (ns *NodeStub) RegisterGuardedAccount(address string, signature string) (*common.GuardianRegistration, error) {
+	if ns.RegisterGuardedAccountCalled != nil {
+		return ns.RegisterGuardedAccountCalled(address, signature)
+	}
+
+	return &common.GuardianRegistration{}, nil
+}
+
+// VerifyGuardianCode -
+func (ns *NodeStub) VerifyGuardianCode(address string, code string) error {
+	if ns.VerifyGuardianCodeCalled != nil {
+		return ns.VerifyGuardianCodeCalled(address, code)
+	}
+
+	return nil
+}
+
+// SetGuardianSpendingPolicy -
+func (ns *NodeStub) SetGuardianSpendingPolicy(address string, code string, policy *common.GuardianSpendingPolicy) error {
+	if ns.SetGuardianSpendingPolicyCalled != nil {
+		return ns.SetGuardianSpendingPolicyCalled(address, code, policy)
+	}
+
+	return nil
+}
+
+// CoSignTransaction -
+func (ns *NodeStub) CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error) {
+	if ns.CoSignTransactionCalled != nil {
+		return ns.CoSignTransactionCalled(tx, code)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposals -
+func (ns *NodeStub) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	if ns.GetGovernanceProposalsCalled != nil {
+		return ns.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ns *NodeStub) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposal, error) {
+	if ns.GetGovernanceProposalCalled != nil {
+		return ns.GetGovernanceProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposalVotes -
+func (ns *NodeStub) GetGovernanceProposalVotes(nonce uint64) (*common.GovernanceProposalVotes, error) {
+	if ns.GetGovernanceProposalVotesCalled != nil {
+		return ns.GetGovernanceProposalVotesCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// SubscribeToEvents -
+func (ns *NodeStub) SubscribeToEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	if ns.SubscribeToEventsCalled != nil {
+		return ns.SubscribeToEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// GetUptime -
+func (ns *NodeStub) GetUptime(epoch uint32) ([]data.PubKeyUptime, error) {
+	if ns.GetUptimeCalled != nil {
+		return ns.GetUptimeCalled(epoch)
+	}
+
+	return nil, nil
+}
+
 // ValidatorStatisticsApi -
 func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error) {
 	if ns.ValidatorStatisticsApiCalled != nil {
@@ -280,6 +411,15 @@ func (ns *NodeStub) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return "", nil
 }
 
+// GetPeersReputation -
+func (ns *NodeStub) GetPeersReputation() ([]*common.PeerReputationAPIResponse, error) {
+	if ns.GetPeersReputationCalled != nil {
+		return ns.GetPeersReputationCalled()
+	}
+
+	return make([]*common.PeerReputationAPIResponse, 0), nil
+}
+
 // GetEpochStartDataAPI -
 func (ns *NodeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if ns.GetEpochStartDataAPICalled != nil {
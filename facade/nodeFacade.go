@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
@@ -30,6 +31,7 @@ import (
 	"github.com/multiversx/mx-chain-go/state"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // DefaultRestInterface is the default interface the rest API will start on if not specified
@@ -270,8 +272,12 @@ func (nf *nodeFacade) CreateTransaction(txArgs *external.ArgsCreateTransaction)
 }
 
 // ValidateTransaction will validate a transaction
-func (nf *nodeFacade) ValidateTransaction(tx *transaction.Transaction) error {
-	return nf.node.ValidateTransaction(tx)
+func (nf *nodeFacade) ValidateTransaction(ctx context.Context, tx *transaction.Transaction) error {
+	ctx, span := tracing.StartSpan(ctx, "facade.ValidateTransaction")
+	err := nf.node.ValidateTransaction(ctx, tx)
+	tracing.EndSpan(span, err)
+
+	return err
 }
 
 // ValidateTransactionForSimulation will validate a transaction for the simulation process
@@ -284,19 +290,59 @@ func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*validator.ValidatorS
 	return nf.node.ValidatorStatisticsApi()
 }
 
+// GetValidatorsPerformance will return the validators performance for each epoch in the provided range
+func (nf *nodeFacade) GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+	return nf.apiResolver.GetValidatorsPerformance(startEpoch, endEpoch)
+}
+
 // AuctionListApi will return the data about the validators in the auction list
 func (nf *nodeFacade) AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error) {
 	return nf.node.AuctionListApi()
 }
 
 // SendBulkTransactions will send a bulk of transactions on the topic channel
-func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
-	return nf.node.SendBulkTransactions(txs)
+func (nf *nodeFacade) SendBulkTransactions(ctx context.Context, txs []*transaction.Transaction) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, "facade.SendBulkTransactions", attribute.Int("numTxs", len(txs)))
+	numSent, err := nf.node.SendBulkTransactions(ctx, txs)
+	tracing.EndSpan(span, err)
+
+	return numSent, err
 }
 
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nf.apiResolver.SimulateTransactionExecution(tx)
+func (nf *nodeFacade) SimulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	ctx, span := tracing.StartSpan(ctx, "facade.SimulateTransactionExecution")
+	results, err := nf.apiResolver.SimulateTransactionExecution(ctx, tx, stateOverrides)
+	tracing.EndSpan(span, err)
+
+	return results, err
+}
+
+// TraceTransactionExecution will simulate a transaction's execution and will return the results together with the
+// call tree of the triggered smart contract executions
+func (nf *nodeFacade) TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nf.apiResolver.TraceTransactionExecution(tx, stateOverrides)
+}
+
+// ReplayTransaction will re-execute, with tracing, a historical transaction against the state it was executed on
+func (nf *nodeFacade) ReplayTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nf.apiResolver.ReplayTransaction(txHash)
+}
+
+// SimulateTransactionsSequence will simulate the execution of an ordered list of transactions, each of them seeing
+// the effects of the previous ones, and will return the results
+func (nf *nodeFacade) SimulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+	numTxs := uint32(len(txs))
+	maxSequenceSize := nf.wsAntifloodConfig.SimulateTransactionsSequenceMaxSize
+	if numTxs > maxSequenceSize {
+		return nil, fmt.Errorf("%w (provided: %d, maximum: %d)", ErrTooManyTransactionsInSequence, numTxs, maxSequenceSize)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "facade.SimulateTransactionsSequence", attribute.Int("numTxs", len(txs)))
+	results, err := nf.apiResolver.SimulateTransactionsSequence(ctx, txs, stateOverrides)
+	tracing.EndSpan(span, err)
+
+	return results, err
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -335,8 +381,12 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 }
 
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
-func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
-	return nf.apiResolver.ComputeTransactionGasLimit(tx)
+func (nf *nodeFacade) ComputeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "facade.ComputeTransactionGasLimit")
+	cost, err := nf.apiResolver.ComputeTransactionGasLimit(ctx, tx, stateOverrides)
+	tracing.EndSpan(span, err)
+
+	return cost, err
 }
 
 // GetAccount returns a response containing information about the account correlated with provided address
@@ -410,11 +460,81 @@ func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return hbStatus, nil
 }
 
+// GetHeartbeatHistory returns the heartbeat history of the provided public key
+func (nf *nodeFacade) GetHeartbeatHistory(pubKey string) (*data.PubKeyHeartbeatHistory, error) {
+	return nf.node.GetHeartbeatHistory(pubKey)
+}
+
+// GetUptime returns the uptime of the monitored public keys in the provided epoch
+func (nf *nodeFacade) GetUptime(epoch uint32) ([]data.PubKeyUptime, error) {
+	return nf.node.GetUptime(epoch)
+}
+
+// GetP2PMessageTraces returns the latest sampled p2p messages traces
+func (nf *nodeFacade) GetP2PMessageTraces() ([]*common.P2PMessageTrace, error) {
+	return nf.node.GetP2PMessageTraces()
+}
+
+// SubscribeP2PMessageTraces subscribes to the sampled p2p messages traces
+func (nf *nodeFacade) SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error) {
+	return nf.node.SubscribeP2PMessageTraces()
+}
+
+// GetGuardianRegistrationChallenge issues a registration challenge for the provided account on the guardian co-signing service
+func (nf *nodeFacade) GetGuardianRegistrationChallenge(address string) (*common.GuardianRegistrationChallenge, error) {
+	return nf.node.GetGuardianRegistrationChallenge(address)
+}
+
+// RegisterGuardedAccount registers the provided account on the guardian co-signing service
+func (nf *nodeFacade) RegisterGuardedAccount(address string, signature string) (*common.GuardianRegistration, error) {
+	return nf.node.RegisterGuardedAccount(address, signature)
+}
+
+// VerifyGuardianCode confirms the registration of the provided account on the guardian co-signing service
+func (nf *nodeFacade) VerifyGuardianCode(address string, code string) error {
+	return nf.node.VerifyGuardianCode(address, code)
+}
+
+// SetGuardianSpendingPolicy sets the spending policy of the provided account on the guardian co-signing service
+func (nf *nodeFacade) SetGuardianSpendingPolicy(address string, code string, policy *common.GuardianSpendingPolicy) error {
+	return nf.node.SetGuardianSpendingPolicy(address, code, policy)
+}
+
+// CoSignTransaction returns the guardian signature of the provided transaction
+func (nf *nodeFacade) CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error) {
+	return nf.node.CoSignTransaction(tx, code)
+}
+
+// GetGovernanceProposals returns all the indexed governance proposals
+func (nf *nodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	return nf.node.GetGovernanceProposals()
+}
+
+// GetGovernanceProposal returns the indexed governance proposal with the provided nonce
+func (nf *nodeFacade) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposal, error) {
+	return nf.node.GetGovernanceProposal(nonce)
+}
+
+// GetGovernanceProposalVotes returns the votes cast on the governance proposal with the provided nonce
+func (nf *nodeFacade) GetGovernanceProposalVotes(nonce uint64) (*common.GovernanceProposalVotes, error) {
+	return nf.node.GetGovernanceProposalVotes(nonce)
+}
+
+// SubscribeToEvents subscribes to the blocks, transactions status changes and smart contract events matching the filter
+func (nf *nodeFacade) SubscribeToEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	return nf.node.SubscribeToEvents(filter)
+}
+
 // StatusMetrics will return the node's status metrics
 func (nf *nodeFacade) StatusMetrics() external.StatusMetricsHandler {
 	return nf.apiResolver.StatusMetrics()
 }
 
+// MetricsRegistry will return the registry holding the typed Prometheus metrics of the node
+func (nf *nodeFacade) MetricsRegistry() common.MetricsRegistry {
+	return nf.apiResolver.MetricsRegistry()
+}
+
 // GetTotalStakedValue will return total staked value
 func (nf *nodeFacade) GetTotalStakedValue() (*apiData.StakeValues, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -440,8 +560,20 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 }
 
 // ExecuteSCQuery retrieves data from existing SC trie
-func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error) {
-	vmOutput, blockInfo, err := nf.apiResolver.ExecuteSCQuery(query)
+func (nf *nodeFacade) ExecuteSCQuery(ctx context.Context, query *process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "facade.ExecuteSCQuery")
+	if query != nil {
+		span.SetAttributes(attribute.String("function", query.FuncName))
+	}
+
+	vmOutput, blockInfo, err := nf.executeSCQuery(ctx, query)
+	tracing.EndSpan(span, err)
+
+	return vmOutput, blockInfo, err
+}
+
+func (nf *nodeFacade) executeSCQuery(ctx context.Context, query *process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error) {
+	vmOutput, blockInfo, err := nf.apiResolver.ExecuteSCQuery(ctx, query)
 	if err != nil {
 		return nil, apiData.BlockInfo{}, err
 	}
@@ -494,6 +626,11 @@ func (nf *nodeFacade) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return nf.node.GetConnectedPeersRatingsOnMainNetwork()
 }
 
+// GetPeersReputation returns the reputation entries tracked by the node
+func (nf *nodeFacade) GetPeersReputation() ([]*common.PeerReputationAPIResponse, error) {
+	return nf.node.GetPeersReputation()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	if !nf.wsAntifloodConfig.WebServerAntifloodEnabled {
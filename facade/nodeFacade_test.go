@@ -27,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -522,7 +523,7 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	nf, err := NewNodeFacade(arg)
 	require.NoError(t, err)
 
-	_, _, _ = nf.ExecuteSCQuery(nil)
+	_, _, _ = nf.ExecuteSCQuery(context.Background(), nil)
 	require.True(t, wasCalled)
 }
 
@@ -585,7 +586,7 @@ func TestNodeFacade_SendBulkTransactions(t *testing.T) {
 	txs := make([]*transaction.Transaction, 0)
 	txs = append(txs, &transaction.Transaction{Nonce: 1})
 
-	res, err := nf.SendBulkTransactions(txs)
+	res, err := nf.SendBulkTransactions(context.Background(), txs)
 	require.NoError(t, err)
 	require.Equal(t, expectedNumOfSuccessfulTxs, res)
 	require.True(t, sendBulkTxsWasCalled)
@@ -1369,7 +1370,7 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 
 		nf, _ := NewNodeFacade(arg)
 
-		_, _, err := nf.ExecuteSCQuery(&process.SCQuery{})
+		_, _, err := nf.ExecuteSCQuery(context.Background(), &process.SCQuery{})
 		require.Equal(t, expectedErr, err)
 	})
 	t.Run("should work", func(t *testing.T) {
@@ -1399,7 +1400,7 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 
 		nf, _ := NewNodeFacade(arg)
 
-		apiVmOutput, _, err := nf.ExecuteSCQuery(&process.SCQuery{})
+		apiVmOutput, _, err := nf.ExecuteSCQuery(context.Background(), &process.SCQuery{})
 		require.NoError(t, err)
 		require.True(t, executeScQueryHandlerWasCalled)
 		require.Equal(t, expectedVmOutput.ReturnData, apiVmOutput.ReturnData)
@@ -2178,7 +2179,7 @@ func TestNodeFacade_ValidateTransaction(t *testing.T) {
 
 	nf, _ := NewNodeFacade(args)
 
-	err := nf.ValidateTransaction(&transaction.Transaction{})
+	err := nf.ValidateTransaction(context.Background(), &transaction.Transaction{})
 	require.NoError(t, err)
 	require.True(t, wasCalled)
 }
@@ -2197,18 +2198,112 @@ func TestNodeFacade_SimulateTransactionExecution(t *testing.T) {
 	}
 	args := createMockArguments()
 	args.ApiResolver = &mock.ApiResolverStub{
-		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
 			return providedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	response, err := nf.SimulateTransactionExecution(&transaction.Transaction{})
+	response, err := nf.SimulateTransactionExecution(context.Background(), &transaction.Transaction{}, nil)
 	require.NoError(t, err)
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := &txSimData.SimulationResultsWithVMOutput{
+		SimulationResults: transaction.SimulationResults{
+			Status: "ok",
+		},
+		ExecutionTrace: &tracing.ExecutionTrace{},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		TraceTransactionExecutionHandler: func(tx *transaction.Transaction, _ common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.TraceTransactionExecution(&transaction.Transaction{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_ReplayTransaction(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := &txSimData.SimulationResultsWithVMOutput{
+		SimulationResults: transaction.SimulationResults{
+			Status: "ok",
+		},
+		ExecutionTrace: &tracing.ExecutionTrace{},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		ReplayTransactionHandler: func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+			require.Equal(t, "0101", txHash)
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.ReplayTransaction("0101")
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_SimulateTransactionsSequence(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many transactions in sequence", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.WsAntifloodConfig.SimulateTransactionsSequenceMaxSize = 1
+		args.ApiResolver = &mock.ApiResolverStub{
+			SimulateTransactionsSequenceCalled: func(txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nf, _ := NewNodeFacade(args)
+
+		response, err := nf.SimulateTransactionsSequence(context.Background(), []*transaction.Transaction{{}, {}}, nil)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, ErrTooManyTransactionsInSequence))
+		require.Equal(t, "too many transactions in the simulation sequence request (provided: 2, maximum: 1)", err.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedResponse := []*txSimData.SimulationResultsWithVMOutput{
+			{GasUnits: 10},
+			{GasUnits: 20},
+		}
+		providedOverrides := common.StateOverrides{
+			"address": &common.AccountStateOverride{Balance: big.NewInt(1000)},
+		}
+		args := createMockArguments()
+		args.WsAntifloodConfig.SimulateTransactionsSequenceMaxSize = 2
+		args.ApiResolver = &mock.ApiResolverStub{
+			SimulateTransactionsSequenceCalled: func(txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, providedOverrides, stateOverrides)
+				return providedResponse, nil
+			},
+		}
+		nf, _ := NewNodeFacade(args)
+
+		response, err := nf.SimulateTransactionsSequence(context.Background(), []*transaction.Transaction{{}, {}}, providedOverrides)
+		require.NoError(t, err)
+		require.Equal(t, providedResponse, response)
+	})
+}
+
 func TestNodeFacade_ComputeTransactionGasLimit(t *testing.T) {
 	t.Parallel()
 
@@ -2217,14 +2312,14 @@ func TestNodeFacade_ComputeTransactionGasLimit(t *testing.T) {
 	}
 	args := createMockArguments()
 	args.ApiResolver = &mock.ApiResolverStub{
-		ComputeTransactionGasLimitHandler: func(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+		ComputeTransactionGasLimitHandler: func(tx *transaction.Transaction, _ common.StateOverrides) (*transaction.CostResponse, error) {
 			return providedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	response, err := nf.ComputeTransactionGasLimit(&transaction.Transaction{})
+	response, err := nf.ComputeTransactionGasLimit(context.Background(), &transaction.Transaction{}, nil)
 	require.NoError(t, err)
 	require.Equal(t, providedResponse, response)
 }
@@ -2268,6 +2363,200 @@ func TestNodeFacade_GetConnectedPeersRatingsOnMainNetwork(t *testing.T) {
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_GetPeersReputation(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := []*common.PeerReputationAPIResponse{
+		{
+			PeerID: "pid",
+			Score:  -10,
+		},
+	}
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		GetPeersReputationCalled: func() ([]*common.PeerReputationAPIResponse, error) {
+			return providedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.GetPeersReputation()
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_GetHeartbeatHistory(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := &data.PubKeyHeartbeatHistory{
+		PublicKey: "pk",
+		FirstSeen: 100,
+	}
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		GetHeartbeatHistoryCalled: func(pubKey string) (*data.PubKeyHeartbeatHistory, error) {
+			assert.Equal(t, "pk", pubKey)
+			return providedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.GetHeartbeatHistory("pk")
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_GetUptime(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := []data.PubKeyUptime{
+		{
+			PublicKey: "pk",
+		},
+	}
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		GetUptimeCalled: func(epoch uint32) ([]data.PubKeyUptime, error) {
+			assert.Equal(t, uint32(3), epoch)
+			return providedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.GetUptime(3)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_GetValidatorsPerformance(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := []*common.ValidatorEpochPerformance{
+		{
+			Epoch: 2,
+		},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		GetValidatorsPerformanceCalled: func(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+			assert.Equal(t, uint32(2), startEpoch)
+			assert.Equal(t, uint32(5), endEpoch)
+			return providedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.GetValidatorsPerformance(2, 5)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_P2PMessageTraces(t *testing.T) {
+	t.Parallel()
+
+	providedTraces := []*common.P2PMessageTrace{
+		{
+			Topic: "topic",
+		},
+	}
+	providedChannel := make(chan *common.P2PMessageTrace)
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		GetP2PMessageTracesCalled: func() ([]*common.P2PMessageTrace, error) {
+			return providedTraces, nil
+		},
+		SubscribeP2PMessageTracesCalled: func() (<-chan *common.P2PMessageTrace, func(), error) {
+			return providedChannel, func() {}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	traces, err := nf.GetP2PMessageTraces()
+	require.NoError(t, err)
+	require.Equal(t, providedTraces, traces)
+
+	chTraces, unsubscribe, err := nf.SubscribeP2PMessageTraces()
+	require.NoError(t, err)
+	require.NotNil(t, unsubscribe)
+	require.True(t, chTraces == providedChannel)
+}
+
+func TestNodeFacade_GuardianCoSigner(t *testing.T) {
+	t.Parallel()
+
+	providedChallenge := &common.GuardianRegistrationChallenge{Challenge: "challenge"}
+	providedRegistration := &common.GuardianRegistration{OTPSecret: "secret"}
+	providedPolicy := &common.GuardianSpendingPolicy{DailyLimits: map[string]string{"EGLD": "10"}}
+	providedTx := &transaction.Transaction{Nonce: 7}
+	providedSignature := []byte("signature")
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		GetGuardianRegistrationChallengeCalled: func(address string) (*common.GuardianRegistrationChallenge, error) {
+			require.Equal(t, "address", address)
+			return providedChallenge, nil
+		},
+		RegisterGuardedAccountCalled: func(address string, signature string) (*common.GuardianRegistration, error) {
+			require.Equal(t, "address", address)
+			require.Equal(t, "aa", signature)
+			return providedRegistration, nil
+		},
+		VerifyGuardianCodeCalled: func(address string, code string) error {
+			require.Equal(t, "address", address)
+			require.Equal(t, "123456", code)
+			return expectedErr
+		},
+		SetGuardianSpendingPolicyCalled: func(address string, code string, policy *common.GuardianSpendingPolicy) error {
+			require.Equal(t, providedPolicy, policy)
+			return expectedErr
+		},
+		CoSignTransactionCalled: func(tx *transaction.Transaction, code string) ([]byte, error) {
+			require.Equal(t, providedTx, tx)
+			return providedSignature, nil
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	challenge, err := nf.GetGuardianRegistrationChallenge("address")
+	require.NoError(t, err)
+	require.Equal(t, providedChallenge, challenge)
+
+	registration, err := nf.RegisterGuardedAccount("address", "aa")
+	require.NoError(t, err)
+	require.Equal(t, providedRegistration, registration)
+
+	err = nf.VerifyGuardianCode("address", "123456")
+	require.Equal(t, expectedErr, err)
+
+	err = nf.SetGuardianSpendingPolicy("address", "123456", providedPolicy)
+	require.Equal(t, expectedErr, err)
+
+	signature, err := nf.CoSignTransaction(providedTx, "123456")
+	require.NoError(t, err)
+	require.Equal(t, providedSignature, signature)
+}
+
+func TestNodeFacade_SubscribeToEvents(t *testing.T) {
+	t.Parallel()
+
+	providedFilter := common.EventsSubscriptionFilter{
+		Blocks:       true,
+		Transactions: []string{"aa"},
+	}
+	expectedErr := errors.New("expected error")
+	args := createMockArguments()
+	args.Node = &mock.NodeStub{
+		SubscribeToEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+			require.Equal(t, providedFilter, filter)
+			return nil, expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(args)
+
+	subscription, err := nf.SubscribeToEvents(providedFilter)
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, subscription)
+}
+
 func TestNodeFacade_GetBlockByHash(t *testing.T) {
 	t.Parallel()
 
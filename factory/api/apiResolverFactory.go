@@ -22,6 +22,8 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external/logs"
 	"github.com/multiversx/mx-chain-go/node/external/timemachine/fee"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	"github.com/multiversx/mx-chain-go/node/external/validatorsPerformance"
+	disabledValidatorsPerformance "github.com/multiversx/mx-chain-go/node/external/validatorsPerformance/disabled"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	trieIteratorsFactory "github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
@@ -33,6 +35,8 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
+	"github.com/multiversx/mx-chain-go/process/stateOverrides"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -211,6 +215,11 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	validatorsPerformanceHandler, err := createValidatorsPerformanceHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
 	feeComputer, err := fee.NewFeeComputer(args.CoreComponents.EconomicsData())
 	if err != nil {
 		return nil, err
@@ -265,10 +274,12 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
+		MetricsRegistry:          args.StatusCoreComponents.MetricsRegistry(),
 		APITransactionEvaluator:  args.ProcessComponents.APITransactionEvaluator(),
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		ValidatorsPerformance:    validatorsPerformanceHandler,
 		APITransactionHandler:    apiTransactionProcessor,
 		APIBlockHandler:          apiBlockProcessor,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
@@ -368,10 +379,21 @@ func createScQueryElement(
 		return nil, nil, err
 	}
 
+	marshaller := args.coreComponents.InternalMarshalizer()
+	overridesApplier, err := stateOverrides.NewAccountOverridesApplier(marshaller, args.coreComponents.Hasher())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountsAdapterWithOverrides, err := stateOverrides.NewAccountsDBWithStateOverrides(accountsAdapterApi, overridesApplier)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	builtInFuncFactory, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
-		args.coreComponents.InternalMarshalizer(),
-		accountsAdapterApi,
+		marshaller,
+		accountsAdapterWithOverrides,
 		args.processComponents.ShardCoordinator(),
 		args.coreComponents.EpochNotifier(),
 		args.coreComponents.EnableEpochsHandler(),
@@ -411,7 +433,8 @@ func createScQueryElement(
 		GasSchedule:              args.gasScheduleNotifier,
 		Counter:                  counters.NewDisabledCounter(),
 		MissingTrieNodesNotifier: syncer.NewMissingTrieNodesNotifier(),
-		Accounts:                 accountsAdapterApi,
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
+		Accounts:                 accountsAdapterWithOverrides,
 		BlockChain:               apiBlockchain,
 	}
 
@@ -736,6 +759,24 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 	return blockApiArgs, nil
 }
 
+func createValidatorsPerformanceHandler(args *ApiResolverArgs) (external.ValidatorsPerformanceHandler, error) {
+	if args.BootstrapComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return disabledValidatorsPerformance.NewDisabledValidatorsPerformanceProcessor(), nil
+	}
+
+	argsValidatorsPerformance := validatorsPerformance.ArgValidatorsPerformanceProcessor{
+		ValidatorInfoProvider:    args.ProcessComponents.ValidatorsStatistics(),
+		StorageService:           args.DataComponents.StorageService(),
+		Marshaller:               args.CoreComponents.InternalMarshalizer(),
+		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
+		AddressPubKeyConverter:   args.CoreComponents.AddressPubKeyConverter(),
+		MaxRating:                args.CoreComponents.RatingsData().MaxRating(),
+		MaxNumEpochs:             args.Configs.GeneralConfig.ValidatorStatistics.PerformanceReportMaxNumEpochs,
+	}
+
+	return validatorsPerformance.NewValidatorsPerformanceProcessor(argsValidatorsPerformance)
+}
+
 func createLogsFacade(args *ApiResolverArgs) (factory.LogsFacade, error) {
 	return logs.NewLogsFacade(logs.ArgsNewLogsFacade{
 		StorageService:  args.DataComponents.StorageService(),
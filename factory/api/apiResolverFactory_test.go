@@ -346,6 +346,7 @@ func createMockSCQueryElementArgs() api.SCQueryElementArgs {
 			},
 		},
 		StatusCoreComponents: &factory.StatusCoreComponentsStub{
+			MetricsRegistryField: &testscommon.MetricsRegistryStub{},
 			AppStatusHandlerCalled: func() core.AppStatusHandler {
 				return &statusHandler.AppStatusHandlerStub{}
 			},
@@ -139,8 +139,9 @@ func (hvh *headerVersionHandler) setInCache(epoch uint32, version string) {
 
 // Verify will check the header's fields such as the chain ID or the software version
 func (hvh *headerVersionHandler) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
-		return process.ErrReservedFieldInvalid
+	err := process.CheckReservedField(hdr)
+	if err != nil {
+		return err
 	}
 
 	return hvh.checkSoftwareVersion(hdr)
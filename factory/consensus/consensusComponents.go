@@ -337,6 +337,7 @@ func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyH
 		SyncTimer:        ccf.coreComponents.SyncTimer(),
 		Watchdog:         wd,
 		AppStatusHandler: ccf.statusCoreComponents.AppStatusHandler(),
+		MetricsRegistry:  ccf.statusCoreComponents.MetricsRegistry(),
 	}
 	return chronology.NewChronology(chronologyArg)
 }
@@ -659,7 +660,8 @@ func (ccf *consensusComponentsFactory) createPeerBlacklistHandler() (consensus.P
 		return nil, err
 	}
 	blacklistArgs := blacklist.PeerBlackListArgs{
-		PeerCacher: peerCacher,
+		PeerCacher:            peerCacher,
+		PeerReputationHandler: ccf.networkComponents.PeerReputationHandler(),
 	}
 
 	return blacklist.NewPeerBlacklist(blacklistArgs)
@@ -718,6 +720,9 @@ func checkArgs(args ConsensusComponentsFactoryArgs) error {
 	if check.IfNil(args.NetworkComponents.NetworkMessenger()) {
 		return errors.ErrNilMessenger
 	}
+	if check.IfNil(args.NetworkComponents.PeerReputationHandler()) {
+		return errors.ErrNilPeerReputationHandler
+	}
 	if check.IfNil(args.ProcessComponents) {
 		return errors.ErrNilProcessComponentsHolder
 	}
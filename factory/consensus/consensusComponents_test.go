@@ -71,9 +71,10 @@ func createMockConsensusComponentsFactoryArgs() consensusComp.ConsensusComponent
 			EnableEpochsHandlerField: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
 		},
 		NetworkComponents: &testsMocks.NetworkComponentsStub{
-			Messenger:      &p2pmocks.MessengerStub{},
-			InputAntiFlood: &testsMocks.P2PAntifloodHandlerStub{},
-			PeerHonesty:    &testscommon.PeerHonestyHandlerStub{},
+			Messenger:                  &p2pmocks.MessengerStub{},
+			InputAntiFlood:             &testsMocks.P2PAntifloodHandlerStub{},
+			PeerHonesty:                &testscommon.PeerHonestyHandlerStub{},
+			PeerReputationHandlerField: &testscommon.PeerReputationHandlerStub{},
 		},
 		CryptoComponents: &testsMocks.CryptoComponentsStub{
 			PrivKey:         &cryptoMocks.PrivateKeyStub{},
@@ -155,6 +156,7 @@ func createMockConsensusComponentsFactoryArgs() consensusComp.ConsensusComponent
 			Outport: &outportMocks.OutportStub{},
 		},
 		StatusCoreComponents: &factoryMocks.StatusCoreComponentsStub{
+			MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 			AppStatusHandlerField: &statusHandler.AppStatusHandlerStub{},
 		},
 		ScheduledProcessor:    &consensusMocks.ScheduledProcessorStub{},
@@ -288,6 +290,18 @@ func TestNewConsensusComponentsFactory(t *testing.T) {
 		require.Nil(t, ccf)
 		require.Equal(t, errorsMx.ErrNilMessenger, err)
 	})
+	t.Run("nil PeerReputationHandler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.NetworkComponents = &testsMocks.NetworkComponentsStub{
+			Messenger: &p2pmocks.MessengerStub{},
+		}
+		ccf, err := consensusComp.NewConsensusComponentsFactory(args)
+
+		require.Nil(t, ccf)
+		require.Equal(t, errorsMx.ErrNilPeerReputationHandler, err)
+	})
 	t.Run("nil ProcessComponents should error", func(t *testing.T) {
 		t.Parallel()
 
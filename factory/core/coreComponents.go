@@ -263,6 +263,9 @@ func (ccf *coreComponentsFactory) Create() (*coreComponents, error) {
 		return nil, err
 	}
 
+	epochStartNotifierWithConfirm := notifier.NewEpochStartSubscriptionHandler()
+	epochStartNotifierWithConfirm.RegisterHandler(economicsData)
+
 	log.Trace("creating ratings data")
 	ratingDataArgs := rating.RatingsDataArg{
 		Config:                   ccf.ratingsConfig,
@@ -339,7 +342,7 @@ func (ccf *coreComponentsFactory) Create() (*coreComponents, error) {
 		epochNotifier:                 epochNotifier,
 		roundNotifier:                 roundNotifier,
 		enableRoundsHandler:           enableRoundsHandler,
-		epochStartNotifierWithConfirm: notifier.NewEpochStartSubscriptionHandler(),
+		epochStartNotifierWithConfirm: epochStartNotifierWithConfirm,
 		chanStopNodeProcess:           ccf.chanStopNodeProcess,
 		encodedAddressLen:             encodedAddressLen,
 		nodeTypeProvider:              nodeTypeProvider,
@@ -36,6 +36,11 @@ func (e *epochStartSystemSCProcessor) ProcessDelegationRewards(
 	return nil
 }
 
+// GetEconomicsParameters returns nil
+func (e *epochStartSystemSCProcessor) GetEconomicsParameters() ([]byte, error) {
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (e *epochStartSystemSCProcessor) IsInterfaceNil() bool {
 	return e == nil
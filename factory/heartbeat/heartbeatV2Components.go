@@ -2,6 +2,7 @@ package heartbeat
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -12,12 +13,16 @@ import (
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/heartbeat/monitor"
+	disabledMonitor "github.com/multiversx/mx-chain-go/heartbeat/monitor/disabled"
 	"github.com/multiversx/mx-chain-go/heartbeat/processor"
 	"github.com/multiversx/mx-chain-go/heartbeat/sender"
 	"github.com/multiversx/mx-chain-go/heartbeat/status"
 	"github.com/multiversx/mx-chain-go/p2p"
 	processFactory "github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/process/peer"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/update"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -58,6 +63,7 @@ type heartbeatV2Components struct {
 	peerAuthRequestsProcessor            update.Closer
 	shardSender                          update.Closer
 	monitor                              factory.HeartbeatV2Monitor
+	history                              factory.HeartbeatV2History
 	statusHandler                        update.Closer
 	mainDirectConnectionProcessor        update.Closer
 	fullArchiveDirectConnectionProcessor update.Closer
@@ -234,6 +240,11 @@ func (hcf *heartbeatV2ComponentsFactory) Create() (*heartbeatV2Components, error
 		return nil, err
 	}
 
+	history, err := hcf.createHeartbeatHistory(heartbeatsMonitor)
+	if err != nil {
+		return nil, err
+	}
+
 	argsMetricsUpdater := status.ArgsMetricsUpdater{
 		PeerAuthenticationCacher:            hcf.dataComponents.Datapool().PeerAuthentications(),
 		HeartbeatMonitor:                    heartbeatsMonitor,
@@ -277,12 +288,56 @@ func (hcf *heartbeatV2ComponentsFactory) Create() (*heartbeatV2Components, error
 		peerAuthRequestsProcessor:            paRequestsProcessor,
 		shardSender:                          shardSender,
 		monitor:                              heartbeatsMonitor,
+		history:                              history,
 		statusHandler:                        statusHandler,
 		mainDirectConnectionProcessor:        mainDirectConnectionProcessor,
 		fullArchiveDirectConnectionProcessor: fullArchiveDirectConnectionProcessor,
 	}, nil
 }
 
+func (hcf *heartbeatV2ComponentsFactory) createHeartbeatHistory(heartbeatsMonitor factory.HeartbeatV2Monitor) (factory.HeartbeatV2History, error) {
+	cfg := hcf.config.HeartbeatV2.History
+	if !cfg.Enabled {
+		return disabledMonitor.NewDisabledHeartbeatHistory(), nil
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(cfg.Storage.DB)
+	dbConfig.FilePath = filepath.Join(hcf.coreComponents.PathHandler().DatabasePath(), storage.DefaultStaticDbString, cfg.Storage.DB.FilePath)
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(cfg.Storage.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(cfg.Storage.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	argsHistory := monitor.ArgHeartbeatHistory{
+		HeartbeatsProvider:          heartbeatsMonitor,
+		EpochProvider:               hcf.processComponents.EpochStartTrigger(),
+		Storer:                      storer,
+		Marshaller:                  hcf.coreComponents.InternalMarshalizer(),
+		TimeBetweenSnapshots:        time.Second * time.Duration(cfg.TimeBetweenSnapshotsInSec),
+		RetentionPeriod:             time.Second * time.Duration(cfg.RetentionPeriodInSec),
+		MaxOnlineIntervalsPerPubKey: cfg.MaxOnlineIntervalsPerPubKey,
+		MaxEventsPerPubKey:          cfg.MaxEventsPerPubKey,
+		MaxEpochsPerPubKey:          cfg.MaxEpochsPerPubKey,
+	}
+	history, err := monitor.NewHeartbeatHistory(argsHistory)
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, err
+	}
+
+	return history, nil
+}
+
 func (hcf *heartbeatV2ComponentsFactory) createTopicsIfNeeded() error {
 	err := createTopicsIfNeededOnMessenger(hcf.networkComponents.NetworkMessenger())
 	if err != nil {
@@ -329,6 +384,10 @@ func (hc *heartbeatV2Components) Close() error {
 		log.LogIfError(hc.statusHandler.Close())
 	}
 
+	if !check.IfNil(hc.history) {
+		log.LogIfError(hc.history.Close())
+	}
+
 	if !check.IfNil(hc.mainDirectConnectionProcessor) {
 		log.LogIfError(hc.mainDirectConnectionProcessor.Close())
 	}
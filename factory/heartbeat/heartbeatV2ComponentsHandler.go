@@ -72,6 +72,18 @@ func (mhc *managedHeartbeatV2Components) Monitor() factory.HeartbeatV2Monitor {
 	return mhc.monitor
 }
 
+// History returns the heartbeatV2 history
+func (mhc *managedHeartbeatV2Components) History() factory.HeartbeatV2History {
+	mhc.mutHeartbeatV2Components.Lock()
+	defer mhc.mutHeartbeatV2Components.Unlock()
+
+	if mhc.heartbeatV2Components == nil {
+		return nil
+	}
+
+	return mhc.history
+}
+
 // Close closes the heartbeat components
 func (mhc *managedHeartbeatV2Components) Close() error {
 	mhc.mutHeartbeatV2Components.Lock()
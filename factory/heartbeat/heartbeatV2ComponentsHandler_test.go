@@ -52,10 +52,12 @@ func TestManagedHeartbeatV2Components_Create(t *testing.T) {
 		mhc, _ := heartbeatComp.NewManagedHeartbeatV2Components(hcf)
 		assert.NotNil(t, mhc)
 		assert.Nil(t, mhc.Monitor())
+		assert.Nil(t, mhc.History())
 
 		err := mhc.Create()
 		assert.NoError(t, err)
 		assert.NotNil(t, mhc.Monitor())
+		assert.NotNil(t, mhc.History())
 
 		assert.Equal(t, factory.HeartbeatV2ComponentsName, mhc.String())
 
@@ -12,6 +12,7 @@ import (
 	heartbeatComp "github.com/multiversx/mx-chain-go/factory/heartbeat"
 	testsMocks "github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
@@ -50,6 +51,9 @@ func createMockHeartbeatV2ComponentsFactoryArgs() heartbeatComp.ArgHeartbeatV2Co
 			ValidatorPubKeyConverterCalled: func() core.PubkeyConverter {
 				return &testscommon.PubkeyConverterStub{}
 			},
+			PathHandlerCalled: func() storage.PathManagerHandler {
+				return &testscommon.PathManagerStub{}
+			},
 		},
 		DataComponents: &testsMocks.DataComponentsStub{
 			DataPool: &dataRetriever.PoolsHolderStub{
@@ -446,6 +450,34 @@ func TestHeartbeatV2Components_Create(t *testing.T) {
 		assert.Nil(t, hc)
 		assert.Error(t, err)
 	})
+	t.Run("NewHeartbeatHistory fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatV2ComponentsFactoryArgs()
+		args.Config.HeartbeatV2.History = createMockHeartbeatHistoryConfig()
+		args.Config.HeartbeatV2.History.TimeBetweenSnapshotsInSec = 0
+		hcf, err := heartbeatComp.NewHeartbeatV2ComponentsFactory(args)
+		assert.NotNil(t, hcf)
+		assert.NoError(t, err)
+
+		hc, err := hcf.Create()
+		assert.Nil(t, hc)
+		assert.Error(t, err)
+	})
+	t.Run("invalid history storage config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatV2ComponentsFactoryArgs()
+		args.Config.HeartbeatV2.History = createMockHeartbeatHistoryConfig()
+		args.Config.HeartbeatV2.History.Storage.DB.Type = "invalid"
+		hcf, err := heartbeatComp.NewHeartbeatV2ComponentsFactory(args)
+		assert.NotNil(t, hcf)
+		assert.NoError(t, err)
+
+		hc, err := hcf.Create()
+		assert.Nil(t, hc)
+		assert.Error(t, err)
+	})
 	t.Run("NewMetricsUpdater fails should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -516,6 +548,44 @@ func TestHeartbeatV2Components_Create(t *testing.T) {
 			assert.Contains(t, messengerTopics, common.PeerAuthenticationTopic)
 		}
 	})
+	t.Run("should work with history enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatV2ComponentsFactoryArgs()
+		args.Config.HeartbeatV2.History = createMockHeartbeatHistoryConfig()
+		hcf, err := heartbeatComp.NewHeartbeatV2ComponentsFactory(args)
+		assert.NotNil(t, hcf)
+		assert.NoError(t, err)
+
+		hc, err := hcf.Create()
+		assert.NotNil(t, hc)
+		assert.NoError(t, err)
+		assert.NoError(t, hc.Close())
+	})
+}
+
+func createMockHeartbeatHistoryConfig() config.HeartbeatHistoryConfig {
+	return config.HeartbeatHistoryConfig{
+		Enabled:                     true,
+		TimeBetweenSnapshotsInSec:   60,
+		RetentionPeriodInSec:        3600,
+		MaxOnlineIntervalsPerPubKey: 10,
+		MaxEventsPerPubKey:          10,
+		MaxEpochsPerPubKey:          10,
+		Storage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 100,
+			},
+			DB: config.DBConfig{
+				FilePath:          "HeartbeatHistory",
+				Type:              string(storageunit.MemoryDB),
+				BatchDelaySeconds: 1,
+				MaxBatchSize:      1,
+				MaxOpenFiles:      10,
+			},
+		},
+	}
 }
 
 func TestHeartbeatV2ComponentsFactory_IsInterfaceNil(t *testing.T) {
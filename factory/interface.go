@@ -152,6 +152,7 @@ type StatusCoreComponentsHolder interface {
 	StatusMetrics() external.StatusMetricsHandler
 	PersistentStatusHandler() PersistentStatusHandler
 	StateStatsHandler() common.StateStatisticsHandler
+	MetricsRegistry() common.MetricsRegistry
 	IsInterfaceNil() bool
 }
 
@@ -246,6 +247,7 @@ type NetworkComponentsHolder interface {
 	PubKeyCacher() process.TimeCacher
 	PeerBlackListHandler() process.PeerBlackListCacher
 	PeerHonestyHandler() PeerHonestyHandler
+	PeerReputationHandler() process.PeerReputationHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
 	PeersRatingHandler() p2p.PeersRatingHandler
 	PeersRatingMonitor() p2p.PeersRatingMonitor
@@ -262,8 +264,11 @@ type NetworkComponentsHandler interface {
 
 // TransactionEvaluator defines the transaction evaluator actions
 type TransactionEvaluator interface {
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	SimulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	ReplayTransaction(replayData *txSimData.TransactionReplayData) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -342,6 +347,7 @@ type StateComponentsHolder interface {
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	EventsSubscriptionsHandler() outport.EventsSubscriptionsHandler
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	ManagedPeersMonitor() common.ManagedPeersMonitor
 	IsInterfaceNil() bool
@@ -363,9 +369,18 @@ type HeartbeatV2Monitor interface {
 	IsInterfaceNil() bool
 }
 
+// HeartbeatV2History holds the heartbeat history of the monitored public keys
+type HeartbeatV2History interface {
+	GetHeartbeatHistory(pubKey string) (*heartbeatData.PubKeyHeartbeatHistory, error)
+	GetUptime(epoch uint32) ([]heartbeatData.PubKeyUptime, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // HeartbeatV2ComponentsHolder holds the heartbeatV2 components
 type HeartbeatV2ComponentsHolder interface {
 	Monitor() HeartbeatV2Monitor
+	History() HeartbeatV2History
 	IsInterfaceNil() bool
 }
 
@@ -5,6 +5,7 @@ import "github.com/multiversx/mx-chain-go/factory"
 // HeartbeatV2ComponentsStub -
 type HeartbeatV2ComponentsStub struct {
 	MonitorField factory.HeartbeatV2Monitor
+	HistoryField factory.HeartbeatV2History
 }
 
 // Create -
@@ -32,6 +33,11 @@ func (hbc *HeartbeatV2ComponentsStub) Monitor() factory.HeartbeatV2Monitor {
 	return hbc.MonitorField
 }
 
+// History -
+func (hbc *HeartbeatV2ComponentsStub) History() factory.HeartbeatV2History {
+	return hbc.HistoryField
+}
+
 // IsInterfaceNil -
 func (hbc *HeartbeatV2ComponentsStub) IsInterfaceNil() bool {
 	return hbc == nil
@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -21,7 +22,10 @@ import (
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/rating/peerHonesty"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
+	disabledPeerReputation "github.com/multiversx/mx-chain-go/process/rating/peerReputation/disabled"
 	antifloodFactory "github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -42,6 +46,7 @@ type NetworkComponentsFactoryArgs struct {
 	NodeOperationMode     common.NodeOperation
 	ConnectionWatcherType string
 	CryptoComponents      factory.CryptoComponentsHolder
+	PathManager           storage.PathManagerHandler
 }
 
 type networkComponentsFactory struct {
@@ -57,6 +62,7 @@ type networkComponentsFactory struct {
 	nodeOperationMode     common.NodeOperation
 	connectionWatcherType string
 	cryptoComponents      factory.CryptoComponentsHolder
+	pathManager           storage.PathManagerHandler
 }
 
 type networkComponentsHolder struct {
@@ -78,6 +84,7 @@ type networkComponents struct {
 	peerBlackListHandler     process.PeerBlackListCacher
 	antifloodConfig          config.AntifloodConfig
 	peerHonestyHandler       consensus.PeerHonestyHandler
+	peerReputationHandler    process.PeerReputationHandler
 	closeFunc                context.CancelFunc
 }
 
@@ -99,6 +106,9 @@ func NewNetworkComponentsFactory(
 	if check.IfNil(args.CryptoComponents) {
 		return nil, errors.ErrNilCryptoComponentsHolder
 	}
+	if check.IfNil(args.PathManager) {
+		return nil, errors.ErrNilPathHandler
+	}
 	if args.NodeOperationMode != common.NormalOperation && args.NodeOperationMode != common.FullArchiveMode {
 		return nil, errors.ErrInvalidNodeOperationMode
 	}
@@ -116,6 +126,7 @@ func NewNetworkComponentsFactory(
 		nodeOperationMode:     args.NodeOperationMode,
 		connectionWatcherType: args.ConnectionWatcherType,
 		cryptoComponents:      args.CryptoComponents,
+		pathManager:           args.PathManager,
 	}, nil
 }
 
@@ -136,14 +147,24 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		return nil, fmt.Errorf("%w for the full archive network holder", err)
 	}
 
+	peerReputationHandler, err := ncf.createPeerReputationHandler()
+	if err != nil {
+		return nil, fmt.Errorf("%w for the peer reputation handler", err)
+	}
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	defer func() {
 		if err != nil {
 			cancelFunc()
+			log.LogIfError(peerReputationHandler.Close())
 		}
 	}()
 
-	antiFloodComponents, inputAntifloodHandler, outputAntifloodHandler, peerHonestyHandler, err := ncf.createAntifloodComponents(ctx, mainNetworkComp.netMessenger.ID())
+	antiFloodComponents, inputAntifloodHandler, outputAntifloodHandler, peerHonestyHandler, err := ncf.createAntifloodComponents(
+		ctx,
+		mainNetworkComp.netMessenger.ID(),
+		peerReputationHandler,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +194,7 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		peerBlackListHandler:     antiFloodComponents.BlacklistHandler,
 		antifloodConfig:          ncf.mainConfig.Antiflood,
 		peerHonestyHandler:       peerHonestyHandler,
+		peerReputationHandler:    peerReputationHandler,
 		closeFunc:                cancelFunc,
 	}, nil
 }
@@ -180,9 +202,10 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 func (ncf *networkComponentsFactory) createAntifloodComponents(
 	ctx context.Context,
 	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
 ) (*antifloodFactory.AntiFloodComponents, factory.P2PAntifloodHandler, factory.P2PAntifloodHandler, consensus.PeerHonestyHandler, error) {
 	var antiFloodComponents *antifloodFactory.AntiFloodComponents
-	antiFloodComponents, err := antifloodFactory.NewP2PAntiFloodComponents(ctx, ncf.mainConfig, ncf.statusHandler, currentPid)
+	antiFloodComponents, err := antifloodFactory.NewP2PAntiFloodComponents(ctx, ncf.mainConfig, ncf.statusHandler, currentPid, peerReputationHandler)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -210,6 +233,7 @@ func (ncf *networkComponentsFactory) createAntifloodComponents(
 		&ncf.mainConfig,
 		ncf.ratingsConfig,
 		antiFloodComponents.PubKeysCacher,
+		peerReputationHandler,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -222,6 +246,7 @@ func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
 	pkTimeCache process.TimeCacher,
+	peerReputationHandler process.PeerReputationHandler,
 ) (consensus.PeerHonestyHandler, error) {
 
 	suCache, err := storageunit.NewCache(storageFactory.GetCacherFromConfig(config.PeerHonesty))
@@ -229,7 +254,39 @@ func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 		return nil, err
 	}
 
-	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, suCache)
+	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, suCache, peerReputationHandler)
+}
+
+func (ncf *networkComponentsFactory) createPeerReputationHandler() (process.PeerReputationHandler, error) {
+	peerReputationConfig := ncf.mainConfig.PeerReputation
+	if !peerReputationConfig.Enabled {
+		return disabledPeerReputation.NewPeerReputationHandler(), nil
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(peerReputationConfig.Storage.DB)
+	dbConfig.FilePath = filepath.Join(ncf.pathManager.DatabasePath(), storage.DefaultStaticDbString, peerReputationConfig.Storage.DB.FilePath)
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(peerReputationConfig.Storage.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(peerReputationConfig.Storage.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	argsPeerReputationStore := peerReputation.ArgsPeerReputationStore{
+		Config:     peerReputationConfig,
+		Storer:     storer,
+		Marshaller: &marshal.JsonMarshalizer{},
+	}
+
+	return peerReputation.NewPeerReputationStore(argsPeerReputationStore)
 }
 
 func (ncf *networkComponentsFactory) createNetworkHolder(
@@ -338,6 +395,9 @@ func (nc *networkComponents) Close() error {
 	if !check.IfNil(nc.peerHonestyHandler) {
 		log.LogIfError(nc.peerHonestyHandler.Close())
 	}
+	if !check.IfNil(nc.peerReputationHandler) {
+		log.LogIfError(nc.peerReputationHandler.Close())
+	}
 
 	mainNetMessenger := nc.mainNetworkHolder.netMessenger
 	if !check.IfNil(mainNetMessenger) {
@@ -105,6 +105,9 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.peerReputationHandler) {
+		return errors.ErrNilPeerReputationHandler
+	}
 
 	return nil
 }
@@ -181,6 +184,18 @@ func (mnc *managedNetworkComponents) PeerHonestyHandler() factory.PeerHonestyHan
 	return mnc.networkComponents.peerHonestyHandler
 }
 
+// PeerReputationHandler returns the peer reputation handler
+func (mnc *managedNetworkComponents) PeerReputationHandler() process.PeerReputationHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.peerReputationHandler
+}
+
 // PreferredPeersHolderHandler returns the preferred peers holder of the main network
 func (mnc *managedNetworkComponents) PreferredPeersHolderHandler() factory.PreferredPeersHolderHandler {
 	mnc.mutNetworkComponents.RLock()
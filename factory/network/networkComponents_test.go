@@ -49,6 +49,15 @@ func TestNewNetworkComponentsFactory(t *testing.T) {
 		require.Nil(t, ncf)
 		require.Equal(t, errorsMx.ErrNilCryptoComponentsHolder, err)
 	})
+	t.Run("nil PathManager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := componentsMock.GetNetworkFactoryArgs()
+		args.PathManager = nil
+		ncf, err := networkComp.NewNetworkComponentsFactory(args)
+		require.Nil(t, ncf)
+		require.Equal(t, errorsMx.ErrNilPathHandler, err)
+	})
 	t.Run("invalid node operation mode should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -146,6 +155,19 @@ func TestNetworkComponentsFactory_Create(t *testing.T) {
 		require.Error(t, err)
 		require.Nil(t, nc)
 	})
+	t.Run("createPeerReputationHandler fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := componentsMock.GetNetworkFactoryArgs()
+		args.MainConfig.PeerReputation.Enabled = true
+		args.MainConfig.PeerReputation.Storage.DB.Type = "invalid" // createPeerReputationHandler fails
+
+		ncf, _ := networkComp.NewNetworkComponentsFactory(args)
+
+		nc, err := ncf.Create()
+		require.Error(t, err)
+		require.Nil(t, nc)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
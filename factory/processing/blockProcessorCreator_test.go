@@ -140,6 +140,9 @@ func Test_newBlockProcessorCreatorForMeta(t *testing.T) {
 		AccountsAdapterAPICalled: func() state.AccountsAdapter {
 			return adb
 		},
+		AccountsRepositoryCalled: func() state.AccountsRepository {
+			return &stateMock.AccountsRepositoryStub{}
+		},
 		TriesContainerCalled: func() common.TriesHolder {
 			return &trieMock.TriesHolderStub{
 				GetCalled: func(bytes []byte) common.Trie {
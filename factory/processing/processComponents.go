@@ -424,6 +424,8 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	pcf.prepareEconomicsDataForStartEpoch()
+
 	requestHandler.SetEpoch(epochStartTrigger.Epoch())
 
 	err = dataRetriever.SetEpochHandlerToHdrResolver(resolversContainer, epochStartTrigger)
@@ -805,6 +807,42 @@ func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.
 	return peer.NewValidatorStatisticsProcessor(arguments)
 }
 
+// prepareEconomicsDataForStartEpoch applies the economics parameters carried by the epoch start meta block of the
+// start epoch, as the economics data is notified only about the epoch start events that follow
+func (pcf *processComponentsFactory) prepareEconomicsDataForStartEpoch() {
+	economicsHandler, ok := pcf.coreData.EconomicsData().(epochStart.ActionHandler)
+	if !ok {
+		return
+	}
+
+	startEpoch := pcf.bootstrapComponents.EpochBootstrapParams().Epoch()
+	if startEpoch == 0 {
+		return
+	}
+
+	metaBlockStorer, err := pcf.data.StorageService().GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		log.Warn("prepareEconomicsDataForStartEpoch: can not get the meta block storer", "error", err)
+		return
+	}
+
+	epochStartIdentifier := core.EpochStartIdentifier(startEpoch)
+	metaBlockBytes, err := metaBlockStorer.SearchFirst([]byte(epochStartIdentifier))
+	if err != nil {
+		log.Warn("prepareEconomicsDataForStartEpoch: epoch start meta block not found", "epoch", startEpoch, "error", err)
+		return
+	}
+
+	metaBlock := &dataBlock.MetaBlock{}
+	err = pcf.coreData.InternalMarshalizer().Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		log.Warn("prepareEconomicsDataForStartEpoch: can not unmarshal the epoch start meta block", "epoch", startEpoch, "error", err)
+		return
+	}
+
+	economicsHandler.EpochStartPrepare(metaBlock, &dataBlock.Body{})
+}
+
 func (pcf *processComponentsFactory) newEpochStartTrigger(requestHandler epochStart.RequestHandler) (epochStart.TriggerHandler, error) {
 	shardCoordinator := pcf.bootstrapComponents.ShardCoordinator()
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
@@ -258,6 +258,7 @@ func createMockProcessComponentsFactoryArgs() processComp.ProcessComponentsFacto
 			Outport: &outport.OutportStub{},
 		},
 		StatusCoreComponents: &factoryMocks.StatusCoreComponentsStub{
+			MetricsRegistryField:   &testscommon.MetricsRegistryStub{},
 			AppStatusHandlerField:  &statusHandler.AppStatusHandlerStub{},
 			StateStatsHandlerField: disabledStatistics.NewStateStatistics(),
 		},
@@ -918,6 +919,7 @@ func TestProcessComponentsFactory_Create(t *testing.T) {
 			PeersAcc:             realStateComp.PeerAccounts(),
 			Tries:                realStateComp.TriesContainer(),
 			AccountsAPI:          realStateComp.AccountsAdapterAPI(),
+			AccountsRepo:         realStateComp.AccountsRepository(),
 			StorageManagers:      realStateComp.TrieStorageManagers(),
 			MissingNodesNotifier: realStateComp.MissingTrieNodesNotifier(),
 		}
@@ -959,6 +961,7 @@ func TestProcessComponentsFactory_Create(t *testing.T) {
 			PeersAcc:             realStateComp.PeerAccounts(),
 			Tries:                realStateComp.TriesContainer(),
 			AccountsAPI:          realStateComp.AccountsAdapterAPI(),
+			AccountsRepo:         realStateComp.AccountsRepository(),
 			StorageManagers:      realStateComp.TrieStorageManagers(),
 			MissingNodesNotifier: realStateComp.MissingTrieNodesNotifier(),
 		}
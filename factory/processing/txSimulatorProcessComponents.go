@@ -14,10 +14,13 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory/shard"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
+	"github.com/multiversx/mx-chain-go/process/stateOverrides"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
 	"github.com/multiversx/mx-chain-go/state"
+	factoryState "github.com/multiversx/mx-chain-go/state/factory"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
@@ -27,8 +30,33 @@ import (
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
+const maxTracedCallsPerExecution = 1000
+
 func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.TransactionEvaluator, process.VirtualMachinesContainerFactory, error) {
-	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(pcf.state.AccountsAdapterAPI())
+	overridesApplier, err := stateOverrides.NewAccountOverridesApplier(pcf.coreData.InternalMarshalizer(), pcf.coreData.Hasher())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountFactory, err := factoryState.NewAccountCreator(factoryState.ArgsAccountCreator{
+		Hasher:              pcf.coreData.Hasher(),
+		Marshaller:          pcf.coreData.InternalMarshalizer(),
+		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	historicalStateAccountsDB, err := transactionEvaluator.NewHistoricalStateAccountsDB(
+		pcf.state.AccountsAdapterAPI(),
+		pcf.state.AccountsRepository(),
+		accountFactory,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(historicalStateAccountsDB, overridesApplier)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -47,7 +75,12 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		return nil, nil, err
 	}
 
-	txSimulatorProcessorArgs, vmContainerFactory, txTypeHandler, err := pcf.createArgsTxSimulatorProcessor(simulationAccountsDB, vmOutputCacher, txLogsProcessor)
+	executionTracer, err := tracing.NewExecutionTracer(pcf.coreData.AddressPubKeyConverter(), maxTracedCallsPerExecution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txSimulatorProcessorArgs, vmContainerFactory, txTypeHandler, err := pcf.createArgsTxSimulatorProcessor(simulationAccountsDB, vmOutputCacher, txLogsProcessor, executionTracer)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,6 +113,7 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		BlockChain:          pcf.data.Blockchain(),
+		ExecutionTracer:     executionTracer,
 	})
 
 	return apiTransactionEvaluator, vmContainerFactory, err
@@ -89,12 +123,13 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessor(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer process.ExecutionTracer,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	shardID := pcf.bootstrapComponents.ShardCoordinator().SelfId()
 	if shardID == core.MetachainShardId {
-		return pcf.createArgsTxSimulatorProcessorForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createArgsTxSimulatorProcessorForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor, executionTracer)
 	} else {
-		return pcf.createArgsTxSimulatorProcessorShard(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createArgsTxSimulatorProcessorShard(accountsAdapter, vmOutputCacher, txLogsProcessor, executionTracer)
 	}
 }
 
@@ -102,6 +137,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer process.ExecutionTracer,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	args := transactionEvaluator.ArgsTxSimulator{}
 
@@ -137,6 +173,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 		pcf.config.SmartContractsStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		executionTracer,
 	)
 	if err != nil {
 		return args, nil, nil, err
@@ -194,6 +231,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 		BadTxForwarder:      badTxInterim,
 		VMOutputCacher:      vmOutputCacher,
 		WasmVMChangeLocker:  pcf.coreData.WasmVMChangeLocker(),
+		ExecutionTracer:     executionTracer,
 		IsGenesisProcessing: false,
 	}
 
@@ -249,6 +287,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer process.ExecutionTracer,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	args := transactionEvaluator.ArgsTxSimulator{}
 
@@ -299,6 +338,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		smartContractStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		executionTracer,
 	)
 	if err != nil {
 		return args, nil, nil, err
@@ -368,6 +408,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		BadTxForwarder:      badTxInterim,
 		VMOutputCacher:      vmOutputCacher,
 		WasmVMChangeLocker:  pcf.coreData.WasmVMChangeLocker(),
+		ExecutionTracer:     executionTracer,
 		IsGenesisProcessing: false,
 	}
 
@@ -19,7 +19,9 @@ import (
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/keysManagement"
 	"github.com/multiversx/mx-chain-go/outport"
+	disabledOutport "github.com/multiversx/mx-chain-go/outport/disabled"
 	outportDriverFactory "github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/subscriptions"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -27,12 +29,13 @@ import (
 )
 
 type statusComponents struct {
-	nodesCoordinator    nodesCoordinator.NodesCoordinator
-	statusHandler       core.AppStatusHandler
-	outportHandler      outport.OutportHandler
-	softwareVersion     statistics.SoftwareVersionChecker
-	managedPeersMonitor common.ManagedPeersMonitor
-	cancelFunc          func()
+	nodesCoordinator           nodesCoordinator.NodesCoordinator
+	statusHandler              core.AppStatusHandler
+	outportHandler             outport.OutportHandler
+	eventsSubscriptionsHandler outport.EventsSubscriptionsHandler
+	softwareVersion            statistics.SoftwareVersionChecker
+	managedPeersMonitor        common.ManagedPeersMonitor
+	cancelFunc                 func()
 }
 
 // StatusComponentsFactoryArgs redefines the arguments structure needed for the status components factory
@@ -142,6 +145,11 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, err
 	}
 
+	eventsSubscriptionsHandler, err := scf.createEventsSubscriptionsHandler(outportHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	managedPeersMonitorArgs := keysManagement.ArgManagedPeersMonitor{
 		ManagedPeersHolder: scf.cryptoComponents.ManagedPeersHolder(),
 		NodesCoordinator:   scf.nodesCoordinator,
@@ -156,12 +164,13 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 	_, cancelFunc := context.WithCancel(context.Background())
 
 	statusComponentsInstance := &statusComponents{
-		nodesCoordinator:    scf.nodesCoordinator,
-		softwareVersion:     softwareVersionChecker,
-		outportHandler:      outportHandler,
-		statusHandler:       scf.statusCoreComponents.AppStatusHandler(),
-		managedPeersMonitor: managedPeersMonitor,
-		cancelFunc:          cancelFunc,
+		nodesCoordinator:           scf.nodesCoordinator,
+		softwareVersion:            softwareVersionChecker,
+		outportHandler:             outportHandler,
+		eventsSubscriptionsHandler: eventsSubscriptionsHandler,
+		statusHandler:              scf.statusCoreComponents.AppStatusHandler(),
+		managedPeersMonitor:        managedPeersMonitor,
+		cancelFunc:                 cancelFunc,
 	}
 
 	if scf.shardCoordinator.SelfId() == core.MetachainShardId {
@@ -233,6 +242,33 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
 }
 
+// createEventsSubscriptionsHandler creates, if enabled, the events hub serving the API subscriptions and subscribes it
+// as an outport driver, so it receives the same data as the other drivers
+func (scf *statusComponentsFactory) createEventsSubscriptionsHandler(
+	outportHandler outport.OutportHandler,
+) (outport.EventsSubscriptionsHandler, error) {
+	subscriptionsConfig := scf.externalConfig.EventsSubscriptions
+	if !subscriptionsConfig.Enabled {
+		return disabledOutport.NewDisabledEventsSubscriptionsHandler(), nil
+	}
+
+	eventsHub, err := subscriptions.NewEventsHub(subscriptions.ArgsEventsHub{
+		Config:           subscriptionsConfig,
+		Marshaller:       scf.coreComponents.InternalMarshalizer(),
+		AddressConverter: scf.coreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = outportHandler.SubscribeDriver(eventsHub)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsHub, nil
+}
+
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() indexerFactory.ArgsIndexerFactory {
 	elasticSearchConfig := scf.externalConfig.ElasticSearchConnector
 	return indexerFactory.ArgsIndexerFactory{
@@ -96,6 +96,9 @@ func (msc *managedStatusComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.outportHandler) {
 		return errors.ErrNilOutportHandler
 	}
+	if check.IfNil(msc.eventsSubscriptionsHandler) {
+		return errors.ErrNilEventsSubscriptionsHandler
+	}
 	if check.IfNil(msc.softwareVersion) {
 		return errors.ErrNilSoftwareVersion
 	}
@@ -156,6 +159,18 @@ func (msc *managedStatusComponents) OutportHandler() outport.OutportHandler {
 	return msc.statusComponents.outportHandler
 }
 
+// EventsSubscriptionsHandler returns the handler serving the API subscriptions to the node events
+func (msc *managedStatusComponents) EventsSubscriptionsHandler() outport.EventsSubscriptionsHandler {
+	msc.mutStatusComponents.RLock()
+	defer msc.mutStatusComponents.RUnlock()
+
+	if msc.statusComponents == nil {
+		return nil
+	}
+
+	return msc.statusComponents.eventsSubscriptionsHandler
+}
+
 // SoftwareVersionChecker returns the software version checker handler
 func (msc *managedStatusComponents) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	msc.mutStatusComponents.RLock()
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/statusHandler"
 	"github.com/multiversx/mx-chain-go/statusHandler/persister"
+	"github.com/multiversx/mx-chain-go/statusHandler/prometheusMetrics"
 	trieStatistics "github.com/multiversx/mx-chain-go/trie/statistics"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -49,6 +50,7 @@ type statusCoreComponents struct {
 	statusMetrics      external.StatusMetricsHandler
 	persistentHandler  factory.PersistentStatusHandler
 	stateStatsHandler  common.StateStatisticsHandler
+	metricsRegistry    common.MetricsRegistry
 }
 
 // NewStatusCoreComponentsFactory initializes the factory which is responsible to creating status core components
@@ -107,6 +109,7 @@ func (sccf *statusCoreComponentsFactory) Create() (*statusCoreComponents, error)
 		statusMetrics:      statusMetrics,
 		persistentHandler:  persistentStatusHandler,
 		stateStatsHandler:  stateStatsHandler,
+		metricsRegistry:    prometheusMetrics.NewPrometheusRegistry(),
 	}
 
 	return ssc, nil
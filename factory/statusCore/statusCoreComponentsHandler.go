@@ -94,6 +94,9 @@ func (mscc *managedStatusCoreComponents) CheckSubcomponents() error {
 	if check.IfNil(mscc.persistentHandler) {
 		return errors.ErrNilPersistentHandler
 	}
+	if check.IfNil(mscc.metricsRegistry) {
+		return errors.ErrNilMetricsRegistry
+	}
 
 	return nil
 }
@@ -182,6 +185,18 @@ func (mscc *managedStatusCoreComponents) StateStatsHandler() common.StateStatist
 	return mscc.statusCoreComponents.stateStatsHandler
 }
 
+// MetricsRegistry returns the typed metrics registry component
+func (mscc *managedStatusCoreComponents) MetricsRegistry() common.MetricsRegistry {
+	mscc.mutCoreComponents.RLock()
+	defer mscc.mutCoreComponents.RUnlock()
+
+	if mscc.statusCoreComponents == nil {
+		return nil
+	}
+
+	return mscc.statusCoreComponents.metricsRegistry
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mscc *managedStatusCoreComponents) IsInterfaceNil() bool {
 	return mscc == nil
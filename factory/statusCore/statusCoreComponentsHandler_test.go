@@ -65,6 +65,7 @@ func TestManagedStatusCoreComponents_Create(t *testing.T) {
 		require.Nil(t, managedStatusCoreComponents.StatusMetrics())
 		require.Nil(t, managedStatusCoreComponents.PersistentStatusHandler())
 		require.Nil(t, managedStatusCoreComponents.StateStatsHandler())
+		require.Nil(t, managedStatusCoreComponents.MetricsRegistry())
 
 		err = managedStatusCoreComponents.Create()
 		require.NoError(t, err)
@@ -76,6 +77,7 @@ func TestManagedStatusCoreComponents_Create(t *testing.T) {
 		require.NotNil(t, managedStatusCoreComponents.StatusMetrics())
 		require.NotNil(t, managedStatusCoreComponents.PersistentStatusHandler())
 		require.NotNil(t, managedStatusCoreComponents.StateStatsHandler())
+		require.NotNil(t, managedStatusCoreComponents.MetricsRegistry())
 
 		require.Equal(t, factory.StatusCoreComponentsName, managedStatusCoreComponents.String())
 	})
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
@@ -24,7 +26,7 @@ func (qss *QueryServiceStub) ComputeScCallGasLimit(tx *transaction.Transaction)
 }
 
 // ExecuteQuery -
-func (qss *QueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+func (qss *QueryServiceStub) ExecuteQuery(_ context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	if qss.ExecuteQueryCalled != nil {
 		return qss.ExecuteQueryCalled(query)
 	}
@@ -1,6 +1,7 @@
 package intermediate
 
 import (
+	"context"
 	"encoding/hex"
 	"strings"
 
@@ -104,7 +105,7 @@ func (dp *deployProcessor) checkVersion(sc genesis.InitialSmartContractHandler,
 		Arguments: [][]byte{},
 	}
 
-	vmOutputVersion, _, err := dp.scQueryService.ExecuteQuery(scQueryVersion)
+	vmOutputVersion, _, err := dp.scQueryService.ExecuteQuery(context.Background(), scQueryVersion)
 	if err != nil {
 		return err
 	}
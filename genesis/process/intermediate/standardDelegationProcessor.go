@@ -2,6 +2,7 @@ package intermediate
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -437,7 +438,7 @@ func (sdp *standardDelegationProcessor) checkDelegator(
 		FuncName:  "getUserStake",
 		Arguments: [][]byte{delegator.AddressBytes()},
 	}
-	vmOutputStakeValue, _, err := sdp.queryService.ExecuteQuery(scQueryStakeValue)
+	vmOutputStakeValue, _, err := sdp.queryService.ExecuteQuery(context.Background(), scQueryStakeValue)
 	if err != nil {
 		return err
 	}
@@ -489,7 +490,7 @@ func (sdp *standardDelegationProcessor) verifyOneNode(
 		Arguments: [][]byte{node.PubKeyBytes()},
 	}
 
-	vmOutput, _, err := sdp.queryService.ExecuteQuery(scQueryBlsKeys)
+	vmOutput, _, err := sdp.queryService.ExecuteQuery(context.Background(), scQueryBlsKeys)
 	if err != nil {
 		return err
 	}
@@ -21,3 +21,44 @@ type PubKeyHeartbeat struct {
 	PidString            string    `json:"pidString"`
 	NumTrieNodesReceived uint64    `json:"numTrieNodesReceived,omitempty"`
 }
+
+// OnlineInterval defines a time interval, as unix timestamps, in which a public key was seen online
+type OnlineInterval struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// PubKeyHistoryEvent defines a change noticed in the heartbeat information of a public key
+type PubKeyHistoryEvent struct {
+	Timestamp int64  `json:"timestamp"`
+	Type      string `json:"type"`
+	OldValue  string `json:"oldValue"`
+	NewValue  string `json:"newValue"`
+}
+
+// EpochUptime holds the time a public key was seen online during an epoch, out of the total monitored time
+type EpochUptime struct {
+	Epoch            uint32  `json:"epoch"`
+	OnlineSeconds    uint64  `json:"onlineSeconds"`
+	MonitoredSeconds uint64  `json:"monitoredSeconds"`
+	UptimePercentage float64 `json:"uptimePercentage"`
+}
+
+// PubKeyHeartbeatHistory holds the heartbeat history of a public key
+type PubKeyHeartbeatHistory struct {
+	PublicKey       string                `json:"publicKey"`
+	FirstSeen       int64                 `json:"firstSeen"`
+	LastSeen        int64                 `json:"lastSeen"`
+	VersionNumber   string                `json:"versionNumber"`
+	ComputedShardID uint32                `json:"computedShardID"`
+	Identity        string                `json:"identity"`
+	OnlineIntervals []*OnlineInterval     `json:"onlineIntervals"`
+	Events          []*PubKeyHistoryEvent `json:"events"`
+	Uptime          []*EpochUptime        `json:"uptime"`
+}
+
+// PubKeyUptime holds the uptime of a public key in a given epoch
+type PubKeyUptime struct {
+	PublicKey string `json:"publicKey"`
+	EpochUptime
+}
@@ -85,3 +85,15 @@ var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
 
 // ErrInvalidConfiguration signals that an invalid configuration has been provided
 var ErrInvalidConfiguration = errors.New("invalid configuration")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilEpochProvider signals that a nil epoch provider has been provided
+var ErrNilEpochProvider = errors.New("nil epoch provider")
+
+// ErrHeartbeatHistoryNotFound signals that no heartbeat history was found for the provided public key
+var ErrHeartbeatHistoryNotFound = errors.New("heartbeat history not found")
+
+// ErrHeartbeatHistoryDisabled signals that the heartbeat history is disabled
+var ErrHeartbeatHistoryDisabled = errors.New("heartbeat history is disabled")
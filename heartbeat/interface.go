@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
+	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
 )
@@ -101,3 +102,15 @@ type ShardCoordinator interface {
 	ComputeId(address []byte) uint32
 	IsInterfaceNil() bool
 }
+
+// EpochProvider defines the behavior of a component able to provide the current epoch
+type EpochProvider interface {
+	Epoch() uint32
+	IsInterfaceNil() bool
+}
+
+// HeartbeatsProvider defines the behavior of a component able to provide the current heartbeats snapshot
+type HeartbeatsProvider interface {
+	GetHeartbeats() []heartbeatData.PubKeyHeartbeat
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,34 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/heartbeat"
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+)
+
+type disabledHeartbeatHistory struct {
+}
+
+// NewDisabledHeartbeatHistory returns a new instance of disabledHeartbeatHistory
+func NewDisabledHeartbeatHistory() *disabledHeartbeatHistory {
+	return &disabledHeartbeatHistory{}
+}
+
+// GetHeartbeatHistory returns ErrHeartbeatHistoryDisabled
+func (history *disabledHeartbeatHistory) GetHeartbeatHistory(_ string) (*data.PubKeyHeartbeatHistory, error) {
+	return nil, heartbeat.ErrHeartbeatHistoryDisabled
+}
+
+// GetUptime returns ErrHeartbeatHistoryDisabled
+func (history *disabledHeartbeatHistory) GetUptime(_ uint32) ([]data.PubKeyUptime, error) {
+	return nil, heartbeat.ErrHeartbeatHistoryDisabled
+}
+
+// Close returns nil
+func (history *disabledHeartbeatHistory) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (history *disabledHeartbeatHistory) IsInterfaceNil() bool {
+	return history == nil
+}
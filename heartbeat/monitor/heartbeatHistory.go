@@ -0,0 +1,456 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/heartbeat"
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	historyEventVersionChange  = "version"
+	historyEventShardChange    = "shard"
+	historyEventIdentityChange = "identity"
+	minNumHistoryEntries       = 1
+	percentageMultiplier       = 100
+)
+
+// ArgHeartbeatHistory holds the arguments needed to create a new instance of heartbeatHistory
+type ArgHeartbeatHistory struct {
+	HeartbeatsProvider          heartbeat.HeartbeatsProvider
+	EpochProvider               heartbeat.EpochProvider
+	Storer                      storage.Storer
+	Marshaller                  marshal.Marshalizer
+	TimeBetweenSnapshots        time.Duration
+	RetentionPeriod             time.Duration
+	MaxOnlineIntervalsPerPubKey uint32
+	MaxEventsPerPubKey          uint32
+	MaxEpochsPerPubKey          uint32
+}
+
+type heartbeatHistory struct {
+	mutRecords                  sync.RWMutex
+	records                     map[string]*data.PubKeyHeartbeatHistory
+	dirtyPubKeys                map[string]struct{}
+	lastSnapshotEpoch           uint32
+	heartbeatsProvider          heartbeat.HeartbeatsProvider
+	epochProvider               heartbeat.EpochProvider
+	storer                      storage.Storer
+	marshaller                  marshal.Marshalizer
+	timeBetweenSnapshots        time.Duration
+	retentionPeriod             time.Duration
+	maxOnlineIntervalsPerPubKey int
+	maxEventsPerPubKey          int
+	maxEpochsPerPubKey          int
+	getTimeHandler              func() time.Time
+	cancelFunc                  func()
+}
+
+// NewHeartbeatHistory creates a new instance of heartbeatHistory which periodically snapshots the heartbeats
+// provided by the monitor and keeps, for each public key, the online intervals, the version, shard and identity
+// changes and the uptime per epoch. The history is persisted in the provided storer and reloaded at startup.
+// Public keys not seen online within the retention period are evicted
+func NewHeartbeatHistory(args ArgHeartbeatHistory) (*heartbeatHistory, error) {
+	err := checkHistoryArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &heartbeatHistory{
+		records:                     make(map[string]*data.PubKeyHeartbeatHistory),
+		dirtyPubKeys:                make(map[string]struct{}),
+		heartbeatsProvider:          args.HeartbeatsProvider,
+		epochProvider:               args.EpochProvider,
+		storer:                      args.Storer,
+		marshaller:                  args.Marshaller,
+		timeBetweenSnapshots:        args.TimeBetweenSnapshots,
+		retentionPeriod:             args.RetentionPeriod,
+		maxOnlineIntervalsPerPubKey: int(args.MaxOnlineIntervalsPerPubKey),
+		maxEventsPerPubKey:          int(args.MaxEventsPerPubKey),
+		maxEpochsPerPubKey:          int(args.MaxEpochsPerPubKey),
+		getTimeHandler:              time.Now,
+	}
+
+	history.loadFromStorage()
+	history.lastSnapshotEpoch = args.EpochProvider.Epoch()
+
+	var ctx context.Context
+	ctx, history.cancelFunc = context.WithCancel(context.Background())
+	go history.processSnapshots(ctx)
+
+	return history, nil
+}
+
+func checkHistoryArgs(args ArgHeartbeatHistory) error {
+	if check.IfNil(args.HeartbeatsProvider) {
+		return heartbeat.ErrNilHeartbeatMonitor
+	}
+	if check.IfNil(args.EpochProvider) {
+		return heartbeat.ErrNilEpochProvider
+	}
+	if check.IfNil(args.Storer) {
+		return heartbeat.ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return heartbeat.ErrNilMarshaller
+	}
+	if args.TimeBetweenSnapshots < minDuration {
+		return fmt.Errorf("%w on TimeBetweenSnapshots, provided %d, min expected %d",
+			heartbeat.ErrInvalidTimeDuration, args.TimeBetweenSnapshots, minDuration)
+	}
+	if args.RetentionPeriod < args.TimeBetweenSnapshots {
+		return fmt.Errorf("%w on RetentionPeriod, provided %d, min expected %d",
+			heartbeat.ErrInvalidTimeDuration, args.RetentionPeriod, args.TimeBetweenSnapshots)
+	}
+	if args.MaxOnlineIntervalsPerPubKey < minNumHistoryEntries {
+		return fmt.Errorf("%w for MaxOnlineIntervalsPerPubKey, provided %d, min expected %d",
+			heartbeat.ErrInvalidValue, args.MaxOnlineIntervalsPerPubKey, minNumHistoryEntries)
+	}
+	if args.MaxEventsPerPubKey < minNumHistoryEntries {
+		return fmt.Errorf("%w for MaxEventsPerPubKey, provided %d, min expected %d",
+			heartbeat.ErrInvalidValue, args.MaxEventsPerPubKey, minNumHistoryEntries)
+	}
+	if args.MaxEpochsPerPubKey < minNumHistoryEntries {
+		return fmt.Errorf("%w for MaxEpochsPerPubKey, provided %d, min expected %d",
+			heartbeat.ErrInvalidValue, args.MaxEpochsPerPubKey, minNumHistoryEntries)
+	}
+
+	return nil
+}
+
+func (history *heartbeatHistory) loadFromStorage() {
+	history.storer.RangeKeys(func(key []byte, value []byte) bool {
+		record := &data.PubKeyHeartbeatHistory{}
+		err := history.marshaller.Unmarshal(record, value)
+		if err != nil {
+			log.Debug("heartbeatHistory.loadFromStorage: can not unmarshal record", "error", err)
+			return true
+		}
+
+		history.records[string(key)] = record
+
+		return true
+	})
+
+	log.Debug("heartbeatHistory: loaded records from storage", "num records", len(history.records))
+}
+
+func (history *heartbeatHistory) processSnapshots(ctx context.Context) {
+	timer := time.NewTimer(history.timeBetweenSnapshots)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(history.timeBetweenSnapshots)
+
+		select {
+		case <-ctx.Done():
+			log.Debug("heartbeatHistory's go routine is stopping...")
+			return
+		case <-timer.C:
+		}
+
+		history.addSnapshot(history.heartbeatsProvider.GetHeartbeats())
+	}
+}
+
+// addSnapshot updates the records of the provided heartbeats and evicts the public keys that were not seen online
+// within the retention period. Only the changed records are persisted: the offline time accumulated by the public keys
+// that are not online is saved at the next change of the record, at epoch change or on close
+func (history *heartbeatHistory) addSnapshot(heartbeats []data.PubKeyHeartbeat) {
+	now := history.getTimeHandler()
+	epoch := history.epochProvider.Epoch()
+	elapsedSeconds := uint64(history.timeBetweenSnapshots / time.Second)
+	retentionLimit := now.Add(-history.retentionPeriod).Unix()
+
+	history.mutRecords.Lock()
+	isNewEpoch := epoch != history.lastSnapshotEpoch
+	history.lastSnapshotEpoch = epoch
+
+	seenPubKeys := make(map[string]struct{}, len(heartbeats))
+	for idx := range heartbeats {
+		hb := &heartbeats[idx]
+		seenPubKeys[hb.PublicKey] = struct{}{}
+
+		record, found := history.records[hb.PublicKey]
+		if !found {
+			if !hb.IsActive && hb.TimeStamp.Unix() < retentionLimit {
+				continue
+			}
+
+			record = newPubKeyHeartbeatHistory(hb, now)
+			history.records[hb.PublicKey] = record
+			history.dirtyPubKeys[hb.PublicKey] = struct{}{}
+		}
+
+		hasChanged := history.recordChanges(record, hb, now)
+		if hb.IsActive {
+			record.LastSeen = hb.TimeStamp.Unix()
+			history.markOnline(record, now)
+			hasChanged = true
+		}
+		hasChanged = history.addUptime(record, epoch, elapsedSeconds, hb.IsActive) || hasChanged
+		if hasChanged {
+			history.dirtyPubKeys[hb.PublicKey] = struct{}{}
+		}
+	}
+
+	evictedPubKeys := make([]string, 0)
+	for pubKey, record := range history.records {
+		if getLastActivity(record) < retentionLimit {
+			delete(history.records, pubKey)
+			delete(history.dirtyPubKeys, pubKey)
+			evictedPubKeys = append(evictedPubKeys, pubKey)
+			continue
+		}
+
+		_, seen := seenPubKeys[pubKey]
+		if !seen && history.addUptime(record, epoch, elapsedSeconds, false) {
+			history.dirtyPubKeys[pubKey] = struct{}{}
+		}
+		if isNewEpoch {
+			history.dirtyPubKeys[pubKey] = struct{}{}
+		}
+	}
+
+	marshalledRecords := history.marshalDirtyRecordsNoLock()
+	history.mutRecords.Unlock()
+
+	history.saveRecords(marshalledRecords)
+	for _, pubKey := range evictedPubKeys {
+		err := history.storer.Remove([]byte(pubKey))
+		if err != nil {
+			log.Debug("heartbeatHistory.addSnapshot: can not remove record", "public key", pubKey, "error", err)
+		}
+	}
+}
+
+// getLastActivity returns the last snapshot time in which the public key was online or, if it was never online,
+// the time it was first seen
+func getLastActivity(record *data.PubKeyHeartbeatHistory) int64 {
+	numIntervals := len(record.OnlineIntervals)
+	if numIntervals > 0 {
+		return record.OnlineIntervals[numIntervals-1].End
+	}
+
+	return record.FirstSeen
+}
+
+func (history *heartbeatHistory) marshalDirtyRecordsNoLock() map[string][]byte {
+	marshalledRecords := make(map[string][]byte, len(history.dirtyPubKeys))
+	for pubKey := range history.dirtyPubKeys {
+		buff, err := history.marshaller.Marshal(history.records[pubKey])
+		if err != nil {
+			log.Warn("heartbeatHistory: can not marshal record", "public key", pubKey, "error", err)
+			continue
+		}
+
+		marshalledRecords[pubKey] = buff
+	}
+	history.dirtyPubKeys = make(map[string]struct{})
+
+	return marshalledRecords
+}
+
+func (history *heartbeatHistory) saveRecords(marshalledRecords map[string][]byte) {
+	for pubKey, buff := range marshalledRecords {
+		err := history.storer.Put([]byte(pubKey), buff)
+		if err != nil {
+			log.Warn("heartbeatHistory: can not save record", "public key", pubKey, "error", err)
+		}
+	}
+}
+
+func newPubKeyHeartbeatHistory(hb *data.PubKeyHeartbeat, now time.Time) *data.PubKeyHeartbeatHistory {
+	return &data.PubKeyHeartbeatHistory{
+		PublicKey:       hb.PublicKey,
+		FirstSeen:       now.Unix(),
+		VersionNumber:   hb.VersionNumber,
+		ComputedShardID: hb.ComputedShardID,
+		Identity:        hb.Identity,
+		OnlineIntervals: make([]*data.OnlineInterval, 0),
+		Events:          make([]*data.PubKeyHistoryEvent, 0),
+		Uptime:          make([]*data.EpochUptime, 0),
+	}
+}
+
+// recordChanges returns true if the version, the shard or the identity of the public key changed
+func (history *heartbeatHistory) recordChanges(record *data.PubKeyHeartbeatHistory, hb *data.PubKeyHeartbeat, now time.Time) bool {
+	hasChanged := false
+	if record.VersionNumber != hb.VersionNumber {
+		history.addEvent(record, now, historyEventVersionChange, record.VersionNumber, hb.VersionNumber)
+		record.VersionNumber = hb.VersionNumber
+		hasChanged = true
+	}
+	if record.ComputedShardID != hb.ComputedShardID {
+		history.addEvent(record, now, historyEventShardChange, fmt.Sprintf("%d", record.ComputedShardID), fmt.Sprintf("%d", hb.ComputedShardID))
+		record.ComputedShardID = hb.ComputedShardID
+		hasChanged = true
+	}
+	if record.Identity != hb.Identity {
+		history.addEvent(record, now, historyEventIdentityChange, record.Identity, hb.Identity)
+		record.Identity = hb.Identity
+		hasChanged = true
+	}
+
+	return hasChanged
+}
+
+func (history *heartbeatHistory) addEvent(record *data.PubKeyHeartbeatHistory, now time.Time, eventType string, oldValue string, newValue string) {
+	record.Events = append(record.Events, &data.PubKeyHistoryEvent{
+		Timestamp: now.Unix(),
+		Type:      eventType,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+	})
+	if len(record.Events) > history.maxEventsPerPubKey {
+		record.Events = record.Events[len(record.Events)-history.maxEventsPerPubKey:]
+	}
+}
+
+// markOnline extends the last online interval if the public key was online at the previous snapshot, otherwise a
+// new interval is started
+func (history *heartbeatHistory) markOnline(record *data.PubKeyHeartbeatHistory, now time.Time) {
+	nowUnix := now.Unix()
+	maxGapInSeconds := int64((history.timeBetweenSnapshots + history.timeBetweenSnapshots/2) / time.Second)
+
+	numIntervals := len(record.OnlineIntervals)
+	if numIntervals > 0 {
+		lastInterval := record.OnlineIntervals[numIntervals-1]
+		if nowUnix-lastInterval.End <= maxGapInSeconds {
+			lastInterval.End = nowUnix
+			return
+		}
+	}
+
+	record.OnlineIntervals = append(record.OnlineIntervals, &data.OnlineInterval{
+		Start: nowUnix,
+		End:   nowUnix,
+	})
+	if len(record.OnlineIntervals) > history.maxOnlineIntervalsPerPubKey {
+		record.OnlineIntervals = record.OnlineIntervals[len(record.OnlineIntervals)-history.maxOnlineIntervalsPerPubKey:]
+	}
+}
+
+// addUptime returns true if the record should be persisted, that is, if a new epoch was added or the public key is online
+func (history *heartbeatHistory) addUptime(record *data.PubKeyHeartbeatHistory, epoch uint32, elapsedSeconds uint64, isOnline bool) bool {
+	isNewEpoch := false
+	var epochUptime *data.EpochUptime
+	numEpochs := len(record.Uptime)
+	if numEpochs > 0 && record.Uptime[numEpochs-1].Epoch == epoch {
+		epochUptime = record.Uptime[numEpochs-1]
+	} else {
+		epochUptime = &data.EpochUptime{
+			Epoch: epoch,
+		}
+		record.Uptime = append(record.Uptime, epochUptime)
+		isNewEpoch = true
+		if len(record.Uptime) > history.maxEpochsPerPubKey {
+			record.Uptime = record.Uptime[len(record.Uptime)-history.maxEpochsPerPubKey:]
+		}
+	}
+
+	epochUptime.MonitoredSeconds += elapsedSeconds
+	if isOnline {
+		epochUptime.OnlineSeconds += elapsedSeconds
+	}
+
+	return isNewEpoch || isOnline
+}
+
+// GetHeartbeatHistory returns the heartbeat history of the provided public key
+func (history *heartbeatHistory) GetHeartbeatHistory(pubKey string) (*data.PubKeyHeartbeatHistory, error) {
+	history.mutRecords.RLock()
+	defer history.mutRecords.RUnlock()
+
+	record, found := history.records[pubKey]
+	if !found {
+		return nil, fmt.Errorf("%w for public key %s", heartbeat.ErrHeartbeatHistoryNotFound, pubKey)
+	}
+
+	return copyHistoryRecord(record), nil
+}
+
+// GetUptime returns the uptime of all the monitored public keys, in the provided epoch
+func (history *heartbeatHistory) GetUptime(epoch uint32) ([]data.PubKeyUptime, error) {
+	history.mutRecords.RLock()
+	defer history.mutRecords.RUnlock()
+
+	uptimes := make([]data.PubKeyUptime, 0, len(history.records))
+	for pubKey, record := range history.records {
+		for _, epochUptime := range record.Uptime {
+			if epochUptime.Epoch != epoch {
+				continue
+			}
+
+			uptimes = append(uptimes, data.PubKeyUptime{
+				PublicKey:   pubKey,
+				EpochUptime: *computeUptimePercentage(epochUptime),
+			})
+			break
+		}
+	}
+
+	sort.Slice(uptimes, func(i, j int) bool {
+		return strings.Compare(uptimes[i].PublicKey, uptimes[j].PublicKey) < 0
+	})
+
+	return uptimes, nil
+}
+
+func copyHistoryRecord(record *data.PubKeyHeartbeatHistory) *data.PubKeyHeartbeatHistory {
+	recordCopy := *record
+	recordCopy.OnlineIntervals = make([]*data.OnlineInterval, 0, len(record.OnlineIntervals))
+	for _, interval := range record.OnlineIntervals {
+		intervalCopy := *interval
+		recordCopy.OnlineIntervals = append(recordCopy.OnlineIntervals, &intervalCopy)
+	}
+	recordCopy.Events = make([]*data.PubKeyHistoryEvent, 0, len(record.Events))
+	for _, event := range record.Events {
+		eventCopy := *event
+		recordCopy.Events = append(recordCopy.Events, &eventCopy)
+	}
+	recordCopy.Uptime = make([]*data.EpochUptime, 0, len(record.Uptime))
+	for _, epochUptime := range record.Uptime {
+		recordCopy.Uptime = append(recordCopy.Uptime, computeUptimePercentage(epochUptime))
+	}
+
+	return &recordCopy
+}
+
+func computeUptimePercentage(epochUptime *data.EpochUptime) *data.EpochUptime {
+	result := *epochUptime
+	if result.MonitoredSeconds > 0 {
+		result.UptimePercentage = float64(result.OnlineSeconds) * percentageMultiplier / float64(result.MonitoredSeconds)
+	}
+
+	return &result
+}
+
+// Close stops the snapshots go routine, saves all the records and closes the inner storer
+func (history *heartbeatHistory) Close() error {
+	history.cancelFunc()
+
+	history.mutRecords.Lock()
+	for pubKey := range history.records {
+		history.dirtyPubKeys[pubKey] = struct{}{}
+	}
+	marshalledRecords := history.marshalDirtyRecordsNoLock()
+	history.mutRecords.Unlock()
+
+	history.saveRecords(marshalledRecords)
+
+	return history.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (history *heartbeatHistory) IsInterfaceNil() bool {
+	return history == nil
+}
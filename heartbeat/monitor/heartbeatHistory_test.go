@@ -0,0 +1,414 @@
+package monitor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/heartbeat"
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/multiversx/mx-chain-go/heartbeat/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockHeartbeatHistoryArgs() ArgHeartbeatHistory {
+	return ArgHeartbeatHistory{
+		HeartbeatsProvider:          &mock.HeartbeatMonitorStub{},
+		EpochProvider:               &testscommon.EpochStartTriggerStub{},
+		Storer:                      genericMocks.NewStorerMock(),
+		Marshaller:                  &marshal.JsonMarshalizer{},
+		TimeBetweenSnapshots:        time.Minute,
+		RetentionPeriod:             time.Hour,
+		MaxOnlineIntervalsPerPubKey: 10,
+		MaxEventsPerPubKey:          10,
+		MaxEpochsPerPubKey:          10,
+	}
+}
+
+func createHeartbeat(pubKey string, isActive bool, version string, shardID uint32, identity string) data.PubKeyHeartbeat {
+	return data.PubKeyHeartbeat{
+		PublicKey:       pubKey,
+		TimeStamp:       time.Unix(1000, 0),
+		IsActive:        isActive,
+		VersionNumber:   version,
+		ComputedShardID: shardID,
+		Identity:        identity,
+	}
+}
+
+func TestNewHeartbeatHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil heartbeats provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.HeartbeatsProvider = nil
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.Equal(t, heartbeat.ErrNilHeartbeatMonitor, err)
+	})
+	t.Run("nil epoch provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.EpochProvider = nil
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.Equal(t, heartbeat.ErrNilEpochProvider, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.Storer = nil
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.Equal(t, heartbeat.ErrNilStorer, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.Marshaller = nil
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.Equal(t, heartbeat.ErrNilMarshaller, err)
+	})
+	t.Run("invalid time between snapshots should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.TimeBetweenSnapshots = time.Second - time.Nanosecond
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+	})
+	t.Run("invalid retention period should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.RetentionPeriod = args.TimeBetweenSnapshots - time.Nanosecond
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.True(t, errors.Is(err, heartbeat.ErrInvalidTimeDuration))
+	})
+	t.Run("invalid max online intervals should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.MaxOnlineIntervalsPerPubKey = 0
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.True(t, errors.Is(err, heartbeat.ErrInvalidValue))
+	})
+	t.Run("invalid max events should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.MaxEventsPerPubKey = 0
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.True(t, errors.Is(err, heartbeat.ErrInvalidValue))
+	})
+	t.Run("invalid max epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.MaxEpochsPerPubKey = 0
+		history, err := NewHeartbeatHistory(args)
+		assert.True(t, check.IfNil(history))
+		assert.True(t, errors.Is(err, heartbeat.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		history, err := NewHeartbeatHistory(createMockHeartbeatHistoryArgs())
+		assert.False(t, check.IfNil(history))
+		assert.Nil(t, err)
+		assert.Nil(t, history.Close())
+	})
+}
+
+func TestHeartbeatHistory_ProcessSnapshotsShouldCallTheProvider(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHeartbeatHistoryArgs()
+	args.TimeBetweenSnapshots = time.Second
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	once := sync.Once{}
+	args.HeartbeatsProvider = &mock.HeartbeatMonitorStub{
+		GetHeartbeatsCalled: func() []data.PubKeyHeartbeat {
+			once.Do(wg.Done)
+			return []data.PubKeyHeartbeat{createHeartbeat("pk", true, "v1", 0, "")}
+		},
+	}
+
+	history, _ := NewHeartbeatHistory(args)
+	wg.Wait()
+	assert.Nil(t, history.Close())
+}
+
+func TestHeartbeatHistory_AddSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should compute online intervals and uptime", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		epoch := uint32(5)
+		args.EpochProvider = &testscommon.EpochStartTriggerStub{
+			EpochCalled: func() uint32 {
+				return epoch
+			},
+		}
+		history, _ := NewHeartbeatHistory(args)
+		defer func() {
+			_ = history.Close()
+		}()
+
+		currentTime := time.Unix(10000, 0)
+		history.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		online := []data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", true, "v1", 1, "")}
+		onlyPk1 := []data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", false, "v1", 1, "")}
+		snapshots := [][]data.PubKeyHeartbeat{online, online, onlyPk1, online}
+		for _, snapshot := range snapshots {
+			history.addSnapshot(snapshot)
+			currentTime = currentTime.Add(time.Minute)
+		}
+
+		pk1History, err := history.GetHeartbeatHistory("pk1")
+		require.Nil(t, err)
+		assert.Equal(t, []*data.OnlineInterval{{Start: 10000, End: 10180}}, pk1History.OnlineIntervals)
+		require.Equal(t, 1, len(pk1History.Uptime))
+		assert.Equal(t, float64(100), pk1History.Uptime[0].UptimePercentage)
+
+		pk2History, err := history.GetHeartbeatHistory("pk2")
+		require.Nil(t, err)
+		expectedIntervals := []*data.OnlineInterval{
+			{Start: 10000, End: 10060},
+			{Start: 10180, End: 10180},
+		}
+		assert.Equal(t, expectedIntervals, pk2History.OnlineIntervals)
+
+		uptime, err := history.GetUptime(epoch)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(uptime))
+		assert.Equal(t, "pk1", uptime[0].PublicKey)
+		assert.Equal(t, uint64(240), uptime[0].MonitoredSeconds)
+		assert.Equal(t, uint64(240), uptime[0].OnlineSeconds)
+		assert.Equal(t, "pk2", uptime[1].PublicKey)
+		assert.Equal(t, uint64(240), uptime[1].MonitoredSeconds)
+		assert.Equal(t, uint64(180), uptime[1].OnlineSeconds)
+		assert.Equal(t, float64(75), uptime[1].UptimePercentage)
+
+		uptime, err = history.GetUptime(epoch + 1)
+		assert.Nil(t, err)
+		assert.Empty(t, uptime)
+	})
+	t.Run("missing public key should be counted as offline", func(t *testing.T) {
+		t.Parallel()
+
+		history, _ := NewHeartbeatHistory(createMockHeartbeatHistoryArgs())
+		defer func() {
+			_ = history.Close()
+		}()
+
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk", true, "v1", 0, "")})
+		history.addSnapshot(make([]data.PubKeyHeartbeat, 0))
+
+		uptime, err := history.GetUptime(0)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(uptime))
+		assert.Equal(t, float64(50), uptime[0].UptimePercentage)
+	})
+	t.Run("should record version, shard and identity changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.MaxEventsPerPubKey = 2
+		history, _ := NewHeartbeatHistory(args)
+		defer func() {
+			_ = history.Close()
+		}()
+
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk", true, "v1", 0, "id1")})
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk", true, "v2", 1, "id2")})
+
+		pkHistory, err := history.GetHeartbeatHistory("pk")
+		require.Nil(t, err)
+		assert.Equal(t, "v2", pkHistory.VersionNumber)
+		assert.Equal(t, uint32(1), pkHistory.ComputedShardID)
+		assert.Equal(t, "id2", pkHistory.Identity)
+		require.Equal(t, 2, len(pkHistory.Events))
+		assert.Equal(t, historyEventShardChange, pkHistory.Events[0].Type)
+		assert.Equal(t, "0", pkHistory.Events[0].OldValue)
+		assert.Equal(t, "1", pkHistory.Events[0].NewValue)
+		assert.Equal(t, historyEventIdentityChange, pkHistory.Events[1].Type)
+	})
+	t.Run("public keys not seen within the retention period should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.RetentionPeriod = time.Minute * 3
+		removedKeys := make([]string, 0)
+		args.Storer = &storageStubs.StorerStub{
+			RemoveCalled: func(key []byte) error {
+				removedKeys = append(removedKeys, string(key))
+				return nil
+			},
+		}
+		history, _ := NewHeartbeatHistory(args)
+		defer func() {
+			_ = history.Close()
+		}()
+
+		currentTime := time.Unix(10000, 0)
+		history.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", true, "v1", 0, "")})
+		for i := 0; i < 3; i++ {
+			currentTime = currentTime.Add(time.Minute)
+			history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", false, "v1", 0, "")})
+		}
+		_, err := history.GetHeartbeatHistory("pk2")
+		assert.Nil(t, err)
+		assert.Empty(t, removedKeys)
+
+		currentTime = currentTime.Add(time.Minute)
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, "")})
+		_, err = history.GetHeartbeatHistory("pk2")
+		assert.True(t, errors.Is(err, heartbeat.ErrHeartbeatHistoryNotFound))
+		_, err = history.GetHeartbeatHistory("pk1")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"pk2"}, removedKeys)
+
+		// an old inactive heartbeat should not re-create the evicted record
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", false, "v1", 0, "")})
+		_, err = history.GetHeartbeatHistory("pk2")
+		assert.True(t, errors.Is(err, heartbeat.ErrHeartbeatHistoryNotFound))
+	})
+	t.Run("should persist only the changed records", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		epoch := uint32(0)
+		args.EpochProvider = &testscommon.EpochStartTriggerStub{
+			EpochCalled: func() uint32 {
+				return epoch
+			},
+		}
+		savedKeys := make([]string, 0)
+		args.Storer = &storageStubs.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				savedKeys = append(savedKeys, string(key))
+				return nil
+			},
+		}
+		history, _ := NewHeartbeatHistory(args)
+
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", true, "v1", 0, "")})
+		assert.ElementsMatch(t, []string{"pk1", "pk2"}, savedKeys)
+
+		savedKeys = savedKeys[:0]
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", false, "v1", 0, "")})
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, "")})
+		assert.Equal(t, []string{"pk1", "pk1"}, savedKeys)
+
+		savedKeys = savedKeys[:0]
+		history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk1", true, "v1", 0, ""), createHeartbeat("pk2", false, "v2", 0, "")})
+		assert.ElementsMatch(t, []string{"pk1", "pk2"}, savedKeys)
+
+		savedKeys = savedKeys[:0]
+		epoch = 1
+		history.addSnapshot(make([]data.PubKeyHeartbeat, 0))
+		assert.ElementsMatch(t, []string{"pk1", "pk2"}, savedKeys)
+
+		savedKeys = savedKeys[:0]
+		history.addSnapshot(make([]data.PubKeyHeartbeat, 0))
+		assert.Empty(t, savedKeys)
+
+		assert.Nil(t, history.Close())
+		assert.ElementsMatch(t, []string{"pk1", "pk2"}, savedKeys)
+	})
+	t.Run("should cap the kept epochs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHeartbeatHistoryArgs()
+		args.MaxEpochsPerPubKey = 2
+		epoch := uint32(0)
+		args.EpochProvider = &testscommon.EpochStartTriggerStub{
+			EpochCalled: func() uint32 {
+				return epoch
+			},
+		}
+		history, _ := NewHeartbeatHistory(args)
+		defer func() {
+			_ = history.Close()
+		}()
+
+		for epoch = 0; epoch < 4; epoch++ {
+			history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk", true, "v1", 0, "")})
+		}
+
+		pkHistory, _ := history.GetHeartbeatHistory("pk")
+		require.Equal(t, 2, len(pkHistory.Uptime))
+		assert.Equal(t, uint32(2), pkHistory.Uptime[0].Epoch)
+		assert.Equal(t, uint32(3), pkHistory.Uptime[1].Epoch)
+	})
+}
+
+func TestHeartbeatHistory_GetHeartbeatHistory(t *testing.T) {
+	t.Parallel()
+
+	history, _ := NewHeartbeatHistory(createMockHeartbeatHistoryArgs())
+	defer func() {
+		_ = history.Close()
+	}()
+
+	pkHistory, err := history.GetHeartbeatHistory("missing")
+	assert.Nil(t, pkHistory)
+	assert.True(t, errors.Is(err, heartbeat.ErrHeartbeatHistoryNotFound))
+
+	history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk", true, "v1", 0, "")})
+	pkHistory, err = history.GetHeartbeatHistory("pk")
+	require.Nil(t, err)
+
+	// altering the returned copy should not affect the kept record
+	pkHistory.Uptime[0].OnlineSeconds = 0
+	pkHistory, _ = history.GetHeartbeatHistory("pk")
+	assert.Equal(t, uint64(60), pkHistory.Uptime[0].OnlineSeconds)
+}
+
+func TestHeartbeatHistory_ShouldReloadFromStorage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHeartbeatHistoryArgs()
+	history, _ := NewHeartbeatHistory(args)
+	history.addSnapshot([]data.PubKeyHeartbeat{createHeartbeat("pk", true, "v1", 0, "")})
+	expectedHistory, _ := history.GetHeartbeatHistory("pk")
+	require.Nil(t, history.Close())
+
+	reloadedHistory, err := NewHeartbeatHistory(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = reloadedHistory.Close()
+	}()
+
+	pkHistory, err := reloadedHistory.GetHeartbeatHistory("pk")
+	require.Nil(t, err)
+	assert.Equal(t, expectedHistory, pkHistory)
+}
@@ -0,0 +1,285 @@
+package guardian
+
+import (
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	testsChainSimulator "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/process/guardian/coSigner"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultPathToInitialConfig              = "../../../cmd/node/config/"
+	minGasPrice                             = 1_000_000_000
+	guardianServiceUID                      = "chain-simulator-guardian"
+	otpPeriodInSeconds                      = 30
+	maxNumOfBlocksToGenerateWhenExecutingTx = 10
+	roundsPerEpoch                          = 20
+)
+
+var (
+	oneEGLD         = big.NewInt(1000000000000000000)
+	keyGenerator    = signing.NewKeyGenerator(ed25519.NewEd25519())
+	singleSigner    = &singlesig.Ed25519Signer{}
+	base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// otpGenerator mimics an authenticator app, providing each time a code that was not used before
+type otpGenerator struct {
+	secret           []byte
+	lastUsedTimeStep int64
+}
+
+func (generator *otpGenerator) nextCode() string {
+	timeStep := time.Now().Unix() / otpPeriodInSeconds
+	if timeStep <= generator.lastUsedTimeStep {
+		timeStep = generator.lastUsedTimeStep + 1
+	}
+
+	// the co-signer accepts the code of the next period, but not the ones further in the future
+	for time.Now().Unix()/otpPeriodInSeconds+1 < timeStep {
+		time.Sleep(time.Second)
+	}
+
+	generator.lastUsedTimeStep = timeStep
+
+	return coSigner.ComputeOTPCode(generator.secret, timeStep*otpPeriodInSeconds, otpPeriodInSeconds)
+}
+
+func TestGuardianCoSignerWithChainSimulator(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	guardianKey, guardianPubKey := keyGenerator.GeneratePair()
+	guardianPemFile := saveGuardianKey(t, guardianKey, guardianPubKey)
+
+	cs := startChainSimulator(t, func(cfg *config.Configs) {
+		cfg.GeneralConfig.GeneralSettings.SetGuardianEpochsDelay = 1
+		cfg.GeneralConfig.GuardianCoSigner.Enabled = true
+		cfg.GeneralConfig.GuardianCoSigner.ServiceUID = guardianServiceUID
+		cfg.GeneralConfig.GuardianCoSigner.GuardianKeyPemFile = guardianPemFile
+		cfg.GeneralConfig.GuardianCoSigner.OTPPeriodInSeconds = otpPeriodInSeconds
+	})
+	defer cs.Close()
+
+	nodeHandler := cs.GetNodeHandler(0)
+	facade := nodeHandler.GetFacadeHandler()
+	pkConv := nodeHandler.GetCoreComponents().AddressPubKeyConverter()
+
+	ownerKey, owner := generateAccountInShard(t, cs, 0)
+	receiver, err := cs.GenerateAndMintWalletAddress(0, big.NewInt(0))
+	require.NoError(t, err)
+
+	initialBalance := big.NewInt(0).Mul(oneEGLD, big.NewInt(10))
+	err = cs.SetStateMultiple([]*dtos.AddressState{
+		{
+			Address: owner.Bech32,
+			Balance: initialBalance.String(),
+		},
+	})
+	require.NoError(t, err)
+	err = cs.GenerateBlocks(1)
+	require.NoError(t, err)
+
+	// register the owner on the co-signing service and confirm the registration
+	registration, err := facade.RegisterGuardedAccount(owner.Bech32, hex.EncodeToString(signRegistration(t, ownerKey, owner.Bech32)))
+	require.NoError(t, err)
+	require.Equal(t, guardianServiceUID, registration.ServiceUID)
+	guardianAddress, err := pkConv.Decode(registration.GuardianAddress)
+	require.NoError(t, err)
+
+	otp := &otpGenerator{
+		secret: decodeOTPSecret(t, registration.OTPSecret),
+	}
+	err = facade.VerifyGuardianCode(owner.Bech32, otp.nextCode())
+	require.NoError(t, err)
+
+	// set and activate the guardian on chain
+	setGuardianData := fmt.Sprintf("SetGuardian@%s@%s", hex.EncodeToString(guardianAddress), hex.EncodeToString([]byte(guardianServiceUID)))
+	setGuardianTx := generateTransaction(owner.Bytes, 0, owner.Bytes, big.NewInt(0), setGuardianData, 1_000_000)
+	signTransaction(t, cs, setGuardianTx, ownerKey)
+	result, err := cs.SendTxAndGenerateBlockTilTxIsExecuted(setGuardianTx, maxNumOfBlocksToGenerateWhenExecutingTx)
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, result.Status)
+
+	currentEpoch := nodeHandler.GetCoreComponents().EpochNotifier().CurrentEpoch()
+	err = cs.GenerateBlocksUntilEpochIsReached(int32(currentEpoch + 2))
+	require.NoError(t, err)
+
+	guardAccountTx := generateTransaction(owner.Bytes, 1, owner.Bytes, big.NewInt(0), "GuardAccount", 1_000_000)
+	signTransaction(t, cs, guardAccountTx, ownerKey)
+	result, err = cs.SendTxAndGenerateBlockTilTxIsExecuted(guardAccountTx, maxNumOfBlocksToGenerateWhenExecutingTx)
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, result.Status)
+
+	// limit the daily EGLD transfers to 1 EGLD
+	err = facade.SetGuardianSpendingPolicy(owner.Bech32, otp.nextCode(), &common.GuardianSpendingPolicy{
+		DailyLimits: map[string]string{"EGLD": oneEGLD.String()},
+	})
+	require.NoError(t, err)
+
+	// a transfer above the daily limit should not be co-signed, the spending policy being checked before the code
+	tooExpensiveTx := generateGuardedTransaction(owner.Bytes, 2, receiver.Bytes, big.NewInt(0).Mul(oneEGLD, big.NewInt(2)), guardianAddress)
+	signTransaction(t, cs, tooExpensiveTx, ownerKey)
+	guardianSignature, err := facade.CoSignTransaction(tooExpensiveTx, "000000")
+	require.ErrorIs(t, err, coSigner.ErrDailyLimitExceeded)
+	require.Nil(t, guardianSignature)
+
+	// a transfer within the daily limit should be co-signed and executed
+	transferValue := big.NewInt(0).Div(oneEGLD, big.NewInt(2))
+	guardedTx := generateGuardedTransaction(owner.Bytes, 2, receiver.Bytes, transferValue, guardianAddress)
+	signTransaction(t, cs, guardedTx, ownerKey)
+	guardedTx.GuardianSignature, err = facade.CoSignTransaction(guardedTx, otp.nextCode())
+	require.NoError(t, err)
+
+	dataToSign := getDataForSigning(t, cs, guardedTx)
+	err = singleSigner.Verify(guardianPubKey, dataToSign, guardedTx.GuardianSignature)
+	require.NoError(t, err)
+
+	result, err = cs.SendTxAndGenerateBlockTilTxIsExecuted(guardedTx, maxNumOfBlocksToGenerateWhenExecutingTx)
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, result.Status)
+
+	receiverAccount, err := cs.GetAccount(receiver)
+	require.NoError(t, err)
+	require.Equal(t, transferValue.String(), receiverAccount.Balance)
+}
+
+func startChainSimulator(t *testing.T, alterConfigsFunction func(cfg *config.Configs)) testsChainSimulator.ChainSimulator {
+	cs, err := chainSimulator.NewChainSimulator(chainSimulator.ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  uint64(6000),
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    roundsPerEpoch,
+		},
+		ApiInterface:             api.NewNoApiInterface(),
+		MinNodesPerShard:         3,
+		MetaChainMinNodes:        3,
+		NumNodesWaitingListMeta:  3,
+		NumNodesWaitingListShard: 3,
+		AlterConfigsFunction:     alterConfigsFunction,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+
+	err = cs.GenerateBlocksUntilEpochIsReached(1)
+	require.NoError(t, err)
+
+	return cs
+}
+
+func saveGuardianKey(t *testing.T, guardianKey crypto.PrivateKey, guardianPubKey crypto.PublicKey) string {
+	skBytes, err := guardianKey.ToByteArray()
+	require.NoError(t, err)
+	pkBytes, err := guardianPubKey.ToByteArray()
+	require.NoError(t, err)
+
+	pemFile := filepath.Join(t.TempDir(), "guardianKey.pem")
+	file, err := os.Create(pemFile)
+	require.NoError(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	err = core.SaveSkToPemFile(file, hex.EncodeToString(pkBytes), []byte(hex.EncodeToString(skBytes)))
+	require.NoError(t, err)
+
+	return pemFile
+}
+
+func generateAccountInShard(t *testing.T, cs testsChainSimulator.ChainSimulator, shardID uint32) (crypto.PrivateKey, dtos.WalletAddress) {
+	nodeHandler := cs.GetNodeHandler(shardID)
+	for {
+		sk, pk := keyGenerator.GeneratePair()
+		pkBytes, err := pk.ToByteArray()
+		require.NoError(t, err)
+		if nodeHandler.GetShardCoordinator().ComputeId(pkBytes) != shardID {
+			continue
+		}
+
+		bech32, err := nodeHandler.GetCoreComponents().AddressPubKeyConverter().Encode(pkBytes)
+		require.NoError(t, err)
+
+		return sk, dtos.WalletAddress{Bech32: bech32, Bytes: pkBytes}
+	}
+}
+
+func signRegistration(t *testing.T, sk crypto.PrivateKey, address string) []byte {
+	message := fmt.Sprintf("%s:register:%s", guardianServiceUID, address)
+	payload := fmt.Sprintf("\x17Elrond Signed Message:\n%d%s", len(message), message)
+
+	signature, err := singleSigner.Sign(sk, keccak.NewKeccak().Compute(payload))
+	require.NoError(t, err)
+
+	return signature
+}
+
+func decodeOTPSecret(t *testing.T, encodedSecret string) []byte {
+	secret, err := base32NoPadding.DecodeString(encodedSecret)
+	require.NoError(t, err)
+
+	return secret
+}
+
+func generateTransaction(sender []byte, nonce uint64, receiver []byte, value *big.Int, data string, gasLimit uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    value,
+		SndAddr:  sender,
+		RcvAddr:  receiver,
+		Data:     []byte(data),
+		GasLimit: gasLimit,
+		GasPrice: minGasPrice,
+		ChainID:  []byte(configs.ChainID),
+		Version:  2,
+	}
+}
+
+func generateGuardedTransaction(sender []byte, nonce uint64, receiver []byte, value *big.Int, guardian []byte) *transaction.Transaction {
+	tx := generateTransaction(sender, nonce, receiver, value, "", 100_000)
+	tx.Options = transaction.MaskGuardedTransaction
+	tx.GuardianAddr = guardian
+
+	return tx
+}
+
+func getDataForSigning(t *testing.T, cs testsChainSimulator.ChainSimulator, tx *transaction.Transaction) []byte {
+	coreComponents := cs.GetNodeHandler(0).GetCoreComponents()
+	dataToSign, err := tx.GetDataForSigning(coreComponents.AddressPubKeyConverter(), coreComponents.TxMarshalizer(), coreComponents.TxSignHasher())
+	require.NoError(t, err)
+
+	return dataToSign
+}
+
+func signTransaction(t *testing.T, cs testsChainSimulator.ChainSimulator, tx *transaction.Transaction, sk crypto.PrivateKey) {
+	signature, err := singleSigner.Sign(sk, getDataForSigning(t, cs, tx))
+	require.NoError(t, err)
+
+	tx.Signature = signature
+}
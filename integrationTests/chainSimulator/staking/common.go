@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"testing"
@@ -59,7 +60,7 @@ func GetBLSKeyStatus(t *testing.T, metachainNode chainSimulatorProcess.NodeHandl
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{blsKey},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -74,7 +75,7 @@ func GetAllNodeStates(t *testing.T, metachainNode chainSimulatorProcess.NodeHand
 		CallerAddr: vm.StakingSCAddress,
 		CallValue:  big.NewInt(0),
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
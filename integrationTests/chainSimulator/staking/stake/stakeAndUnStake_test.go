@@ -1,6 +1,7 @@
 package stake
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -650,7 +651,7 @@ func getTotalStaked(t *testing.T, metachainNode chainSimulatorProcess.NodeHandle
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{blsKey},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -890,7 +891,7 @@ func getUnStakedTokensList(t *testing.T, metachainNode chainSimulatorProcess.Nod
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{blsKey},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -1394,7 +1395,7 @@ func testChainSimulatorDirectStakedWithdrawUnstakedFundsBeforeUnbonding(t *testi
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{validatorOwner.Bytes},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -1618,7 +1619,7 @@ func testChainSimulatorDirectStakedWithdrawUnstakedFundsInFirstEpoch(t *testing.
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{validatorOwner.Bytes},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -1651,7 +1652,7 @@ func testChainSimulatorDirectStakedWithdrawUnstakedFundsInFirstEpoch(t *testing.
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{validatorOwner.Bytes},
 	}
-	result, _, err = metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err = metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -1924,7 +1925,7 @@ func testChainSimulatorDirectStakedWithdrawUnstakedFundsInBatches(t *testing.T,
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{validatorOwner.Bytes},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -1939,7 +1940,7 @@ func testChainSimulatorDirectStakedWithdrawUnstakedFundsInBatches(t *testing.T,
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{validatorOwner.Bytes},
 	}
-	result, _, err = metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err = metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -2271,7 +2272,7 @@ func testChainSimulatorDirectStakedWithdrawUnstakedFundsInEpoch(t *testing.T, cs
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{validatorOwner.Bytes},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -2286,7 +2287,7 @@ func testChainSimulatorDirectStakedWithdrawUnstakedFundsInEpoch(t *testing.T, cs
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{validatorOwner.Bytes},
 	}
-	result, _, err = metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err = metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
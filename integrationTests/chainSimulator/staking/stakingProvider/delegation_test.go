@@ -1,6 +1,7 @@
 package stakingProvider
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -1735,7 +1736,7 @@ func testChainSimulatorMaxDelegationCap(t *testing.T, cs chainSimulatorIntegrati
 }
 
 func executeQuery(cs chainSimulatorIntegrationTests.ChainSimulator, shardID uint32, scAddress []byte, funcName string, args [][]byte) (*dataVm.VMOutputApi, error) {
-	output, _, err := cs.GetNodeHandler(shardID).GetFacadeHandler().ExecuteSCQuery(&process.SCQuery{
+	output, _, err := cs.GetNodeHandler(shardID).GetFacadeHandler().ExecuteSCQuery(context.Background(), &process.SCQuery{
 		ScAddress: scAddress,
 		FuncName:  funcName,
 		Arguments: args,
@@ -1789,7 +1790,7 @@ func getBLSTopUpValue(t *testing.T, metachainNode chainSimulatorProcess.NodeHand
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{address},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -2065,7 +2066,7 @@ func getBLSKeyOwner(t *testing.T, metachainNode chainSimulatorProcess.NodeHandle
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{blsKey},
 	}
-	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := metachainNode.GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, chainSimulatorIntegrationTests.OkReturnCode, result.ReturnCode)
 
@@ -0,0 +1,183 @@
+package stakingProvider
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	coreAPI "github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-go/config"
+	chainSimulatorIntegrationTests "github.com/multiversx/mx-chain-go/integrationTests/chainSimulator"
+	"github.com/multiversx/mx-chain-go/integrationTests/chainSimulator/staking"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/stretchr/testify/require"
+)
+
+const gasLimitForEnableLiquidStaking = 500_000_000
+const gasLimitForLiquidOperation = 60_000_000
+
+// Test description:
+// Test that a delegation contract can opt in for liquid staking, that delegateLiquid mints the liquid staking token
+// to the delegator and that burning the token unDelegates its value in the name of the holder
+func TestChainSimulator_DelegationLiquidStaking(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    30,
+	}
+
+	// 1. Create a new delegation contract with 1250 egld
+	// 2. Enable liquid staking on the contract, check the contract is registered in the delegation manager
+	// 3. Delegate liquid from user A 100 EGLD, check user A received 100 liquid staking tokens
+	// 4. Burn 40 liquid staking tokens from user A, check user A has 40 EGLD unDelegated and 60 tokens left
+	// 5. Burn 40 liquid staking tokens from user B, who holds none, check nothing was redeemed
+	cs, err := chainSimulator.NewChainSimulator(chainSimulator.ArgsChainSimulator{
+		BypassTxSignatureCheck:   true,
+		TempDir:                  t.TempDir(),
+		PathToInitialConfig:      defaultPathToInitialConfig,
+		NumOfShards:              3,
+		GenesisTimestamp:         time.Now().Unix(),
+		RoundDurationInMillis:    uint64(6000),
+		RoundsPerEpoch:           roundsPerEpoch,
+		ApiInterface:             api.NewNoApiInterface(),
+		MinNodesPerShard:         3,
+		MetaChainMinNodes:        3,
+		NumNodesWaitingListMeta:  3,
+		NumNodesWaitingListShard: 3,
+		AlterConfigsFunction: func(cfg *config.Configs) {
+			cfg.EpochConfig.EnableEpochs.DelegationLiquidStakingEnableEpoch = 0
+		},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, cs)
+
+	defer cs.Close()
+
+	testChainSimulatorDelegationLiquidStaking(t, cs, 1)
+}
+
+func testChainSimulatorDelegationLiquidStaking(t *testing.T, cs chainSimulatorIntegrationTests.ChainSimulator, targetEpoch int32) {
+	err := cs.GenerateBlocksUntilEpochIsReached(targetEpoch)
+	require.Nil(t, err)
+
+	initialFunds := big.NewInt(0).Mul(chainSimulatorIntegrationTests.OneEGLD, big.NewInt(10000))
+	owner, err := cs.GenerateAndMintWalletAddress(core.AllShardId, initialFunds)
+	require.Nil(t, err)
+	delegatorA, err := cs.GenerateAndMintWalletAddress(core.AllShardId, initialFunds)
+	require.Nil(t, err)
+	delegatorB, err := cs.GenerateAndMintWalletAddress(core.AllShardId, initialFunds)
+	require.Nil(t, err)
+
+	err = cs.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	// Step 1: create a new delegation contract
+	txCreateDelegationContract := chainSimulatorIntegrationTests.GenerateTransaction(owner.Bytes, 0, vm.DelegationManagerSCAddress, staking.InitialDelegationValue,
+		fmt.Sprintf("createNewDelegationContract@%s@%s", maxCap, hexServiceFee),
+		gasLimitForDelegationContractCreationOperation)
+	createDelegationContractTx, err := cs.SendTxAndGenerateBlockTilTxIsExecuted(txCreateDelegationContract, staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Nil(t, err)
+	require.NotNil(t, createDelegationContractTx)
+
+	output, err := executeQuery(cs, core.MetachainShardId, vm.DelegationManagerSCAddress, "getAllContractAddresses", nil)
+	require.Nil(t, err)
+	delegationContractAddress := output.ReturnData[0]
+
+	// Step 2: enable liquid staking, paying the token issue cost
+	issueCost := big.NewInt(0).Mul(chainSimulatorIntegrationTests.OneEGLD, big.NewInt(5))
+	txEnable := chainSimulatorIntegrationTests.GenerateTransaction(owner.Bytes, 1, delegationContractAddress, issueCost,
+		fmt.Sprintf("enableLiquidStaking@%s@%s", hex.EncodeToString([]byte("LiquidStake")), hex.EncodeToString([]byte("LQST"))),
+		gasLimitForEnableLiquidStaking)
+	enableTx, err := cs.SendTxAndGenerateBlockTilTxIsExecuted(txEnable, staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Nil(t, err)
+	require.NotNil(t, enableTx)
+
+	output, err = executeQuery(cs, core.MetachainShardId, delegationContractAddress, "getLiquidStakingData", nil)
+	require.Nil(t, err)
+	tokenID := output.ReturnData[0]
+	require.True(t, len(tokenID) > 0)
+	require.Equal(t, 0, big.NewInt(0).SetBytes(output.ReturnData[1]).Sign())
+
+	output, err = executeQuery(cs, core.MetachainShardId, vm.DelegationManagerSCAddress, "getAllLiquidStakingContracts", nil)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{delegationContractAddress}, output.ReturnData)
+
+	// Step 3: delegate liquid from user A
+	delegateValue := big.NewInt(0).Mul(chainSimulatorIntegrationTests.OneEGLD, big.NewInt(100))
+	txDelegate := chainSimulatorIntegrationTests.GenerateTransaction(delegatorA.Bytes, 0, delegationContractAddress, delegateValue, "delegateLiquid", gasLimitForLiquidOperation)
+	delegateTx, err := cs.SendTxAndGenerateBlockTilTxIsExecuted(txDelegate, staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Nil(t, err)
+	require.NotNil(t, delegateTx)
+
+	err = cs.GenerateBlocks(staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Nil(t, err)
+	require.Equal(t, delegateValue, getLiquidStakingTokenBalance(t, cs, delegatorA, tokenID))
+
+	output, err = executeQuery(cs, core.MetachainShardId, delegationContractAddress, "getLiquidStakingValue", [][]byte{delegateValue.Bytes()})
+	require.Nil(t, err)
+	require.Equal(t, delegateValue, big.NewInt(0).SetBytes(output.ReturnData[0]))
+
+	// Step 4: burn part of the liquid staking tokens
+	burnValue := big.NewInt(0).Mul(chainSimulatorIntegrationTests.OneEGLD, big.NewInt(40))
+	txBurn := chainSimulatorIntegrationTests.GenerateTransaction(delegatorA.Bytes, 1, vm.ESDTSCAddress, chainSimulatorIntegrationTests.ZeroValue,
+		fmt.Sprintf("%s@%s@%s", core.BuiltInFunctionESDTBurn, hex.EncodeToString(tokenID), hex.EncodeToString(burnValue.Bytes())),
+		gasLimitForLiquidOperation)
+	burnTx, err := cs.SendTxAndGenerateBlockTilTxIsExecuted(txBurn, staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Nil(t, err)
+	require.NotNil(t, burnTx)
+
+	err = cs.GenerateBlocks(staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0).Sub(delegateValue, burnValue), getLiquidStakingTokenBalance(t, cs, delegatorA, tokenID))
+
+	output, err = executeQuery(cs, core.MetachainShardId, delegationContractAddress, "getUserUnStakedValue", [][]byte{delegatorA.Bytes})
+	require.Nil(t, err)
+	require.Equal(t, burnValue, big.NewInt(0).SetBytes(output.ReturnData[0]))
+
+	output, err = executeQuery(cs, core.MetachainShardId, delegationContractAddress, "getLiquidStakingData", nil)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0).Sub(delegateValue, burnValue), big.NewInt(0).SetBytes(output.ReturnData[1]))
+
+	// Step 5: burning from an account without liquid staking tokens does not reach the delegation contract
+	txBurn = chainSimulatorIntegrationTests.GenerateTransaction(delegatorB.Bytes, 0, vm.ESDTSCAddress, chainSimulatorIntegrationTests.ZeroValue,
+		fmt.Sprintf("%s@%s@%s", core.BuiltInFunctionESDTBurn, hex.EncodeToString(tokenID), hex.EncodeToString(burnValue.Bytes())),
+		gasLimitForLiquidOperation)
+	// the transaction fails on the sender's shard without any result, so it is not reported as executed
+	_, _ = cs.SendTxAndGenerateBlockTilTxIsExecuted(txBurn, staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Equal(t, uint64(1), staking.GetNonce(t, cs, delegatorB))
+
+	err = cs.GenerateBlocks(staking.MaxNumOfBlockToGenerateWhenExecutingTx)
+	require.Nil(t, err)
+
+	output, err = executeQuery(cs, core.MetachainShardId, delegationContractAddress, "getLiquidStakingData", nil)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0).Sub(delegateValue, burnValue), big.NewInt(0).SetBytes(output.ReturnData[1]))
+}
+
+// the balance is read directly from the account storage, as the token has no data saved in the shard's system account
+func getLiquidStakingTokenBalance(t *testing.T, cs chainSimulatorIntegrationTests.ChainSimulator, address dtos.WalletAddress, tokenID []byte) *big.Int {
+	shardID := cs.GetNodeHandler(0).GetShardCoordinator().ComputeId(address.Bytes)
+	nodeHandler := cs.GetNodeHandler(shardID)
+	keyValuePairs, _, err := nodeHandler.GetFacadeHandler().GetKeyValuePairs(address.Bech32, coreAPI.AccountQueryOptions{})
+	require.Nil(t, err)
+
+	esdtTokenKey := hex.EncodeToString([]byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID)))
+	marshaledData, err := hex.DecodeString(keyValuePairs[esdtTokenKey])
+	require.Nil(t, err)
+
+	esdtData := &esdt.ESDigitalToken{}
+	err = nodeHandler.GetCoreComponents().InternalMarshalizer().Unmarshal(esdtData, marshaledData)
+	require.Nil(t, err)
+
+	return esdtData.Value
+}
@@ -1,6 +1,7 @@
 package chainSimulator
 
 import (
+	"context"
 	"encoding/base64"
 	"math/big"
 	"testing"
@@ -46,7 +47,7 @@ func CheckSetEntireState(t *testing.T, chainSimulator ChainSimulator, nodeHandle
 	require.Nil(t, err)
 
 	scAddress, _ := nodeHandler.GetCoreComponents().AddressPubKeyConverter().Decode(accountState.Address)
-	res, _, err := nodeHandler.GetFacadeHandler().ExecuteSCQuery(&process.SCQuery{
+	res, _, err := nodeHandler.GetFacadeHandler().ExecuteSCQuery(context.Background(), &process.SCQuery{
 		ScAddress:  scAddress,
 		FuncName:   "getSum",
 		CallerAddr: nil,
@@ -83,7 +84,7 @@ func CheckSetEntireStateWithRemoval(t *testing.T, chainSimulator ChainSimulator,
 	require.Nil(t, err)
 
 	scAddress, _ := nodeHandler.GetCoreComponents().AddressPubKeyConverter().Decode(accountState.Address)
-	res, _, err := nodeHandler.GetFacadeHandler().ExecuteSCQuery(&process.SCQuery{
+	res, _, err := nodeHandler.GetFacadeHandler().ExecuteSCQuery(context.Background(), &process.SCQuery{
 		ScAddress:  scAddress,
 		FuncName:   "getSum",
 		CallerAddr: nil,
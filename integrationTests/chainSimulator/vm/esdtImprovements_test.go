@@ -2,6 +2,7 @@ package vm
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -2457,7 +2458,7 @@ func TestChainSimulator_NFT_RegisterDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{nftTokenID},
 	}
-	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -2553,7 +2554,7 @@ func TestChainSimulator_MetaESDT_RegisterDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{nftTokenID},
 	}
-	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -2701,7 +2702,7 @@ func TestChainSimulator_NFT_RegisterAndSetAllRolesDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{nftTokenID},
 	}
-	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -2717,7 +2718,7 @@ func TestChainSimulator_NFT_RegisterAndSetAllRolesDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{nftTokenID},
 	}
-	result, _, err = cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err = cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -2821,7 +2822,7 @@ func TestChainSimulator_SFT_RegisterAndSetAllRolesDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{sftTokenID},
 	}
-	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -2837,7 +2838,7 @@ func TestChainSimulator_SFT_RegisterAndSetAllRolesDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{sftTokenID},
 	}
-	result, _, err = cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err = cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -2996,7 +2997,7 @@ func TestChainSimulator_MetaESDT_RegisterAndSetAllRolesDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{metaTokenID},
 	}
-	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -3012,7 +3013,7 @@ func TestChainSimulator_MetaESDT_RegisterAndSetAllRolesDynamic(t *testing.T) {
 		CallValue: big.NewInt(0),
 		Arguments: [][]byte{metaTokenID},
 	}
-	result, _, err = cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err = cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -3567,7 +3568,7 @@ func TestChainSimulator_CreateAndPause_NFT(t *testing.T) {
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{nftTokenID},
 	}
-	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
@@ -3749,7 +3750,7 @@ func TestChainSimulator_CreateAndPauseTokens_DynamicNFT(t *testing.T) {
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{nftTokenID},
 	}
-	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(scQuery)
+	result, _, err := cs.GetNodeHandler(core.MetachainShardId).GetFacadeHandler().ExecuteSCQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.Equal(t, "", result.ReturnMessage)
 	require.Equal(t, testsChainSimulator.OkReturnCode, result.ReturnCode)
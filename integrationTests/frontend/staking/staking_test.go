@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"testing"
@@ -131,7 +132,7 @@ func checkStakeOnNode(t *testing.T, n *integrationTests.TestProcessorNode, blsKe
 		Arguments: [][]byte{blsKey},
 	}
 
-	vmOutput, _, err := n.SCQueryService.ExecuteQuery(query)
+	vmOutput, _, err := n.SCQueryService.ExecuteQuery(context.Background(), query)
 	require.Nil(t, err)
 	require.NotNil(t, vmOutput)
 	require.Equal(t, 1, len(vmOutput.ReturnData))
@@ -13,6 +13,7 @@ type NetworkComponentsStub struct {
 	InputAntiFlood                   factory.P2PAntifloodHandler
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
+	PeerReputationHandlerField       process.PeerReputationHandler
 	PeerHonesty                      factory.PeerHonestyHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
@@ -31,6 +32,11 @@ func (ncs *NetworkComponentsStub) PeerHonestyHandler() factory.PeerHonestyHandle
 	return ncs.PeerHonesty
 }
 
+// PeerReputationHandler -
+func (ncs *NetworkComponentsStub) PeerReputationHandler() process.PeerReputationHandler {
+	return ncs.PeerReputationHandlerField
+}
+
 // Create -
 func (ncs *NetworkComponentsStub) Create() error {
 	return nil
@@ -9,6 +9,7 @@ import (
 // StatusComponentsStub -
 type StatusComponentsStub struct {
 	Outport                  outport.OutportHandler
+	EventsSubscriptions      outport.EventsSubscriptionsHandler
 	SoftwareVersionCheck     statistics.SoftwareVersionChecker
 	ManagedPeersMonitorField common.ManagedPeersMonitor
 }
@@ -33,6 +34,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// EventsSubscriptionsHandler -
+func (scs *StatusComponentsStub) EventsSubscriptionsHandler() outport.EventsSubscriptionsHandler {
+	return scs.EventsSubscriptions
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
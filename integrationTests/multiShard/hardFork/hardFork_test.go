@@ -590,6 +590,7 @@ func createHardForkExporter(
 		cryptoComponents.TxKeyGen = node.OwnAccount.KeygenTxSign
 
 		statusCoreComponents := &factoryTests.StatusCoreComponentsStub{
+			MetricsRegistryField:   &testscommon.MetricsRegistryStub{},
 			AppStatusHandlerField:  &statusHandler.AppStatusHandlerStub{},
 			StateStatsHandlerField: disabled.NewStateStatistics(),
 		}
@@ -1,6 +1,7 @@
 package relayedTx
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"testing"
@@ -417,7 +418,7 @@ func checkAttestedPublicKeys(
 	userAddress []byte,
 ) {
 	scQuery := node.SCQueryService
-	vmOutput, _, err := scQuery.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := scQuery.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress: scAddress,
 		FuncName:  "getPublicKey",
 		Arguments: [][]byte{obfuscatedData},
@@ -429,7 +430,7 @@ func checkAttestedPublicKeys(
 
 func checkSCBalance(t *testing.T, node *integrationTests.TestProcessorNode, scAddress []byte, userAddress []byte, balance *big.Int) {
 	scQuery := node.SCQueryService
-	vmOutput, _, err := scQuery.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := scQuery.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress: scAddress,
 		FuncName:  "balanceOf",
 		Arguments: [][]byte{userAddress},
@@ -2,6 +2,7 @@ package dns
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -276,7 +277,7 @@ func checkUserNamesAreSetCorrectly(
 				continue
 			}
 
-			vmOutput, _, _ := node.SCQueryService.ExecuteQuery(scQuery)
+			vmOutput, _, _ := node.SCQueryService.ExecuteQuery(context.Background(), scQuery)
 
 			require.NotNil(t, vmOutput)
 			require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
@@ -1,6 +1,7 @@
 package polynetworkbridge
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -120,7 +121,7 @@ func TestBridgeSetupAndBurn(t *testing.T) {
 		FuncName:   "getWrappedEgldTokenIdentifier",
 		Arguments:  [][]byte{},
 	}
-	vmOutput, _, err := ownerNode.SCQueryService.ExecuteQuery(scQuery)
+	vmOutput, _, err := ownerNode.SCQueryService.ExecuteQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	require.NotNil(t, vmOutput)
 	require.NotZero(t, len(vmOutput.ReturnData[0]))
@@ -1,6 +1,7 @@
 package txRouting
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -46,7 +47,7 @@ func TestRoutingOfTransactionsInShards(t *testing.T) {
 		for shardID, listTxs := range txs {
 			integrationTests.WhiteListTxs(nodes, listTxs)
 			dispatchNode := getNodeOnShard(shardID, nodes)
-			_, err := dispatchNode.Node.SendBulkTransactions(listTxs)
+			_, err := dispatchNode.Node.SendBulkTransactions(context.Background(), listTxs)
 			require.Nil(t, err)
 		}
 	}
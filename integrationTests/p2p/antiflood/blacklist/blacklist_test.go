@@ -147,6 +147,8 @@ func applyBlacklistComponents(peers []p2p.Messenger, blacklistHandler []process.
 			blacklistHandler[idx],
 			&testscommon.TimeCacheStub{},
 			&mock.PeerShardMapperStub{},
+			&testscommon.PeerReputationHandlerStub{},
+			peer,
 		)
 
 		_ = peer.SetPeerDenialEvaluator(pde)
@@ -170,6 +172,7 @@ func createBlacklistHandlersAndProcessors(
 		blacklistProcessors[i], err = blackList.NewP2PBlackListProcessor(
 			blacklistCache,
 			blacklistCachers[i],
+			&testscommon.PeerReputationHandlerStub{},
 			thresholdNumReceived,
 			thresholdSizeReceived,
 			maxFloodingRounds,
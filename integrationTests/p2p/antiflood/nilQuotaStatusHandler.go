@@ -13,6 +13,10 @@ func (nqsh *nilQuotaStatusHandler) ResetStatistics() {
 func (nqsh *nilQuotaStatusHandler) AddQuota(_ core.PeerID, _ uint32, _ uint64, _ uint32, _ uint64) {
 }
 
+// SetLiveQuota is not implemented
+func (nqsh *nilQuotaStatusHandler) SetLiveQuota(_ uint32, _ uint64) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nqsh *nilQuotaStatusHandler) IsInterfaceNil() bool {
 	return nqsh == nil
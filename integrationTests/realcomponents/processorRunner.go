@@ -178,6 +178,7 @@ func (pr *ProcessorRunner) createNetworkComponents(tb testing.TB) {
 		NodeOperationMode:     common.NormalOperation,
 		ConnectionWatcherType: "",
 		CryptoComponents:      pr.CryptoComponents,
+		PathManager:           pr.CoreComponents.PathHandler(),
 	}
 
 	networkFactory, err := factoryNetwork.NewNetworkComponentsFactory(argsNetwork)
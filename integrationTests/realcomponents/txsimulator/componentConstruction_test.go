@@ -1,6 +1,7 @@
 package txsimulator
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -64,7 +65,7 @@ func TestTransactionSimulationComponentConstructionOnMetachain(t *testing.T) {
 		Version:  1,
 	}
 
-	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(txForSimulation)
+	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(context.Background(), txForSimulation, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, pr.StateComponents.AccountsAdapter().JournalLen()) // state for processing should not be dirtied
 }
@@ -135,7 +136,7 @@ func TestTransactionSimulationComponentConstructionOnShard(t *testing.T) {
 		Version:  1,
 	}
 
-	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(txForSimulation)
+	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(context.Background(), txForSimulation, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, pr.StateComponents.AccountsAdapter().JournalLen()) // state for processing should not be dirtied
 }
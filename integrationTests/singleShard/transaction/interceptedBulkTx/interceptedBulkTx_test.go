@@ -1,6 +1,7 @@
 package interceptedBulkTx
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -154,6 +155,6 @@ func TestNode_SendTransactionFromAnUnmintedAccountShouldReturnErrorAtApiLevel(t
 	txBuff, _ := tx.GetDataForSigning(integrationTests.TestAddressPubkeyConverter, integrationTests.TestTxSignMarshalizer, integrationTests.TestTxSignHasher)
 	tx.Signature, _ = node.OwnAccount.SingleSigner.Sign(node.OwnAccount.SkTxSign, txBuff)
 
-	err := node.Node.ValidateTransaction(tx)
+	err := node.Node.ValidateTransaction(context.Background(), tx)
 	assert.True(t, errors.Is(err, process.ErrInsufficientFunds))
 }
@@ -333,6 +333,7 @@ func (tcn *TestConsensusNode) initNode(args ArgsTestConsensusNode) {
 	stateComponents.AccountsAPI = tcn.AccountsDB
 
 	statusCoreComponents := &testFactory.StatusCoreComponentsStub{
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
 	}
 
@@ -89,6 +89,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
 	"github.com/multiversx/mx-chain-go/process/smartContract/processProxy"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	processSync "github.com/multiversx/mx-chain-go/process/sync"
 	"github.com/multiversx/mx-chain-go/process/track"
 	"github.com/multiversx/mx-chain-go/process/transaction"
@@ -900,6 +901,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService(gasMap map[string]map[str
 		GasSchedule:              gasSchedule,
 		Counter:                  counters.NewDisabledCounter(),
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	var apiBlockchain data.ChainHandler
@@ -1642,6 +1644,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		GasSchedule:              gasSchedule,
 		Counter:                  counter,
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	maxGasLimitPerBlock := uint64(0xFFFFFFFFFFFFFFFF)
@@ -1712,6 +1715,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		WasmVMChangeLocker:  tpn.WasmVMChangeLocker,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 
 	tpn.ScProcessor, _ = processProxy.NewTestSmartContractProcessorProxy(argsNewScProcessor, tpn.EpochNotifier)
@@ -1869,6 +1873,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors(gasMap map[string]map[stri
 		GasSchedule:              gasSchedule,
 		Counter:                  counters.NewDisabledCounter(),
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	var signVerifier vm.MessageSignVerifier
@@ -1991,6 +1996,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors(gasMap map[string]map[stri
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		WasmVMChangeLocker:  tpn.WasmVMChangeLocker,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 
 	tpn.ScProcessor, _ = processProxy.NewTestSmartContractProcessorProxy(argsNewScProcessor, tpn.EpochNotifier)
@@ -2180,6 +2186,7 @@ func (tpn *TestProcessorNode) initBlockProcessor() {
 	statusComponents := GetDefaultStatusComponents()
 
 	statusCoreComponents := &testFactory.StatusCoreComponentsStub{
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
 	}
 
@@ -2457,6 +2464,7 @@ func (tpn *TestProcessorNode) initNode() {
 	var err error
 
 	statusCoreComponents := &testFactory.StatusCoreComponentsStub{
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 		StatusMetricsField:    tpn.StatusMetrics,
 		AppStatusHandlerField: tpn.AppStatusHandler,
 	}
@@ -2592,6 +2600,10 @@ func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (
 	if len(tx.GuardianAddr) == TestAddressPubkeyConverter.Len() {
 		guardianAddress = TestAddressPubkeyConverter.SilentEncode(tx.GuardianAddr, log)
 	}
+	relayerAddress := ""
+	if len(tx.RelayerAddr) == TestAddressPubkeyConverter.Len() {
+		relayerAddress = TestAddressPubkeyConverter.SilentEncode(tx.RelayerAddr, log)
+	}
 	createTxArgs := &external.ArgsCreateTransaction{
 		Nonce:            tx.Nonce,
 		Value:            tx.Value.String(),
@@ -2608,18 +2620,20 @@ func (tpn *TestProcessorNode) SendTransaction(tx *dataTransaction.Transaction) (
 		Options:          tx.Options,
 		Guardian:         guardianAddress,
 		GuardianSigHex:   hex.EncodeToString(tx.GuardianSignature),
+		Relayer:          relayerAddress,
+		RelayerSigHex:    hex.EncodeToString(tx.RelayerSignature),
 	}
 	tx, txHash, err := tpn.Node.CreateTransaction(createTxArgs)
 	if err != nil {
 		return "", err
 	}
 
-	err = tpn.Node.ValidateTransaction(tx)
+	err = tpn.Node.ValidateTransaction(context.Background(), tx)
 	if err != nil {
 		return "", err
 	}
 
-	_, err = tpn.Node.SendBulkTransactions([]*dataTransaction.Transaction{tx})
+	_, err = tpn.Node.SendBulkTransactions(context.Background(), []*dataTransaction.Transaction{tx})
 	if err != nil {
 		return "", err
 	}
@@ -3117,6 +3131,7 @@ func (tpn *TestProcessorNode) createHeartbeatWithHardforkTrigger() {
 	processComponents.HardforkTriggerField = tpn.HardforkTrigger
 
 	statusCoreComponents := &testFactory.StatusCoreComponentsStub{
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 		AppStatusHandlerField: tpn.AppStatusHandler,
 	}
 
@@ -3255,6 +3270,10 @@ func CreateEnableEpochsConfig() config.EnableEpochs {
 		SCProcessorV2EnableEpoch:                          UnreachableEpoch,
 		FixRelayedBaseCostEnableEpoch:                     UnreachableEpoch,
 		FixRelayedMoveBalanceToNonPayableSCEnableEpoch:    UnreachableEpoch,
+		RelayedTransactionsV3EnableEpoch:                  UnreachableEpoch,
+		GovernanceParameterChangesEnableEpoch:             UnreachableEpoch,
+		DelegationLiquidStakingEnableEpoch:                UnreachableEpoch,
+		ESDTSupplyPolicyEnableEpoch:                       UnreachableEpoch,
 	}
 }
 
@@ -3395,6 +3414,7 @@ func GetDefaultNetworkComponents() *mock.NetworkComponentsStub {
 		InputAntiFlood:                   &mock.P2PAntifloodHandlerStub{},
 		OutputAntiFlood:                  &mock.P2PAntifloodHandlerStub{},
 		PeerBlackList:                    &mock.PeerBlackListCacherStub{},
+		PeerReputationHandlerField:       &testscommon.PeerReputationHandlerStub{},
 		PeersRatingHandlerField:          &p2pmocks.PeersRatingHandlerStub{},
 		PeersRatingMonitorField:          &p2pmocks.PeersRatingMonitorStub{},
 		FullArchiveNetworkMessengerField: &p2pmocks.MessengerStub{},
@@ -15,10 +15,13 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	disabledValidatorsPerformance "github.com/multiversx/mx-chain-go/node/external/validatorsPerformance/disabled"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	"github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
+	"github.com/multiversx/mx-chain-go/process/stateOverrides"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -186,7 +189,10 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(argSimulator)
 	log.LogIfError(err)
 
-	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState)
+	overridesApplier, err := stateOverrides.NewAccountOverridesApplier(TestMarshalizer, TestHasher)
+	log.LogIfError(err)
+
+	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState, overridesApplier)
 	log.LogIfError(err)
 
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
@@ -197,6 +203,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		ShardCoordinator:    tpn.ShardCoordinator,
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		BlockChain:          tpn.BlockChain,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	log.LogIfError(err)
@@ -271,10 +278,12 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           tpn.SCQueryService,
 		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 		APITransactionEvaluator:  apiTransactionEvaluator,
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		ValidatorsPerformance:    disabledValidatorsPerformance.NewDisabledValidatorsPerformanceProcessor(),
 		APITransactionHandler:    apiTransactionHandler,
 		APIBlockHandler:          blockAPIHandler,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
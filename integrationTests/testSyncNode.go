@@ -71,6 +71,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 	statusComponents := GetDefaultStatusComponents()
 
 	statusCoreComponents := &factory.StatusCoreComponentsStub{
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
 	}
 
@@ -1,6 +1,7 @@
 package delegation
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"testing"
@@ -259,7 +260,7 @@ func checkClaimMultiReturn(
 		CallValue:  big.NewInt(0),
 		Arguments:  arguments,
 	}
-	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(query)
+	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(context.Background(), query)
 	assert.Nil(t, err)
 	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
 	require.Equal(t, big.NewInt(expectedRewards).Bytes(), vmOutput.ReturnData[0])
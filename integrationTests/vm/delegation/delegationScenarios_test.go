@@ -2,6 +2,7 @@ package delegation
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
@@ -286,7 +287,7 @@ func TestDelegationChangeConfig(t *testing.T) {
 		CallValue:  big.NewInt(0),
 		Arguments:  make([][]byte, 0),
 	}
-	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(scQuery)
+	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(context.Background(), scQuery)
 	require.Nil(t, err)
 	assert.Equal(t, newMinDelegationAmount.Bytes(), vmOutput.ReturnData[5])
 
@@ -1212,7 +1213,7 @@ func verifyUserUndelegatedList(
 		Arguments:  [][]byte{delegator},
 		CallValue:  big.NewInt(0),
 	}
-	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(query)
+	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(context.Background(), query)
 	assert.Nil(t, err)
 	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
 	assert.Equal(t, len(values)*2, len(vmOutput.ReturnData))
@@ -1245,7 +1246,7 @@ func verifyValidatorSCStake(
 		CallValue:  big.NewInt(0),
 		Arguments:  [][]byte{delegationAddr},
 	}
-	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(query)
+	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(context.Background(), query)
 	assert.Nil(t, err)
 	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
 	assert.Equal(t, string(vmOutput.ReturnData[0]), expectedRes.String())
@@ -1344,7 +1345,7 @@ func verifyDelegatorIsDeleted(
 			CallValue:  big.NewInt(0),
 			Arguments:  [][]byte{address},
 		}
-		vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(query)
+		vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(context.Background(), query)
 		assert.Nil(t, err)
 		assert.Equal(t, vmOutput.ReturnMessage, "view function works only for existing delegators")
 		assert.Equal(t, vmOutput.ReturnCode, vmcommon.UserError)
@@ -1428,7 +1429,7 @@ func getReturnDataFromQuery(
 		CallValue:  big.NewInt(0),
 		Arguments:  arguments,
 	}
-	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(query)
+	vmOutput, _, err := tpn.SCQueryService.ExecuteQuery(context.Background(), query)
 	assert.Nil(t, err)
 	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
 
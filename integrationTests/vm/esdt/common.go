@@ -1,6 +1,7 @@
 package esdt
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -297,7 +298,7 @@ func CheckNumCallBacks(
 			CallValue:  big.NewInt(0),
 			Arguments:  [][]byte{},
 		}
-		vmOutput, _, err := node.SCQueryService.ExecuteQuery(scQuery)
+		vmOutput, _, err := node.SCQueryService.ExecuteQuery(context.Background(), scQuery)
 		require.Nil(t, err)
 		require.NotNil(t, vmOutput)
 		require.Equal(t, vmOutput.ReturnCode, vmcommon.Ok)
@@ -329,7 +330,7 @@ func CheckForwarderRawSavedCallbackArgs(
 				{byte(callbackIndex)},
 			},
 		}
-		vmOutputArgs, _, err := node.SCQueryService.ExecuteQuery(scQueryArgs)
+		vmOutputArgs, _, err := node.SCQueryService.ExecuteQuery(context.Background(), scQueryArgs)
 		require.Nil(t, err)
 		require.Equal(t, vmcommon.Ok, vmOutputArgs.ReturnCode)
 		require.GreaterOrEqual(t, len(vmOutputArgs.ReturnData), 1)
@@ -369,7 +370,7 @@ func CheckForwarderRawSavedCallbackPayments(
 		if node.ShardCoordinator.SelfId() != contractID {
 			continue
 		}
-		vmOutputPayment, _, err := node.SCQueryService.ExecuteQuery(scQueryPayment)
+		vmOutputPayment, _, err := node.SCQueryService.ExecuteQuery(context.Background(), scQueryPayment)
 		require.Nil(t, err)
 		require.Equal(t, vmcommon.Ok, vmOutputPayment.ReturnCode)
 
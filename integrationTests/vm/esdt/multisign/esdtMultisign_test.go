@@ -1,6 +1,7 @@
 package multisign
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"os"
@@ -140,7 +141,7 @@ func checkCallBackWasSaved(t *testing.T, nodes []*integrationTests.TestProcessor
 			CallValue:  big.NewInt(0),
 			Arguments:  [][]byte{},
 		}
-		vmOutput, _, err := node.SCQueryService.ExecuteQuery(scQuery)
+		vmOutput, _, err := node.SCQueryService.ExecuteQuery(context.Background(), scQuery)
 		assert.Nil(t, err)
 		assert.Equal(t, vmOutput.ReturnCode, vmcommon.Ok)
 		assert.Equal(t, 1, len(vmOutput.ReturnData))
@@ -250,7 +251,7 @@ func getActionID(t *testing.T, nodes []*integrationTests.TestProcessorNode, mult
 		Arguments:  make([][]byte, 0),
 	}
 
-	vmOutput, _, err := node.SCQueryService.ExecuteQuery(query)
+	vmOutput, _, err := node.SCQueryService.ExecuteQuery(context.Background(), query)
 	require.Nil(t, err)
 	require.Equal(t, 1, len(vmOutput.ReturnData))
 
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"strings"
@@ -587,7 +588,7 @@ func TestESDTcallsSC(t *testing.T) {
 		FuncName:  "getCurrentFunds",
 		Arguments: [][]byte{},
 	}
-	vmOutput1, _, _ := nodes[0].SCQueryService.ExecuteQuery(scQuery1)
+	vmOutput1, _, _ := nodes[0].SCQueryService.ExecuteQuery(context.Background(), scQuery1)
 	require.Equal(t, big.NewInt(60).Bytes(), vmOutput1.ReturnData[0])
 
 	nodesBalance := valueToSend - valueToSendToSc
@@ -1252,7 +1253,7 @@ func TestScACallsScBWithExecOnDestScAPerformsAsyncCall_NoCallbackInScB(t *testin
 		Arguments:  [][]byte{},
 	}
 
-	res, _, err := scQuery.ExecuteQuery(childScAddressQuery)
+	res, _, err := scQuery.ExecuteQuery(context.Background(), childScAddressQuery)
 	require.Nil(t, err)
 
 	receiverScAddress := res.ReturnData[0]
@@ -1265,7 +1266,7 @@ func TestScACallsScBWithExecOnDestScAPerformsAsyncCall_NoCallbackInScB(t *testin
 		Arguments:  [][]byte{},
 	}
 
-	res, _, err = scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(context.Background(), tokenIdQuery)
 	require.Nil(t, err)
 	require.True(t, strings.Contains(string(res.ReturnData[0]), ticker))
 
@@ -1442,7 +1443,7 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithIntermediaryExecOnDest_NotEn
 		Arguments:  [][]byte{},
 	}
 
-	res, _, err := scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err := scQuery.ExecuteQuery(context.Background(), tokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStr := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStr, ticker))
@@ -1732,7 +1733,7 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithScCall_GasUsedMismatch(t *te
 		Arguments:  [][]byte{},
 	}
 
-	res, _, err := scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err := scQuery.ExecuteQuery(context.Background(), tokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStrLendBusd := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStrLendBusd, ticker))
@@ -1746,7 +1747,7 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithScCall_GasUsedMismatch(t *te
 		Arguments:  [][]byte{},
 	}
 
-	res, _, err = scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(context.Background(), tokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStrBorrow := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStrBorrow, ticker))
@@ -1793,12 +1794,12 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithScCall_GasUsedMismatch(t *te
 		Arguments:  [][]byte{},
 	}
 
-	res, _, err = scQuery.ExecuteQuery(borrowWEGLDtokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(context.Background(), borrowWEGLDtokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStr := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStr, tickerWEGLD))
 
-	res, _, err = scQuery.ExecuteQuery(lendWEGLDtokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(context.Background(), lendWEGLDtokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStr = string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStr, tickerWEGLD))
@@ -2082,7 +2083,7 @@ func TestIssueAndBurnESDT_MaxGasPerBlockExceeded(t *testing.T) {
 			CallValue:  big.NewInt(0),
 			Arguments:  [][]byte{[]byte(tokenIdentifier)},
 		}
-		vmOutput, _, err := n.SCQueryService.ExecuteQuery(scQuery)
+		vmOutput, _, err := n.SCQueryService.ExecuteQuery(context.Background(), scQuery)
 		require.Nil(t, err)
 		require.Equal(t, vmOutput.ReturnCode, vmcommon.Ok)
 
@@ -1,6 +1,7 @@
 package vmGet
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -69,7 +70,7 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	vmOutput, _, err := service.ExecuteQuery(&query)
+	vmOutput, _, err := service.ExecuteQuery(context.Background(), &query)
 	assert.Nil(t, err)
 
 	returnData, _ := vmOutput.GetFirstReturnData(vmData.AsBigInt)
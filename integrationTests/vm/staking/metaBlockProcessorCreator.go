@@ -78,6 +78,7 @@ func createMetaBlockProcessor(
 			BootstrapComponents: bootstrapComponents,
 			StatusComponents:    statusComponents,
 			StatusCoreComponents: &factory2.StatusCoreComponentsStub{
+				MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 				AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
 			},
 			AccountsDB:                     accountsDb,
@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -44,6 +45,8 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
 	"github.com/multiversx/mx-chain-go/process/smartContract/processProxy"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
+	"github.com/multiversx/mx-chain-go/process/stateOverrides"
 	syncDisabled "github.com/multiversx/mx-chain-go/process/sync/disabled"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
@@ -273,7 +276,7 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
-	vmOutput, _, err := scQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := scQueryService.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress: scAddressBytes,
 		FuncName:  funcName,
 		Arguments: args,
@@ -415,6 +418,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		GasSchedule:              gasScheduleNotifier,
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
@@ -466,6 +470,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		EnableRoundsHandler: enableRoundsHandler,
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		WasmVMChangeLocker:  wasmVMChangeLocker,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 
 	scProcessor, _ := processProxy.NewTestSmartContractProcessorProxy(argsNewSCProcessor, genericEpochNotifier)
@@ -523,6 +528,7 @@ func CreateOneSCExecutorMockVM(accnts state.AccountsAdapter) vmcommon.VMExecutio
 		GasSchedule:              CreateMockGasScheduleNotifier(),
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
 	vm, _ := mock.NewOneSCExecutorMockVM(blockChainHook, integrationtests.TestHasher)
@@ -594,6 +600,7 @@ func CreateVMAndBlockchainHookAndDataPool(
 		GasSchedule:              gasSchedule,
 		Counter:                  counter,
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	maxGasLimitPerBlock := uint64(0xFFFFFFFFFFFFFFFF)
@@ -685,6 +692,7 @@ func CreateVMAndBlockchainHookMeta(
 		GasSchedule:              gasSchedule,
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	economicsData, err := createEconomicsData(config.EnableEpochs{}, 1)
@@ -887,6 +895,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		EnableRoundsHandler: enableRoundsHandler,
 		EnableEpochsHandler: enableEpochsHandler,
 		WasmVMChangeLocker:  wasmVMChangeLocker,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 		VMOutputCacher:      txcache.NewDisabledCache(),
 	}
 
@@ -919,7 +928,12 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	// create transaction simulator
-	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(accnts)
+	overridesApplier, err := stateOverrides.NewAccountOverridesApplier(integrationtests.TestMarshalizer, integrationtests.TestHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(accnts, overridesApplier)
 	if err != nil {
 		return nil, err
 	}
@@ -1018,6 +1032,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		ShardCoordinator:    shardCoordinator,
 		EnableEpochsHandler: argsNewSCProcessor.EnableEpochsHandler,
 		BlockChain:          chainHandler,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	if err != nil {
@@ -1735,7 +1750,7 @@ func GetVmOutput(
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
-	vmOutput, _, err := scQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := scQueryService.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress: scAddressBytes,
 		FuncName:  funcName,
 		Arguments: args,
@@ -1,6 +1,7 @@
 package txsFee
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 	"testing"
@@ -45,7 +46,7 @@ func TestSCCallCostTransactionCost(t *testing.T) {
 
 	tx := vm.CreateTransaction(0, big.NewInt(0), sndAddr, scAddress, gasPrice, gasLimit, []byte("increment"))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(15704), res.GasUnits)
 }
@@ -65,7 +66,7 @@ func TestScDeployTransactionCost(t *testing.T) {
 	scCode := wasm.GetSCCode("../wasm/testdata/misc/fib_wasm/output/fib_wasm.wasm")
 	tx := vm.CreateTransaction(0, big.NewInt(0), sndAddr, vm.CreateEmptyAddress(), 0, 0, []byte(wasm.CreateDeployTxData(scCode)))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(1960), res.GasUnits)
 }
@@ -96,7 +97,7 @@ func TestAsyncCallsTransactionCost(t *testing.T) {
 	secondSCAddress := utils.DoDeploySecond(t, testContext, pathToContract, ownerAccount, gasPrice, deployGasLimit, args, big.NewInt(50))
 
 	tx := vm.CreateTransaction(1, big.NewInt(0), senderAddr, secondSCAddress, 0, 0, []byte("doSomething"))
-	resWithCost, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	resWithCost, err := testContext.TxCostHandler.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(99984751), resWithCost.GasUnits)
 }
@@ -122,7 +123,7 @@ func TestBuiltInFunctionTransactionCost(t *testing.T) {
 
 	txData := []byte(core.BuiltInFunctionChangeOwnerAddress + "@" + hex.EncodeToString(newOwner))
 	tx := vm.CreateTransaction(1, big.NewInt(0), owner, scAddress, 0, 0, txData)
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(85), res.GasUnits)
 }
@@ -145,7 +146,7 @@ func TestESDTTransfer(t *testing.T) {
 	utils.CreateAccountWithESDTBalance(t, testContext.Accounts, sndAddr, egldBalance, token, 0, esdtBalance, uint32(core.Fungible))
 
 	tx := utils.CreateESDTTransferTx(0, sndAddr, rcvAddr, token, big.NewInt(100), 0, 0)
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(36), res.GasUnits)
 }
@@ -190,7 +191,7 @@ func TestAsyncESDTTransfer(t *testing.T) {
 	tx := utils.CreateESDTTransferTx(0, sndAddr, firstSCAddress, token, big.NewInt(5000), 0, 0)
 	tx.Data = []byte(string(tx.Data) + "@" + hex.EncodeToString([]byte("transferToSecondContractHalf")))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(177653), res.GasUnits)
 }
@@ -1,6 +1,7 @@
 package delegation
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
@@ -158,14 +159,14 @@ func RunDelegationStressTest(
 					getClaimableRewards.Arguments = [][]byte{copiedAddresses[j]}
 					getUserStakeByType.Arguments = [][]byte{copiedAddresses[j]}
 
-					_, _, localErrQuery := scQuery.ExecuteQuery(getClaimableRewards)
+					_, _, localErrQuery := scQuery.ExecuteQuery(context.Background(), getClaimableRewards)
 					if localErrQuery != nil {
 						mutExecutionError.Lock()
 						executionError = localErrQuery
 						mutExecutionError.Unlock()
 					}
 
-					_, _, localErrQuery = scQuery.ExecuteQuery(getUserStakeByType)
+					_, _, localErrQuery = scQuery.ExecuteQuery(context.Background(), getUserStakeByType)
 					if localErrQuery != nil {
 						mutExecutionError.Lock()
 						executionError = localErrQuery
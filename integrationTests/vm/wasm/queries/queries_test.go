@@ -151,7 +151,7 @@ func setState(t *testing.T, network *integrationTests.MiniNetwork, scAddress []b
 }
 
 func getState(t *testing.T, node *integrationTests.TestProcessorNode, scAddress []byte, blockNonce core.OptionalUint64) int {
-	vmOutput, _, err := node.SCQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := node.SCQueryService.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress:  scAddress,
 		FuncName:   "getState",
 		Arguments:  [][]byte{},
@@ -166,7 +166,7 @@ func getState(t *testing.T, node *integrationTests.TestProcessorNode, scAddress
 }
 
 func queryHistoryGetNow(t *testing.T, node *integrationTests.TestProcessorNode, scAddress []byte, blockNonce core.OptionalUint64) now {
-	vmOutput, _, err := node.SCQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := node.SCQueryService.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress:  scAddress,
 		FuncName:   "getNow",
 		Arguments:  [][]byte{},
@@ -217,7 +217,7 @@ func TestQueries_Metachain(t *testing.T) {
 	require.Len(t, tokens, 1)
 
 	// Query token on older block (should fail)
-	vmOutput, _, err := network.MetachainNode.SCQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := network.MetachainNode.SCQueryService.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress:  vm.ESDTSCAddress,
 		FuncName:   "getTokenProperties",
 		Arguments:  [][]byte{[]byte(tokens[0])},
@@ -229,7 +229,7 @@ func TestQueries_Metachain(t *testing.T) {
 	require.Equal(t, "no ticker with given name", vmOutput.ReturnMessage)
 
 	// Query token on newer block (should succeed)
-	vmOutput, _, err = network.MetachainNode.SCQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err = network.MetachainNode.SCQueryService.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress:  vm.ESDTSCAddress,
 		FuncName:   "getTokenProperties",
 		Arguments:  [][]byte{[]byte(tokens[0])},
@@ -1,6 +1,7 @@
 package upgrades
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -314,7 +315,7 @@ func TestUpgrades_CounterTrialAndError(t *testing.T) {
 
 func query(t *testing.T, node *integrationTests.TestProcessorNode, scAddress []byte, function string) []byte {
 	scQuery := node.SCQueryService
-	vmOutput, _, err := scQuery.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := scQuery.ExecuteQuery(context.Background(), &process.SCQuery{
 		ScAddress: scAddress,
 		FuncName:  function,
 		Arguments: [][]byte{},
@@ -1,6 +1,7 @@
 package wasm
 
 import (
+	goContext "context"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
@@ -41,6 +42,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/processProxy"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/process/sync/disabled"
 	processTransaction "github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
@@ -313,6 +315,7 @@ func (context *TestContext) initVMAndBlockchainHook() {
 		GasSchedule:              gasSchedule,
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	vmFactoryConfig := config.VirtualMachineConfig{
@@ -396,6 +399,7 @@ func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 		EnableRoundsHandler: context.EnableRoundsHandler,
 		EnableEpochsHandler: context.EnableEpochsHandler,
 		WasmVMChangeLocker:  context.WasmVMChangeLocker,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 		VMOutputCacher:      txcache.NewDisabledCache(),
 	}
 
@@ -750,7 +754,7 @@ func (context *TestContext) querySC(function string, args [][]byte) []byte {
 		Arguments: args,
 	}
 
-	vmOutput, _, err := context.QueryService.ExecuteQuery(&query)
+	vmOutput, _, err := context.QueryService.ExecuteQuery(goContext.Background(), &query)
 	require.Nil(context.T, err)
 
 	firstResult := vmOutput.ReturnData[0]
@@ -2,6 +2,7 @@ package chainSimulator
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -611,7 +612,7 @@ func getApiTransactionsFromResult(txWithResult []*transactionWithResult) []*tran
 
 func (s *simulator) sendTx(tx *transaction.Transaction) (string, error) {
 	shardID := s.GetNodeHandler(0).GetShardCoordinator().ComputeId(tx.SndAddr)
-	err := s.GetNodeHandler(shardID).GetFacadeHandler().ValidateTransaction(tx)
+	err := s.GetNodeHandler(shardID).GetFacadeHandler().ValidateTransaction(context.Background(), tx)
 	if err != nil {
 		return "", err
 	}
@@ -623,7 +624,7 @@ func (s *simulator) sendTx(tx *transaction.Transaction) (string, error) {
 	}
 
 	txHashHex := hex.EncodeToString(txHash)
-	_, err = node.GetFacadeHandler().SendBulkTransactions([]*transaction.Transaction{tx})
+	_, err = node.GetFacadeHandler().SendBulkTransactions(context.Background(), []*transaction.Transaction{tx})
 	if err != nil {
 		return "", err
 	}
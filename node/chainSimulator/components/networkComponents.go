@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-go/p2p"
 	disabledP2P "github.com/multiversx/mx-chain-go/p2p/disabled"
 	"github.com/multiversx/mx-chain-go/process"
+	disabledPeerReputation "github.com/multiversx/mx-chain-go/process/rating/peerReputation/disabled"
 	disabledAntiflood "github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 )
 
@@ -19,6 +20,7 @@ type networkComponentsHolder struct {
 	pubKeyCacher                           process.TimeCacher
 	peerBlackListHandler                   process.PeerBlackListCacher
 	peerHonestyHandler                     factory.PeerHonestyHandler
+	peerReputationHandler                  process.PeerReputationHandler
 	preferredPeersHolderHandler            factory.PreferredPeersHolderHandler
 	peersRatingHandler                     p2p.PeersRatingHandler
 	peersRatingMonitor                     p2p.PeersRatingMonitor
@@ -41,6 +43,7 @@ func CreateNetworkComponents(network SyncedBroadcastNetworkHandler) (*networkCom
 		pubKeyCacher:                           &disabledAntiflood.TimeCache{},
 		peerBlackListHandler:                   &disabledAntiflood.PeerBlacklistCacher{},
 		peerHonestyHandler:                     disabled.NewPeerHonesty(),
+		peerReputationHandler:                  disabledPeerReputation.NewPeerReputationHandler(),
 		preferredPeersHolderHandler:            disabledFactory.NewPreferredPeersHolder(),
 		peersRatingHandler:                     disabledBootstrap.NewDisabledPeersRatingHandler(),
 		peersRatingMonitor:                     disabled.NewPeersRatingMonitor(),
@@ -83,6 +86,11 @@ func (holder *networkComponentsHolder) PeerHonestyHandler() factory.PeerHonestyH
 	return holder.peerHonestyHandler
 }
 
+// PeerReputationHandler returns the peer reputation handler
+func (holder *networkComponentsHolder) PeerReputationHandler() process.PeerReputationHandler {
+	return holder.peerReputationHandler
+}
+
 // PreferredPeersHolderHandler returns the preferred peers holder
 func (holder *networkComponentsHolder) PreferredPeersHolderHandler() factory.PreferredPeersHolderHandler {
 	return holder.preferredPeersHolderHandler
@@ -113,6 +121,7 @@ func (holder *networkComponentsHolder) collectClosableComponents() {
 	holder.closeHandler.AddComponent(holder.inputAntiFloodHandler)
 	holder.closeHandler.AddComponent(holder.outputAntiFloodHandler)
 	holder.closeHandler.AddComponent(holder.peerHonestyHandler)
+	holder.closeHandler.AddComponent(holder.peerReputationHandler)
 	holder.closeHandler.AddComponent(holder.fullArchiveNetworkMessenger)
 }
 
@@ -100,6 +100,11 @@ func (node *testOnlyProcessingNode) createFacade(configs config.Configs, apiInte
 		return errors.New("error creating node: " + err.Error())
 	}
 
+	err = nodePack.CreateGuardianCoSigner(nd, configs.GeneralConfig.GuardianCoSigner)
+	if err != nil {
+		return fmt.Errorf("%w while creating the guardian co-signer", err)
+	}
+
 	shardID := node.GetShardCoordinator().SelfId()
 	restApiInterface := apiInterface.RestApiInterface(shardID)
 
@@ -135,6 +140,8 @@ func (node *testOnlyProcessingNode) createHttpServer(configs config.Configs) err
 		Facade:          node.facadeHandler,
 		ApiConfig:       *configs.ApiRoutesConfig,
 		AntiFloodConfig: configs.GeneralConfig.WebServerAntiflood,
+		EventsConfig:    configs.ExternalConfig.EventsSubscriptions,
+		MetricsRegistry: node.StatusCoreComponents.MetricsRegistry(),
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -208,6 +208,7 @@ func createArgsProcessComponentsHolder() ArgsProcessComponentsHolder {
 			Outport: &outport.OutportStub{},
 		},
 		StatusCoreComponents: &factory.StatusCoreComponentsStub{
+			MetricsRegistryField:   &testscommon.MetricsRegistryStub{},
 			AppStatusHandlerField:  &statusHandler.AppStatusHandlerStub{},
 			StateStatsHandlerField: disabledStatistics.NewStateStatistics(),
 		},
@@ -17,21 +17,23 @@ import (
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/outport"
+	disabledOutport "github.com/multiversx/mx-chain-go/outport/disabled"
 	"github.com/multiversx/mx-chain-go/outport/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
 )
 
 type statusComponentsHolder struct {
-	closeHandler             *closeHandler
-	outportHandler           outport.OutportHandler
-	softwareVersionChecker   statistics.SoftwareVersionChecker
-	managedPeerMonitor       common.ManagedPeersMonitor
-	appStatusHandler         core.AppStatusHandler
-	forkDetector             process.ForkDetector
-	statusPollingIntervalSec int
-	cancelFunc               func()
-	mutex                    sync.RWMutex
+	closeHandler               *closeHandler
+	outportHandler             outport.OutportHandler
+	eventsSubscriptionsHandler outport.EventsSubscriptionsHandler
+	softwareVersionChecker     statistics.SoftwareVersionChecker
+	managedPeerMonitor         common.ManagedPeersMonitor
+	appStatusHandler           core.AppStatusHandler
+	forkDetector               process.ForkDetector
+	statusPollingIntervalSec   int
+	cancelFunc                 func()
+	mutex                      sync.RWMutex
 }
 
 // CreateStatusComponents will create a new instance of status components holder
@@ -62,6 +64,7 @@ func CreateStatusComponents(shardID uint32, appStatusHandler core.AppStatusHandl
 	if err != nil {
 		return nil, err
 	}
+	instance.eventsSubscriptionsHandler = disabledOutport.NewDisabledEventsSubscriptionsHandler()
 	instance.softwareVersionChecker = &mock.SoftwareVersionCheckerMock{}
 	instance.managedPeerMonitor = &testscommon.ManagedPeersMonitorStub{}
 
@@ -117,6 +120,11 @@ func (s *statusComponentsHolder) OutportHandler() outport.OutportHandler {
 	return s.outportHandler
 }
 
+// EventsSubscriptionsHandler will return the events subscriptions handler
+func (s *statusComponentsHolder) EventsSubscriptionsHandler() outport.EventsSubscriptionsHandler {
+	return s.eventsSubscriptionsHandler
+}
+
 // SoftwareVersionChecker will return the software version checker
 func (s *statusComponentsHolder) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return s.softwareVersionChecker
@@ -19,6 +19,7 @@ type statusCoreComponentsHolder struct {
 	statusMetrics                     external.StatusMetricsHandler
 	persistentStatusHandler           factory.PersistentStatusHandler
 	stateStatisticsHandler            common.StateStatisticsHandler
+	metricsRegistry                   common.MetricsRegistry
 	managedStatusCoreComponentsCloser io.Closer
 }
 
@@ -59,6 +60,7 @@ func CreateStatusCoreComponents(configs config.Configs, coreComponents factory.C
 		statusMetrics:                     managedStatusCoreComponents.StatusMetrics(),
 		persistentStatusHandler:           managedStatusCoreComponents.PersistentStatusHandler(),
 		stateStatisticsHandler:            managedStatusCoreComponents.StateStatsHandler(),
+		metricsRegistry:                   managedStatusCoreComponents.MetricsRegistry(),
 		managedStatusCoreComponentsCloser: managedStatusCoreComponents,
 	}
 
@@ -100,6 +102,11 @@ func (s *statusCoreComponentsHolder) PersistentStatusHandler() factory.Persisten
 	return s.persistentStatusHandler
 }
 
+// MetricsRegistry will return the typed metrics registry
+func (s *statusCoreComponentsHolder) MetricsRegistry() common.MetricsRegistry {
+	return s.metricsRegistry
+}
+
 // Close will call the Close methods on all inner components
 func (s *statusCoreComponentsHolder) Close() error {
 	return s.managedStatusCoreComponentsCloser.Close()
@@ -108,6 +108,7 @@ func TestStatusCoreComponentsHolder_Getters(t *testing.T) {
 	require.NotNil(t, comp.StatusMetrics())
 	require.NotNil(t, comp.PersistentStatusHandler())
 	require.NotNil(t, comp.StateStatsHandler())
+	require.NotNil(t, comp.MetricsRegistry())
 	require.Nil(t, comp.CheckSubcomponents())
 	require.Empty(t, comp.String())
 }
@@ -30,6 +30,7 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.UserAccountsUnit,
 		dataRetriever.PeerAccountsUnit,
 		dataRetriever.ESDTSuppliesUnit,
+		dataRetriever.GovernanceIndexUnit,
 		dataRetriever.RoundHdrHashDataUnit,
 		dataRetriever.MiniblocksMetadataUnit,
 		dataRetriever.MiniblockHashByTxHashUnit,
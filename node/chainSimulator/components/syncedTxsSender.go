@@ -1,6 +1,8 @@
 package components
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -52,7 +54,7 @@ func NewSyncedTxsSender(args ArgsSyncedTxsSender) (*syncedTxsSender, error) {
 }
 
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
-func (sender *syncedTxsSender) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
+func (sender *syncedTxsSender) SendBulkTransactions(_ context.Context, txs []*transaction.Transaction) (uint64, error) {
 	if len(txs) == 0 {
 		return 0, process.ErrNoTxToProcess
 	}
@@ -1,6 +1,7 @@
 package components
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -173,7 +174,7 @@ func TestSyncedTxsSender_SendBulkTransactions(t *testing.T) {
 		args := createMockSyncedTxsSenderArgs()
 		sender, _ := NewSyncedTxsSender(args)
 
-		num, err := sender.SendBulkTransactions(nil)
+		num, err := sender.SendBulkTransactions(context.Background(), nil)
 		assert.Equal(t, process.ErrNoTxToProcess, err)
 		assert.Zero(t, num)
 	})
@@ -188,7 +189,7 @@ func TestSyncedTxsSender_SendBulkTransactions(t *testing.T) {
 		}
 		sender, _ := NewSyncedTxsSender(args)
 
-		num, err := sender.SendBulkTransactions(testTransactions)
+		num, err := sender.SendBulkTransactions(context.Background(), testTransactions)
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(4), num)
 
@@ -138,3 +138,24 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrNilHeartbeatV2Components signals that nil heartbeat v2 components were provided
+var ErrNilHeartbeatV2Components = errors.New("nil heartbeat v2 components")
+
+// ErrNilHeartbeatHistory signals that a nil heartbeat history was provided
+var ErrNilHeartbeatHistory = errors.New("nil heartbeat history")
+
+// ErrNilP2PMessageTracer signals that a nil p2p message tracer was provided
+var ErrNilP2PMessageTracer = errors.New("nil p2p message tracer")
+
+// ErrP2PMessageTracingDisabled signals that the p2p message tracing is disabled
+var ErrP2PMessageTracingDisabled = errors.New("p2p message tracing is disabled")
+
+// ErrNilGuardianCoSigner signals that a nil guardian co-signer was provided
+var ErrNilGuardianCoSigner = errors.New("nil guardian co-signer")
+
+// ErrGuardianCoSignerDisabled signals that the guardian co-signing service is disabled
+var ErrGuardianCoSignerDisabled = errors.New("guardian co-signing service is disabled")
+
+// ErrNilEventsSubscriptionsHandler signals that a nil events subscriptions handler was provided
+var ErrNilEventsSubscriptionsHandler = errors.New("nil events subscriptions handler")
@@ -17,4 +17,6 @@ type ArgsCreateTransaction struct {
 	Options          uint32
 	Guardian         string
 	GuardianSigHex   string
+	Relayer          string
+	RelayerSigHex    string
 }
@@ -8,6 +8,9 @@ var ErrNilSCQueryService = errors.New("nil SC query service")
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 
+// ErrNilMetricsRegistry signals that a nil metrics registry was provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
+
 // ErrNilAPITransactionEvaluator signals that a nil api transaction evaluator was provided
 var ErrNilAPITransactionEvaluator = errors.New("nil api transaction evaluator")
 
@@ -20,6 +23,9 @@ var ErrNilDirectStakeListHandler = errors.New("nil direct stake list handler")
 // ErrNilDelegatedListHandler signals that a nil delegated list handler has been provided
 var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 
+// ErrNilValidatorsPerformanceHandler signals that a nil validators performance handler has been provided
+var ErrNilValidatorsPerformanceHandler = errors.New("nil validators performance handler")
+
 // ErrNilAPITransactionHandler signals that a nil api transaction handler has been provided
 var ErrNilAPITransactionHandler = errors.New("nil api transaction handler")
 
@@ -28,10 +28,12 @@ var log = logger.GetOrCreate("node/external")
 type ArgNodeApiResolver struct {
 	SCQueryService           SCQueryService
 	StatusMetricsHandler     StatusMetricsHandler
+	MetricsRegistry          common.MetricsRegistry
 	APITransactionEvaluator  TransactionEvaluator
 	TotalStakedValueHandler  TotalStakedValueHandler
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
+	ValidatorsPerformance    ValidatorsPerformanceHandler
 	APITransactionHandler    APITransactionHandler
 	APIBlockHandler          blockAPI.APIBlockHandler
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -49,10 +51,12 @@ type ArgNodeApiResolver struct {
 type nodeApiResolver struct {
 	scQueryService           SCQueryService
 	statusMetricsHandler     StatusMetricsHandler
+	metricsRegistry          common.MetricsRegistry
 	apiTransactionEvaluator  TransactionEvaluator
 	totalStakedValueHandler  TotalStakedValueHandler
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
+	validatorsPerformance    ValidatorsPerformanceHandler
 	apiTransactionHandler    APITransactionHandler
 	apiBlockHandler          blockAPI.APIBlockHandler
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -86,6 +90,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.ValidatorsPerformance) {
+		return nil, ErrNilValidatorsPerformanceHandler
+	}
 	if check.IfNil(arg.APITransactionHandler) {
 		return nil, ErrNilAPITransactionHandler
 	}
@@ -113,14 +120,19 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.NodesCoordinator) {
 		return nil, ErrNilNodesCoordinator
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return nil, ErrNilMetricsRegistry
+	}
 
 	return &nodeApiResolver{
 		scQueryService:           arg.SCQueryService,
 		statusMetricsHandler:     arg.StatusMetricsHandler,
+		metricsRegistry:          arg.MetricsRegistry,
 		apiTransactionEvaluator:  arg.APITransactionEvaluator,
 		totalStakedValueHandler:  arg.TotalStakedValueHandler,
 		directStakedListHandler:  arg.DirectStakedListHandler,
 		delegatedListHandler:     arg.DelegatedListHandler,
+		validatorsPerformance:    arg.ValidatorsPerformance,
 		apiBlockHandler:          arg.APIBlockHandler,
 		apiTransactionHandler:    arg.APITransactionHandler,
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
@@ -136,8 +148,8 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 }
 
 // ExecuteSCQuery retrieves data stored in a SC account through a VM
-func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
-	return nar.scQueryService.ExecuteQuery(query)
+func (nar *nodeApiResolver) ExecuteSCQuery(ctx context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	return nar.scQueryService.ExecuteQuery(ctx, query)
 }
 
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
@@ -145,14 +157,41 @@ func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
 }
 
+// MetricsRegistry returns the registry holding the typed Prometheus metrics of the node
+func (nar *nodeApiResolver) MetricsRegistry() common.MetricsRegistry {
+	return nar.metricsRegistry
+}
+
 // ComputeTransactionGasLimit will calculate how many gas a transaction will consume
-func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
-	return nar.apiTransactionEvaluator.ComputeTransactionGasLimit(tx)
+func (nar *nodeApiResolver) ComputeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
+	return nar.apiTransactionEvaluator.ComputeTransactionGasLimit(ctx, tx, stateOverrides)
 }
 
 // SimulateTransactionExecution will simulate the provided transaction and return the simulation results
-func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
+func (nar *nodeApiResolver) SimulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionExecution(ctx, tx, stateOverrides)
+}
+
+// TraceTransactionExecution will simulate the provided transaction and return the simulation results together with
+// the execution trace
+func (nar *nodeApiResolver) TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nar.apiTransactionEvaluator.TraceTransactionExecution(tx, stateOverrides)
+}
+
+// ReplayTransaction will re-execute, with tracing, the historical transaction with the provided hash against the
+// state it was executed on
+func (nar *nodeApiResolver) ReplayTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	replayData, err := nar.apiTransactionHandler.GetTransactionReplayData(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return nar.apiTransactionEvaluator.ReplayTransaction(replayData)
+}
+
+// SimulateTransactionsSequence will simulate the provided ordered transactions and return the simulation results
+func (nar *nodeApiResolver) SimulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionsSequence(ctx, txs, stateOverrides)
 }
 
 // Close closes all underlying components
@@ -184,6 +223,11 @@ func (nar *nodeApiResolver) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nar.delegatedListHandler.GetDelegatorsList(ctx)
 }
 
+// GetValidatorsPerformance will return the validators performance for each epoch in the provided range
+func (nar *nodeApiResolver) GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+	return nar.validatorsPerformance.GetValidatorsPerformance(startEpoch, endEpoch)
+}
+
 // GetTransaction will return the transaction with the given hash and optionally with results
 func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nar.apiTransactionHandler.GetTransaction(hash, withResults)
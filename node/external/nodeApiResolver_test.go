@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -32,10 +33,12 @@ func createMockArgs() external.ArgNodeApiResolver {
 	return external.ArgNodeApiResolver{
 		SCQueryService:           &mock.SCQueryServiceStub{},
 		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 		APITransactionEvaluator:  &mock.TransactionCostEstimatorMock{},
 		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
+		ValidatorsPerformance:    &mock.ValidatorsPerformanceProcessorStub{},
 		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
 		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
@@ -114,6 +117,17 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilValidatorsPerformanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ValidatorsPerformance = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilValidatorsPerformanceHandler, err)
+}
+
 func TestNewNodeApiResolver_NilGasSchedules(t *testing.T) {
 	t.Parallel()
 
@@ -136,6 +150,17 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilMetricsRegistry(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.MetricsRegistry = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilMetricsRegistry, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -178,7 +203,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	}
 	nar, _ := external.NewNodeApiResolver(arg)
 
-	_, _, _ = nar.ExecuteSCQuery(&process.SCQuery{
+	_, _, _ = nar.ExecuteSCQuery(context.Background(), &process.SCQuery{
 		ScAddress: []byte{0},
 		FuncName:  "",
 	})
@@ -277,6 +302,28 @@ func TestNodeApiResolver_GetDelegatorsList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetValidatorsPerformance(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	performance := []*common.ValidatorEpochPerformance{{Epoch: 3}}
+	arg.ValidatorsPerformance = &mock.ValidatorsPerformanceProcessorStub{
+		GetValidatorsPerformanceCalled: func(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+			wasCalled = true
+			assert.Equal(t, uint32(3), startEpoch)
+			assert.Equal(t, uint32(4), endEpoch)
+			return performance, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredPerformance, err := nar.GetValidatorsPerformance(3, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, performance, recoveredPerformance)
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
@@ -373,6 +420,56 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_ReplayTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replay data error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionReplayDataCalled: func(hash string) (*txSimData.TransactionReplayData, error) {
+				return nil, expectedErr
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			ReplayTransactionCalled: func(_ *txSimData.TransactionReplayData) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		results, err := nar.ReplayTransaction("0101")
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+	})
+	t.Run("should replay the loaded data", func(t *testing.T) {
+		t.Parallel()
+
+		providedReplayData := &txSimData.TransactionReplayData{Transaction: &transaction.Transaction{Nonce: 7}}
+		providedResults := &txSimData.SimulationResultsWithVMOutput{}
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionReplayDataCalled: func(hash string) (*txSimData.TransactionReplayData, error) {
+				require.Equal(t, "0101", hash)
+				return providedReplayData, nil
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			ReplayTransactionCalled: func(replayData *txSimData.TransactionReplayData) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, providedReplayData, replayData)
+				return providedResults, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		results, err := nar.ReplayTransaction("0101")
+		require.Nil(t, err)
+		require.Equal(t, providedResults, results)
+	})
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
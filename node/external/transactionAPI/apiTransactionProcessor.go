@@ -1,6 +1,7 @@
 package transactionAPI
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
@@ -175,6 +177,255 @@ func (atp *apiTransactionProcessor) doGetTransaction(hash []byte, withResults bo
 	return atp.getTransactionFromStorage(hash)
 }
 
+// GetTransactionReplayData will gather, from storage, the information needed to re-execute a historical transaction:
+// the transaction itself, the header of its block, the state root hash of the previous block together with the
+// scheduled transactions of the previous block (executed on top of that root hash, before the current block) and the
+// transactions executed before the target one in its block, in execution order
+func (atp *apiTransactionProcessor) GetTransactionReplayData(txHash string) (*txSimData.TransactionReplayData, error) {
+	if !atp.historyRepository.IsEnabled() {
+		return nil, ErrDBLookExtensionIsNotEnabled
+	}
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+	if block.Type(miniblockMetadata.Type) != block.TxBlock {
+		return nil, ErrTransactionReplayNotSupported
+	}
+
+	header, err := atp.getHeaderFromStorage(miniblockMetadata.HeaderHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	precedingMiniblockHeaders, targetMiniblockHeader, err := atp.getMiniblockHeadersExecutedBefore(header, miniblockMetadata.MiniblockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	prevHeader, prevHeaderEpoch, err := atp.getPreviousHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	prevHeaderScheduledTxs, err := atp.getScheduledTransactionsOfBlock(prevHeader, prevHeaderEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	precedingTxs := make([]*transaction.Transaction, 0)
+	for _, miniblockHeader := range precedingMiniblockHeaders {
+		txs, errGet := atp.getExecutedTransactionsOfMiniblock(miniblockHeader, miniblockMetadata.Epoch, nil)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		precedingTxs = append(precedingTxs, txs...)
+	}
+
+	txsOfOwnMiniblock, err := atp.getExecutedTransactionsOfMiniblock(targetMiniblockHeader, miniblockMetadata.Epoch, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	numPrecedingInOwnMiniblock := len(txsOfOwnMiniblock) - 1
+	return &txSimData.TransactionReplayData{
+		Transaction:                         txsOfOwnMiniblock[numPrecedingInOwnMiniblock],
+		PrecedingTransactions:               append(precedingTxs, txsOfOwnMiniblock[:numPrecedingInOwnMiniblock]...),
+		Header:                              header,
+		PreviousHeader:                      prevHeader,
+		PreviousHeaderScheduledTransactions: prevHeaderScheduledTxs,
+		RootHash:                            prevHeader.GetRootHash(),
+		RootHashEpoch:                       core.OptionalUint32{Value: prevHeaderEpoch, HasValue: true},
+	}, nil
+}
+
+// getMiniblockHeadersExecutedBefore returns, in execution order, the headers of the miniblocks executed in the provided
+// block before the one with the provided hash, together with the header of the latter. The miniblocks are executed
+// in three steps: the ones with destination in self shard, the ones from self shard and, after the block is committed,
+// the scheduled ones from self shard. The miniblocks already executed as scheduled in the previous block and the ones
+// holding the results of the execution are skipped, as they do not change the state by themselves
+func (atp *apiTransactionProcessor) getMiniblockHeadersExecutedBefore(
+	header data.HeaderHandler,
+	miniblockHash []byte,
+) ([]data.MiniBlockHeaderHandler, data.MiniBlockHeaderHandler, error) {
+	selfShardID := atp.shardCoordinator.SelfId()
+	normalMiniblockHeaders := make([]data.MiniBlockHeaderHandler, 0)
+	scheduledMiniblockHeaders := make([]data.MiniBlockHeaderHandler, 0)
+	for _, miniblockHeader := range header.GetMiniBlockHeaderHandlers() {
+		isFromSelfShard := miniblockHeader.GetSenderShardID() == selfShardID
+		isExecutionResult := isFromSelfShard && miniblockHeader.GetTypeInt32() != int32(block.TxBlock)
+		isAlreadyExecuted := miniblockHeader.GetProcessingType() == int32(block.Processed)
+		// the validators info does not change the accounts state
+		isPeerBlock := miniblockHeader.GetTypeInt32() == int32(block.PeerBlock)
+		if isExecutionResult || isAlreadyExecuted || isPeerBlock {
+			continue
+		}
+
+		if isFromSelfShard && miniblockHeader.GetProcessingType() == int32(block.Scheduled) {
+			scheduledMiniblockHeaders = append(scheduledMiniblockHeaders, miniblockHeader)
+			continue
+		}
+
+		normalMiniblockHeaders = append(normalMiniblockHeaders, miniblockHeader)
+	}
+
+	executedMiniblockHeaders := append(normalMiniblockHeaders, scheduledMiniblockHeaders...)
+	for index, miniblockHeader := range executedMiniblockHeaders {
+		if !bytes.Equal(miniblockHeader.GetHash(), miniblockHash) {
+			continue
+		}
+
+		precedingMiniblockHeaders := executedMiniblockHeaders[:index]
+		for _, precedingMiniblockHeader := range precedingMiniblockHeaders {
+			// only the regular transactions can be re-executed
+			if precedingMiniblockHeader.GetTypeInt32() != int32(block.TxBlock) {
+				return nil, nil, ErrTransactionReplayNotSupported
+			}
+		}
+
+		return precedingMiniblockHeaders, miniblockHeader, nil
+	}
+
+	return nil, nil, ErrTransactionReplayNotSupported
+}
+
+func (atp *apiTransactionProcessor) getPreviousHeader(header data.HeaderHandler) (data.HeaderHandler, uint32, error) {
+	prevHeaderEpoch, err := atp.historyRepository.GetEpochByHash(header.GetPrevHash())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	prevHeader, err := atp.getHeaderFromStorage(header.GetPrevHash(), prevHeaderEpoch)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return prevHeader, prevHeaderEpoch, nil
+}
+
+// getScheduledTransactionsOfBlock returns the transactions of the scheduled miniblocks from self shard of the provided
+// block, executed after the block was committed, on top of its root hash
+func (atp *apiTransactionProcessor) getScheduledTransactionsOfBlock(header data.HeaderHandler, epoch uint32) ([]*transaction.Transaction, error) {
+	scheduledTxs := make([]*transaction.Transaction, 0)
+	for _, miniblockHeader := range header.GetMiniBlockHeaderHandlers() {
+		isScheduledFromSelfShard := miniblockHeader.GetSenderShardID() == atp.shardCoordinator.SelfId() &&
+			miniblockHeader.GetProcessingType() == int32(block.Scheduled)
+		if !isScheduledFromSelfShard {
+			continue
+		}
+
+		txs, err := atp.getExecutedTransactionsOfMiniblock(miniblockHeader, epoch, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduledTxs = append(scheduledTxs, txs...)
+	}
+
+	return scheduledTxs, nil
+}
+
+// getExecutedTransactionsOfMiniblock returns the transactions executed in the block from the provided miniblock. If a
+// last transaction hash is provided, the transactions after it are not returned and not finding it is an error
+func (atp *apiTransactionProcessor) getExecutedTransactionsOfMiniblock(
+	miniblockHeader data.MiniBlockHeaderHandler,
+	epoch uint32,
+	lastTxHash []byte,
+) ([]*transaction.Transaction, error) {
+	miniblock, err := atp.getMiniblockFromStorage(miniblockHeader.GetHash(), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	firstIndex := int(miniblockHeader.GetIndexOfFirstTxProcessed())
+	lastIndex := int(miniblockHeader.GetIndexOfLastTxProcessed())
+	if firstIndex < 0 || lastIndex >= len(miniblock.TxHashes) {
+		return nil, ErrInvalidMiniblockProcessedIndexes
+	}
+
+	txs := make([]*transaction.Transaction, 0, lastIndex-firstIndex+1)
+	for index := firstIndex; index <= lastIndex; index++ {
+		currentHash := miniblock.TxHashes[index]
+		tx, errGet := atp.getRegularTransactionFromStorage(currentHash, epoch)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		txs = append(txs, tx)
+		if bytes.Equal(currentHash, lastTxHash) {
+			return txs, nil
+		}
+	}
+
+	if len(lastTxHash) > 0 {
+		return nil, ErrTransactionNotFoundInMiniblock
+	}
+
+	return txs, nil
+}
+
+func (atp *apiTransactionProcessor) getHeaderFromStorage(headerHash []byte, epoch uint32) (data.HeaderHandler, error) {
+	shardID := atp.shardCoordinator.SelfId()
+	storer, err := atp.storageService.GetStorer(dataRetriever.GetHeadersDataUnit(shardID))
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := storer.GetFromEpoch(headerHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalHeader(shardID, atp.marshalizer, headerBytes)
+}
+
+func (atp *apiTransactionProcessor) getMiniblockFromStorage(miniblockHash []byte, epoch uint32) (*block.MiniBlock, error) {
+	storer, err := atp.storageService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblockBytes, err := storer.GetFromEpoch(miniblockHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblock := &block.MiniBlock{}
+	err = atp.marshalizer.Unmarshal(miniblock, miniblockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniblock, nil
+}
+
+func (atp *apiTransactionProcessor) getRegularTransactionFromStorage(hash []byte, epoch uint32) (*transaction.Transaction, error) {
+	storer, err := atp.storageService.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := storer.GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrCannotRetrieveTransaction.Error(), err)
+	}
+
+	tx := &transaction.Transaction{}
+	err = atp.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
 // PopulateComputedFields populates (computes) transaction fields such as processing type(s), initially paid fee etc.
 func (atp *apiTransactionProcessor) PopulateComputedFields(tx *transaction.ApiTransactionResult) {
 	atp.populateComputedFieldsProcessingType(tx)
@@ -405,6 +656,12 @@ func (atp *apiTransactionProcessor) getFieldGettersForTx(wrappedTx *txcache.Wrap
 		fieldGetters[guardianSignatureField] = hex.EncodeToString(guardedTx.GetGuardianSignature())
 	}
 
+	relayedTx, isRelayedTx := wrappedTx.Tx.(data.RelayedTransactionHandler)
+	if isRelayedTx && len(relayedTx.GetRelayerAddr()) > 0 {
+		fieldGetters[relayerField] = atp.addressPubKeyConverter.SilentEncode(relayedTx.GetRelayerAddr(), log)
+		fieldGetters[relayerSignatureField] = hex.EncodeToString(relayedTx.GetRelayerSignature())
+	}
+
 	return fieldGetters
 }
 
@@ -1240,6 +1240,73 @@ func TestPrepareUnsignedTx(t *testing.T) {
 	assert.Equal(t, scrResult2, expectedScr2)
 }
 
+func TestPrepareNormalTxRelayedTxV3(t *testing.T) {
+	t.Parallel()
+	addrSize := 32
+	tx := &transaction.Transaction{
+		Nonce:            1,
+		Value:            big.NewInt(2),
+		SndAddr:          bytes.Repeat([]byte{0}, addrSize),
+		RcvAddr:          bytes.Repeat([]byte{1}, addrSize),
+		Signature:        []byte("signature"),
+		RelayerAddr:      bytes.Repeat([]byte{5}, addrSize),
+		RelayerSignature: []byte("relayer signature"),
+	}
+
+	n, _, _, _ := createAPITransactionProc(t, 0, true)
+	n.txUnmarshaller.addressPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(addrSize, "erd")
+
+	apiTx := n.txUnmarshaller.prepareNormalTx(tx)
+	assert.Equal(t, "erd1q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zsrqsks3", apiTx.RelayerAddress)
+	assert.Equal(t, hex.EncodeToString([]byte("relayer signature")), apiTx.RelayerSignature)
+
+	apiTx = n.txUnmarshaller.prepareInvalidTx(tx)
+	assert.Equal(t, "erd1q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zsrqsks3", apiTx.RelayerAddress)
+	assert.Equal(t, hex.EncodeToString([]byte("relayer signature")), apiTx.RelayerSignature)
+
+	tx.RelayerAddr = nil
+	tx.RelayerSignature = nil
+	apiTx = n.txUnmarshaller.prepareNormalTx(tx)
+	assert.Empty(t, apiTx.RelayerAddress)
+	assert.Empty(t, apiTx.RelayerSignature)
+}
+
+func TestApiTransactionProcessor_GetFieldGettersForRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgAPITransactionProcessor()
+	args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+		SilentEncodeCalled: func(pkBytes []byte, log core.Logger) string {
+			return string(pkBytes)
+		},
+	}
+	atp, err := NewAPITransactionProcessor(args)
+	require.NoError(t, err)
+
+	wrappedTx := &txcache.WrappedTransaction{
+		Tx: &transaction.Transaction{
+			Value:            big.NewInt(0),
+			SndAddr:          []byte("sender"),
+			RelayerAddr:      []byte("relayer"),
+			RelayerSignature: []byte("relayer signature"),
+		},
+		TxHash: []byte("txHash"),
+	}
+	fieldGetters := atp.getFieldGettersForTx(wrappedTx)
+	require.Equal(t, "relayer", fieldGetters[relayerField])
+	require.Equal(t, hex.EncodeToString([]byte("relayer signature")), fieldGetters[relayerSignatureField])
+
+	wrappedTx.Tx = &transaction.Transaction{
+		Value:   big.NewInt(0),
+		SndAddr: []byte("sender"),
+	}
+	fieldGetters = atp.getFieldGettersForTx(wrappedTx)
+	_, found := fieldGetters[relayerField]
+	require.False(t, found)
+	_, found = fieldGetters[relayerSignatureField]
+	require.False(t, found)
+}
+
 func TestNode_ComputeTimestampForRound(t *testing.T) {
 	genesis := getTime(t, "1596117600")
 	n, _, _, _ := createAPITransactionProc(t, 0, false)
@@ -1362,3 +1429,234 @@ func TestApiTransactionProcessor_PopulateComputedFields(t *testing.T) {
 	require.Equal(t, "SCDeployment", apiTx.ProcessingTypeOnDestination)
 	require.Equal(t, "1000", apiTx.InitiallyPaidFee)
 }
+
+func TestApiTransactionProcessor_GetTransactionReplayData(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(42)
+	prevEpoch := uint32(41)
+	headerHash := []byte("header hash")
+	prevHeaderHash := []byte("previous header hash")
+	selfShardID := createShardCoordinator().SelfId()
+
+	createMiniblockHeader := func(hash string, senderShardID uint32, blockType block.Type, processingType block.ProcessingType, txCount uint32) block.MiniBlockHeader {
+		miniblockHeader := block.MiniBlockHeader{
+			Hash:            []byte(hash),
+			SenderShardID:   senderShardID,
+			ReceiverShardID: selfShardID,
+			Type:            blockType,
+			TxCount:         txCount,
+		}
+		_ = miniblockHeader.SetProcessingType(int32(processingType))
+
+		return miniblockHeader
+	}
+
+	// the previous block holds a normal and a scheduled miniblock, only the latter being executed after the block
+	prevMiniblocks := map[string][]string{
+		"prev normal":    {"q0"},
+		"prev scheduled": {"p0", "p1"},
+	}
+	prevHeader := &block.Header{
+		Nonce:    36,
+		RootHash: []byte("previous root hash"),
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			createMiniblockHeader("prev normal", selfShardID, block.TxBlock, block.Normal, 1),
+			createMiniblockHeader("prev scheduled", selfShardID, block.TxBlock, block.Scheduled, 2),
+		},
+	}
+
+	// the block is executed as: the cross shard miniblock (only its last 2 transactions), the normal miniblocks from
+	// self shard and, at last, the scheduled one. The results and the already executed miniblocks are skipped
+	miniblocks := map[string][]string{
+		"cross shard":       {"x0", "x1", "x2"},
+		"results":           {"r0"},
+		"already executed":  {"e0"},
+		"normal":            {"a", "b", "c"},
+		"scheduled":         {"s0", "s1"},
+		"normal after":      {"d"},
+		"cross shard scrs":  {"scr0"},
+		"after cross scrs":  {"f"},
+		"processed targets": {"g"},
+	}
+	crossShardMiniblockHeader := createMiniblockHeader("cross shard", 0, block.TxBlock, block.Normal, 3)
+	_ = crossShardMiniblockHeader.SetIndexOfFirstTxProcessed(1)
+	_ = crossShardMiniblockHeader.SetIndexOfLastTxProcessed(2)
+	header := &block.Header{
+		Nonce:    37,
+		PrevHash: prevHeaderHash,
+		Epoch:    epoch,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			crossShardMiniblockHeader,
+			createMiniblockHeader("normal", selfShardID, block.TxBlock, block.Normal, 3),
+			createMiniblockHeader("scheduled", selfShardID, block.TxBlock, block.Scheduled, 2),
+			createMiniblockHeader("normal after", selfShardID, block.TxBlock, block.Normal, 1),
+			createMiniblockHeader("results", selfShardID, block.SmartContractResultBlock, block.Normal, 1),
+			createMiniblockHeader("already executed", selfShardID, block.TxBlock, block.Processed, 1),
+			createMiniblockHeader("processed targets", selfShardID, block.TxBlock, block.Processed, 1),
+		},
+	}
+	headerWithCrossShardResults := &block.Header{
+		Nonce:    37,
+		PrevHash: prevHeaderHash,
+		Epoch:    epoch,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			createMiniblockHeader("cross shard scrs", 0, block.SmartContractResultBlock, block.Normal, 1),
+			createMiniblockHeader("after cross scrs", selfShardID, block.TxBlock, block.Normal, 1),
+		},
+	}
+
+	// each transaction gets a distinct nonce, so it can be identified in the replay data
+	nonces := make(map[string]uint64)
+	for _, txHashes := range []map[string][]string{prevMiniblocks, miniblocks} {
+		for _, hashes := range txHashes {
+			for _, txHash := range hashes {
+				nonces[txHash] = uint64(len(nonces))
+			}
+		}
+	}
+
+	setupStorage := func(t *testing.T, header data.HeaderHandler) *apiTransactionProcessor {
+		atp, chainStorer, _, historyRepo := createAPITransactionProc(t, epoch, true)
+		marshal := func(obj interface{}) []byte {
+			buff, err := atp.marshalizer.Marshal(obj)
+			require.Nil(t, err)
+			return buff
+		}
+		miniblockHashByTxHash := make(map[string]string)
+		storeMiniblocks := func(miniblocksToStore map[string][]string, storageEpoch uint32) {
+			for miniblockHash, txHashes := range miniblocksToStore {
+				miniblock := &block.MiniBlock{}
+				for _, txHash := range txHashes {
+					miniblockHashByTxHash[txHash] = miniblockHash
+					miniblock.TxHashes = append(miniblock.TxHashes, []byte(txHash))
+					tx := &transaction.Transaction{Nonce: nonces[txHash], SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+					_ = chainStorer.Transactions.PutInEpoch([]byte(txHash), marshal(tx), storageEpoch)
+				}
+				_ = chainStorer.Miniblocks.PutInEpoch([]byte(miniblockHash), marshal(miniblock), storageEpoch)
+			}
+		}
+		storeMiniblocks(miniblocks, epoch)
+		storeMiniblocks(prevMiniblocks, prevEpoch)
+		_ = chainStorer.BlockHeaders.PutInEpoch(headerHash, marshal(header), epoch)
+		_ = chainStorer.BlockHeaders.PutInEpoch(prevHeaderHash, marshal(prevHeader), prevEpoch)
+
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			miniblockHash, ok := miniblockHashByTxHash[string(hash)]
+			if !ok {
+				// a transaction reported in a miniblock it is missing from
+				miniblockHash = "normal"
+			}
+
+			return &dblookupext.MiniblockMetadata{
+				Type:          int32(block.TxBlock),
+				Epoch:         epoch,
+				HeaderHash:    headerHash,
+				MiniblockHash: []byte(miniblockHash),
+			}, nil
+		}
+		historyRepo.GetEpochByHashCalled = func(hash []byte) (uint32, error) {
+			require.Equal(t, prevHeaderHash, hash)
+			return prevEpoch, nil
+		}
+
+		return atp
+	}
+	getNonces := func(names ...string) []uint64 {
+		result := make([]uint64, 0, len(names))
+		for _, name := range names {
+			result = append(result, nonces[name])
+		}
+		return result
+	}
+	getTxsNonces := func(txs []*transaction.Transaction) []uint64 {
+		result := make([]uint64, 0, len(txs))
+		for _, tx := range txs {
+			result = append(result, tx.Nonce)
+		}
+		return result
+	}
+
+	t.Run("dblookupext not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, _ := createAPITransactionProc(t, epoch, false)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("b")))
+		require.Equal(t, ErrDBLookExtensionIsNotEnabled, err)
+		require.Nil(t, replayData)
+	})
+	t.Run("not a regular transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _, _, historyRepo := createAPITransactionProc(t, epoch, true)
+		setupGetMiniblockMetadataByTxHash(historyRepo, block.SmartContractResultBlock, 1, 1, epoch, headerHash, 0)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("b")))
+		require.Equal(t, ErrTransactionReplayNotSupported, err)
+		require.Nil(t, replayData)
+	})
+	t.Run("transaction missing from its miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp := setupStorage(t, header)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("missing")))
+		require.Equal(t, ErrTransactionNotFoundInMiniblock, err)
+		require.Nil(t, replayData)
+	})
+	t.Run("transaction from a miniblock executed in the previous block should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp := setupStorage(t, header)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("g")))
+		require.Equal(t, ErrTransactionReplayNotSupported, err)
+		require.Nil(t, replayData)
+	})
+	t.Run("preceding miniblock which is not a transactions one should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp := setupStorage(t, headerWithCrossShardResults)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("f")))
+		require.Equal(t, ErrTransactionReplayNotSupported, err)
+		require.Nil(t, replayData)
+	})
+	t.Run("should return the preceding transactions in execution order", func(t *testing.T) {
+		t.Parallel()
+
+		atp := setupStorage(t, header)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("b")))
+		require.Nil(t, err)
+		require.Equal(t, nonces["b"], replayData.Transaction.Nonce)
+		require.Equal(t, getNonces("x1", "x2", "a"), getTxsNonces(replayData.PrecedingTransactions))
+		require.Equal(t, getNonces("p0", "p1"), getTxsNonces(replayData.PreviousHeaderScheduledTransactions))
+		require.Equal(t, uint64(37), replayData.Header.GetNonce())
+		require.Equal(t, uint64(36), replayData.PreviousHeader.GetNonce())
+		require.Equal(t, []byte("previous root hash"), replayData.RootHash)
+		require.Equal(t, core.OptionalUint32{Value: prevEpoch, HasValue: true}, replayData.RootHashEpoch)
+	})
+	t.Run("normal miniblocks should be executed before the scheduled ones", func(t *testing.T) {
+		t.Parallel()
+
+		atp := setupStorage(t, header)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("d")))
+		require.Nil(t, err)
+		require.Equal(t, nonces["d"], replayData.Transaction.Nonce)
+		require.Equal(t, getNonces("x1", "x2", "a", "b", "c"), getTxsNonces(replayData.PrecedingTransactions))
+	})
+	t.Run("scheduled transaction should be replayed after all the normal miniblocks", func(t *testing.T) {
+		t.Parallel()
+
+		atp := setupStorage(t, header)
+
+		replayData, err := atp.GetTransactionReplayData(hex.EncodeToString([]byte("s1")))
+		require.Nil(t, err)
+		require.Equal(t, nonces["s1"], replayData.Transaction.Nonce)
+		require.Equal(t, getNonces("x1", "x2", "a", "b", "c", "d", "s0"), getTxsNonces(replayData.PrecedingTransactions))
+		require.Equal(t, getNonces("p0", "p1"), getTxsNonces(replayData.PreviousHeaderScheduledTransactions))
+	})
+}
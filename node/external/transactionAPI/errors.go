@@ -34,3 +34,12 @@ var ErrInvalidAddress = errors.New("invalid address")
 
 // ErrDBLookExtensionIsNotEnabled signals that the db look extension is not enabled
 var ErrDBLookExtensionIsNotEnabled = errors.New("db look extension is not enabled")
+
+// ErrTransactionReplayNotSupported signals that the re-execution is not supported for the requested transaction
+var ErrTransactionReplayNotSupported = errors.New("re-execution is supported only for regular transactions preceded, in their block, by regular transactions only")
+
+// ErrTransactionNotFoundInMiniblock signals that the transaction was not found in the miniblock it was reported in
+var ErrTransactionNotFoundInMiniblock = errors.New("transaction not found in its miniblock")
+
+// ErrInvalidMiniblockProcessedIndexes signals that the processed transactions indexes of a miniblock are out of its bounds
+var ErrInvalidMiniblockProcessedIndexes = errors.New("invalid processed transactions indexes for miniblock")
@@ -17,6 +17,8 @@ const (
 	signatureField         = "signature"
 	guardianField          = "guardian"
 	guardianSignatureField = "guardiansignature"
+	relayerField           = "relayer"
+	relayerSignatureField  = "relayersignature"
 	senderShardID          = "sendershard"
 	receiverShardID        = "receivershard"
 	wildCard               = "*"
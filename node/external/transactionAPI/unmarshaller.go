@@ -133,6 +133,11 @@ func (tu *txUnmarshaller) prepareNormalTx(tx *transaction.Transaction) *transact
 		apiTx.GuardianSignature = hex.EncodeToString(tx.GuardianSignature)
 	}
 
+	if len(tx.RelayerAddr) > 0 {
+		apiTx.RelayerAddress = tu.addressPubKeyConverter.SilentEncode(tx.RelayerAddr, log)
+		apiTx.RelayerSignature = hex.EncodeToString(tx.RelayerSignature)
+	}
+
 	return apiTx
 }
 
@@ -163,6 +168,11 @@ func (tu *txUnmarshaller) prepareInvalidTx(tx *transaction.Transaction) *transac
 		apiTx.GuardianSignature = hex.EncodeToString(tx.GuardianSignature)
 	}
 
+	if len(tx.RelayerAddr) > 0 {
+		apiTx.RelayerAddress = tu.addressPubKeyConverter.SilentEncode(tx.RelayerAddr, log)
+		apiTx.RelayerSignature = hex.EncodeToString(tx.RelayerSignature)
+	}
+
 	return apiTx
 }
 
@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/external/validatorsPerformance"
+)
+
+type validatorsPerformanceProcessor struct {
+}
+
+// NewDisabledValidatorsPerformanceProcessor returns a new instance of a disabled validators performance processor,
+// used on shard nodes, which do not hold the peer accounts trie
+func NewDisabledValidatorsPerformanceProcessor() *validatorsPerformanceProcessor {
+	return &validatorsPerformanceProcessor{}
+}
+
+// GetValidatorsPerformance returns nil and error
+func (vpp *validatorsPerformanceProcessor) GetValidatorsPerformance(_ uint32, _ uint32) ([]*common.ValidatorEpochPerformance, error) {
+	return nil, validatorsPerformance.ErrMetachainOnlyEndpoint
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vpp *validatorsPerformanceProcessor) IsInterfaceNil() bool {
+	return vpp == nil
+}
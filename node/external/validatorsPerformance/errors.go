@@ -0,0 +1,34 @@
+package validatorsPerformance
+
+import "errors"
+
+// ErrNilValidatorInfoProvider signals that a nil validator info provider has been provided
+var ErrNilValidatorInfoProvider = errors.New("nil validator info provider")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidMaxRating signals that an invalid max rating has been provided
+var ErrInvalidMaxRating = errors.New("invalid max rating")
+
+// ErrInvalidMaxNumEpochs signals that an invalid maximum number of epochs has been provided
+var ErrInvalidMaxNumEpochs = errors.New("invalid maximum number of epochs")
+
+// ErrInvalidEpochsRange signals that an invalid epochs range has been requested
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
+// ErrEpochDataNotAvailable signals that the data for the requested epoch is not available
+var ErrEpochDataNotAvailable = errors.New("epoch data not available")
+
+// ErrPeerAccountsTrieNotAvailable signals that the peer accounts trie of the last block of the requested epoch is not
+// available: it is kept only by the metachain nodes which do not prune the peer accounts state
+var ErrPeerAccountsTrieNotAvailable = errors.New("peer accounts trie not available, the report requires a metachain node with PeerStatePruningEnabled set to false")
+
+// ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
+var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
@@ -0,0 +1,10 @@
+package validatorsPerformance
+
+import "github.com/multiversx/mx-chain-go/state"
+
+// ValidatorInfoProvider defines the component able to return the validators info stored in the peer accounts trie
+// at a given root hash
+type ValidatorInfoProvider interface {
+	GetValidatorInfoForRootHash(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error)
+	IsInterfaceNil() bool
+}
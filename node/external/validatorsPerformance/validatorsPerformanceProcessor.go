@@ -0,0 +1,375 @@
+package validatorsPerformance
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("node/external/validatorsPerformance")
+
+// ArgValidatorsPerformanceProcessor is the DTO used to create a new instance of validatorsPerformanceProcessor
+type ArgValidatorsPerformanceProcessor struct {
+	ValidatorInfoProvider    ValidatorInfoProvider
+	StorageService           dataRetriever.StorageService
+	Marshaller               marshal.Marshalizer
+	ValidatorPubKeyConverter core.PubkeyConverter
+	AddressPubKeyConverter   core.PubkeyConverter
+	MaxRating                uint32
+	MaxNumEpochs             uint32
+}
+
+type validatorsPerformanceProcessor struct {
+	validatorInfoProvider    ValidatorInfoProvider
+	metaBlockStorer          storage.Storer
+	miniBlockStorer          storage.Storer
+	rewardTxStorer           storage.Storer
+	marshaller               marshal.Marshalizer
+	validatorPubKeyConverter core.PubkeyConverter
+	addressPubKeyConverter   core.PubkeyConverter
+	maxRating                uint32
+	maxNumEpochs             uint32
+}
+
+type rewardAddressData struct {
+	rewards         *big.Int
+	accumulatedFees *big.Int
+	numBlocks       uint64
+}
+
+// NewValidatorsPerformanceProcessor creates a new instance of validatorsPerformanceProcessor, able to compute the
+// performance of the validators in past epochs out of the stored epoch start data
+func NewValidatorsPerformanceProcessor(args ArgValidatorsPerformanceProcessor) (*validatorsPerformanceProcessor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlockStorer, err := args.StorageService.GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, fmt.Errorf("%w for identifier MetaBlockUnit", err)
+	}
+	miniBlockStorer, err := args.StorageService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, fmt.Errorf("%w for identifier MiniBlockUnit", err)
+	}
+	rewardTxStorer, err := args.StorageService.GetStorer(dataRetriever.RewardTransactionUnit)
+	if err != nil {
+		return nil, fmt.Errorf("%w for identifier RewardTransactionUnit", err)
+	}
+
+	return &validatorsPerformanceProcessor{
+		validatorInfoProvider:    args.ValidatorInfoProvider,
+		metaBlockStorer:          metaBlockStorer,
+		miniBlockStorer:          miniBlockStorer,
+		rewardTxStorer:           rewardTxStorer,
+		marshaller:               args.Marshaller,
+		validatorPubKeyConverter: args.ValidatorPubKeyConverter,
+		addressPubKeyConverter:   args.AddressPubKeyConverter,
+		maxRating:                args.MaxRating,
+		maxNumEpochs:             args.MaxNumEpochs,
+	}, nil
+}
+
+func checkArgs(args ArgValidatorsPerformanceProcessor) error {
+	if check.IfNil(args.ValidatorInfoProvider) {
+		return ErrNilValidatorInfoProvider
+	}
+	if check.IfNil(args.StorageService) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.ValidatorPubKeyConverter) {
+		return fmt.Errorf("%w for validators", ErrNilPubkeyConverter)
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return fmt.Errorf("%w for addresses", ErrNilPubkeyConverter)
+	}
+	if args.MaxRating == 0 {
+		return ErrInvalidMaxRating
+	}
+	if args.MaxNumEpochs == 0 {
+		return ErrInvalidMaxNumEpochs
+	}
+
+	return nil
+}
+
+// GetValidatorsPerformance returns the performance of all validators for each epoch in the provided range,
+// both ends included. Only finished epochs can be requested. The validators statistics are read from the peer accounts
+// trie of the last block of each epoch, so the report can only be computed by metachain nodes that did not prune it
+// (PeerStatePruningEnabled set to false), otherwise ErrPeerAccountsTrieNotAvailable is returned
+func (vpp *validatorsPerformanceProcessor) GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+	if startEpoch > endEpoch {
+		return nil, fmt.Errorf("%w, start epoch %d is greater than end epoch %d", ErrInvalidEpochsRange, startEpoch, endEpoch)
+	}
+	numEpochs := uint64(endEpoch) - uint64(startEpoch) + 1
+	if numEpochs > uint64(vpp.maxNumEpochs) {
+		return nil, fmt.Errorf("%w, requested %d epochs, maximum %d", ErrInvalidEpochsRange, numEpochs, vpp.maxNumEpochs)
+	}
+
+	result := make([]*common.ValidatorEpochPerformance, 0)
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		epochPerformance, err := vpp.getPerformanceForEpoch(epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, epochPerformance...)
+
+		if epoch == endEpoch {
+			// avoid overflow when endEpoch is the maximum uint32 value
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (vpp *validatorsPerformanceProcessor) getPerformanceForEpoch(epoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+	// the statistics of an epoch are finalized in the epoch start block of the next one, which also holds the rewards
+	nextEpoch := epoch + 1
+	epochStartBlock, err := vpp.getEpochStartMetaBlock(nextEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", ErrEpochDataNotAvailable, epoch, err.Error())
+	}
+
+	lastBlockOfEpoch, err := vpp.getMetaBlock(epochStartBlock.GetPrevHash(), epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d: %s", ErrEpochDataNotAvailable, epoch, err.Error())
+	}
+
+	validatorsInfo, err := vpp.validatorInfoProvider.GetValidatorInfoForRootHash(lastBlockOfEpoch.GetValidatorStatsRootHash())
+	if err != nil {
+		return nil, fmt.Errorf("%w for epoch %d, root hash %s: %s",
+			ErrPeerAccountsTrieNotAvailable, epoch, hex.EncodeToString(lastBlockOfEpoch.GetValidatorStatsRootHash()), err.Error())
+	}
+
+	rewardsPerAddress := vpp.getRewardsPerAddress(epochStartBlock, nextEpoch)
+	addressesData := computeRewardAddressesData(validatorsInfo, rewardsPerAddress)
+
+	return vpp.createEpochPerformance(epoch, validatorsInfo, addressesData), nil
+}
+
+func (vpp *validatorsPerformanceProcessor) getEpochStartMetaBlock(epoch uint32) (data.MetaHeaderHandler, error) {
+	identifier := core.EpochStartIdentifier(epoch)
+
+	return vpp.getMetaBlock([]byte(identifier), epoch)
+}
+
+func (vpp *validatorsPerformanceProcessor) getMetaBlock(key []byte, epoch uint32) (data.MetaHeaderHandler, error) {
+	buff, err := vpp.metaBlockStorer.GetFromEpoch(key, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalMetaHeader(vpp.marshaller, buff)
+}
+
+func (vpp *validatorsPerformanceProcessor) getRewardsPerAddress(epochStartBlock data.MetaHeaderHandler, epoch uint32) map[string]*big.Int {
+	rewardsPerAddress := make(map[string]*big.Int)
+	for _, mbHeader := range epochStartBlock.GetMiniBlockHeaderHandlers() {
+		if mbHeader.GetTypeInt32() != int32(block.RewardsBlock) {
+			continue
+		}
+
+		miniBlock, err := vpp.getMiniBlock(mbHeader.GetHash(), epoch)
+		if err != nil {
+			log.Debug("validatorsPerformanceProcessor.getRewardsPerAddress: can not load rewards mini block",
+				"epoch", epoch, "hash", mbHeader.GetHash(), "error", err)
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			rwdTx, errGet := vpp.getRewardTx(txHash, epoch)
+			if errGet != nil {
+				log.Debug("validatorsPerformanceProcessor.getRewardsPerAddress: can not load reward transaction",
+					"epoch", epoch, "hash", txHash, "error", errGet)
+				continue
+			}
+
+			rewards, ok := rewardsPerAddress[string(rwdTx.RcvAddr)]
+			if !ok {
+				rewards = big.NewInt(0)
+				rewardsPerAddress[string(rwdTx.RcvAddr)] = rewards
+			}
+			rewards.Add(rewards, rwdTx.GetValue())
+		}
+	}
+
+	return rewardsPerAddress
+}
+
+func (vpp *validatorsPerformanceProcessor) getMiniBlock(hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	buff, err := vpp.miniBlockStorer.GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = vpp.marshaller.Unmarshal(miniBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+func (vpp *validatorsPerformanceProcessor) getRewardTx(hash []byte, epoch uint32) (*rewardTx.RewardTx, error) {
+	buff, err := vpp.rewardTxStorer.GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	rwdTx := &rewardTx.RewardTx{}
+	err = vpp.marshaller.Unmarshal(rwdTx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return rwdTx, nil
+}
+
+// computeRewardAddressesData aggregates, for each reward address, the received rewards together with the leader fees
+// and the number of signed blocks of all the validators sharing it
+func computeRewardAddressesData(
+	validatorsInfo state.ShardValidatorsInfoMapHandler,
+	rewardsPerAddress map[string]*big.Int,
+) map[string]*rewardAddressData {
+	addressesData := make(map[string]*rewardAddressData)
+	for _, validatorInfo := range validatorsInfo.GetAllValidatorsInfo() {
+		address := string(validatorInfo.GetRewardAddress())
+		addressData, ok := addressesData[address]
+		if !ok {
+			rewards, found := rewardsPerAddress[address]
+			if !found {
+				rewards = big.NewInt(0)
+			}
+
+			addressData = &rewardAddressData{
+				rewards:         rewards,
+				accumulatedFees: big.NewInt(0),
+			}
+			addressesData[address] = addressData
+		}
+
+		if validatorInfo.GetAccumulatedFees() != nil {
+			addressData.accumulatedFees.Add(addressData.accumulatedFees, validatorInfo.GetAccumulatedFees())
+		}
+		addressData.numBlocks += uint64(validatorInfo.GetNumSelectedInSuccessBlocks())
+	}
+
+	return addressesData
+}
+
+func (vpp *validatorsPerformanceProcessor) createEpochPerformance(
+	epoch uint32,
+	validatorsInfo state.ShardValidatorsInfoMapHandler,
+	addressesData map[string]*rewardAddressData,
+) []*common.ValidatorEpochPerformance {
+	allValidatorsInfo := validatorsInfo.GetAllValidatorsInfo()
+	result := make([]*common.ValidatorEpochPerformance, 0, len(allValidatorsInfo))
+	for _, validatorInfo := range allValidatorsInfo {
+		accumulatedFees := big.NewInt(0)
+		if validatorInfo.GetAccumulatedFees() != nil {
+			accumulatedFees.Set(validatorInfo.GetAccumulatedFees())
+		}
+
+		rewardAddress := validatorInfo.GetRewardAddress()
+		addressData := addressesData[string(rewardAddress)]
+		rewardAddressRewards := big.NewInt(0)
+		if addressData != nil {
+			rewardAddressRewards.Set(addressData.rewards)
+		}
+		estimatedRewards := estimateValidatorRewards(validatorInfo, addressData)
+
+		result = append(result, &common.ValidatorEpochPerformance{
+			Epoch:                         epoch,
+			PublicKey:                     vpp.validatorPubKeyConverter.SilentEncode(validatorInfo.GetPublicKey(), log),
+			ShardId:                       validatorInfo.GetShardId(),
+			List:                          validatorInfo.GetList(),
+			NumLeaderSuccess:              validatorInfo.GetLeaderSuccess(),
+			NumLeaderFailure:              validatorInfo.GetLeaderFailure(),
+			NumValidatorSuccess:           validatorInfo.GetValidatorSuccess(),
+			NumValidatorFailure:           validatorInfo.GetValidatorFailure(),
+			NumValidatorIgnoredSignatures: validatorInfo.GetValidatorIgnoredSignatures(),
+			NumSelectedInSuccessBlocks:    validatorInfo.GetNumSelectedInSuccessBlocks(),
+			StartRating:                   vpp.computeRatingPercent(validatorInfo.GetRating()),
+			EndRating:                     vpp.computeRatingPercent(validatorInfo.GetTempRating()),
+			AccumulatedFees:               accumulatedFees.String(),
+			RewardAddress:                 vpp.encodeAddress(rewardAddress),
+			RewardAddressRewards:          rewardAddressRewards.String(),
+			EstimatedRewards:              estimatedRewards.String(),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].ShardId != result[j].ShardId {
+			return result[i].ShardId < result[j].ShardId
+		}
+
+		return result[i].PublicKey < result[j].PublicKey
+	})
+
+	return result
+}
+
+// estimateValidatorRewards estimates the rewards of a validator. The rewards are paid per reward address and the
+// per validator split is not recorded, so the validator is attributed its own leader fees plus a share of the rest of
+// the address rewards, proportional to its signed blocks. The result is not the amount actually paid for the BLS key
+func estimateValidatorRewards(validatorInfo state.ValidatorInfoHandler, addressData *rewardAddressData) *big.Int {
+	rewards := big.NewInt(0)
+	if addressData == nil {
+		return rewards
+	}
+
+	if validatorInfo.GetAccumulatedFees() != nil {
+		rewards.Set(validatorInfo.GetAccumulatedFees())
+	}
+	if addressData.numBlocks == 0 {
+		return rewards
+	}
+
+	remainingRewards := big.NewInt(0).Sub(addressData.rewards, addressData.accumulatedFees)
+	if remainingRewards.Sign() <= 0 {
+		return rewards
+	}
+
+	share := big.NewInt(0).Mul(remainingRewards, big.NewInt(0).SetUint64(uint64(validatorInfo.GetNumSelectedInSuccessBlocks())))
+	share.Div(share, big.NewInt(0).SetUint64(addressData.numBlocks))
+
+	return rewards.Add(rewards, share)
+}
+
+func (vpp *validatorsPerformanceProcessor) computeRatingPercent(rating uint32) float32 {
+	return float32(rating) * 100 / float32(vpp.maxRating)
+}
+
+func (vpp *validatorsPerformanceProcessor) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return vpp.addressPubKeyConverter.SilentEncode(address, log)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vpp *validatorsPerformanceProcessor) IsInterfaceNil() bool {
+	return vpp == nil
+}
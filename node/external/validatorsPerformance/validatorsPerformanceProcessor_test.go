@@ -0,0 +1,297 @@
+package validatorsPerformance
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	lastBlockHash          = []byte("last block of epoch hash")
+	validatorStatsRootHash = []byte("validator stats root hash")
+	rewardsMiniBlockHash   = []byte("rewards mini block hash")
+	rewardAddress          = []byte("reward address")
+)
+
+func createMockArgs() ArgValidatorsPerformanceProcessor {
+	return ArgValidatorsPerformanceProcessor{
+		ValidatorInfoProvider:    &testscommon.ValidatorStatisticsProcessorStub{},
+		StorageService:           genericMocks.NewChainStorerMock(0),
+		Marshaller:               &marshallerMock.MarshalizerMock{},
+		ValidatorPubKeyConverter: testscommon.NewPubkeyConverterMock(4),
+		AddressPubKeyConverter:   testscommon.NewPubkeyConverterMock(14),
+		MaxRating:                100,
+		MaxNumEpochs:             5,
+	}
+}
+
+func TestNewValidatorsPerformanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil validator info provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ValidatorInfoProvider = nil
+		vpp, err := NewValidatorsPerformanceProcessor(args)
+		assert.True(t, check.IfNil(vpp))
+		assert.Equal(t, ErrNilValidatorInfoProvider, err)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StorageService = nil
+		vpp, err := NewValidatorsPerformanceProcessor(args)
+		assert.True(t, check.IfNil(vpp))
+		assert.Equal(t, ErrNilStorageService, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+		vpp, err := NewValidatorsPerformanceProcessor(args)
+		assert.True(t, check.IfNil(vpp))
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil validator pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ValidatorPubKeyConverter = nil
+		vpp, err := NewValidatorsPerformanceProcessor(args)
+		assert.True(t, check.IfNil(vpp))
+		assert.True(t, errors.Is(err, ErrNilPubkeyConverter))
+	})
+	t.Run("nil address pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AddressPubKeyConverter = nil
+		vpp, err := NewValidatorsPerformanceProcessor(args)
+		assert.True(t, check.IfNil(vpp))
+		assert.True(t, errors.Is(err, ErrNilPubkeyConverter))
+	})
+	t.Run("zero max rating should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxRating = 0
+		vpp, err := NewValidatorsPerformanceProcessor(args)
+		assert.True(t, check.IfNil(vpp))
+		assert.Equal(t, ErrInvalidMaxRating, err)
+	})
+	t.Run("zero max num epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxNumEpochs = 0
+		vpp, err := NewValidatorsPerformanceProcessor(args)
+		assert.True(t, check.IfNil(vpp))
+		assert.Equal(t, ErrInvalidMaxNumEpochs, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, err := NewValidatorsPerformanceProcessor(createMockArgs())
+		assert.False(t, check.IfNil(vpp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestValidatorsPerformanceProcessor_GetValidatorsPerformance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("start epoch greater than end epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, _ := NewValidatorsPerformanceProcessor(createMockArgs())
+		performance, err := vpp.GetValidatorsPerformance(3, 2)
+		assert.Nil(t, performance)
+		assert.True(t, errors.Is(err, ErrInvalidEpochsRange))
+	})
+	t.Run("too many epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, _ := NewValidatorsPerformanceProcessor(createMockArgs())
+		performance, err := vpp.GetValidatorsPerformance(0, 5)
+		assert.Nil(t, performance)
+		assert.True(t, errors.Is(err, ErrInvalidEpochsRange))
+	})
+	t.Run("unfinished epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		vpp, _ := NewValidatorsPerformanceProcessor(createMockArgs())
+		performance, err := vpp.GetValidatorsPerformance(1, 1)
+		assert.Nil(t, performance)
+		assert.True(t, errors.Is(err, ErrEpochDataNotAvailable))
+	})
+	t.Run("peer accounts trie not available should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		storageService := genericMocks.NewChainStorerMock(0)
+		args.StorageService = storageService
+		saveEpochData(t, storageService, args, 1)
+		expectedErr := errors.New("trie missing")
+		args.ValidatorInfoProvider = &testscommon.ValidatorStatisticsProcessorStub{
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				return nil, expectedErr
+			},
+		}
+
+		vpp, _ := NewValidatorsPerformanceProcessor(args)
+		performance, err := vpp.GetValidatorsPerformance(1, 1)
+		assert.Nil(t, performance)
+		assert.True(t, errors.Is(err, ErrPeerAccountsTrieNotAvailable))
+		assert.True(t, strings.Contains(err.Error(), hex.EncodeToString(validatorStatsRootHash)))
+		assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		storageService := genericMocks.NewChainStorerMock(0)
+		args.StorageService = storageService
+		saveEpochData(t, storageService, args, 1)
+		saveEpochData(t, storageService, args, 2)
+
+		requestedRootHashes := 0
+		args.ValidatorInfoProvider = &testscommon.ValidatorStatisticsProcessorStub{
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (state.ShardValidatorsInfoMapHandler, error) {
+				assert.Equal(t, validatorStatsRootHash, rootHash)
+				requestedRootHashes++
+				return createValidatorsInfo(t), nil
+			},
+		}
+
+		vpp, _ := NewValidatorsPerformanceProcessor(args)
+		performance, err := vpp.GetValidatorsPerformance(1, 2)
+		require.Nil(t, err)
+		require.Equal(t, 4, len(performance))
+		assert.Equal(t, 2, requestedRootHashes)
+
+		encodedRewardAddress := args.AddressPubKeyConverter.SilentEncode(rewardAddress, log)
+		expectedFirstValidator := &common.ValidatorEpochPerformance{
+			Epoch:                         1,
+			PublicKey:                     args.ValidatorPubKeyConverter.SilentEncode([]byte("pk00"), log),
+			ShardId:                       0,
+			List:                          string(common.EligibleList),
+			NumLeaderSuccess:              2,
+			NumLeaderFailure:              1,
+			NumValidatorSuccess:           10,
+			NumValidatorFailure:           3,
+			NumValidatorIgnoredSignatures: 4,
+			NumSelectedInSuccessBlocks:    30,
+			StartRating:                   50,
+			EndRating:                     60,
+			AccumulatedFees:               "100",
+			RewardAddress:                 encodedRewardAddress,
+			RewardAddressRewards:          "1000",
+			// own fees (100) + (1000 - 100 - 0) * 30 / (30 + 10)
+			EstimatedRewards: "775",
+		}
+		assert.Equal(t, expectedFirstValidator, performance[0])
+		assert.Equal(t, uint32(1), performance[1].Epoch)
+		assert.Equal(t, "1000", performance[1].RewardAddressRewards)
+		assert.Equal(t, "225", performance[1].EstimatedRewards)
+		assert.Equal(t, uint32(2), performance[2].Epoch)
+		assert.Equal(t, uint32(2), performance[3].Epoch)
+	})
+}
+
+func saveEpochData(t *testing.T, storageService dataRetriever.StorageService, args ArgValidatorsPerformanceProcessor, epoch uint32) {
+	metaBlockStorer, _ := storageService.GetStorer(dataRetriever.MetaBlockUnit)
+	miniBlockStorer, _ := storageService.GetStorer(dataRetriever.MiniBlockUnit)
+	rewardTxStorer, _ := storageService.GetStorer(dataRetriever.RewardTransactionUnit)
+
+	lastBlock := &block.MetaBlock{
+		Epoch:                  epoch,
+		ValidatorStatsRootHash: validatorStatsRootHash,
+	}
+	buff, err := args.Marshaller.Marshal(lastBlock)
+	require.Nil(t, err)
+	require.Nil(t, metaBlockStorer.PutInEpoch(lastBlockHash, buff, epoch))
+
+	epochStartBlock := &block.MetaBlock{
+		Epoch:    epoch + 1,
+		PrevHash: lastBlockHash,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{
+				Hash: []byte("peer mini block hash"),
+				Type: block.PeerBlock,
+			},
+			{
+				Hash: rewardsMiniBlockHash,
+				Type: block.RewardsBlock,
+			},
+		},
+	}
+	buff, err = args.Marshaller.Marshal(epochStartBlock)
+	require.Nil(t, err)
+	require.Nil(t, metaBlockStorer.PutInEpoch([]byte(core.EpochStartIdentifier(epoch+1)), buff, epoch+1))
+
+	rewardTxHash := []byte("reward tx hash")
+	miniBlock := &block.MiniBlock{
+		TxHashes: [][]byte{rewardTxHash, []byte("missing reward tx hash")},
+		Type:     block.RewardsBlock,
+	}
+	buff, err = args.Marshaller.Marshal(miniBlock)
+	require.Nil(t, err)
+	require.Nil(t, miniBlockStorer.PutInEpoch(rewardsMiniBlockHash, buff, epoch+1))
+
+	rwdTx := &rewardTx.RewardTx{
+		Epoch:   epoch,
+		Value:   big.NewInt(1000),
+		RcvAddr: rewardAddress,
+	}
+	buff, err = args.Marshaller.Marshal(rwdTx)
+	require.Nil(t, err)
+	require.Nil(t, rewardTxStorer.PutInEpoch(rewardTxHash, buff, epoch+1))
+}
+
+func createValidatorsInfo(t *testing.T) state.ShardValidatorsInfoMapHandler {
+	validatorsInfo := state.NewShardValidatorsInfoMap()
+	err := validatorsInfo.Add(&state.ValidatorInfo{
+		PublicKey:                  []byte("pk01"),
+		ShardId:                    0,
+		List:                       string(common.EligibleList),
+		RewardAddress:              rewardAddress,
+		NumSelectedInSuccessBlocks: 10,
+	})
+	require.Nil(t, err)
+	err = validatorsInfo.Add(&state.ValidatorInfo{
+		PublicKey:                  []byte("pk00"),
+		ShardId:                    0,
+		List:                       string(common.EligibleList),
+		LeaderSuccess:              2,
+		LeaderFailure:              1,
+		ValidatorSuccess:           10,
+		ValidatorFailure:           3,
+		ValidatorIgnoredSignatures: 4,
+		NumSelectedInSuccessBlocks: 30,
+		Rating:                     50,
+		TempRating:                 60,
+		AccumulatedFees:            big.NewInt(100),
+		RewardAddress:              rewardAddress,
+	})
+	require.Nil(t, err)
+
+	return validatorsInfo
+}
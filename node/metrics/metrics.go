@@ -202,6 +202,10 @@ func InitConfigMetrics(
 	appStatusHandler.SetUInt64Value(common.MetricCryptoOpcodesV2EnableEpoch, uint64(enableEpochs.CryptoOpcodesV2EnableEpoch))
 	appStatusHandler.SetUInt64Value(common.MetricMultiESDTNFTTransferAndExecuteByUserEnableEpoch, uint64(enableEpochs.MultiESDTNFTTransferAndExecuteByUserEnableEpoch))
 	appStatusHandler.SetUInt64Value(common.MetricFixRelayedMoveBalanceToNonPayableSCEnableEpoch, uint64(enableEpochs.FixRelayedMoveBalanceToNonPayableSCEnableEpoch))
+	appStatusHandler.SetUInt64Value(common.MetricRelayedTransactionsV3EnableEpoch, uint64(enableEpochs.RelayedTransactionsV3EnableEpoch))
+	appStatusHandler.SetUInt64Value(common.MetricGovernanceParameterChangesEnableEpoch, uint64(enableEpochs.GovernanceParameterChangesEnableEpoch))
+	appStatusHandler.SetUInt64Value(common.MetricDelegationLiquidStakingEnableEpoch, uint64(enableEpochs.DelegationLiquidStakingEnableEpoch))
+	appStatusHandler.SetUInt64Value(common.MetricESDTSupplyPolicyEnableEpoch, uint64(enableEpochs.ESDTSupplyPolicyEnableEpoch))
 
 	for i, nodesChangeConfig := range enableEpochs.MaxNodesChangeEnableEpoch {
 		epochEnable := fmt.Sprintf("%s%d%s", common.MetricMaxNodesChangeEnableEpoch, i, common.EpochEnableSuffix)
@@ -211,6 +211,10 @@ func TestInitConfigMetrics(t *testing.T) {
 			FixRelayedBaseCostEnableEpoch:                            104,
 			MultiESDTNFTTransferAndExecuteByUserEnableEpoch:          105,
 			FixRelayedMoveBalanceToNonPayableSCEnableEpoch:           106,
+			RelayedTransactionsV3EnableEpoch:                         107,
+			GovernanceParameterChangesEnableEpoch:                    108,
+			DelegationLiquidStakingEnableEpoch:                       109,
+			ESDTSupplyPolicyEnableEpoch:                              110,
 			MaxNodesChangeEnableEpoch: []config.MaxNodesChangeConfig{
 				{
 					EpochEnable:            0,
@@ -332,6 +336,10 @@ func TestInitConfigMetrics(t *testing.T) {
 		"erd_fix_relayed_base_cost_enable_epoch":                               uint32(104),
 		"erd_multi_esdt_transfer_execute_by_user_enable_epoch":                 uint32(105),
 		"erd_fix_relayed_move_balance_to_non_payable_sc_enable_epoch":          uint32(106),
+		"erd_relayed_transactions_v3_enable_epoch":                             uint32(107),
+		"erd_governance_parameter_changes_enable_epoch":                        uint32(108),
+		"erd_delegation_liquid_staking_enable_epoch":                           uint32(109),
+		"erd_esdt_supply_policy_enable_epoch":                                  uint32(110),
 		"erd_max_nodes_change_enable_epoch":                                    nil,
 		"erd_total_supply":                                                     "12345",
 		"erd_hysteresis":                                                       "0.100000",
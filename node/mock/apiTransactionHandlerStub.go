@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
 
 // TransactionAPIHandlerStub -
@@ -16,6 +17,7 @@ type TransactionAPIHandlerStub struct {
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
 	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetTransactionReplayDataCalled              func(txHash string) (*txSimData.TransactionReplayData, error)
 }
 
 // GetSCRsByTxHash --
@@ -72,6 +74,15 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionReplayData -
+func (tas *TransactionAPIHandlerStub) GetTransactionReplayData(txHash string) (*txSimData.TransactionReplayData, error) {
+	if tas.GetTransactionReplayDataCalled != nil {
+		return tas.GetTransactionReplayDataCalled(txHash)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
@@ -12,6 +12,7 @@ type NetworkComponentsMock struct {
 	InputAntiFlood                   factory.P2PAntifloodHandler
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
+	PeerReputationHandlerField       process.PeerReputationHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
 	PeersRatingMonitorField          p2p.PeersRatingMonitor
@@ -29,6 +30,11 @@ func (ncm *NetworkComponentsMock) PeerHonestyHandler() factory.PeerHonestyHandle
 	panic("implement me")
 }
 
+// PeerReputationHandler -
+func (ncm *NetworkComponentsMock) PeerReputationHandler() process.PeerReputationHandler {
+	return ncm.PeerReputationHandlerField
+}
+
 // Create -
 func (ncm *NetworkComponentsMock) Create() error {
 	return nil
@@ -4,7 +4,8 @@ import "github.com/multiversx/mx-chain-go/debug"
 
 // NodeWrapperStub -
 type NodeWrapperStub struct {
-	AddQueryHandlerCalled func(name string, handler debug.QueryHandler) error
+	AddQueryHandlerCalled     func(name string, handler debug.QueryHandler) error
+	SetP2PMessageTracerCalled func(tracer debug.P2PMessageTracer) error
 }
 
 // AddQueryHandler -
@@ -16,6 +17,15 @@ func (nws *NodeWrapperStub) AddQueryHandler(name string, handler debug.QueryHand
 	return nil
 }
 
+// SetP2PMessageTracer -
+func (nws *NodeWrapperStub) SetP2PMessageTracer(tracer debug.P2PMessageTracer) error {
+	if nws.SetP2PMessageTracerCalled != nil {
+		return nws.SetP2PMessageTracerCalled(tracer)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (nws *NodeWrapperStub) IsInterfaceNil() bool {
 	return nws == nil
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
@@ -15,7 +17,7 @@ type SCQueryServiceStub struct {
 }
 
 // ExecuteQuery -
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(_ context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	return serviceStub.ExecuteQueryCalled(query)
 }
 
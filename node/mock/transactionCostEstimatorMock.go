@@ -1,33 +1,66 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
 
 // TransactionCostEstimatorMock  -
 type TransactionCostEstimatorMock struct {
-	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionCalled func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
+	SimulateTransactionExecutionCalled func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionExecutionCalled    func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error)
+	ReplayTransactionCalled            func(replayData *txSimData.TransactionReplayData) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsSequenceCalled func(txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error)
 }
 
 // ComputeTransactionGasLimit -
-func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(_ context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
 	if tcem.ComputeTransactionGasLimitCalled != nil {
-		return tcem.ComputeTransactionGasLimitCalled(tx)
+		return tcem.ComputeTransactionGasLimitCalled(tx, stateOverrides)
 	}
 	return &transaction.CostResponse{}, nil
 }
 
 // SimulateTransactionExecution -
-func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(_ context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if tcem.SimulateTransactionExecutionCalled != nil {
-		return tcem.SimulateTransactionExecutionCalled(tx)
+		return tcem.SimulateTransactionExecutionCalled(tx, stateOverrides)
+	}
+
+	return &txSimData.SimulationResultsWithVMOutput{}, nil
+}
+
+// TraceTransactionExecution -
+func (tcem *TransactionCostEstimatorMock) TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if tcem.TraceTransactionExecutionCalled != nil {
+		return tcem.TraceTransactionExecutionCalled(tx, stateOverrides)
 	}
 
 	return &txSimData.SimulationResultsWithVMOutput{}, nil
 }
 
+// ReplayTransaction -
+func (tcem *TransactionCostEstimatorMock) ReplayTransaction(replayData *txSimData.TransactionReplayData) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if tcem.ReplayTransactionCalled != nil {
+		return tcem.ReplayTransactionCalled(replayData)
+	}
+
+	return &txSimData.SimulationResultsWithVMOutput{}, nil
+}
+
+// SimulateTransactionsSequence -
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionsSequence(_ context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+	if tcem.SimulateTransactionsSequenceCalled != nil {
+		return tcem.SimulateTransactionsSequenceCalled(txs, stateOverrides)
+	}
+
+	return make([]*txSimData.SimulationResultsWithVMOutput, 0), nil
+}
+
 // IsInterfaceNil -
 func (tcem *TransactionCostEstimatorMock) IsInterfaceNil() bool {
 	return tcem == nil
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// ValidatorsPerformanceProcessorStub -
+type ValidatorsPerformanceProcessorStub struct {
+	GetValidatorsPerformanceCalled func(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error)
+}
+
+// GetValidatorsPerformance -
+func (stub *ValidatorsPerformanceProcessorStub) GetValidatorsPerformance(startEpoch uint32, endEpoch uint32) ([]*common.ValidatorEpochPerformance, error) {
+	if stub.GetValidatorsPerformanceCalled != nil {
+		return stub.GetValidatorsPerformanceCalled(startEpoch, endEpoch)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *ValidatorsPerformanceProcessorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -25,7 +25,9 @@ import (
 	disabledSig "github.com/multiversx/mx-chain-crypto-go/signing/disabled/singlesig"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade"
 	mainFactory "github.com/multiversx/mx-chain-go/factory"
@@ -50,6 +52,15 @@ const (
 	esdtTickerNumChars = 6
 )
 
+// statuses of the governance proposals, as returned by the governance API
+const (
+	governanceProposalPending  = "pending"
+	governanceProposalActive   = "active"
+	governanceProposalEnded    = "ended"
+	governanceProposalPassed   = "passed"
+	governanceProposalRejected = "rejected"
+)
+
 var log = logger.GetOrCreate("node")
 var _ facade.NodeHandler = (*Node)(nil)
 
@@ -90,6 +101,10 @@ type Node struct {
 
 	mutQueryHandlers      syncGo.RWMutex
 	queryHandlers         map[string]debug.QueryHandler
+	mutP2PMessageTracer   syncGo.RWMutex
+	p2pMessageTracer      debug.P2PMessageTracer
+	mutGuardianCoSigner   syncGo.RWMutex
+	guardianCoSigner      process.GuardianCoSigner
 	bootstrapComponents   mainFactory.BootstrapComponentsHolder
 	consensusComponents   mainFactory.ConsensusComponentsHolder
 	coreComponents        mainFactory.CoreComponentsHolder
@@ -570,6 +585,109 @@ func (n *Node) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	}, nil
 }
 
+// SubscribeToEvents subscribes to the blocks, transactions status changes and smart contract events matching the filter,
+// as received through the outport
+func (n *Node) SubscribeToEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	subscriptionsHandler := n.statusComponents.EventsSubscriptionsHandler()
+	if check.IfNil(subscriptionsHandler) {
+		return nil, ErrNilEventsSubscriptionsHandler
+	}
+
+	return subscriptionsHandler.Subscribe(filter)
+}
+
+// GetGovernanceProposals returns all the governance proposals found in the governance index
+func (n *Node) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	proposals, err := n.processComponents.HistoryRepository().GetGovernanceProposals()
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch := n.coreComponents.EpochNotifier().CurrentEpoch()
+	apiProposals := make([]*common.GovernanceProposal, 0, len(proposals))
+	for _, proposal := range proposals {
+		apiProposals = append(apiProposals, n.convertGovernanceProposal(proposal, currentEpoch))
+	}
+
+	return apiProposals, nil
+}
+
+// GetGovernanceProposal returns the governance proposal with the provided nonce from the governance index
+func (n *Node) GetGovernanceProposal(nonce uint64) (*common.GovernanceProposal, error) {
+	proposal, err := n.processComponents.HistoryRepository().GetGovernanceProposal(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.convertGovernanceProposal(proposal, n.coreComponents.EpochNotifier().CurrentEpoch()), nil
+}
+
+// GetGovernanceProposalVotes returns the votes cast on the governance proposal with the provided nonce
+func (n *Node) GetGovernanceProposalVotes(nonce uint64) (*common.GovernanceProposalVotes, error) {
+	votes, err := n.processComponents.HistoryRepository().GetGovernanceProposalVotes(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	proposalVotes := &common.GovernanceProposalVotes{
+		Votes:          make([]*common.GovernanceVote, 0, len(votes)),
+		DelegatedVotes: make([]*common.GovernanceVote, 0),
+	}
+	for _, vote := range votes {
+		apiVote := &common.GovernanceVote{
+			Voter:       pubKeyConverter.SilentEncode(vote.Voter, log),
+			Option:      vote.Option,
+			Stake:       bigToString(vote.Stake),
+			VotingPower: bigToString(vote.VotingPower),
+			TxHash:      hex.EncodeToString(vote.TxHash),
+		}
+		if len(vote.DelegatedBy) == 0 {
+			proposalVotes.Votes = append(proposalVotes.Votes, apiVote)
+			continue
+		}
+
+		apiVote.DelegatedBy = pubKeyConverter.SilentEncode(vote.DelegatedBy, log)
+		proposalVotes.DelegatedVotes = append(proposalVotes.DelegatedVotes, apiVote)
+	}
+
+	return proposalVotes, nil
+}
+
+func (n *Node) convertGovernanceProposal(proposal *governance.Proposal, currentEpoch uint32) *common.GovernanceProposal {
+	return &common.GovernanceProposal{
+		Nonce:            proposal.Nonce,
+		CommitHash:       proposal.CommitHash,
+		Issuer:           n.coreComponents.AddressPubKeyConverter().SilentEncode(proposal.Issuer, log),
+		TxHash:           hex.EncodeToString(proposal.TxHash),
+		StartVoteEpoch:   proposal.StartVoteEpoch,
+		EndVoteEpoch:     proposal.EndVoteEpoch,
+		Status:           governanceProposalStatus(proposal, uint64(currentEpoch)),
+		Yes:              bigToString(proposal.Yes),
+		No:               bigToString(proposal.No),
+		Veto:             bigToString(proposal.Veto),
+		Abstain:          bigToString(proposal.Abstain),
+		NumVotes:         proposal.NumVotes,
+		ParameterChanges: proposal.ParameterChanges,
+		ExecutionEpoch:   proposal.ExecutionEpoch,
+	}
+}
+
+func governanceProposalStatus(proposal *governance.Proposal, currentEpoch uint64) string {
+	switch {
+	case proposal.Closed && proposal.Passed:
+		return governanceProposalPassed
+	case proposal.Closed:
+		return governanceProposalRejected
+	case currentEpoch < proposal.StartVoteEpoch:
+		return governanceProposalPending
+	case currentEpoch <= proposal.EndVoteEpoch:
+		return governanceProposalActive
+	default:
+		return governanceProposalEnded
+	}
+}
+
 func bigToString(bigValue *big.Int) string {
 	if bigValue == nil {
 		return "0"
@@ -703,23 +821,41 @@ func (n *Node) castAccountToUserAccount(ah vmcommon.AccountHandler) (state.UserA
 }
 
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
-func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
-	return n.processComponents.TxsSenderHandler().SendBulkTransactions(txs)
+func (n *Node) SendBulkTransactions(ctx context.Context, txs []*transaction.Transaction) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, "node.SendBulkTransactions")
+	numSent, err := n.processComponents.TxsSenderHandler().SendBulkTransactions(ctx, txs)
+	tracing.EndSpan(span, err)
+
+	return numSent, err
 }
 
 // ValidateTransaction will validate a transaction
-func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
+func (n *Node) ValidateTransaction(ctx context.Context, tx *transaction.Transaction) error {
+	ctx, span := tracing.StartSpan(ctx, "node.ValidateTransaction")
+	err := n.validateTransaction(ctx, tx)
+	tracing.EndSpan(span, err)
+
+	return err
+}
+
+func (n *Node) validateTransaction(ctx context.Context, tx *transaction.Transaction) error {
 	err := n.checkSenderIsInShard(tx)
 	if err != nil {
 		return err
 	}
 
+	// the intercepted transaction checks include the signature verification
+	_, interceptedTxSpan := tracing.StartSpan(ctx, "node.checkInterceptedTransaction")
 	txValidator, intTx, err := n.commonTransactionValidation(tx, n.processComponents.WhiteListerVerifiedTxs(), n.processComponents.WhiteListHandler(), true)
+	tracing.EndSpan(interceptedTxSpan, err)
 	if err != nil {
 		return err
 	}
 
+	// the sender account nonce and balance are loaded from the API accounts adapter
+	_, stateSpan := tracing.StartSpan(ctx, "state.CheckTxValidity")
 	err = txValidator.CheckTxValidity(intTx)
+	tracing.EndSpan(stateSpan, err)
 	if errors.Is(err, process.ErrAccountNotFound) {
 		return fmt.Errorf("%w for address %s",
 			process.ErrInsufficientFunds,
@@ -798,6 +934,7 @@ func (n *Node) commonTransactionValidation(
 		enableSignWithTxHash,
 		n.coreComponents.TxSignHasher(),
 		n.coreComponents.TxVersionChecker(),
+		n.coreComponents.EnableEpochsHandler(),
 	)
 	if err != nil {
 		return nil, nil, err
@@ -846,6 +983,9 @@ func (n *Node) CreateTransaction(txArgs *external.ArgsCreateTransaction) (*trans
 	if len(txArgs.GuardianSigHex) > n.addressSignatureHexSize {
 		return nil, nil, fmt.Errorf("%w for guardian signature", ErrInvalidSignatureLength)
 	}
+	if len(txArgs.RelayerSigHex) > n.addressSignatureHexSize {
+		return nil, nil, fmt.Errorf("%w for relayer signature", ErrInvalidSignatureLength)
+	}
 
 	if uint32(len(txArgs.Receiver)) > n.coreComponents.EncodedAddressLen() {
 		return nil, nil, fmt.Errorf("%w for receiver", ErrInvalidAddressLength)
@@ -856,6 +996,9 @@ func (n *Node) CreateTransaction(txArgs *external.ArgsCreateTransaction) (*trans
 	if uint32(len(txArgs.Guardian)) > n.coreComponents.EncodedAddressLen() {
 		return nil, nil, fmt.Errorf("%w for guardian", ErrInvalidAddressLength)
 	}
+	if uint32(len(txArgs.Relayer)) > n.coreComponents.EncodedAddressLen() {
+		return nil, nil, fmt.Errorf("%w for relayer", ErrInvalidAddressLength)
+	}
 	if len(txArgs.SenderUsername) > core.MaxUserNameLength {
 		return nil, nil, ErrInvalidSenderUsernameLength
 	}
@@ -913,6 +1056,13 @@ func (n *Node) CreateTransaction(txArgs *external.ArgsCreateTransaction) (*trans
 		}
 	}
 
+	if len(txArgs.Relayer) > 0 {
+		err = n.setTxRelayerData(txArgs.Relayer, txArgs.RelayerSigHex, tx)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	var txHash []byte
 	txHash, err = core.CalculateHash(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher(), tx)
 	if err != nil {
@@ -942,6 +1092,23 @@ func (n *Node) setTxGuardianData(guardian string, guardianSigHex string, tx *tra
 	return nil
 }
 
+func (n *Node) setTxRelayerData(relayer string, relayerSigHex string, tx *transaction.Transaction) error {
+	addrPubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	relayerAddress, err := addrPubKeyConverter.Decode(relayer)
+	if err != nil {
+		return errors.New("could not create relayer address from provided param")
+	}
+	relayerSigBytes, err := hex.DecodeString(relayerSigHex)
+	if err != nil {
+		return errors.New("could not fetch relayer signature bytes")
+	}
+
+	tx.RelayerAddr = relayerAddress
+	tx.RelayerSignature = relayerSigBytes
+
+	return nil
+}
+
 // GetAccount will return account details for a given address
 func (n *Node) GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	accInfo, err := n.getAccountInfo(address, options)
@@ -1064,6 +1231,39 @@ func (n *Node) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
 	return monitor.GetHeartbeats()
 }
 
+// GetHeartbeatHistory returns the heartbeat history of the provided public key
+func (n *Node) GetHeartbeatHistory(pubKey string) (*heartbeatData.PubKeyHeartbeatHistory, error) {
+	history, err := n.getHeartbeatHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	return history.GetHeartbeatHistory(pubKey)
+}
+
+// GetUptime returns the uptime of the monitored public keys in the provided epoch
+func (n *Node) GetUptime(epoch uint32) ([]heartbeatData.PubKeyUptime, error) {
+	history, err := n.getHeartbeatHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	return history.GetUptime(epoch)
+}
+
+func (n *Node) getHeartbeatHistory() (mainFactory.HeartbeatV2History, error) {
+	if check.IfNil(n.heartbeatV2Components) {
+		return nil, ErrNilHeartbeatV2Components
+	}
+
+	history := n.heartbeatV2Components.History()
+	if check.IfNil(history) {
+		return nil, ErrNilHeartbeatHistory
+	}
+
+	return history, nil
+}
+
 // ValidatorStatisticsApi will return the statistics for all the validators from the initial nodes pub keys
 func (n *Node) ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error) {
 	return n.processComponents.ValidatorsProvider().GetLatestValidators(), nil
@@ -1137,6 +1337,156 @@ func (n *Node) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return qh, nil
 }
 
+// SetP2PMessageTracer sets the p2p message tracer used to serve the sampled p2p messages traces
+func (n *Node) SetP2PMessageTracer(tracer debug.P2PMessageTracer) error {
+	if check.IfNil(tracer) {
+		return ErrNilP2PMessageTracer
+	}
+
+	n.mutP2PMessageTracer.Lock()
+	n.p2pMessageTracer = tracer
+	n.mutP2PMessageTracer.Unlock()
+
+	return nil
+}
+
+// GetP2PMessageTraces returns the latest sampled p2p messages traces
+func (n *Node) GetP2PMessageTraces() ([]*common.P2PMessageTrace, error) {
+	tracer, err := n.getP2PMessageTracer()
+	if err != nil {
+		return nil, err
+	}
+
+	return tracer.GetTraces(), nil
+}
+
+// SubscribeP2PMessageTraces returns a channel on which the new sampled p2p messages traces will be pushed,
+// together with the function that should be called in order to unsubscribe
+func (n *Node) SubscribeP2PMessageTraces() (<-chan *common.P2PMessageTrace, func(), error) {
+	tracer, err := n.getP2PMessageTracer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id, chTraces := tracer.Subscribe()
+	unsubscribe := func() {
+		tracer.Unsubscribe(id)
+	}
+
+	return chTraces, unsubscribe, nil
+}
+
+func (n *Node) getP2PMessageTracer() (debug.P2PMessageTracer, error) {
+	n.mutP2PMessageTracer.RLock()
+	defer n.mutP2PMessageTracer.RUnlock()
+
+	if check.IfNil(n.p2pMessageTracer) {
+		return nil, ErrP2PMessageTracingDisabled
+	}
+
+	return n.p2pMessageTracer, nil
+}
+
+// SetGuardianCoSigner sets the guardian co-signing service used to serve the guardian API requests
+func (n *Node) SetGuardianCoSigner(coSigner process.GuardianCoSigner) error {
+	if check.IfNil(coSigner) {
+		return ErrNilGuardianCoSigner
+	}
+
+	n.mutGuardianCoSigner.Lock()
+	n.guardianCoSigner = coSigner
+	n.mutGuardianCoSigner.Unlock()
+
+	return nil
+}
+
+// GetGuardianRegistrationChallenge issues a registration challenge for the provided address on the guardian
+// co-signing service
+func (n *Node) GetGuardianRegistrationChallenge(address string) (*common.GuardianRegistrationChallenge, error) {
+	coSigner, err := n.getGuardianCoSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	addressBytes, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return coSigner.GetRegistrationChallenge(addressBytes)
+}
+
+// RegisterGuardedAccount registers the provided address on the guardian co-signing service
+func (n *Node) RegisterGuardedAccount(address string, signatureHex string) (*common.GuardianRegistration, error) {
+	coSigner, err := n.getGuardianCoSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	addressBytes, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the signature", err)
+	}
+
+	return coSigner.RegisterAccount(addressBytes, signature)
+}
+
+// VerifyGuardianCode confirms the registration of the provided address on the guardian co-signing service
+func (n *Node) VerifyGuardianCode(address string, code string) error {
+	coSigner, err := n.getGuardianCoSigner()
+	if err != nil {
+		return err
+	}
+
+	addressBytes, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return err
+	}
+
+	return coSigner.VerifyCode(addressBytes, code)
+}
+
+// SetGuardianSpendingPolicy sets the spending policy of the provided address on the guardian co-signing service
+func (n *Node) SetGuardianSpendingPolicy(address string, code string, policy *common.GuardianSpendingPolicy) error {
+	coSigner, err := n.getGuardianCoSigner()
+	if err != nil {
+		return err
+	}
+
+	addressBytes, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return err
+	}
+
+	return coSigner.SetSpendingPolicy(addressBytes, code, policy)
+}
+
+// CoSignTransaction returns the signature of the guardian co-signing service for the provided transaction
+func (n *Node) CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error) {
+	coSigner, err := n.getGuardianCoSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	return coSigner.CoSignTransaction(tx, code)
+}
+
+func (n *Node) getGuardianCoSigner() (process.GuardianCoSigner, error) {
+	n.mutGuardianCoSigner.RLock()
+	defer n.mutGuardianCoSigner.RUnlock()
+
+	if check.IfNil(n.guardianCoSigner) {
+		return nil, ErrGuardianCoSignerDisabled
+	}
+
+	return n.guardianCoSigner, nil
+}
+
 // GetPeerInfo returns information about a peer id
 func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	peers := n.networkComponents.NetworkMessenger().Peers()
@@ -1172,6 +1522,11 @@ func (n *Node) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return n.networkComponents.PeersRatingMonitor().GetConnectedPeersRatings(n.networkComponents.NetworkMessenger())
 }
 
+// GetPeersReputation returns the reputation entries tracked by the node
+func (n *Node) GetPeersReputation() ([]*common.PeerReputationAPIResponse, error) {
+	return n.networkComponents.PeerReputationHandler().GetPeersReputation(), nil
+}
+
 // GetEpochStartDataAPI returns epoch start data of a given epoch
 func (n *Node) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if epoch == 0 {
@@ -1336,6 +1691,18 @@ func (n *Node) Close() error {
 		log.LogIfError(qh.Close())
 	}
 
+	n.mutP2PMessageTracer.RLock()
+	if !check.IfNil(n.p2pMessageTracer) {
+		log.LogIfError(n.p2pMessageTracer.Close())
+	}
+	n.mutP2PMessageTracer.RUnlock()
+
+	n.mutGuardianCoSigner.RLock()
+	if !check.IfNil(n.guardianCoSigner) {
+		log.LogIfError(n.guardianCoSigner.Close())
+	}
+	n.mutGuardianCoSigner.RUnlock()
+
 	var closeError error = nil
 
 	allComponents := make([]string, 0, len(n.closableComponents))
@@ -5,5 +5,6 @@ import "github.com/multiversx/mx-chain-go/debug"
 // NodeWrapper is the interface that defines the behavior of a Node that can work with debug handlers
 type NodeWrapper interface {
 	AddQueryHandler(name string, handler debug.QueryHandler) error
+	SetP2PMessageTracer(tracer debug.P2PMessageTracer) error
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,56 @@
+package nodeDebugFactory
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	p2pDebug "github.com/multiversx/mx-chain-go/debug/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// CreateP2PMessageTracer creates a p2p message tracer, if enabled, applies it on all the interceptors and sets it on
+// the node so the traces can be fetched through the API
+func CreateP2PMessageTracer(
+	node NodeWrapper,
+	interceptors process.InterceptorsContainer,
+	config config.P2PMessageTracingDebugConfig,
+) error {
+	if check.IfNil(node) {
+		return ErrNilNodeWrapper
+	}
+	if check.IfNil(interceptors) {
+		return ErrNilInterceptorContainer
+	}
+	if !config.Enabled {
+		return nil
+	}
+
+	tracer, err := p2pDebug.NewMessageTracer(config)
+	if err != nil {
+		return err
+	}
+
+	var errFound error
+	interceptors.Iterate(func(key string, interceptor process.Interceptor) bool {
+		err = interceptor.SetMessageTracer(tracer)
+		if err != nil {
+			errFound = err
+			return false
+		}
+
+		return true
+	})
+	if errFound != nil {
+		_ = tracer.Close()
+		return fmt.Errorf("%w while setting up the message tracer on interceptors", errFound)
+	}
+
+	err = node.SetP2PMessageTracer(tracer)
+	if err != nil {
+		_ = tracer.Close()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package nodeDebugFactory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockP2PMessageTracingConfig() config.P2PMessageTracingDebugConfig {
+	return config.P2PMessageTracingDebugConfig{
+		Enabled:          true,
+		SamplingRate:     1,
+		CacheSize:        10,
+		StreamBufferSize: 10,
+	}
+}
+
+func TestCreateP2PMessageTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil node wrapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := CreateP2PMessageTracer(nil, &testscommon.InterceptorsContainerStub{}, createMockP2PMessageTracingConfig())
+		assert.Equal(t, ErrNilNodeWrapper, err)
+	})
+	t.Run("nil interceptors should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := CreateP2PMessageTracer(&mock.NodeWrapperStub{}, nil, createMockP2PMessageTracingConfig())
+		assert.Equal(t, ErrNilInterceptorContainer, err)
+	})
+	t.Run("disabled tracing should not set the tracer", func(t *testing.T) {
+		t.Parallel()
+
+		err := CreateP2PMessageTracer(
+			&mock.NodeWrapperStub{
+				SetP2PMessageTracerCalled: func(tracer debug.P2PMessageTracer) error {
+					assert.Fail(t, "should have not been called")
+					return nil
+				},
+			},
+			&testscommon.InterceptorsContainerStub{
+				IterateCalled: func(handler func(key string, interceptor process.Interceptor) bool) {
+					assert.Fail(t, "should have not been called")
+				},
+			},
+			config.P2PMessageTracingDebugConfig{},
+		)
+		assert.Nil(t, err)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockP2PMessageTracingConfig()
+		cfg.SamplingRate = 0
+		err := CreateP2PMessageTracer(&mock.NodeWrapperStub{}, &testscommon.InterceptorsContainerStub{}, cfg)
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+	})
+	t.Run("interceptor errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		err := CreateP2PMessageTracer(
+			&mock.NodeWrapperStub{
+				SetP2PMessageTracerCalled: func(tracer debug.P2PMessageTracer) error {
+					assert.Fail(t, "should have not been called")
+					return nil
+				},
+			},
+			&testscommon.InterceptorsContainerStub{
+				IterateCalled: func(handler func(key string, interceptor process.Interceptor) bool) {
+					handler("key", &testscommon.InterceptorStub{
+						SetMessageTracerCalled: func(tracer process.P2PMessageTracer) error {
+							return expectedErr
+						},
+					})
+				},
+			},
+			createMockP2PMessageTracingConfig(),
+		)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("node wrapper errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		err := CreateP2PMessageTracer(
+			&mock.NodeWrapperStub{
+				SetP2PMessageTracerCalled: func(tracer debug.P2PMessageTracer) error {
+					return expectedErr
+				},
+			},
+			&testscommon.InterceptorsContainerStub{},
+			createMockP2PMessageTracingConfig(),
+		)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var tracerSetOnInterceptor process.P2PMessageTracer
+		var tracerSetOnNode debug.P2PMessageTracer
+		err := CreateP2PMessageTracer(
+			&mock.NodeWrapperStub{
+				SetP2PMessageTracerCalled: func(tracer debug.P2PMessageTracer) error {
+					tracerSetOnNode = tracer
+					return nil
+				},
+			},
+			&testscommon.InterceptorsContainerStub{
+				IterateCalled: func(handler func(key string, interceptor process.Interceptor) bool) {
+					handler("key", &testscommon.InterceptorStub{
+						SetMessageTracerCalled: func(tracer process.P2PMessageTracer) error {
+							tracerSetOnInterceptor = tracer
+							return nil
+						},
+					})
+				},
+			},
+			createMockP2PMessageTracingConfig(),
+		)
+		assert.Nil(t, err)
+		assert.NotNil(t, tracerSetOnNode)
+		assert.True(t, tracerSetOnNode == tracerSetOnInterceptor)
+	})
+}
@@ -1,18 +1,28 @@
 package node
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/statusHandler/prometheusMetrics"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/node/nodeDebugFactory"
 	"github.com/multiversx/mx-chain-go/p2p"
 	procFactory "github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/process/guardian/coSigner"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
 	"github.com/multiversx/mx-chain-go/sharding"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
 // prepareOpenTopics will set to the anti flood handler the topics for which
@@ -120,9 +130,129 @@ func CreateNode(
 		return nil, err
 	}
 
+	err = nodeDebugFactory.CreateP2PMessageTracer(
+		nd,
+		processComponents.InterceptorsContainer(),
+		config.Debug.P2PMessageTracing,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CreateP2PMessagesMetrics(processComponents.InterceptorsContainer(), statusCoreComponents.MetricsRegistry())
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the p2p messages metrics", err)
+	}
+
+	err = CreateGuardianCoSigner(nd, config.GuardianCoSigner)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the guardian co-signer", err)
+	}
+
 	return nd, nil
 }
 
+// CreateP2PMessagesMetrics creates the per topic p2p messages metrics and applies them on all the interceptors
+func CreateP2PMessagesMetrics(interceptors process.InterceptorsContainer, registry common.MetricsRegistry) error {
+	messagesMetrics, err := prometheusMetrics.NewP2PMessagesMetrics(registry)
+	if err != nil {
+		return err
+	}
+
+	var errFound error
+	interceptors.Iterate(func(key string, interceptor process.Interceptor) bool {
+		err = interceptor.SetMessageMetrics(messagesMetrics)
+		if err != nil {
+			errFound = err
+			return false
+		}
+
+		return true
+	})
+
+	return errFound
+}
+
+// CreateGuardianCoSigner creates the guardian co-signing service, if enabled, and sets it on the node
+func CreateGuardianCoSigner(nd *Node, cfg config.GuardianCoSignerConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	guardianKey, err := loadGuardianKey(cfg.GuardianKeyPemFile, nd.cryptoComponents.TxSignKeyGen())
+	if err != nil {
+		return err
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(cfg.Storage.DB)
+	shardID := core.GetShardIDString(nd.processComponents.ShardCoordinator().SelfId())
+	dbConfig.FilePath = nd.coreComponents.PathHandler().PathForStatic(shardID, cfg.Storage.DB.FilePath)
+	persisterFactory, err := storageFactory.NewPersisterFactory(cfg.Storage.DB)
+	if err != nil {
+		return err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(cfg.Storage.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return err
+	}
+
+	dataFieldParser, err := datafield.NewOperationDataFieldParser(&datafield.ArgsOperationDataFieldParser{
+		AddressLength: nd.coreComponents.AddressPubKeyConverter().Len(),
+		Marshalizer:   nd.coreComponents.InternalMarshalizer(),
+	})
+	if err != nil {
+		_ = storer.Close()
+		return err
+	}
+
+	argsCoSigner := coSigner.ArgsCoSigner{
+		Config:           cfg,
+		GuardianKey:      guardianKey,
+		KeyGenerator:     nd.cryptoComponents.TxSignKeyGen(),
+		SingleSigner:     nd.cryptoComponents.TxSingleSigner(),
+		PubKeyConverter:  nd.coreComponents.AddressPubKeyConverter(),
+		TxMarshaller:     nd.coreComponents.TxMarshalizer(),
+		TxSignHasher:     nd.coreComponents.TxSignHasher(),
+		TxVersionChecker: nd.coreComponents.TxVersionChecker(),
+		DataFieldParser:  dataFieldParser,
+		ShardCoordinator: nd.processComponents.ShardCoordinator(),
+		Storer:           storer,
+		Marshaller:       &marshal.JsonMarshalizer{},
+	}
+	guardianCoSigner, err := coSigner.NewCoSigner(argsCoSigner)
+	if err != nil {
+		_ = storer.Close()
+		return err
+	}
+
+	err = nd.SetGuardianCoSigner(guardianCoSigner)
+	if err != nil {
+		_ = guardianCoSigner.Close()
+		return err
+	}
+
+	return nil
+}
+
+func loadGuardianKey(pemFile string, keyGenerator crypto.KeyGenerator) (crypto.PrivateKey, error) {
+	encodedSk, _, err := core.LoadSkPkFromPemFile(pemFile, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, fmt.Errorf("%w for the encoded guardian key", err)
+	}
+
+	return keyGenerator.PrivateKeyFromByteArray(skBytes)
+}
+
 func createAndAttachPeerDenialEvaluators(
 	networkComponents factory.NetworkComponentsHandler,
 	processComponents factory.ProcessComponentsHandler,
@@ -131,6 +261,8 @@ func createAndAttachPeerDenialEvaluators(
 		networkComponents.PeerBlackListHandler(),
 		networkComponents.PubKeyCacher(),
 		processComponents.PeerShardMapper(),
+		networkComponents.PeerReputationHandler(),
+		networkComponents.NetworkMessenger(),
 	)
 	if err != nil {
 		return nil, err
@@ -145,6 +277,8 @@ func createAndAttachPeerDenialEvaluators(
 		networkComponents.PeerBlackListHandler(),
 		networkComponents.PubKeyCacher(),
 		processComponents.FullArchivePeerShardMapper(),
+		networkComponents.PeerReputationHandler(),
+		networkComponents.FullArchiveNetworkMessenger(),
 	)
 	if err != nil {
 		return nil, err
@@ -28,6 +28,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/goroutines"
 	"github.com/multiversx/mx-chain-go/common/ordering"
 	"github.com/multiversx/mx-chain-go/common/statistics"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
@@ -122,6 +123,22 @@ func (nr *nodeRunner) Start() error {
 
 	printEnableEpochs(nr.configs)
 
+	tracingConfig := configs.ExternalConfig.Tracing
+	if tracingConfig.Enabled {
+		tracerProvider, errTracing := tracing.NewTracerProvider(tracing.ArgsTracerProvider{
+			Config:  tracingConfig,
+			Version: flagsConfig.Version,
+		})
+		if errTracing != nil {
+			return errTracing
+		}
+		defer func() {
+			log.LogIfError(tracerProvider.Close())
+		}()
+
+		log.Info("API requests tracing enabled", "collector", tracingConfig.CollectorEndpoint, "sampling ratio", tracingConfig.SamplingRatio)
+	}
+
 	core.DumpGoRoutinesToLog(0, log)
 
 	err = nr.startShufflingProcessLoop(chanStopNodeProcess)
@@ -158,11 +175,15 @@ func printEnableEpochs(configs *config.Configs) {
 	log.Debug(readEpochFor("double key protection"), "epoch", enableEpochs.DoubleKeyProtectionEnableEpoch)
 	log.Debug(readEpochFor("esdt"), "epoch", enableEpochs.ESDTEnableEpoch)
 	log.Debug(readEpochFor("governance"), "epoch", enableEpochs.GovernanceEnableEpoch)
+	log.Debug(readEpochFor("governance parameter changes"), "epoch", enableEpochs.GovernanceParameterChangesEnableEpoch)
+	log.Debug(readEpochFor("delegation liquid staking"), "epoch", enableEpochs.DelegationLiquidStakingEnableEpoch)
+	log.Debug(readEpochFor("esdt supply policy"), "epoch", enableEpochs.ESDTSupplyPolicyEnableEpoch)
 	log.Debug(readEpochFor("delegation manager"), "epoch", enableEpochs.DelegationManagerEnableEpoch)
 	log.Debug(readEpochFor("delegation smart contract"), "epoch", enableEpochs.DelegationSmartContractEnableEpoch)
 	log.Debug(readEpochFor("correct last unjailed"), "epoch", enableEpochs.CorrectLastUnjailedEnableEpoch)
 	log.Debug(readEpochFor("balance waiting lists"), "epoch", enableEpochs.BalanceWaitingListsEnableEpoch)
 	log.Debug(readEpochFor("relayed transactions v2"), "epoch", enableEpochs.RelayedTransactionsV2EnableEpoch)
+	log.Debug(readEpochFor("relayed transactions v3"), "epoch", enableEpochs.RelayedTransactionsV3EnableEpoch)
 	log.Debug(readEpochFor("unbond tokens v2"), "epoch", enableEpochs.UnbondTokensV2EnableEpoch)
 	log.Debug(readEpochFor("save jailed always"), "epoch", enableEpochs.SaveJailedAlwaysEnableEpoch)
 	log.Debug(readEpochFor("validator to delegation"), "epoch", enableEpochs.ValidatorToDelegationEnableEpoch)
@@ -779,6 +800,7 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 		PprofEnabled:                nr.configs.FlagsConfig.EnablePprof,
 		P2PPrometheusMetricsEnabled: nr.configs.FlagsConfig.P2PPrometheusMetricsEnabled,
 		StatusMetricsHandler:        managedStatusCoreComponents.StatusMetrics(),
+		MetricsRegistry:             managedStatusCoreComponents.MetricsRegistry(),
 	}
 	initialFacade, err := initial.NewInitialNodeFacade(argsInitialNodeFacade)
 	if err != nil {
@@ -789,6 +811,9 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 		Facade:          initialFacade,
 		ApiConfig:       *nr.configs.ApiRoutesConfig,
 		AntiFloodConfig: nr.configs.GeneralConfig.WebServerAntiflood,
+		EventsConfig:    nr.configs.ExternalConfig.EventsSubscriptions,
+		TracingEnabled:  nr.configs.ExternalConfig.Tracing.Enabled,
+		MetricsRegistry: managedStatusCoreComponents.MetricsRegistry(),
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -1427,6 +1452,7 @@ func (nr *nodeRunner) CreateManagedNetworkComponents(
 		NodeOperationMode:     common.NormalOperation,
 		ConnectionWatcherType: nr.configs.PreferencesConfig.Preferences.ConnectionWatcherType,
 		CryptoComponents:      cryptoComponents,
+		PathManager:           coreComponents.PathHandler(),
 	}
 	if nr.configs.ImportDbConfig.IsImportDBMode {
 		networkComponentsFactoryArgs.BootstrapWaitTime = 0
@@ -34,14 +34,19 @@ import (
 	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 	"github.com/multiversx/mx-chain-go/factory"
 	factoryMock "github.com/multiversx/mx-chain-go/factory/mock"
+	"github.com/multiversx/mx-chain-go/heartbeat"
 	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
+	disabledHeartbeatMonitor "github.com/multiversx/mx-chain-go/heartbeat/monitor/disabled"
 	integrationTestsMock "github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/node"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	nodeMockFactory "github.com/multiversx/mx-chain-go/node/mock/factory"
+	"github.com/multiversx/mx-chain-go/outport"
+	disabledOutport "github.com/multiversx/mx-chain-go/outport/disabled"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/accounts"
@@ -2164,7 +2169,7 @@ func TestCreateTransaction_SenderShardIdIsInDifferentShardShouldNotValidate(t *t
 	assert.Equal(t, value, tx.Value)
 	assert.True(t, bytes.Equal([]byte(receiver), tx.RcvAddr))
 
-	err = n.ValidateTransaction(tx)
+	err = n.ValidateTransaction(context.Background(), tx)
 	assert.True(t, errors.Is(err, node.ErrDifferentSenderShardId))
 }
 
@@ -2642,6 +2647,82 @@ func TestCreateTransaction_InvalidGuardianAddressLenShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, node.ErrInvalidAddressLength))
 }
 
+func TestCreateTransaction_InvalidRelayerSigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.TxMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	coreComponents.AddrPubKeyConv = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(hexAddress string) ([]byte, error) {
+			return []byte(hexAddress), nil
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithAddressSignatureSize(16),
+	)
+
+	txArgs := getDefaultTransactionArgs()
+	txArgs.SignatureHex = hex.EncodeToString(bytes.Repeat([]byte{0}, 1))
+	txArgs.RelayerSigHex = hex.EncodeToString(bytes.Repeat([]byte{0}, 32))
+
+	tx, txHash, err := n.CreateTransaction(txArgs)
+
+	assert.Nil(t, tx)
+	assert.Nil(t, txHash)
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, node.ErrInvalidSignatureLength))
+	assert.True(t, strings.Contains(err.Error(), "relayer signature"))
+}
+
+func TestCreateTransaction_InvalidRelayerAddressLenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.TxMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+
+	encodedAddressLen := 8
+	coreComponents.AddrPubKeyConv = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(hexAddress string) ([]byte, error) {
+			return []byte(hexAddress), nil
+		},
+		LenCalled: func() int {
+			return encodedAddressLen
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithAddressSignatureSize(16),
+	)
+
+	txArgs := getDefaultTransactionArgs()
+	txArgs.SignatureHex = hex.EncodeToString(bytes.Repeat([]byte{0}, 8))
+	txArgs.RelayerSigHex = hex.EncodeToString(bytes.Repeat([]byte{0}, 8))
+	txArgs.Relayer = strings.Repeat("r", encodedAddressLen) + "additional"
+
+	tx, txHash, err := n.CreateTransaction(txArgs)
+
+	assert.Nil(t, tx)
+	assert.Nil(t, txHash)
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, node.ErrInvalidAddressLength))
+	assert.True(t, strings.Contains(err.Error(), "relayer"))
+}
+
 func TestCreateTransaction_AddressPubKeyConverterDecode(t *testing.T) {
 	t.Parallel()
 
@@ -2724,6 +2805,39 @@ func TestCreateTransaction_AddressPubKeyConverterDecode(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.True(t, strings.Contains(err.Error(), "sender address"))
 	})
+
+	t.Run("fail to decode relayer", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.IntMarsh = getMarshalizer()
+		coreComponents.VmMarsh = getMarshalizer()
+		coreComponents.TxMarsh = getMarshalizer()
+		coreComponents.Hash = getHasher()
+
+		coreComponents.AddrPubKeyConv = addrPubKeyConverter
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithAddressSignatureSize(16),
+		)
+
+		txArgs := getDefaultTransactionArgs()
+		txArgs.Receiver = strings.Repeat("r", minAddrLen+1)
+		txArgs.Sender = strings.Repeat("s", minAddrLen+1)
+		txArgs.Relayer = "rl"
+		txArgs.RelayerSigHex = guardianSig
+
+		tx, txHash, err := n.CreateTransaction(txArgs)
+
+		assert.Nil(t, tx)
+		assert.Nil(t, txHash)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "relayer address"))
+	})
 }
 
 func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
@@ -2800,8 +2914,17 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	assert.Equal(t, value, tx.Value)
 	assert.True(t, bytes.Equal([]byte(receiver), tx.RcvAddr))
 
-	err = n.ValidateTransaction(tx)
+	err = n.ValidateTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+
+	relayerSig := bytes.Repeat([]byte{1}, 10)
+	txArgs.Relayer = "rly"
+	txArgs.RelayerSigHex = hex.EncodeToString(relayerSig)
+
+	tx, _, err = n.CreateTransaction(txArgs)
 	assert.Nil(t, err)
+	assert.Equal(t, []byte("rly"), tx.RelayerAddr)
+	assert.Equal(t, relayerSig, tx.RelayerSignature)
 }
 
 func TestCreateTransaction_TxSignedWithHashShouldErrVersionShoudBe2(t *testing.T) {
@@ -2891,7 +3014,7 @@ func TestCreateTransaction_TxSignedWithHashShouldErrVersionShoudBe2(t *testing.T
 
 	tx, _, err := n.CreateTransaction(txArgs)
 	require.Nil(t, err)
-	err = n.ValidateTransaction(tx)
+	err = n.ValidateTransaction(context.Background(), tx)
 	assert.Equal(t, process.ErrInvalidTransactionVersion, err)
 }
 
@@ -2989,7 +3112,7 @@ func TestCreateTransaction_TxSignedWithHashNoEnabledShouldErr(t *testing.T) {
 
 	tx, _, _ := n.CreateTransaction(txArgs)
 
-	err := n.ValidateTransaction(tx)
+	err := n.ValidateTransaction(context.Background(), tx)
 	assert.Equal(t, process.ErrTransactionSignedWithHashIsNotEnabled, err)
 }
 
@@ -3011,7 +3134,7 @@ func TestValidateTransaction_ShouldAdaptAccountNotFoundError(t *testing.T) {
 		Signature: []byte("signature"),
 		ChainID:   []byte("chainID"),
 	}
-	err := n.ValidateTransaction(tx)
+	err := n.ValidateTransaction(context.Background(), tx)
 	require.Equal(t, "insufficient funds for address erd1xycnzvf3xycnzvf3xycnzvf3xycnzvf3xycnzvf3xycnzvf3xycspcqad6", err.Error())
 }
 
@@ -4536,6 +4659,145 @@ func TestGetESDTSupply(t *testing.T) {
 	}, supply)
 }
 
+func TestNode_SubscribeToEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil events subscriptions handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStatusComponents(&mainFactoryMocks.StatusComponentsStub{}),
+		)
+
+		subscription, err := n.SubscribeToEvents(common.EventsSubscriptionFilter{})
+		require.Equal(t, node.ErrNilEventsSubscriptionsHandler, err)
+		require.Nil(t, subscription)
+	})
+	t.Run("disabled events subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStatusComponents(&mainFactoryMocks.StatusComponentsStub{
+				EventsSubscriptions: disabledOutport.NewDisabledEventsSubscriptionsHandler(),
+			}),
+		)
+
+		subscription, err := n.SubscribeToEvents(common.EventsSubscriptionFilter{})
+		require.Equal(t, outport.ErrEventsSubscriptionsDisabled, err)
+		require.Nil(t, subscription)
+	})
+}
+
+func TestNode_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		localErr := errors.New("local error")
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			GetGovernanceProposalsCalled: func() ([]*governance.Proposal, error) {
+				return nil, localErr
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		_, err := n.GetGovernanceProposals()
+		require.Equal(t, localErr, err)
+	})
+	t.Run("should compute the status based on the current epoch", func(t *testing.T) {
+		t.Parallel()
+
+		newProposal := func(nonce uint64, closed bool, passed bool) *governance.Proposal {
+			return &governance.Proposal{
+				Nonce:          nonce,
+				Issuer:         bytes.Repeat([]byte{1}, 32),
+				TxHash:         []byte{0xaa},
+				StartVoteEpoch: 10,
+				EndVoteEpoch:   12,
+				Yes:            big.NewInt(int64(nonce)),
+				Closed:         closed,
+				Passed:         passed,
+			}
+		}
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			GetGovernanceProposalsCalled: func() ([]*governance.Proposal, error) {
+				return []*governance.Proposal{
+					newProposal(1, true, true),
+					newProposal(2, true, false),
+					newProposal(3, false, false),
+				}, nil
+			},
+		}
+
+		statuses := map[uint32]string{9: "pending", 10: "active", 12: "active", 13: "ended"}
+		for epoch, expectedStatus := range statuses {
+			currentEpoch := epoch
+			coreComponents := getDefaultCoreComponents()
+			coreComponents.EpochChangeNotifier = &epochNotifier.EpochNotifierStub{
+				CurrentEpochCalled: func() uint32 {
+					return currentEpoch
+				},
+			}
+			n, _ := node.NewNode(
+				node.WithCoreComponents(coreComponents),
+				node.WithProcessComponents(processComponentsMock),
+			)
+
+			proposals, err := n.GetGovernanceProposals()
+			require.Nil(t, err)
+			require.Len(t, proposals, 3)
+			require.Equal(t, "passed", proposals[0].Status)
+			require.Equal(t, "rejected", proposals[1].Status)
+			require.Equal(t, expectedStatus, proposals[2].Status)
+			require.Equal(t, "3", proposals[2].Yes)
+			require.Equal(t, "0", proposals[2].No)
+			require.Equal(t, "aa", proposals[2].TxHash)
+			require.Equal(t, testscommon.RealWorldBech32PubkeyConverter.SilentEncode(bytes.Repeat([]byte{1}, 32), nil), proposals[2].Issuer)
+		}
+	})
+}
+
+func TestNode_GetGovernanceProposalVotes(t *testing.T) {
+	t.Parallel()
+
+	voter := bytes.Repeat([]byte{2}, 32)
+	delegationSC := bytes.Repeat([]byte{3}, 32)
+	processComponentsMock := getDefaultProcessComponents()
+	processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		GetGovernanceProposalVotesCalled: func(nonce uint64) ([]*governance.Vote, error) {
+			require.Equal(t, uint64(7), nonce)
+			return []*governance.Vote{
+				{TxHash: []byte{0xaa}, Voter: voter, Option: "yes", Stake: big.NewInt(1000), VotingPower: big.NewInt(100)},
+				{TxHash: []byte{0xbb}, Voter: voter, Option: "no", Stake: big.NewInt(400), VotingPower: big.NewInt(40), DelegatedBy: delegationSC},
+			}, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponentsMock),
+	)
+
+	votes, err := n.GetGovernanceProposalVotes(7)
+	require.Nil(t, err)
+
+	converter := testscommon.RealWorldBech32PubkeyConverter
+	require.Equal(t, []*common.GovernanceVote{
+		{Voter: converter.SilentEncode(voter, nil), Option: "yes", Stake: "1000", VotingPower: "100", TxHash: "aa"},
+	}, votes.Votes)
+	require.Equal(t, []*common.GovernanceVote{
+		{Voter: converter.SilentEncode(voter, nil), Option: "no", Stake: "400", VotingPower: "40", TxHash: "bb",
+			DelegatedBy: converter.SilentEncode(delegationSC, nil)},
+	}, votes.DelegatedVotes)
+}
+
 func TestNode_SendBulkTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -4557,7 +4819,7 @@ func TestNode_SendBulkTransactions(t *testing.T) {
 	n, err := node.NewNode(node.WithProcessComponents(processComponentsMock))
 	require.Nil(t, err)
 
-	actualNoOfTxs, err := n.SendBulkTransactions(expectedTxs)
+	actualNoOfTxs, err := n.SendBulkTransactions(context.Background(), expectedTxs)
 	require.True(t, flag.IsSet())
 	require.Equal(t, expectedNoOfTxs, actualNoOfTxs)
 	require.Nil(t, err)
@@ -4581,6 +4843,241 @@ func TestNode_GetHeartbeats(t *testing.T) {
 	assert.True(t, sameMessages(providedMessages, receivedMessages))
 }
 
+func TestNode_GetHeartbeatHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil history should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, err := node.NewNode(node.WithHeartbeatV2Components(&factoryMock.HeartbeatV2ComponentsStub{}))
+		require.Nil(t, err)
+
+		history, err := n.GetHeartbeatHistory("pk")
+		assert.Nil(t, history)
+		assert.Equal(t, node.ErrNilHeartbeatHistory, err)
+
+		uptime, err := n.GetUptime(0)
+		assert.Nil(t, uptime)
+		assert.Equal(t, node.ErrNilHeartbeatHistory, err)
+	})
+	t.Run("should forward the calls to the history component", func(t *testing.T) {
+		t.Parallel()
+
+		heartbeatV2Components := &factoryMock.HeartbeatV2ComponentsStub{
+			HistoryField: disabledHeartbeatMonitor.NewDisabledHeartbeatHistory(),
+		}
+		n, err := node.NewNode(node.WithHeartbeatV2Components(heartbeatV2Components))
+		require.Nil(t, err)
+
+		history, err := n.GetHeartbeatHistory("pk")
+		assert.Nil(t, history)
+		assert.Equal(t, heartbeat.ErrHeartbeatHistoryDisabled, err)
+
+		uptime, err := n.GetUptime(0)
+		assert.Nil(t, uptime)
+		assert.Equal(t, heartbeat.ErrHeartbeatHistoryDisabled, err)
+	})
+}
+
+func TestNode_P2PMessageTraces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tracer should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		err := n.SetP2PMessageTracer(nil)
+		assert.Equal(t, node.ErrNilP2PMessageTracer, err)
+	})
+	t.Run("tracing disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+
+		traces, err := n.GetP2PMessageTraces()
+		assert.Nil(t, traces)
+		assert.Equal(t, node.ErrP2PMessageTracingDisabled, err)
+
+		chTraces, unsubscribe, err := n.SubscribeP2PMessageTraces()
+		assert.Nil(t, chTraces)
+		assert.Nil(t, unsubscribe)
+		assert.Equal(t, node.ErrP2PMessageTracingDisabled, err)
+	})
+	t.Run("should forward the calls to the tracer", func(t *testing.T) {
+		t.Parallel()
+
+		providedTraces := []*common.P2PMessageTrace{{Topic: "topic"}}
+		providedChannel := make(chan *common.P2PMessageTrace)
+		unsubscribedID := uint64(0)
+		closeCalled := false
+		tracer := &testscommon.P2PMessageTracerStub{
+			GetTracesCalled: func() []*common.P2PMessageTrace {
+				return providedTraces
+			},
+			SubscribeCalled: func() (uint64, <-chan *common.P2PMessageTrace) {
+				return 7, providedChannel
+			},
+			UnsubscribeCalled: func(id uint64) {
+				unsubscribedID = id
+			},
+			CloseCalled: func() error {
+				closeCalled = true
+				return nil
+			},
+		}
+
+		n, _ := node.NewNode()
+		err := n.SetP2PMessageTracer(tracer)
+		require.Nil(t, err)
+
+		traces, err := n.GetP2PMessageTraces()
+		assert.Nil(t, err)
+		assert.Equal(t, providedTraces, traces)
+
+		chTraces, unsubscribe, err := n.SubscribeP2PMessageTraces()
+		assert.Nil(t, err)
+		assert.True(t, chTraces == providedChannel)
+		unsubscribe()
+		assert.Equal(t, uint64(7), unsubscribedID)
+
+		_ = n.Close()
+		assert.True(t, closeCalled)
+	})
+}
+
+func TestNode_GuardianCoSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil co-signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		err := n.SetGuardianCoSigner(nil)
+		assert.Equal(t, node.ErrNilGuardianCoSigner, err)
+	})
+	t.Run("co-signer disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+
+		challenge, err := n.GetGuardianRegistrationChallenge(testscommon.TestAddressAlice)
+		assert.Nil(t, challenge)
+		assert.Equal(t, node.ErrGuardianCoSignerDisabled, err)
+
+		registration, err := n.RegisterGuardedAccount(testscommon.TestAddressAlice, "aa")
+		assert.Nil(t, registration)
+		assert.Equal(t, node.ErrGuardianCoSignerDisabled, err)
+
+		err = n.VerifyGuardianCode(testscommon.TestAddressAlice, "123456")
+		assert.Equal(t, node.ErrGuardianCoSignerDisabled, err)
+
+		err = n.SetGuardianSpendingPolicy(testscommon.TestAddressAlice, "123456", &common.GuardianSpendingPolicy{})
+		assert.Equal(t, node.ErrGuardianCoSignerDisabled, err)
+
+		signature, err := n.CoSignTransaction(&transaction.Transaction{}, "123456")
+		assert.Nil(t, signature)
+		assert.Equal(t, node.ErrGuardianCoSignerDisabled, err)
+	})
+	t.Run("invalid address or signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.AddrPubKeyConv = testscommon.RealWorldBech32PubkeyConverter
+		n, _ := node.NewNode(node.WithCoreComponents(coreComponents))
+		_ = n.SetGuardianCoSigner(&testscommon.GuardianCoSignerStub{})
+
+		challenge, err := n.GetGuardianRegistrationChallenge("invalid")
+		assert.Nil(t, challenge)
+		assert.Error(t, err)
+
+		registration, err := n.RegisterGuardedAccount("invalid", "aa")
+		assert.Nil(t, registration)
+		assert.Error(t, err)
+
+		registration, err = n.RegisterGuardedAccount(testscommon.TestAddressAlice, "not hex")
+		assert.Nil(t, registration)
+		assert.Error(t, err)
+
+		err = n.VerifyGuardianCode("invalid", "123456")
+		assert.Error(t, err)
+
+		err = n.SetGuardianSpendingPolicy("invalid", "123456", &common.GuardianSpendingPolicy{})
+		assert.Error(t, err)
+	})
+	t.Run("should forward the calls to the co-signer", func(t *testing.T) {
+		t.Parallel()
+
+		providedChallenge := &common.GuardianRegistrationChallenge{Challenge: "challenge"}
+		providedRegistration := &common.GuardianRegistration{OTPSecret: "secret"}
+		providedPolicy := &common.GuardianSpendingPolicy{AllowedReceivers: []string{testscommon.TestAddressBob}}
+		providedTx := &transaction.Transaction{Nonce: 7}
+		providedSignature := []byte("guardian signature")
+		closeCalled := false
+		verifyCodeCalled := false
+		setSpendingPolicyCalled := false
+		coSigner := &testscommon.GuardianCoSignerStub{
+			GetRegistrationChallengeCalled: func(address []byte) (*common.GuardianRegistrationChallenge, error) {
+				assert.Equal(t, testscommon.TestPubKeyAlice, address)
+				return providedChallenge, nil
+			},
+			RegisterAccountCalled: func(address []byte, signature []byte) (*common.GuardianRegistration, error) {
+				assert.Equal(t, testscommon.TestPubKeyAlice, address)
+				assert.Equal(t, []byte{0xaa}, signature)
+				return providedRegistration, nil
+			},
+			VerifyCodeCalled: func(address []byte, code string) error {
+				verifyCodeCalled = true
+				assert.Equal(t, testscommon.TestPubKeyAlice, address)
+				assert.Equal(t, "123456", code)
+				return nil
+			},
+			SetSpendingPolicyCalled: func(address []byte, code string, policy *common.GuardianSpendingPolicy) error {
+				setSpendingPolicyCalled = true
+				assert.Equal(t, testscommon.TestPubKeyAlice, address)
+				assert.Equal(t, providedPolicy, policy)
+				return nil
+			},
+			CoSignTransactionCalled: func(tx *transaction.Transaction, code string) ([]byte, error) {
+				assert.Equal(t, providedTx, tx)
+				return providedSignature, nil
+			},
+			CloseCalled: func() error {
+				closeCalled = true
+				return nil
+			},
+		}
+
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.AddrPubKeyConv = testscommon.RealWorldBech32PubkeyConverter
+		n, _ := node.NewNode(node.WithCoreComponents(coreComponents))
+		err := n.SetGuardianCoSigner(coSigner)
+		require.Nil(t, err)
+
+		challenge, err := n.GetGuardianRegistrationChallenge(testscommon.TestAddressAlice)
+		assert.Nil(t, err)
+		assert.Equal(t, providedChallenge, challenge)
+
+		registration, err := n.RegisterGuardedAccount(testscommon.TestAddressAlice, "aa")
+		assert.Nil(t, err)
+		assert.Equal(t, providedRegistration, registration)
+
+		err = n.VerifyGuardianCode(testscommon.TestAddressAlice, "123456")
+		assert.Nil(t, err)
+		assert.True(t, verifyCodeCalled)
+
+		err = n.SetGuardianSpendingPolicy(testscommon.TestAddressAlice, "123456", providedPolicy)
+		assert.Nil(t, err)
+		assert.True(t, setSpendingPolicyCalled)
+
+		signature, err := n.CoSignTransaction(providedTx, "123456")
+		assert.Nil(t, err)
+		assert.Equal(t, providedSignature, signature)
+
+		_ = n.Close()
+		assert.True(t, closeCalled)
+	})
+}
+
 func TestNode_Getters(t *testing.T) {
 	t.Parallel()
 
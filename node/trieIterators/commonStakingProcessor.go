@@ -1,6 +1,7 @@
 package trieIterators
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -30,7 +31,7 @@ func (csp *commonStakingProcessor) getValidatorInfoFromSC(validatorAddress []byt
 		Arguments:  [][]byte{validatorAddress},
 	}
 
-	vmOutput, _, err := csp.queryService.ExecuteQuery(scQuery)
+	vmOutput, _, err := csp.queryService.ExecuteQuery(context.Background(), scQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,23 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport"
+)
+
+type disabledEventsSubscriptionsHandler struct{}
+
+// NewDisabledEventsSubscriptionsHandler will create a new instance of disabledEventsSubscriptionsHandler
+func NewDisabledEventsSubscriptionsHandler() *disabledEventsSubscriptionsHandler {
+	return new(disabledEventsSubscriptionsHandler)
+}
+
+// Subscribe returns ErrEventsSubscriptionsDisabled
+func (d *disabledEventsSubscriptionsHandler) Subscribe(_ common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	return nil, outport.ErrEventsSubscriptionsDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledEventsSubscriptionsHandler) IsInterfaceNil() bool {
+	return d == nil
+}
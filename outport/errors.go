@@ -14,6 +14,9 @@ var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
 // ErrNilPubKeyConverter signals that a nil pubkey converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
 
+// ErrEventsSubscriptionsDisabled signals that the subscriptions to the node events are disabled
+var ErrEventsSubscriptionsDisabled = errors.New("events subscriptions are disabled")
+
 var errNilSaveBlockArgs = errors.New("nil save blocks args provided")
 
 var errNilHeaderAndBodyArgs = errors.New("nil header and body args provided")
@@ -3,6 +3,7 @@ package outport
 import (
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/outport/process"
 )
 
@@ -39,6 +40,13 @@ type OutportHandler interface {
 	IsInterfaceNil() bool
 }
 
+// EventsSubscriptionsHandler defines the component able to serve subscriptions to the blocks, transactions and
+// smart contract events received through the outport
+type EventsSubscriptionsHandler interface {
+	Subscribe(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	IsInterfaceNil() bool
+}
+
 // DataProviderOutport is an interface that defines what an implementation of data provider outport should be able to do
 type DataProviderOutport interface {
 	PrepareOutportSaveBlockData(arg process.ArgPrepareOutportSaveBlockData) (*outportcore.OutportBlockWithHeaderAndBody, error)
@@ -0,0 +1,41 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidSubscriberBufferSize signals that an invalid subscriber buffer size has been provided
+var ErrInvalidSubscriberBufferSize = errors.New("invalid subscriber buffer size")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrTooManyTransactions signals that a subscription filter follows too many transactions
+var ErrTooManyTransactions = errors.New("too many transactions in subscription filter")
+
+// ErrTooManyEventFilters signals that a subscription filter holds too many smart contract events filters
+var ErrTooManyEventFilters = errors.New("too many events filters in subscription filter")
+
+// ErrInvalidTransactionHash signals that an invalid transaction hash has been provided in a subscription filter
+var ErrInvalidTransactionHash = errors.New("invalid transaction hash")
+
+// ErrInvalidEventAddress signals that an invalid address has been provided in a smart contract events filter
+var ErrInvalidEventAddress = errors.New("invalid address in events filter")
+
+// ErrNilEventFilter signals that a nil smart contract events filter has been provided
+var ErrNilEventFilter = errors.New("nil events filter")
+
+// ErrSubscriptionClosed signals that the subscription has been closed
+var ErrSubscriptionClosed = errors.New("subscription closed")
+
+// ErrEventsHubClosed signals that the events hub has been closed
+var ErrEventsHubClosed = errors.New("events hub closed")
+
+var errUnknownHeaderType = errors.New("unknown header type")
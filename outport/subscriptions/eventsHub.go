@@ -0,0 +1,560 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/subscriptions")
+
+const (
+	blockMessageType             = "block"
+	finalizedBlockMessageType    = "finalizedBlock"
+	revertedBlockMessageType     = "revertedBlock"
+	transactionStatusMessageType = "transactionStatus"
+	scEventMessageType           = "event"
+
+	txStatusExecuted  = "executed"
+	txStatusFailed    = "failed"
+	txStatusInvalid   = "invalid"
+	txStatusFinalized = "finalized"
+	txStatusReverted  = "reverted"
+
+	maxTrackedBlocks = 1000
+)
+
+// ArgsEventsHub holds the arguments needed to create an events hub
+type ArgsEventsHub struct {
+	Config           config.EventsSubscriptionsConfig
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
+}
+
+// trackedBlock holds the followed transactions included in a block which was not yet finalized or reverted
+type trackedBlock struct {
+	nonce    uint64
+	shardID  uint32
+	txHashes []string
+}
+
+type eventsHub struct {
+	config           config.EventsSubscriptionsConfig
+	marshaller       marshal.Marshalizer
+	addressConverter core.PubkeyConverter
+	blockCreators    map[core.HeaderType]block.EmptyBlockCreator
+
+	mut           sync.Mutex
+	subscriptions map[uint64]*subscription
+	lastID        uint64
+	trackedBlocks map[string]*trackedBlock
+	closed        bool
+}
+
+// NewEventsHub creates an outport driver which pushes the blocks, the transactions status changes and the smart
+// contract events it receives to the API subscribers, based on their filters
+func NewEventsHub(args ArgsEventsHub) (*eventsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventsHub{
+		config:           args.Config,
+		marshaller:       args.Marshaller,
+		addressConverter: args.AddressConverter,
+		blockCreators: map[core.HeaderType]block.EmptyBlockCreator{
+			core.ShardHeaderV1: block.NewEmptyHeaderCreator(),
+			core.ShardHeaderV2: block.NewEmptyHeaderV2Creator(),
+			core.MetaHeader:    block.NewEmptyMetaBlockCreator(),
+		},
+		subscriptions: make(map[uint64]*subscription),
+		trackedBlocks: make(map[string]*trackedBlock),
+	}, nil
+}
+
+func checkArgs(args ArgsEventsHub) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.AddressConverter) {
+		return ErrNilAddressConverter
+	}
+	if args.Config.MaxSubscribers < 1 {
+		return ErrInvalidMaxSubscribers
+	}
+	if args.Config.SubscriberBufferSize < 1 {
+		return ErrInvalidSubscriberBufferSize
+	}
+
+	return nil
+}
+
+// Subscribe creates a new subscription which will receive the messages matching the provided filter
+func (eh *eventsHub) Subscribe(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	sf, err := newSubscriptionFilter(filter, eh.config, eh.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	eh.mut.Lock()
+	defer eh.mut.Unlock()
+
+	if eh.closed {
+		return nil, ErrEventsHubClosed
+	}
+	if len(eh.subscriptions) >= eh.config.MaxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	eh.lastID++
+	sub := &subscription{
+		id:         eh.lastID,
+		hub:        eh,
+		filter:     sf,
+		chMessages: make(chan *common.EventsSubscriptionMessage, eh.config.SubscriberBufferSize),
+	}
+	eh.subscriptions[sub.id] = sub
+
+	log.Debug("eventsHub: new subscription", "id", sub.id, "num subscriptions", len(eh.subscriptions))
+
+	return sub, nil
+}
+
+func (eh *eventsHub) updateFilter(id uint64, filter common.EventsSubscriptionFilter) error {
+	sf, err := newSubscriptionFilter(filter, eh.config, eh.addressConverter)
+	if err != nil {
+		return err
+	}
+
+	eh.mut.Lock()
+	defer eh.mut.Unlock()
+
+	sub, found := eh.subscriptions[id]
+	if !found {
+		return ErrSubscriptionClosed
+	}
+
+	sub.filter = sf
+
+	return nil
+}
+
+func (eh *eventsHub) unsubscribe(id uint64) {
+	eh.mut.Lock()
+	eh.removeSubscription(id)
+	eh.mut.Unlock()
+}
+
+// removeSubscription must be called under mutex protection
+func (eh *eventsHub) removeSubscription(id uint64) {
+	sub, found := eh.subscriptions[id]
+	if !found {
+		return
+	}
+
+	delete(eh.subscriptions, id)
+	close(sub.chMessages)
+
+	log.Debug("eventsHub: subscription closed", "id", id, "num subscriptions", len(eh.subscriptions))
+}
+
+// SaveBlock pushes the new block, the status of the followed transactions and the matching smart contract events
+func (eh *eventsHub) SaveBlock(outportBlock *outportcore.OutportBlock) error {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return nil
+	}
+
+	eh.mut.Lock()
+	defer eh.mut.Unlock()
+
+	if len(eh.subscriptions) == 0 {
+		return nil
+	}
+
+	header, err := eh.getHeader(outportBlock.BlockData)
+	if err != nil {
+		log.Warn("eventsHub.SaveBlock: cannot unmarshal header",
+			"hash", outportBlock.BlockData.HeaderHash, "error", err)
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(outportBlock.BlockData.HeaderHash)
+	slowSubscribers := make(map[uint64]struct{})
+
+	eh.pushBlock(blockMessageType, createSubscriptionBlock(blockHash, header), slowSubscribers)
+
+	pool := outportBlock.TransactionPool
+	if pool != nil {
+		eh.pushTransactionsStatus(pool, blockHash, header, slowSubscribers)
+		eh.pushEvents(pool, blockHash, header, slowSubscribers)
+	}
+
+	eh.removeSlowSubscribers(slowSubscribers)
+
+	return nil
+}
+
+func (eh *eventsHub) getHeader(blockData *outportcore.BlockData) (data.HeaderHandler, error) {
+	creator, found := eh.blockCreators[core.HeaderType(blockData.HeaderType)]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errUnknownHeaderType, blockData.HeaderType)
+	}
+
+	return block.GetHeaderFromBytes(eh.marshaller, creator, blockData.HeaderBytes)
+}
+
+func createSubscriptionBlock(blockHash string, header data.HeaderHandler) *common.SubscriptionBlock {
+	return &common.SubscriptionBlock{
+		Hash:      blockHash,
+		ShardID:   header.GetShardID(),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		TimeStamp: header.GetTimeStamp(),
+		NumTxs:    header.GetTxCount(),
+	}
+}
+
+func (eh *eventsHub) pushBlock(messageType string, subscriptionBlock *common.SubscriptionBlock, slowSubscribers map[uint64]struct{}) {
+	message := &common.EventsSubscriptionMessage{
+		Type: messageType,
+		Data: subscriptionBlock,
+	}
+
+	for _, sub := range eh.subscriptions {
+		isFollowed := (messageType == blockMessageType && sub.filter.blocks) ||
+			(messageType == finalizedBlockMessageType && sub.filter.finalizedBlocks) ||
+			(messageType == revertedBlockMessageType && sub.filter.revertedBlocks)
+		if isFollowed {
+			eh.push(sub, message, slowSubscribers)
+		}
+	}
+}
+
+func (eh *eventsHub) pushTransactionsStatus(
+	pool *outportcore.TransactionPool,
+	blockHash string,
+	header data.HeaderHandler,
+	slowSubscribers map[uint64]struct{},
+) {
+	failedTxs := getFailedTransactions(pool)
+	trackedTxs := make(map[string]struct{})
+
+	for _, sub := range eh.subscriptions {
+		for txHash := range sub.filter.transactions {
+			status, found := getTransactionStatus(pool, failedTxs, txHash)
+			if !found {
+				continue
+			}
+
+			trackedTxs[txHash] = struct{}{}
+			eh.push(sub, createTransactionStatusMessage(txHash, status, blockHash, header.GetNonce(), header.GetShardID()), slowSubscribers)
+		}
+	}
+
+	if len(trackedTxs) == 0 {
+		return
+	}
+
+	tb := &trackedBlock{
+		nonce:    header.GetNonce(),
+		shardID:  header.GetShardID(),
+		txHashes: make([]string, 0, len(trackedTxs)),
+	}
+	for txHash := range trackedTxs {
+		tb.txHashes = append(tb.txHashes, txHash)
+	}
+	eh.trackBlock(blockHash, tb)
+}
+
+func getFailedTransactions(pool *outportcore.TransactionPool) map[string]struct{} {
+	failedTxs := make(map[string]struct{})
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event != nil && string(event.Identifier) == core.SignalErrorOperation {
+				failedTxs[logData.TxHash] = struct{}{}
+				break
+			}
+		}
+	}
+
+	return failedTxs
+}
+
+func getTransactionStatus(pool *outportcore.TransactionPool, failedTxs map[string]struct{}, txHash string) (string, bool) {
+	_, isInvalid := pool.InvalidTxs[txHash]
+	if isInvalid {
+		return txStatusInvalid, true
+	}
+
+	_, isTx := pool.Transactions[txHash]
+	_, isSCR := pool.SmartContractResults[txHash]
+	if !isTx && !isSCR {
+		return "", false
+	}
+
+	_, isFailed := failedTxs[txHash]
+	if isFailed {
+		return txStatusFailed, true
+	}
+
+	return txStatusExecuted, true
+}
+
+func createTransactionStatusMessage(txHash string, status string, blockHash string, blockNonce uint64, shardID uint32) *common.EventsSubscriptionMessage {
+	return &common.EventsSubscriptionMessage{
+		Type: transactionStatusMessageType,
+		Data: &common.SubscriptionTransactionStatus{
+			Hash:       txHash,
+			Status:     status,
+			BlockHash:  blockHash,
+			BlockNonce: blockNonce,
+			ShardID:    shardID,
+		},
+	}
+}
+
+// trackBlock must be called under mutex protection
+func (eh *eventsHub) trackBlock(blockHash string, tb *trackedBlock) {
+	if len(eh.trackedBlocks) >= maxTrackedBlocks {
+		eh.evictOldestTrackedBlock()
+	}
+
+	eh.trackedBlocks[blockHash] = tb
+}
+
+func (eh *eventsHub) evictOldestTrackedBlock() {
+	oldestHash := ""
+	var oldest *trackedBlock
+	for hash, tb := range eh.trackedBlocks {
+		if oldest == nil || tb.nonce < oldest.nonce {
+			oldestHash, oldest = hash, tb
+		}
+	}
+
+	delete(eh.trackedBlocks, oldestHash)
+}
+
+func (eh *eventsHub) pushEvents(
+	pool *outportcore.TransactionPool,
+	blockHash string,
+	header data.HeaderHandler,
+	slowSubscribers map[uint64]struct{},
+) {
+	hasEventFilters := false
+	for _, sub := range eh.subscriptions {
+		hasEventFilters = hasEventFilters || len(sub.filter.events) > 0
+	}
+	if !hasEventFilters {
+		return
+	}
+
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event == nil {
+				continue
+			}
+
+			var message *common.EventsSubscriptionMessage
+			for _, sub := range eh.subscriptions {
+				if !sub.filter.matchesEvent(event) {
+					continue
+				}
+				if message == nil {
+					message = &common.EventsSubscriptionMessage{
+						Type: scEventMessageType,
+						Data: &common.SubscriptionSCEvent{
+							TxHash:         logData.TxHash,
+							Address:        eh.addressConverter.SilentEncode(event.Address, log),
+							Identifier:     string(event.Identifier),
+							Topics:         event.Topics,
+							Data:           event.Data,
+							AdditionalData: event.AdditionalData,
+							BlockHash:      blockHash,
+							BlockNonce:     header.GetNonce(),
+							ShardID:        header.GetShardID(),
+						},
+					}
+				}
+
+				eh.push(sub, message, slowSubscribers)
+			}
+		}
+	}
+}
+
+// push must be called under mutex protection. The subscribers which do not keep up are marked as slow, instead of
+// blocking the outport
+func (eh *eventsHub) push(sub *subscription, message *common.EventsSubscriptionMessage, slowSubscribers map[uint64]struct{}) {
+	_, isSlow := slowSubscribers[sub.id]
+	if isSlow {
+		return
+	}
+
+	select {
+	case sub.chMessages <- message:
+	default:
+		slowSubscribers[sub.id] = struct{}{}
+	}
+}
+
+func (eh *eventsHub) removeSlowSubscribers(slowSubscribers map[uint64]struct{}) {
+	for id := range slowSubscribers {
+		log.Debug("eventsHub: closing the subscription of a subscriber which does not keep up", "id", id)
+		eh.removeSubscription(id)
+	}
+}
+
+// RevertIndexedBlock pushes the reverted block and the reverted status of the followed transactions it included
+func (eh *eventsHub) RevertIndexedBlock(blockData *outportcore.BlockData) error {
+	if blockData == nil {
+		return nil
+	}
+
+	eh.mut.Lock()
+	defer eh.mut.Unlock()
+
+	blockHash := hex.EncodeToString(blockData.HeaderHash)
+	subscriptionBlock := &common.SubscriptionBlock{
+		Hash:    blockHash,
+		ShardID: blockData.ShardID,
+	}
+	header, err := eh.getHeader(blockData)
+	if err == nil {
+		subscriptionBlock = createSubscriptionBlock(blockHash, header)
+	}
+
+	slowSubscribers := make(map[uint64]struct{})
+	eh.pushBlock(revertedBlockMessageType, subscriptionBlock, slowSubscribers)
+	eh.pushTrackedTransactionsStatus(blockHash, txStatusReverted, slowSubscribers)
+	eh.removeSlowSubscribers(slowSubscribers)
+
+	return nil
+}
+
+// FinalizedBlock pushes the finalized block and the finalized status of the followed transactions it included
+func (eh *eventsHub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error {
+	if finalizedBlock == nil {
+		return nil
+	}
+
+	eh.mut.Lock()
+	defer eh.mut.Unlock()
+
+	blockHash := hex.EncodeToString(finalizedBlock.HeaderHash)
+	subscriptionBlock := &common.SubscriptionBlock{
+		Hash:    blockHash,
+		ShardID: finalizedBlock.ShardID,
+	}
+
+	slowSubscribers := make(map[uint64]struct{})
+	eh.pushBlock(finalizedBlockMessageType, subscriptionBlock, slowSubscribers)
+	tb := eh.pushTrackedTransactionsStatus(blockHash, txStatusFinalized, slowSubscribers)
+	if tb != nil {
+		eh.removeTrackedBlocksNotAfter(tb.nonce, tb.shardID)
+	}
+	eh.removeSlowSubscribers(slowSubscribers)
+
+	return nil
+}
+
+// pushTrackedTransactionsStatus must be called under mutex protection
+func (eh *eventsHub) pushTrackedTransactionsStatus(blockHash string, status string, slowSubscribers map[uint64]struct{}) *trackedBlock {
+	tb, found := eh.trackedBlocks[blockHash]
+	if !found {
+		return nil
+	}
+	delete(eh.trackedBlocks, blockHash)
+
+	for _, txHash := range tb.txHashes {
+		message := createTransactionStatusMessage(txHash, status, blockHash, tb.nonce, tb.shardID)
+		for _, sub := range eh.subscriptions {
+			if sub.filter.followsTransaction(txHash) {
+				eh.push(sub, message, slowSubscribers)
+			}
+		}
+	}
+
+	return tb
+}
+
+// removeTrackedBlocksNotAfter drops the blocks which can not be finalized anymore, as they were on a discarded fork
+func (eh *eventsHub) removeTrackedBlocksNotAfter(nonce uint64, shardID uint32) {
+	for hash, tb := range eh.trackedBlocks {
+		if tb.shardID == shardID && tb.nonce <= nonce {
+			delete(eh.trackedBlocks, hash)
+		}
+	}
+}
+
+// SaveRoundsInfo does nothing
+func (eh *eventsHub) SaveRoundsInfo(_ *outportcore.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (eh *eventsHub) SaveValidatorsPubKeys(_ *outportcore.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (eh *eventsHub) SaveValidatorsRating(_ *outportcore.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (eh *eventsHub) SaveAccounts(_ *outportcore.Accounts) error {
+	return nil
+}
+
+// GetMarshaller returns the marshaller used to encode the headers received through the outport
+func (eh *eventsHub) GetMarshaller() marshal.Marshalizer {
+	return eh.marshaller
+}
+
+// SetCurrentSettings does nothing
+func (eh *eventsHub) SetCurrentSettings(_ outportcore.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler does nothing
+func (eh *eventsHub) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close ends all the subscriptions
+func (eh *eventsHub) Close() error {
+	eh.mut.Lock()
+	defer eh.mut.Unlock()
+
+	for id := range eh.subscriptions {
+		eh.removeSubscription(id)
+	}
+	eh.closed = true
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eh *eventsHub) IsInterfaceNil() bool {
+	return eh == nil
+}
@@ -0,0 +1,396 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	txHash      = hex.EncodeToString([]byte("txHash"))
+	otherTxHash = hex.EncodeToString([]byte("otherTxHash"))
+	blockHash   = []byte("blockHash")
+	scAddress   = []byte("scAddress")
+)
+
+func createMockArgsEventsHub() ArgsEventsHub {
+	return ArgsEventsHub{
+		Config: config.EventsSubscriptionsConfig{
+			Enabled:                        true,
+			MaxSubscribers:                 2,
+			SubscriberBufferSize:           10,
+			MaxTransactionsPerSubscription: 2,
+			MaxEventFiltersPerSubscription: 2,
+		},
+		Marshaller:       &marshal.GogoProtoMarshalizer{},
+		AddressConverter: testscommon.NewPubkeyConverterMock(len(scAddress)),
+	}
+}
+
+func createOutportBlock(t *testing.T, hash []byte, nonce uint64, pool *outportcore.TransactionPool) *outportcore.OutportBlock {
+	header := &block.Header{
+		Nonce:   nonce,
+		Round:   nonce + 1,
+		Epoch:   2,
+		TxCount: 3,
+	}
+	headerBytes, err := (&marshal.GogoProtoMarshalizer{}).Marshal(header)
+	require.Nil(t, err)
+
+	return &outportcore.OutportBlock{
+		BlockData: &outportcore.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV1),
+			HeaderHash:  hash,
+		},
+		TransactionPool: pool,
+	}
+}
+
+func createTransactionPool() *outportcore.TransactionPool {
+	return &outportcore.TransactionPool{
+		Transactions: map[string]*outportcore.TxInfo{
+			txHash:      {Transaction: &transaction.Transaction{Nonce: 1}},
+			otherTxHash: {Transaction: &transaction.Transaction{Nonce: 2}},
+		},
+		Logs: []*outportcore.LogData{
+			{
+				TxHash: otherTxHash,
+				Log: &transaction.Log{
+					Events: []*transaction.Event{
+						{
+							Address:    scAddress,
+							Identifier: []byte(core.SignalErrorOperation),
+						},
+						{
+							Address:    scAddress,
+							Identifier: []byte("transfer"),
+							Topics:     [][]byte{[]byte("token"), []byte("receiver")},
+							Data:       []byte("data"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readMessages(sub common.EventsSubscription) []*common.EventsSubscriptionMessage {
+	messages := make([]*common.EventsSubscriptionMessage, 0)
+	for {
+		select {
+		case message, ok := <-sub.Messages():
+			if !ok {
+				return messages
+			}
+			messages = append(messages, message)
+		default:
+			return messages
+		}
+	}
+}
+
+func TestNewEventsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.Marshaller = nil
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrNilMarshaller, err)
+		require.Nil(t, hub)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.AddressConverter = nil
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrNilAddressConverter, err)
+		require.Nil(t, hub)
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.Config.MaxSubscribers = 0
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrInvalidMaxSubscribers, err)
+		require.Nil(t, hub)
+	})
+	t.Run("invalid subscriber buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.Config.SubscriberBufferSize = 0
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrInvalidSubscriberBufferSize, err)
+		require.Nil(t, hub)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := NewEventsHub(createMockArgsEventsHub())
+		require.Nil(t, err)
+		require.False(t, hub.IsInterfaceNil())
+	})
+}
+
+func TestEventsHub_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid filters should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+
+		_, err := hub.Subscribe(common.EventsSubscriptionFilter{Transactions: []string{"a", "b", "c"}})
+		require.True(t, errors.Is(err, ErrTooManyTransactions))
+
+		_, err = hub.Subscribe(common.EventsSubscriptionFilter{Transactions: []string{"not hex"}})
+		require.True(t, errors.Is(err, ErrInvalidTransactionHash))
+
+		_, err = hub.Subscribe(common.EventsSubscriptionFilter{Events: make([]*common.SCEventSubscriptionFilter, 3)})
+		require.True(t, errors.Is(err, ErrTooManyEventFilters))
+
+		_, err = hub.Subscribe(common.EventsSubscriptionFilter{Events: make([]*common.SCEventSubscriptionFilter, 1)})
+		require.Equal(t, ErrNilEventFilter, err)
+
+		_, err = hub.Subscribe(common.EventsSubscriptionFilter{Events: []*common.SCEventSubscriptionFilter{{Address: "invalid"}}})
+		require.True(t, errors.Is(err, ErrInvalidEventAddress))
+	})
+	t.Run("too many subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+
+		sub1, err := hub.Subscribe(common.EventsSubscriptionFilter{})
+		require.Nil(t, err)
+		_, err = hub.Subscribe(common.EventsSubscriptionFilter{})
+		require.Nil(t, err)
+
+		_, err = hub.Subscribe(common.EventsSubscriptionFilter{})
+		require.Equal(t, ErrTooManySubscribers, err)
+
+		sub1.Close()
+		_, err = hub.Subscribe(common.EventsSubscriptionFilter{})
+		require.Nil(t, err)
+	})
+	t.Run("closed hub should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+		sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{})
+
+		require.Nil(t, hub.Close())
+		_, ok := <-sub.Messages()
+		require.False(t, ok)
+
+		_, err := hub.Subscribe(common.EventsSubscriptionFilter{})
+		require.Equal(t, ErrEventsHubClosed, err)
+	})
+}
+
+func TestEventsHub_SaveBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should push the new block", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+		sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Blocks: true})
+		otherSub, _ := hub.Subscribe(common.EventsSubscriptionFilter{FinalizedBlocks: true})
+
+		err := hub.SaveBlock(createOutportBlock(t, blockHash, 5, nil))
+		require.Nil(t, err)
+
+		require.Equal(t, []*common.EventsSubscriptionMessage{
+			{
+				Type: blockMessageType,
+				Data: &common.SubscriptionBlock{
+					Hash:   hex.EncodeToString(blockHash),
+					Nonce:  5,
+					Round:  6,
+					Epoch:  2,
+					NumTxs: 3,
+				},
+			},
+		}, readMessages(sub))
+		require.Empty(t, readMessages(otherSub))
+	})
+	t.Run("should push the followed transactions status", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+		sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Transactions: []string{txHash, otherTxHash}})
+
+		err := hub.SaveBlock(createOutportBlock(t, blockHash, 5, createTransactionPool()))
+		require.Nil(t, err)
+
+		messages := readMessages(sub)
+		require.Len(t, messages, 2)
+		statuses := make(map[string]string)
+		for _, message := range messages {
+			require.Equal(t, transactionStatusMessageType, message.Type)
+			txStatus := message.Data.(*common.SubscriptionTransactionStatus)
+			require.Equal(t, hex.EncodeToString(blockHash), txStatus.BlockHash)
+			require.Equal(t, uint64(5), txStatus.BlockNonce)
+			statuses[txStatus.Hash] = txStatus.Status
+		}
+		require.Equal(t, map[string]string{txHash: txStatusExecuted, otherTxHash: txStatusFailed}, statuses)
+	})
+	t.Run("should push the invalid transactions status", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+		sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Transactions: []string{txHash}})
+
+		pool := &outportcore.TransactionPool{
+			InvalidTxs: map[string]*outportcore.TxInfo{
+				txHash: {Transaction: &transaction.Transaction{}},
+			},
+		}
+		err := hub.SaveBlock(createOutportBlock(t, blockHash, 5, pool))
+		require.Nil(t, err)
+
+		messages := readMessages(sub)
+		require.Len(t, messages, 1)
+		require.Equal(t, txStatusInvalid, messages[0].Data.(*common.SubscriptionTransactionStatus).Status)
+	})
+	t.Run("should push the matching events", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+		matchingFilters := []*common.SCEventSubscriptionFilter{
+			{Identifier: "transfer"},
+			{Address: hex.EncodeToString(scAddress), Topics: [][]byte{{}, []byte("receiver")}},
+		}
+		for _, filter := range matchingFilters {
+			sub, err := hub.Subscribe(common.EventsSubscriptionFilter{Events: []*common.SCEventSubscriptionFilter{filter}})
+			require.Nil(t, err)
+
+			err = hub.SaveBlock(createOutportBlock(t, blockHash, 5, createTransactionPool()))
+			require.Nil(t, err)
+
+			require.Equal(t, []*common.EventsSubscriptionMessage{
+				{
+					Type: scEventMessageType,
+					Data: &common.SubscriptionSCEvent{
+						TxHash:     otherTxHash,
+						Address:    hex.EncodeToString(scAddress),
+						Identifier: "transfer",
+						Topics:     [][]byte{[]byte("token"), []byte("receiver")},
+						Data:       []byte("data"),
+						BlockHash:  hex.EncodeToString(blockHash),
+						BlockNonce: 5,
+					},
+				},
+			}, readMessages(sub))
+			sub.Close()
+		}
+
+		sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Events: []*common.SCEventSubscriptionFilter{
+			{Identifier: "transfer", Topics: [][]byte{[]byte("other token")}},
+			{Identifier: "transfer", Topics: [][]byte{{}, {}, {}}},
+		}})
+		err := hub.SaveBlock(createOutportBlock(t, blockHash, 5, createTransactionPool()))
+		require.Nil(t, err)
+		require.Empty(t, readMessages(sub))
+	})
+	t.Run("slow subscriber should be closed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.Config.SubscriberBufferSize = 1
+		hub, _ := NewEventsHub(args)
+		sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Blocks: true})
+
+		_ = hub.SaveBlock(createOutportBlock(t, blockHash, 5, nil))
+		_ = hub.SaveBlock(createOutportBlock(t, blockHash, 6, nil))
+
+		_, ok := <-sub.Messages()
+		require.True(t, ok)
+		_, ok = <-sub.Messages()
+		require.False(t, ok)
+		require.Equal(t, ErrSubscriptionClosed, sub.UpdateFilter(common.EventsSubscriptionFilter{}))
+	})
+	t.Run("invalid header should not error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewEventsHub(createMockArgsEventsHub())
+		sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Blocks: true})
+
+		outportBlock := createOutportBlock(t, blockHash, 5, nil)
+		outportBlock.BlockData.HeaderType = "unknown"
+		require.Nil(t, hub.SaveBlock(outportBlock))
+		require.Nil(t, hub.SaveBlock(nil))
+		require.Empty(t, readMessages(sub))
+	})
+}
+
+func TestEventsHub_FinalizedAndRevertedBlocks(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{
+		FinalizedBlocks: true,
+		RevertedBlocks:  true,
+		Transactions:    []string{txHash},
+	})
+
+	revertedBlockHash := []byte("revertedBlockHash")
+	revertedBlock := createOutportBlock(t, revertedBlockHash, 5, createTransactionPool())
+	_ = hub.SaveBlock(revertedBlock)
+	_ = hub.RevertIndexedBlock(revertedBlock.BlockData)
+	_ = hub.SaveBlock(createOutportBlock(t, blockHash, 5, createTransactionPool()))
+	_ = hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash})
+
+	messages := readMessages(sub)
+	require.Len(t, messages, 6)
+	require.Equal(t, txStatusExecuted, messages[0].Data.(*common.SubscriptionTransactionStatus).Status)
+	require.Equal(t, revertedBlockMessageType, messages[1].Type)
+	require.Equal(t, hex.EncodeToString(revertedBlockHash), messages[1].Data.(*common.SubscriptionBlock).Hash)
+	require.Equal(t, uint64(5), messages[1].Data.(*common.SubscriptionBlock).Nonce)
+	require.Equal(t, txStatusReverted, messages[2].Data.(*common.SubscriptionTransactionStatus).Status)
+	require.Equal(t, txStatusExecuted, messages[3].Data.(*common.SubscriptionTransactionStatus).Status)
+	require.Equal(t, finalizedBlockMessageType, messages[4].Type)
+	require.Equal(t, &common.SubscriptionTransactionStatus{
+		Hash:       txHash,
+		Status:     txStatusFinalized,
+		BlockHash:  hex.EncodeToString(blockHash),
+		BlockNonce: 5,
+	}, messages[5].Data)
+	require.Empty(t, hub.trackedBlocks)
+}
+
+func TestEventsHub_UpdateFilter(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Blocks: true})
+
+	err := sub.UpdateFilter(common.EventsSubscriptionFilter{Transactions: []string{"not hex"}})
+	require.True(t, errors.Is(err, ErrInvalidTransactionHash))
+
+	err = sub.UpdateFilter(common.EventsSubscriptionFilter{FinalizedBlocks: true})
+	require.Nil(t, err)
+
+	_ = hub.SaveBlock(createOutportBlock(t, blockHash, 5, nil))
+	_ = hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: blockHash})
+
+	messages := readMessages(sub)
+	require.Len(t, messages, 1)
+	require.Equal(t, finalizedBlockMessageType, messages[0].Type)
+}
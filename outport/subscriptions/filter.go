@@ -0,0 +1,116 @@
+package subscriptions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+type eventFilter struct {
+	address    []byte
+	identifier []byte
+	topics     [][]byte
+}
+
+type subscriptionFilter struct {
+	blocks          bool
+	finalizedBlocks bool
+	revertedBlocks  bool
+	transactions    map[string]struct{}
+	events          []*eventFilter
+}
+
+func newSubscriptionFilter(
+	filter common.EventsSubscriptionFilter,
+	cfg config.EventsSubscriptionsConfig,
+	addressConverter core.PubkeyConverter,
+) (*subscriptionFilter, error) {
+	if len(filter.Transactions) > cfg.MaxTransactionsPerSubscription {
+		return nil, fmt.Errorf("%w, maximum %d", ErrTooManyTransactions, cfg.MaxTransactionsPerSubscription)
+	}
+	if len(filter.Events) > cfg.MaxEventFiltersPerSubscription {
+		return nil, fmt.Errorf("%w, maximum %d", ErrTooManyEventFilters, cfg.MaxEventFiltersPerSubscription)
+	}
+
+	sf := &subscriptionFilter{
+		blocks:          filter.Blocks,
+		finalizedBlocks: filter.FinalizedBlocks,
+		revertedBlocks:  filter.RevertedBlocks,
+		transactions:    make(map[string]struct{}, len(filter.Transactions)),
+		events:          make([]*eventFilter, 0, len(filter.Events)),
+	}
+
+	for _, txHash := range filter.Transactions {
+		txHash = strings.ToLower(txHash)
+		_, err := hex.DecodeString(txHash)
+		if err != nil || len(txHash) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTransactionHash, txHash)
+		}
+
+		sf.transactions[txHash] = struct{}{}
+	}
+
+	for _, ef := range filter.Events {
+		if ef == nil {
+			return nil, ErrNilEventFilter
+		}
+
+		var address []byte
+		if len(ef.Address) > 0 {
+			var err error
+			address, err = addressConverter.Decode(ef.Address)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s, %s", ErrInvalidEventAddress, ef.Address, err.Error())
+			}
+		}
+
+		sf.events = append(sf.events, &eventFilter{
+			address:    address,
+			identifier: []byte(ef.Identifier),
+			topics:     ef.Topics,
+		})
+	}
+
+	return sf, nil
+}
+
+func (sf *subscriptionFilter) followsTransaction(txHash string) bool {
+	_, found := sf.transactions[txHash]
+	return found
+}
+
+func (sf *subscriptionFilter) matchesEvent(event *transaction.Event) bool {
+	for _, ef := range sf.events {
+		if ef.matches(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ef *eventFilter) matches(event *transaction.Event) bool {
+	if len(ef.address) > 0 && !bytes.Equal(ef.address, event.Address) {
+		return false
+	}
+	if len(ef.identifier) > 0 && !bytes.Equal(ef.identifier, event.Identifier) {
+		return false
+	}
+	if len(ef.topics) > len(event.Topics) {
+		return false
+	}
+
+	for idx, topic := range ef.topics {
+		if len(topic) > 0 && !bytes.Equal(topic, event.Topics[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,28 @@
+package subscriptions
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type subscription struct {
+	id         uint64
+	hub        *eventsHub
+	filter     *subscriptionFilter
+	chMessages chan *common.EventsSubscriptionMessage
+}
+
+// Messages returns the channel on which the messages matching the subscription filter are pushed. The channel is
+// closed when the subscription ends, either by calling Close or because the subscriber could not keep up
+func (s *subscription) Messages() <-chan *common.EventsSubscriptionMessage {
+	return s.chMessages
+}
+
+// UpdateFilter replaces the subscription filter
+func (s *subscription) UpdateFilter(filter common.EventsSubscriptionFilter) error {
+	return s.hub.updateFilter(s.id, filter)
+}
+
+// Close ends the subscription
+func (s *subscription) Close() {
+	s.hub.unsubscribe(s.id)
+}
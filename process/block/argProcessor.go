@@ -55,6 +55,7 @@ type statusComponentsHolder interface {
 
 type statusCoreComponentsHolder interface {
 	AppStatusHandler() core.AppStatusHandler
+	MetricsRegistry() common.MetricsRegistry
 	IsInterfaceNil() bool
 }
 
@@ -123,6 +123,7 @@ type baseProcessor struct {
 	mutNonceOfFirstCommittedBlock sync.RWMutex
 	nonceOfFirstCommittedBlock    core.OptionalUint64
 	extraDelayRequestBlockInfo    time.Duration
+	metrics                       *blockProcessingMetrics
 }
 
 type bootStorerDataArgs struct {
@@ -536,6 +537,9 @@ func checkProcessorParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.StatusCoreComponents.AppStatusHandler()) {
 		return process.ErrNilAppStatusHandler
 	}
+	if check.IfNil(arguments.StatusCoreComponents.MetricsRegistry()) {
+		return process.ErrNilMetricsRegistry
+	}
 	if check.IfNil(arguments.GasHandler) {
 		return process.ErrNilGasHandler
 	}
@@ -1510,10 +1514,12 @@ func (bp *baseProcessor) commitInLastEpoch(currentEpoch uint32) error {
 
 func (bp *baseProcessor) commit() error {
 	for key := range bp.accountsDB {
+		startTime := time.Now()
 		_, err := bp.accountsDB[key].Commit()
 		if err != nil {
 			return err
 		}
+		bp.metrics.observeTrieCommit(key, startTime)
 	}
 
 	return nil
@@ -1521,10 +1527,12 @@ func (bp *baseProcessor) commit() error {
 
 func (bp *baseProcessor) commitInEpoch(currentEpoch uint32, epochToCommit uint32) error {
 	for key := range bp.accountsDB {
+		startTime := time.Now()
 		_, err := bp.accountsDB[key].CommitInEpoch(currentEpoch, epochToCommit)
 		if err != nil {
 			return err
 		}
+		bp.metrics.observeTrieCommit(key, startTime)
 	}
 
 	return nil
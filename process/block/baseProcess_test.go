@@ -92,6 +92,7 @@ func createArgBaseProcessor(
 
 	statusCoreComponents := &factory.StatusCoreComponentsStub{
 		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 	}
 
 	return blproc.ArgBaseProcessor{
@@ -713,6 +714,17 @@ func TestCheckProcessorNilParameters(t *testing.T) {
 			},
 			expectedErr: process.ErrNilAppStatusHandler,
 		},
+		{
+			args: func() blproc.ArgBaseProcessor {
+				args := createArgBaseProcessor(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+				args.StatusCoreComponents = &factory.StatusCoreComponentsStub{
+					AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
+					MetricsRegistryField:  nil,
+				}
+				return args
+			},
+			expectedErr: process.ErrNilMetricsRegistry,
+		},
 		{
 			args: func() blproc.ArgBaseProcessor {
 				coreCompCopy := *coreComponents
@@ -3001,6 +3013,7 @@ func TestBaseProcessor_getPruningHandler(t *testing.T) {
 	arguments.Config = config.Config{}
 	arguments.StatusCoreComponents = &factory.StatusCoreComponentsStub{
 		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 	}
 	bp, _ := blproc.NewShardProcessor(arguments)
 
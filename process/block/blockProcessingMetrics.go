@@ -0,0 +1,79 @@
+package block
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const (
+	blockProcessingDurationMetric = "erd_block_processing_duration_seconds"
+	trieCommitDurationMetric      = "erd_trie_commit_duration_seconds"
+	resultSuccess                 = "success"
+	resultError                   = "error"
+	unknownShardLabel             = "unknown"
+)
+
+var accountsDbLabels = map[state.AccountsDbIdentifier]string{
+	state.UserAccountsState: "user",
+	state.PeerAccountsState: "peer",
+}
+
+type blockProcessingMetrics struct {
+	processingDuration common.HistogramMetric
+	commitDuration     common.HistogramMetric
+}
+
+func newBlockProcessingMetrics(registry common.MetricsRegistry) (*blockProcessingMetrics, error) {
+	if check.IfNil(registry) {
+		return nil, process.ErrNilMetricsRegistry
+	}
+
+	processingDuration, err := registry.RegisterHistogram(
+		blockProcessingDurationMetric,
+		"Time spent processing a block, by the block shard and the processing result",
+		nil,
+		"shard", "result",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	commitDuration, err := registry.RegisterHistogram(
+		trieCommitDurationMetric,
+		"Time spent committing the state trie, by accounts type",
+		nil,
+		"trie",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockProcessingMetrics{
+		processingDuration: processingDuration,
+		commitDuration:     commitDuration,
+	}, nil
+}
+
+func (bpm *blockProcessingMetrics) observeBlockProcessing(headerHandler data.HeaderHandler, startTime time.Time, err error) {
+	shard := unknownShardLabel
+	if !check.IfNil(headerHandler) {
+		shard = core.GetShardIDString(headerHandler.GetShardID())
+	}
+
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+
+	bpm.processingDuration.Observe(time.Since(startTime).Seconds(), shard, result)
+}
+
+func (bpm *blockProcessingMetrics) observeTrieCommit(identifier state.AccountsDbIdentifier, startTime time.Time) {
+	bpm.commitDuration.Observe(time.Since(startTime).Seconds(), accountsDbLabels[identifier])
+}
@@ -134,6 +134,7 @@ func NewShardProcessorEmptyWith3shards(
 	}
 	statusCoreComponents := &factory.StatusCoreComponentsStub{
 		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 	}
 
 	arguments := ArgShardProcessor{
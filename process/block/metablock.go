@@ -91,6 +91,11 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		return nil, err
 	}
 
+	metrics, err := newBlockProcessingMetrics(arguments.StatusCoreComponents.MetricsRegistry())
+	if err != nil {
+		return nil, err
+	}
+
 	genesisHdr := arguments.DataComponents.Blockchain().GetGenesisHeader()
 	base := &baseProcessor{
 		accountsDB:                    arguments.AccountsDB,
@@ -139,6 +144,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		managedPeersHolder:            arguments.ManagedPeersHolder,
 		sentSignaturesTracker:         arguments.SentSignaturesTracker,
 		extraDelayRequestBlockInfo:    time.Duration(arguments.Config.EpochStartConfig.ExtraDelayForRequestBlockInfoInMilliseconds) * time.Millisecond,
+		metrics:                       metrics,
 	}
 
 	mp := metaProcessor{
@@ -191,6 +197,18 @@ func (mp *metaProcessor) ProcessBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
+) error {
+	startTime := time.Now()
+	err := mp.processBlock(headerHandler, bodyHandler, haveTime)
+	mp.metrics.observeBlockProcessing(headerHandler, startTime, err)
+
+	return err
+}
+
+func (mp *metaProcessor) processBlock(
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
 ) error {
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -461,6 +479,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		}
 	}
 
+	err = mp.verifyEconomicsParameters(header)
+	if err != nil {
+		return err
+	}
+
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(body.MiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
 	if err != nil {
 		return err
@@ -843,6 +866,21 @@ func (mp *metaProcessor) updateEpochStartHeader(metaHdr *block.MetaBlock) error
 	return nil
 }
 
+// verifyEconomicsParameters checks that the reserved field of the epoch start meta block carries the economics
+// parameters changed through governance, as these are applied by all the shards from the epoch start meta block
+func (mp *metaProcessor) verifyEconomicsParameters(header *block.MetaBlock) error {
+	economicsParameters, err := mp.epochSystemSCProcessor.GetEconomicsParameters()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(economicsParameters, header.GetReserved()) {
+		return fmt.Errorf("%w, economics parameters mismatch, computed: %s, received: %s",
+			process.ErrReservedFieldInvalid, economicsParameters, header.GetReserved())
+	}
+
+	return nil
+}
+
 func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.BodyHandler, error) {
 	err := mp.createBlockStarted()
 	if err != nil {
@@ -893,6 +931,11 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 		}
 	}
 
+	metaBlock.Reserved, err = mp.epochSystemSCProcessor.GetEconomicsParameters()
+	if err != nil {
+		return nil, err
+	}
+
 	metaBlock.EpochStart.Economics.RewardsForProtocolSustainability.Set(mp.epochRewardsCreator.GetProtocolSustainabilityRewards())
 
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(rewardMiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
@@ -117,6 +117,7 @@ func createMockMetaArguments(
 
 	statusCoreComponents := &factory.StatusCoreComponentsStub{
 		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
+		MetricsRegistryField:  &testscommon.MetricsRegistryStub{},
 	}
 
 	arguments := blproc.ArgMetaProcessor{
@@ -3235,6 +3236,37 @@ func TestMetaProcessor_ProcessEpochStartMetaBlock(t *testing.T) {
 		err := mp.ProcessEpochStartMetaBlock(headerMeta, &block.Body{})
 		assert.Nil(t, err)
 	})
+	t.Run("economics parameters should be verified", func(t *testing.T) {
+		t.Parallel()
+
+		coreC, dataC, bootstrapC, statusC := createMockComponentHolders()
+		arguments := createMockMetaArguments(coreC, dataC, bootstrapC, statusC)
+		economicsParameters := []byte("economicsGasPriceModifier@0.5")
+		arguments.EpochSystemSCProcessor = &testscommon.EpochStartSystemSCStub{
+			GetEconomicsParametersCalled: func() ([]byte, error) {
+				return economicsParameters, nil
+			},
+		}
+
+		mp, _ := blproc.NewMetaProcessor(arguments)
+
+		header := &block.MetaBlock{
+			Nonce:           1,
+			Round:           1,
+			AccumulatedFees: big.NewInt(0),
+			DeveloperFees:   big.NewInt(0),
+		}
+		err := mp.ProcessEpochStartMetaBlock(header, &block.Body{})
+		assert.ErrorIs(t, err, process.ErrReservedFieldInvalid)
+
+		header.Reserved = []byte("economicsGasPriceModifier@0.25")
+		err = mp.ProcessEpochStartMetaBlock(header, &block.Body{})
+		assert.ErrorIs(t, err, process.ErrReservedFieldInvalid)
+
+		header.Reserved = economicsParameters
+		err = mp.ProcessEpochStartMetaBlock(header, &block.Body{})
+		assert.Nil(t, err)
+	})
 }
 
 func TestMetaProcessor_UpdateEpochStartHeader(t *testing.T) {
@@ -3433,12 +3465,17 @@ func TestMetaProcessor_CreateEpochStartBodyShouldWork(t *testing.T) {
 		}
 
 		wasCalled := false
+		economicsParameters := []byte("economicsGasPriceModifier@0.5")
 		arguments.EpochSystemSCProcessor = &testscommon.EpochStartSystemSCStub{
 			ProcessSystemSmartContractCalled: func(validatorsInfo state.ShardValidatorsInfoMapHandler, header data.HeaderHandler) error {
 				wasCalled = true
 				assert.Equal(t, mb, header)
 				return nil
 			},
+			GetEconomicsParametersCalled: func() ([]byte, error) {
+				assert.True(t, wasCalled)
+				return economicsParameters, nil
+			},
 		}
 
 		expectedRewardsForProtocolSustain := big.NewInt(11)
@@ -3474,6 +3511,7 @@ func TestMetaProcessor_CreateEpochStartBodyShouldWork(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, expectedBody, body)
 		assert.Equal(t, expectedRewardsForProtocolSustain, mb.EpochStart.Economics.GetRewardsForProtocolSustainability())
+		assert.Equal(t, economicsParameters, mb.Reserved)
 	})
 	t.Run("rewards V2 Not enabled", func(t *testing.T) {
 		t.Parallel()
@@ -3706,6 +3744,7 @@ func TestMetaProcessor_CrossChecksBlockHeightsMetrics(t *testing.T) {
 				savedMetrics[key] = value
 			},
 		},
+		MetricsRegistryField: &testscommon.MetricsRegistryStub{},
 	}
 	arguments.BootstrapComponents = &mock.BootstrapComponentsMock{
 		Coordinator:          mock.NewMultiShardsCoordinatorMock(3),
@@ -77,6 +77,11 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		return nil, err
 	}
 
+	metrics, err := newBlockProcessingMetrics(arguments.StatusCoreComponents.MetricsRegistry())
+	if err != nil {
+		return nil, err
+	}
+
 	base := &baseProcessor{
 		accountsDB:                    arguments.AccountsDB,
 		blockSizeThrottler:            arguments.BlockSizeThrottler,
@@ -124,6 +129,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		managedPeersHolder:            arguments.ManagedPeersHolder,
 		sentSignaturesTracker:         arguments.SentSignaturesTracker,
 		extraDelayRequestBlockInfo:    time.Duration(arguments.Config.EpochStartConfig.ExtraDelayForRequestBlockInfoInMilliseconds) * time.Millisecond,
+		metrics:                       metrics,
 	}
 
 	sp := shardProcessor{
@@ -161,6 +167,18 @@ func (sp *shardProcessor) ProcessBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
+) error {
+	startTime := time.Now()
+	err := sp.processBlock(headerHandler, bodyHandler, haveTime)
+	sp.metrics.observeBlockProcessing(headerHandler, startTime, err)
+
+	return err
+}
+
+func (sp *shardProcessor) processBlock(
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
 ) error {
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -44,6 +44,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/multiversx/mx-chain-go/testscommon/factory"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/outport"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
@@ -264,6 +265,37 @@ func TestShardProcessor_ProcessBlockWithNilHaveTimeFuncShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilHaveTimeHandler, err)
 }
 
+func TestShardProcessor_ProcessBlockShouldObserveTheProcessingDuration(t *testing.T) {
+	t.Parallel()
+
+	var observedLabels []string
+	metricsRegistry := &testscommon.MetricsRegistryStub{
+		RegisterHistogramCalled: func(name string, help string, buckets []float64, labelNames ...string) (common.HistogramMetric, error) {
+			if name != "erd_block_processing_duration_seconds" {
+				return &testscommon.MetricStub{}, nil
+			}
+
+			return &testscommon.MetricStub{
+				ObserveCalled: func(value float64, labelValues ...string) {
+					observedLabels = labelValues
+				},
+			}, nil
+		},
+	}
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.StatusCoreComponents = &factory.StatusCoreComponentsStub{
+		AppStatusHandlerField: &statusHandlerMock.AppStatusHandlerStub{},
+		MetricsRegistryField:  metricsRegistry,
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+	err := sp.ProcessBlock(&block.Header{ShardID: 1}, &block.Body{}, nil)
+
+	assert.Equal(t, process.ErrNilHaveTimeHandler, err)
+	assert.Equal(t, []string{"1", "error"}, observedLabels)
+}
+
 func TestShardProcess_CreateNewBlockHeaderProcessHeaderExpectCheckRoundCalled(t *testing.T) {
 	t.Parallel()
 
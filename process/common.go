@@ -947,3 +947,19 @@ func CheckIfIndexesAreOutOfBound(
 
 	return nil
 }
+
+// CheckReservedField returns an error if the header carries an unexpected reserved field. Only the epoch start
+// meta blocks can carry it, holding the economics parameters changed through governance, its content being verified
+// when the block is processed
+func CheckReservedField(hdr data.HeaderHandler) error {
+	if len(hdr.GetReserved()) == 0 {
+		return nil
+	}
+
+	metaBlock, ok := hdr.(*block.MetaBlock)
+	if !ok || !metaBlock.IsStartOfEpochBlock() || len(metaBlock.Reserved) > maxEpochStartReservedFieldLength {
+		return ErrReservedFieldInvalid
+	}
+
+	return nil
+}
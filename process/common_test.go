@@ -2362,3 +2362,25 @@ func TestShardedCacheSearchMethod_ToString(t *testing.T) {
 	str := process.ShardedCacheSearchMethod(166).ToString()
 	assert.Equal(t, "unknown method 166", str)
 }
+
+func TestCheckReservedField(t *testing.T) {
+	t.Parallel()
+
+	epochStartMetaBlock := &block.MetaBlock{
+		EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+	}
+	assert.Nil(t, process.CheckReservedField(epochStartMetaBlock))
+	assert.Nil(t, process.CheckReservedField(&block.Header{}))
+
+	epochStartMetaBlock.Reserved = []byte("economicsGasPriceModifier@0.5")
+	assert.Nil(t, process.CheckReservedField(epochStartMetaBlock))
+
+	epochStartMetaBlock.Reserved = make([]byte, 257)
+	assert.Equal(t, process.ErrReservedFieldInvalid, process.CheckReservedField(epochStartMetaBlock))
+
+	metaBlock := &block.MetaBlock{Reserved: []byte("r")}
+	assert.Equal(t, process.ErrReservedFieldInvalid, process.CheckReservedField(metaBlock))
+
+	header := &block.Header{Reserved: []byte("r")}
+	assert.Equal(t, process.ErrReservedFieldInvalid, process.CheckReservedField(header))
+}
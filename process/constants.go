@@ -148,3 +148,7 @@ const MaxHeadersToWhitelistInAdvance = 300
 // the real gas used, after which the transaction will be considered an attack and all the gas will be consumed and
 // nothing will be refunded to the sender
 const MaxGasFeeHigherFactorAccepted = 10
+
+// maxEpochStartReservedFieldLength defines the maximum length of the economics parameters carried by the reserved
+// field of an epoch start meta block
+const maxEpochStartReservedFieldLength = 256
@@ -5,6 +5,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -74,7 +76,7 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.InterceptedTransac
 		return nil
 	}
 
-	accountHandler, err := txv.getSenderAccount(interceptedTx)
+	accountHandler, err := txv.getAccount(interceptedTx.SenderAddress())
 	if err != nil {
 		return err
 	}
@@ -91,37 +93,64 @@ func (txv *txValidator) checkAccount(
 		return err
 	}
 
-	account, err := txv.getSenderUserAccount(interceptedTx, accountHandler)
+	// the relayer of a relayed tx v3 pays the fee instead of the sender
+	relayedTx, ok := interceptedTx.Transaction().(data.RelayedTransactionHandler)
+	if ok && common.IsRelayedTxV3(interceptedTx.Transaction()) {
+		return txv.checkRelayer(interceptedTx, relayedTx.GetRelayerAddr())
+	}
+
+	account, err := txv.getUserAccount(interceptedTx.SenderAddress(), accountHandler)
 	if err != nil {
 		return err
 	}
 
-	return txv.checkBalance(interceptedTx, account)
+	return txv.checkBalance(interceptedTx, interceptedTx.SenderAddress(), account)
 }
 
-func (txv *txValidator) getSenderUserAccount(
-	interceptedTx process.InterceptedTransactionHandler,
+func (txv *txValidator) checkRelayer(interceptedTx process.InterceptedTransactionHandler, relayerAddress []byte) error {
+	accountHandler, err := txv.getAccount(relayerAddress)
+	if err != nil {
+		return err
+	}
+
+	account, err := txv.getUserAccount(relayerAddress, accountHandler)
+	if err != nil {
+		return err
+	}
+
+	// a guarded account can not co-sign as relayer, so it is not allowed to pay fees for others
+	if account.IsGuarded() {
+		return process.ErrGuardedRelayerNotAllowed
+	}
+
+	return txv.checkBalance(interceptedTx, relayerAddress, account)
+}
+
+func (txv *txValidator) getUserAccount(
+	address []byte,
 	accountHandler vmcommon.AccountHandler,
 ) (state.UserAccountHandler, error) {
-	senderAddress := interceptedTx.SenderAddress()
 	account, ok := accountHandler.(state.UserAccountHandler)
 	if !ok {
 		return nil, fmt.Errorf("%w, account is not of type *state.Account, address: %s",
 			process.ErrWrongTypeAssertion,
-			txv.pubKeyConverter.SilentEncode(senderAddress, log),
+			txv.pubKeyConverter.SilentEncode(address, log),
 		)
 	}
 	return account, nil
 }
 
-func (txv *txValidator) checkBalance(interceptedTx process.InterceptedTransactionHandler, account state.UserAccountHandler) error {
+func (txv *txValidator) checkBalance(
+	interceptedTx process.InterceptedTransactionHandler,
+	feePayerAddress []byte,
+	account state.UserAccountHandler,
+) error {
 	accountBalance := account.GetBalance()
 	txFee := interceptedTx.Fee()
 	if accountBalance.Cmp(txFee) < 0 {
-		senderAddress := interceptedTx.SenderAddress()
 		return fmt.Errorf("%w, for address: %s, wanted %v, have %v",
 			process.ErrInsufficientFunds,
-			txv.pubKeyConverter.SilentEncode(senderAddress, log),
+			txv.pubKeyConverter.SilentEncode(feePayerAddress, log),
 			txFee,
 			accountBalance,
 		)
@@ -151,13 +180,12 @@ func (txv *txValidator) isSenderInDifferentShard(interceptedTx process.Intercept
 	return shardID != txShardID
 }
 
-func (txv *txValidator) getSenderAccount(interceptedTx process.InterceptedTransactionHandler) (vmcommon.AccountHandler, error) {
-	senderAddress := interceptedTx.SenderAddress()
-	accountHandler, err := txv.accounts.GetExistingAccount(senderAddress)
+func (txv *txValidator) getAccount(address []byte) (vmcommon.AccountHandler, error) {
+	accountHandler, err := txv.accounts.GetExistingAccount(address)
 	if err != nil {
 		return nil, fmt.Errorf("%w for address %s and shard %d, err: %s",
 			process.ErrAccountNotFound,
-			txv.pubKeyConverter.SilentEncode(senderAddress, log),
+			txv.pubKeyConverter.SilentEncode(address, log),
 			txv.shardCoordinator.SelfId(),
 			err.Error(),
 		)
@@ -1,6 +1,7 @@
 package dataValidators_test
 
 import (
+	"encoding/hex"
 	"errors"
 	"math/big"
 	"strconv"
@@ -412,6 +413,89 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestTxValidator_CheckTxValidityRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	senderAddress := []byte("sender")
+	relayerAddress := []byte("relayer")
+	createValidator := func(relayer *stateMock.UserAccountStub) process.TxValidator {
+		adb := &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				if string(address) == string(relayerAddress) {
+					if relayer == nil {
+						return nil, errors.New("account not found")
+					}
+
+					return relayer, nil
+				}
+
+				// the sender has no balance, the fee is paid by the relayer
+				return &stateMock.UserAccountStub{Balance: big.NewInt(0)}, nil
+			},
+		}
+		txValidator, _ := dataValidators.NewTxValidator(
+			adb,
+			createMockCoordinator("_", 0),
+			&testscommon.WhiteListHandlerStub{},
+			testscommon.NewPubkeyConverterMock(32),
+			&testscommon.TxVersionCheckerStub{},
+			100,
+		)
+
+		return txValidator
+	}
+	createInterceptedTx := func() *mock.InterceptedTxHandlerStub {
+		interceptedTx := getDefaultInterceptedTx()
+		interceptedTx.SenderAddressCalled = func() []byte {
+			return senderAddress
+		}
+		interceptedTx.FeeCalled = func() *big.Int {
+			return big.NewInt(10)
+		}
+		interceptedTx.TransactionCalled = func() data.TransactionHandler {
+			return &transaction.Transaction{
+				SndAddr:     senderAddress,
+				RelayerAddr: relayerAddress,
+			}
+		}
+
+		return interceptedTx
+	}
+
+	t.Run("relayer with enough balance should work", func(t *testing.T) {
+		t.Parallel()
+
+		txValidator := createValidator(&stateMock.UserAccountStub{Balance: big.NewInt(10)})
+		assert.Nil(t, txValidator.CheckTxValidity(createInterceptedTx()))
+	})
+	t.Run("relayer with insufficient balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		txValidator := createValidator(&stateMock.UserAccountStub{Balance: big.NewInt(9)})
+		err := txValidator.CheckTxValidity(createInterceptedTx())
+		assert.ErrorIs(t, err, process.ErrInsufficientFunds)
+		assert.Contains(t, err.Error(), hex.EncodeToString(relayerAddress))
+	})
+	t.Run("guarded relayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		txValidator := createValidator(&stateMock.UserAccountStub{
+			Balance: big.NewInt(10),
+			IsGuardedCalled: func() bool {
+				return true
+			},
+		})
+		assert.Equal(t, process.ErrGuardedRelayerNotAllowed, txValidator.CheckTxValidity(createInterceptedTx()))
+	})
+	t.Run("missing relayer account should error", func(t *testing.T) {
+		t.Parallel()
+
+		txValidator := createValidator(nil)
+		err := txValidator.CheckTxValidity(createInterceptedTx())
+		assert.ErrorIs(t, err, process.ErrAccountNotFound)
+	})
+}
+
 func Test_getTxData(t *testing.T) {
 	t.Run("nil tx in intercepted tx returns error", func(t *testing.T) {
 		interceptedTx := getDefaultInterceptedTx()
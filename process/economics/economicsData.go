@@ -1,8 +1,11 @@
 package economics
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -12,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/statusHandler"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -20,9 +24,15 @@ import (
 var _ process.EconomicsDataHandler = (*economicsData)(nil)
 var _ process.RewardsHandler = (*economicsData)(nil)
 var _ process.FeeHandler = (*economicsData)(nil)
+var _ epochStart.ActionHandler = (*economicsData)(nil)
 
 var log = logger.GetOrCreate("process/economics")
 
+type gasPriceModifierSetting struct {
+	epoch            uint32
+	gasPriceModifier float64
+}
+
 // economicsData will store information about economics
 type economicsData struct {
 	*gasConfigHandler
@@ -35,6 +45,10 @@ type economicsData struct {
 	enableEpochsHandler common.EnableEpochsHandler
 	txVersionHandler    process.TxVersionCheckerHandler
 	mut                 sync.RWMutex
+
+	// gas price modifiers changed through governance, sorted by the epoch they were received in
+	governanceGasPriceModifiers    []*gasPriceModifierSetting
+	mutGovernanceGasPriceModifiers sync.RWMutex
 }
 
 // ArgsNewEconomicsData defines the arguments needed for new economics economicsData
@@ -59,6 +73,7 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.GasPriceModifierFlag,
 		common.PenalizedTooMuchGasFlag,
+		common.RelayedTransactionsV3Flag,
 	})
 	if err != nil {
 		return nil, err
@@ -194,7 +209,18 @@ func (ed *economicsData) GasPriceModifierInEpoch(epoch uint32) float64 {
 	if !ed.enableEpochsHandler.IsFlagEnabledInEpoch(common.GasPriceModifierFlag, epoch) {
 		return 1.0
 	}
-	return ed.gasPriceModifier
+
+	ed.mutGovernanceGasPriceModifiers.RLock()
+	defer ed.mutGovernanceGasPriceModifiers.RUnlock()
+
+	gasPriceModifier := ed.gasPriceModifier
+	for _, setting := range ed.governanceGasPriceModifiers {
+		if epoch >= setting.epoch {
+			gasPriceModifier = setting.gasPriceModifier
+		}
+	}
+
+	return gasPriceModifier
 }
 
 // MinGasLimit returns min gas limit
@@ -499,6 +525,12 @@ func (ed *economicsData) ComputeGasLimitInEpoch(tx data.TransactionWithFeeHandle
 		gasLimit += ed.getExtraGasLimitGuardedTx(epoch)
 	}
 
+	// the relayer signature of a relayed tx v3 costs as much as an extra move balance
+	isRelayedTxV3Enabled := ed.enableEpochsHandler.IsFlagEnabledInEpoch(common.RelayedTransactionsV3Flag, epoch)
+	if ok && isRelayedTxV3Enabled && common.IsRelayedTxV3(txInstance) {
+		gasLimit += ed.getMinGasLimit(epoch)
+	}
+
 	return gasLimit
 }
 
@@ -569,6 +601,75 @@ func (ed *economicsData) EpochConfirmed(epoch uint32, _ uint64) {
 	ed.updateGasConfigMetrics(epoch)
 }
 
+// EpochStartPrepare applies the economics parameters changed through governance, carried by the reserved field of
+// the epoch start meta block, starting with the epoch of the meta block
+func (ed *economicsData) EpochStartPrepare(metaHdr data.HeaderHandler, _ data.BodyHandler) {
+	if check.IfNil(metaHdr) {
+		return
+	}
+
+	gasPriceModifier, found := parseGasPriceModifier(metaHdr.GetReserved())
+	if !found {
+		return
+	}
+
+	ed.setGovernanceGasPriceModifier(metaHdr.GetEpoch(), gasPriceModifier)
+}
+
+func (ed *economicsData) setGovernanceGasPriceModifier(epoch uint32, gasPriceModifier float64) {
+	ed.mutGovernanceGasPriceModifiers.Lock()
+	defer ed.mutGovernanceGasPriceModifiers.Unlock()
+
+	for _, setting := range ed.governanceGasPriceModifiers {
+		if setting.epoch == epoch {
+			setting.gasPriceModifier = gasPriceModifier
+			return
+		}
+	}
+
+	ed.governanceGasPriceModifiers = append(ed.governanceGasPriceModifiers, &gasPriceModifierSetting{
+		epoch:            epoch,
+		gasPriceModifier: gasPriceModifier,
+	})
+	sort.Slice(ed.governanceGasPriceModifiers, func(i, j int) bool {
+		return ed.governanceGasPriceModifiers[i].epoch < ed.governanceGasPriceModifiers[j].epoch
+	})
+
+	log.Debug("economicsData: gas price modifier changed through governance", "epoch", epoch, "gas price modifier", gasPriceModifier)
+}
+
+func parseGasPriceModifier(economicsParameters []byte) (float64, bool) {
+	if len(economicsParameters) == 0 {
+		return 0, false
+	}
+
+	pairs := bytes.Split(economicsParameters, []byte(common.EconomicsParametersSeparator))
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if string(pairs[i]) != common.GasPriceModifierParameter {
+			continue
+		}
+
+		gasPriceModifier, err := strconv.ParseFloat(string(pairs[i+1]), 64)
+		if err != nil || !(gasPriceModifier >= epsilon && gasPriceModifier <= 1.0) {
+			log.Warn("economicsData: invalid gas price modifier in the epoch start meta block", "value", string(pairs[i+1]))
+			return 0, false
+		}
+
+		return gasPriceModifier, true
+	}
+
+	return 0, false
+}
+
+// EpochStartAction does nothing, as the economics parameters are applied when preparing for the new epoch
+func (ed *economicsData) EpochStartAction(_ data.HeaderHandler) {
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (ed *economicsData) NotifyOrder() uint32 {
+	return common.EconomicsDataOrder
+}
+
 // ComputeGasLimitBasedOnBalance will compute gas limit for the given transaction based on the balance
 func (ed *economicsData) ComputeGasLimitBasedOnBalance(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error) {
 	currentEpoch := ed.enableEpochsHandler.GetCurrentEpoch()
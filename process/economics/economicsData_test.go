@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
@@ -366,6 +367,40 @@ func TestNewEconomicsData_InvalidGasPriceModifierShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrInvalidGasModifier, err)
 }
 
+func TestEconomicsData_EpochStartPrepareGovernanceGasPriceModifier(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(0.01)
+	economicsData, _ := economics.NewEconomicsData(args)
+	assert.Equal(t, uint32(common.EconomicsDataOrder), economicsData.NotifyOrder())
+
+	economicsData.EpochStartPrepare(&block.MetaBlock{Epoch: 5}, &block.Body{})
+	assert.Equal(t, 0.01, economicsData.GasPriceModifierInEpoch(5))
+
+	invalidValues := []string{"0", "1.5", "NaN", "abc", ""}
+	for _, value := range invalidValues {
+		economicsData.EpochStartPrepare(&block.MetaBlock{Epoch: 5, Reserved: []byte("economicsGasPriceModifier@" + value)}, &block.Body{})
+		assert.Equal(t, 0.01, economicsData.GasPriceModifierInEpoch(5))
+	}
+	economicsData.EpochStartPrepare(&block.MetaBlock{Epoch: 5, Reserved: []byte("economicsGasPriceModifier")}, &block.Body{})
+	assert.Equal(t, 0.01, economicsData.GasPriceModifierInEpoch(5))
+
+	economicsData.EpochStartPrepare(&block.MetaBlock{Epoch: 10, Reserved: []byte("economicsGasPriceModifier@0.5")}, &block.Body{})
+	economicsData.EpochStartPrepare(&block.MetaBlock{Epoch: 20, Reserved: []byte("economicsGasPriceModifier@0.25")}, &block.Body{})
+	assert.Equal(t, 0.01, economicsData.GasPriceModifierInEpoch(9))
+	assert.Equal(t, 0.5, economicsData.GasPriceModifierInEpoch(10))
+	assert.Equal(t, 0.5, economicsData.GasPriceModifierInEpoch(19))
+	assert.Equal(t, 0.25, economicsData.GasPriceModifierInEpoch(20))
+	assert.Equal(t, 0.25, economicsData.GasPriceModifierInEpoch(100))
+
+	// a new epoch start meta block for the same epoch replaces the previous value
+	economicsData.EpochStartPrepare(&block.MetaBlock{Epoch: 10, Reserved: []byte("economicsGasPriceModifier@0.75")}, &block.Body{})
+	assert.Equal(t, 0.75, economicsData.GasPriceModifierInEpoch(10))
+
+	tx := &transaction.Transaction{GasPrice: 1000}
+	assert.Equal(t, uint64(750), economicsData.GasPriceForProcessingInEpoch(tx, 10))
+}
+
 func TestNewEconomicsData_InvalidExtraGasLimitGuardedTxShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1034,6 +1069,36 @@ func TestEconomicsData_MoveBalanceNoData(t *testing.T) {
 	require.Equal(t, big.NewInt(500000000000), fee)
 }
 
+func TestEconomicsData_ComputeGasLimitRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		GasPrice:    1000000000,
+		GasLimit:    1000,
+		RelayerAddr: []byte("relayer"),
+	}
+
+	t.Run("flag not active should not add the relayer cost", func(t *testing.T) {
+		t.Parallel()
+
+		economicData, _ := economics.NewEconomicsData(createArgsForEconomicsData(1))
+		require.Equal(t, uint64(500), economicData.ComputeGasLimit(tx))
+	})
+	t.Run("flag active should add a move balance cost for the relayer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsForEconomicsData(1)
+		args.EnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+				return flag == common.GasPriceModifierFlag || flag == common.RelayedTransactionsV3Flag
+			},
+		}
+		economicData, _ := economics.NewEconomicsData(args)
+		require.Equal(t, uint64(1000), economicData.ComputeGasLimit(tx))
+		require.Equal(t, uint64(500), economicData.ComputeGasLimit(&transaction.Transaction{GasLimit: 1000}))
+	})
+}
+
 func TestEconomicsData_MoveBalanceWithData(t *testing.T) {
 	t.Parallel()
 
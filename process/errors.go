@@ -921,6 +921,27 @@ var ErrRelayedTxV2Disabled = errors.New("relayed tx v2 is disabled")
 // ErrRelayedTxV2ZeroVal signals that the v2 version of relayed tx should be created with 0 as value
 var ErrRelayedTxV2ZeroVal = errors.New("relayed tx v2 value should be 0")
 
+// ErrRelayedTxV3Disabled signals that the v3 version of relayed tx is disabled
+var ErrRelayedTxV3Disabled = errors.New("relayed tx v3 is disabled")
+
+// ErrInvalidRelayerAddress signals that an invalid relayer address was provided
+var ErrInvalidRelayerAddress = errors.New("invalid relayer address")
+
+// ErrNilRelayerSignature signals that a relayed tx v3 without relayer signature was provided
+var ErrNilRelayerSignature = errors.New("nil relayer signature")
+
+// ErrRelayerSignatureNotExpected signals that the relayer signature is not expected
+var ErrRelayerSignatureNotExpected = errors.New("relayer signature not expected")
+
+// ErrRelayedTxV3SenderShardMismatch signals that the relayer and the sender of a relayed tx v3 are not in the same shard
+var ErrRelayedTxV3SenderShardMismatch = errors.New("relayer and sender of relayed tx v3 are not in the same shard")
+
+// ErrMultipleRelayedTxTypesIsNotAllowed signals that a relayed tx v3 carrying an older relayed tx is not allowed
+var ErrMultipleRelayedTxTypesIsNotAllowed = errors.New("multiple relayed tx types are not allowed")
+
+// ErrGuardedRelayerNotAllowed signals that a guarded account is not allowed to act as relayer
+var ErrGuardedRelayerNotAllowed = errors.New("guarded relayer is not allowed")
+
 // ErrEmptyConsensusGroup is raised when an operation is attempted with an empty consensus group
 var ErrEmptyConsensusGroup = errors.New("consensusGroup is empty")
 
@@ -1229,3 +1250,36 @@ var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
 
 // ErrTransferAndExecuteByUserAddressesAreNil signals that transfer and execute by user addresses are nil
 var ErrTransferAndExecuteByUserAddressesAreNil = errors.New("transfer and execute by user addresses are nil")
+
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrInvalidPeerAccessListEntry signals that an invalid entry was found in a peer access list
+var ErrInvalidPeerAccessListEntry = errors.New("invalid peer access list entry")
+
+// ErrNilNodeLoadProvider signals that a nil node load provider has been provided
+var ErrNilNodeLoadProvider = errors.New("nil node load provider")
+
+// ErrNilFloodPreventer signals that a nil flood preventer has been provided
+var ErrNilFloodPreventer = errors.New("nil flood preventer")
+
+// ErrNilMessageTracer signals that a nil message tracer has been provided
+var ErrNilMessageTracer = errors.New("nil message tracer")
+
+// ErrNilMessageMetrics signals that a nil message metrics handler has been provided
+var ErrNilMessageMetrics = errors.New("nil message metrics handler")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry has been provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
+
+// ErrStateOverridesNotSupported signals that the state overrides are not supported by the current accounts adapter
+var ErrStateOverridesNotSupported = errors.New("state overrides are not supported")
+
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
+
+// ErrHistoricalStateNotSupported signals that the historical state is not supported by the current accounts adapter
+var ErrHistoricalStateNotSupported = errors.New("historical state is not supported")
+
+// ErrLocalMintAllowanceExceeded signals that the local mint value is higher than the mint allowance of the account
+var ErrLocalMintAllowanceExceeded = errors.New("local mint allowance exceeded")
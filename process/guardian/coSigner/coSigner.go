@@ -0,0 +1,549 @@
+package coSigner
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
+)
+
+var log = logger.GetOrCreate("process/guardian/cosigner")
+
+const (
+	signedMessagePrefix         = "\x17Elrond Signed Message:\n"
+	registrationMessageTemplate = "%s:register:%s:%s"
+	registrationChallengeLength = 16
+)
+
+// ArgsCoSigner holds the arguments needed to create a new guardian co-signer
+type ArgsCoSigner struct {
+	Config           config.GuardianCoSignerConfig
+	GuardianKey      crypto.PrivateKey
+	KeyGenerator     crypto.KeyGenerator
+	SingleSigner     crypto.SingleSigner
+	PubKeyConverter  core.PubkeyConverter
+	TxMarshaller     marshal.Marshalizer
+	TxSignHasher     hashing.Hasher
+	TxVersionChecker process.TxVersionCheckerHandler
+	DataFieldParser  DataFieldParser
+	ShardCoordinator sharding.Coordinator
+	Storer           storage.Storer
+	Marshaller       marshal.Marshalizer
+}
+
+type guardedAccountRecord struct {
+	Secret            []byte              `json:"secret"`
+	Confirmed         bool                `json:"confirmed"`
+	LastUsedTimeStep  uint64              `json:"lastUsedTimeStep"`
+	NumFailedAttempts uint32              `json:"numFailedAttempts"`
+	LockedUntil       int64               `json:"lockedUntil"`
+	Policy            *spendingPolicy     `json:"policy"`
+	SpendingDay       int64               `json:"spendingDay"`
+	SpentAmounts      map[string]*big.Int `json:"spentAmounts"`
+}
+
+type registrationChallenge struct {
+	value     string
+	expiresAt int64
+}
+
+type coSigner struct {
+	mut                        sync.Mutex
+	config                     config.GuardianCoSignerConfig
+	guardianKey                crypto.PrivateKey
+	guardianAddress            []byte
+	keyGenerator               crypto.KeyGenerator
+	singleSigner               crypto.SingleSigner
+	pubKeyConverter            core.PubkeyConverter
+	txMarshaller               marshal.Marshalizer
+	txSignHasher               hashing.Hasher
+	txVersionChecker           process.TxVersionCheckerHandler
+	dataFieldParser            DataFieldParser
+	shardCoordinator           sharding.Coordinator
+	storer                     storage.Storer
+	marshaller                 marshal.Marshalizer
+	messageSigningHasher       hashing.Hasher
+	failedAttemptsLockDuration time.Duration
+	challengeExpiry            time.Duration
+	challenges                 map[string]*registrationChallenge
+	getTimeHandler             func() time.Time
+}
+
+// NewCoSigner creates a new guardian co-signer. The co-signer keeps, for each registered account, the secret used
+// to verify the time-based one-time codes and the spending policy checked before co-signing a transaction
+func NewCoSigner(args ArgsCoSigner) (*coSigner, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	guardianAddress, err := args.GuardianKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &coSigner{
+		config:                     args.Config,
+		guardianKey:                args.GuardianKey,
+		guardianAddress:            guardianAddress,
+		keyGenerator:               args.KeyGenerator,
+		singleSigner:               args.SingleSigner,
+		pubKeyConverter:            args.PubKeyConverter,
+		txMarshaller:               args.TxMarshaller,
+		txSignHasher:               args.TxSignHasher,
+		txVersionChecker:           args.TxVersionChecker,
+		dataFieldParser:            args.DataFieldParser,
+		shardCoordinator:           args.ShardCoordinator,
+		storer:                     args.Storer,
+		marshaller:                 args.Marshaller,
+		messageSigningHasher:       keccak.NewKeccak(),
+		failedAttemptsLockDuration: time.Duration(args.Config.FailedCodeAttemptsLockInSecs) * time.Second,
+		challengeExpiry:            time.Duration(args.Config.RegistrationChallengeExpiryInSecs) * time.Second,
+		challenges:                 make(map[string]*registrationChallenge),
+		getTimeHandler:             time.Now,
+	}
+
+	log.Debug("guardian co-signer created",
+		"service UID", args.Config.ServiceUID,
+		"guardian address", args.PubKeyConverter.SilentEncode(guardianAddress, log),
+	)
+
+	return cs, nil
+}
+
+func checkArgs(args ArgsCoSigner) error {
+	if check.IfNil(args.GuardianKey) {
+		return ErrNilGuardianKey
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return process.ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return process.ErrNilSingleSigner
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.TxMarshaller) {
+		return fmt.Errorf("%w for the transaction marshaller", process.ErrNilMarshalizer)
+	}
+	if check.IfNil(args.TxSignHasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(args.TxVersionChecker) {
+		return process.ErrNilTransactionVersionChecker
+	}
+	if args.DataFieldParser == nil {
+		return ErrNilDataFieldParser
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Storer) {
+		return process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if len(args.Config.ServiceUID) == 0 {
+		return ErrEmptyServiceUID
+	}
+	if args.Config.OTPPeriodInSeconds == 0 {
+		return fmt.Errorf("%w for OTPPeriodInSeconds", process.ErrInvalidValue)
+	}
+	if args.Config.MaxFailedCodeAttempts == 0 {
+		return fmt.Errorf("%w for MaxFailedCodeAttempts", process.ErrInvalidValue)
+	}
+	if args.Config.RegistrationChallengeExpiryInSecs == 0 {
+		return fmt.Errorf("%w for RegistrationChallengeExpiryInSecs", process.ErrInvalidValue)
+	}
+	if args.Config.MaxPendingRegistrationChallenges == 0 {
+		return fmt.Errorf("%w for MaxPendingRegistrationChallenges", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// GetRegistrationChallenge issues a new registration challenge for the provided address, replacing the previous one.
+// The challenge can be used for a single registration and only until it expires
+func (cs *coSigner) GetRegistrationChallenge(address []byte) (*common.GuardianRegistrationChallenge, error) {
+	addressString, err := cs.pubKeyConverter.Encode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, registrationChallengeLength)
+	_, err = rand.Read(value)
+	if err != nil {
+		return nil, err
+	}
+
+	cs.mut.Lock()
+	defer cs.mut.Unlock()
+
+	now := cs.getTimeHandler().Unix()
+	cs.removeExpiredChallengesNoLock(now)
+	_, exists := cs.challenges[string(address)]
+	if !exists && len(cs.challenges) >= int(cs.config.MaxPendingRegistrationChallenges) {
+		return nil, ErrTooManyPendingChallenges
+	}
+
+	challenge := &registrationChallenge{
+		value:     hex.EncodeToString(value),
+		expiresAt: now + int64(cs.challengeExpiry.Seconds()),
+	}
+	cs.challenges[string(address)] = challenge
+
+	return &common.GuardianRegistrationChallenge{
+		Challenge: challenge.value,
+		Message:   fmt.Sprintf(registrationMessageTemplate, cs.config.ServiceUID, addressString, challenge.value),
+		ExpiresAt: challenge.expiresAt,
+	}, nil
+}
+
+func (cs *coSigner) removeExpiredChallengesNoLock(now int64) {
+	for address, challenge := range cs.challenges {
+		if now >= challenge.expiresAt {
+			delete(cs.challenges, address)
+		}
+	}
+}
+
+// RegisterAccount registers the provided address and returns the data needed to configure an authenticator app.
+// The signature should be a signed message (same format as the wallets use) over the message returned by
+// GetRegistrationChallenge, "<service UID>:register:<address>:<challenge>". The challenge is consumed on success,
+// so each registration, including the one replacing a not yet confirmed registration, needs a freshly signed
+// challenge. An account can register again only if it did not confirm the previous registration
+func (cs *coSigner) RegisterAccount(address []byte, signature []byte) (*common.GuardianRegistration, error) {
+	addressString, err := cs.pubKeyConverter.Encode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	cs.mut.Lock()
+	defer cs.mut.Unlock()
+
+	challenge, ok := cs.challenges[string(address)]
+	if !ok || cs.getTimeHandler().Unix() >= challenge.expiresAt {
+		return nil, ErrRegistrationChallengeNotFound
+	}
+
+	err = cs.verifyRegistrationSignature(address, addressString, challenge.value, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := cs.loadRecord(address)
+	if err == nil && record.Confirmed {
+		return nil, ErrAccountAlreadyRegistered
+	}
+
+	secret, err := generateOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cs.saveRecord(address, &guardedAccountRecord{
+		Secret: secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	delete(cs.challenges, string(address))
+
+	log.Debug("guardian co-signer: account registered", "address", addressString)
+
+	return &common.GuardianRegistration{
+		GuardianAddress:    cs.pubKeyConverter.SilentEncode(cs.guardianAddress, log),
+		ServiceUID:         cs.config.ServiceUID,
+		OTPSecret:          otpSecretEncoding.EncodeToString(secret),
+		OTPProvisioningURI: createProvisioningURI(cs.config.OTPIssuer, addressString, secret, cs.config.OTPPeriodInSeconds),
+	}, nil
+}
+
+func (cs *coSigner) verifyRegistrationSignature(address []byte, addressString string, challenge string, signature []byte) error {
+	publicKey, err := cs.keyGenerator.PublicKeyFromByteArray(address)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf(registrationMessageTemplate, cs.config.ServiceUID, addressString, challenge)
+	payload := fmt.Sprintf("%s%d%s", signedMessagePrefix, len(message), message)
+	hash := cs.messageSigningHasher.Compute(payload)
+
+	err = cs.singleSigner.Verify(publicKey, hash, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRegistrationSignature, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyCode confirms the registration of the account if the provided code is valid
+func (cs *coSigner) VerifyCode(address []byte, code string) error {
+	cs.mut.Lock()
+	defer cs.mut.Unlock()
+
+	record, err := cs.loadRecord(address)
+	if err != nil {
+		return err
+	}
+
+	err = cs.verifyCodeForRecord(address, record, code)
+	if err != nil {
+		return err
+	}
+
+	record.Confirmed = true
+
+	return cs.saveRecord(address, record)
+}
+
+// SetSpendingPolicy replaces the spending policy of a confirmed account if the provided code is valid
+func (cs *coSigner) SetSpendingPolicy(address []byte, code string, policy *common.GuardianSpendingPolicy) error {
+	newPolicy, err := newSpendingPolicy(policy, cs.pubKeyConverter)
+	if err != nil {
+		return err
+	}
+
+	cs.mut.Lock()
+	defer cs.mut.Unlock()
+
+	record, err := cs.loadConfirmedRecord(address)
+	if err != nil {
+		return err
+	}
+
+	err = cs.verifyCodeForRecord(address, record, code)
+	if err != nil {
+		return err
+	}
+
+	record.Policy = newPolicy
+
+	return cs.saveRecord(address, record)
+}
+
+// CoSignTransaction checks the provided code and the spending policy of the sender and, if everything is fine,
+// returns the guardian signature of the transaction. The transaction should already be signed by its sender
+func (cs *coSigner) CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error) {
+	if tx == nil {
+		return nil, process.ErrNilTransaction
+	}
+	if !cs.txVersionChecker.IsGuardedTransaction(tx) {
+		return nil, ErrNotGuardedTransaction
+	}
+	if !bytes.Equal(tx.GuardianAddr, cs.guardianAddress) {
+		return nil, ErrGuardianAddressMismatch
+	}
+
+	parsedData := cs.dataFieldParser.Parse(tx.Data, tx.SndAddr, tx.RcvAddr, cs.shardCoordinator.NumberOfShards())
+	if parsedData == nil {
+		parsedData = &datafield.ResponseParseData{}
+	}
+	if parsedData.IsRelayed {
+		return nil, ErrRelayedTransactionNotSupported
+	}
+
+	transfers, receivers, err := extractTransfers(tx, parsedData)
+	if err != nil {
+		return nil, err
+	}
+
+	dataToSign, err := tx.GetDataForSigning(cs.pubKeyConverter, cs.txMarshaller, cs.txSignHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	err = cs.verifySenderSignature(tx, dataToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	cs.mut.Lock()
+	defer cs.mut.Unlock()
+
+	record, err := cs.loadConfirmedRecord(tx.SndAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the code is verified first so the policy limits can not be probed without it
+	err = cs.verifyCodeForRecord(tx.SndAddr, record, code)
+	if err != nil {
+		return nil, err
+	}
+
+	spentAmounts, err := cs.checkSpendingPolicy(record, tx.SndAddr, transfers, receivers)
+	if err != nil {
+		cs.saveUsedCode(tx.SndAddr, record)
+		return nil, err
+	}
+
+	guardianSignature, err := cs.singleSigner.Sign(cs.guardianKey, dataToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	record.SpendingDay = computeDay(cs.getTimeHandler().Unix())
+	record.SpentAmounts = spentAmounts
+	err = cs.saveRecord(tx.SndAddr, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return guardianSignature, nil
+}
+
+func (cs *coSigner) verifySenderSignature(tx *transaction.Transaction, dataToSign []byte) error {
+	senderPublicKey, err := cs.keyGenerator.PublicKeyFromByteArray(tx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	err = cs.singleSigner.Verify(senderPublicKey, dataToSign, tx.Signature)
+	if err != nil {
+		return fmt.Errorf("%w when checking the sender's signature", err)
+	}
+
+	return nil
+}
+
+func (cs *coSigner) checkSpendingPolicy(
+	record *guardedAccountRecord,
+	sender []byte,
+	transfers []*tokenTransfer,
+	receivers [][]byte,
+) (map[string]*big.Int, error) {
+	if record.Policy == nil {
+		return record.SpentAmounts, nil
+	}
+
+	err := record.Policy.checkReceivers(sender, receivers)
+	if err != nil {
+		return nil, err
+	}
+
+	alreadySpent := record.SpentAmounts
+	if record.SpendingDay != computeDay(cs.getTimeHandler().Unix()) {
+		alreadySpent = nil
+	}
+
+	return record.Policy.computeSpentAmounts(alreadySpent, transfers)
+}
+
+// verifyCodeForRecord checks the code against the current time step and the allowed skew periods. A code can be
+// used only once and too many consecutive invalid codes will temporarily lock the account. The record is saved on
+// failure, while on success the caller is responsible for saving it
+func (cs *coSigner) verifyCodeForRecord(address []byte, record *guardedAccountRecord, code string) error {
+	now := cs.getTimeHandler()
+	if now.Unix() < record.LockedUntil {
+		return ErrAccountLocked
+	}
+
+	currentTimeStep := computeTimeStep(now.Unix(), cs.config.OTPPeriodInSeconds)
+	skew := uint64(cs.config.OTPAllowedSkewPeriods)
+	firstTimeStep := uint64(0)
+	if currentTimeStep > skew {
+		firstTimeStep = currentTimeStep - skew
+	}
+
+	for timeStep := firstTimeStep; timeStep <= currentTimeStep+skew; timeStep++ {
+		if timeStep <= record.LastUsedTimeStep {
+			continue
+		}
+
+		expectedCode := computeOTPCodeForTimeStep(record.Secret, timeStep)
+		if subtle.ConstantTimeCompare([]byte(expectedCode), []byte(code)) == 1 {
+			record.LastUsedTimeStep = timeStep
+			record.NumFailedAttempts = 0
+			return nil
+		}
+	}
+
+	record.NumFailedAttempts++
+	if record.NumFailedAttempts >= cs.config.MaxFailedCodeAttempts {
+		record.NumFailedAttempts = 0
+		record.LockedUntil = now.Add(cs.failedAttemptsLockDuration).Unix()
+	}
+
+	err := cs.saveRecord(address, record)
+	if err != nil {
+		log.Warn("guardian co-signer: can not save the failed attempt", "error", err)
+	}
+
+	return ErrInvalidCode
+}
+
+// saveUsedCode persists the consumed time step so a code accepted for a rejected transaction can not be reused
+func (cs *coSigner) saveUsedCode(address []byte, record *guardedAccountRecord) {
+	err := cs.saveRecord(address, record)
+	if err != nil {
+		log.Warn("guardian co-signer: can not save the used code", "error", err)
+	}
+}
+
+func (cs *coSigner) loadConfirmedRecord(address []byte) (*guardedAccountRecord, error) {
+	record, err := cs.loadRecord(address)
+	if err != nil {
+		return nil, err
+	}
+	if !record.Confirmed {
+		return nil, ErrRegistrationNotConfirmed
+	}
+
+	return record, nil
+}
+
+func (cs *coSigner) loadRecord(address []byte) (*guardedAccountRecord, error) {
+	buff, err := cs.storer.Get(address)
+	if err != nil {
+		return nil, ErrAccountNotRegistered
+	}
+
+	record := &guardedAccountRecord{}
+	err = cs.marshaller.Unmarshal(record, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (cs *coSigner) saveRecord(address []byte, record *guardedAccountRecord) error {
+	buff, err := cs.marshaller.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return cs.storer.Put(address, buff)
+}
+
+// Close closes the underlying storer
+func (cs *coSigner) Close() error {
+	return cs.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cs *coSigner) IsInterfaceNil() bool {
+	return cs == nil
+}
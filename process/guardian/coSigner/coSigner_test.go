@@ -0,0 +1,613 @@
+package coSigner
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/versioning"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceUID = "test-guardian"
+
+var keyGenerator = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+type dataFieldParserStub struct {
+	parseCalled func(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
+}
+
+func (stub *dataFieldParserStub) Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData {
+	if stub.parseCalled != nil {
+		return stub.parseCalled(dataField, sender, receiver, numOfShards)
+	}
+
+	return &datafield.ResponseParseData{}
+}
+
+type testAccount struct {
+	sk      crypto.PrivateKey
+	address []byte
+}
+
+func newTestAccount() *testAccount {
+	sk, pk := keyGenerator.GeneratePair()
+	address, _ := pk.ToByteArray()
+
+	return &testAccount{
+		sk:      sk,
+		address: address,
+	}
+}
+
+func createMockArgs() ArgsCoSigner {
+	guardianKey, _ := keyGenerator.GeneratePair()
+
+	return ArgsCoSigner{
+		Config: config.GuardianCoSignerConfig{
+			Enabled:                           true,
+			ServiceUID:                        testServiceUID,
+			OTPIssuer:                         "MultiversX",
+			OTPPeriodInSeconds:                30,
+			OTPAllowedSkewPeriods:             1,
+			MaxFailedCodeAttempts:             3,
+			FailedCodeAttemptsLockInSecs:      300,
+			RegistrationChallengeExpiryInSecs: 300,
+			MaxPendingRegistrationChallenges:  2,
+		},
+		GuardianKey:      guardianKey,
+		KeyGenerator:     keyGenerator,
+		SingleSigner:     &singlesig.Ed25519Signer{},
+		PubKeyConverter:  testscommon.RealWorldBech32PubkeyConverter,
+		TxMarshaller:     &marshal.JsonMarshalizer{},
+		TxSignHasher:     keccak.NewKeccak(),
+		TxVersionChecker: versioning.NewTxVersionChecker(1),
+		DataFieldParser:  &dataFieldParserStub{},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(3),
+		Storer:           genericMocks.NewStorerMock(),
+		Marshaller:       &marshal.JsonMarshalizer{},
+	}
+}
+
+// signRegistration requests a new registration challenge and returns the account signature over it
+func signRegistration(t *testing.T, cs *coSigner, account *testAccount) []byte {
+	challenge, err := cs.GetRegistrationChallenge(account.address)
+	require.NoError(t, err)
+
+	return signMessage(t, account, challenge.Message)
+}
+
+func signMessage(t *testing.T, account *testAccount, message string) []byte {
+	payload := fmt.Sprintf("%s%d%s", signedMessagePrefix, len(message), message)
+
+	signature, err := (&singlesig.Ed25519Signer{}).Sign(account.sk, keccak.NewKeccak().Compute(payload))
+	require.NoError(t, err)
+
+	return signature
+}
+
+// registerAccount registers and confirms the account, returning the one-time codes secret
+func registerAccount(t *testing.T, cs *coSigner, account *testAccount, currentTime *time.Time) []byte {
+	registration, err := cs.RegisterAccount(account.address, signRegistration(t, cs, account))
+	require.NoError(t, err)
+
+	secret, err := otpSecretEncoding.DecodeString(registration.OTPSecret)
+	require.NoError(t, err)
+
+	err = cs.VerifyCode(account.address, ComputeOTPCode(secret, currentTime.Unix(), 30))
+	require.NoError(t, err)
+
+	*currentTime = currentTime.Add(30 * time.Second)
+
+	return secret
+}
+
+func createGuardedTransaction(t *testing.T, cs *coSigner, account *testAccount, value int64) *transaction.Transaction {
+	tx := &transaction.Transaction{
+		Nonce:        1,
+		Value:        big.NewInt(value),
+		RcvAddr:      testscommon.TestPubKeyBob,
+		SndAddr:      account.address,
+		GasPrice:     1000000000,
+		GasLimit:     50000,
+		ChainID:      []byte("chain"),
+		Version:      2,
+		Options:      transaction.MaskGuardedTransaction,
+		GuardianAddr: cs.guardianAddress,
+	}
+
+	dataToSign, err := tx.GetDataForSigning(cs.pubKeyConverter, cs.txMarshaller, cs.txSignHasher)
+	require.NoError(t, err)
+	tx.Signature, err = cs.singleSigner.Sign(account.sk, dataToSign)
+	require.NoError(t, err)
+
+	return tx
+}
+
+func createCoSignerWithTime(t *testing.T, currentTime *time.Time) *coSigner {
+	cs, err := NewCoSigner(createMockArgs())
+	require.NoError(t, err)
+	cs.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+
+	return cs
+}
+
+func TestNewCoSigner(t *testing.T) {
+	t.Parallel()
+
+	testErrorCase := func(modify func(args *ArgsCoSigner), expectedErr error) {
+		args := createMockArgs()
+		modify(&args)
+		cs, err := NewCoSigner(args)
+		require.True(t, errors.Is(err, expectedErr))
+		require.True(t, check.IfNil(cs))
+	}
+
+	t.Run("nil guardian key should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.GuardianKey = nil }, ErrNilGuardianKey)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.KeyGenerator = nil }, process.ErrNilKeyGen)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.SingleSigner = nil }, process.ErrNilSingleSigner)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.PubKeyConverter = nil }, process.ErrNilPubkeyConverter)
+	})
+	t.Run("nil tx marshaller should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.TxMarshaller = nil }, process.ErrNilMarshalizer)
+	})
+	t.Run("nil tx sign hasher should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.TxSignHasher = nil }, process.ErrNilHasher)
+	})
+	t.Run("nil tx version checker should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.TxVersionChecker = nil }, process.ErrNilTransactionVersionChecker)
+	})
+	t.Run("nil data field parser should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.DataFieldParser = nil }, ErrNilDataFieldParser)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.ShardCoordinator = nil }, process.ErrNilShardCoordinator)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.Storer = nil }, process.ErrNilStorage)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.Marshaller = nil }, process.ErrNilMarshalizer)
+	})
+	t.Run("empty service UID should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.Config.ServiceUID = "" }, ErrEmptyServiceUID)
+	})
+	t.Run("invalid OTP period should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.Config.OTPPeriodInSeconds = 0 }, process.ErrInvalidValue)
+	})
+	t.Run("invalid max failed attempts should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.Config.MaxFailedCodeAttempts = 0 }, process.ErrInvalidValue)
+	})
+	t.Run("invalid registration challenge expiry should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.Config.RegistrationChallengeExpiryInSecs = 0 }, process.ErrInvalidValue)
+	})
+	t.Run("invalid max pending registration challenges should error", func(t *testing.T) {
+		testErrorCase(func(args *ArgsCoSigner) { args.Config.MaxPendingRegistrationChallenges = 0 }, process.ErrInvalidValue)
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgs()
+		cs, err := NewCoSigner(args)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(cs))
+
+		expectedAddress, _ := args.GuardianKey.GeneratePublic().ToByteArray()
+		require.Equal(t, expectedAddress, cs.guardianAddress)
+	})
+}
+
+func TestCoSigner_GetRegistrationChallenge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should issue a new challenge on each call", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+		address := testscommon.RealWorldBech32PubkeyConverter.SilentEncode(account.address, log)
+
+		challenge, err := cs.GetRegistrationChallenge(account.address)
+		require.NoError(t, err)
+		require.Len(t, challenge.Challenge, 2*registrationChallengeLength)
+		require.Equal(t, fmt.Sprintf("%s:register:%s:%s", testServiceUID, address, challenge.Challenge), challenge.Message)
+		require.Equal(t, currentTime.Unix()+300, challenge.ExpiresAt)
+
+		newChallenge, err := cs.GetRegistrationChallenge(account.address)
+		require.NoError(t, err)
+		require.NotEqual(t, challenge.Challenge, newChallenge.Challenge)
+		require.Len(t, cs.challenges, 1)
+	})
+	t.Run("too many pending challenges should error until they expire", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		_, _ = cs.GetRegistrationChallenge(newTestAccount().address)
+		_, _ = cs.GetRegistrationChallenge(newTestAccount().address)
+
+		challenge, err := cs.GetRegistrationChallenge(newTestAccount().address)
+		require.Equal(t, ErrTooManyPendingChallenges, err)
+		require.Nil(t, challenge)
+
+		currentTime = currentTime.Add(300 * time.Second)
+		challenge, err = cs.GetRegistrationChallenge(newTestAccount().address)
+		require.NoError(t, err)
+		require.NotNil(t, challenge)
+		require.Len(t, cs.challenges, 1)
+	})
+}
+
+func TestCoSigner_RegisterAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing challenge should error", func(t *testing.T) {
+		t.Parallel()
+
+		cs, _ := NewCoSigner(createMockArgs())
+		account := newTestAccount()
+		address := testscommon.RealWorldBech32PubkeyConverter.SilentEncode(account.address, log)
+		signature := signMessage(t, account, fmt.Sprintf("%s:register:%s:", testServiceUID, address))
+
+		registration, err := cs.RegisterAccount(account.address, signature)
+		require.Equal(t, ErrRegistrationChallengeNotFound, err)
+		require.Nil(t, registration)
+	})
+	t.Run("expired challenge should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+		signature := signRegistration(t, cs, account)
+
+		currentTime = currentTime.Add(300 * time.Second)
+		registration, err := cs.RegisterAccount(account.address, signature)
+		require.Equal(t, ErrRegistrationChallengeNotFound, err)
+		require.Nil(t, registration)
+	})
+	t.Run("invalid signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		cs, _ := NewCoSigner(createMockArgs())
+		account := newTestAccount()
+		otherAccount := newTestAccount()
+		challenge, _ := cs.GetRegistrationChallenge(account.address)
+
+		registration, err := cs.RegisterAccount(account.address, signMessage(t, otherAccount, challenge.Message))
+		require.True(t, errors.Is(err, ErrInvalidRegistrationSignature))
+		require.Nil(t, registration)
+
+		// the challenge is kept, so an invalid signature can not be used to drop the challenge of the owner
+		registration, err = cs.RegisterAccount(account.address, signMessage(t, account, challenge.Message))
+		require.NoError(t, err)
+		require.NotNil(t, registration)
+	})
+	t.Run("replayed signature should not take over a pending registration", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+		signature := signRegistration(t, cs, account)
+
+		registration, err := cs.RegisterAccount(account.address, signature)
+		require.NoError(t, err)
+
+		// an attacker who captured the signature replays it, with or without requesting a new challenge
+		replayedRegistration, err := cs.RegisterAccount(account.address, signature)
+		require.Equal(t, ErrRegistrationChallengeNotFound, err)
+		require.Nil(t, replayedRegistration)
+
+		_, err = cs.GetRegistrationChallenge(account.address)
+		require.NoError(t, err)
+		replayedRegistration, err = cs.RegisterAccount(account.address, signature)
+		require.True(t, errors.Is(err, ErrInvalidRegistrationSignature))
+		require.Nil(t, replayedRegistration)
+
+		// the pending registration still holds the secret provided to the owner
+		secret, _ := otpSecretEncoding.DecodeString(registration.OTPSecret)
+		err = cs.VerifyCode(account.address, ComputeOTPCode(secret, currentTime.Unix(), 30))
+		require.NoError(t, err)
+	})
+	t.Run("should work and allow registering again until confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+
+		registration, err := cs.RegisterAccount(account.address, signRegistration(t, cs, account))
+		require.NoError(t, err)
+		require.Equal(t, testServiceUID, registration.ServiceUID)
+		require.Equal(t, testscommon.RealWorldBech32PubkeyConverter.SilentEncode(cs.guardianAddress, log), registration.GuardianAddress)
+		require.Contains(t, registration.OTPProvisioningURI, registration.OTPSecret)
+
+		newRegistration, err := cs.RegisterAccount(account.address, signRegistration(t, cs, account))
+		require.NoError(t, err)
+		require.NotEqual(t, registration.OTPSecret, newRegistration.OTPSecret)
+
+		secret, _ := otpSecretEncoding.DecodeString(newRegistration.OTPSecret)
+		err = cs.VerifyCode(account.address, ComputeOTPCode(secret, currentTime.Unix(), 30))
+		require.NoError(t, err)
+
+		registration, err = cs.RegisterAccount(account.address, signRegistration(t, cs, account))
+		require.Equal(t, ErrAccountAlreadyRegistered, err)
+		require.Nil(t, registration)
+	})
+}
+
+func TestCoSigner_VerifyCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not registered account should error", func(t *testing.T) {
+		t.Parallel()
+
+		cs, _ := NewCoSigner(createMockArgs())
+		err := cs.VerifyCode(newTestAccount().address, "123456")
+		require.Equal(t, ErrAccountNotRegistered, err)
+	})
+	t.Run("codes within the allowed skew should work only once", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+		secret := registerAccount(t, cs, account, &currentTime)
+
+		previousCode := ComputeOTPCode(secret, currentTime.Add(-30*time.Second).Unix(), 30)
+		err := cs.VerifyCode(account.address, previousCode)
+		require.Equal(t, ErrInvalidCode, err, "the previous code was already used when registering")
+
+		nextCode := ComputeOTPCode(secret, currentTime.Add(30*time.Second).Unix(), 30)
+		err = cs.VerifyCode(account.address, nextCode)
+		require.NoError(t, err)
+
+		err = cs.VerifyCode(account.address, nextCode)
+		require.Equal(t, ErrInvalidCode, err)
+
+		farCode := ComputeOTPCode(secret, currentTime.Add(5*time.Minute).Unix(), 30)
+		err = cs.VerifyCode(account.address, farCode)
+		require.Equal(t, ErrInvalidCode, err)
+	})
+	t.Run("too many invalid codes should lock the account", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+		secret := registerAccount(t, cs, account, &currentTime)
+
+		for i := 0; i < 3; i++ {
+			err := cs.VerifyCode(account.address, "000000")
+			require.Equal(t, ErrInvalidCode, err)
+		}
+
+		err := cs.VerifyCode(account.address, ComputeOTPCode(secret, currentTime.Unix(), 30))
+		require.Equal(t, ErrAccountLocked, err)
+
+		currentTime = currentTime.Add(301 * time.Second)
+		err = cs.VerifyCode(account.address, ComputeOTPCode(secret, currentTime.Unix(), 30))
+		require.NoError(t, err)
+	})
+}
+
+func TestCoSigner_SetSpendingPolicy(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1700000000, 0)
+	cs := createCoSignerWithTime(t, &currentTime)
+	account := newTestAccount()
+	policy := &common.GuardianSpendingPolicy{
+		DailyLimits: map[string]string{"EGLD": "100"},
+	}
+
+	err := cs.SetSpendingPolicy(account.address, "123456", policy)
+	require.Equal(t, ErrAccountNotRegistered, err)
+
+	_, _ = cs.RegisterAccount(account.address, signRegistration(t, cs, account))
+	err = cs.SetSpendingPolicy(account.address, "123456", policy)
+	require.Equal(t, ErrRegistrationNotConfirmed, err)
+
+	cs2 := createCoSignerWithTime(t, &currentTime)
+	secret := registerAccount(t, cs2, account, &currentTime)
+
+	err = cs2.SetSpendingPolicy(account.address, "000000", policy)
+	require.Equal(t, ErrInvalidCode, err)
+
+	err = cs2.SetSpendingPolicy(account.address, ComputeOTPCode(secret, currentTime.Unix(), 30), nil)
+	require.Equal(t, ErrNilSpendingPolicy, err)
+
+	err = cs2.SetSpendingPolicy(account.address, ComputeOTPCode(secret, currentTime.Unix(), 30), policy)
+	require.NoError(t, err)
+
+	record, _ := cs2.loadRecord(account.address)
+	require.Equal(t, big.NewInt(100), record.Policy.DailyLimits["EGLD"])
+}
+
+func TestCoSigner_CoSignTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+
+		signature, err := cs.CoSignTransaction(nil, "123456")
+		require.Equal(t, process.ErrNilTransaction, err)
+		require.Nil(t, signature)
+
+		tx := createGuardedTransaction(t, cs, account, 1)
+		tx.Options = 0
+		signature, err = cs.CoSignTransaction(tx, "123456")
+		require.Equal(t, ErrNotGuardedTransaction, err)
+		require.Nil(t, signature)
+
+		tx = createGuardedTransaction(t, cs, account, 1)
+		tx.GuardianAddr = testscommon.TestPubKeyAlice
+		signature, err = cs.CoSignTransaction(tx, "123456")
+		require.Equal(t, ErrGuardianAddressMismatch, err)
+		require.Nil(t, signature)
+
+		tx = createGuardedTransaction(t, cs, account, 1)
+		tx.Nonce++
+		signature, err = cs.CoSignTransaction(tx, "123456")
+		require.Error(t, err)
+		require.Nil(t, signature)
+
+		tx = createGuardedTransaction(t, cs, account, 1)
+		signature, err = cs.CoSignTransaction(tx, "123456")
+		require.Equal(t, ErrAccountNotRegistered, err)
+		require.Nil(t, signature)
+	})
+	t.Run("relayed transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.DataFieldParser = &dataFieldParserStub{
+			parseCalled: func(_ []byte, _, _ []byte, _ uint32) *datafield.ResponseParseData {
+				return &datafield.ResponseParseData{IsRelayed: true}
+			},
+		}
+		cs, _ := NewCoSigner(args)
+
+		signature, err := cs.CoSignTransaction(createGuardedTransaction(t, cs, newTestAccount(), 1), "123456")
+		require.Equal(t, ErrRelayedTransactionNotSupported, err)
+		require.Nil(t, signature)
+	})
+	t.Run("should co-sign within the spending policy", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+		secret := registerAccount(t, cs, account, &currentTime)
+		nextCode := func() string {
+			currentTime = currentTime.Add(30 * time.Second)
+			return ComputeOTPCode(secret, currentTime.Unix(), 30)
+		}
+
+		err := cs.SetSpendingPolicy(account.address, nextCode(), &common.GuardianSpendingPolicy{
+			DailyLimits:      map[string]string{"EGLD": "100"},
+			AllowedReceivers: []string{testscommon.TestAddressBob},
+		})
+		require.NoError(t, err)
+
+		tx := createGuardedTransaction(t, cs, account, 60)
+		guardianSignature, err := cs.CoSignTransaction(tx, nextCode())
+		require.NoError(t, err)
+
+		dataToSign, _ := tx.GetDataForSigning(cs.pubKeyConverter, cs.txMarshaller, cs.txSignHasher)
+		err = cs.singleSigner.Verify(cs.guardianKey.GeneratePublic(), dataToSign, guardianSignature)
+		require.NoError(t, err)
+
+		tx = createGuardedTransaction(t, cs, account, 41)
+		guardianSignature, err = cs.CoSignTransaction(tx, nextCode())
+		require.True(t, errors.Is(err, ErrDailyLimitExceeded))
+		require.Nil(t, guardianSignature)
+
+		tx = createGuardedTransaction(t, cs, account, 1)
+		tx.RcvAddr = testscommon.TestPubKeyAlice
+		dataToSign, _ = tx.GetDataForSigning(cs.pubKeyConverter, cs.txMarshaller, cs.txSignHasher)
+		tx.Signature, _ = cs.singleSigner.Sign(account.sk, dataToSign)
+		guardianSignature, err = cs.CoSignTransaction(tx, nextCode())
+		require.Equal(t, ErrReceiverNotAllowed, err)
+		require.Nil(t, guardianSignature)
+
+		currentTime = currentTime.Add(24 * time.Hour)
+		tx = createGuardedTransaction(t, cs, account, 100)
+		guardianSignature, err = cs.CoSignTransaction(tx, nextCode())
+		require.NoError(t, err)
+		require.NotEmpty(t, guardianSignature)
+	})
+	t.Run("spending policy should not be evaluated before the code", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		cs := createCoSignerWithTime(t, &currentTime)
+		account := newTestAccount()
+		secret := registerAccount(t, cs, account, &currentTime)
+		nextCode := func() string {
+			currentTime = currentTime.Add(30 * time.Second)
+			return ComputeOTPCode(secret, currentTime.Unix(), 30)
+		}
+
+		err := cs.SetSpendingPolicy(account.address, nextCode(), &common.GuardianSpendingPolicy{
+			DailyLimits: map[string]string{"EGLD": "10"},
+		})
+		require.NoError(t, err)
+
+		tx := createGuardedTransaction(t, cs, account, 11)
+		guardianSignature, err := cs.CoSignTransaction(tx, "000000")
+		require.Equal(t, ErrInvalidCode, err)
+		require.Nil(t, guardianSignature)
+
+		record, _ := cs.loadRecord(account.address)
+		require.Equal(t, uint32(1), record.NumFailedAttempts)
+
+		code := nextCode()
+		guardianSignature, err = cs.CoSignTransaction(tx, code)
+		require.True(t, errors.Is(err, ErrDailyLimitExceeded))
+		require.Nil(t, guardianSignature)
+
+		tx = createGuardedTransaction(t, cs, account, 1)
+		guardianSignature, err = cs.CoSignTransaction(tx, code)
+		require.Equal(t, ErrInvalidCode, err, "the code was consumed by the rejected transaction")
+		require.Nil(t, guardianSignature)
+	})
+}
+
+func TestCoSigner_Close(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	wasClosed := false
+	storer := &storerCloseStub{
+		StorerMock: genericMocks.NewStorerMock(),
+		closeCalled: func() {
+			wasClosed = true
+		},
+	}
+	args.Storer = storer
+	cs, _ := NewCoSigner(args)
+
+	err := cs.Close()
+	require.NoError(t, err)
+	require.True(t, wasClosed)
+}
+
+type storerCloseStub struct {
+	*genericMocks.StorerMock
+	closeCalled func()
+}
+
+func (stub *storerCloseStub) Close() error {
+	stub.closeCalled()
+	return nil
+}
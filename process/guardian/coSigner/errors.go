@@ -0,0 +1,60 @@
+package coSigner
+
+import "errors"
+
+// ErrNilGuardianKey signals that a nil guardian private key was provided
+var ErrNilGuardianKey = errors.New("nil guardian key")
+
+// ErrNilDataFieldParser signals that a nil data field parser was provided
+var ErrNilDataFieldParser = errors.New("nil data field parser")
+
+// ErrEmptyServiceUID signals that an empty guardian service unique identifier was provided
+var ErrEmptyServiceUID = errors.New("empty guardian service unique identifier")
+
+// ErrInvalidRegistrationSignature signals that the signature provided on registration is invalid
+var ErrInvalidRegistrationSignature = errors.New("invalid registration signature")
+
+// ErrRegistrationChallengeNotFound signals that the account has no valid registration challenge
+var ErrRegistrationChallengeNotFound = errors.New("registration challenge not found or expired")
+
+// ErrTooManyPendingChallenges signals that the maximum number of pending registration challenges was reached
+var ErrTooManyPendingChallenges = errors.New("too many pending registration challenges")
+
+// ErrAccountAlreadyRegistered signals that the account has already confirmed its registration
+var ErrAccountAlreadyRegistered = errors.New("account already registered")
+
+// ErrAccountNotRegistered signals that the account is not registered
+var ErrAccountNotRegistered = errors.New("account not registered")
+
+// ErrRegistrationNotConfirmed signals that the account did not confirm its registration by providing a valid code
+var ErrRegistrationNotConfirmed = errors.New("registration not confirmed")
+
+// ErrInvalidCode signals that an invalid one-time code was provided
+var ErrInvalidCode = errors.New("invalid code")
+
+// ErrAccountLocked signals that the account is temporarily locked because of too many invalid codes
+var ErrAccountLocked = errors.New("account temporarily locked because of too many invalid codes")
+
+// ErrNilSpendingPolicy signals that a nil spending policy was provided
+var ErrNilSpendingPolicy = errors.New("nil spending policy")
+
+// ErrInvalidDailyLimit signals that an invalid daily limit was provided
+var ErrInvalidDailyLimit = errors.New("invalid daily limit")
+
+// ErrNotGuardedTransaction signals that the transaction is not a guarded one
+var ErrNotGuardedTransaction = errors.New("not a guarded transaction")
+
+// ErrGuardianAddressMismatch signals that the transaction does not have the service guardian set as guardian
+var ErrGuardianAddressMismatch = errors.New("the transaction guardian is not the service guardian")
+
+// ErrRelayedTransactionNotSupported signals that relayed transactions can not be co-signed
+var ErrRelayedTransactionNotSupported = errors.New("relayed transactions can not be co-signed")
+
+// ErrReceiverNotAllowed signals that the transaction transfers funds to a receiver outside the allowed list
+var ErrReceiverNotAllowed = errors.New("receiver not allowed by the spending policy")
+
+// ErrDailyLimitExceeded signals that the transaction would exceed the daily limit of the spending policy
+var ErrDailyLimitExceeded = errors.New("daily limit exceeded")
+
+// ErrInvalidTransferValue signals that the transaction data field contains an invalid transfer value
+var ErrInvalidTransferValue = errors.New("invalid transfer value")
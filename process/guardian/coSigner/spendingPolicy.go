@@ -0,0 +1,153 @@
+package coSigner
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
+)
+
+const (
+	nativeTokenIdentifier = "EGLD"
+	secondsInDay          = 24 * 60 * 60
+)
+
+type spendingPolicy struct {
+	DailyLimits      map[string]*big.Int `json:"dailyLimits"`
+	AllowedReceivers [][]byte            `json:"allowedReceivers"`
+}
+
+type tokenTransfer struct {
+	token string
+	value *big.Int
+}
+
+func newSpendingPolicy(policy *common.GuardianSpendingPolicy, pubKeyConverter core.PubkeyConverter) (*spendingPolicy, error) {
+	if policy == nil {
+		return nil, ErrNilSpendingPolicy
+	}
+
+	dailyLimits := make(map[string]*big.Int, len(policy.DailyLimits))
+	for token, limitString := range policy.DailyLimits {
+		limit, ok := big.NewInt(0).SetString(limitString, 10)
+		if !ok || limit.Sign() < 0 {
+			return nil, fmt.Errorf("%w for token %s", ErrInvalidDailyLimit, token)
+		}
+
+		dailyLimits[token] = limit
+	}
+
+	allowedReceivers := make([][]byte, 0, len(policy.AllowedReceivers))
+	for _, receiver := range policy.AllowedReceivers {
+		receiverBytes, err := pubKeyConverter.Decode(receiver)
+		if err != nil {
+			return nil, fmt.Errorf("%w for allowed receiver %s", err, receiver)
+		}
+
+		allowedReceivers = append(allowedReceivers, receiverBytes)
+	}
+
+	return &spendingPolicy{
+		DailyLimits:      dailyLimits,
+		AllowedReceivers: allowedReceivers,
+	}, nil
+}
+
+// checkReceivers returns an error if any of the receivers, other than the sender itself, is not allowed
+func (policy *spendingPolicy) checkReceivers(sender []byte, receivers [][]byte) error {
+	if len(policy.AllowedReceivers) == 0 {
+		return nil
+	}
+
+	for _, receiver := range receivers {
+		if bytes.Equal(receiver, sender) {
+			continue
+		}
+		if !policy.isReceiverAllowed(receiver) {
+			return ErrReceiverNotAllowed
+		}
+	}
+
+	return nil
+}
+
+func (policy *spendingPolicy) isReceiverAllowed(receiver []byte) bool {
+	for _, allowedReceiver := range policy.AllowedReceivers {
+		if bytes.Equal(allowedReceiver, receiver) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computeSpentAmounts returns the amounts spent in the current day after adding the provided transfers. The tokens
+// without a daily limit are not accounted
+func (policy *spendingPolicy) computeSpentAmounts(alreadySpent map[string]*big.Int, transfers []*tokenTransfer) (map[string]*big.Int, error) {
+	spent := make(map[string]*big.Int, len(alreadySpent))
+	for token, value := range alreadySpent {
+		spent[token] = big.NewInt(0).Set(value)
+	}
+
+	for _, transfer := range transfers {
+		limit, hasLimit := policy.DailyLimits[transfer.token]
+		if !hasLimit {
+			continue
+		}
+
+		total, found := spent[transfer.token]
+		if !found {
+			total = big.NewInt(0)
+			spent[transfer.token] = total
+		}
+
+		total.Add(total, transfer.value)
+		if total.Cmp(limit) > 0 {
+			return nil, fmt.Errorf("%w for token %s", ErrDailyLimitExceeded, transfer.token)
+		}
+	}
+
+	return spent, nil
+}
+
+// extractTransfers returns the native and ESDT values moved by the transaction, together with their receivers
+func extractTransfers(tx *transaction.Transaction, parsedData *datafield.ResponseParseData) ([]*tokenTransfer, [][]byte, error) {
+	transfers := make([]*tokenTransfer, 0, len(parsedData.Tokens)+1)
+	if tx.Value != nil && tx.Value.Sign() > 0 {
+		transfers = append(transfers, &tokenTransfer{
+			token: nativeTokenIdentifier,
+			value: tx.Value,
+		})
+	}
+
+	for idx, token := range parsedData.Tokens {
+		if idx >= len(parsedData.ESDTValues) {
+			break
+		}
+
+		value, ok := big.NewInt(0).SetString(parsedData.ESDTValues[idx], 10)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w for token %s", ErrInvalidTransferValue, token)
+		}
+
+		transfers = append(transfers, &tokenTransfer{
+			token: token,
+			value: value,
+		})
+	}
+
+	receivers := parsedData.Receivers
+	if len(receivers) == 0 {
+		receivers = [][]byte{tx.RcvAddr}
+	}
+
+	return transfers, receivers, nil
+}
+
+func computeDay(unixTime int64) int64 {
+	return unixTime / secondsInDay
+}
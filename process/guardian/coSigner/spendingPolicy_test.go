@@ -0,0 +1,151 @@
+package coSigner
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpendingPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := newSpendingPolicy(nil, testscommon.RealWorldBech32PubkeyConverter)
+		require.Equal(t, ErrNilSpendingPolicy, err)
+		require.Nil(t, policy)
+	})
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := newSpendingPolicy(&common.GuardianSpendingPolicy{
+			DailyLimits: map[string]string{"EGLD": "not a number"},
+		}, testscommon.RealWorldBech32PubkeyConverter)
+		require.True(t, errors.Is(err, ErrInvalidDailyLimit))
+		require.Nil(t, policy)
+
+		policy, err = newSpendingPolicy(&common.GuardianSpendingPolicy{
+			DailyLimits: map[string]string{"EGLD": "-1"},
+		}, testscommon.RealWorldBech32PubkeyConverter)
+		require.True(t, errors.Is(err, ErrInvalidDailyLimit))
+		require.Nil(t, policy)
+	})
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := newSpendingPolicy(&common.GuardianSpendingPolicy{
+			AllowedReceivers: []string{"invalid"},
+		}, testscommon.RealWorldBech32PubkeyConverter)
+		require.Error(t, err)
+		require.Nil(t, policy)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := newSpendingPolicy(&common.GuardianSpendingPolicy{
+			DailyLimits:      map[string]string{"EGLD": "1000", "TKN-abcdef": "5"},
+			AllowedReceivers: []string{testscommon.TestAddressBob},
+		}, testscommon.RealWorldBech32PubkeyConverter)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1000), policy.DailyLimits["EGLD"])
+		require.Equal(t, big.NewInt(5), policy.DailyLimits["TKN-abcdef"])
+		require.Equal(t, [][]byte{testscommon.TestPubKeyBob}, policy.AllowedReceivers)
+	})
+}
+
+func TestSpendingPolicy_CheckReceivers(t *testing.T) {
+	t.Parallel()
+
+	sender := testscommon.TestPubKeyAlice
+	other := []byte("other receiver")
+
+	policy := &spendingPolicy{}
+	require.NoError(t, policy.checkReceivers(sender, [][]byte{other}))
+
+	policy.AllowedReceivers = [][]byte{testscommon.TestPubKeyBob}
+	require.NoError(t, policy.checkReceivers(sender, [][]byte{testscommon.TestPubKeyBob, sender}))
+	require.Equal(t, ErrReceiverNotAllowed, policy.checkReceivers(sender, [][]byte{testscommon.TestPubKeyBob, other}))
+}
+
+func TestSpendingPolicy_ComputeSpentAmounts(t *testing.T) {
+	t.Parallel()
+
+	policy := &spendingPolicy{
+		DailyLimits: map[string]*big.Int{
+			"EGLD": big.NewInt(100),
+		},
+	}
+	alreadySpent := map[string]*big.Int{
+		"EGLD": big.NewInt(60),
+	}
+
+	spent, err := policy.computeSpentAmounts(alreadySpent, []*tokenTransfer{
+		{token: "EGLD", value: big.NewInt(40)},
+		{token: "TKN-abcdef", value: big.NewInt(1000)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*big.Int{"EGLD": big.NewInt(100)}, spent)
+	require.Equal(t, big.NewInt(60), alreadySpent["EGLD"])
+
+	spent, err = policy.computeSpentAmounts(alreadySpent, []*tokenTransfer{
+		{token: "EGLD", value: big.NewInt(41)},
+	})
+	require.True(t, errors.Is(err, ErrDailyLimitExceeded))
+	require.Nil(t, spent)
+}
+
+func TestExtractTransfers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("native transfer", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.Transaction{
+			Value:   big.NewInt(10),
+			RcvAddr: testscommon.TestPubKeyBob,
+		}
+		transfers, receivers, err := extractTransfers(tx, &datafield.ResponseParseData{})
+		require.NoError(t, err)
+		require.Equal(t, []*tokenTransfer{{token: nativeTokenIdentifier, value: big.NewInt(10)}}, transfers)
+		require.Equal(t, [][]byte{testscommon.TestPubKeyBob}, receivers)
+	})
+	t.Run("token transfers", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.Transaction{
+			Value:   big.NewInt(0),
+			RcvAddr: testscommon.TestPubKeyAlice,
+		}
+		parsedData := &datafield.ResponseParseData{
+			Tokens:     []string{"TKN-abcdef", "TKN2-abcdef"},
+			ESDTValues: []string{"5", "6"},
+			Receivers:  [][]byte{testscommon.TestPubKeyBob},
+		}
+		transfers, receivers, err := extractTransfers(tx, parsedData)
+		require.NoError(t, err)
+		require.Equal(t, []*tokenTransfer{
+			{token: "TKN-abcdef", value: big.NewInt(5)},
+			{token: "TKN2-abcdef", value: big.NewInt(6)},
+		}, transfers)
+		require.Equal(t, [][]byte{testscommon.TestPubKeyBob}, receivers)
+	})
+	t.Run("invalid token value should error", func(t *testing.T) {
+		t.Parallel()
+
+		parsedData := &datafield.ResponseParseData{
+			Tokens:     []string{"TKN-abcdef"},
+			ESDTValues: []string{"invalid"},
+		}
+		transfers, receivers, err := extractTransfers(&transaction.Transaction{}, parsedData)
+		require.True(t, errors.Is(err, ErrInvalidTransferValue))
+		require.Nil(t, transfers)
+		require.Nil(t, receivers)
+	})
+}
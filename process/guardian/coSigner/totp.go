@@ -0,0 +1,69 @@
+package coSigner
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+)
+
+const (
+	otpSecretLength = 20
+	otpNumDigits    = 6
+	otpModulo       = 1000000
+)
+
+var otpSecretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func generateOTPSecret() ([]byte, error) {
+	secret := make([]byte, otpSecretLength)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// ComputeOTPCode computes the time-based one-time code (RFC 6238, HMAC-SHA1, 6 digits) of the provided secret
+// for the provided unix time
+func ComputeOTPCode(secret []byte, unixTime int64, periodInSeconds uint32) string {
+	return computeOTPCodeForTimeStep(secret, computeTimeStep(unixTime, periodInSeconds))
+}
+
+func computeTimeStep(unixTime int64, periodInSeconds uint32) uint64 {
+	if unixTime < 0 || periodInSeconds == 0 {
+		return 0
+	}
+
+	return uint64(unixTime) / uint64(periodInSeconds)
+}
+
+func computeOTPCodeForTimeStep(secret []byte, timeStep uint64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, timeStep)
+
+	mac := hmac.New(sha1.New, secret)
+	_, _ = mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", otpNumDigits, value%otpModulo)
+}
+
+func createProvisioningURI(issuer string, accountName string, secret []byte, periodInSeconds uint32) string {
+	label := url.PathEscape(issuer + ":" + accountName)
+	values := url.Values{}
+	values.Set("secret", otpSecretEncoding.EncodeToString(secret))
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprintf("%d", otpNumDigits))
+	values.Set("period", fmt.Sprintf("%d", periodInSeconds))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, values.Encode())
+}
@@ -0,0 +1,51 @@
+package coSigner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeOTPCode(t *testing.T) {
+	t.Parallel()
+
+	// test vectors from RFC 6238, appendix B, truncated to 6 digits
+	secret := []byte("12345678901234567890")
+	require.Equal(t, "287082", ComputeOTPCode(secret, 59, 30))
+	require.Equal(t, "081804", ComputeOTPCode(secret, 1111111109, 30))
+	require.Equal(t, "050471", ComputeOTPCode(secret, 1111111111, 30))
+	require.Equal(t, "005924", ComputeOTPCode(secret, 1234567890, 30))
+	require.Equal(t, "279037", ComputeOTPCode(secret, 2000000000, 30))
+}
+
+func TestComputeTimeStep(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, uint64(0), computeTimeStep(-1, 30))
+	require.Equal(t, uint64(0), computeTimeStep(100, 0))
+	require.Equal(t, uint64(3), computeTimeStep(100, 30))
+}
+
+func TestGenerateOTPSecret(t *testing.T) {
+	t.Parallel()
+
+	secret1, err := generateOTPSecret()
+	require.NoError(t, err)
+	require.Len(t, secret1, otpSecretLength)
+
+	secret2, err := generateOTPSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret1, secret2)
+}
+
+func TestCreateProvisioningURI(t *testing.T) {
+	t.Parallel()
+
+	uri := createProvisioningURI("MultiversX", "erd1abc", []byte("12345678901234567890"), 30)
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/MultiversX:erd1abc?"))
+	require.Contains(t, uri, "secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	require.Contains(t, uri, "issuer=MultiversX")
+	require.Contains(t, uri, "digits=6")
+	require.Contains(t, uri, "period=30")
+}
@@ -44,11 +44,12 @@ func (hdrIntVer *headerIntegrityVerifier) GetVersion(epoch uint32) string {
 
 // Verify will check the header's fields such as the chain ID or the software version
 func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
-		return process.ErrReservedFieldInvalid
+	err := process.CheckReservedField(hdr)
+	if err != nil {
+		return err
 	}
 
-	err := hdrIntVer.headerVersionHandler.Verify(hdr)
+	err = hdrIntVer.headerVersionHandler.Verify(hdr)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package interceptors
 import (
 	"bytes"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -18,6 +19,10 @@ type baseDataInterceptor struct {
 	processor            process.InterceptorProcessor
 	mutDebugHandler      sync.RWMutex
 	debugHandler         process.InterceptedDebugger
+	mutMessageTracer     sync.RWMutex
+	messageTracer        process.P2PMessageTracer
+	mutMessageMetrics    sync.RWMutex
+	messageMetrics       process.P2PMessagesMetricsHandler
 	preferredPeersHolder process.PreferredPeersHolderHandler
 }
 
@@ -62,7 +67,7 @@ func (bdi *baseDataInterceptor) isMessageFromSelfToSelf(fromConnectedPeer core.P
 		fromConnectedPeer == bdi.currentPeerId
 }
 
-func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedData, msg p2p.MessageP2P) {
+func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedData, msg p2p.MessageP2P) error {
 	err := bdi.processor.Validate(data, msg.Peer())
 	if err != nil {
 		log.Trace("intercepted data is not valid",
@@ -75,7 +80,7 @@ func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedD
 		)
 		bdi.processDebugInterceptedData(data, err)
 
-		return
+		return err
 	}
 
 	err = bdi.processor.Save(data, msg.Peer(), bdi.topic)
@@ -90,7 +95,7 @@ func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedD
 		)
 		bdi.processDebugInterceptedData(data, err)
 
-		return
+		return err
 	}
 
 	log.Trace("intercepted data is processed",
@@ -101,6 +106,8 @@ func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedD
 		"intercepted data", data.String(),
 	)
 	bdi.processDebugInterceptedData(data, err)
+
+	return nil
 }
 
 func (bdi *baseDataInterceptor) processDebugInterceptedData(interceptedData process.InterceptedData, err error) {
@@ -131,3 +138,41 @@ func (bdi *baseDataInterceptor) SetInterceptedDebugHandler(handler process.Inter
 
 	return nil
 }
+
+// SetMessageTracer will set a new p2p message tracer
+func (bdi *baseDataInterceptor) SetMessageTracer(tracer process.P2PMessageTracer) error {
+	if check.IfNil(tracer) {
+		return process.ErrNilMessageTracer
+	}
+
+	bdi.mutMessageTracer.Lock()
+	bdi.messageTracer = tracer
+	bdi.mutMessageTracer.Unlock()
+
+	return nil
+}
+
+// SetMessageMetrics will set a new p2p messages metrics handler
+func (bdi *baseDataInterceptor) SetMessageMetrics(handler process.P2PMessagesMetricsHandler) error {
+	if check.IfNil(handler) {
+		return process.ErrNilMessageMetrics
+	}
+
+	bdi.mutMessageMetrics.Lock()
+	bdi.messageMetrics = handler
+	bdi.mutMessageMetrics.Unlock()
+
+	return nil
+}
+
+// messageProcessed should be called after the message was completely processed, including the asynchronous processing
+// of the intercepted data, so that the traced and observed duration and result reflect the whole processing
+func (bdi *baseDataInterceptor) messageProcessed(message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error) {
+	bdi.mutMessageTracer.RLock()
+	bdi.messageTracer.TraceMessage(bdi.topic, message, fromConnectedPeer, arrivalTime, err)
+	bdi.mutMessageTracer.RUnlock()
+
+	bdi.mutMessageMetrics.RLock()
+	bdi.messageMetrics.ObserveMessage(bdi.topic, message, time.Since(arrivalTime), err)
+	bdi.mutMessageMetrics.RUnlock()
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+type disabledP2PMessagesMetrics struct {
+}
+
+// NewDisabledP2PMessagesMetrics returns a p2p messages metrics handler that does not record anything
+func NewDisabledP2PMessagesMetrics() *disabledP2PMessagesMetrics {
+	return &disabledP2PMessagesMetrics{}
+}
+
+// ObserveMessage does nothing
+func (dpmm *disabledP2PMessagesMetrics) ObserveMessage(_ string, _ p2p.MessageP2P, _ time.Duration, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpmm *disabledP2PMessagesMetrics) IsInterfaceNil() bool {
+	return dpmm == nil
+}
@@ -135,6 +135,16 @@ func (e *epochStartMetaBlockInterceptor) SetInterceptedDebugHandler(_ process.In
 	return nil
 }
 
+// SetMessageTracer won't do anything
+func (e *epochStartMetaBlockInterceptor) SetMessageTracer(_ process.P2PMessageTracer) error {
+	return nil
+}
+
+// SetMessageMetrics won't do anything
+func (e *epochStartMetaBlockInterceptor) SetMessageMetrics(_ process.P2PMessagesMetricsHandler) error {
+	return nil
+}
+
 // RegisterHandler will append the handler to the slice, so it will be called when the epoch start meta block is fetched
 func (e *epochStartMetaBlockInterceptor) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	if handler == nil {
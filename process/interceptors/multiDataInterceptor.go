@@ -2,6 +2,7 @@ package interceptors
 
 import (
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/debug/handler"
+	p2pDebug "github.com/multiversx/mx-chain-go/debug/p2p"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors/disabled"
@@ -79,6 +81,8 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 			processor:            arg.Processor,
 			preferredPeersHolder: arg.PreferredPeersHolder,
 			debugHandler:         handler.NewDisabledInterceptorDebugHandler(),
+			messageTracer:        p2pDebug.NewDisabledMessageTracer(),
+			messageMetrics:       disabled.NewDisabledP2PMessagesMetrics(),
 		},
 		marshalizer:      arg.Marshalizer,
 		factory:          arg.DataFactory,
@@ -92,6 +96,17 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 // ProcessReceivedMessage is the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
 func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, _ p2p.MessageHandler) error {
+	arrivalTime := time.Now()
+	err := mdi.processReceivedMessage(message, fromConnectedPeer, arrivalTime)
+	if err != nil {
+		mdi.messageProcessed(message, fromConnectedPeer, arrivalTime, err)
+	}
+
+	return err
+}
+
+// processReceivedMessage traces the message by itself only if it returns a nil error
+func (mdi *MultiDataInterceptor) processReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time) error {
 	err := mdi.preProcessMesage(message, fromConnectedPeer)
 	if err != nil {
 		return err
@@ -139,6 +154,7 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	isIncompleteChunk := checkChunksRes.IsChunk && !checkChunksRes.HaveAllChunks
 	if isIncompleteChunk {
 		mdi.throttler.EndProcessing()
+		mdi.messageProcessed(message, fromConnectedPeer, arrivalTime, nil)
 		return nil
 	}
 	isCompleteChunk := checkChunksRes.IsChunk && checkChunksRes.HaveAllChunks
@@ -185,10 +201,15 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	}
 
 	go func() {
+		var errProcess error
 		for _, interceptedData := range listInterceptedData {
-			mdi.processInterceptedData(interceptedData, message)
+			errData := mdi.processInterceptedData(interceptedData, message)
+			if errProcess == nil {
+				errProcess = errData
+			}
 		}
 		mdi.throttler.EndProcessing()
+		mdi.messageProcessed(message, fromConnectedPeer, arrivalTime, errProcess)
 	}()
 
 	return nil
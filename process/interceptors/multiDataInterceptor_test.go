@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/process/mock"
@@ -643,6 +644,164 @@ func TestMultiDataInterceptor_SetInterceptedDebugHandlerShouldWork(t *testing.T)
 	assert.True(t, debugger == mdi.InterceptedDebugHandler()) //pointer testing
 }
 
+func TestMultiDataInterceptor_SetMessageTracerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	err := mdi.SetMessageTracer(nil)
+
+	assert.Equal(t, process.ErrNilMessageTracer, err)
+}
+
+func TestMultiDataInterceptor_SetMessageMetricsNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	err := mdi.SetMessageMetrics(nil)
+
+	assert.Equal(t, process.ErrNilMessageMetrics, err)
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageShouldUpdateMessageMetricsAfterProcessing(t *testing.T) {
+	t.Parallel()
+
+	processingDuration := time.Millisecond * 100
+	marshalizer := &mock.MarshalizerMock{}
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	processCalledNum := int32(0)
+	arg.Processor = &mock.InterceptorProcessorStub{
+		ValidateCalled: func(data process.InterceptedData) error {
+			return nil
+		},
+		SaveCalled: func(data process.InterceptedData) error {
+			time.Sleep(processingDuration)
+			atomic.AddInt32(&processCalledNum, 1)
+			return nil
+		},
+	}
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	chMetrics := make(chan error, 1)
+	metricsHandler := &testscommon.P2PMessagesMetricsHandlerStub{
+		ObserveMessageCalled: func(topic string, message p2p.MessageP2P, observedDuration time.Duration, err error) {
+			assert.True(t, observedDuration >= processingDuration*2)
+			assert.Equal(t, int32(2), atomic.LoadInt32(&processCalledNum))
+			chMetrics <- err
+		},
+	}
+	require.Nil(t, mdi.SetMessageMetrics(metricsHandler))
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: [][]byte{[]byte("buff1"), []byte("buff2")}})
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: dataField,
+	}
+	err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+	assert.Nil(t, err)
+
+	select {
+	case metricsErr := <-chMetrics:
+		assert.Nil(t, metricsErr)
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "timeout while waiting for the message metrics")
+	}
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageShouldTraceMessage(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	var tracedErr error
+	numTraced := 0
+	tracer := &testscommon.P2PMessageTracerStub{
+		TraceMessageCalled: func(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error) {
+			assert.Equal(t, arg.Topic, topic)
+			assert.Equal(t, fromConnectedPeerId, fromConnectedPeer)
+			tracedErr = err
+			numTraced++
+		},
+	}
+	err := mdi.SetMessageTracer(tracer)
+	require.Nil(t, err)
+
+	err = mdi.ProcessReceivedMessage(nil, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+
+	assert.Equal(t, process.ErrNilMessage, err)
+	assert.Equal(t, process.ErrNilMessage, tracedErr)
+	assert.Equal(t, 1, numTraced)
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageShouldTraceMessageAfterProcessing(t *testing.T) {
+	t.Parallel()
+
+	processingDuration := time.Millisecond * 100
+	expectedErr := errors.New("expected error")
+	marshalizer := &mock.MarshalizerMock{}
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	numSaved := int32(0)
+	arg.Processor = &mock.InterceptorProcessorStub{
+		ValidateCalled: func(data process.InterceptedData) error {
+			return nil
+		},
+		SaveCalled: func(data process.InterceptedData) error {
+			time.Sleep(processingDuration)
+			if atomic.AddInt32(&numSaved, 1) == 1 {
+				return expectedErr
+			}
+
+			return nil
+		},
+	}
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	chTraced := make(chan error, 1)
+	tracer := &testscommon.P2PMessageTracerStub{
+		TraceMessageCalled: func(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error) {
+			assert.True(t, time.Since(arrivalTime) >= processingDuration*2)
+			assert.Equal(t, int32(2), atomic.LoadInt32(&numSaved))
+			chTraced <- err
+		},
+	}
+	require.Nil(t, mdi.SetMessageTracer(tracer))
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: [][]byte{[]byte("buff1"), []byte("buff2")}})
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: dataField,
+	}
+	err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+	assert.Nil(t, err)
+
+	select {
+	case tracedErr := <-chTraced:
+		assert.Equal(t, expectedErr, tracedErr)
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "timeout while waiting for the message to be traced")
+	}
+}
+
 func TestMultiDataInterceptor_ProcessReceivedMessageIsOriginatorNotOkButWhiteListed(t *testing.T) {
 	t.Parallel()
 
@@ -1,12 +1,16 @@
 package interceptors
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/debug/handler"
+	p2pDebug "github.com/multiversx/mx-chain-go/debug/p2p"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/interceptors/disabled"
 )
 
 // ArgSingleDataInterceptor is the argument for the single-data interceptor
@@ -64,6 +68,8 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 			processor:            arg.Processor,
 			preferredPeersHolder: arg.PreferredPeersHolder,
 			debugHandler:         handler.NewDisabledInterceptorDebugHandler(),
+			messageTracer:        p2pDebug.NewDisabledMessageTracer(),
+			messageMetrics:       disabled.NewDisabledP2PMessagesMetrics(),
 		},
 		factory:          arg.DataFactory,
 		whiteListRequest: arg.WhiteListRequest,
@@ -75,6 +81,17 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 // ProcessReceivedMessage is the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
 func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, _ p2p.MessageHandler) error {
+	arrivalTime := time.Now()
+	err := sdi.processReceivedMessage(message, fromConnectedPeer, arrivalTime)
+	if err != nil {
+		sdi.messageProcessed(message, fromConnectedPeer, arrivalTime, err)
+	}
+
+	return err
+}
+
+// processReceivedMessage traces the message by itself only if it returns a nil error
+func (sdi *SingleDataInterceptor) processReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time) error {
 	err := sdi.preProcessMesage(message, fromConnectedPeer)
 	if err != nil {
 		return err
@@ -132,13 +149,15 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 			"is for current shard", isForCurrentShard,
 			"is white listed", isWhiteListed,
 		)
+		sdi.messageProcessed(message, fromConnectedPeer, arrivalTime, nil)
 
 		return nil
 	}
 
 	go func() {
-		sdi.processInterceptedData(interceptedData, message)
+		errProcess := sdi.processInterceptedData(interceptedData, message)
 		sdi.throttler.EndProcessing()
+		sdi.messageProcessed(message, fromConnectedPeer, arrivalTime, errProcess)
 	}()
 
 	return nil
@@ -8,6 +8,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/process/mock"
@@ -458,6 +459,177 @@ func TestSingleDataInterceptor_SetInterceptedDebugHandlerShouldWork(t *testing.T
 	assert.True(t, debugger == sdi.InterceptedDebugHandler()) //pointer testing
 }
 
+func TestSingleDataInterceptor_SetMessageTracerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	err := sdi.SetMessageTracer(nil)
+
+	assert.Equal(t, process.ErrNilMessageTracer, err)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageShouldTraceMessage(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	var tracedErr error
+	numTraced := 0
+	tracer := &testscommon.P2PMessageTracerStub{
+		TraceMessageCalled: func(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error) {
+			assert.Equal(t, arg.Topic, topic)
+			assert.Equal(t, fromConnectedPeerId, fromConnectedPeer)
+			tracedErr = err
+			numTraced++
+		},
+	}
+	err := sdi.SetMessageTracer(tracer)
+	require.Nil(t, err)
+
+	err = sdi.ProcessReceivedMessage(nil, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+
+	assert.Equal(t, process.ErrNilMessage, err)
+	assert.Equal(t, process.ErrNilMessage, tracedErr)
+	assert.Equal(t, 1, numTraced)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageShouldTraceMessageAfterProcessing(t *testing.T) {
+	t.Parallel()
+
+	processingDuration := time.Millisecond * 200
+	expectedErr := errors.New("expected error")
+	arg := createMockArgSingleDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	arg.Processor = &mock.InterceptorProcessorStub{
+		ValidateCalled: func(data process.InterceptedData) error {
+			return nil
+		},
+		SaveCalled: func(data process.InterceptedData) error {
+			time.Sleep(processingDuration)
+			return expectedErr
+		},
+	}
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	chTraced := make(chan error, 1)
+	tracer := &testscommon.P2PMessageTracerStub{
+		TraceMessageCalled: func(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error) {
+			assert.True(t, time.Since(arrivalTime) >= processingDuration)
+			chTraced <- err
+		},
+	}
+	require.Nil(t, sdi.SetMessageTracer(tracer))
+
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: []byte("data to be processed"),
+	}
+	err := sdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+	assert.Nil(t, err)
+
+	select {
+	case tracedErr := <-chTraced:
+		assert.Equal(t, expectedErr, tracedErr)
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "timeout while waiting for the message to be traced")
+	}
+}
+
+func TestSingleDataInterceptor_SetMessageMetricsNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	err := sdi.SetMessageMetrics(nil)
+
+	assert.Equal(t, process.ErrNilMessageMetrics, err)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageShouldUpdateMessageMetrics(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	numTraced := 0
+	tracer := &testscommon.P2PMessageTracerStub{
+		TraceMessageCalled: func(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error) {
+			numTraced++
+		},
+	}
+	metricsHandler := &testscommon.P2PMessagesMetricsHandlerStub{
+		ObserveMessageCalled: func(topic string, message p2p.MessageP2P, processingDuration time.Duration, err error) {
+			assert.Equal(t, arg.Topic, topic)
+			assert.Equal(t, process.ErrNilMessage, err)
+			numTraced++
+		},
+	}
+	require.Nil(t, sdi.SetMessageTracer(tracer))
+	require.Nil(t, sdi.SetMessageMetrics(metricsHandler))
+
+	_ = sdi.ProcessReceivedMessage(nil, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+
+	assert.Equal(t, 2, numTraced)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageShouldUpdateMessageMetricsAfterProcessing(t *testing.T) {
+	t.Parallel()
+
+	processingDuration := time.Millisecond * 200
+	expectedErr := errors.New("expected error")
+	arg := createMockArgSingleDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	arg.Processor = &mock.InterceptorProcessorStub{
+		ValidateCalled: func(data process.InterceptedData) error {
+			time.Sleep(processingDuration)
+			return expectedErr
+		},
+	}
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	chMetrics := make(chan error, 1)
+	metricsHandler := &testscommon.P2PMessagesMetricsHandlerStub{
+		ObserveMessageCalled: func(topic string, message p2p.MessageP2P, observedDuration time.Duration, err error) {
+			assert.Equal(t, arg.Topic, topic)
+			assert.True(t, observedDuration >= processingDuration)
+			chMetrics <- err
+		},
+	}
+	require.Nil(t, sdi.SetMessageMetrics(metricsHandler))
+
+	msg := &p2pmocks.P2PMessageMock{
+		DataField: []byte("data to be processed"),
+	}
+	err := sdi.ProcessReceivedMessage(msg, fromConnectedPeerId, &p2pmocks.MessengerStub{})
+	assert.Nil(t, err)
+
+	select {
+	case metricsErr := <-chMetrics:
+		assert.Equal(t, expectedErr, metricsErr)
+	case <-time.After(time.Second * 2):
+		assert.Fail(t, "timeout while waiting for the message metrics")
+	}
+}
+
 func TestSingleDataInterceptor_Close(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"math/big"
 	"time"
 
@@ -543,6 +544,28 @@ type BlockChainHookHandler interface {
 	IsBuiltinFunctionName(functionName string) bool
 }
 
+// StateOverridesHandler defines an accounts adapter able to temporarily replace parts of the accounts state
+type StateOverridesHandler interface {
+	SetStateOverrides(overrides common.StateOverrides) error
+	CleanStateOverrides()
+}
+
+// HistoricalStateHandler defines an accounts adapter able to temporarily serve the accounts state from a past root hash
+type HistoricalStateHandler interface {
+	SetHistoricalState(rootHashHolder common.RootHashHolder) error
+	ResetHistoricalState()
+}
+
+// ExecutionTracer defines the component able to record the call tree of smart contract executions
+type ExecutionTracer interface {
+	OnContractCallStart(input *vmcommon.ContractCallInput)
+	OnContractCreateStart(input *vmcommon.ContractCreateInput)
+	OnBuiltInFunctionCallStart(input *vmcommon.ContractCallInput)
+	OnExecutionEnd(vmOutput *vmcommon.VMOutput, err error)
+	OnStorageRead(address []byte, key []byte, value []byte)
+	IsInterfaceNil() bool
+}
+
 // BlockChainHookWithAccountsAdapter defines an extension of BlockChainHookHandler with the AccountsAdapter exposed
 type BlockChainHookWithAccountsAdapter interface {
 	BlockChainHookHandler
@@ -554,6 +577,8 @@ type BlockChainHookWithAccountsAdapter interface {
 type Interceptor interface {
 	ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, source p2p.MessageHandler) error
 	SetInterceptedDebugHandler(handler InterceptedDebugger) error
+	SetMessageTracer(tracer P2PMessageTracer) error
+	SetMessageMetrics(handler P2PMessagesMetricsHandler) error
 	RegisterHandler(handler func(topic string, hash []byte, data interface{}))
 	Close() error
 	IsInterfaceNil() bool
@@ -759,6 +784,27 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReputationHandler defines the behavior of a component able to keep the reputation of the network peers
+// and to evaluate the operator-managed deny and allow lists
+type PeerReputationHandler interface {
+	RecordPeerIDEvent(pid core.PeerID, source string, reason string, scoreDelta float64, banDuration time.Duration)
+	RecordPublicKeyEvent(pk []byte, source string, reason string, scoreDelta float64, banDuration time.Duration)
+	IsPeerIDDenied(pid core.PeerID) bool
+	IsPublicKeyDenied(pk []byte) bool
+	IsAddressDenied(address string) bool
+	IsPeerIDAllowed(pid core.PeerID) bool
+	IsPublicKeyAllowed(pk []byte) bool
+	GetPeersReputation() []*common.PeerReputationAPIResponse
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// PeerAddressesProvider is able to return the known addresses of a peer
+type PeerAddressesProvider interface {
+	PeerAddresses(pid core.PeerID) []string
+	IsInterfaceNil() bool
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	UpdatePeerIDPublicKeyPair(pid core.PeerID, pk []byte)
@@ -792,6 +838,7 @@ type SCQuery struct {
 	ShouldBeSynced bool
 	BlockNonce     core.OptionalUint64
 	BlockHash      []byte
+	StateOverrides common.StateOverrides
 }
 
 // GasHandler is able to perform some gas calculation
@@ -932,7 +979,7 @@ type PeerValidatorMapper interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -987,6 +1034,7 @@ type EpochStartSystemSCProcessor interface {
 		rewardTxs epochStart.TransactionCacher,
 	) error
 	ToggleUnStakeUnBond(value bool) error
+	GetEconomicsParameters() ([]byte, error)
 	IsInterfaceNil() bool
 }
 
@@ -1078,6 +1126,19 @@ type InterceptedDebugger interface {
 	IsInterfaceNil() bool
 }
 
+// P2PMessageTracer defines the behavior of a component able to trace the p2p messages processed by the interceptors
+type P2PMessageTracer interface {
+	TraceMessage(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error)
+	IsInterfaceNil() bool
+}
+
+// P2PMessagesMetricsHandler defines the behavior of a component able to record metrics about the p2p messages processed
+// by the interceptors
+type P2PMessagesMetricsHandler interface {
+	ObserveMessage(topic string, message p2p.MessageP2P, processingDuration time.Duration, err error)
+	IsInterfaceNil() bool
+}
+
 // PreferredPeersHolderHandler defines the behavior of a component able to handle preferred peers operations
 type PreferredPeersHolderHandler interface {
 	Get() map[uint32][]core.PeerID
@@ -1304,6 +1365,17 @@ type GuardedAccountHandler interface {
 	IsInterfaceNil() bool
 }
 
+// GuardianCoSigner defines the operations of the self-hosted guardian co-signing service
+type GuardianCoSigner interface {
+	GetRegistrationChallenge(address []byte) (*common.GuardianRegistrationChallenge, error)
+	RegisterAccount(address []byte, signature []byte) (*common.GuardianRegistration, error)
+	VerifyCode(address []byte, code string) error
+	SetSpendingPolicy(address []byte, code string, policy *common.GuardianSpendingPolicy) error
+	CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // DoubleTransactionDetector is able to detect if a transaction hash is present more than once in a block body
 type DoubleTransactionDetector interface {
 	ProcessBlockBody(body *block.Body)
@@ -1312,7 +1384,7 @@ type DoubleTransactionDetector interface {
 
 // TxsSenderHandler handles transactions sending
 type TxsSenderHandler interface {
-	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
+	SendBulkTransactions(ctx context.Context, txs []*transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -7,6 +7,7 @@ type FloodPreventerStub struct {
 	IncreaseLoadCalled       func(pid core.PeerID, size uint64) error
 	ApplyConsensusSizeCalled func(size int)
 	ResetCalled              func()
+	SetQuotaPercentCalled    func(percent uint32)
 }
 
 // IncreaseLoad -
@@ -26,6 +27,13 @@ func (fps *FloodPreventerStub) Reset() {
 	fps.ResetCalled()
 }
 
+// SetQuotaPercent -
+func (fps *FloodPreventerStub) SetQuotaPercent(percent uint32) {
+	if fps.SetQuotaPercentCalled != nil {
+		fps.SetQuotaPercentCalled(percent)
+	}
+}
+
 // IsInterfaceNil -
 func (fps *FloodPreventerStub) IsInterfaceNil() bool {
 	return fps == nil
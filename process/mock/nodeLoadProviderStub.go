@@ -0,0 +1,30 @@
+package mock
+
+// NodeLoadProviderStub -
+type NodeLoadProviderStub struct {
+	CpuLoadPercentCalled        func() uint64
+	ProcessingLoadPercentCalled func() uint64
+}
+
+// CpuLoadPercent -
+func (stub *NodeLoadProviderStub) CpuLoadPercent() uint64 {
+	if stub.CpuLoadPercentCalled != nil {
+		return stub.CpuLoadPercentCalled()
+	}
+
+	return 0
+}
+
+// ProcessingLoadPercent -
+func (stub *NodeLoadProviderStub) ProcessingLoadPercent() uint64 {
+	if stub.ProcessingLoadPercentCalled != nil {
+		return stub.ProcessingLoadPercentCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *NodeLoadProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
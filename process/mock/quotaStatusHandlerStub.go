@@ -7,6 +7,7 @@ type QuotaStatusHandlerStub struct {
 	ResetStatisticsCalled func()
 	AddQuotaCalled        func(pid core.PeerID, numReceivedMessages uint32, sizeReceivedMessages uint64,
 		numProcessedMessages uint32, sizeProcessedMessages uint64)
+	SetLiveQuotaCalled func(maxNumMessages uint32, maxTotalSize uint64)
 }
 
 // ResetStatistics -
@@ -29,6 +30,13 @@ func (qshs *QuotaStatusHandlerStub) AddQuota(
 	}
 }
 
+// SetLiveQuota -
+func (qshs *QuotaStatusHandlerStub) SetLiveQuota(maxNumMessages uint32, maxTotalSize uint64) {
+	if qshs.SetLiveQuotaCalled != nil {
+		qshs.SetLiveQuotaCalled(maxNumMessages, maxTotalSize)
+	}
+}
+
 // IsInterfaceNil -
 func (qshs *QuotaStatusHandlerStub) IsInterfaceNil() bool {
 	return qshs == nil
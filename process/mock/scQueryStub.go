@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
@@ -15,7 +17,7 @@ type ScQueryStub struct {
 }
 
 // ExecuteQuery -
-func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+func (s *ScQueryStub) ExecuteQuery(_ context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	if s.ExecuteQueryCalled != nil {
 		return s.ExecuteQueryCalled(query)
 	}
@@ -13,6 +13,7 @@ func NewP2pPeerHonestyWithCustomExecuteDelayFunction(
 	peerHonestyConfig config.PeerHonestyConfig,
 	blackListedPkCache process.TimeCacher,
 	cache storage.Cacher,
+	peerReputationHandler process.PeerReputationHandler,
 	handler func(),
 ) (*p2pPeerHonesty, error) {
 	instance := &p2pPeerHonesty{
@@ -24,6 +25,7 @@ func NewP2pPeerHonestyWithCustomExecuteDelayFunction(
 		unitValue:              peerHonestyConfig.UnitValue,
 		cache:                  cache,
 		blackListedPkCache:     blackListedPkCache,
+		peerReputationHandler:  peerReputationHandler,
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
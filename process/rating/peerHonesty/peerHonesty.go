@@ -36,6 +36,7 @@ type p2pPeerHonesty struct {
 	cache                  storage.Cacher
 	mut                    sync.RWMutex
 	blackListedPkCache     process.TimeCacher
+	peerReputationHandler  process.PeerReputationHandler
 	cancelFunc             func()
 }
 
@@ -45,8 +46,9 @@ func NewP2pPeerHonesty(
 	peerHonestyConfig config.PeerHonestyConfig,
 	blackListedPkCache process.TimeCacher,
 	cache storage.Cacher,
+	peerReputationHandler process.PeerReputationHandler,
 ) (*p2pPeerHonesty, error) {
-	err := checkParams(peerHonestyConfig, blackListedPkCache, cache)
+	err := checkParams(peerHonestyConfig, blackListedPkCache, cache, peerReputationHandler)
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating an instance of p2pPeerHonesty", err)
 	}
@@ -60,6 +62,7 @@ func NewP2pPeerHonesty(
 		unitValue:              peerHonestyConfig.UnitValue,
 		cache:                  cache,
 		blackListedPkCache:     blackListedPkCache,
+		peerReputationHandler:  peerReputationHandler,
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -74,6 +77,7 @@ func checkParams(
 	peerHonestyConfig config.PeerHonestyConfig,
 	blackListedPkCache process.TimeCacher,
 	cache storage.Cacher,
+	peerReputationHandler process.PeerReputationHandler,
 ) error {
 	if check.IfNil(blackListedPkCache) {
 		return process.ErrNilBlackListedPkCache
@@ -83,6 +87,10 @@ func checkParams(
 		return process.ErrNilCacher
 	}
 
+	if check.IfNil(peerReputationHandler) {
+		return process.ErrNilPeerReputationHandler
+	}
+
 	isDecayCoefficientOk := peerHonestyConfig.DecayCoefficient > minDecayCoefficient &&
 		peerHonestyConfig.DecayCoefficient < maxDecayCoefficient
 	if !isDecayCoefficientOk {
@@ -239,6 +247,13 @@ func (pph *p2pPeerHonesty) checkBlacklistNoLock(ps *peerScore) {
 		return
 	}
 
+	if pph.peerReputationHandler.IsPublicKeyAllowed([]byte(ps.pk)) {
+		log.Debug("p2pPeerHonesty.checkBlacklist: pk is in the allow list, will not blacklist",
+			"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(ps.pk))),
+		)
+		return
+	}
+
 	log.Debug("p2pPeerHonesty.checkBlacklist: added blacklisted pk",
 		"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(ps.pk))),
 		"duration", common.PublicKeyBlacklistDuration,
@@ -249,7 +264,16 @@ func (pph *p2pPeerHonesty) checkBlacklistNoLock(ps *peerScore) {
 		log.Warn("p2pPeerHonesty.checkBlacklist",
 			"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(ps.pk))),
 			"error", err)
+		return
 	}
+
+	pph.peerReputationHandler.RecordPublicKeyEvent(
+		[]byte(ps.pk),
+		common.PeerReputationSourcePeerHonesty,
+		"score below bad peer threshold",
+		common.PeerReputationBanPenalty,
+		common.PublicKeyBlacklistDuration,
+	)
 }
 
 // Close closes the running go routines related to this instance
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -32,6 +33,7 @@ func TestNewP2pPeerHonesty_NilCacheShouldErr(t *testing.T) {
 		createMockPeerHonestyConfig(),
 		&testscommon.TimeCacheStub{},
 		nil,
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -45,12 +47,27 @@ func TestNewP2pPeerHonesty_NilBlacklistedPkCacheShouldErr(t *testing.T) {
 		createMockPeerHonestyConfig(),
 		nil,
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
 	assert.True(t, errors.Is(err, process.ErrNilBlackListedPkCache))
 }
 
+func TestNewP2pPeerHonesty_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pph, err := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&testscommon.TimeCacheStub{},
+		&testscommon.CacherStub{},
+		nil,
+	)
+
+	assert.True(t, check.IfNil(pph))
+	assert.True(t, errors.Is(err, process.ErrNilPeerReputationHandler))
+}
+
 func TestNewP2pPeerHonesty_InvalidDecayCoefficientShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -60,6 +77,7 @@ func TestNewP2pPeerHonesty_InvalidDecayCoefficientShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -75,6 +93,7 @@ func TestNewP2pPeerHonesty_InvalidDecayUpdateIntervalShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -90,6 +109,7 @@ func TestNewP2pPeerHonesty_InvalidMinScoreShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -105,6 +125,7 @@ func TestNewP2pPeerHonesty_InvalidMaxScoreShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -120,6 +141,7 @@ func TestNewP2pPeerHonesty_InvalidUnitValueShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -135,6 +157,7 @@ func TestNewP2pPeerHonesty_InvalidBadPeerThresholdShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -149,6 +172,7 @@ func TestNewP2pPeerHonesty_ShouldWork(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	assert.False(t, check.IfNil(pph))
@@ -168,6 +192,7 @@ func TestP2pPeerHonesty_Close(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		handler,
 	)
 
@@ -190,6 +215,7 @@ func TestP2pPeerHonesty_ChangeScoreShouldWork(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
@@ -211,6 +237,7 @@ func TestP2pPeerHonesty_DoubleChangeScoreShouldWork(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
@@ -244,6 +271,7 @@ func TestP2pPeerHonesty_CheckBlacklistNotBlacklisted(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
@@ -276,6 +304,7 @@ func TestP2pPeerHonesty_CheckBlacklistMaxScoreReached(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
@@ -311,6 +340,7 @@ func TestP2pPeerHonesty_CheckBlacklistMinScoreReached(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
@@ -326,6 +356,64 @@ func TestP2pPeerHonesty_CheckBlacklistMinScoreReached(t *testing.T) {
 	assert.True(t, upsertCalled)
 }
 
+func TestP2pPeerHonesty_CheckBlacklistMinScoreReachedShouldRecordReputationEvent(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPeerHonestyConfig()
+	cfg.UnitValue = 4
+	recordedPk := make([]byte, 0)
+	recordedDuration := time.Duration(0)
+	pph, _ := NewP2pPeerHonesty(
+		cfg,
+		&testscommon.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{
+			RecordPublicKeyEventCalled: func(pk []byte, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+				recordedPk = pk
+				recordedDuration = banDuration
+			},
+		},
+	)
+
+	pk := "pk"
+	pph.ChangeScore(pk, "topic", int(cfg.MinScore)-1)
+
+	assert.Equal(t, []byte(pk), recordedPk)
+	assert.Equal(t, common.PublicKeyBlacklistDuration, recordedDuration)
+}
+
+func TestP2pPeerHonesty_CheckBlacklistAllowedPkShouldNotCallUpsert(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPeerHonestyConfig()
+	cfg.UnitValue = 4
+	upsertCalled := false
+	recordCalled := false
+	pph, _ := NewP2pPeerHonesty(
+		cfg,
+		&testscommon.TimeCacheStub{
+			UpsertCalled: func(key string, span time.Duration) error {
+				upsertCalled = true
+				return nil
+			},
+		},
+		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{
+			IsPublicKeyAllowedCalled: func(pk []byte) bool {
+				return true
+			},
+			RecordPublicKeyEventCalled: func(pk []byte, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+				recordCalled = true
+			},
+		},
+	)
+
+	pph.ChangeScore("pk", "topic", int(cfg.MinScore)-1)
+
+	assert.False(t, upsertCalled)
+	assert.False(t, recordCalled)
+}
+
 func TestP2pPeerHonesty_CheckBlacklistHasShouldNotCallUpsert(t *testing.T) {
 	t.Parallel()
 
@@ -346,6 +434,7 @@ func TestP2pPeerHonesty_CheckBlacklistHasShouldNotCallUpsert(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
@@ -375,6 +464,7 @@ func TestP2pPeerHonesty_CheckBlacklistUpsertErrorsShouldWork(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
@@ -393,6 +483,7 @@ func TestP2pPeerHonesty_ApplyDecay(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pks := []string{"pkMin", "pkMax", "pkNearZero", "pkZero", "pkValue"}
@@ -423,6 +514,7 @@ func TestP2pPeerHonesty_ApplyDecayWillEventuallyGoTheScoreToZero(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&testscommon.PeerReputationHandlerStub{},
 	)
 
 	pk := "pk"
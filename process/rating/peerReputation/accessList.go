@@ -0,0 +1,131 @@
+package peerReputation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+const multiAddressIPv4Protocol = "ip4"
+const multiAddressIPv6Protocol = "ip6"
+
+// accessList holds an operator-managed list of peer IDs, IP networks and BLS public keys
+type accessList struct {
+	peerIDs    map[core.PeerID]struct{}
+	publicKeys map[string]struct{}
+	networks   []*net.IPNet
+}
+
+func newAccessList(cfg config.PeerAccessListConfig) (*accessList, error) {
+	al := &accessList{
+		peerIDs:    make(map[core.PeerID]struct{}),
+		publicKeys: make(map[string]struct{}),
+		networks:   make([]*net.IPNet, 0, len(cfg.IPs)),
+	}
+
+	for _, pidString := range cfg.PeerIDs {
+		pid, err := core.NewPeerID(pidString)
+		if err != nil {
+			return nil, fmt.Errorf("%w, peer ID %s: %s", process.ErrInvalidPeerAccessListEntry, pidString, err.Error())
+		}
+
+		al.peerIDs[pid] = struct{}{}
+	}
+
+	for _, pkString := range cfg.PublicKeys {
+		pk, err := hex.DecodeString(pkString)
+		if err != nil || len(pk) == 0 {
+			return nil, fmt.Errorf("%w, public key %s", process.ErrInvalidPeerAccessListEntry, pkString)
+		}
+
+		al.publicKeys[string(pk)] = struct{}{}
+	}
+
+	for _, ipString := range cfg.IPs {
+		network, err := parseNetwork(ipString)
+		if err != nil {
+			return nil, fmt.Errorf("%w, IP %s: %s", process.ErrInvalidPeerAccessListEntry, ipString, err.Error())
+		}
+
+		al.networks = append(al.networks, network)
+	}
+
+	return al, nil
+}
+
+func parseNetwork(ipString string) (*net.IPNet, error) {
+	if strings.Contains(ipString, "/") {
+		_, network, err := net.ParseCIDR(ipString)
+		return network, err
+	}
+
+	ip := net.ParseIP(ipString)
+	if ip == nil {
+		return nil, fmt.Errorf("not an IP address")
+	}
+
+	numBits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		numBits = 8 * net.IPv4len
+	}
+
+	return &net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(numBits, numBits),
+	}, nil
+}
+
+func (al *accessList) hasPeerID(pid core.PeerID) bool {
+	_, found := al.peerIDs[pid]
+	return found
+}
+
+func (al *accessList) hasPublicKey(pk []byte) bool {
+	_, found := al.publicKeys[string(pk)]
+	return found
+}
+
+// hasAddress accepts either a plain IP address or a multi address like /ip4/127.0.0.1/tcp/37373
+func (al *accessList) hasAddress(address string) bool {
+	if len(al.networks) == 0 {
+		return false
+	}
+
+	ip := extractIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range al.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (al *accessList) isEmpty() bool {
+	return len(al.peerIDs) == 0 && len(al.publicKeys) == 0 && len(al.networks) == 0
+}
+
+func extractIP(address string) net.IP {
+	if !strings.HasPrefix(address, "/") {
+		return net.ParseIP(address)
+	}
+
+	parts := strings.Split(address, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == multiAddressIPv4Protocol || parts[i] == multiAddressIPv6Protocol {
+			return net.ParseIP(parts[i+1])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package peerReputation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPeerID = "16Uiu2HAmSHgyTYyawhsZv9opxTHX77vKjoPeGkyCYS5fYVMssHjN"
+
+func TestNewAccessList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid peer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := newAccessList(config.PeerAccessListConfig{PeerIDs: []string{"invalid"}})
+		assert.Nil(t, al)
+		assert.True(t, errors.Is(err, process.ErrInvalidPeerAccessListEntry))
+	})
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := newAccessList(config.PeerAccessListConfig{PublicKeys: []string{"not hex"}})
+		assert.Nil(t, al)
+		assert.True(t, errors.Is(err, process.ErrInvalidPeerAccessListEntry))
+	})
+	t.Run("invalid IP should error", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := newAccessList(config.PeerAccessListConfig{IPs: []string{"300.0.0.1"}})
+		assert.Nil(t, al)
+		assert.True(t, errors.Is(err, process.ErrInvalidPeerAccessListEntry))
+	})
+	t.Run("invalid network should error", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := newAccessList(config.PeerAccessListConfig{IPs: []string{"10.0.0.0/99"}})
+		assert.Nil(t, al)
+		assert.True(t, errors.Is(err, process.ErrInvalidPeerAccessListEntry))
+	})
+	t.Run("empty config should work", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := newAccessList(config.PeerAccessListConfig{})
+		assert.Nil(t, err)
+		assert.True(t, al.isEmpty())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		al, err := newAccessList(config.PeerAccessListConfig{
+			PeerIDs:    []string{testPeerID},
+			IPs:        []string{"10.0.0.0/8", "192.168.1.1", "2001:db8::1"},
+			PublicKeys: []string{"abcd"},
+		})
+		require.Nil(t, err)
+		assert.False(t, al.isEmpty())
+		assert.Equal(t, 3, len(al.networks))
+	})
+}
+
+func TestAccessList_HasPeerID(t *testing.T) {
+	t.Parallel()
+
+	al, _ := newAccessList(config.PeerAccessListConfig{PeerIDs: []string{testPeerID}})
+
+	pid, _ := core.NewPeerID(testPeerID)
+	assert.True(t, al.hasPeerID(pid))
+	assert.False(t, al.hasPeerID("other pid"))
+}
+
+func TestAccessList_HasPublicKey(t *testing.T) {
+	t.Parallel()
+
+	al, _ := newAccessList(config.PeerAccessListConfig{PublicKeys: []string{"abcd"}})
+
+	assert.True(t, al.hasPublicKey([]byte{0xab, 0xcd}))
+	assert.False(t, al.hasPublicKey([]byte{0xab}))
+}
+
+func TestAccessList_HasAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty list should return false", func(t *testing.T) {
+		t.Parallel()
+
+		al, _ := newAccessList(config.PeerAccessListConfig{})
+		assert.False(t, al.hasAddress("10.0.0.1"))
+	})
+	t.Run("should match plain IPs and multi addresses", func(t *testing.T) {
+		t.Parallel()
+
+		al, _ := newAccessList(config.PeerAccessListConfig{
+			IPs: []string{"10.0.0.0/8", "192.168.1.1", "2001:db8::1"},
+		})
+
+		assert.True(t, al.hasAddress("10.1.2.3"))
+		assert.True(t, al.hasAddress("/ip4/10.1.2.3/tcp/37373"))
+		assert.True(t, al.hasAddress("192.168.1.1"))
+		assert.True(t, al.hasAddress("/ip4/192.168.1.1/tcp/37373/p2p/"+testPeerID))
+		assert.True(t, al.hasAddress("/ip6/2001:db8::1/tcp/37373"))
+
+		assert.False(t, al.hasAddress("192.168.1.2"))
+		assert.False(t, al.hasAddress("/ip4/11.0.0.1/tcp/37373"))
+		assert.False(t, al.hasAddress("/dns4/example.com/tcp/37373"))
+		assert.False(t, al.hasAddress("not an address"))
+	})
+}
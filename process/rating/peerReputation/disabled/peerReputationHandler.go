@@ -0,0 +1,64 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type peerReputationHandler struct {
+}
+
+// NewPeerReputationHandler returns a new instance of a disabled peer reputation handler
+func NewPeerReputationHandler() *peerReputationHandler {
+	return &peerReputationHandler{}
+}
+
+// RecordPeerIDEvent does nothing
+func (handler *peerReputationHandler) RecordPeerIDEvent(_ core.PeerID, _ string, _ string, _ float64, _ time.Duration) {
+}
+
+// RecordPublicKeyEvent does nothing
+func (handler *peerReputationHandler) RecordPublicKeyEvent(_ []byte, _ string, _ string, _ float64, _ time.Duration) {
+}
+
+// IsPeerIDDenied returns false
+func (handler *peerReputationHandler) IsPeerIDDenied(_ core.PeerID) bool {
+	return false
+}
+
+// IsPublicKeyDenied returns false
+func (handler *peerReputationHandler) IsPublicKeyDenied(_ []byte) bool {
+	return false
+}
+
+// IsAddressDenied returns false
+func (handler *peerReputationHandler) IsAddressDenied(_ string) bool {
+	return false
+}
+
+// IsPeerIDAllowed returns false
+func (handler *peerReputationHandler) IsPeerIDAllowed(_ core.PeerID) bool {
+	return false
+}
+
+// IsPublicKeyAllowed returns false
+func (handler *peerReputationHandler) IsPublicKeyAllowed(_ []byte) bool {
+	return false
+}
+
+// GetPeersReputation returns an empty slice
+func (handler *peerReputationHandler) GetPeersReputation() []*common.PeerReputationAPIResponse {
+	return make([]*common.PeerReputationAPIResponse, 0)
+}
+
+// Close returns nil
+func (handler *peerReputationHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *peerReputationHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,411 @@
+package peerReputation
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/rating/peerreputation")
+
+const (
+	peerIDKeyPrefix      = "pid_"
+	publicKeyKeyPrefix   = "pk_"
+	minPersistInterval   = time.Second
+	minMaxNumEntries     = 1
+	minMaxNumReasons     = 1
+	entryTypePeerID      = "pid"
+	entryTypePublicKey   = "pk"
+	approximateZeroScore = 0.00001
+)
+
+// ArgsPeerReputationStore holds the arguments needed to create a new peer reputation store
+type ArgsPeerReputationStore struct {
+	Config     config.PeerReputationConfig
+	Storer     storage.Storer
+	Marshaller marshal.Marshalizer
+}
+
+type peerReputationEntry struct {
+	Type        string                         `json:"type"`
+	Identifier  []byte                         `json:"identifier"`
+	Score       float64                        `json:"score"`
+	NumEvents   uint32                         `json:"numEvents"`
+	BannedUntil int64                          `json:"bannedUntil"`
+	LastUpdate  int64                          `json:"lastUpdate"`
+	Reasons     []*common.PeerReputationReason `json:"reasons"`
+}
+
+type peerReputationStore struct {
+	mut             sync.RWMutex
+	entries         map[string]*peerReputationEntry
+	dirtyKeys       map[string]struct{}
+	removedKeys     map[string]struct{}
+	storer          storage.Storer
+	marshaller      marshal.Marshalizer
+	denyList        *accessList
+	allowList       *accessList
+	maxNumEntries   int
+	maxNumReasons   int
+	persistInterval time.Duration
+	getTimeHandler  func() time.Time
+	cancelFunc      func()
+}
+
+// NewPeerReputationStore creates a new peer reputation store that keeps, in a persistent manner, the events reported
+// against the network peers. It also evaluates the operator-managed deny and allow lists.
+func NewPeerReputationStore(args ArgsPeerReputationStore) (*peerReputationStore, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	denyList, err := newAccessList(args.Config.DenyList)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the deny list", err)
+	}
+	allowList, err := newAccessList(args.Config.AllowList)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the allow list", err)
+	}
+
+	prs := &peerReputationStore{
+		entries:         make(map[string]*peerReputationEntry),
+		dirtyKeys:       make(map[string]struct{}),
+		removedKeys:     make(map[string]struct{}),
+		storer:          args.Storer,
+		marshaller:      args.Marshaller,
+		denyList:        denyList,
+		allowList:       allowList,
+		maxNumEntries:   int(args.Config.MaxNumEntries),
+		maxNumReasons:   int(args.Config.MaxNumReasonsPerPeer),
+		persistInterval: time.Duration(args.Config.PersistIntervalInSeconds) * time.Second,
+		getTimeHandler:  time.Now,
+	}
+
+	prs.loadFromStorage()
+
+	log.Debug("peer reputation store created",
+		"num loaded entries", len(prs.entries),
+		"has deny list", !denyList.isEmpty(),
+		"has allow list", !allowList.isEmpty(),
+	)
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	prs.cancelFunc = cancelFunc
+
+	go prs.persistContinuously(ctx)
+
+	return prs, nil
+}
+
+func checkArgs(args ArgsPeerReputationStore) error {
+	if check.IfNil(args.Storer) {
+		return process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if time.Duration(args.Config.PersistIntervalInSeconds)*time.Second < minPersistInterval {
+		return fmt.Errorf("%w for PersistIntervalInSeconds", process.ErrInvalidValue)
+	}
+	if args.Config.MaxNumEntries < minMaxNumEntries {
+		return fmt.Errorf("%w for MaxNumEntries", process.ErrInvalidValue)
+	}
+	if args.Config.MaxNumReasonsPerPeer < minMaxNumReasons {
+		return fmt.Errorf("%w for MaxNumReasonsPerPeer", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func (prs *peerReputationStore) loadFromStorage() {
+	prs.storer.RangeKeys(func(key []byte, value []byte) bool {
+		entry := &peerReputationEntry{}
+		err := prs.marshaller.Unmarshal(entry, value)
+		if err != nil {
+			log.Debug("peerReputationStore.loadFromStorage: can not unmarshal entry", "error", err)
+			return true
+		}
+
+		prs.entries[string(key)] = entry
+
+		return true
+	})
+}
+
+// RecordPeerIDEvent records an event that changes the reputation of the provided peer ID. A non-zero ban duration
+// will mark the peer as banned, even after a node restart
+func (prs *peerReputationStore) RecordPeerIDEvent(pid core.PeerID, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+	if len(pid) == 0 {
+		return
+	}
+
+	prs.recordEvent(peerIDKeyPrefix+string(pid), entryTypePeerID, pid.Bytes(), source, reason, scoreDelta, banDuration)
+}
+
+// RecordPublicKeyEvent records an event that changes the reputation of the provided BLS public key. A non-zero ban
+// duration will mark the public key as banned, even after a node restart
+func (prs *peerReputationStore) RecordPublicKeyEvent(pk []byte, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+	if len(pk) == 0 {
+		return
+	}
+
+	prs.recordEvent(publicKeyKeyPrefix+string(pk), entryTypePublicKey, pk, source, reason, scoreDelta, banDuration)
+}
+
+func (prs *peerReputationStore) recordEvent(
+	key string,
+	entryType string,
+	identifier []byte,
+	source string,
+	reason string,
+	scoreDelta float64,
+	banDuration time.Duration,
+) {
+	now := prs.getTimeHandler()
+
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	entry, found := prs.entries[key]
+	if !found {
+		prs.evictIfNeededNoLock()
+
+		entry = &peerReputationEntry{
+			Type:       entryType,
+			Identifier: append([]byte(nil), identifier...),
+			Reasons:    make([]*common.PeerReputationReason, 0, prs.maxNumReasons),
+		}
+		prs.entries[key] = entry
+		delete(prs.removedKeys, key)
+	}
+
+	entry.Score += scoreDelta
+	if check.IsZeroFloat64(entry.Score, approximateZeroScore) {
+		entry.Score = 0
+	}
+	entry.NumEvents++
+	entry.LastUpdate = now.Unix()
+	if banDuration > 0 {
+		bannedUntil := now.Add(banDuration).Unix()
+		if bannedUntil > entry.BannedUntil {
+			entry.BannedUntil = bannedUntil
+		}
+	}
+
+	entry.Reasons = append(entry.Reasons, &common.PeerReputationReason{
+		Source:     source,
+		Reason:     reason,
+		ScoreDelta: scoreDelta,
+		Timestamp:  now.Unix(),
+	})
+	if len(entry.Reasons) > prs.maxNumReasons {
+		entry.Reasons = entry.Reasons[len(entry.Reasons)-prs.maxNumReasons:]
+	}
+
+	prs.dirtyKeys[key] = struct{}{}
+}
+
+// evictIfNeededNoLock removes the entry with the oldest update if the maximum number of entries was reached
+func (prs *peerReputationStore) evictIfNeededNoLock() {
+	if len(prs.entries) < prs.maxNumEntries {
+		return
+	}
+
+	oldestKey := ""
+	oldestUpdate := int64(0)
+	for key, entry := range prs.entries {
+		if len(oldestKey) == 0 || entry.LastUpdate < oldestUpdate {
+			oldestKey = key
+			oldestUpdate = entry.LastUpdate
+		}
+	}
+
+	delete(prs.entries, oldestKey)
+	delete(prs.dirtyKeys, oldestKey)
+	prs.removedKeys[oldestKey] = struct{}{}
+}
+
+// IsPeerIDDenied returns true if the provided peer ID is in the deny list or it is still banned. A peer ID
+// found in the allow list is never denied
+func (prs *peerReputationStore) IsPeerIDDenied(pid core.PeerID) bool {
+	if prs.allowList.hasPeerID(pid) {
+		return false
+	}
+	if prs.denyList.hasPeerID(pid) {
+		return true
+	}
+
+	return prs.isBanned(peerIDKeyPrefix + string(pid))
+}
+
+// IsPublicKeyDenied returns true if the provided public key is in the deny list or it is still banned. A public key
+// found in the allow list is never denied
+func (prs *peerReputationStore) IsPublicKeyDenied(pk []byte) bool {
+	if prs.allowList.hasPublicKey(pk) {
+		return false
+	}
+	if prs.denyList.hasPublicKey(pk) {
+		return true
+	}
+
+	return prs.isBanned(publicKeyKeyPrefix + string(pk))
+}
+
+// IsAddressDenied returns true if the provided address (plain IP or multi address) is contained in the deny list
+// and is not contained in the allow list
+func (prs *peerReputationStore) IsAddressDenied(address string) bool {
+	if prs.allowList.hasAddress(address) {
+		return false
+	}
+
+	return prs.denyList.hasAddress(address)
+}
+
+// IsPeerIDAllowed returns true if the provided peer ID is in the allow list
+func (prs *peerReputationStore) IsPeerIDAllowed(pid core.PeerID) bool {
+	return prs.allowList.hasPeerID(pid)
+}
+
+// IsPublicKeyAllowed returns true if the provided public key is in the allow list
+func (prs *peerReputationStore) IsPublicKeyAllowed(pk []byte) bool {
+	return prs.allowList.hasPublicKey(pk)
+}
+
+func (prs *peerReputationStore) isBanned(key string) bool {
+	prs.mut.RLock()
+	entry, found := prs.entries[key]
+	bannedUntil := int64(0)
+	if found {
+		bannedUntil = entry.BannedUntil
+	}
+	prs.mut.RUnlock()
+
+	return bannedUntil > prs.getTimeHandler().Unix()
+}
+
+// GetPeersReputation returns the reputation of all known peers, sorted by score, the worst peers being the first ones
+func (prs *peerReputationStore) GetPeersReputation() []*common.PeerReputationAPIResponse {
+	prs.mut.RLock()
+	defer prs.mut.RUnlock()
+
+	response := make([]*common.PeerReputationAPIResponse, 0, len(prs.entries))
+	for _, entry := range prs.entries {
+		response = append(response, prs.convertEntry(entry))
+	}
+
+	sort.Slice(response, func(i, j int) bool {
+		if response[i].Score == response[j].Score {
+			return response[i].PeerID+response[i].PublicKey < response[j].PeerID+response[j].PublicKey
+		}
+
+		return response[i].Score < response[j].Score
+	})
+
+	return response
+}
+
+func (prs *peerReputationStore) convertEntry(entry *peerReputationEntry) *common.PeerReputationAPIResponse {
+	reasons := make([]*common.PeerReputationReason, 0, len(entry.Reasons))
+	for _, reason := range entry.Reasons {
+		reasonCopy := *reason
+		reasons = append(reasons, &reasonCopy)
+	}
+
+	response := &common.PeerReputationAPIResponse{
+		Score:       entry.Score,
+		NumEvents:   entry.NumEvents,
+		BannedUntil: entry.BannedUntil,
+		Reasons:     reasons,
+	}
+
+	switch entry.Type {
+	case entryTypePeerID:
+		pid := core.PeerID(entry.Identifier)
+		response.PeerID = pid.Pretty()
+		response.IsDenied = prs.denyList.hasPeerID(pid)
+		response.IsAllowed = prs.allowList.hasPeerID(pid)
+	case entryTypePublicKey:
+		response.PublicKey = hex.EncodeToString(entry.Identifier)
+		response.IsDenied = prs.denyList.hasPublicKey(entry.Identifier)
+		response.IsAllowed = prs.allowList.hasPublicKey(entry.Identifier)
+	}
+
+	return response
+}
+
+func (prs *peerReputationStore) persistContinuously(ctx context.Context) {
+	timer := time.NewTimer(prs.persistInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(prs.persistInterval)
+
+		select {
+		case <-ctx.Done():
+			log.Debug("peerReputationStore's go routine is stopping...")
+			return
+		case <-timer.C:
+		}
+
+		prs.persist()
+	}
+}
+
+func (prs *peerReputationStore) persist() {
+	prs.mut.Lock()
+	dirtyData := make(map[string][]byte, len(prs.dirtyKeys))
+	for key := range prs.dirtyKeys {
+		entryBytes, err := prs.marshaller.Marshal(prs.entries[key])
+		if err != nil {
+			log.Warn("peerReputationStore.persist: can not marshal entry", "error", err)
+			continue
+		}
+
+		dirtyData[key] = entryBytes
+	}
+	prs.dirtyKeys = make(map[string]struct{})
+
+	removedKeys := prs.removedKeys
+	prs.removedKeys = make(map[string]struct{})
+	prs.mut.Unlock()
+
+	for key, entryBytes := range dirtyData {
+		err := prs.storer.Put([]byte(key), entryBytes)
+		if err != nil {
+			log.Warn("peerReputationStore.persist: can not save entry", "error", err)
+		}
+	}
+	for key := range removedKeys {
+		err := prs.storer.Remove([]byte(key))
+		if err != nil {
+			log.Debug("peerReputationStore.persist: can not remove entry", "error", err)
+		}
+	}
+}
+
+// Close saves the modified entries and closes the inner storer
+func (prs *peerReputationStore) Close() error {
+	prs.cancelFunc()
+	prs.persist()
+
+	return prs.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prs *peerReputationStore) IsInterfaceNil() bool {
+	return prs == nil
+}
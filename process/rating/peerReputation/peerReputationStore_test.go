@@ -0,0 +1,350 @@
+package peerReputation
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsPeerReputationStore() ArgsPeerReputationStore {
+	return ArgsPeerReputationStore{
+		Config: config.PeerReputationConfig{
+			Enabled:                  true,
+			PersistIntervalInSeconds: 3600,
+			MaxNumEntries:            100,
+			MaxNumReasonsPerPeer:     3,
+		},
+		Storer:     genericMocks.NewStorerMock(),
+		Marshaller: &marshal.JsonMarshalizer{},
+	}
+}
+
+func TestNewPeerReputationStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Storer = nil
+
+		prs, err := NewPeerReputationStore(args)
+		assert.Nil(t, prs)
+		assert.Equal(t, process.ErrNilStorage, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Marshaller = nil
+
+		prs, err := NewPeerReputationStore(args)
+		assert.Nil(t, prs)
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("invalid persist interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Config.PersistIntervalInSeconds = 0
+
+		prs, err := NewPeerReputationStore(args)
+		assert.Nil(t, prs)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid max num entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Config.MaxNumEntries = 0
+
+		prs, err := NewPeerReputationStore(args)
+		assert.Nil(t, prs)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid max num reasons should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Config.MaxNumReasonsPerPeer = 0
+
+		prs, err := NewPeerReputationStore(args)
+		assert.Nil(t, prs)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("invalid deny list should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Config.DenyList.IPs = []string{"invalid"}
+
+		prs, err := NewPeerReputationStore(args)
+		assert.Nil(t, prs)
+		assert.True(t, errors.Is(err, process.ErrInvalidPeerAccessListEntry))
+	})
+	t.Run("invalid allow list should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputationStore()
+		args.Config.AllowList.PublicKeys = []string{"invalid"}
+
+		prs, err := NewPeerReputationStore(args)
+		assert.Nil(t, prs)
+		assert.True(t, errors.Is(err, process.ErrInvalidPeerAccessListEntry))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		prs, err := NewPeerReputationStore(createMockArgsPeerReputationStore())
+		assert.Nil(t, err)
+		assert.False(t, prs.IsInterfaceNil())
+		assert.Nil(t, prs.Close())
+	})
+}
+
+func TestPeerReputationStore_RecordPeerIDEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty peer ID should not record", func(t *testing.T) {
+		t.Parallel()
+
+		prs, _ := NewPeerReputationStore(createMockArgsPeerReputationStore())
+		defer func() {
+			_ = prs.Close()
+		}()
+
+		prs.RecordPeerIDEvent("", common.PeerReputationSourceAntiflood, "reason", -1, 0)
+		assert.Empty(t, prs.GetPeersReputation())
+	})
+	t.Run("should accumulate score and cap the reasons", func(t *testing.T) {
+		t.Parallel()
+
+		prs, _ := NewPeerReputationStore(createMockArgsPeerReputationStore())
+		defer func() {
+			_ = prs.Close()
+		}()
+
+		pid := core.PeerID("pid")
+		for i := 0; i < 5; i++ {
+			prs.RecordPeerIDEvent(pid, common.PeerReputationSourceAntiflood, "reason", -2, 0)
+		}
+
+		peers := prs.GetPeersReputation()
+		require.Equal(t, 1, len(peers))
+		assert.Equal(t, pid.Pretty(), peers[0].PeerID)
+		assert.Equal(t, float64(-10), peers[0].Score)
+		assert.Equal(t, uint32(5), peers[0].NumEvents)
+		assert.Equal(t, 3, len(peers[0].Reasons))
+		assert.Equal(t, common.PeerReputationSourceAntiflood, peers[0].Reasons[0].Source)
+		assert.False(t, prs.IsPeerIDDenied(pid))
+	})
+	t.Run("ban should expire", func(t *testing.T) {
+		t.Parallel()
+
+		prs, _ := NewPeerReputationStore(createMockArgsPeerReputationStore())
+		defer func() {
+			_ = prs.Close()
+		}()
+
+		currentTime := time.Unix(1000, 0)
+		prs.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		pid := core.PeerID("pid")
+		prs.RecordPeerIDEvent(pid, common.PeerReputationSourceConsensus, "reason", common.PeerReputationBanPenalty, time.Minute)
+		assert.True(t, prs.IsPeerIDDenied(pid))
+
+		currentTime = currentTime.Add(time.Minute)
+		assert.False(t, prs.IsPeerIDDenied(pid))
+	})
+}
+
+func TestPeerReputationStore_RecordPublicKeyEvent(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	pk := []byte{0xab, 0xcd}
+	prs.RecordPublicKeyEvent(nil, common.PeerReputationSourcePeerHonesty, "reason", -1, time.Hour)
+	assert.Empty(t, prs.GetPeersReputation())
+
+	prs.RecordPublicKeyEvent(pk, common.PeerReputationSourcePeerHonesty, "reason", -1, time.Hour)
+	peers := prs.GetPeersReputation()
+	require.Equal(t, 1, len(peers))
+	assert.Equal(t, "abcd", peers[0].PublicKey)
+	assert.Empty(t, peers[0].PeerID)
+	assert.True(t, prs.IsPublicKeyDenied(pk))
+	assert.False(t, prs.IsPeerIDDenied(core.PeerID(pk)))
+}
+
+func TestPeerReputationStore_RecordPublicKeyEventShouldNotKeepTheProvidedSlice(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	pk := []byte{0xab, 0xcd}
+	prs.RecordPublicKeyEvent(pk, common.PeerReputationSourcePeerHonesty, "reason", -1, time.Hour)
+	pk[0] = 0xff
+
+	peers := prs.GetPeersReputation()
+	require.Equal(t, 1, len(peers))
+	assert.Equal(t, "abcd", peers[0].PublicKey)
+	assert.True(t, prs.IsPublicKeyDenied([]byte{0xab, 0xcd}))
+}
+
+func TestPeerReputationStore_ConcurrentOperationsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := NewPeerReputationStore(createMockArgsPeerReputationStore())
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	numCalls := 1000
+	pid := core.PeerID("pid")
+	pk := []byte("pk")
+
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 6 {
+			case 0:
+				prs.RecordPeerIDEvent(pid, common.PeerReputationSourceAntiflood, "reason", -1, time.Duration(idx)*time.Second)
+			case 1:
+				prs.RecordPublicKeyEvent(pk, common.PeerReputationSourceConsensus, "reason", -1, time.Duration(idx)*time.Second)
+			case 2:
+				_ = prs.IsPeerIDDenied(pid)
+			case 3:
+				_ = prs.IsPublicKeyDenied(pk)
+			case 4:
+				_ = prs.GetPeersReputation()
+			case 5:
+				prs.persist()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.True(t, prs.IsPeerIDDenied(pid))
+	assert.True(t, prs.IsPublicKeyDenied(pk))
+}
+
+func TestPeerReputationStore_AccessLists(t *testing.T) {
+	t.Parallel()
+
+	allowedPid, _ := core.NewPeerID(testPeerID)
+	deniedPid := core.PeerID("denied pid")
+
+	args := createMockArgsPeerReputationStore()
+	args.Config.AllowList = config.PeerAccessListConfig{
+		PeerIDs:    []string{testPeerID},
+		IPs:        []string{"10.0.0.1"},
+		PublicKeys: []string{"aa"},
+	}
+	args.Config.DenyList = config.PeerAccessListConfig{
+		IPs:        []string{"10.0.0.0/8"},
+		PublicKeys: []string{"aa", "bb"},
+	}
+	prs, err := NewPeerReputationStore(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	t.Run("allow list wins over the deny list", func(t *testing.T) {
+		assert.True(t, prs.IsPublicKeyAllowed([]byte{0xaa}))
+		assert.False(t, prs.IsPublicKeyDenied([]byte{0xaa}))
+		assert.False(t, prs.IsAddressDenied("/ip4/10.0.0.1/tcp/37373"))
+	})
+	t.Run("deny list entries should be denied", func(t *testing.T) {
+		assert.True(t, prs.IsPublicKeyDenied([]byte{0xbb}))
+		assert.True(t, prs.IsAddressDenied("/ip4/10.0.0.2/tcp/37373"))
+		assert.False(t, prs.IsAddressDenied("11.0.0.2"))
+	})
+	t.Run("allowed peer should not be denied even if banned", func(t *testing.T) {
+		prs.RecordPeerIDEvent(allowedPid, common.PeerReputationSourceAntiflood, "reason", -1, time.Hour)
+		prs.RecordPeerIDEvent(deniedPid, common.PeerReputationSourceAntiflood, "reason", -1, time.Hour)
+
+		assert.True(t, prs.IsPeerIDAllowed(allowedPid))
+		assert.False(t, prs.IsPeerIDDenied(allowedPid))
+		assert.False(t, prs.IsPeerIDAllowed(deniedPid))
+		assert.True(t, prs.IsPeerIDDenied(deniedPid))
+	})
+}
+
+func TestPeerReputationStore_EvictionShouldRemoveTheOldestEntry(t *testing.T) {
+	t.Parallel()
+
+	removedKeys := make([]string, 0)
+	args := createMockArgsPeerReputationStore()
+	args.Config.MaxNumEntries = 2
+	args.Storer = &storageStubs.StorerStub{
+		RemoveCalled: func(key []byte) error {
+			removedKeys = append(removedKeys, string(key))
+			return nil
+		},
+	}
+	prs, _ := NewPeerReputationStore(args)
+
+	currentTime := time.Unix(1000, 0)
+	prs.getTimeHandler = func() time.Time {
+		currentTime = currentTime.Add(time.Second)
+		return currentTime
+	}
+
+	prs.RecordPeerIDEvent("pid1", common.PeerReputationSourceAntiflood, "reason", -1, 0)
+	prs.RecordPeerIDEvent("pid2", common.PeerReputationSourceAntiflood, "reason", -2, 0)
+	prs.RecordPeerIDEvent("pid3", common.PeerReputationSourceAntiflood, "reason", -3, 0)
+
+	peers := prs.GetPeersReputation()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, core.PeerID("pid3").Pretty(), peers[0].PeerID)
+	assert.Equal(t, core.PeerID("pid2").Pretty(), peers[1].PeerID)
+
+	assert.Nil(t, prs.Close())
+	assert.Equal(t, []string{peerIDKeyPrefix + "pid1"}, removedKeys)
+}
+
+func TestPeerReputationStore_ShouldReloadPersistedEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPeerReputationStore()
+	prs, _ := NewPeerReputationStore(args)
+
+	pid := core.PeerID("pid")
+	pk := []byte("pk")
+	prs.RecordPeerIDEvent(pid, common.PeerReputationSourceAntiflood, "flooding", -5, time.Hour)
+	prs.RecordPublicKeyEvent(pk, common.PeerReputationSourcePeerHonesty, "bad peer", -10, time.Hour)
+	require.Nil(t, prs.Close())
+
+	reloaded, err := NewPeerReputationStore(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = reloaded.Close()
+	}()
+
+	assert.Equal(t, prs.GetPeersReputation(), reloaded.GetPeersReputation())
+	assert.True(t, reloaded.IsPeerIDDenied(pid))
+	assert.True(t, reloaded.IsPublicKeyDenied(pk))
+}
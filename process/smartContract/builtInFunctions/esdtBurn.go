@@ -0,0 +1,37 @@
+package builtInFunctions
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// esdtBurn keeps the ESDTBurn built in function active after the global burn was disabled, once the delegation
+// liquid staking tokens are enabled, as these tokens are redeemed by burning them through the ESDT system SC.
+// For any other token the ESDT system SC rejects the call and the tokens are returned to the sender.
+type esdtBurn struct {
+	vmcommon.BuiltinFunction
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+func replaceESDTBurnFunction(container vmcommon.BuiltInFunctionContainer, enableEpochsHandler vmcommon.EnableEpochsHandler) error {
+	burnFunction, err := container.Get(core.BuiltInFunctionESDTBurn)
+	if err != nil {
+		return err
+	}
+
+	return container.Replace(core.BuiltInFunctionESDTBurn, &esdtBurn{
+		BuiltinFunction:     burnFunction,
+		enableEpochsHandler: enableEpochsHandler,
+	})
+}
+
+// IsActive returns true if either the global burn or the delegation liquid staking is enabled
+func (e *esdtBurn) IsActive() bool {
+	return e.BuiltinFunction.IsActive() || e.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *esdtBurn) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,33 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEsdtBurn_IsActive(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler := enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+	args := createMockArguments()
+	args.EnableEpochsHandler = enableEpochsHandler
+	builtInFuncFactory, err := CreateBuiltInFunctionsFactory(args)
+	require.Nil(t, err)
+
+	burnFunction, err := builtInFuncFactory.BuiltInFunctionContainer().Get(core.BuiltInFunctionESDTBurn)
+	require.Nil(t, err)
+	assert.False(t, burnFunction.IsInterfaceNil())
+	assert.False(t, burnFunction.IsActive())
+
+	enableEpochsHandler.AddActiveFlags(common.DelegationLiquidStakingFlag)
+	assert.True(t, burnFunction.IsActive())
+
+	enableEpochsHandler.RemoveActiveFlags(common.DelegationLiquidStakingFlag)
+	enableEpochsHandler.AddActiveFlags(common.GlobalMintBurnFlag)
+	assert.True(t, burnFunction.IsActive())
+}
@@ -0,0 +1,178 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	vmcommonBuiltInFunctions "github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+)
+
+var mintAllowanceKeyPrefix = []byte(core.ProtectedKeyPrefix + common.MintAllowanceKeyIdentifier)
+
+// esdtSetLocalMintAllowance is called by the ESDT system SC to increase the value an account can mint locally from
+// a token with a supply policy
+type esdtSetLocalMintAllowance struct {
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+// esdtLocalMint restricts the ESDTLocalMint built in function to the mint allowance of the account, for the accounts
+// which received one
+type esdtLocalMint struct {
+	vmcommon.BuiltinFunction
+	enableEpochsHandler vmcommon.EnableEpochsHandler
+}
+
+func addESDTSupplyPolicyFunctions(container vmcommon.BuiltInFunctionContainer, enableEpochsHandler vmcommon.EnableEpochsHandler) error {
+	err := container.Add(common.BuiltInFunctionESDTSetLocalMintAllowance, &esdtSetLocalMintAllowance{
+		enableEpochsHandler: enableEpochsHandler,
+	})
+	if err != nil {
+		return err
+	}
+
+	localMintFunction, err := container.Get(core.BuiltInFunctionESDTLocalMint)
+	if err != nil {
+		return err
+	}
+
+	return container.Replace(core.BuiltInFunctionESDTLocalMint, &esdtLocalMint{
+		BuiltinFunction:     localMintFunction,
+		enableEpochsHandler: enableEpochsHandler,
+	})
+}
+
+// ProcessBuiltinFunction adds the provided value to the local mint allowance of the destination account
+//
+//	vmInput.Arguments[0] - token identifier
+//	vmInput.Arguments[1] - value to add to the allowance
+//	vmInput.Arguments[2] - max supply of the token
+func (e *esdtSetLocalMintAllowance) ProcessBuiltinFunction(
+	_, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, vmcommonBuiltInFunctions.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Sign() != 0 {
+		return nil, vmcommonBuiltInFunctions.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, vmcommonBuiltInFunctions.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, core.ESDTSCAddress) {
+		return nil, vmcommonBuiltInFunctions.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, vmcommonBuiltInFunctions.ErrNilUserAccount
+	}
+
+	tokenID := vmInput.Arguments[0]
+	allowance, _, err := getMintAllowance(acntDst, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	allowance.Value.Add(allowance.Value, value)
+	allowance.MaxSupply = big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	err = saveMintAllowance(acntDst, tokenID, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	vmOutput.Logs = []*vmcommon.LogEntry{{
+		Identifier: []byte(vmInput.Function),
+		Address:    acntDst.AddressBytes(),
+		Topics:     [][]byte{tokenID, {}, value.Bytes(), vmInput.Arguments[2]},
+	}}
+
+	return vmOutput, nil
+}
+
+// SetNewGasConfig does nothing as the function is called only by the ESDT system SC
+func (e *esdtSetLocalMintAllowance) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// IsActive returns true if the ESDT supply policies are enabled
+func (e *esdtSetLocalMintAllowance) IsActive() bool {
+	return e.enableEpochsHandler.IsFlagEnabled(common.ESDTSupplyPolicyFlag)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *esdtSetLocalMintAllowance) IsInterfaceNil() bool {
+	return e == nil
+}
+
+// ProcessBuiltinFunction mints locally and decreases the allowance of the sender, if the sender has one
+func (e *esdtLocalMint) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTSupplyPolicyFlag) || check.IfNil(acntSnd) ||
+		vmInput == nil || len(vmInput.Arguments) < 2 {
+		return e.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	}
+
+	tokenID := vmInput.Arguments[0]
+	allowance, found, err := getMintAllowance(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return e.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(allowance.Value) > 0 {
+		return nil, process.ErrLocalMintAllowanceExceeded
+	}
+
+	vmOutput, err := e.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance.Value.Sub(allowance.Value, value)
+	err = saveMintAllowance(acntSnd, tokenID, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *esdtLocalMint) IsInterfaceNil() bool {
+	return e == nil
+}
+
+func getMintAllowance(account vmcommon.UserAccountHandler, tokenID []byte) (*common.MintAllowance, bool, error) {
+	data, _, err := account.AccountDataHandler().RetrieveValue(mintAllowanceKey(tokenID))
+	if core.IsGetNodeFromDBError(err) {
+		return nil, false, err
+	}
+	if err != nil || len(data) == 0 {
+		return &common.MintAllowance{MaxSupply: big.NewInt(0), Value: big.NewInt(0)}, false, nil
+	}
+
+	allowance, err := common.DecodeMintAllowance(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return allowance, true, nil
+}
+
+func saveMintAllowance(account vmcommon.UserAccountHandler, tokenID []byte, allowance *common.MintAllowance) error {
+	return account.AccountDataHandler().SaveKeyValue(mintAllowanceKey(tokenID), common.EncodeMintAllowance(allowance))
+}
+
+func mintAllowanceKey(tokenID []byte) []byte {
+	return append(append([]byte{}, mintAllowanceKeyPrefix...), tokenID...)
+}
@@ -0,0 +1,218 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	vmcommonBuiltInFunctions "github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var allowanceTokenID = []byte("TKN-abcdef")
+
+func createSetLocalMintAllowanceInput(value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: core.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{allowanceTokenID, big.NewInt(value).Bytes(), big.NewInt(1000).Bytes()},
+		},
+		Function: common.BuiltInFunctionESDTSetLocalMintAllowance,
+	}
+}
+
+func createLocalMintInput(value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: []byte("minter"),
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{allowanceTokenID, big.NewInt(value).Bytes()},
+		},
+		Function: core.BuiltInFunctionESDTLocalMint,
+	}
+}
+
+func TestCreateBuiltInFunctionsFactory_AddsESDTSupplyPolicyFunctions(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler := enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+	args := createMockArguments()
+	args.EnableEpochsHandler = enableEpochsHandler
+	builtInFuncFactory, err := CreateBuiltInFunctionsFactory(args)
+	require.Nil(t, err)
+
+	setAllowanceFunction, err := builtInFuncFactory.BuiltInFunctionContainer().Get(common.BuiltInFunctionESDTSetLocalMintAllowance)
+	require.Nil(t, err)
+	assert.False(t, setAllowanceFunction.IsActive())
+
+	enableEpochsHandler.AddActiveFlags(common.ESDTSupplyPolicyFlag)
+	assert.True(t, setAllowanceFunction.IsActive())
+
+	localMintFunction, err := builtInFuncFactory.BuiltInFunctionContainer().Get(core.BuiltInFunctionESDTLocalMint)
+	require.Nil(t, err)
+	_, ok := localMintFunction.(*esdtLocalMint)
+	assert.True(t, ok)
+}
+
+func TestEsdtSetLocalMintAllowance_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	setAllowance := &esdtSetLocalMintAllowance{
+		enableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.ESDTSupplyPolicyFlag),
+	}
+
+	t.Run("nil vm input should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput, err := setAllowance.ProcessBuiltinFunction(nil, stateMock.NewAccountWrapMock([]byte("minter")), nil)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, vmcommonBuiltInFunctions.ErrNilVmInput, err)
+	})
+	t.Run("caller not the ESDT system SC should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmInput := createSetLocalMintAllowanceInput(10)
+		vmInput.CallerAddr = []byte("caller")
+		vmOutput, err := setAllowance.ProcessBuiltinFunction(nil, stateMock.NewAccountWrapMock([]byte("minter")), vmInput)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, vmcommonBuiltInFunctions.ErrAddressIsNotESDTSystemSC, err)
+	})
+	t.Run("invalid number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmInput := createSetLocalMintAllowanceInput(10)
+		vmInput.Arguments = vmInput.Arguments[:2]
+		vmOutput, err := setAllowance.ProcessBuiltinFunction(nil, stateMock.NewAccountWrapMock([]byte("minter")), vmInput)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, vmcommonBuiltInFunctions.ErrInvalidArguments, err)
+	})
+	t.Run("nil destination account should error", func(t *testing.T) {
+		t.Parallel()
+
+		vmOutput, err := setAllowance.ProcessBuiltinFunction(nil, nil, createSetLocalMintAllowanceInput(10))
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, vmcommonBuiltInFunctions.ErrNilUserAccount, err)
+	})
+	t.Run("should add to the allowance", func(t *testing.T) {
+		t.Parallel()
+
+		account := stateMock.NewAccountWrapMock([]byte("minter"))
+		vmOutput, err := setAllowance.ProcessBuiltinFunction(nil, account, createSetLocalMintAllowanceInput(0))
+		require.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+		allowance, found, _ := getMintAllowance(account, allowanceTokenID)
+		assert.True(t, found)
+		assert.Equal(t, big.NewInt(0), allowance.Value)
+		assert.Equal(t, big.NewInt(1000), allowance.MaxSupply)
+
+		vmOutput, err = setAllowance.ProcessBuiltinFunction(nil, account, createSetLocalMintAllowanceInput(10))
+		require.Nil(t, err)
+		_, err = setAllowance.ProcessBuiltinFunction(nil, account, createSetLocalMintAllowanceInput(15))
+		require.Nil(t, err)
+
+		allowance, _, _ = getMintAllowance(account, allowanceTokenID)
+		assert.Equal(t, big.NewInt(25), allowance.Value)
+
+		require.Equal(t, 1, len(vmOutput.Logs))
+		assert.Equal(t, []byte(common.BuiltInFunctionESDTSetLocalMintAllowance), vmOutput.Logs[0].Identifier)
+		assert.Equal(t, []byte("minter"), vmOutput.Logs[0].Address)
+		assert.Equal(t, [][]byte{allowanceTokenID, {}, big.NewInt(10).Bytes(), big.NewInt(1000).Bytes()}, vmOutput.Logs[0].Topics)
+	})
+}
+
+func TestEsdtLocalMint_ProcessBuiltinFunction(t *testing.T) {
+	t.Parallel()
+
+	createLocalMint := func(flags ...core.EnableEpochFlag) (*esdtLocalMint, *int) {
+		numCalls := 0
+		return &esdtLocalMint{
+			BuiltinFunction: &mock.BuiltInFunctionStub{
+				ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					numCalls++
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+				},
+			},
+			enableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(flags...),
+		}, &numCalls
+	}
+
+	t.Run("account without allowance should mint", func(t *testing.T) {
+		t.Parallel()
+
+		localMint, numCalls := createLocalMint(common.ESDTSupplyPolicyFlag)
+		vmOutput, err := localMint.ProcessBuiltinFunction(stateMock.NewAccountWrapMock([]byte("minter")), nil, createLocalMintInput(100))
+		require.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+		assert.Equal(t, 1, *numCalls)
+	})
+	t.Run("flag not active should ignore the allowance", func(t *testing.T) {
+		t.Parallel()
+
+		localMint, numCalls := createLocalMint()
+		account := stateMock.NewAccountWrapMock([]byte("minter"))
+		_ = saveMintAllowance(account, allowanceTokenID, &common.MintAllowance{MaxSupply: big.NewInt(1000), Value: big.NewInt(10)})
+
+		_, err := localMint.ProcessBuiltinFunction(account, nil, createLocalMintInput(100))
+		require.Nil(t, err)
+		assert.Equal(t, 1, *numCalls)
+	})
+	t.Run("value over the allowance should error", func(t *testing.T) {
+		t.Parallel()
+
+		localMint, numCalls := createLocalMint(common.ESDTSupplyPolicyFlag)
+		account := stateMock.NewAccountWrapMock([]byte("minter"))
+		_ = saveMintAllowance(account, allowanceTokenID, &common.MintAllowance{MaxSupply: big.NewInt(1000), Value: big.NewInt(10)})
+
+		vmOutput, err := localMint.ProcessBuiltinFunction(account, nil, createLocalMintInput(11))
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, process.ErrLocalMintAllowanceExceeded, err)
+		assert.Equal(t, 0, *numCalls)
+	})
+	t.Run("inner mint error should not decrease the allowance", func(t *testing.T) {
+		t.Parallel()
+
+		localMint, _ := createLocalMint(common.ESDTSupplyPolicyFlag)
+		localMint.BuiltinFunction = &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return nil, vmcommonBuiltInFunctions.ErrActionNotAllowed
+			},
+		}
+		account := stateMock.NewAccountWrapMock([]byte("minter"))
+		_ = saveMintAllowance(account, allowanceTokenID, &common.MintAllowance{MaxSupply: big.NewInt(1000), Value: big.NewInt(10)})
+
+		_, err := localMint.ProcessBuiltinFunction(account, nil, createLocalMintInput(5))
+		assert.Equal(t, vmcommonBuiltInFunctions.ErrActionNotAllowed, err)
+
+		allowance, _, _ := getMintAllowance(account, allowanceTokenID)
+		assert.Equal(t, big.NewInt(10), allowance.Value)
+	})
+	t.Run("should mint and decrease the allowance", func(t *testing.T) {
+		t.Parallel()
+
+		localMint, numCalls := createLocalMint(common.ESDTSupplyPolicyFlag)
+		account := stateMock.NewAccountWrapMock([]byte("minter"))
+		_ = saveMintAllowance(account, allowanceTokenID, &common.MintAllowance{MaxSupply: big.NewInt(1000), Value: big.NewInt(10)})
+
+		_, err := localMint.ProcessBuiltinFunction(account, nil, createLocalMintInput(4))
+		require.Nil(t, err)
+		_, err = localMint.ProcessBuiltinFunction(account, nil, createLocalMintInput(6))
+		require.Nil(t, err)
+		assert.Equal(t, 2, *numCalls)
+
+		allowance, found, _ := getMintAllowance(account, allowanceTokenID)
+		assert.True(t, found)
+		assert.Equal(t, big.NewInt(0), allowance.Value)
+
+		_, err = localMint.ProcessBuiltinFunction(account, nil, createLocalMintInput(1))
+		assert.Equal(t, process.ErrLocalMintAllowanceExceeded, err)
+	})
+}
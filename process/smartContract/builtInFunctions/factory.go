@@ -100,6 +100,16 @@ func CreateBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (vmc
 		return nil, err
 	}
 
+	err = replaceESDTBurnFunction(bContainerFactory.BuiltInFunctionContainer(), args.EnableEpochsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addESDTSupplyPolicyFunctions(bContainerFactory.BuiltInFunctionContainer(), args.EnableEpochsHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	args.GasSchedule.RegisterNotifyHandler(bContainerFactory)
 
 	return bContainerFactory, nil
@@ -64,6 +64,7 @@ type ArgBlockChainHook struct {
 	GasSchedule              core.GasScheduleNotifier
 	Counter                  BlockChainHookCounter
 	MissingTrieNodesNotifier common.MissingTrieNodesNotifier
+	ExecutionTracer          process.ExecutionTracer
 }
 
 // BlockChainHookImpl is a wrapper over AccountsAdapter that satisfy vmcommon.BlockchainHook interface
@@ -81,6 +82,7 @@ type BlockChainHookImpl struct {
 	globalSettingsHandler vmcommon.ESDTGlobalSettingsHandler
 	enableEpochsHandler   common.EnableEpochsHandler
 	counter               BlockChainHookCounter
+	executionTracer       process.ExecutionTracer
 
 	mutCurrentHdr sync.RWMutex
 	currentHdr    data.HeaderHandler
@@ -126,6 +128,7 @@ func NewBlockChainHookImpl(
 		gasSchedule:              args.GasSchedule,
 		counter:                  args.Counter,
 		missingTrieNodesNotifier: args.MissingTrieNodesNotifier,
+		executionTracer:          args.ExecutionTracer,
 	}
 
 	err = blockChainHookImpl.makeCompiledSCStorage()
@@ -217,6 +220,9 @@ func checkForNil(args ArgBlockChainHook) error {
 	if check.IfNil(args.MissingTrieNodesNotifier) {
 		return ErrNilMissingTrieNodesNotifier
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return process.ErrNilExecutionTracer
+	}
 	return nil
 }
 
@@ -286,6 +292,7 @@ func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte
 		bh.syncIfMissingDataTrieNode(err)
 	}
 	log.Trace("GetStorageData ", messages...)
+	bh.executionTracer.OnStorageRead(accountAddress, index, value)
 
 	// returning nil here ensures backwards compatibility as the error wasn't taken into account by the previous versions
 	// of the vm. Now, the VM take into account this error so the processMaxReadsCounters call can stop the execution of the contract
@@ -475,6 +482,16 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 		return nil, process.ErrNilVmInput
 	}
 
+	// only the calls passing through the hook are traced here, the calls a contract makes inside its own VM are
+	// reconstructed by the tracer from the VM output of the enclosing call
+	bh.executionTracer.OnBuiltInFunctionCallStart(input)
+	vmOutput, err := bh.processBuiltInFunction(input)
+	bh.executionTracer.OnExecutionEnd(vmOutput, err)
+
+	return vmOutput, err
+}
+
+func (bh *BlockChainHookImpl) processBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
 	function, err := bh.builtInFunctions.Get(input.Function)
 	if err != nil {
 		return nil, err
@@ -868,7 +885,14 @@ func (bh *BlockChainHookImpl) ExecuteSmartContractCallOnOtherVM(input *vmcommon.
 	if err != nil {
 		return nil, err
 	}
-	return vmExec.RunSmartContractCall(input)
+
+	// the cross VM call is traced as a regular call, the calls made inside the called VM are reconstructed by the
+	// tracer from the returned VM output
+	bh.executionTracer.OnContractCallStart(input)
+	vmOutput, err := vmExec.RunSmartContractCall(input)
+	bh.executionTracer.OnExecutionEnd(vmOutput, err)
+
+	return vmOutput, err
 }
 
 // SetVMContainer sets the vm container in order to be used for sc execution via blockchain
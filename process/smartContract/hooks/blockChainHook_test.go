@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/accounts"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -69,6 +70,7 @@ func createMockBlockChainHookArgs() hooks.ArgBlockChainHook {
 		GasSchedule:              testscommon.NewGasScheduleNotifierMock(make(map[string]map[string]uint64)),
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 	return arguments
 }
@@ -207,6 +209,14 @@ func TestNewBlockChainHookImpl(t *testing.T) {
 			},
 			expectedErr: hooks.ErrNilBlockchainHookCounter,
 		},
+		{
+			args: func() hooks.ArgBlockChainHook {
+				args := createMockBlockChainHookArgs()
+				args.ExecutionTracer = nil
+				return args
+			},
+			expectedErr: process.ErrNilExecutionTracer,
+		},
 		{
 			args: func() hooks.ArgBlockChainHook {
 				args := createMockBlockChainHookArgs()
@@ -449,6 +459,38 @@ func TestBlockChainHookImpl_GetStorageData(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 		assert.Nil(t, value)
 	})
+	t.Run("should notify the execution tracer", func(t *testing.T) {
+		t.Parallel()
+
+		address := []byte("address")
+		variableIdentifier := []byte("variable")
+		variableValue := []byte("value")
+		accnt := stateMock.NewAccountWrapMock(nil)
+		_ = accnt.SaveKeyValue(variableIdentifier, variableValue)
+
+		tracer, _ := tracing.NewExecutionTracer(testscommon.NewPubkeyConverterMock(32), 10)
+		args := createMockBlockChainHookArgs()
+		args.ExecutionTracer = tracer
+		args.Accounts = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (handler vmcommon.AccountHandler, e error) {
+				return accnt, nil
+			},
+		}
+		bh, _ := hooks.NewBlockChainHookImpl(args)
+
+		tracer.StartTracing()
+		tracer.OnContractCallStart(&vmcommon.ContractCallInput{RecipientAddr: address})
+		value, _, err := bh.GetStorageData(address, variableIdentifier)
+		tracer.OnExecutionEnd(&vmcommon.VMOutput{}, nil)
+		trace := tracer.StopTracing()
+
+		assert.Nil(t, err)
+		assert.Equal(t, variableValue, value)
+		require.Equal(t, 1, len(trace.Calls))
+		require.Equal(t, 1, len(trace.Calls[0].StorageReads))
+		assert.Equal(t, hex.EncodeToString(variableIdentifier), trace.Calls[0].StorageReads[0].Key)
+		assert.Equal(t, hex.EncodeToString(variableValue), trace.Calls[0].StorageReads[0].Value)
+	})
 	t.Run("should work before counters activation", func(t *testing.T) {
 		t.Parallel()
 
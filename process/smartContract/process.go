@@ -64,6 +64,7 @@ type scProcessor struct {
 	esdtTransferParser vmcommon.ESDTTransferParser
 	builtInFunctions   vmcommon.BuiltInFunctionContainer
 	wasmVMChangeLocker common.Locker
+	executionTracer    process.ExecutionTracer
 
 	enableRoundsHandler process.EnableRoundsHandler
 	enableEpochsHandler common.EnableEpochsHandler
@@ -194,6 +195,9 @@ func NewSmartContractProcessor(args scrCommon.ArgsNewSmartContractProcessor) (*s
 	if check.IfNilReflect(args.WasmVMChangeLocker) {
 		return nil, process.ErrNilLocker
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
 	if check.IfNil(args.VMOutputCacher) {
 		return nil, process.ErrNilCacher
 	}
@@ -225,6 +229,7 @@ func NewSmartContractProcessor(args scrCommon.ArgsNewSmartContractProcessor) (*s
 		builtInFunctions:    args.BuiltInFunctions,
 		isGenesisProcessing: args.IsGenesisProcessing,
 		wasmVMChangeLocker:  args.WasmVMChangeLocker,
+		executionTracer:     args.ExecutionTracer,
 		vmOutputCacher:      args.VMOutputCacher,
 		storePerByte:        baseOperationCost["StorePerByte"],
 		persistPerByte:      baseOperationCost["PersistPerByte"],
@@ -306,7 +311,7 @@ func (sc *scProcessor) prepareExecution(
 	acntSnd, acntDst state.UserAccountHandler,
 	builtInFuncCall bool,
 ) (vmcommon.ReturnCode, *vmcommon.ContractCallInput, []byte, error) {
-	err := sc.processSCPayment(tx, acntSnd)
+	err := sc.processSCPayment(tx, acntSnd, acntDst)
 	if err != nil {
 		log.Debug("process sc payment error", "error", err.Error())
 		return 0, nil, nil, err
@@ -411,7 +416,9 @@ func (sc *scProcessor) executeSmartContractCall(
 	defer sc.printBlockchainHookCounters(tx)
 
 	var vmOutput *vmcommon.VMOutput
+	sc.executionTracer.OnContractCallStart(vmInput)
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	sc.executionTracer.OnExecutionEnd(vmOutput, err)
 
 	sc.wasmVMChangeLocker.RUnlock()
 	if err != nil {
@@ -1638,6 +1645,16 @@ func isRelayedTx(tx data.TransactionHandler) (*smartContractResult.SmartContract
 	return nil, false
 }
 
+// getRelayerOfRelayedTxV3 returns the relayer address if the provided transaction is a relayed tx v3
+func getRelayerOfRelayedTxV3(tx data.TransactionHandler) ([]byte, bool) {
+	relayedTx, ok := tx.(data.RelayedTransactionHandler)
+	if !ok || !common.IsRelayedTxV3(tx) {
+		return nil, false
+	}
+
+	return relayedTx.GetRelayerAddr(), true
+}
+
 // refunds the transaction values minus the relayed value to the sender account
 // in case of failed smart contract execution - gas is consumed, value is sent back
 func (sc *scProcessor) addBackTxValues(
@@ -1732,7 +1749,7 @@ func (sc *scProcessor) doDeploySmartContract(
 		return 0, err
 	}
 
-	err = sc.processSCPayment(tx, acntSnd)
+	err = sc.processSCPayment(tx, acntSnd, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -1765,7 +1782,9 @@ func (sc *scProcessor) doDeploySmartContract(
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
+	sc.executionTracer.OnContractCreateStart(vmInput)
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	sc.executionTracer.OnExecutionEnd(vmOutput, err)
 	sc.wasmVMChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
@@ -1871,7 +1890,7 @@ func (sc *scProcessor) printScDeployed(vmOutput *vmcommon.VMOutput, tx data.Tran
 }
 
 // taking money from sender, as VM might not have access to him because of state sharding
-func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd state.UserAccountHandler) error {
+func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd, acntDst state.UserAccountHandler) error {
 	if check.IfNil(acntSnd) {
 		// transaction was already processed at sender shard
 		return nil
@@ -1887,6 +1906,29 @@ func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd stat
 	if !sc.enableEpochsHandler.IsFlagEnabled(common.PenalizedTooMuchGasFlag) {
 		cost = core.SafeMul(tx.GetGasLimit(), tx.GetGasPrice())
 	}
+
+	feePayer, err := sc.getFeePayer(tx, acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+	if feePayer != acntSnd {
+		// the relayer pays the fee, the sender only the value
+		err = feePayer.SubFromBalance(cost)
+		if err != nil {
+			return err
+		}
+
+		// the receiver is saved by the caller
+		if feePayer != acntDst {
+			err = sc.accounts.SaveAccount(feePayer)
+			if err != nil {
+				return err
+			}
+		}
+
+		return acntSnd.SubFromBalance(tx.GetValue())
+	}
+
 	cost = cost.Add(cost, tx.GetValue())
 
 	if cost.Cmp(big.NewInt(0)) == 0 {
@@ -1901,6 +1943,34 @@ func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd stat
 	return nil
 }
 
+// getFeePayer returns the account paying the fee of the provided transaction: the relayer of a relayed tx v3 or
+// the sender otherwise. Already loaded accounts are reused, so that a stale copy of the same account is never saved.
+func (sc *scProcessor) getFeePayer(
+	tx data.TransactionHandler,
+	acntSnd, acntDst state.UserAccountHandler,
+) (state.UserAccountHandler, error) {
+	relayerAddr, isRelayedV3 := getRelayerOfRelayedTxV3(tx)
+	if !isRelayedV3 {
+		return acntSnd, nil
+	}
+	if bytes.Equal(relayerAddr, tx.GetSndAddr()) {
+		return acntSnd, nil
+	}
+	if !check.IfNil(acntDst) && bytes.Equal(relayerAddr, tx.GetRcvAddr()) {
+		return acntDst, nil
+	}
+
+	relayerAcnt, err := sc.getAccountFromAddress(relayerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(relayerAcnt) {
+		return nil, process.ErrRelayedTxV3SenderShardMismatch
+	}
+
+	return relayerAcnt, nil
+}
+
 func (sc *scProcessor) processVMOutput(
 	vmInput *vmcommon.VMInput,
 	vmOutput *vmcommon.VMOutput,
@@ -2493,6 +2563,29 @@ func (sc *scProcessor) createSCRForSenderAndRelayer(
 		gasRemaining = 0
 	}
 
+	relayerAddr, isRelayedV3 := getRelayerOfRelayedTxV3(tx)
+	shouldRefundGasToRelayerV3 := isRelayedV3 && callType != vmData.AsynchronousCall && gasRefund.Cmp(zero) > 0
+	if shouldRefundGasToRelayerV3 {
+		senderForRelayerRefund := tx.GetRcvAddr()
+		if !sc.isSelfShard(tx.GetRcvAddr()) {
+			senderForRelayerRefund = tx.GetSndAddr()
+		}
+
+		refundGasToRelayerSCR = &smartContractResult.SmartContractResult{
+			Nonce:          tx.GetNonce() + 1,
+			Value:          big.NewInt(0).Set(gasRefund),
+			RcvAddr:        relayerAddr,
+			SndAddr:        senderForRelayerRefund,
+			PrevTxHash:     txHash,
+			OriginalTxHash: txHash,
+			GasPrice:       tx.GetGasPrice(),
+			CallType:       vmData.DirectCall,
+			ReturnMessage:  []byte(core.GasRefundForRelayerMessage),
+			OriginalSender: tx.GetSndAddr(),
+		}
+		gasRemaining = 0
+	}
+
 	scTx := &smartContractResult.SmartContractResult{}
 	scTx.Value = big.NewInt(0).Set(storageFreeRefund)
 	if callType != vmData.AsynchronousCall && check.IfNil(refundGasToRelayerSCR) {
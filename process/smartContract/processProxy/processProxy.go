@@ -72,6 +72,7 @@ func NewSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProcessor
 			EnableEpochs:        args.EnableEpochs,
 			VMOutputCacher:      args.VMOutputCacher,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
+			ExecutionTracer:     args.ExecutionTracer,
 			IsGenesisProcessing: args.IsGenesisProcessing,
 		},
 	}
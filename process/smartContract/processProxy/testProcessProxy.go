@@ -50,6 +50,7 @@ func NewTestSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProce
 			EnableEpochs:        args.EnableEpochs,
 			VMOutputCacher:      args.VMOutputCacher,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
+			ExecutionTracer:     args.ExecutionTracer,
 			IsGenesisProcessing: args.IsGenesisProcessing,
 		},
 	}
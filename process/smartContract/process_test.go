@@ -29,6 +29,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/economics"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/accounts"
@@ -119,6 +120,7 @@ func createMockSmartContractProcessorArguments() scrCommon.ArgsNewSmartContractP
 		EnableRoundsHandler: &testscommon.EnableRoundsHandlerStub{},
 		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.SCDeployFlag),
 		WasmVMChangeLocker:  &sync.RWMutex{},
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 		VMOutputCacher:      txcache.NewDisabledCache(),
 	}
 }
@@ -369,6 +371,17 @@ func TestNewSmartContractProcessor_NilLockerShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrNilLocker, err)
 }
 
+func TestNewSmartContractProcessor_NilExecutionTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ExecutionTracer = nil
+	sc, err := NewSmartContractProcessor(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
 func TestNewSmartContractProcessor_ShouldRegisterNotifiers(t *testing.T) {
 	t.Parallel()
 
@@ -1699,6 +1712,50 @@ func TestScProcessor_ExecuteSmartContractTransactionVmOutputError(t *testing.T)
 	require.Equal(t, vmcommon.ExecutionFailed, errCode)
 }
 
+func TestScProcessor_ExecuteSmartContractTransactionShouldTraceExecution(t *testing.T) {
+	t.Parallel()
+
+	executionTracer, err := tracing.NewExecutionTracer(createMockPubkeyConverter(), 10)
+	require.Nil(t, err)
+
+	vmContainer := &mock.VMContainerMock{}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VmContainer = vmContainer
+	arguments.ArgsParser = &testscommon.ArgumentParserMock{}
+	arguments.ExecutionTracer = executionTracer
+	sc, err := NewSmartContractProcessor(arguments)
+	require.NotNil(t, sc)
+	require.Nil(t, err)
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = []byte("data")
+	tx.Value = big.NewInt(45)
+	acntSrc, acntDst := createAccounts(tx)
+
+	acntDst.SetCode([]byte("code"))
+	vm := &mock.VMExecutionHandlerStub{}
+	vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+		return nil, errors.New("vm error")
+	}
+	vmContainer.GetCalled = func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+		return vm, nil
+	}
+
+	executionTracer.StartTracing()
+	_, err = sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst)
+	require.Nil(t, err)
+
+	trace := executionTracer.StopTracing()
+	require.Equal(t, 1, len(trace.Calls))
+	assert.Equal(t, tracing.CallFrameType, trace.Calls[0].Type)
+	assert.Equal(t, "45", trace.Calls[0].Value)
+	assert.Equal(t, vmcommon.ExecutionFailed.String(), trace.Calls[0].ReturnCode)
+	assert.Equal(t, "vm error", trace.Calls[0].ReturnMessage)
+}
+
 func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -2326,7 +2383,7 @@ func TestScProcessor_ProcessSCPaymentAccNotInShardShouldNotReturnError(t *testin
 	tx.GasPrice = 10
 	tx.GasLimit = 10
 
-	err = sc.processSCPayment(tx, nil)
+	err = sc.processSCPayment(tx, nil, nil)
 	require.Nil(t, err)
 }
 
@@ -2357,7 +2414,7 @@ func TestScProcessor_ProcessSCPaymentNotEnoughBalance(t *testing.T) {
 
 	currBalance := acntSrc.GetBalance().Uint64()
 
-	err = sc.processSCPayment(tx, acntSrc)
+	err = sc.processSCPayment(tx, acntSrc, nil)
 	require.Equal(t, process.ErrInsufficientFunds, err)
 	require.Equal(t, currBalance, acntSrc.GetBalance().Uint64())
 }
@@ -2384,7 +2441,7 @@ func TestScProcessor_ProcessSCPayment(t *testing.T) {
 	currBalance := acntSrc.GetBalance().Uint64()
 	modifiedBalance := currBalance - tx.Value.Uint64() - tx.GasLimit*tx.GasLimit
 
-	err = sc.processSCPayment(tx, acntSrc)
+	err = sc.processSCPayment(tx, acntSrc, nil)
 	require.Nil(t, err)
 	require.Equal(t, modifiedBalance, acntSrc.GetBalance().Uint64())
 }
@@ -2425,14 +2482,14 @@ func TestScProcessor_ProcessSCPaymentWithNewFlags(t *testing.T) {
 	acntSrc, _ := createAccounts(tx)
 	currBalance := acntSrc.GetBalance().Uint64()
 	modifiedBalance := currBalance - tx.Value.Uint64() - txFee.Uint64()
-	err = sc.processSCPayment(tx, acntSrc)
+	err = sc.processSCPayment(tx, acntSrc, nil)
 	require.Nil(t, err)
 	require.Equal(t, modifiedBalance, acntSrc.GetBalance().Uint64())
 
 	acntSrc, _ = createAccounts(tx)
 	modifiedBalance = currBalance - tx.Value.Uint64() - tx.GasLimit*tx.GasLimit
 	enableEpochsHandlerStub.RemoveActiveFlags(common.PenalizedTooMuchGasFlag)
-	err = sc.processSCPayment(tx, acntSrc)
+	err = sc.processSCPayment(tx, acntSrc, nil)
 	require.Nil(t, err)
 	require.Equal(t, modifiedBalance, acntSrc.GetBalance().Uint64())
 }
@@ -2470,6 +2527,79 @@ func TestScProcessor_RefundGasToSenderNilAndZeroRefund(t *testing.T) {
 	require.Equal(t, currBalance, acntSrc.GetBalance().Uint64())
 }
 
+func TestScProcessor_ProcessSCPaymentRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		SndAddr:     []byte("SRC"),
+		RcvAddr:     []byte("DST"),
+		RelayerAddr: []byte("RLY"),
+		Value:       big.NewInt(45),
+		GasPrice:    10,
+		GasLimit:    10,
+	}
+	acntSrc, _ := createAccounts(tx)
+	_ = acntSrc.SubFromBalance(big.NewInt(100))
+	acntRelayer := createAccount(tx.RelayerAddr)
+	_ = acntRelayer.AddToBalance(big.NewInt(150))
+
+	savedAccounts := make(map[string]vmcommon.AccountHandler)
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ShardCoordinator = mock.NewOneShardCoordinatorMock()
+	arguments.AccountsDB = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			require.Equal(t, tx.RelayerAddr, address)
+			return acntRelayer, nil
+		},
+		SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+			savedAccounts[string(account.AddressBytes())] = account
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	err := sc.processSCPayment(tx, acntSrc, nil)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0), acntSrc.GetBalance())
+	require.Equal(t, uint64(1), acntSrc.GetNonce())
+	require.Equal(t, big.NewInt(50), acntRelayer.GetBalance())
+	require.Equal(t, acntRelayer, savedAccounts[string(tx.RelayerAddr)])
+}
+
+func TestScProcessor_CreateRefundForRelayerOfRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ShardCoordinator = mock.NewOneShardCoordinatorMock()
+	arguments.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+		ComputeFeeForProcessingCalled: func(tx data.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+			return big.NewInt(100)
+		}}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{
+		Nonce:       3,
+		SndAddr:     []byte("sender"),
+		RcvAddr:     []byte("receiver"),
+		RelayerAddr: []byte("relayer"),
+		Value:       big.NewInt(0),
+		GasPrice:    10,
+		GasLimit:    10000,
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: 1000}
+	scrForSender, relayerRefund := sc.createSCRForSenderAndRelayer(vmOutput, tx, []byte("txhash"), vmData.DirectCall)
+	require.NotNil(t, relayerRefund)
+	assert.Equal(t, tx.RelayerAddr, relayerRefund.RcvAddr)
+	assert.Equal(t, tx.RcvAddr, relayerRefund.SndAddr)
+	assert.Equal(t, big.NewInt(100), relayerRefund.Value)
+	assert.Equal(t, []byte(core.GasRefundForRelayerMessage), relayerRefund.ReturnMessage)
+	assert.Equal(t, []byte("txhash"), relayerRefund.OriginalTxHash)
+	assert.Equal(t, tx.SndAddr, relayerRefund.OriginalSender)
+	assert.Equal(t, tx.SndAddr, scrForSender.RcvAddr)
+	assert.Equal(t, big.NewInt(0), scrForSender.Value)
+}
+
 func TestScProcessor_RefundGasToSenderAccNotInShard(t *testing.T) {
 	t.Parallel()
 
@@ -72,6 +72,7 @@ type scProcessor struct {
 	esdtTransferParser vmcommon.ESDTTransferParser
 	builtInFunctions   vmcommon.BuiltInFunctionContainer
 	arwenChangeLocker  common.Locker
+	executionTracer    process.ExecutionTracer
 
 	enableEpochsHandler common.EnableEpochsHandler
 	badTxForwarder      process.IntermediateTransactionHandler
@@ -180,6 +181,9 @@ func NewSmartContractProcessorV2(args scrCommon.ArgsNewSmartContractProcessor) (
 	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
 
 	builtInFuncCost := args.GasSchedule.LatestGasSchedule()[common.BuiltInCost]
 	baseOperationCost := args.GasSchedule.LatestGasSchedule()[common.BaseOperationCost]
@@ -204,6 +208,7 @@ func NewSmartContractProcessorV2(args scrCommon.ArgsNewSmartContractProcessor) (
 		isGenesisProcessing: args.IsGenesisProcessing,
 		arwenChangeLocker:   args.WasmVMChangeLocker,
 		vmOutputCacher:      args.VMOutputCacher,
+		executionTracer:     args.ExecutionTracer,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		storePerByte:        baseOperationCost["StorePerByte"],
 		persistPerByte:      baseOperationCost["PersistPerByte"],
@@ -298,7 +303,7 @@ func (sc *scProcessor) prepareExecution(
 	builtInFuncCall bool,
 	failureContext *failureContext,
 ) (vmcommon.ReturnCode, *vmcommon.ContractCallInput, []byte, error) {
-	err := sc.processSCPayment(tx, acntSnd)
+	err := sc.processSCPayment(tx, acntSnd, acntDst)
 	if err != nil {
 		log.Debug("process sc payment error", "error", err.Error())
 		return 0, nil, nil, err
@@ -423,7 +428,9 @@ func (sc *scProcessor) executeSmartContractCall(
 	defer sc.printBlockchainHookCounters(tx)
 
 	var vmOutput *vmcommon.VMOutput
+	sc.executionTracer.OnContractCallStart(vmInput)
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	sc.executionTracer.OnExecutionEnd(vmOutput, err)
 
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
@@ -1677,6 +1684,16 @@ func isRelayedTx(tx data.TransactionHandler) (*smartContractResult.SmartContract
 	return nil, false
 }
 
+// getRelayerOfRelayedTxV3 returns the relayer address if the provided transaction is a relayed tx v3
+func getRelayerOfRelayedTxV3(tx data.TransactionHandler) ([]byte, bool) {
+	relayedTx, ok := tx.(data.RelayedTransactionHandler)
+	if !ok || !common.IsRelayedTxV3(tx) {
+		return nil, false
+	}
+
+	return relayedTx.GetRelayerAddr(), true
+}
+
 // refunds the transaction values minus the relayed value to the sender account
 // in case of failed smart contract execution - gas is consumed, value is sent back
 func (sc *scProcessor) addBackTxValues(
@@ -1784,7 +1801,7 @@ func (sc *scProcessor) doDeploySmartContract(
 		return vmcommon.Ok, err
 	}
 
-	err = sc.processSCPayment(tx, acntSnd)
+	err = sc.processSCPayment(tx, acntSnd, nil)
 	if err != nil {
 		return vmcommon.Ok, err
 	}
@@ -1818,7 +1835,9 @@ func (sc *scProcessor) doDeploySmartContract(
 		return vmcommon.UserError, nil
 	}
 
+	sc.executionTracer.OnContractCreateStart(vmInput)
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	sc.executionTracer.OnExecutionEnd(vmOutput, err)
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
@@ -1909,7 +1928,7 @@ func (sc *scProcessor) printScDeployed(vmOutput *vmcommon.VMOutput, tx data.Tran
 }
 
 // taking money from sender, as VM might not have access to him because of state sharding
-func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd state.UserAccountHandler) error {
+func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd, acntDst state.UserAccountHandler) error {
 	if check.IfNil(acntSnd) {
 		// transaction was already processed at sender shard
 		return nil
@@ -1922,6 +1941,29 @@ func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd stat
 	}
 
 	cost := sc.economicsFee.ComputeTxFee(tx)
+
+	feePayer, err := sc.getFeePayer(tx, acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+	if feePayer != acntSnd {
+		// the relayer pays the fee, the sender only the value
+		err = feePayer.SubFromBalance(cost)
+		if err != nil {
+			return err
+		}
+
+		// the receiver is saved by the caller
+		if feePayer != acntDst {
+			err = sc.accounts.SaveAccount(feePayer)
+			if err != nil {
+				return err
+			}
+		}
+
+		return acntSnd.SubFromBalance(tx.GetValue())
+	}
+
 	cost = cost.Add(cost, tx.GetValue())
 
 	if cost.Cmp(big.NewInt(0)) == 0 {
@@ -1936,6 +1978,34 @@ func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd stat
 	return nil
 }
 
+// getFeePayer returns the account paying the fee of the provided transaction: the relayer of a relayed tx v3 or
+// the sender otherwise. Already loaded accounts are reused, so that a stale copy of the same account is never saved.
+func (sc *scProcessor) getFeePayer(
+	tx data.TransactionHandler,
+	acntSnd, acntDst state.UserAccountHandler,
+) (state.UserAccountHandler, error) {
+	relayerAddr, isRelayedV3 := getRelayerOfRelayedTxV3(tx)
+	if !isRelayedV3 {
+		return acntSnd, nil
+	}
+	if bytes.Equal(relayerAddr, tx.GetSndAddr()) {
+		return acntSnd, nil
+	}
+	if !check.IfNil(acntDst) && bytes.Equal(relayerAddr, tx.GetRcvAddr()) {
+		return acntDst, nil
+	}
+
+	relayerAcnt, err := sc.getAccountFromAddress(relayerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(relayerAcnt) {
+		return nil, process.ErrRelayedTxV3SenderShardMismatch
+	}
+
+	return relayerAcnt, nil
+}
+
 func (sc *scProcessor) processVMOutput(
 	vmInput *vmcommon.VMInput,
 	vmOutput *vmcommon.VMOutput,
@@ -2592,6 +2662,29 @@ func (sc *scProcessor) createSCRForSenderAndRelayer(
 		gasRemaining = 0
 	}
 
+	relayerAddr, isRelayedV3 := getRelayerOfRelayedTxV3(tx)
+	shouldRefundGasToRelayerV3 := isRelayedV3 && callType != vmData.AsynchronousCall && gasRefund.Cmp(zero) > 0
+	if shouldRefundGasToRelayerV3 {
+		senderForRelayerRefund := tx.GetRcvAddr()
+		if !sc.isSelfShard(tx.GetRcvAddr()) {
+			senderForRelayerRefund = tx.GetSndAddr()
+		}
+
+		refundGasToRelayerSCR = &smartContractResult.SmartContractResult{
+			Nonce:          tx.GetNonce() + 1,
+			Value:          big.NewInt(0).Set(gasRefund),
+			RcvAddr:        relayerAddr,
+			SndAddr:        senderForRelayerRefund,
+			PrevTxHash:     txHash,
+			OriginalTxHash: txHash,
+			GasPrice:       tx.GetGasPrice(),
+			CallType:       vmData.DirectCall,
+			ReturnMessage:  []byte(core.GasRefundForRelayerMessage),
+			OriginalSender: tx.GetSndAddr(),
+		}
+		gasRemaining = 0
+	}
+
 	scTx := &smartContractResult.SmartContractResult{}
 	scTx.Value = big.NewInt(0).Set(storageFreeRefund)
 	if callType != vmData.AsynchronousCall && check.IfNil(refundGasToRelayerSCR) {
@@ -31,6 +31,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -132,6 +133,7 @@ func createMockSmartContractProcessorArguments() scrCommon.ArgsNewSmartContractP
 		},
 		GasSchedule:        testscommon.NewGasScheduleNotifierMock(gasSchedule),
 		WasmVMChangeLocker: &sync.RWMutex{},
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 		VMOutputCacher:     txcache.NewDisabledCache(),
 	}
 }
@@ -346,6 +348,17 @@ func TestNewSmartContractProcessor_NilBadTxForwarderShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrNilBadTxHandler, err)
 }
 
+func TestNewSmartContractProcessor_NilExecutionTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ExecutionTracer = nil
+	sc, err := NewSmartContractProcessorV2(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
 func TestNewSmartContractProcessor_NilLockerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -2378,7 +2391,7 @@ func TestScProcessor_ProcessSCPaymentAccNotInShardShouldNotReturnError(t *testin
 	tx.GasPrice = 10
 	tx.GasLimit = 10
 
-	err = sc.processSCPayment(tx, nil)
+	err = sc.processSCPayment(tx, nil, nil)
 	require.Nil(t, err)
 }
 
@@ -2409,7 +2422,7 @@ func TestScProcessor_ProcessSCPaymentNotEnoughBalance(t *testing.T) {
 
 	currBalance := acntSrc.GetBalance().Uint64()
 
-	err = sc.processSCPayment(tx, acntSrc)
+	err = sc.processSCPayment(tx, acntSrc, nil)
 	require.Equal(t, process.ErrInsufficientFunds, err)
 	require.Equal(t, currBalance, acntSrc.GetBalance().Uint64())
 }
@@ -2436,7 +2449,7 @@ func TestScProcessor_ProcessSCPayment(t *testing.T) {
 	currBalance := acntSrc.GetBalance().Uint64()
 	modifiedBalance := currBalance - tx.Value.Uint64() - tx.GasLimit*tx.GasLimit
 
-	err = sc.processSCPayment(tx, acntSrc)
+	err = sc.processSCPayment(tx, acntSrc, nil)
 	require.Nil(t, err)
 	require.Equal(t, modifiedBalance, acntSrc.GetBalance().Uint64())
 }
@@ -2475,11 +2488,84 @@ func TestScProcessor_ProcessSCPaymentWithNewFlags(t *testing.T) {
 	acntSrc, _ := createAccounts(tx)
 	currBalance := acntSrc.GetBalance().Uint64()
 	modifiedBalance := currBalance - tx.Value.Uint64() - txFee.Uint64()
-	err = sc.processSCPayment(tx, acntSrc)
+	err = sc.processSCPayment(tx, acntSrc, nil)
 	require.Nil(t, err)
 	require.Equal(t, modifiedBalance, acntSrc.GetBalance().Uint64())
 }
 
+func TestScProcessor_ProcessSCPaymentRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		SndAddr:     []byte("SRC"),
+		RcvAddr:     []byte("DST"),
+		RelayerAddr: []byte("RLY"),
+		Value:       big.NewInt(45),
+		GasPrice:    10,
+		GasLimit:    10,
+	}
+	acntSrc, _ := createAccounts(tx)
+	_ = acntSrc.SubFromBalance(big.NewInt(100))
+	acntRelayer := createAccount(tx.RelayerAddr)
+	_ = acntRelayer.AddToBalance(big.NewInt(150))
+
+	savedAccounts := make(map[string]vmcommon.AccountHandler)
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ShardCoordinator = mock.NewOneShardCoordinatorMock()
+	arguments.AccountsDB = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			require.Equal(t, tx.RelayerAddr, address)
+			return acntRelayer, nil
+		},
+		SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+			savedAccounts[string(account.AddressBytes())] = account
+			return nil
+		},
+	}
+	sc, _ := NewSmartContractProcessorV2(arguments)
+
+	err := sc.processSCPayment(tx, acntSrc, nil)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0), acntSrc.GetBalance())
+	require.Equal(t, uint64(1), acntSrc.GetNonce())
+	require.Equal(t, big.NewInt(50), acntRelayer.GetBalance())
+	require.Equal(t, acntRelayer, savedAccounts[string(tx.RelayerAddr)])
+}
+
+func TestScProcessor_CreateRefundForRelayerOfRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ShardCoordinator = mock.NewOneShardCoordinatorMock()
+	arguments.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+		ComputeFeeForProcessingCalled: func(tx data.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+			return big.NewInt(100)
+		}}
+	sc, _ := NewSmartContractProcessorV2(arguments)
+
+	tx := &transaction.Transaction{
+		Nonce:       3,
+		SndAddr:     []byte("sender"),
+		RcvAddr:     []byte("receiver"),
+		RelayerAddr: []byte("relayer"),
+		Value:       big.NewInt(0),
+		GasPrice:    10,
+		GasLimit:    10000,
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: 1000}
+	scrForSender, relayerRefund := sc.createSCRForSenderAndRelayer(vmOutput, tx, []byte("txhash"), vmData.DirectCall)
+	require.NotNil(t, relayerRefund)
+	assert.Equal(t, tx.RelayerAddr, relayerRefund.RcvAddr)
+	assert.Equal(t, tx.RcvAddr, relayerRefund.SndAddr)
+	assert.Equal(t, big.NewInt(100), relayerRefund.Value)
+	assert.Equal(t, []byte(core.GasRefundForRelayerMessage), relayerRefund.ReturnMessage)
+	assert.Equal(t, []byte("txhash"), relayerRefund.OriginalTxHash)
+	assert.Equal(t, tx.SndAddr, relayerRefund.OriginalSender)
+	assert.Equal(t, tx.SndAddr, scrForSender.RcvAddr)
+	assert.Equal(t, big.NewInt(0), scrForSender.Value)
+}
+
 func TestScProcessor_RefundGasToSenderAccNotInShard(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -17,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/process"
@@ -25,6 +27,8 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ process.SCQueryService = (*SCQueryService)(nil)
@@ -156,7 +160,15 @@ func checkArgs(args ArgsNewSCQueryService) error {
 }
 
 // ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
-func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+func (service *SCQueryService) ExecuteQuery(ctx context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "scQueryService.ExecuteQuery", attribute.String("function", query.FuncName))
+	vmOutput, blockInfo, err := service.executeQuery(ctx, query)
+	tracing.EndSpan(span, err)
+
+	return vmOutput, blockInfo, err
+}
+
+func (service *SCQueryService) executeQuery(ctx context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	if !service.shouldAllowQueriesExecution() {
 		return nil, nil, process.ErrQueriesNotAllowedYet
 	}
@@ -170,8 +182,10 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
+	// the queries are executed one at a time, so the time spent waiting for the previous ones is part of the latency
+	trace.SpanFromContext(ctx).AddEvent("execution lock acquired")
 
-	return service.executeScCall(query, 0)
+	return service.executeScCall(ctx, query, 0)
 }
 
 func (service *SCQueryService) shouldAllowQueriesExecution() bool {
@@ -183,7 +197,7 @@ func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	}
 }
 
-func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, common.BlockInfo, error) {
+func (service *SCQueryService) executeScCall(ctx context.Context, query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	logQueryService.Trace("executeScCall", "address", query.ScAddress, "function", query.FuncName, "blockNonce", query.BlockNonce.Value, "blockHash", query.BlockHash)
 
 	shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
@@ -191,7 +205,9 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, nil, process.ErrNodeIsNotSynced
 	}
 
+	_, headerSpan := tracing.StartSpan(ctx, "scQueryService.extractBlockHeaderAndRootHash")
 	blockHeader, blockRootHash, err := service.extractBlockHeaderAndRootHash(query)
+	tracing.EndSpan(headerSpan, err)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -202,7 +218,9 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 			return nil, nil, err
 		}
 
+		_, recreateTrieSpan := tracing.StartSpan(ctx, "state.RecreateTrie")
 		err = service.recreateTrie(blockRootHash, blockHeader)
+		tracing.EndSpan(recreateTrieSpan, err)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -223,9 +241,19 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, nil, err
 	}
 
+	err = service.setStateOverrides(query.StateOverrides)
+	if err != nil {
+		service.wasmVMChangeLocker.RUnlock()
+		return nil, nil, err
+	}
+
 	query = prepareScQuery(query)
 	vmInput := service.createVMCallInput(query, gasPrice)
+	// the accounts and the storage of the called contracts are read from the state during the execution
+	_, vmSpan := tracing.StartSpan(ctx, "vm.RunSmartContractCall")
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
+	tracing.EndSpan(vmSpan, err)
+	service.cleanStateOverrides(query.StateOverrides)
 	service.wasmVMChangeLocker.RUnlock()
 	if err != nil {
 		return nil, nil, err
@@ -251,6 +279,30 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, blockInfo, nil
 }
 
+func (service *SCQueryService) setStateOverrides(overrides common.StateOverrides) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	overridesHandler, ok := service.blockChainHook.GetAccountsAdapter().(process.StateOverridesHandler)
+	if !ok {
+		return process.ErrStateOverridesNotSupported
+	}
+
+	return overridesHandler.SetStateOverrides(overrides)
+}
+
+func (service *SCQueryService) cleanStateOverrides(overrides common.StateOverrides) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	overridesHandler, ok := service.blockChainHook.GetAccountsAdapter().(process.StateOverridesHandler)
+	if ok {
+		overridesHandler.CleanStateOverrides()
+	}
+}
+
 func (service *SCQueryService) recreateTrie(blockRootHash []byte, blockHeader data.HeaderHandler) error {
 	if check.IfNil(blockHeader) {
 		return process.ErrNilBlockHeader
@@ -445,7 +497,7 @@ func (service *SCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
-	vmOutput, _, err := service.executeScCall(query, 1)
+	vmOutput, _, err := service.executeScCall(context.Background(), query, 1)
 	if err != nil {
 		return 0, err
 	}
@@ -1,6 +1,7 @@
 package smartContract
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -39,13 +40,13 @@ func NewScQueryServiceDispatcher(list []process.SCQueryService) (*scQueryService
 }
 
 // ExecuteQuery will call this method on one of the element from provided list
-func (sqsd *scQueryServiceDispatcher) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+func (sqsd *scQueryServiceDispatcher) ExecuteQuery(ctx context.Context, query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 	index := sqsd.getNewIndex()
 
 	sqsd.mutList.RLock()
 	defer sqsd.mutList.RUnlock()
 
-	return sqsd.list[index].ExecuteQuery(query)
+	return sqsd.list[index].ExecuteQuery(ctx, query)
 }
 
 // ComputeScCallGasLimit will call this method on one of the element from provided list
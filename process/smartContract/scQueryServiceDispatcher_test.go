@@ -1,6 +1,7 @@
 package smartContract
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"sync/atomic"
@@ -73,9 +74,9 @@ func TestScQueryServiceDispatcher_ExecuteQueryShouldCallInRoundRobinFashion(t *t
 		},
 	})
 
-	_, _, _ = sqsd.ExecuteQuery(nil)
-	_, _, _ = sqsd.ExecuteQuery(nil)
-	_, _, _ = sqsd.ExecuteQuery(nil)
+	_, _, _ = sqsd.ExecuteQuery(context.Background(), nil)
+	_, _, _ = sqsd.ExecuteQuery(context.Background(), nil)
+	_, _, _ = sqsd.ExecuteQuery(context.Background(), nil)
 
 	assert.Equal(t, 2, calledElement1)
 	assert.Equal(t, 1, calledElement2)
@@ -148,7 +149,7 @@ func TestScQueryServiceDispatcher_ShouldWorkInAConcurrentManner(t *testing.T) {
 	wg.Add(numCalls * 2)
 	for i := 0; i < numCalls; i++ {
 		go func() {
-			_, _, _ = sqsd.ExecuteQuery(nil)
+			_, _, _ = sqsd.ExecuteQuery(context.Background(), nil)
 			wg.Done()
 		}()
 		go func() {
@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -29,6 +30,10 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb99089770720a"
@@ -223,7 +228,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	output, _, err := target.ExecuteQuery(&query)
+	output, _, err := target.ExecuteQuery(context.Background(), &query)
 
 	assert.Nil(t, output)
 	assert.Equal(t, process.ErrNilScAddress, err)
@@ -241,7 +246,7 @@ func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	output, _, err := target.ExecuteQuery(&query)
+	output, _, err := target.ExecuteQuery(context.Background(), &query)
 
 	assert.Nil(t, output)
 	assert.Equal(t, process.ErrEmptyFunctionName, err)
@@ -261,12 +266,12 @@ func TestExecuteQuery_ShouldPerformActionsInRegardsToAllowanceChannel(t *testing
 		Arguments: [][]byte{},
 	}
 
-	output, _, err := target.ExecuteQuery(&query)
+	output, _, err := target.ExecuteQuery(context.Background(), &query)
 	assert.Equal(t, process.ErrQueriesNotAllowedYet, err)
 	assert.Nil(t, output)
 
 	close(chanAllowedQueries)
-	_, _, err = target.ExecuteQuery(&query)
+	_, _, err = target.ExecuteQuery(context.Background(), &query)
 	assert.NoError(t, err)
 }
 
@@ -302,10 +307,10 @@ func TestExecuteQuery_AllowanceChannelShouldWorkUnderConcurrentRequests(t *testi
 		go func(idx int) {
 			select {
 			case <-chanAllowedQueries:
-				_, _, err := target.ExecuteQuery(&query)
+				_, _, err := target.ExecuteQuery(context.Background(), &query)
 				assert.NoError(t, err)
 			default:
-				output, _, err := target.ExecuteQuery(&query)
+				output, _, err := target.ExecuteQuery(context.Background(), &query)
 				assert.Equal(t, process.ErrQueriesNotAllowedYet, err)
 				assert.Nil(t, output)
 			}
@@ -364,7 +369,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 			Arguments: dataArgs,
 		}
 
-		_, _, _ = target.ExecuteQuery(&query)
+		_, _, _ = target.ExecuteQuery(context.Background(), &query)
 		assert.True(t, runWasCalled)
 	})
 	t.Run("block hash should work - in deep history mode", func(t *testing.T) {
@@ -468,7 +473,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 			BlockHash: providedHash,
 		}
 
-		_, _, err := target.ExecuteQuery(&query)
+		_, _, err := target.ExecuteQuery(context.Background(), &query)
 		assert.True(t, runWasCalled)
 		assert.True(t, recreateTrieFromEpochWasCalled)
 		assert.False(t, recreateTrieWasCalled)
@@ -564,7 +569,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 			BlockHash: providedHash,
 		}
 
-		_, _, err := target.ExecuteQuery(&query)
+		_, _, err := target.ExecuteQuery(context.Background(), &query)
 		assert.True(t, runWasCalled)
 		assert.True(t, recreateTrieWasCalled)
 		assert.False(t, recreateTrieFromEpochWasCalled)
@@ -715,13 +720,156 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	vmOutput, _, err := target.ExecuteQuery(&query)
+	vmOutput, _, err := target.ExecuteQuery(context.Background(), &query)
 
 	assert.Nil(t, err)
 	assert.Equal(t, d[0], vmOutput.ReturnData[0])
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func TestExecuteQuery_ShouldRecordTheSpansUnderTheProvidedContext(t *testing.T) {
+	// not parallel, as the tracer provider is set as the global one
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	argsNewSCQuery := createMockArgumentsForSCQuery()
+	argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return mockVM, nil
+		},
+	}
+	argsNewSCQuery.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+		MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+			return uint64(math.MaxUint64)
+		},
+	}
+	target, _ := NewSCQueryService(argsNewSCQuery)
+
+	ctx, requestSpan := otel.Tracer("test").Start(context.Background(), "request")
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		Arguments: [][]byte{},
+	}
+	_, _, err := target.ExecuteQuery(ctx, &query)
+	requestSpan.End()
+	require.Nil(t, err)
+
+	spansByName := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spansByName[span.Name()] = span
+	}
+
+	querySpan := spansByName["scQueryService.ExecuteQuery"]
+	require.NotNil(t, querySpan)
+	require.Equal(t, requestSpan.SpanContext().SpanID(), querySpan.Parent().SpanID())
+	require.Equal(t, "execution lock acquired", querySpan.Events()[0].Name)
+
+	for _, name := range []string{"scQueryService.extractBlockHeaderAndRootHash", "vm.RunSmartContractCall"} {
+		span := spansByName[name]
+		require.NotNil(t, span, name)
+		require.Equal(t, querySpan.SpanContext().SpanID(), span.Parent().SpanID(), name)
+	}
+}
+
+func TestExecuteQuery_WithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	query := &process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		StateOverrides: common.StateOverrides{
+			"address": &common.AccountStateOverride{Balance: big.NewInt(100)},
+		},
+	}
+
+	createArgs := func(runCalled *bool) ArgsNewSCQueryService {
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						*runCalled = true
+						return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+					},
+				}, nil
+			},
+		}
+		args.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return uint64(math.MaxUint64)
+			},
+		}
+
+		return args
+	}
+
+	t.Run("accounts adapter not supporting overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		runCalled := false
+		target, _ := NewSCQueryService(createArgs(&runCalled))
+
+		_, _, err := target.ExecuteQuery(context.Background(), query)
+		require.Equal(t, process.ErrStateOverridesNotSupported, err)
+		require.False(t, runCalled)
+	})
+	t.Run("should set the overrides before the execution and clean them afterwards", func(t *testing.T) {
+		t.Parallel()
+
+		runCalled := false
+		setCalled := false
+		cleanCalled := false
+		accountsAdapter := &accountsAdapterWithOverridesStub{
+			AccountsStub: &stateMocks.AccountsStub{},
+			setStateOverridesCalled: func(overrides common.StateOverrides) error {
+				require.Equal(t, query.StateOverrides, overrides)
+				require.False(t, runCalled)
+				setCalled = true
+				return nil
+			},
+			cleanStateOverridesCalled: func() {
+				require.True(t, runCalled)
+				cleanCalled = true
+			},
+		}
+
+		args := createArgs(&runCalled)
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return accountsAdapter
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		_, _, err := target.ExecuteQuery(context.Background(), query)
+		require.NoError(t, err)
+		require.True(t, setCalled)
+		require.True(t, runCalled)
+		require.True(t, cleanCalled)
+	})
+}
+
+type accountsAdapterWithOverridesStub struct {
+	*stateMocks.AccountsStub
+	setStateOverridesCalled   func(overrides common.StateOverrides) error
+	cleanStateOverridesCalled func()
+}
+
+func (stub *accountsAdapterWithOverridesStub) SetStateOverrides(overrides common.StateOverrides) error {
+	return stub.setStateOverridesCalled(overrides)
+}
+
+func (stub *accountsAdapterWithOverridesStub) CleanStateOverrides() {
+	stub.cleanStateOverridesCalled()
+}
+
 func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 	t.Parallel()
 
@@ -756,7 +904,7 @@ func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 			Arguments: [][]byte{},
 		}
 
-		_, _, err := target.ExecuteQuery(&query)
+		_, _, err := target.ExecuteQuery(context.Background(), &query)
 		require.Nil(t, err)
 		require.True(t, runSCWasCalled)
 	})
@@ -795,7 +943,7 @@ func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 			Arguments: [][]byte{},
 		}
 
-		_, _, err := target.ExecuteQuery(&query)
+		_, _, err := target.ExecuteQuery(context.Background(), &query)
 		require.Nil(t, err)
 		require.True(t, runSCWasCalled)
 	})
@@ -832,7 +980,7 @@ func TestExecuteQuery_WhenNotOkCodeShouldNotErr(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	returnedData, _, err := target.ExecuteQuery(&query)
+	returnedData, _, err := target.ExecuteQuery(context.Background(), &query)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, returnedData)
@@ -884,7 +1032,7 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 				Arguments: [][]byte{},
 			}
 
-			_, _, _ = target.ExecuteQuery(&query)
+			_, _, _ = target.ExecuteQuery(context.Background(), &query)
 			wg.Done()
 		}()
 	}
@@ -927,7 +1075,7 @@ func TestSCQueryService_ExecuteQueryShouldNotIncludeCallerAddressAndValue(t *tes
 		Arguments: [][]byte{},
 	}
 
-	_, _, err := target.ExecuteQuery(&query)
+	_, _, err := target.ExecuteQuery(context.Background(), &query)
 	require.NoError(t, err)
 	require.True(t, callerAddressAndCallValueAreNotSet)
 }
@@ -971,7 +1119,7 @@ func TestSCQueryService_ExecuteQueryShouldIncludeCallerAddressAndValue(t *testin
 		Arguments:  [][]byte{},
 	}
 
-	_, _, err := target.ExecuteQuery(&query)
+	_, _, err := target.ExecuteQuery(context.Background(), &query)
 	require.NoError(t, err)
 	require.True(t, callerAddressAndCallValueAreSet)
 }
@@ -988,7 +1136,7 @@ func TestSCQueryService_ShouldFailIfNodeIsNotSynced(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, _, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(context.Background(), &process.SCQuery{
 		ShouldBeSynced: true,
 		ScAddress:      []byte(DummyScAddress),
 		FuncName:       "function",
@@ -1009,7 +1157,7 @@ func TestSCQueryService_ShouldWorkIfNodeIsSynced(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, _, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(context.Background(), &process.SCQuery{
 		ShouldBeSynced: true,
 		ScAddress:      []byte(DummyScAddress),
 		FuncName:       "function",
@@ -1037,7 +1185,7 @@ func TestSCQueryService_ShouldFailIfStateChanged(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, _, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(context.Background(), &process.SCQuery{
 		SameScState: true,
 		ScAddress:   []byte(DummyScAddress),
 		FuncName:    "function",
@@ -1062,7 +1210,7 @@ func TestSCQueryService_ShouldWorkIfStateDidntChange(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, _, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(context.Background(), &process.SCQuery{
 		SameScState: true,
 		ScAddress:   []byte(DummyScAddress),
 		FuncName:    "function",
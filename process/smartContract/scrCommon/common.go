@@ -54,6 +54,7 @@ type ArgsNewSmartContractProcessor struct {
 	EnableEpochs        config.EnableEpochs
 	VMOutputCacher      storage.Cacher
 	WasmVMChangeLocker  common.Locker
+	ExecutionTracer     process.ExecutionTracer
 	IsGenesisProcessing bool
 }
 
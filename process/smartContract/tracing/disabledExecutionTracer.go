@@ -0,0 +1,41 @@
+package tracing
+
+import vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+
+type disabledExecutionTracer struct {
+}
+
+// NewDisabledExecutionTracer will create a new instance of type disabledExecutionTracer
+func NewDisabledExecutionTracer() *disabledExecutionTracer {
+	return &disabledExecutionTracer{}
+}
+
+// OnContractCallStart does nothing
+func (tracer *disabledExecutionTracer) OnContractCallStart(_ *vmcommon.ContractCallInput) {}
+
+// OnContractCreateStart does nothing
+func (tracer *disabledExecutionTracer) OnContractCreateStart(_ *vmcommon.ContractCreateInput) {}
+
+// OnBuiltInFunctionCallStart does nothing
+func (tracer *disabledExecutionTracer) OnBuiltInFunctionCallStart(_ *vmcommon.ContractCallInput) {}
+
+// OnExecutionEnd does nothing
+func (tracer *disabledExecutionTracer) OnExecutionEnd(_ *vmcommon.VMOutput, _ error) {}
+
+// OnStorageRead does nothing
+func (tracer *disabledExecutionTracer) OnStorageRead(_ []byte, _ []byte, _ []byte) {}
+
+// StartTracing does nothing
+func (tracer *disabledExecutionTracer) StartTracing() {}
+
+// StopTracing returns an empty trace
+func (tracer *disabledExecutionTracer) StopTracing() *ExecutionTrace {
+	return &ExecutionTrace{
+		Calls: make([]*CallFrame, 0),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *disabledExecutionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledExecutionTracer_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	tracer := NewDisabledExecutionTracer()
+	assert.False(t, check.IfNil(tracer))
+
+	tracer.StartTracing()
+	tracer.OnContractCallStart(&vmcommon.ContractCallInput{})
+	tracer.OnContractCreateStart(&vmcommon.ContractCreateInput{})
+	tracer.OnBuiltInFunctionCallStart(&vmcommon.ContractCallInput{})
+	tracer.OnStorageRead([]byte("address"), []byte("key"), []byte("value"))
+	tracer.OnExecutionEnd(nil, errors.New("error"))
+
+	trace := tracer.StopTracing()
+	assert.NotNil(t, trace)
+	assert.Empty(t, trace.Calls)
+	assert.False(t, trace.Truncated)
+}
@@ -0,0 +1,6 @@
+package tracing
+
+import "errors"
+
+// ErrInvalidMaxTracedCalls signals that an invalid maximum number of traced calls has been provided
+var ErrInvalidMaxTracedCalls = errors.New("invalid maximum number of traced calls")
@@ -0,0 +1,378 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("process/smartcontract/tracing")
+
+const (
+	transferValueOnlyIdentifier  = "transferValueOnly"
+	executeOnDestContextCallType = "ExecuteOnDestContext"
+	directCallType               = "DirectCall"
+)
+
+type executionTracer struct {
+	pubkeyConverter core.PubkeyConverter
+	maxTracedCalls  int
+
+	mutTrace       sync.Mutex
+	isTracing      bool
+	numTracedCalls int
+	trace          *ExecutionTrace
+	// a nil frame on the stack marks a call which was not recorded because the limit was reached
+	framesStack []*CallFrame
+}
+
+// NewExecutionTracer creates a tracer able to record the call tree of the smart contract executions which happen
+// between a StartTracing and a StopTracing call
+func NewExecutionTracer(pubkeyConverter core.PubkeyConverter, maxTracedCalls int) (*executionTracer, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if maxTracedCalls < 1 {
+		return nil, ErrInvalidMaxTracedCalls
+	}
+
+	return &executionTracer{
+		pubkeyConverter: pubkeyConverter,
+		maxTracedCalls:  maxTracedCalls,
+	}, nil
+}
+
+// StartTracing will reset the recorded trace and will start recording the next executions
+func (tracer *executionTracer) StartTracing() {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	tracer.isTracing = true
+	tracer.numTracedCalls = 0
+	tracer.framesStack = make([]*CallFrame, 0)
+	tracer.trace = &ExecutionTrace{
+		Calls: make([]*CallFrame, 0),
+	}
+}
+
+// StopTracing stops the recording and returns the recorded trace
+func (tracer *executionTracer) StopTracing() *ExecutionTrace {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	trace := tracer.trace
+	if trace == nil {
+		trace = &ExecutionTrace{
+			Calls: make([]*CallFrame, 0),
+		}
+	}
+
+	tracer.isTracing = false
+	tracer.trace = nil
+	tracer.framesStack = nil
+
+	return trace
+}
+
+// OnContractCallStart records the start of a smart contract call
+func (tracer *executionTracer) OnContractCallStart(input *vmcommon.ContractCallInput) {
+	if input == nil {
+		return
+	}
+
+	tracer.startFrame(CallFrameType, input.RecipientAddr, input.Function, &input.VMInput)
+}
+
+// OnContractCreateStart records the start of a smart contract deployment
+func (tracer *executionTracer) OnContractCreateStart(input *vmcommon.ContractCreateInput) {
+	if input == nil {
+		return
+	}
+
+	tracer.startFrame(DeployFrameType, nil, "", &input.VMInput)
+}
+
+// OnBuiltInFunctionCallStart records the start of a built-in function call
+func (tracer *executionTracer) OnBuiltInFunctionCallStart(input *vmcommon.ContractCallInput) {
+	if input == nil {
+		return
+	}
+
+	tracer.startFrame(BuiltInFunctionFrameType, input.RecipientAddr, input.Function, &input.VMInput)
+}
+
+func (tracer *executionTracer) startFrame(frameType string, callee []byte, function string, input *vmcommon.VMInput) {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	if !tracer.isTracing {
+		return
+	}
+
+	parent, hasParent := tracer.getCurrentFrame()
+	if hasParent && parent == nil {
+		// the parent call was not recorded, so its inner calls are skipped as well
+		tracer.framesStack = append(tracer.framesStack, nil)
+		return
+	}
+	if tracer.numTracedCalls >= tracer.maxTracedCalls {
+		tracer.trace.Truncated = true
+		tracer.framesStack = append(tracer.framesStack, nil)
+		return
+	}
+
+	frame := tracer.newFrame(frameType, callee, function, input)
+	if hasParent {
+		parent.Calls = append(parent.Calls, frame)
+	} else {
+		tracer.trace.Calls = append(tracer.trace.Calls, frame)
+	}
+
+	tracer.numTracedCalls++
+	tracer.framesStack = append(tracer.framesStack, frame)
+}
+
+func (tracer *executionTracer) newFrame(frameType string, callee []byte, function string, input *vmcommon.VMInput) *CallFrame {
+	frame := &CallFrame{
+		Type:        frameType,
+		Caller:      tracer.encodeAddress(input.CallerAddr),
+		Callee:      tracer.encodeAddress(callee),
+		Function:    function,
+		Arguments:   hexEncodeSlice(input.Arguments),
+		Value:       "0",
+		GasProvided: input.GasProvided,
+	}
+	if input.CallValue != nil {
+		frame.Value = input.CallValue.String()
+	}
+
+	for _, transfer := range input.ESDTTransfers {
+		if transfer == nil {
+			continue
+		}
+
+		transferTrace := &ESDTTransferTrace{
+			TokenIdentifier: string(transfer.ESDTTokenName),
+			Nonce:           transfer.ESDTTokenNonce,
+			Value:           "0",
+		}
+		if transfer.ESDTValue != nil {
+			transferTrace.Value = transfer.ESDTValue.String()
+		}
+		frame.ESDTTransfers = append(frame.ESDTTransfers, transferTrace)
+	}
+
+	return frame
+}
+
+// OnExecutionEnd records the results of the most recently started execution
+func (tracer *executionTracer) OnExecutionEnd(vmOutput *vmcommon.VMOutput, err error) {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	if !tracer.isTracing {
+		return
+	}
+
+	frame, hasFrame := tracer.getCurrentFrame()
+	if !hasFrame {
+		log.Debug("executionTracer.OnExecutionEnd called without a started execution")
+		return
+	}
+
+	tracer.framesStack = tracer.framesStack[:len(tracer.framesStack)-1]
+	if frame == nil {
+		return
+	}
+
+	tracer.setFrameResults(frame, vmOutput, err)
+}
+
+func (tracer *executionTracer) setFrameResults(frame *CallFrame, vmOutput *vmcommon.VMOutput, err error) {
+	if err != nil {
+		frame.ReturnCode = vmcommon.ExecutionFailed.String()
+		frame.ReturnMessage = err.Error()
+		frame.GasUsed = frame.GasProvided
+		return
+	}
+	if vmOutput == nil {
+		frame.ReturnCode = vmcommon.ExecutionFailed.String()
+		frame.ReturnMessage = process.ErrNilVMOutput.Error()
+		frame.GasUsed = frame.GasProvided
+		return
+	}
+
+	frame.ReturnCode = vmOutput.ReturnCode.String()
+	frame.ReturnMessage = vmOutput.ReturnMessage
+	frame.ReturnData = hexEncodeSlice(vmOutput.ReturnData)
+	if frame.GasProvided > vmOutput.GasRemaining {
+		frame.GasUsed = frame.GasProvided - vmOutput.GasRemaining
+	}
+
+	for _, outputAccount := range sortedOutputAccounts(vmOutput.OutputAccounts) {
+		if frame.Type == DeployFrameType && len(frame.Callee) == 0 && len(outputAccount.Code) > 0 {
+			frame.Callee = tracer.encodeAddress(outputAccount.Address)
+		}
+
+		frame.StorageWrites = append(frame.StorageWrites, tracer.createStorageWrites(outputAccount)...)
+	}
+
+	if frame.Type == CallFrameType || frame.Type == DeployFrameType {
+		tracer.appendVMCalls(frame, vmOutput.Logs)
+	}
+}
+
+// appendVMCalls reconstructs the calls a contract made inside its own VM (execute on dest/same context, async calls,
+// transfers) from the transferValueOnly log entries of the VM output, since those calls do not pass through the
+// blockchain hook. The entries only hold the caller, callee, value, function and arguments, so the resulting frames
+// carry no gas, storage or return details, and the calls made by the nested contracts are listed flat, in the order
+// they were made, after the calls recorded through the hook.
+func (tracer *executionTracer) appendVMCalls(frame *CallFrame, logs []*vmcommon.LogEntry) {
+	for _, logEntry := range logs {
+		vmCall, ok := tracer.createVMCallFrame(logEntry)
+		if !ok {
+			continue
+		}
+		if tracer.numTracedCalls >= tracer.maxTracedCalls {
+			tracer.trace.Truncated = true
+			return
+		}
+
+		frame.Calls = append(frame.Calls, vmCall)
+		tracer.numTracedCalls++
+	}
+}
+
+func (tracer *executionTracer) createVMCallFrame(logEntry *vmcommon.LogEntry) (*CallFrame, bool) {
+	if logEntry == nil || string(logEntry.Identifier) != transferValueOnlyIdentifier {
+		return nil, false
+	}
+	if len(logEntry.Topics) < 2 || len(logEntry.Data) == 0 {
+		return nil, false
+	}
+
+	callType := string(logEntry.Data[0])
+	if len(callType) == 0 {
+		callType = executeOnDestContextCallType
+	}
+
+	frame := &CallFrame{
+		Type:     VMCallFrameType,
+		CallType: callType,
+		Caller:   tracer.encodeAddress(logEntry.Address),
+		Callee:   tracer.encodeAddress(logEntry.Topics[1]),
+		Value:    big.NewInt(0).SetBytes(logEntry.Topics[0]).String(),
+	}
+	// a direct call entry holds the raw transfer data instead of a function call
+	if callType == directCallType || len(logEntry.Data) < 2 {
+		return frame, true
+	}
+
+	frame.Function = string(logEntry.Data[1])
+	frame.Arguments = hexEncodeSlice(logEntry.Data[2:])
+
+	return frame, true
+}
+
+func (tracer *executionTracer) createStorageWrites(outputAccount *vmcommon.OutputAccount) []*StorageAccess {
+	keys := make([]string, 0, len(outputAccount.StorageUpdates))
+	for key, update := range outputAccount.StorageUpdates {
+		if update == nil || !update.Written {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	address := tracer.encodeAddress(outputAccount.Address)
+	writes := make([]*StorageAccess, 0, len(keys))
+	for _, key := range keys {
+		writes = append(writes, &StorageAccess{
+			Address: address,
+			Key:     hex.EncodeToString([]byte(key)),
+			Value:   hex.EncodeToString(outputAccount.StorageUpdates[key].Data),
+		})
+	}
+
+	return writes
+}
+
+// OnStorageRead records a storage read made by the most recently started execution
+func (tracer *executionTracer) OnStorageRead(address []byte, key []byte, value []byte) {
+	tracer.mutTrace.Lock()
+	defer tracer.mutTrace.Unlock()
+
+	if !tracer.isTracing {
+		return
+	}
+
+	frame, hasFrame := tracer.getCurrentFrame()
+	if !hasFrame || frame == nil {
+		return
+	}
+
+	frame.StorageReads = append(frame.StorageReads, &StorageAccess{
+		Address: tracer.encodeAddress(address),
+		Key:     hex.EncodeToString(key),
+		Value:   hex.EncodeToString(value),
+	})
+}
+
+func (tracer *executionTracer) getCurrentFrame() (*CallFrame, bool) {
+	if len(tracer.framesStack) == 0 {
+		return nil, false
+	}
+
+	return tracer.framesStack[len(tracer.framesStack)-1], true
+}
+
+func (tracer *executionTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return tracer.pubkeyConverter.SilentEncode(address, log)
+}
+
+func sortedOutputAccounts(outputAccounts map[string]*vmcommon.OutputAccount) []*vmcommon.OutputAccount {
+	keys := make([]string, 0, len(outputAccounts))
+	for key, outputAccount := range outputAccounts {
+		if outputAccount == nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sorted := make([]*vmcommon.OutputAccount, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, outputAccounts[key])
+	}
+
+	return sorted
+}
+
+func hexEncodeSlice(values [][]byte) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		encoded = append(encoded, hex.EncodeToString(value))
+	}
+
+	return encoded
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *executionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
@@ -0,0 +1,305 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	callerAddress   = []byte("caller-address")
+	contractAddress = []byte("contract-address")
+	innerAddress    = []byte("inner-contract-address")
+)
+
+func createCallInput(recipient []byte, function string, gasProvided uint64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  callerAddress,
+			Arguments:   [][]byte{[]byte("arg")},
+			CallValue:   big.NewInt(10),
+			GasProvided: gasProvided,
+		},
+		RecipientAddr: recipient,
+		Function:      function,
+	}
+}
+
+func createTracer(t *testing.T, maxTracedCalls int) *executionTracer {
+	tracer, err := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32), maxTracedCalls)
+	require.Nil(t, err)
+
+	return tracer
+}
+
+func TestNewExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(nil, 10)
+		assert.Equal(t, process.ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(tracer))
+	})
+	t.Run("invalid max traced calls should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32), 0)
+		assert.Equal(t, ErrInvalidMaxTracedCalls, err)
+		assert.True(t, check.IfNil(tracer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32), 10)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(tracer))
+	})
+}
+
+func TestExecutionTracer_NotStartedShouldNotRecord(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t, 10)
+	tracer.OnContractCallStart(createCallInput(contractAddress, "foo", 1000))
+	tracer.OnStorageRead(contractAddress, []byte("key"), []byte("value"))
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{}, nil)
+
+	trace := tracer.StopTracing()
+	assert.Empty(t, trace.Calls)
+	assert.False(t, trace.Truncated)
+}
+
+func TestExecutionTracer_ShouldRecordNestedCalls(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t, 10)
+	tracer.StartTracing()
+
+	tracer.OnContractCallStart(createCallInput(contractAddress, "foo", 1000))
+	tracer.OnStorageRead(contractAddress, []byte("key"), []byte("value"))
+
+	tracer.OnBuiltInFunctionCallStart(createCallInput(innerAddress, "ESDTTransfer", 100))
+	tracer.OnExecutionEnd(nil, errors.New("insufficient funds"))
+
+	tracer.OnContractCallStart(createCallInput(innerAddress, "bar", 500))
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		ReturnData:   [][]byte{[]byte("result")},
+		GasRemaining: 200,
+	}, nil)
+
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contractAddress): {
+				Address: contractAddress,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"b": {Offset: []byte("b"), Data: []byte("2"), Written: true},
+					"a": {Offset: []byte("a"), Data: []byte("1"), Written: true},
+					"c": {Offset: []byte("c"), Data: []byte("3"), Written: false},
+				},
+			},
+		},
+	}, nil)
+
+	trace := tracer.StopTracing()
+	require.Equal(t, 1, len(trace.Calls))
+	assert.False(t, trace.Truncated)
+
+	root := trace.Calls[0]
+	assert.Equal(t, CallFrameType, root.Type)
+	assert.Equal(t, hex.EncodeToString(callerAddress), root.Caller)
+	assert.Equal(t, hex.EncodeToString(contractAddress), root.Callee)
+	assert.Equal(t, "foo", root.Function)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("arg"))}, root.Arguments)
+	assert.Equal(t, "10", root.Value)
+	assert.Equal(t, uint64(900), root.GasUsed)
+	assert.Equal(t, vmcommon.Ok.String(), root.ReturnCode)
+	require.Equal(t, 1, len(root.StorageReads))
+	assert.Equal(t, hex.EncodeToString([]byte("key")), root.StorageReads[0].Key)
+	assert.Equal(t, hex.EncodeToString([]byte("value")), root.StorageReads[0].Value)
+	require.Equal(t, 2, len(root.StorageWrites))
+	assert.Equal(t, hex.EncodeToString([]byte("a")), root.StorageWrites[0].Key)
+	assert.Equal(t, hex.EncodeToString([]byte("b")), root.StorageWrites[1].Key)
+
+	require.Equal(t, 2, len(root.Calls))
+	builtInCall := root.Calls[0]
+	assert.Equal(t, BuiltInFunctionFrameType, builtInCall.Type)
+	assert.Equal(t, vmcommon.ExecutionFailed.String(), builtInCall.ReturnCode)
+	assert.Equal(t, "insufficient funds", builtInCall.ReturnMessage)
+	assert.Equal(t, uint64(100), builtInCall.GasUsed)
+
+	innerCall := root.Calls[1]
+	assert.Equal(t, "bar", innerCall.Function)
+	assert.Equal(t, uint64(300), innerCall.GasUsed)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("result"))}, innerCall.ReturnData)
+}
+
+func createTransferValueOnlyLog(sender []byte, destination []byte, value int64, data ...[]byte) *vmcommon.LogEntry {
+	return &vmcommon.LogEntry{
+		Identifier: []byte(transferValueOnlyIdentifier),
+		Address:    sender,
+		Topics:     [][]byte{big.NewInt(value).Bytes(), destination},
+		Data:       data,
+	}
+}
+
+func TestExecutionTracer_ShouldRecordVMCallsFromOutput(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t, 10)
+	tracer.StartTracing()
+
+	tracer.OnContractCallStart(createCallInput(contractAddress, "foo", 1000))
+	tracer.OnBuiltInFunctionCallStart(createCallInput(innerAddress, "ESDTTransfer", 100))
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		Logs:       []*vmcommon.LogEntry{createTransferValueOnlyLog(contractAddress, innerAddress, 1, []byte(""), []byte("bar"))},
+	}, nil)
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		Logs: []*vmcommon.LogEntry{
+			createTransferValueOnlyLog(contractAddress, innerAddress, 5, []byte(""), []byte("bar"), []byte("arg")),
+			{Identifier: []byte("writeLog"), Address: contractAddress, Topics: [][]byte{[]byte("topic")}},
+			createTransferValueOnlyLog(innerAddress, contractAddress, 0, []byte("ExecuteOnSameContext"), []byte("baz")),
+			createTransferValueOnlyLog(contractAddress, callerAddress, 7, []byte("DirectCall"), []byte("data")),
+			createTransferValueOnlyLog(contractAddress, innerAddress, 0, []byte("AsyncCall"), []byte("qux"), []byte("a"), []byte("b")),
+			nil,
+		},
+	}, nil)
+
+	trace := tracer.StopTracing()
+	require.Equal(t, 1, len(trace.Calls))
+	assert.False(t, trace.Truncated)
+
+	root := trace.Calls[0]
+	require.Equal(t, 5, len(root.Calls))
+
+	builtInCall := root.Calls[0]
+	assert.Equal(t, BuiltInFunctionFrameType, builtInCall.Type)
+	assert.Empty(t, builtInCall.Calls)
+
+	destContextCall := root.Calls[1]
+	assert.Equal(t, VMCallFrameType, destContextCall.Type)
+	assert.Equal(t, "ExecuteOnDestContext", destContextCall.CallType)
+	assert.Equal(t, hex.EncodeToString(contractAddress), destContextCall.Caller)
+	assert.Equal(t, hex.EncodeToString(innerAddress), destContextCall.Callee)
+	assert.Equal(t, "5", destContextCall.Value)
+	assert.Equal(t, "bar", destContextCall.Function)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("arg"))}, destContextCall.Arguments)
+
+	sameContextCall := root.Calls[2]
+	assert.Equal(t, "ExecuteOnSameContext", sameContextCall.CallType)
+	assert.Equal(t, hex.EncodeToString(innerAddress), sameContextCall.Caller)
+	assert.Equal(t, "0", sameContextCall.Value)
+	assert.Equal(t, "baz", sameContextCall.Function)
+	assert.Nil(t, sameContextCall.Arguments)
+
+	directCall := root.Calls[3]
+	assert.Equal(t, "DirectCall", directCall.CallType)
+	assert.Equal(t, "7", directCall.Value)
+	assert.Empty(t, directCall.Function)
+	assert.Nil(t, directCall.Arguments)
+
+	asyncCall := root.Calls[4]
+	assert.Equal(t, "AsyncCall", asyncCall.CallType)
+	assert.Equal(t, "qux", asyncCall.Function)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("a")), hex.EncodeToString([]byte("b"))}, asyncCall.Arguments)
+}
+
+func TestExecutionTracer_VMCallsShouldTruncateWhenLimitReached(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t, 2)
+	tracer.StartTracing()
+
+	tracer.OnContractCallStart(createCallInput(contractAddress, "foo", 1000))
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		Logs: []*vmcommon.LogEntry{
+			createTransferValueOnlyLog(contractAddress, innerAddress, 0, []byte(""), []byte("bar")),
+			createTransferValueOnlyLog(contractAddress, innerAddress, 0, []byte(""), []byte("baz")),
+		},
+	}, nil)
+
+	trace := tracer.StopTracing()
+	assert.True(t, trace.Truncated)
+	require.Equal(t, 1, len(trace.Calls))
+	require.Equal(t, 1, len(trace.Calls[0].Calls))
+	assert.Equal(t, "bar", trace.Calls[0].Calls[0].Function)
+}
+
+func TestExecutionTracer_DeployShouldSetCalleeFromOutput(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t, 10)
+	tracer.StartTracing()
+
+	tracer.OnContractCreateStart(&vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  callerAddress,
+			GasProvided: 1000,
+		},
+		ContractCode: []byte("code"),
+	})
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contractAddress): {
+				Address: contractAddress,
+				Code:    []byte("code"),
+			},
+		},
+	}, nil)
+
+	trace := tracer.StopTracing()
+	require.Equal(t, 1, len(trace.Calls))
+	assert.Equal(t, DeployFrameType, trace.Calls[0].Type)
+	assert.Equal(t, hex.EncodeToString(contractAddress), trace.Calls[0].Callee)
+	assert.Equal(t, uint64(600), trace.Calls[0].GasUsed)
+}
+
+func TestExecutionTracer_ShouldTruncateWhenLimitReached(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t, 1)
+	tracer.StartTracing()
+
+	tracer.OnContractCallStart(createCallInput(contractAddress, "foo", 1000))
+	tracer.OnContractCallStart(createCallInput(innerAddress, "bar", 500))
+	tracer.OnStorageRead(innerAddress, []byte("key"), []byte("value"))
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil)
+
+	trace := tracer.StopTracing()
+	assert.True(t, trace.Truncated)
+	require.Equal(t, 1, len(trace.Calls))
+	assert.Empty(t, trace.Calls[0].Calls)
+	assert.Empty(t, trace.Calls[0].StorageReads)
+	assert.Equal(t, vmcommon.UserError.String(), trace.Calls[0].ReturnCode)
+}
+
+func TestExecutionTracer_StartTracingShouldResetTrace(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracer(t, 10)
+	tracer.StartTracing()
+	tracer.OnContractCallStart(createCallInput(contractAddress, "foo", 1000))
+	tracer.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+
+	tracer.StartTracing()
+	trace := tracer.StopTracing()
+	assert.Empty(t, trace.Calls)
+}
@@ -0,0 +1,53 @@
+package tracing
+
+const (
+	// CallFrameType is the frame type of a smart contract call
+	CallFrameType = "call"
+	// DeployFrameType is the frame type of a smart contract deployment
+	DeployFrameType = "deploy"
+	// BuiltInFunctionFrameType is the frame type of a built-in function call
+	BuiltInFunctionFrameType = "builtInFunction"
+	// VMCallFrameType is the frame type of a call made by a contract inside the same VM, which is reconstructed from
+	// the VM output of the calling frame
+	VMCallFrameType = "vmCall"
+)
+
+// ExecutionTrace holds the recorded call tree of a traced execution
+type ExecutionTrace struct {
+	Calls     []*CallFrame `json:"calls"`
+	Truncated bool         `json:"truncated,omitempty"`
+}
+
+// CallFrame holds the details of a single traced execution, together with the executions it triggered
+type CallFrame struct {
+	Type          string               `json:"type"`
+	CallType      string               `json:"callType,omitempty"`
+	Caller        string               `json:"caller"`
+	Callee        string               `json:"callee,omitempty"`
+	Function      string               `json:"function,omitempty"`
+	Arguments     []string             `json:"arguments,omitempty"`
+	Value         string               `json:"value"`
+	ESDTTransfers []*ESDTTransferTrace `json:"esdtTransfers,omitempty"`
+	GasProvided   uint64               `json:"gasProvided"`
+	GasUsed       uint64               `json:"gasUsed"`
+	StorageReads  []*StorageAccess     `json:"storageReads,omitempty"`
+	StorageWrites []*StorageAccess     `json:"storageWrites,omitempty"`
+	ReturnCode    string               `json:"returnCode"`
+	ReturnMessage string               `json:"returnMessage,omitempty"`
+	ReturnData    []string             `json:"returnData,omitempty"`
+	Calls         []*CallFrame         `json:"calls,omitempty"`
+}
+
+// ESDTTransferTrace holds the details of an ESDT transfer attached to a traced call
+type ESDTTransferTrace struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	Value           string `json:"value"`
+}
+
+// StorageAccess holds a storage key, hex encoded, together with the value read or written, also hex encoded
+type StorageAccess struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
@@ -0,0 +1,124 @@
+package stateOverrides
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const esdtKeyPrefix = core.ProtectedKeyPrefix + core.ESDTKeyIdentifier
+
+type accountOverridesApplier struct {
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// NewAccountOverridesApplier creates a component able to apply state overrides on user accounts
+func NewAccountOverridesApplier(marshaller marshal.Marshalizer, hasher hashing.Hasher) (*accountOverridesApplier, error) {
+	if check.IfNil(marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &accountOverridesApplier{
+		marshaller: marshaller,
+		hasher:     hasher,
+	}, nil
+}
+
+// ApplyOverride will change the provided account (without saving it) so that it reflects the provided override.
+// It returns the hash of the overridden code, if any
+func (applier *accountOverridesApplier) ApplyOverride(account state.UserAccountHandler, override *common.AccountStateOverride) ([]byte, error) {
+	if check.IfNil(account) {
+		return nil, process.ErrNilUserAccount
+	}
+	if override == nil {
+		return nil, ErrNilAccountStateOverride
+	}
+
+	err := applier.applyBalance(account, override)
+	if err != nil {
+		return nil, err
+	}
+
+	for tokenID, value := range override.ESDTBalances {
+		err = applier.applyESDTBalance(account, tokenID, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for key, value := range override.Storage {
+		err = account.SaveKeyValue([]byte(key), value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(override.Code) == 0 {
+		return nil, nil
+	}
+
+	codeHash := applier.hasher.Compute(string(override.Code))
+	account.SetCode(override.Code)
+	account.SetCodeHash(codeHash)
+
+	return codeHash, nil
+}
+
+func (applier *accountOverridesApplier) applyBalance(account state.UserAccountHandler, override *common.AccountStateOverride) error {
+	if override.Balance == nil {
+		return nil
+	}
+	if override.Balance.Sign() < 0 {
+		return ErrNegativeValueOverride
+	}
+
+	delta := big.NewInt(0).Set(override.Balance)
+	currentBalance := account.GetBalance()
+	if currentBalance != nil {
+		delta.Sub(delta, currentBalance)
+	}
+
+	return account.AddToBalance(delta)
+}
+
+func (applier *accountOverridesApplier) applyESDTBalance(account state.UserAccountHandler, tokenID string, value *big.Int) error {
+	if value == nil || value.Sign() < 0 {
+		return ErrNegativeValueOverride
+	}
+
+	key := []byte(esdtKeyPrefix + tokenID)
+	token := &esdt.ESDigitalToken{}
+
+	// an error here means the token was never stored on this account, so a fresh token is created
+	existingBuff, _, err := account.RetrieveValue(key)
+	if err == nil && len(existingBuff) > 0 {
+		err = applier.marshaller.Unmarshal(token, existingBuff)
+		if err != nil {
+			return err
+		}
+	}
+
+	token.Value = value
+	tokenBuff, err := applier.marshaller.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	return account.SaveKeyValue(key, tokenBuff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (applier *accountOverridesApplier) IsInterfaceNil() bool {
+	return applier == nil
+}
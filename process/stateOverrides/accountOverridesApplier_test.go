@@ -0,0 +1,149 @@
+package stateOverrides
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountOverridesApplier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		applier, err := NewAccountOverridesApplier(nil, &hashingMocks.HasherMock{})
+		require.True(t, check.IfNil(applier))
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		applier, err := NewAccountOverridesApplier(&marshallerMock.MarshalizerMock{}, nil)
+		require.True(t, check.IfNil(applier))
+		require.Equal(t, process.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		applier, err := NewAccountOverridesApplier(&marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		require.False(t, check.IfNil(applier))
+		require.NoError(t, err)
+	})
+}
+
+func TestAccountOverridesApplier_ApplyOverride(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account should error", func(t *testing.T) {
+		t.Parallel()
+
+		applier, _ := NewAccountOverridesApplier(&marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		codeHash, err := applier.ApplyOverride(nil, &common.AccountStateOverride{})
+		require.Nil(t, codeHash)
+		require.Equal(t, process.ErrNilUserAccount, err)
+	})
+	t.Run("nil override should error", func(t *testing.T) {
+		t.Parallel()
+
+		applier, _ := NewAccountOverridesApplier(&marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		codeHash, err := applier.ApplyOverride(&stateMock.UserAccountStub{}, nil)
+		require.Nil(t, codeHash)
+		require.Equal(t, ErrNilAccountStateOverride, err)
+	})
+	t.Run("negative balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		applier, _ := NewAccountOverridesApplier(&marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		_, err := applier.ApplyOverride(&stateMock.UserAccountStub{}, &common.AccountStateOverride{Balance: big.NewInt(-1)})
+		require.Equal(t, ErrNegativeValueOverride, err)
+	})
+	t.Run("missing ESDT value should error", func(t *testing.T) {
+		t.Parallel()
+
+		applier, _ := NewAccountOverridesApplier(&marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		_, err := applier.ApplyOverride(&stateMock.UserAccountStub{}, &common.AccountStateOverride{
+			ESDTBalances: map[string]*big.Int{"TKN-abcdef": nil},
+		})
+		require.Equal(t, ErrNegativeValueOverride, err)
+	})
+	t.Run("save key value fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		account := &stateMock.UserAccountStub{
+			SaveKeyValueCalled: func(_ []byte, _ []byte) error {
+				return expectedErr
+			},
+		}
+
+		applier, _ := NewAccountOverridesApplier(&marshallerMock.MarshalizerMock{}, &hashingMocks.HasherMock{})
+		_, err := applier.ApplyOverride(account, &common.AccountStateOverride{
+			Storage: map[string][]byte{"key": []byte("value")},
+		})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should apply all the overrides", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller := &marshallerMock.MarshalizerMock{}
+		hasher := &hashingMocks.HasherMock{}
+		tokenID := "TKN-abcdef"
+		tokenKey := esdtKeyPrefix + tokenID
+		existingToken := &esdt.ESDigitalToken{
+			Value:      big.NewInt(5),
+			Properties: []byte("properties"),
+		}
+		existingTokenBuff, _ := marshaller.Marshal(existingToken)
+
+		addedToBalance := big.NewInt(0)
+		savedKeys := make(map[string][]byte)
+		account := &stateMock.UserAccountStub{
+			Balance: big.NewInt(30),
+			AddToBalanceCalled: func(value *big.Int) error {
+				addedToBalance.Add(addedToBalance, value)
+				return nil
+			},
+			RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+				if string(key) == tokenKey {
+					return existingTokenBuff, 0, nil
+				}
+				return nil, 0, errors.New("missing key")
+			},
+			SaveKeyValueCalled: func(key []byte, value []byte) error {
+				savedKeys[string(key)] = value
+				return nil
+			},
+		}
+
+		override := &common.AccountStateOverride{
+			Balance:      big.NewInt(100),
+			ESDTBalances: map[string]*big.Int{tokenID: big.NewInt(7)},
+			Storage:      map[string][]byte{"key": []byte("value")},
+			Code:         []byte("code"),
+		}
+
+		applier, _ := NewAccountOverridesApplier(marshaller, hasher)
+		codeHash, err := applier.ApplyOverride(account, override)
+		require.NoError(t, err)
+		require.Equal(t, hasher.Compute("code"), codeHash)
+		require.Equal(t, big.NewInt(70), addedToBalance)
+		require.Equal(t, big.NewInt(100), override.Balance)
+		require.Equal(t, []byte("value"), savedKeys["key"])
+
+		savedToken := &esdt.ESDigitalToken{}
+		err = marshaller.Unmarshal(savedToken, savedKeys[tokenKey])
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(7), savedToken.Value)
+		require.Equal(t, existingToken.Properties, savedToken.Properties)
+	})
+}
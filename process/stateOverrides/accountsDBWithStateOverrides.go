@@ -0,0 +1,140 @@
+package stateOverrides
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// accountsDBWithStateOverrides is a wrapper over an API accounts adapter which can temporarily serve overridden
+// accounts. The overridden accounts are never saved in the underlying accounts adapter
+type accountsDBWithStateOverrides struct {
+	state.AccountsAdapterAPI
+	overridesApplier   AccountOverridesApplier
+	mutOverrides       sync.RWMutex
+	overriddenAccounts map[string]vmcommon.AccountHandler
+	overriddenCodes    map[string][]byte
+}
+
+// NewAccountsDBWithStateOverrides creates a new accounts adapter able to hold temporary state overrides
+func NewAccountsDBWithStateOverrides(
+	accountsDB state.AccountsAdapterAPI,
+	overridesApplier AccountOverridesApplier,
+) (*accountsDBWithStateOverrides, error) {
+	if check.IfNil(accountsDB) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(overridesApplier) {
+		return nil, ErrNilOverridesApplier
+	}
+
+	return &accountsDBWithStateOverrides{
+		AccountsAdapterAPI: accountsDB,
+		overridesApplier:   overridesApplier,
+		overriddenAccounts: make(map[string]vmcommon.AccountHandler),
+		overriddenCodes:    make(map[string][]byte),
+	}, nil
+}
+
+// SetStateOverrides loads the overridden accounts from the underlying accounts adapter and applies the overrides
+// on them. It should be called after the trie was recreated for the block the execution will run against
+func (adb *accountsDBWithStateOverrides) SetStateOverrides(overrides common.StateOverrides) error {
+	adb.mutOverrides.Lock()
+	defer adb.mutOverrides.Unlock()
+
+	adb.resetOverrides()
+	for address, override := range overrides {
+		err := adb.overrideAccount([]byte(address), override)
+		if err != nil {
+			adb.resetOverrides()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (adb *accountsDBWithStateOverrides) overrideAccount(address []byte, override *common.AccountStateOverride) error {
+	account, err := adb.AccountsAdapterAPI.LoadAccount(address)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	codeHash, err := adb.overridesApplier.ApplyOverride(userAccount, override)
+	if err != nil {
+		return err
+	}
+
+	adb.overriddenAccounts[string(address)] = userAccount
+	if len(codeHash) > 0 {
+		adb.overriddenCodes[string(codeHash)] = override.Code
+	}
+
+	return nil
+}
+
+// CleanStateOverrides removes all the overridden accounts
+func (adb *accountsDBWithStateOverrides) CleanStateOverrides() {
+	adb.mutOverrides.Lock()
+	adb.resetOverrides()
+	adb.mutOverrides.Unlock()
+}
+
+func (adb *accountsDBWithStateOverrides) resetOverrides() {
+	adb.overriddenAccounts = make(map[string]vmcommon.AccountHandler)
+	adb.overriddenCodes = make(map[string][]byte)
+}
+
+// GetExistingAccount returns the overridden account, if any, otherwise calls the underlying accounts adapter
+func (adb *accountsDBWithStateOverrides) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, ok := adb.getOverriddenAccount(address)
+	if ok {
+		return account, nil
+	}
+
+	return adb.AccountsAdapterAPI.GetExistingAccount(address)
+}
+
+// LoadAccount returns the overridden account, if any, otherwise calls the underlying accounts adapter
+func (adb *accountsDBWithStateOverrides) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, ok := adb.getOverriddenAccount(address)
+	if ok {
+		return account, nil
+	}
+
+	return adb.AccountsAdapterAPI.LoadAccount(address)
+}
+
+// GetCode returns the overridden code, if any, otherwise calls the underlying accounts adapter
+func (adb *accountsDBWithStateOverrides) GetCode(codeHash []byte) []byte {
+	adb.mutOverrides.RLock()
+	code, ok := adb.overriddenCodes[string(codeHash)]
+	adb.mutOverrides.RUnlock()
+	if ok {
+		return code
+	}
+
+	return adb.AccountsAdapterAPI.GetCode(codeHash)
+}
+
+func (adb *accountsDBWithStateOverrides) getOverriddenAccount(address []byte) (vmcommon.AccountHandler, bool) {
+	adb.mutOverrides.RLock()
+	defer adb.mutOverrides.RUnlock()
+
+	account, ok := adb.overriddenAccounts[string(address)]
+	return account, ok
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adb *accountsDBWithStateOverrides) IsInterfaceNil() bool {
+	return adb == nil
+}
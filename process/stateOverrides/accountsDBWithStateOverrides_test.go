@@ -0,0 +1,124 @@
+package stateOverrides
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsDBWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		adb, err := NewAccountsDBWithStateOverrides(nil, &stateMock.AccountOverridesApplierStub{})
+		require.True(t, check.IfNil(adb))
+		require.Equal(t, process.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil overrides applier should error", func(t *testing.T) {
+		t.Parallel()
+
+		adb, err := NewAccountsDBWithStateOverrides(&stateMock.AccountsStub{}, nil)
+		require.True(t, check.IfNil(adb))
+		require.Equal(t, ErrNilOverridesApplier, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		adb, err := NewAccountsDBWithStateOverrides(&stateMock.AccountsStub{}, &stateMock.AccountOverridesApplierStub{})
+		require.False(t, check.IfNil(adb))
+		require.NoError(t, err)
+	})
+}
+
+func TestAccountsDBWithStateOverrides_SetStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	overriddenAddress := []byte("overridden")
+	otherAddress := []byte("other")
+	overriddenCodeHash := []byte("overridden code hash")
+	overriddenCode := []byte("overridden code")
+	originalCode := []byte("original code")
+
+	createAccountsStub := func() *stateMock.AccountsStub {
+		return &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Address: address}, nil
+			},
+			GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Address: address}, nil
+			},
+			GetCodeCalled: func(_ []byte) []byte {
+				return originalCode
+			},
+		}
+	}
+
+	t.Run("apply override fails should error and not keep partial overrides", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		applier := &stateMock.AccountOverridesApplierStub{
+			ApplyOverrideCalled: func(account state.UserAccountHandler, _ *common.AccountStateOverride) ([]byte, error) {
+				if string(account.AddressBytes()) == string(otherAddress) {
+					return nil, expectedErr
+				}
+				return nil, nil
+			},
+		}
+		adb, _ := NewAccountsDBWithStateOverrides(createAccountsStub(), applier)
+
+		err := adb.SetStateOverrides(common.StateOverrides{
+			string(overriddenAddress): &common.AccountStateOverride{},
+			string(otherAddress):      &common.AccountStateOverride{},
+		})
+		require.Equal(t, expectedErr, err)
+		require.Empty(t, adb.overriddenAccounts)
+	})
+	t.Run("should serve the overridden accounts and code until cleaned", func(t *testing.T) {
+		t.Parallel()
+
+		var overriddenAccount state.UserAccountHandler
+		applier := &stateMock.AccountOverridesApplierStub{
+			ApplyOverrideCalled: func(account state.UserAccountHandler, _ *common.AccountStateOverride) ([]byte, error) {
+				overriddenAccount = account
+				return overriddenCodeHash, nil
+			},
+		}
+		adb, _ := NewAccountsDBWithStateOverrides(createAccountsStub(), applier)
+
+		err := adb.SetStateOverrides(common.StateOverrides{
+			string(overriddenAddress): &common.AccountStateOverride{Code: overriddenCode},
+		})
+		require.NoError(t, err)
+
+		account, err := adb.GetExistingAccount(overriddenAddress)
+		require.NoError(t, err)
+		require.True(t, account == overriddenAccount)
+
+		account, err = adb.LoadAccount(overriddenAddress)
+		require.NoError(t, err)
+		require.True(t, account == overriddenAccount)
+
+		account, err = adb.GetExistingAccount(otherAddress)
+		require.NoError(t, err)
+		require.Equal(t, otherAddress, account.AddressBytes())
+
+		require.Equal(t, overriddenCode, adb.GetCode(overriddenCodeHash))
+
+		adb.CleanStateOverrides()
+
+		account, err = adb.GetExistingAccount(overriddenAddress)
+		require.NoError(t, err)
+		require.False(t, account == overriddenAccount)
+		require.Equal(t, originalCode, adb.GetCode(overriddenCodeHash))
+	})
+}
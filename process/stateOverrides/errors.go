@@ -0,0 +1,12 @@
+package stateOverrides
+
+import "errors"
+
+// ErrNilAccountStateOverride signals that a nil account state override has been provided
+var ErrNilAccountStateOverride = errors.New("nil account state override")
+
+// ErrNegativeValueOverride signals that a negative balance override has been provided
+var ErrNegativeValueOverride = errors.New("negative or missing value provided as balance override")
+
+// ErrNilOverridesApplier signals that a nil account overrides applier has been provided
+var ErrNilOverridesApplier = errors.New("nil account overrides applier")
@@ -0,0 +1,12 @@
+package stateOverrides
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// AccountOverridesApplier defines a component able to apply state overrides on a user account
+type AccountOverridesApplier interface {
+	ApplyOverride(account state.UserAccountHandler, override *common.AccountStateOverride) ([]byte, error)
+	IsInterfaceNil() bool
+}
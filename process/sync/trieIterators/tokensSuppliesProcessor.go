@@ -19,9 +19,10 @@ import (
 )
 
 type tokensSuppliesProcessor struct {
-	storageService dataRetriever.StorageService
-	marshaller     marshal.Marshalizer
-	tokensSupplies map[string]*big.Int
+	storageService       dataRetriever.StorageService
+	marshaller           marshal.Marshalizer
+	tokensSupplies       map[string]*big.Int
+	tokensMintAllowances map[string]*common.MintAllowance
 }
 
 // ArgsTokensSuppliesProcessor is the arguments struct for NewTokensSuppliesProcessor
@@ -40,9 +41,10 @@ func NewTokensSuppliesProcessor(args ArgsTokensSuppliesProcessor) (*tokensSuppli
 	}
 
 	return &tokensSuppliesProcessor{
-		storageService: args.StorageService,
-		marshaller:     args.Marshaller,
-		tokensSupplies: make(map[string]*big.Int),
+		storageService:       args.StorageService,
+		marshaller:           args.Marshaller,
+		tokensSupplies:       make(map[string]*big.Int),
+		tokensMintAllowances: make(map[string]*common.MintAllowance),
 	}, nil
 }
 
@@ -75,7 +77,15 @@ func (t *tokensSuppliesProcessor) HandleTrieAccountIteration(userAccount state.U
 
 	log.Trace("extractTokensSupplies - parsing account", "address", userAccount.AddressBytes())
 	esdtPrefix := []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	mintAllowancePrefix := []byte(core.ProtectedKeyPrefix + common.MintAllowanceKeyIdentifier)
 	for userLeaf := range dataTrieChan.LeavesChan {
+		if bytes.HasPrefix(userLeaf.Key(), mintAllowancePrefix) {
+			err := t.addToMintAllowance(userLeaf.Key()[len(mintAllowancePrefix):], userLeaf.Value())
+			if err != nil {
+				return fmt.Errorf("%w while decoding the mint allowance with key %s", err, hex.EncodeToString(userLeaf.Key()))
+			}
+			continue
+		}
 		if !bytes.HasPrefix(userLeaf.Key(), esdtPrefix) {
 			continue
 		}
@@ -114,6 +124,23 @@ func (t *tokensSuppliesProcessor) addToBalance(tokenID []byte, nonce uint64, val
 	t.putInSuppliesMap(tokenIDStr, value)
 }
 
+func (t *tokensSuppliesProcessor) addToMintAllowance(tokenID []byte, value []byte) error {
+	allowance, err := common.DecodeMintAllowance(value)
+	if err != nil {
+		return err
+	}
+
+	currentAllowance, found := t.tokensMintAllowances[string(tokenID)]
+	if !found {
+		t.tokensMintAllowances[string(tokenID)] = allowance
+		return nil
+	}
+
+	currentAllowance.Value = big.NewInt(0).Add(currentAllowance.Value, allowance.Value)
+
+	return nil
+}
+
 func (t *tokensSuppliesProcessor) putInSuppliesMap(id string, value *big.Int) {
 	currentValue, found := t.tokensSupplies[id]
 	if !found {
@@ -133,12 +160,26 @@ func (t *tokensSuppliesProcessor) SaveSupplies() error {
 		return err
 	}
 
+	// tokens with a supply policy might have mint allowances without any balance on this shard
+	for tokenName := range t.tokensMintAllowances {
+		_, found := t.tokensSupplies[tokenName]
+		if !found {
+			t.tokensSupplies[tokenName] = big.NewInt(0)
+		}
+	}
+
 	for tokenName, supply := range t.tokensSupplies {
 		log.Trace("repopulate tokens supplies", "token", tokenName, "supply", supply.String())
 		supplyObj := &esdtSupply.SupplyESDT{
 			Supply:           supply,
 			RecomputedSupply: true,
 		}
+		allowance, found := t.tokensMintAllowances[tokenName]
+		if found {
+			supplyObj.MaxSupply = allowance.MaxSupply
+			supplyObj.MintAllowance = allowance.Value
+		}
+
 		supplyObjBytes, err := t.marshaller.Marshal(supplyObj)
 		if err != nil {
 			return err
@@ -225,6 +225,14 @@ func TestTokensSuppliesProcessor_HandleTrieAccountIteration(t *testing.T) {
 				require.Nil(t, err)
 				leavesChannels.LeavesChan <- leaf
 
+				allowanceBytes := common.EncodeMintAllowance(&common.MintAllowance{MaxSupply: big.NewInt(1000), Value: big.NewInt(10)})
+				allowanceKey := []byte("ELRONDmintallowanceTKN-00aacc")
+				value = append(allowanceBytes, allowanceKey...)
+				value = append(value, []byte("addr")...)
+				leaf, err = leafParser.ParseLeaf(allowanceKey, value, 0)
+				require.Nil(t, err)
+				leavesChannels.LeavesChan <- leaf
+
 				close(leavesChannels.LeavesChan)
 				return nil
 			},
@@ -245,6 +253,11 @@ func TestTokensSuppliesProcessor_HandleTrieAccountIteration(t *testing.T) {
 			"TKN-00aacc":    big.NewInt(74),
 		}
 		require.Equal(t, expectedSupplies, tsp.tokensSupplies)
+
+		expectedMintAllowances := map[string]*common.MintAllowance{
+			"TKN-00aacc": {MaxSupply: big.NewInt(1000), Value: big.NewInt(20)},
+		}
+		require.Equal(t, expectedMintAllowances, tsp.tokensMintAllowances)
 	})
 }
 
@@ -304,4 +317,45 @@ func TestTokensSuppliesProcessor_SaveSupplies(t *testing.T) {
 			checkStoredSupply(t, key, value, supplies[key])
 		}
 	})
+
+	t.Run("should save the mint allowances", func(t *testing.T) {
+		t.Parallel()
+
+		savedItems := make(map[string][]byte)
+		args := getTokensSuppliesProcessorArgs()
+		args.StorageService = &storage.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) (chainStorage.Storer, error) {
+				return &storage.StorerStub{
+					PutCalled: func(key, data []byte) error {
+						savedItems[string(key)] = data
+						return nil
+					},
+				}, nil
+			},
+		}
+		tsp, _ := NewTokensSuppliesProcessor(args)
+		tsp.tokensSupplies = map[string]*big.Int{
+			"TKN-00aacc": big.NewInt(74),
+		}
+		tsp.tokensMintAllowances = map[string]*common.MintAllowance{
+			"TKN-00aacc": {MaxSupply: big.NewInt(1000), Value: big.NewInt(20)},
+			"TKN-00bbdd": {MaxSupply: big.NewInt(500), Value: big.NewInt(5)},
+		}
+
+		err := tsp.SaveSupplies()
+		require.NoError(t, err)
+		require.Len(t, savedItems, 2)
+
+		supply := coreEsdt.SupplyESDT{}
+		_ = args.Marshaller.Unmarshal(&supply, savedItems["TKN-00aacc"])
+		require.Equal(t, big.NewInt(74), supply.Supply)
+		require.Equal(t, big.NewInt(1000), supply.MaxSupply)
+		require.Equal(t, big.NewInt(20), supply.MintAllowance)
+
+		supply = coreEsdt.SupplyESDT{}
+		_ = args.Marshaller.Unmarshal(&supply, savedItems["TKN-00bbdd"])
+		require.Equal(t, 0, supply.Supply.Sign())
+		require.Equal(t, big.NewInt(500), supply.MaxSupply)
+		require.Equal(t, big.NewInt(5), supply.MintAllowance)
+	})
 }
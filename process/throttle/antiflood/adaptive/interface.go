@@ -0,0 +1,31 @@
+package adaptive
+
+// NodeLoadProvider defines the behavior of a component able to provide the current load of the node
+type NodeLoadProvider interface {
+	CpuLoadPercent() uint64
+	ProcessingLoadPercent() uint64
+	IsInterfaceNil() bool
+}
+
+// FloodPreventer defines the behavior of a quota flood preventer that can have its limits adjusted
+type FloodPreventer interface {
+	SetQuotaPercent(percent uint32)
+	IsInterfaceNil() bool
+}
+
+// TopicFloodPreventer defines the behavior of a topic flood preventer that can have its limits adjusted
+type TopicFloodPreventer interface {
+	SetQuotaPercent(percent uint32)
+	SetAdjustedMaxMessagesForTopic(topic string, numMessages uint32)
+	MaxMessagesForTopic(topic string) uint32
+	LiveMaxMessagesForTopic(topic string) uint32
+	NumMessagesInLastInterval(topic string) uint64
+	IsInterfaceNil() bool
+}
+
+// QuotaStatusHandler defines the behavior of a component able to output the quotas computed by the adaptive mode
+type QuotaStatusHandler interface {
+	SetLiveTopicQuota(topic string, maxNumMessages uint32)
+	SetAdaptiveStatus(loadPercent uint64, quotaPercent uint32)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,75 @@
+package adaptive
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/multiversx/mx-chain-go/common/statistics/machine"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+const minNumGoRoutines = 1
+
+type nodeLoadProvider struct {
+	cpuStats         *machine.CpuStatistics
+	maxNumGoRoutines uint32
+	numGoRoutines    func() int
+}
+
+// NewNodeLoadProvider creates a new node load provider that periodically measures the CPU load of the current
+// process. The processing load is computed as the number of running go routines relative to maxNumGoRoutines, since
+// every intercepted message is processed on its own go routine
+func NewNodeLoadProvider(ctx context.Context, maxNumGoRoutines uint32) (*nodeLoadProvider, error) {
+	if maxNumGoRoutines < minNumGoRoutines {
+		return nil, fmt.Errorf("%w for maxNumGoRoutines, provided %d, minimum %d",
+			process.ErrInvalidValue,
+			maxNumGoRoutines,
+			minNumGoRoutines,
+		)
+	}
+
+	cpuStats, err := machine.NewCpuStatistics()
+	if err != nil {
+		return nil, err
+	}
+
+	nlp := &nodeLoadProvider{
+		cpuStats:         cpuStats,
+		maxNumGoRoutines: maxNumGoRoutines,
+		numGoRoutines:    runtime.NumGoroutine,
+	}
+
+	go nlp.computeCpuStatistics(ctx)
+
+	return nlp, nil
+}
+
+func (nlp *nodeLoadProvider) computeCpuStatistics(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("nodeLoadProvider's go routine is stopping...")
+			return
+		default:
+		}
+
+		// blocking call for a bounded time (1 second)
+		nlp.cpuStats.ComputeStatistics()
+	}
+}
+
+// CpuLoadPercent returns the last measured CPU load of the current process
+func (nlp *nodeLoadProvider) CpuLoadPercent() uint64 {
+	return nlp.cpuStats.CpuPercentUsage()
+}
+
+// ProcessingLoadPercent returns the number of running go routines relative to the configured maximum
+func (nlp *nodeLoadProvider) ProcessingLoadPercent() uint64 {
+	return uint64(nlp.numGoRoutines()) * maxPercent / uint64(nlp.maxNumGoRoutines)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nlp *nodeLoadProvider) IsInterfaceNil() bool {
+	return nlp == nil
+}
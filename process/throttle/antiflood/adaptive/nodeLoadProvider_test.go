@@ -0,0 +1,51 @@
+package adaptive
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNodeLoadProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max num go routines should error", func(t *testing.T) {
+		t.Parallel()
+
+		nlp, err := NewNodeLoadProvider(context.Background(), 0)
+		assert.True(t, check.IfNil(nlp))
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		nlp, err := NewNodeLoadProvider(ctx, 100)
+		assert.False(t, check.IfNil(nlp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestNodeLoadProvider_ProcessingLoadPercent(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nlp, _ := NewNodeLoadProvider(ctx, 200)
+	nlp.numGoRoutines = func() int {
+		return 50
+	}
+	assert.Equal(t, uint64(25), nlp.ProcessingLoadPercent())
+
+	nlp.numGoRoutines = func() int {
+		return 400
+	}
+	assert.Equal(t, uint64(200), nlp.ProcessingLoadPercent())
+}
@@ -0,0 +1,236 @@
+package adaptive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/throttle/antiflood/adaptive")
+
+const maxPercent = 100
+const minPercent = 1
+const minIntervalInSeconds = 1
+
+// ArgsQuotaController defines the arguments needed to create a new quota controller
+type ArgsQuotaController struct {
+	Config              config.AdaptiveAntifloodConfig
+	TopicMaxMessages    []config.TopicMaxMessagesConfig
+	LoadProvider        NodeLoadProvider
+	FloodPreventers     []FloodPreventer
+	TopicFloodPreventer TopicFloodPreventer
+	StatusHandler       QuotaStatusHandler
+}
+
+type topicOverride struct {
+	config.AdaptiveTopicOverrideConfig
+	quotaPercent uint32
+}
+
+type quotaController struct {
+	cfg                 config.AdaptiveAntifloodConfig
+	loadProvider        NodeLoadProvider
+	floodPreventers     []FloodPreventer
+	topicFloodPreventer TopicFloodPreventer
+	statusHandler       QuotaStatusHandler
+	overrides           []*topicOverride
+	monitoredTopics     []string
+	quotaPercent        uint32
+}
+
+// NewQuotaController creates a component that periodically adjusts the antiflood quotas based on the node load,
+// the quotas being decreased while the load is over the high threshold and increased back while the load is under
+// the low threshold. Topics with a throughput threshold are also adjusted based on their own throughput
+func NewQuotaController(args ArgsQuotaController) (*quotaController, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	qc := &quotaController{
+		cfg:                 args.Config,
+		loadProvider:        args.LoadProvider,
+		floodPreventers:     args.FloodPreventers,
+		topicFloodPreventer: args.TopicFloodPreventer,
+		statusHandler:       args.StatusHandler,
+		overrides:           make([]*topicOverride, 0, len(args.Config.TopicOverrides)),
+		monitoredTopics:     make([]string, 0, len(args.TopicMaxMessages)+len(args.Config.TopicOverrides)),
+		quotaPercent:        maxPercent,
+	}
+
+	monitoredTopics := make(map[string]struct{})
+	addMonitoredTopic := func(topic string) {
+		_, exists := monitoredTopics[topic]
+		if exists {
+			return
+		}
+
+		monitoredTopics[topic] = struct{}{}
+		qc.monitoredTopics = append(qc.monitoredTopics, topic)
+	}
+
+	for _, topicMaxMessages := range args.TopicMaxMessages {
+		addMonitoredTopic(topicMaxMessages.Topic)
+	}
+	for _, override := range args.Config.TopicOverrides {
+		qc.overrides = append(qc.overrides, &topicOverride{
+			AdaptiveTopicOverrideConfig: override,
+			quotaPercent:                maxPercent,
+		})
+		addMonitoredTopic(override.Topic)
+	}
+
+	return qc, nil
+}
+
+func checkArgs(args ArgsQuotaController) error {
+	if check.IfNil(args.LoadProvider) {
+		return process.ErrNilNodeLoadProvider
+	}
+	for _, fp := range args.FloodPreventers {
+		if check.IfNil(fp) {
+			return process.ErrNilFloodPreventer
+		}
+	}
+	if check.IfNil(args.TopicFloodPreventer) {
+		return process.ErrNilTopicFloodPreventer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return process.ErrNilQuotaStatusHandler
+	}
+
+	cfg := args.Config
+	if cfg.IntervalInSeconds < minIntervalInSeconds {
+		return fmt.Errorf("%w for IntervalInSeconds, provided %d, minimum %d",
+			process.ErrInvalidValue, cfg.IntervalInSeconds, minIntervalInSeconds)
+	}
+	if cfg.LowLoadThresholdPercent >= cfg.HighLoadThresholdPercent {
+		return fmt.Errorf("%w, LowLoadThresholdPercent (%d) should be lower than HighLoadThresholdPercent (%d)",
+			process.ErrInvalidValue, cfg.LowLoadThresholdPercent, cfg.HighLoadThresholdPercent)
+	}
+	err := checkPercent("DecreaseStepPercent", cfg.DecreaseStepPercent)
+	if err != nil {
+		return err
+	}
+	err = checkPercent("IncreaseStepPercent", cfg.IncreaseStepPercent)
+	if err != nil {
+		return err
+	}
+	err = checkPercent("MinQuotaPercent", cfg.MinQuotaPercent)
+	if err != nil {
+		return err
+	}
+
+	for _, override := range cfg.TopicOverrides {
+		if len(override.Topic) == 0 {
+			return fmt.Errorf("%w, empty topic in TopicOverrides", process.ErrInvalidValue)
+		}
+		if override.MaxMessagesPerSec > 0 && override.MinMessagesPerSec > override.MaxMessagesPerSec {
+			return fmt.Errorf("%w for topic %s, MinMessagesPerSec (%d) is greater than MaxMessagesPerSec (%d)",
+				process.ErrInvalidValue, override.Topic, override.MinMessagesPerSec, override.MaxMessagesPerSec)
+		}
+	}
+
+	return nil
+}
+
+func checkPercent(name string, value uint32) error {
+	if value < minPercent || value > maxPercent {
+		return fmt.Errorf("%w for %s, provided %d, allowed interval [%d, %d]",
+			process.ErrInvalidValue, name, value, minPercent, maxPercent)
+	}
+
+	return nil
+}
+
+// StartAdjusting starts the go routine that periodically adjusts the quotas. The go routine stops when the
+// provided context is done
+func (qc *quotaController) StartAdjusting(ctx context.Context) {
+	interval := time.Duration(qc.cfg.IntervalInSeconds) * time.Second
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("quotaController's go routine is stopping...")
+				return
+			case <-time.After(interval):
+			}
+
+			qc.adjust()
+		}
+	}()
+}
+
+func (qc *quotaController) adjust() {
+	loadPercent := core.MaxUint64(qc.loadProvider.CpuLoadPercent(), qc.loadProvider.ProcessingLoadPercent())
+	oldQuotaPercent := qc.quotaPercent
+	qc.quotaPercent = qc.computeQuotaPercent(qc.quotaPercent, loadPercent)
+	if oldQuotaPercent != qc.quotaPercent {
+		log.Debug("adaptive antiflood quota changed",
+			"load percent", loadPercent,
+			"old quota percent", oldQuotaPercent,
+			"new quota percent", qc.quotaPercent,
+		)
+	}
+
+	for _, fp := range qc.floodPreventers {
+		fp.SetQuotaPercent(qc.quotaPercent)
+	}
+	qc.topicFloodPreventer.SetQuotaPercent(qc.quotaPercent)
+
+	for _, override := range qc.overrides {
+		qc.adjustTopic(override)
+	}
+
+	qc.statusHandler.SetAdaptiveStatus(loadPercent, qc.quotaPercent)
+	for _, topic := range qc.monitoredTopics {
+		qc.statusHandler.SetLiveTopicQuota(topic, qc.topicFloodPreventer.LiveMaxMessagesForTopic(topic))
+	}
+}
+
+// computeQuotaPercent applies the hysteresis: the quota is decreased only when the load reaches the high threshold
+// and is increased only when the load drops under the low threshold
+func (qc *quotaController) computeQuotaPercent(currentPercent uint32, loadPercent uint64) uint32 {
+	if loadPercent >= uint64(qc.cfg.HighLoadThresholdPercent) {
+		if currentPercent <= qc.cfg.MinQuotaPercent+qc.cfg.DecreaseStepPercent {
+			return qc.cfg.MinQuotaPercent
+		}
+
+		return currentPercent - qc.cfg.DecreaseStepPercent
+	}
+	if loadPercent <= uint64(qc.cfg.LowLoadThresholdPercent) {
+		return core.MinUint32(currentPercent+qc.cfg.IncreaseStepPercent, maxPercent)
+	}
+
+	return currentPercent
+}
+
+func (qc *quotaController) adjustTopic(override *topicOverride) {
+	if override.ThroughputThresholdPerSec > 0 {
+		// scale the throughput so that reaching the threshold equals reaching the high load threshold
+		numMessages := qc.topicFloodPreventer.NumMessagesInLastInterval(override.Topic)
+		throughputLoad := numMessages * uint64(qc.cfg.HighLoadThresholdPercent) / override.ThroughputThresholdPerSec
+		override.quotaPercent = qc.computeQuotaPercent(override.quotaPercent, throughputLoad)
+	}
+
+	percent := core.MinUint32(qc.quotaPercent, override.quotaPercent)
+	maxMessages := uint64(qc.topicFloodPreventer.MaxMessagesForTopic(override.Topic)) * uint64(percent) / maxPercent
+	if override.MaxMessagesPerSec > 0 {
+		maxMessages = core.MinUint64(maxMessages, uint64(override.MaxMessagesPerSec))
+	}
+	maxMessages = core.MaxUint64(maxMessages, uint64(override.MinMessagesPerSec))
+
+	qc.topicFloodPreventer.SetAdjustedMaxMessagesForTopic(override.Topic, uint32(maxMessages))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qc *quotaController) IsInterfaceNil() bool {
+	return qc == nil
+}
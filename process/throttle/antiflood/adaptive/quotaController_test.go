@@ -0,0 +1,310 @@
+package adaptive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
+	"github.com/multiversx/mx-chain-go/statusHandler/p2pQuota"
+	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsQuotaController() ArgsQuotaController {
+	topicFloodPreventer, _ := floodPreventers.NewTopicFloodPreventer(100)
+	quotaProcessor, _ := p2pQuota.NewP2PQuotaProcessor(statusHandlerMock.NewAppStatusHandlerMock(), "adaptive")
+
+	return ArgsQuotaController{
+		Config: config.AdaptiveAntifloodConfig{
+			Enabled:                  true,
+			IntervalInSeconds:        1,
+			HighLoadThresholdPercent: 80,
+			LowLoadThresholdPercent:  50,
+			DecreaseStepPercent:      20,
+			IncreaseStepPercent:      10,
+			MinQuotaPercent:          30,
+			MaxNumGoRoutines:         100,
+		},
+		LoadProvider:        &mock.NodeLoadProviderStub{},
+		FloodPreventers:     []FloodPreventer{&mock.FloodPreventerStub{}},
+		TopicFloodPreventer: topicFloodPreventer,
+		StatusHandler:       quotaProcessor,
+	}
+}
+
+func TestNewQuotaController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil load provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQuotaController()
+		args.LoadProvider = nil
+		qc, err := NewQuotaController(args)
+		assert.True(t, check.IfNil(qc))
+		assert.Equal(t, process.ErrNilNodeLoadProvider, err)
+	})
+	t.Run("nil flood preventer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQuotaController()
+		args.FloodPreventers = append(args.FloodPreventers, nil)
+		qc, err := NewQuotaController(args)
+		assert.True(t, check.IfNil(qc))
+		assert.Equal(t, process.ErrNilFloodPreventer, err)
+	})
+	t.Run("nil topic flood preventer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQuotaController()
+		args.TopicFloodPreventer = nil
+		qc, err := NewQuotaController(args)
+		assert.True(t, check.IfNil(qc))
+		assert.Equal(t, process.ErrNilTopicFloodPreventer, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQuotaController()
+		args.StatusHandler = nil
+		qc, err := NewQuotaController(args)
+		assert.True(t, check.IfNil(qc))
+		assert.Equal(t, process.ErrNilQuotaStatusHandler, err)
+	})
+	t.Run("invalid config values should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig(t, func(cfg *config.AdaptiveAntifloodConfig) { cfg.IntervalInSeconds = 0 })
+		testInvalidConfig(t, func(cfg *config.AdaptiveAntifloodConfig) { cfg.LowLoadThresholdPercent = 80 })
+		testInvalidConfig(t, func(cfg *config.AdaptiveAntifloodConfig) { cfg.DecreaseStepPercent = 0 })
+		testInvalidConfig(t, func(cfg *config.AdaptiveAntifloodConfig) { cfg.IncreaseStepPercent = 101 })
+		testInvalidConfig(t, func(cfg *config.AdaptiveAntifloodConfig) { cfg.MinQuotaPercent = 0 })
+		testInvalidConfig(t, func(cfg *config.AdaptiveAntifloodConfig) {
+			cfg.TopicOverrides = []config.AdaptiveTopicOverrideConfig{{Topic: ""}}
+		})
+		testInvalidConfig(t, func(cfg *config.AdaptiveAntifloodConfig) {
+			cfg.TopicOverrides = []config.AdaptiveTopicOverrideConfig{{Topic: "topic", MinMessagesPerSec: 10, MaxMessagesPerSec: 5}}
+		})
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		qc, err := NewQuotaController(createMockArgsQuotaController())
+		assert.False(t, check.IfNil(qc))
+		assert.Nil(t, err)
+	})
+}
+
+func testInvalidConfig(t *testing.T, changeConfig func(cfg *config.AdaptiveAntifloodConfig)) {
+	args := createMockArgsQuotaController()
+	changeConfig(&args.Config)
+
+	qc, err := NewQuotaController(args)
+	assert.True(t, check.IfNil(qc))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestQuotaController_AdjustShouldApplyHysteresis(t *testing.T) {
+	t.Parallel()
+
+	loadPercent := uint64(0)
+	lastQuotaPercent := uint32(0)
+	args := createMockArgsQuotaController()
+	args.LoadProvider = &mock.NodeLoadProviderStub{
+		CpuLoadPercentCalled: func() uint64 {
+			return loadPercent
+		},
+		ProcessingLoadPercentCalled: func() uint64 {
+			return loadPercent / 2
+		},
+	}
+	args.FloodPreventers = []FloodPreventer{
+		&mock.FloodPreventerStub{
+			SetQuotaPercentCalled: func(percent uint32) {
+				lastQuotaPercent = percent
+			},
+		},
+	}
+	qc, _ := NewQuotaController(args)
+
+	loadPercent = 90
+	qc.adjust()
+	assert.Equal(t, uint32(80), lastQuotaPercent)
+	qc.adjust()
+	assert.Equal(t, uint32(60), lastQuotaPercent)
+	qc.adjust()
+	assert.Equal(t, uint32(40), lastQuotaPercent)
+	qc.adjust()
+	assert.Equal(t, uint32(30), lastQuotaPercent) // capped at MinQuotaPercent
+
+	// between the thresholds the quota should not change
+	loadPercent = 60
+	qc.adjust()
+	assert.Equal(t, uint32(30), lastQuotaPercent)
+
+	loadPercent = 50
+	qc.adjust()
+	assert.Equal(t, uint32(40), lastQuotaPercent)
+
+	loadPercent = 79
+	qc.adjust()
+	assert.Equal(t, uint32(40), lastQuotaPercent)
+
+	loadPercent = 0
+	for i := 0; i < 10; i++ {
+		qc.adjust()
+	}
+	assert.Equal(t, uint32(100), lastQuotaPercent)
+}
+
+func TestQuotaController_AdjustShouldUseTheProcessingLoad(t *testing.T) {
+	t.Parallel()
+
+	lastQuotaPercent := uint32(0)
+	args := createMockArgsQuotaController()
+	args.LoadProvider = &mock.NodeLoadProviderStub{
+		ProcessingLoadPercentCalled: func() uint64 {
+			return 200
+		},
+	}
+	args.FloodPreventers = []FloodPreventer{
+		&mock.FloodPreventerStub{
+			SetQuotaPercentCalled: func(percent uint32) {
+				lastQuotaPercent = percent
+			},
+		},
+	}
+	qc, _ := NewQuotaController(args)
+
+	qc.adjust()
+	assert.Equal(t, uint32(80), lastQuotaPercent)
+}
+
+func TestQuotaController_AdjustShouldApplyTopicOverrides(t *testing.T) {
+	t.Parallel()
+
+	loadPercent := uint64(0)
+	topicFloodPreventer, _ := floodPreventers.NewTopicFloodPreventer(100)
+	topicFloodPreventer.SetMaxMessagesForTopic("shardBlocks*", 30)
+	topicFloodPreventer.SetMaxMessagesForTopic("transactions", 100)
+
+	args := createMockArgsQuotaController()
+	args.TopicFloodPreventer = topicFloodPreventer
+	args.LoadProvider = &mock.NodeLoadProviderStub{
+		CpuLoadPercentCalled: func() uint64 {
+			return loadPercent
+		},
+	}
+	args.Config.TopicOverrides = []config.AdaptiveTopicOverrideConfig{
+		{
+			Topic:             "shardBlocks*",
+			MinMessagesPerSec: 20,
+			MaxMessagesPerSec: 25,
+		},
+		{
+			Topic:                     "transactions",
+			ThroughputThresholdPerSec: 1000,
+		},
+	}
+	qc, _ := NewQuotaController(args)
+
+	qc.adjust()
+	assert.Equal(t, uint32(25), topicFloodPreventer.LiveMaxMessagesForTopic("shardBlocks_0_META"))
+	assert.Equal(t, uint32(100), topicFloodPreventer.LiveMaxMessagesForTopic("transactions"))
+	assert.Equal(t, uint32(100), topicFloodPreventer.LiveMaxMessagesForTopic("other"))
+
+	loadPercent = 100
+	qc.adjust()
+	qc.adjust()
+	// 60% of 30 is 18, bounded by the minimum value
+	assert.Equal(t, uint32(20), topicFloodPreventer.LiveMaxMessagesForTopic("shardBlocks_0_META"))
+	assert.Equal(t, uint32(60), topicFloodPreventer.LiveMaxMessagesForTopic("transactions"))
+	assert.Equal(t, uint32(60), topicFloodPreventer.LiveMaxMessagesForTopic("other"))
+
+	// the node is no longer under load, but the transactions topic reaches its throughput threshold
+	loadPercent = 0
+	_ = topicFloodPreventer.IncreaseLoad("pid", "transactions", 60)
+	for i := 0; i < 20; i++ {
+		_ = topicFloodPreventer.IncreaseLoad(core.PeerID(fmt.Sprintf("pid%d", i)), "transactions", 50)
+	}
+	topicFloodPreventer.ResetForTopic("transactions")
+	qc.adjust()
+	assert.Equal(t, uint32(70), topicFloodPreventer.LiveMaxMessagesForTopic("other"))
+	// min(70% global, 80% topic)
+	assert.Equal(t, uint32(70), topicFloodPreventer.LiveMaxMessagesForTopic("transactions"))
+
+	qc.adjust()
+	assert.Equal(t, uint32(80), topicFloodPreventer.LiveMaxMessagesForTopic("other"))
+	assert.Equal(t, uint32(60), topicFloodPreventer.LiveMaxMessagesForTopic("transactions"))
+
+	// no more messages on the topic, the throughput load goes under the low threshold
+	topicFloodPreventer.ResetForTopic("transactions")
+	qc.adjust()
+	assert.Equal(t, uint32(90), topicFloodPreventer.LiveMaxMessagesForTopic("other"))
+	assert.Equal(t, uint32(70), topicFloodPreventer.LiveMaxMessagesForTopic("transactions"))
+}
+
+func TestQuotaController_AdjustShouldOutputTheLiveQuotas(t *testing.T) {
+	t.Parallel()
+
+	topicFloodPreventer, _ := floodPreventers.NewTopicFloodPreventer(100)
+	topicFloodPreventer.SetMaxMessagesForTopic("shardBlocks*", 30)
+	status := statusHandlerMock.NewAppStatusHandlerMock()
+	quotaProcessor, _ := p2pQuota.NewP2PQuotaProcessor(status, "adaptive")
+
+	args := createMockArgsQuotaController()
+	args.TopicFloodPreventer = topicFloodPreventer
+	args.StatusHandler = quotaProcessor
+	args.TopicMaxMessages = []config.TopicMaxMessagesConfig{
+		{
+			Topic:             "shardBlocks*",
+			NumMessagesPerSec: 30,
+		},
+	}
+	args.LoadProvider = &mock.NodeLoadProviderStub{
+		CpuLoadPercentCalled: func() uint64 {
+			return 95
+		},
+	}
+	qc, _ := NewQuotaController(args)
+
+	qc.adjust()
+
+	assert.Equal(t, uint64(95), status.GetUint64(common.MetricP2PNodeLoadPercent+"_adaptive"))
+	assert.Equal(t, uint64(80), status.GetUint64(common.MetricP2PQuotaPercent+"_adaptive"))
+	assert.Equal(t, uint64(24), status.GetUint64(common.MetricP2PTopicMaxNumMessages+"_shardBlocks*_adaptive"))
+}
+
+func TestQuotaController_StartAdjusting(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	args := createMockArgsQuotaController()
+	args.FloodPreventers = []FloodPreventer{
+		&mock.FloodPreventerStub{
+			SetQuotaPercentCalled: func(percent uint32) {
+				atomic.AddUint32(&numCalls, 1)
+			},
+		},
+	}
+	qc, err := NewQuotaController(args)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	qc.StartAdjusting(ctx)
+	time.Sleep(time.Millisecond * 1500)
+	cancel()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
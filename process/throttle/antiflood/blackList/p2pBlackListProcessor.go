@@ -6,6 +6,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -24,6 +25,7 @@ type p2pBlackListProcessor struct {
 	thresholdSizeReceivedFlood uint64
 	cacher                     storage.Cacher
 	peerBlacklistCacher        process.PeerBlackListCacher
+	peerReputationHandler      process.PeerReputationHandler
 	banDuration                time.Duration
 	selfPid                    core.PeerID
 	name                       string
@@ -35,6 +37,7 @@ type p2pBlackListProcessor struct {
 func NewP2PBlackListProcessor(
 	cacher storage.Cacher,
 	peerBlacklistCacher process.PeerBlackListCacher,
+	peerReputationHandler process.PeerReputationHandler,
 	thresholdNumReceivedFlood uint32,
 	thresholdSizeReceivedFlood uint64,
 	numFloodingRounds uint32,
@@ -49,6 +52,9 @@ func NewP2PBlackListProcessor(
 	if check.IfNil(peerBlacklistCacher) {
 		return nil, fmt.Errorf("%w, NewP2PBlackListProcessor", process.ErrNilBlackListCacher)
 	}
+	if check.IfNil(peerReputationHandler) {
+		return nil, fmt.Errorf("%w, NewP2PBlackListProcessor", process.ErrNilPeerReputationHandler)
+	}
 	if thresholdNumReceivedFlood == 0 {
 		return nil, fmt.Errorf("%w, thresholdNumReceivedFlood == 0", process.ErrInvalidValue)
 	}
@@ -65,6 +71,7 @@ func NewP2PBlackListProcessor(
 	return &p2pBlackListProcessor{
 		cacher:                     cacher,
 		peerBlacklistCacher:        peerBlacklistCacher,
+		peerReputationHandler:      peerReputationHandler,
 		thresholdNumReceivedFlood:  thresholdNumReceivedFlood,
 		thresholdSizeReceivedFlood: thresholdSizeReceivedFlood,
 		numFloodingRounds:          numFloodingRounds,
@@ -87,22 +94,42 @@ func (pbp *p2pBlackListProcessor) ResetStatistics() {
 
 		if val >= pbp.numFloodingRounds-1 { //-1 because the reset function is called before the AddQuota
 			pbp.cacher.Remove(key)
-			pid := core.PeerID(key)
-			log.Debug("added new peer to black list",
-				"peer ID", pid.Pretty(),
-				"ban period", pbp.banDuration,
-			)
-			err := pbp.peerBlacklistCacher.Upsert(pid, pbp.banDuration)
-			if err != nil {
-				log.Warn("error adding peer id in peer ids cache", ""+
-					"pid", p2p.PeerIdToShortString(pid),
-					"error", err,
-				)
-			}
+			pbp.blacklistFloodingPeer(core.PeerID(key))
 		}
 	}
 }
 
+func (pbp *p2pBlackListProcessor) blacklistFloodingPeer(pid core.PeerID) {
+	if pbp.peerReputationHandler.IsPeerIDAllowed(pid) {
+		log.Debug("flooding peer is in the allow list, will not add it to black list",
+			"peer ID", pid.Pretty(),
+			"name", pbp.name,
+		)
+		return
+	}
+
+	log.Debug("added new peer to black list",
+		"peer ID", pid.Pretty(),
+		"ban period", pbp.banDuration,
+	)
+	err := pbp.peerBlacklistCacher.Upsert(pid, pbp.banDuration)
+	if err != nil {
+		log.Warn("error adding peer id in peer ids cache", ""+
+			"pid", p2p.PeerIdToShortString(pid),
+			"error", err,
+		)
+		return
+	}
+
+	pbp.peerReputationHandler.RecordPeerIDEvent(
+		pid,
+		common.PeerReputationSourceAntiflood,
+		fmt.Sprintf("flooding detected by the %s flood preventer", pbp.name),
+		common.PeerReputationBanPenalty,
+		pbp.banDuration,
+	)
+}
+
 func (pbp *p2pBlackListProcessor) getFloodingValue(key []byte) (uint32, bool) {
 	obj, ok := pbp.cacher.Peek(key)
 	if !ok {
@@ -149,6 +176,10 @@ func (pbp *p2pBlackListProcessor) incrementStatsFloodingPeer(pid core.PeerID) {
 	pbp.cacher.Put(pid.Bytes(), val+1, sizeBlacklistInfo)
 }
 
+// SetLiveQuota does nothing as the blacklist thresholds are not affected by the applied quota
+func (pbp *p2pBlackListProcessor) SetLiveQuota(_ uint32, _ uint64) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pbp *p2pBlackListProcessor) IsInterfaceNil() bool {
 	return pbp == nil
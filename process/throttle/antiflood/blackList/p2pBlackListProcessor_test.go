@@ -24,6 +24,7 @@ func TestNewP2PQuotaBlacklistProcessor_NilCacherShouldErr(t *testing.T) {
 	pbp, err := blackList.NewP2PBlackListProcessor(
 		nil,
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		1,
 		1,
 		2,
@@ -42,6 +43,7 @@ func TestNewP2PQuotaBlacklistProcessor_NilBlackListHandlerShouldErr(t *testing.T
 	pbp, err := blackList.NewP2PBlackListProcessor(
 		testscommon.NewCacherStub(),
 		nil,
+		&testscommon.PeerReputationHandlerStub{},
 		1,
 		1,
 		2,
@@ -54,12 +56,32 @@ func TestNewP2PQuotaBlacklistProcessor_NilBlackListHandlerShouldErr(t *testing.T
 	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
 }
 
+func TestNewP2PQuotaBlacklistProcessor_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pbp, err := blackList.NewP2PBlackListProcessor(
+		testscommon.NewCacherStub(),
+		&mock.PeerBlackListHandlerStub{},
+		nil,
+		1,
+		1,
+		2,
+		time.Second,
+		"",
+		selfPid,
+	)
+
+	assert.True(t, check.IfNil(pbp))
+	assert.True(t, errors.Is(err, process.ErrNilPeerReputationHandler))
+}
+
 func TestNewP2PQuotaBlacklistProcessor_InvalidThresholdNumReceivedFloodShouldErr(t *testing.T) {
 	t.Parallel()
 
 	pbp, err := blackList.NewP2PBlackListProcessor(
 		testscommon.NewCacherStub(),
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		0,
 		1,
 		2,
@@ -78,6 +100,7 @@ func TestNewP2PQuotaBlacklistProcessor_InvalidThresholdSizeReceivedFloodShouldEr
 	pbp, err := blackList.NewP2PBlackListProcessor(
 		testscommon.NewCacherStub(),
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		1,
 		0,
 		2,
@@ -96,6 +119,7 @@ func TestNewP2PQuotaBlacklistProcessor_InvalidNumFloodingRoundsShouldErr(t *test
 	pbp, err := blackList.NewP2PBlackListProcessor(
 		testscommon.NewCacherStub(),
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		1,
 		1,
 		1,
@@ -114,6 +138,7 @@ func TestNewP2PQuotaBlacklistProcessor_InvalidBanDurationShouldErr(t *testing.T)
 	pbp, err := blackList.NewP2PBlackListProcessor(
 		testscommon.NewCacherStub(),
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		1,
 		1,
 		2,
@@ -132,6 +157,7 @@ func TestNewP2PQuotaBlacklistProcessor_ShouldWork(t *testing.T) {
 	pbp, err := blackList.NewP2PBlackListProcessor(
 		testscommon.NewCacherStub(),
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		1,
 		1,
 		2,
@@ -164,6 +190,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaUnderThresholdShouldNotCallGetOrPut(
 			},
 		},
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		2,
@@ -197,6 +224,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaOverThresholdInexistentDataOnGetShou
 			},
 		},
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		2,
@@ -232,6 +260,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaOverThresholdDataNotValidOnGetShould
 			},
 		},
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		2,
@@ -268,6 +297,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaShouldIncrement(t *testing.T) {
 			},
 		},
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		2,
@@ -300,6 +330,7 @@ func TestP2PQuotaBlacklistProcessor_AddQuotaForSelfShouldNotIncrement(t *testing
 			},
 		},
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		2,
@@ -338,6 +369,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsRemoveNilValueKey(t *testing.
 			},
 		},
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		2,
@@ -374,6 +406,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsShouldRemoveInvalidValueKey(t
 			},
 		},
 		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		2,
@@ -418,6 +451,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsUnderNumFloodingRoundsShouldN
 				return nil
 			},
 		},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		numFloodingRounds,
@@ -463,6 +497,7 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsOverNumFloodingRoundsShouldBl
 				return nil
 			},
 		},
+		&testscommon.PeerReputationHandlerStub{},
 		thresholdNum,
 		thresholdSize,
 		numFloodingRounds,
@@ -476,3 +511,82 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsOverNumFloodingRoundsShouldBl
 	assert.True(t, removedCalled)
 	assert.True(t, upsertCalled)
 }
+
+func TestP2PQuotaBlacklistProcessor_ResetStatisticsOverNumFloodingRoundsShouldRecordReputationEvent(t *testing.T) {
+	t.Parallel()
+
+	numFloodingRounds := uint32(30)
+	key := "key"
+	duration := time.Second * 3892
+	var recordedPid core.PeerID
+	recordedDuration := time.Duration(0)
+	pbp, _ := blackList.NewP2PBlackListProcessor(
+		&testscommon.CacherStub{
+			KeysCalled: func() [][]byte {
+				return [][]byte{[]byte(key)}
+			},
+			PeekCalled: func(key []byte) (value interface{}, ok bool) {
+				return numFloodingRounds, true
+			},
+		},
+		&mock.PeerBlackListHandlerStub{},
+		&testscommon.PeerReputationHandlerStub{
+			RecordPeerIDEventCalled: func(pid core.PeerID, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+				recordedPid = pid
+				recordedDuration = banDuration
+			},
+		},
+		10,
+		20,
+		numFloodingRounds,
+		duration,
+		"",
+		selfPid,
+	)
+
+	pbp.ResetStatistics()
+
+	assert.Equal(t, core.PeerID(key), recordedPid)
+	assert.Equal(t, duration, recordedDuration)
+}
+
+func TestP2PQuotaBlacklistProcessor_ResetStatisticsAllowedPeerShouldNotBlackList(t *testing.T) {
+	t.Parallel()
+
+	numFloodingRounds := uint32(30)
+	removedCalled := false
+	pbp, _ := blackList.NewP2PBlackListProcessor(
+		&testscommon.CacherStub{
+			KeysCalled: func() [][]byte {
+				return [][]byte{[]byte("key")}
+			},
+			PeekCalled: func(key []byte) (value interface{}, ok bool) {
+				return numFloodingRounds, true
+			},
+			RemoveCalled: func(key []byte) {
+				removedCalled = true
+			},
+		},
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				assert.Fail(t, "should have not called upsert")
+				return nil
+			},
+		},
+		&testscommon.PeerReputationHandlerStub{
+			IsPeerIDAllowedCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		},
+		10,
+		20,
+		numFloodingRounds,
+		time.Second,
+		"",
+		selfPid,
+	)
+
+	pbp.ResetStatistics()
+
+	assert.True(t, removedCalled)
+}
@@ -13,6 +13,8 @@ type peerDenialEvaluator struct {
 	blackListIDsCache          process.PeerBlackListCacher
 	blackListedPublicKeysCache process.TimeCacher
 	peerShardMapper            process.PeerShardMapper
+	peerReputationHandler      process.PeerReputationHandler
+	peerAddressesProvider      process.PeerAddressesProvider
 }
 
 // NewPeerDenialEvaluator will create a new instance of a peer deny cache evaluator
@@ -20,6 +22,8 @@ func NewPeerDenialEvaluator(
 	blackListIDsCache process.PeerBlackListCacher,
 	blackListedPublicKeysCache process.TimeCacher,
 	psm process.PeerShardMapper,
+	peerReputationHandler process.PeerReputationHandler,
+	peerAddressesProvider process.PeerAddressesProvider,
 ) (*peerDenialEvaluator, error) {
 
 	if check.IfNil(blackListIDsCache) {
@@ -31,18 +35,33 @@ func NewPeerDenialEvaluator(
 	if check.IfNil(psm) {
 		return nil, process.ErrNilPeerShardMapper
 	}
+	if check.IfNil(peerReputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
+	if check.IfNil(peerAddressesProvider) {
+		return nil, fmt.Errorf("%w for peer addresses provider", process.ErrNilMessenger)
+	}
 
 	return &peerDenialEvaluator{
 		blackListIDsCache:          blackListIDsCache,
 		blackListedPublicKeysCache: blackListedPublicKeysCache,
 		peerShardMapper:            psm,
+		peerReputationHandler:      peerReputationHandler,
+		peerAddressesProvider:      peerAddressesProvider,
 	}, nil
 }
 
 // IsDenied returns true if the provided peer id is denied to access the network
-// It also checks if the provided peer id has a backing public key, checking also that the public key is not denied
+// It also checks if the provided peer id has a backing public key, checking also that the public key is not denied.
+// The operator-managed allow list has priority over the deny list and over the black listed peers
 func (pde *peerDenialEvaluator) IsDenied(pid core.PeerID) bool {
-	if pde.blackListIDsCache.Has(pid) {
+	if pde.peerReputationHandler.IsPeerIDAllowed(pid) {
+		return false
+	}
+	if pde.blackListIDsCache.Has(pid) || pde.peerReputationHandler.IsPeerIDDenied(pid) {
+		return true
+	}
+	if pde.isAnyAddressDenied(pid) {
 		return true
 	}
 
@@ -51,8 +70,21 @@ func (pde *peerDenialEvaluator) IsDenied(pid core.PeerID) bool {
 	if len(pkBytes) == 0 {
 		return false //no need to further search in the next cache, this is an unknown peer
 	}
+	if pde.peerReputationHandler.IsPublicKeyAllowed(pkBytes) {
+		return false
+	}
+
+	return pde.blackListedPublicKeysCache.Has(string(pkBytes)) || pde.peerReputationHandler.IsPublicKeyDenied(pkBytes)
+}
+
+func (pde *peerDenialEvaluator) isAnyAddressDenied(pid core.PeerID) bool {
+	for _, address := range pde.peerAddressesProvider.PeerAddresses(pid) {
+		if pde.peerReputationHandler.IsAddressDenied(address) {
+			return true
+		}
+	}
 
-	return pde.blackListedPublicKeysCache.Has(string(pkBytes))
+	return false
 }
 
 // UpsertPeerID will update or insert the provided peer id in the corresponding time cache
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,6 +21,8 @@ func TestNewPeerDenialEvaluator_NilPeerTimeCacherShouldErr(t *testing.T) {
 		nil,
 		&testscommon.TimeCacheStub{},
 		&mock.PeerShardMapperStub{},
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
@@ -33,6 +36,8 @@ func TestNewPeerDenialEvaluator_NilTimeCacherShouldErr(t *testing.T) {
 		&mock.PeerBlackListHandlerStub{},
 		nil,
 		&mock.PeerShardMapperStub{},
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
@@ -46,12 +51,44 @@ func TestNewPeerDenialEvaluator_NilPeerShardMapperShouldErr(t *testing.T) {
 		&mock.PeerBlackListHandlerStub{},
 		&testscommon.TimeCacheStub{},
 		nil,
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	assert.True(t, errors.Is(err, process.ErrNilPeerShardMapper))
 	assert.True(t, check.IfNil(pdc))
 }
 
+func TestNewPeerDenialEvaluator_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pdc, err := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&testscommon.TimeCacheStub{},
+		&mock.PeerShardMapperStub{},
+		nil,
+		&p2pmocks.MessengerStub{},
+	)
+
+	assert.True(t, errors.Is(err, process.ErrNilPeerReputationHandler))
+	assert.True(t, check.IfNil(pdc))
+}
+
+func TestNewPeerDenialEvaluator_NilPeerAddressesProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pdc, err := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&testscommon.TimeCacheStub{},
+		&mock.PeerShardMapperStub{},
+		&testscommon.PeerReputationHandlerStub{},
+		nil,
+	)
+
+	assert.True(t, errors.Is(err, process.ErrNilMessenger))
+	assert.True(t, check.IfNil(pdc))
+}
+
 func TestNewPeerDenialEvaluator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -59,6 +96,8 @@ func TestNewPeerDenialEvaluator_ShouldWork(t *testing.T) {
 		&mock.PeerBlackListHandlerStub{},
 		&testscommon.TimeCacheStub{},
 		&mock.PeerShardMapperStub{},
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -86,6 +125,8 @@ func TestPeerDenialEvaluator_IsDeniedShouldWorkIfFoundInPids(t *testing.T) {
 				return core.P2PPeerInfo{}
 			},
 		},
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	assert.True(t, pdc.IsDenied(""))
@@ -111,6 +152,8 @@ func TestPeerDenialEvaluator_IsDeniedShouldWorkIfNotFoundInPidsNorInPeerShardMap
 				return core.P2PPeerInfo{}
 			},
 		},
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	assert.False(t, pdc.IsDenied(""))
@@ -137,11 +180,105 @@ func TestPeerDenialEvaluator_IsDeniedShouldWorkIfFoundInPk(t *testing.T) {
 				}
 			},
 		},
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	assert.True(t, pdc.IsDenied(""))
 }
 
+func TestPeerDenialEvaluator_IsDeniedShouldNotDenyAllowedPeerID(t *testing.T) {
+	t.Parallel()
+
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{
+			HasCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		},
+		&testscommon.TimeCacheStub{},
+		&mock.PeerShardMapperStub{},
+		&testscommon.PeerReputationHandlerStub{
+			IsPeerIDAllowedCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		},
+		&p2pmocks.MessengerStub{},
+	)
+
+	assert.False(t, pdc.IsDenied("pid"))
+}
+
+func TestPeerDenialEvaluator_IsDeniedShouldWorkIfPeerIDIsDeniedByReputation(t *testing.T) {
+	t.Parallel()
+
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&testscommon.TimeCacheStub{},
+		&mock.PeerShardMapperStub{},
+		&testscommon.PeerReputationHandlerStub{
+			IsPeerIDDeniedCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		},
+		&p2pmocks.MessengerStub{},
+	)
+
+	assert.True(t, pdc.IsDenied("pid"))
+}
+
+func TestPeerDenialEvaluator_IsDeniedShouldWorkIfAddressIsDenied(t *testing.T) {
+	t.Parallel()
+
+	deniedAddress := "/ip4/10.0.0.1/tcp/37373"
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&testscommon.TimeCacheStub{},
+		&mock.PeerShardMapperStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				assert.Fail(t, "should have not reached this point")
+				return core.P2PPeerInfo{}
+			},
+		},
+		&testscommon.PeerReputationHandlerStub{
+			IsAddressDeniedCalled: func(address string) bool {
+				return address == deniedAddress
+			},
+		},
+		&p2pmocks.MessengerStub{
+			PeerAddressesCalled: func(pid core.PeerID) []string {
+				return []string{"/ip4/127.0.0.1/tcp/37373", deniedAddress}
+			},
+		},
+	)
+
+	assert.True(t, pdc.IsDenied("pid"))
+}
+
+func TestPeerDenialEvaluator_IsDeniedShouldWorkIfPublicKeyIsDeniedByReputation(t *testing.T) {
+	t.Parallel()
+
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&testscommon.TimeCacheStub{},
+		&mock.PeerShardMapperStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				return core.P2PPeerInfo{
+					PkBytes: []byte("pk"),
+				}
+			},
+		},
+		&testscommon.PeerReputationHandlerStub{
+			IsPublicKeyDeniedCalled: func(pk []byte) bool {
+				return string(pk) == "pk"
+			},
+		},
+		&p2pmocks.MessengerStub{},
+	)
+
+	assert.True(t, pdc.IsDenied("pid"))
+}
+
 func TestPeerDenialEvaluator_UpsertPeerID(t *testing.T) {
 	t.Parallel()
 
@@ -155,6 +292,8 @@ func TestPeerDenialEvaluator_UpsertPeerID(t *testing.T) {
 		},
 		&testscommon.TimeCacheStub{},
 		&mock.PeerShardMapperStub{},
+		&testscommon.PeerReputationHandlerStub{},
+		&p2pmocks.MessengerStub{},
 	)
 
 	err := pdc.UpsertPeerID("", time.Second)
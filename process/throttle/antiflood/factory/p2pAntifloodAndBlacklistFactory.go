@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/adaptive"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
@@ -29,9 +30,15 @@ const fastReactingIdentifier = "fast_reacting"
 const slowReactingIdentifier = "slow_reacting"
 const outOfSpecsIdentifier = "out_of_specs"
 const outputIdentifier = "output"
+const adaptiveIdentifier = "adaptive"
 
 var durationSweepP2PBlacklist = time.Second * 5
 
+type adjustableFloodPreventer interface {
+	process.FloodPreventer
+	SetQuotaPercent(percent uint32)
+}
+
 // AntiFloodComponents holds the handlers for the anti-flood and blacklist mechanisms
 type AntiFloodComponents struct {
 	AntiFloodHandler process.P2PAntifloodHandler
@@ -42,12 +49,21 @@ type AntiFloodComponents struct {
 }
 
 // NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config
-func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, statusHandler core.AppStatusHandler, currentPid core.PeerID) (*AntiFloodComponents, error) {
+func NewP2PAntiFloodComponents(
+	ctx context.Context,
+	config config.Config,
+	statusHandler core.AppStatusHandler,
+	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
+) (*AntiFloodComponents, error) {
 	if check.IfNil(statusHandler) {
 		return nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(peerReputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid)
+		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid, peerReputationHandler)
 	}
 
 	return &AntiFloodComponents{
@@ -64,6 +80,7 @@ func initP2PAntiFloodComponents(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
 ) (*AntiFloodComponents, error) {
 	timeCache := cache.NewTimeCache(defaultSpan)
 	p2pPeerBlackList, err := cache.NewPeerTimeCache(timeCache)
@@ -80,6 +97,7 @@ func initP2PAntiFloodComponents(
 		statusHandler,
 		fastReactingIdentifier,
 		p2pPeerBlackList,
+		peerReputationHandler,
 		currentPid,
 	)
 	if err != nil {
@@ -93,6 +111,7 @@ func initP2PAntiFloodComponents(
 		statusHandler,
 		slowReactingIdentifier,
 		p2pPeerBlackList,
+		peerReputationHandler,
 		currentPid,
 	)
 	if err != nil {
@@ -106,6 +125,7 @@ func initP2PAntiFloodComponents(
 		statusHandler,
 		outOfSpecsIdentifier,
 		p2pPeerBlackList,
+		peerReputationHandler,
 		currentPid,
 	)
 	if err != nil {
@@ -131,6 +151,21 @@ func initP2PAntiFloodComponents(
 		return nil, err
 	}
 
+	if mainConfig.Antiflood.Adaptive.Enabled {
+		err = startAdaptiveQuotaController(
+			ctx,
+			mainConfig.Antiflood,
+			statusHandler,
+			topicFloodPreventer,
+			fastReactingFloodPreventer,
+			slowReactingFloodPreventer,
+			outOfSpecsFloodPreventer,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w when creating the adaptive quota controller", err)
+		}
+	}
+
 	if mainConfig.Debug.Antiflood.Enabled {
 		debugger, errDebugger := antifloodDebug.NewAntifloodDebugger(mainConfig.Debug.Antiflood)
 		if errDebugger != nil {
@@ -159,6 +194,53 @@ func initP2PAntiFloodComponents(
 	}, nil
 }
 
+func startAdaptiveQuotaController(
+	ctx context.Context,
+	antifloodConfig config.AntifloodConfig,
+	statusHandler core.AppStatusHandler,
+	topicFloodPreventer adaptive.TopicFloodPreventer,
+	floodPreventers ...adjustableFloodPreventer,
+) error {
+	loadProvider, err := adaptive.NewNodeLoadProvider(ctx, antifloodConfig.Adaptive.MaxNumGoRoutines)
+	if err != nil {
+		return err
+	}
+
+	quotaProcessor, err := p2pQuota.NewP2PQuotaProcessor(statusHandler, adaptiveIdentifier)
+	if err != nil {
+		return err
+	}
+
+	adaptiveFloodPreventers := make([]adaptive.FloodPreventer, 0, len(floodPreventers))
+	for _, fp := range floodPreventers {
+		adaptiveFloodPreventers = append(adaptiveFloodPreventers, fp)
+	}
+
+	quotaController, err := adaptive.NewQuotaController(adaptive.ArgsQuotaController{
+		Config:              antifloodConfig.Adaptive,
+		TopicMaxMessages:    antifloodConfig.Topic.MaxMessages,
+		LoadProvider:        loadProvider,
+		FloodPreventers:     adaptiveFloodPreventers,
+		TopicFloodPreventer: topicFloodPreventer,
+		StatusHandler:       quotaProcessor,
+	})
+	if err != nil {
+		return err
+	}
+
+	quotaController.StartAdjusting(ctx)
+
+	log.Debug("started adaptive antiflood quota controller",
+		"interval in seconds", antifloodConfig.Adaptive.IntervalInSeconds,
+		"high load threshold percent", antifloodConfig.Adaptive.HighLoadThresholdPercent,
+		"low load threshold percent", antifloodConfig.Adaptive.LowLoadThresholdPercent,
+		"min quota percent", antifloodConfig.Adaptive.MinQuotaPercent,
+		"num topic overrides", len(antifloodConfig.Adaptive.TopicOverrides),
+	)
+
+	return nil
+}
+
 func setMaxMessages(topicFloodPreventer process.TopicFloodPreventer, topicMaxMessages []config.TopicMaxMessagesConfig) {
 	for _, topicMaxMsg := range topicMaxMessages {
 		topicFloodPreventer.SetMaxMessagesForTopic(topicMaxMsg.Topic, topicMaxMsg.NumMessagesPerSec)
@@ -217,8 +299,9 @@ func createFloodPreventer(
 	statusHandler core.AppStatusHandler,
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListCacher,
+	peerReputationHandler process.PeerReputationHandler,
 	selfPid core.PeerID,
-) (process.FloodPreventer, error) {
+) (adjustableFloodPreventer, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
 	blackListCache, err := storageunit.NewCache(cacheConfig)
 	if err != nil {
@@ -228,6 +311,7 @@ func createFloodPreventer(
 	blackListProcessor, err := blackList.NewP2PBlackListProcessor(
 		blackListCache,
 		blackListHandler,
+		peerReputationHandler,
 		floodPreventerConfig.BlackList.ThresholdNumMessagesPerInterval,
 		floodPreventerConfig.BlackList.ThresholdSizePerInterval,
 		floodPreventerConfig.BlackList.NumFloodingRounds,
@@ -2,13 +2,16 @@ package factory
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,11 +23,21 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 
 	ctx := context.Background()
 	cfg := config.Config{}
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, currentPid, &testscommon.PeerReputationHandlerStub{})
 	assert.Nil(t, components)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodAndBlackList_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := config.Config{}
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, statusHandler.NewAppStatusHandlerMock(), currentPid, nil)
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -35,7 +48,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 	}
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, &testscommon.PeerReputationHandlerStub{})
 	assert.NotNil(t, components)
 	assert.Nil(t, err)
 
@@ -69,7 +82,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, &testscommon.PeerReputationHandlerStub{})
 	assert.Nil(t, err)
 	assert.NotNil(t, components.AntiFloodHandler)
 	assert.NotNil(t, components.BlacklistHandler)
@@ -80,6 +93,65 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	time.Sleep(time.Second * 2)
 }
 
+func TestNewP2PAntiFloodAndBlackList_AdaptiveMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid adaptive config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createEnabledAntifloodConfig()
+		cfg.Antiflood.Adaptive.MinQuotaPercent = 0
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		components, err := NewP2PAntiFloodComponents(ctx, cfg, statusHandler.NewAppStatusHandlerMock(), currentPid, &testscommon.PeerReputationHandlerStub{})
+		assert.Nil(t, components)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createEnabledAntifloodConfig()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		components, err := NewP2PAntiFloodComponents(ctx, cfg, statusHandler.NewAppStatusHandlerMock(), currentPid, &testscommon.PeerReputationHandlerStub{})
+		assert.Nil(t, err)
+		assert.NotNil(t, components.AntiFloodHandler)
+	})
+}
+
+func createEnabledAntifloodConfig() config.Config {
+	return config.Config{
+		Antiflood: config.AntifloodConfig{
+			Enabled: true,
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+				Shards:   2,
+			},
+			FastReacting: createFloodPreventerConfig(),
+			SlowReacting: createFloodPreventerConfig(),
+			OutOfSpecs:   createFloodPreventerConfig(),
+			Topic: config.TopicAntifloodConfig{
+				DefaultMaxMessagesPerSec: 10,
+			},
+			Adaptive: config.AdaptiveAntifloodConfig{
+				Enabled:                  true,
+				IntervalInSeconds:        1,
+				HighLoadThresholdPercent: 85,
+				LowLoadThresholdPercent:  60,
+				DecreaseStepPercent:      10,
+				IncreaseStepPercent:      5,
+				MinQuotaPercent:          30,
+				MaxNumGoRoutines:         10000,
+			},
+		},
+	}
+}
+
 func createFloodPreventerConfig() config.FloodPreventerConfig {
 	return config.FloodPreventerConfig{
 		IntervalInSeconds: 1,
@@ -17,10 +17,6 @@ func (tfp *topicFloodPreventer) CountForTopicAndIdentifier(topic string, pid cor
 	return countForId
 }
 
-func (tfp *topicFloodPreventer) MaxMessagesForTopic(topic string) uint32 {
-	return tfp.maxMessagesForTopic(topic)
-}
-
 func (tfp *topicFloodPreventer) TopicMaxMessages() map[string]uint32 {
 	copiedMaxMessages := make(map[string]uint32)
 	tfp.mutTopicMaxMessages.RLock()
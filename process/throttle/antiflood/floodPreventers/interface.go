@@ -7,5 +7,6 @@ import "github.com/multiversx/mx-chain-core-go/core"
 type QuotaStatusHandler interface {
 	ResetStatistics()
 	AddQuota(pid core.PeerID, numReceived uint32, sizeReceived uint64, numProcessed uint32, sizeProcessed uint64)
+	SetLiveQuota(maxNumMessages uint32, maxTotalSize uint64)
 	IsInterfaceNil() bool
 }
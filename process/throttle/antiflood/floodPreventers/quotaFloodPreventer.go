@@ -30,6 +30,7 @@ const initNumMessages = 1
 const maxPercentReserved = 90.0
 const minPercentReserved = 0.0
 const quotaStructSize = 24
+const maxQuotaPercent = 100
 
 type quota struct {
 	numReceivedMessages   uint32
@@ -55,6 +56,7 @@ type quotaFloodPreventer struct {
 	percentReserved               float32
 	increaseThreshold             uint32
 	increaseFactor                float32
+	quotaPercent                  uint32
 }
 
 // NewQuotaFloodPreventer creates a new flood preventer based on quota / peer
@@ -113,6 +115,7 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 		percentReserved:               arg.PercentReserved,
 		increaseThreshold:             arg.IncreaseThreshold,
 		increaseFactor:                arg.IncreaseFactor,
+		quotaPercent:                  maxQuotaPercent,
 	}, nil
 }
 
@@ -146,8 +149,9 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	q.numReceivedMessages++
 	q.sizeReceivedMessages += size
 
-	maxNumMessagesReached := qfp.isMaximumReached(uint64(qfp.computedMaxNumMessagesPerPeer), uint64(q.numReceivedMessages))
-	maxSizeMessagesReached := qfp.isMaximumReached(qfp.maxTotalSizePerPeer, q.sizeReceivedMessages)
+	maxNumMessages, maxTotalSize := qfp.liveQuota()
+	maxNumMessagesReached := qfp.isMaximumReached(uint64(maxNumMessages), uint64(q.numReceivedMessages))
+	maxSizeMessagesReached := qfp.isMaximumReached(maxTotalSize, q.sizeReceivedMessages)
 	isPeerQuotaReached := maxNumMessagesReached || maxSizeMessagesReached
 	if isPeerQuotaReached {
 		return fmt.Errorf("%w for pid %s", process.ErrSystemBusy, pid.Pretty())
@@ -159,6 +163,22 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	return nil
 }
 
+// liveQuota returns the currently applied limits, after the quota percent was taken into account
+func (qfp *quotaFloodPreventer) liveQuota() (uint32, uint64) {
+	maxNumMessages := applyPercent(uint64(qfp.computedMaxNumMessagesPerPeer), qfp.quotaPercent, minMessages)
+	maxTotalSize := applyPercent(qfp.maxTotalSizePerPeer, qfp.quotaPercent, minTotalSize)
+
+	return uint32(maxNumMessages), maxTotalSize
+}
+
+func applyPercent(value uint64, percent uint32, minValue uint64) uint64 {
+	if percent >= maxQuotaPercent {
+		return value
+	}
+
+	return core.MaxUint64(value*uint64(percent)/maxQuotaPercent, minValue)
+}
+
 func (qfp *quotaFloodPreventer) isMaximumReached(absoluteMax uint64, counted uint64) bool {
 	max := uint64(100-qfp.percentReserved) * absoluteMax / 100
 
@@ -188,8 +208,10 @@ func (qfp *quotaFloodPreventer) Reset() {
 }
 
 func (qfp *quotaFloodPreventer) resetStatusHandlers() {
+	maxNumMessages, maxTotalSize := qfp.liveQuota()
 	for _, statusHandler := range qfp.statusHandlers {
 		statusHandler.ResetStatistics()
+		statusHandler.SetLiveQuota(maxNumMessages, maxTotalSize)
 	}
 }
 
@@ -266,6 +288,35 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// SetQuotaPercent will set the percent of the computed limits that will be applied on each peer. Values over 100 are
+// capped at 100 as the adaptive mode is only allowed to decrease the configured limits
+func (qfp *quotaFloodPreventer) SetQuotaPercent(percent uint32) {
+	if percent > maxQuotaPercent {
+		percent = maxQuotaPercent
+	}
+
+	qfp.mutOperation.Lock()
+	oldPercent := qfp.quotaPercent
+	qfp.quotaPercent = percent
+	qfp.mutOperation.Unlock()
+
+	if oldPercent != percent {
+		log.Debug("quotaFloodPreventer.SetQuotaPercent",
+			"name", qfp.name,
+			"old percent", oldPercent,
+			"new percent", percent,
+		)
+	}
+}
+
+// LiveQuota returns the maximum number of messages and the maximum total size currently allowed for a peer
+func (qfp *quotaFloodPreventer) LiveQuota() (uint32, uint64) {
+	qfp.mutOperation.RLock()
+	defer qfp.mutOperation.RUnlock()
+
+	return qfp.liveQuota()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
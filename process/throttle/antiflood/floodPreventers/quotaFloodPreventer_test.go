@@ -488,3 +488,78 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+func TestQuotaFloodPreventer_SetQuotaPercent(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = 1000
+	arg.PercentReserved = 0
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	maxNumMessages, maxTotalSize := qfp.LiveQuota()
+	assert.Equal(t, uint32(100), maxNumMessages)
+	assert.Equal(t, uint64(1000), maxTotalSize)
+
+	qfp.SetQuotaPercent(40)
+	maxNumMessages, maxTotalSize = qfp.LiveQuota()
+	assert.Equal(t, uint32(40), maxNumMessages)
+	assert.Equal(t, uint64(400), maxTotalSize)
+
+	qfp.SetQuotaPercent(0)
+	maxNumMessages, maxTotalSize = qfp.LiveQuota()
+	assert.Equal(t, uint32(minMessages), maxNumMessages)
+	assert.Equal(t, uint64(minTotalSize), maxTotalSize)
+
+	qfp.SetQuotaPercent(150)
+	maxNumMessages, maxTotalSize = qfp.LiveQuota()
+	assert.Equal(t, uint32(100), maxNumMessages)
+	assert.Equal(t, uint64(1000), maxTotalSize)
+}
+
+func TestQuotaFloodPreventer_IncreaseLoadShouldUseTheQuotaPercent(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = testscommon.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 10
+	arg.MaxTotalSizePerPeer = 1000
+	arg.PercentReserved = 0
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.SetQuotaPercent(50)
+
+	pid := core.PeerID("pid")
+	for i := 0; i < 5; i++ {
+		err := qfp.IncreaseLoad(pid, 1)
+		assert.Nil(t, err)
+	}
+
+	err := qfp.IncreaseLoad(pid, 1)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+}
+
+func TestQuotaFloodPreventer_ResetShouldOutputTheLiveQuota(t *testing.T) {
+	t.Parallel()
+
+	var providedNumMessages uint32
+	var providedTotalSize uint64
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = 1000
+	arg.StatusHandlers = []QuotaStatusHandler{
+		&mock.QuotaStatusHandlerStub{
+			SetLiveQuotaCalled: func(maxNumMessages uint32, maxTotalSize uint64) {
+				providedNumMessages = maxNumMessages
+				providedTotalSize = maxTotalSize
+			},
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.SetQuotaPercent(20)
+
+	qfp.Reset()
+
+	assert.Equal(t, uint32(20), providedNumMessages)
+	assert.Equal(t, uint64(200), providedTotalSize)
+}
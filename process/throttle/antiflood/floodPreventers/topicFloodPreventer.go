@@ -26,6 +26,10 @@ type topicFloodPreventer struct {
 	registeredTopics          map[string]struct{}
 	counterMap                map[string]map[core.PeerID]uint32
 	defaultMaxMessagesPerPeer uint32
+	quotaPercent              uint32
+	adjustedMaxMessages       map[string]uint32
+	adjustedTopicsPatterns    map[string]string
+	lastIntervalNumMessages   map[string]uint64
 }
 
 // NewTopicFloodPreventer creates a new flood preventer based on topic
@@ -46,6 +50,10 @@ func NewTopicFloodPreventer(
 		counterMap:                make(map[string]map[core.PeerID]uint32),
 		registeredTopics:          make(map[string]struct{}),
 		defaultMaxMessagesPerPeer: maxMessagesPerPeer,
+		quotaPercent:              maxQuotaPercent,
+		adjustedMaxMessages:       make(map[string]uint32),
+		adjustedTopicsPatterns:    make(map[string]string),
+		lastIntervalNumMessages:   make(map[string]uint64),
 	}, nil
 }
 
@@ -62,7 +70,7 @@ func (tfp *topicFloodPreventer) IncreaseLoad(pid core.PeerID, topic string, numM
 
 	tfp.counterMap[topic][pid] += numMessages
 
-	limitExceeded := tfp.counterMap[topic][pid] > tfp.maxMessagesForTopic(topic)
+	limitExceeded := tfp.counterMap[topic][pid] > tfp.liveMaxMessagesForTopic(topic)
 	if limitExceeded {
 		return process.ErrSystemBusy
 	}
@@ -87,7 +95,7 @@ func (tfp *topicFloodPreventer) ResetForTopic(topic string) {
 	if strings.Contains(topic, WildcardCharacter) {
 		tfp.resetTopicWithWildCard(topic)
 	}
-	tfp.counterMap[topic] = make(map[core.PeerID]uint32)
+	tfp.resetCounters(topic)
 }
 
 // ResetForNotRegisteredTopics resets all topic counters that were not registered
@@ -101,10 +109,22 @@ func (tfp *topicFloodPreventer) ResetForNotRegisteredTopics() {
 			continue
 		}
 
-		tfp.counterMap[topic] = make(map[core.PeerID]uint32)
+		tfp.resetCounters(topic)
 	}
 }
 
+// resetCounters clears the counters of a topic, remembering the total number of messages received in the
+// ended interval
+func (tfp *topicFloodPreventer) resetCounters(topic string) {
+	numMessages := uint64(0)
+	for _, counter := range tfp.counterMap[topic] {
+		numMessages += uint64(counter)
+	}
+
+	tfp.lastIntervalNumMessages[topic] = numMessages
+	tfp.counterMap[topic] = make(map[core.PeerID]uint32)
+}
+
 func (tfp *topicFloodPreventer) isRegisteredTopic(searchedTopic string) bool {
 	for topic := range tfp.registeredTopics {
 		if strings.Contains(topic, WildcardCharacter) {
@@ -128,7 +148,7 @@ func (tfp *topicFloodPreventer) resetTopicWithWildCard(topic string) {
 	topicWithoutWildcard := strings.Replace(topic, WildcardCharacter, "", 1)
 	for topicKey := range tfp.counterMap {
 		if strings.Contains(topicKey, topicWithoutWildcard) {
-			tfp.counterMap[topicKey] = make(map[core.PeerID]uint32)
+			tfp.resetCounters(topicKey)
 		}
 	}
 }
@@ -158,6 +178,120 @@ func (tfp *topicFloodPreventer) maxMessagesForTopicWildcard(topic string) uint32
 	return tfp.defaultMaxMessagesPerPeer
 }
 
+func (tfp *topicFloodPreventer) liveMaxMessagesForTopic(topic string) uint32 {
+	maxMessages, ok := tfp.adjustedMaxMessagesForTopic(topic)
+	if ok {
+		return maxMessages
+	}
+
+	return uint32(applyPercent(uint64(tfp.maxMessagesForTopic(topic)), tfp.quotaPercent, topicMinMessages))
+}
+
+func (tfp *topicFloodPreventer) adjustedMaxMessagesForTopic(topic string) (uint32, bool) {
+	if len(tfp.adjustedMaxMessages) == 0 {
+		return 0, false
+	}
+
+	pattern, ok := tfp.adjustedTopicsPatterns[topic]
+	if !ok {
+		pattern = tfp.adjustedTopicPattern(topic)
+		tfp.adjustedTopicsPatterns[topic] = pattern
+	}
+
+	maxMessages, ok := tfp.adjustedMaxMessages[pattern]
+
+	return maxMessages, ok
+}
+
+func (tfp *topicFloodPreventer) adjustedTopicPattern(topic string) string {
+	_, ok := tfp.adjustedMaxMessages[topic]
+	if ok {
+		return topic
+	}
+
+	for t := range tfp.adjustedMaxMessages {
+		if !strings.Contains(t, WildcardCharacter) {
+			continue
+		}
+
+		topicWithoutWildcard := strings.Replace(t, WildcardCharacter, "", 1)
+		if strings.Contains(topic, topicWithoutWildcard) {
+			return t
+		}
+	}
+
+	return ""
+}
+
+// SetQuotaPercent will set the percent of the configured limits that will be applied on the topics that do not have
+// an adjusted maximum value. Values over 100 are capped at 100
+func (tfp *topicFloodPreventer) SetQuotaPercent(percent uint32) {
+	if percent > maxQuotaPercent {
+		percent = maxQuotaPercent
+	}
+
+	tfp.mutTopicMaxMessages.Lock()
+	tfp.quotaPercent = percent
+	tfp.mutTopicMaxMessages.Unlock()
+}
+
+// SetAdjustedMaxMessagesForTopic will set the maximum number of messages that can be received from a peer in a topic,
+// overriding both the configured value and the quota percent. The topic can contain the wildcard character
+func (tfp *topicFloodPreventer) SetAdjustedMaxMessagesForTopic(topic string, numMessages uint32) {
+	if numMessages < topicMinMessages {
+		numMessages = topicMinMessages
+	}
+
+	tfp.mutTopicMaxMessages.Lock()
+	defer tfp.mutTopicMaxMessages.Unlock()
+
+	_, exists := tfp.adjustedMaxMessages[topic]
+	tfp.adjustedMaxMessages[topic] = numMessages
+	if !exists {
+		tfp.adjustedTopicsPatterns = make(map[string]string)
+	}
+}
+
+// MaxMessagesForTopic returns the configured maximum number of messages that can be received from a peer in a topic
+func (tfp *topicFloodPreventer) MaxMessagesForTopic(topic string) uint32 {
+	tfp.mutTopicMaxMessages.Lock()
+	defer tfp.mutTopicMaxMessages.Unlock()
+
+	return tfp.maxMessagesForTopic(topic)
+}
+
+// LiveMaxMessagesForTopic returns the maximum number of messages that can currently be received from a peer in a topic
+func (tfp *topicFloodPreventer) LiveMaxMessagesForTopic(topic string) uint32 {
+	tfp.mutTopicMaxMessages.Lock()
+	defer tfp.mutTopicMaxMessages.Unlock()
+
+	return tfp.liveMaxMessagesForTopic(topic)
+}
+
+// NumMessagesInLastInterval returns the total number of messages received on a topic in the last ended interval.
+// If the topic contains the wildcard character, the messages from all the matching topics are summed up
+func (tfp *topicFloodPreventer) NumMessagesInLastInterval(topic string) uint64 {
+	tfp.mutTopicMaxMessages.RLock()
+	defer tfp.mutTopicMaxMessages.RUnlock()
+
+	if !strings.Contains(topic, WildcardCharacter) {
+		return tfp.lastIntervalNumMessages[topic]
+	}
+
+	topicWithoutWildcard := strings.Replace(topic, WildcardCharacter, "", 1)
+	numMessages := uint64(0)
+	for topicKey, numTopicMessages := range tfp.lastIntervalNumMessages {
+		if topicKey == topic {
+			continue
+		}
+		if strings.Contains(topicKey, topicWithoutWildcard) {
+			numMessages += numTopicMessages
+		}
+	}
+
+	return numMessages
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tfp *topicFloodPreventer) IsInterfaceNil() bool {
 	return tfp == nil
@@ -304,3 +304,72 @@ func TestTopicFloodPreventer_ResetForNotRegisteredTopicsWithWildcardShuldWork(t
 	err = tfp.IncreaseLoad(identifier, unregisteredTopic, defaultMaxMessages)
 	assert.Nil(t, err)
 }
+
+func TestTopicFloodPreventer_SetQuotaPercent(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(100)
+	tfp.SetMaxMessagesForTopic("topic", 50)
+
+	tfp.SetQuotaPercent(10)
+	assert.Equal(t, uint32(10), tfp.LiveMaxMessagesForTopic("other topic"))
+	assert.Equal(t, uint32(5), tfp.LiveMaxMessagesForTopic("topic"))
+	assert.Equal(t, uint32(50), tfp.MaxMessagesForTopic("topic"))
+
+	id := core.PeerID("identifier")
+	err := tfp.IncreaseLoad(id, "topic", 5)
+	assert.Nil(t, err)
+	err = tfp.IncreaseLoad(id, "topic", 1)
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	tfp.SetQuotaPercent(200)
+	assert.Equal(t, uint32(100), tfp.LiveMaxMessagesForTopic("other topic"))
+	assert.Equal(t, uint32(50), tfp.LiveMaxMessagesForTopic("topic"))
+}
+
+func TestTopicFloodPreventer_SetAdjustedMaxMessagesForTopic(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(100)
+	tfp.SetMaxMessagesForTopic("shardBlocks*", 30)
+	tfp.SetQuotaPercent(50)
+
+	assert.Equal(t, uint32(15), tfp.LiveMaxMessagesForTopic("shardBlocks_0_META"))
+
+	tfp.SetAdjustedMaxMessagesForTopic("shardBlocks*", 20)
+	assert.Equal(t, uint32(20), tfp.LiveMaxMessagesForTopic("shardBlocks_0_META"))
+	assert.Equal(t, uint32(20), tfp.LiveMaxMessagesForTopic("shardBlocks_1_META"))
+	assert.Equal(t, uint32(50), tfp.LiveMaxMessagesForTopic("transactions_0"))
+
+	tfp.SetAdjustedMaxMessagesForTopic("shardBlocks*", 0)
+	assert.Equal(t, uint32(1), tfp.LiveMaxMessagesForTopic("shardBlocks_0_META"))
+
+	tfp.SetAdjustedMaxMessagesForTopic("transactions_0", 7)
+	assert.Equal(t, uint32(7), tfp.LiveMaxMessagesForTopic("transactions_0"))
+	assert.Equal(t, uint32(1), tfp.LiveMaxMessagesForTopic("shardBlocks_0_META"))
+}
+
+func TestTopicFloodPreventer_NumMessagesInLastInterval(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(100)
+	tfp.SetMaxMessagesForTopic("shardBlocks*", 30)
+
+	_ = tfp.IncreaseLoad("pid1", "shardBlocks_0_META", 3)
+	_ = tfp.IncreaseLoad("pid2", "shardBlocks_0_META", 4)
+	_ = tfp.IncreaseLoad("pid1", "shardBlocks_1_META", 5)
+	_ = tfp.IncreaseLoad("pid1", "unregistered", 6)
+
+	assert.Equal(t, uint64(0), tfp.NumMessagesInLastInterval("shardBlocks*"))
+
+	tfp.ResetForTopic("shardBlocks*")
+	tfp.ResetForNotRegisteredTopics()
+
+	assert.Equal(t, uint64(12), tfp.NumMessagesInLastInterval("shardBlocks*"))
+	assert.Equal(t, uint64(7), tfp.NumMessagesInLastInterval("shardBlocks_0_META"))
+	assert.Equal(t, uint64(6), tfp.NumMessagesInLastInterval("unregistered"))
+	assert.Equal(t, uint64(0), tfp.NumMessagesInLastInterval("missing"))
+
+	tfp.ResetForTopic("shardBlocks*")
+	assert.Equal(t, uint64(0), tfp.NumMessagesInLastInterval("shardBlocks*"))
+}
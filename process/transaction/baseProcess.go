@@ -141,6 +141,17 @@ func (txProc *baseTxProcessor) checkTxValues(
 		return err
 	}
 
+	feePayer, err := txProc.getFeePayer(tx, acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+	if common.IsRelayedTxV3(tx) {
+		err = txProc.checkRelayedTxV3(tx, feePayer)
+		if err != nil {
+			return err
+		}
+	}
+
 	var txFee *big.Int
 	if isUserTxOfRelayed {
 		if tx.GasLimit < txProc.economicsFee.ComputeGasLimit(tx) {
@@ -152,10 +163,10 @@ func (txProc *baseTxProcessor) checkTxValues(
 		txFee = txProc.economicsFee.ComputeTxFee(tx)
 	}
 
-	if acntSnd.GetBalance().Cmp(txFee) < 0 {
+	if feePayer.GetBalance().Cmp(txFee) < 0 {
 		return fmt.Errorf("%w, has: %s, wanted: %s",
 			process.ErrInsufficientFee,
-			acntSnd.GetBalance().String(),
+			feePayer.GetBalance().String(),
 			txFee.String(),
 		)
 	}
@@ -166,6 +177,15 @@ func (txProc *baseTxProcessor) checkTxValues(
 		txFee = core.SafeMul(tx.GasLimit, tx.GasPrice)
 	}
 
+	if feePayer != acntSnd {
+		// the relayer pays the fee, the sender only the value
+		if feePayer.GetBalance().Cmp(txFee) < 0 || acntSnd.GetBalance().Cmp(tx.Value) < 0 {
+			return process.ErrInsufficientFunds
+		}
+
+		return nil
+	}
+
 	cost := big.NewInt(0).Add(txFee, tx.Value)
 	if acntSnd.GetBalance().Cmp(cost) < 0 {
 		return process.ErrInsufficientFunds
@@ -174,6 +194,51 @@ func (txProc *baseTxProcessor) checkTxValues(
 	return nil
 }
 
+// getFeePayer returns the account paying the fee of the provided transaction: the relayer of a relayed tx v3 or
+// the sender otherwise. Already loaded accounts are reused, so that a stale copy of the same account is never saved.
+func (txProc *baseTxProcessor) getFeePayer(
+	tx *transaction.Transaction,
+	acntSnd, acntDst state.UserAccountHandler,
+) (state.UserAccountHandler, error) {
+	if check.IfNil(acntSnd) || !common.IsRelayedTxV3(tx) {
+		return acntSnd, nil
+	}
+	if bytes.Equal(tx.RelayerAddr, tx.SndAddr) {
+		return acntSnd, nil
+	}
+	if !check.IfNil(acntDst) && bytes.Equal(tx.RelayerAddr, tx.RcvAddr) {
+		return acntDst, nil
+	}
+
+	relayerAcnt, err := txProc.getAccountFromAddress(tx.RelayerAddr)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(relayerAcnt) {
+		return nil, process.ErrRelayedTxV3SenderShardMismatch
+	}
+
+	return relayerAcnt, nil
+}
+
+func (txProc *baseTxProcessor) checkRelayedTxV3(tx *transaction.Transaction, relayerAcnt state.UserAccountHandler) error {
+	if !txProc.enableEpochsHandler.IsFlagEnabled(common.RelayedTransactionsV3Flag) {
+		return process.ErrRelayedTxV3Disabled
+	}
+
+	txType, _ := txProc.txTypeHandler.ComputeTransactionType(tx)
+	if txType == process.RelayedTx || txType == process.RelayedTxV2 {
+		return process.ErrMultipleRelayedTxTypesIsNotAllowed
+	}
+
+	// the relayer has no guardian co-signature on the transaction
+	if relayerAcnt.IsGuarded() {
+		return process.ErrGuardedRelayerNotAllowed
+	}
+
+	return nil
+}
+
 func (txProc *baseTxProcessor) computeInnerTxFee(tx *transaction.Transaction) *big.Int {
 	if txProc.enableEpochsHandler.IsFlagEnabled(common.FixRelayedBaseCostFlag) {
 		return txProc.computeInnerTxFeeAfterBaseCostFix(tx)
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -37,6 +38,7 @@ type InterceptedTransaction struct {
 	whiteListerVerifiedTxs process.WhiteListHandler
 	argsParser             process.ArgumentsParser
 	txVersionChecker       process.TxVersionCheckerHandler
+	enableEpochsHandler    common.EnableEpochsHandler
 	chainID                []byte
 	rcvShard               uint32
 	sndShard               uint32
@@ -61,6 +63,7 @@ func NewInterceptedTransaction(
 	enableSignedTxWithHash bool,
 	txSignHasher hashing.Hasher,
 	txVersionChecker process.TxVersionCheckerHandler,
+	enableEpochsHandler common.EnableEpochsHandler,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -105,6 +108,9 @@ func NewInterceptedTransaction(
 	if check.IfNil(txVersionChecker) {
 		return nil, process.ErrNilTransactionVersionChecker
 	}
+	if check.IfNil(enableEpochsHandler) {
+		return nil, process.ErrNilEnableEpochsHandler
+	}
 
 	tx, err := createTx(protoMarshalizer, txBuff)
 	if err != nil {
@@ -127,6 +133,7 @@ func NewInterceptedTransaction(
 		enableSignedTxWithHash: enableSignedTxWithHash,
 		txVersionChecker:       txVersionChecker,
 		txSignHasher:           txSignHasher,
+		enableEpochsHandler:    enableEpochsHandler,
 	}
 
 	err = inTx.processFields(txBuff)
@@ -199,6 +206,11 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 			return err
 		}
 
+		err = inTx.verifyIfRelayedTxV3(inTx.tx)
+		if err != nil {
+			return err
+		}
+
 		inTx.whiteListerVerifiedTxs.Add([][]byte{inTx.Hash()})
 	}
 
@@ -261,6 +273,9 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transactio
 	if !bytes.Equal(userTx.SndAddr, tx.RcvAddr) {
 		return process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
 	}
+	if common.IsRelayedTxV3(userTx) {
+		return fmt.Errorf("inner transaction: %w", process.ErrMultipleRelayedTxTypesIsNotAllowed)
+	}
 
 	err = inTx.integrity(userTx)
 	if err != nil {
@@ -270,6 +285,61 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transactio
 	return inTx.verifyUserTx(userTx)
 }
 
+func (inTx *InterceptedTransaction) verifyIfRelayedTxV3(tx *transaction.Transaction) error {
+	if !common.IsRelayedTxV3(tx) {
+		return nil
+	}
+
+	funcName, _, err := inTx.argsParser.ParseCallData(string(tx.Data))
+	if err == nil && isRelayedTx(funcName) {
+		return process.ErrMultipleRelayedTxTypesIsNotAllowed
+	}
+
+	relayerPubKey, err := inTx.keyGen.PublicKeyFromByteArray(tx.RelayerAddr)
+	if err != nil {
+		return err
+	}
+
+	// the relayer signs the same message as the sender, which already contains the relayer address
+	txMessageForSigVerification, err := inTx.getTxMessageForGivenTx(tx)
+	if err != nil {
+		return err
+	}
+
+	errVerifySig := inTx.singleSigner.Verify(relayerPubKey, txMessageForSigVerification, tx.RelayerSignature)
+	if errVerifySig != nil {
+		return fmt.Errorf("%w when checking the relayer's signature", errVerifySig)
+	}
+
+	return nil
+}
+
+// checkRelayedTxV3Fields checks the relayer fields of a relayed tx v3 and that they are absent on any other transaction
+func (inTx *InterceptedTransaction) checkRelayedTxV3Fields(tx *transaction.Transaction) error {
+	if !common.IsRelayedTxV3(tx) {
+		if len(tx.GetRelayerSignature()) > 0 {
+			return process.ErrRelayerSignatureNotExpected
+		}
+
+		return nil
+	}
+
+	if !inTx.enableEpochsHandler.IsFlagEnabled(common.RelayedTransactionsV3Flag) {
+		return process.ErrRelayedTxV3Disabled
+	}
+	if len(tx.RelayerAddr) != inTx.pubkeyConv.Len() {
+		return process.ErrInvalidRelayerAddress
+	}
+	if len(tx.RelayerSignature) == 0 {
+		return process.ErrNilRelayerSignature
+	}
+	if inTx.coordinator.ComputeId(tx.RelayerAddr) != inTx.coordinator.ComputeId(tx.SndAddr) {
+		return process.ErrRelayedTxV3SenderShardMismatch
+	}
+
+	return nil
+}
+
 func (inTx *InterceptedTransaction) verifyUserTx(userTx *transaction.Transaction) error {
 	// recursive relayed transactions are not allowed
 	err := inTx.checkRecursiveRelayed(userTx.Data)
@@ -328,6 +398,11 @@ func (inTx *InterceptedTransaction) integrity(tx *transaction.Transaction) error
 		return process.ErrInvalidSndAddr
 	}
 
+	err = inTx.checkRelayedTxV3Fields(tx)
+	if err != nil {
+		return err
+	}
+
 	err = inTx.checkMaxGasPrice()
 	if err != nil {
 		return err
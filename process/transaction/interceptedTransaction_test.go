@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	dataTransaction "github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/process/mock"
@@ -22,6 +23,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -36,6 +38,7 @@ var senderShard = uint32(2)
 var recvShard = uint32(3)
 var senderAddress = []byte("12345678901234567890123456789012")
 var recvAddress = []byte("23456789012345678901234567890123")
+var relayerAddress = []byte("34567890123456789012345678901234")
 var sigBad = []byte("bad-signature")
 var sigOk = []byte("signature")
 
@@ -114,6 +117,7 @@ func createInterceptedTxWithTxFeeHandlerAndVersionChecker(tx *dataTransaction.Tr
 		false,
 		&hashingMocks.HasherMock{},
 		txVerChecker,
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 }
 
@@ -157,10 +161,15 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 }
 
 func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction) (*transaction.InterceptedTransaction, error) {
+	return createInterceptedTxFromPlainTxWithArgParserAndEnableEpochsHandler(tx, enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag))
+}
+
+func createInterceptedTxFromPlainTxWithArgParserAndEnableEpochsHandler(tx *dataTransaction.Transaction, enableEpochsHandler common.EnableEpochsHandler) (*transaction.InterceptedTransaction, error) {
 	marshalizer := &mock.MarshalizerMock{}
 	txBuff, err := marshalizer.Marshal(tx)
 	if err != nil {
@@ -170,7 +179,7 @@ func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction
 	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
 	shardCoordinator.CurrentShard = 0
 	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
-		if bytes.Equal(address, senderAddress) {
+		if bytes.Equal(address, senderAddress) || bytes.Equal(address, relayerAddress) {
 			return senderShard
 		}
 		if bytes.Equal(address, recvAddress) {
@@ -200,6 +209,7 @@ func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(tx.Version),
+		enableEpochsHandler,
 	)
 }
 
@@ -224,6 +234,7 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -249,6 +260,7 @@ func TestNewInterceptedTransaction_NilArgsParser(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -274,12 +286,39 @@ func TestNewInterceptedTransaction_NilVersionChecker(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		nil,
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
 	assert.Equal(t, process.ErrNilTransactionVersionChecker, err)
 }
 
+func TestNewInterceptedTransaction_NilEnableEpochsHandler(t *testing.T) {
+	t.Parallel()
+
+	txi, err := transaction.NewInterceptedTransaction(
+		make([]byte, 0),
+		&mock.MarshalizerMock{},
+		&mock.MarshalizerMock{},
+		&hashingMocks.HasherMock{},
+		&mock.SingleSignKeyGenMock{},
+		&mock.SignerMock{},
+		createMockPubKeyConverter(),
+		mock.NewOneShardCoordinatorMock(),
+		&economicsmocks.EconomicsHandlerStub{},
+		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ArgumentParserMock{},
+		[]byte("chainID"),
+		false,
+		&hashingMocks.HasherMock{},
+		versioning.NewTxVersionChecker(1),
+		nil,
+	)
+
+	assert.Nil(t, txi)
+	assert.Equal(t, process.ErrNilEnableEpochsHandler, err)
+}
+
 func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -299,6 +338,7 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -324,6 +364,7 @@ func TestNewInterceptedTransaction_NilSignMarshalizerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -349,6 +390,7 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -374,6 +416,7 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -399,6 +442,7 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -424,6 +468,7 @@ func TestNewInterceptedTransaction_NilPubkeyConverterShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -449,6 +494,7 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -474,6 +520,7 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -499,6 +546,7 @@ func TestNewInterceptedTransaction_NilWhiteListerVerifiedTxsShouldErr(t *testing
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -524,6 +572,7 @@ func TestNewInterceptedTransaction_InvalidChainIDShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -549,6 +598,7 @@ func TestNewInterceptedTransaction_NilTxSignHasherShouldErr(t *testing.T) {
 		false,
 		nil,
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -580,6 +630,7 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, txi)
@@ -1050,6 +1101,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *test
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	err := txi.CheckValidity()
@@ -1110,6 +1162,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashShouldWork(t *testing
 		true,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	err := txi.CheckValidity()
@@ -1195,6 +1248,7 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Nil(t, err)
@@ -1334,6 +1388,7 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 	require.Nil(t, err)
 
@@ -1501,6 +1556,116 @@ func TestInterceptedTransaction_CheckValidityOfRelayedTxV2(t *testing.T) {
 }
 
 // ------- IsInterfaceNil
+func TestInterceptedTransaction_CheckValidityOfRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	createRelayedTxV3 := func() *dataTransaction.Transaction {
+		return &dataTransaction.Transaction{
+			Nonce:            1,
+			Value:            big.NewInt(2),
+			Data:             []byte("data"),
+			GasLimit:         3,
+			GasPrice:         4,
+			RcvAddr:          recvAddress,
+			SndAddr:          senderAddress,
+			Signature:        sigOk,
+			ChainID:          []byte("chain"),
+			Version:          1,
+			RelayerAddr:      relayerAddress,
+			RelayerSignature: sigOk,
+		}
+	}
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(createRelayedTxV3())
+		require.Nil(t, err)
+		assert.Nil(t, txi.CheckValidity())
+	})
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		txi, err := createInterceptedTxFromPlainTxWithArgParserAndEnableEpochsHandler(createRelayedTxV3(), enableEpochsHandlerMock.NewEnableEpochsHandlerStub())
+		require.Nil(t, err)
+		assert.Equal(t, process.ErrRelayedTxV3Disabled, txi.CheckValidity())
+	})
+	t.Run("relayer signature without relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTxV3()
+		tx.RelayerAddr = nil
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(tx)
+		require.Nil(t, err)
+		assert.Equal(t, process.ErrRelayerSignatureNotExpected, txi.CheckValidity())
+	})
+	t.Run("invalid relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTxV3()
+		tx.RelayerAddr = []byte("short")
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(tx)
+		require.Nil(t, err)
+		assert.Equal(t, process.ErrInvalidRelayerAddress, txi.CheckValidity())
+	})
+	t.Run("missing relayer signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTxV3()
+		tx.RelayerSignature = nil
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(tx)
+		require.Nil(t, err)
+		assert.Equal(t, process.ErrNilRelayerSignature, txi.CheckValidity())
+	})
+	t.Run("relayer in another shard than the sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTxV3()
+		tx.RelayerAddr = recvAddress
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(tx)
+		require.Nil(t, err)
+		assert.Equal(t, process.ErrRelayedTxV3SenderShardMismatch, txi.CheckValidity())
+	})
+	t.Run("invalid relayer signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTxV3()
+		tx.RelayerSignature = sigBad
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(tx)
+		require.Nil(t, err)
+		err = txi.CheckValidity()
+		assert.ErrorIs(t, err, errSignerMockVerifySigFails)
+		assert.Contains(t, err.Error(), "relayer")
+	})
+	t.Run("relayed tx v3 carrying a relayed tx should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createRelayedTxV3()
+		tx.Data = []byte(core.RelayedTransactionV2 + "@" + hex.EncodeToString(recvAddress) + "@00@" + hex.EncodeToString([]byte("data")) + "@" + hex.EncodeToString(sigOk))
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(tx)
+		require.Nil(t, err)
+		assert.Equal(t, process.ErrMultipleRelayedTxTypesIsNotAllowed, txi.CheckValidity())
+	})
+	t.Run("relayed tx carrying a relayed tx v3 should error", func(t *testing.T) {
+		t.Parallel()
+
+		userTx := createRelayedTxV3()
+		userTx.SndAddr = recvAddress
+		userTx.RcvAddr = senderAddress
+		userTxData, _ := (&mock.MarshalizerMock{}).Marshal(userTx)
+
+		tx := createRelayedTxV3()
+		tx.RelayerAddr = nil
+		tx.RelayerSignature = nil
+		tx.Data = []byte(core.RelayedTransaction + "@" + hex.EncodeToString(userTxData))
+		txi, err := createInterceptedTxFromPlainTxWithArgParser(tx)
+		require.Nil(t, err)
+		err = txi.CheckValidity()
+		assert.ErrorIs(t, err, process.ErrMultipleRelayedTxTypesIsNotAllowed)
+		assert.Contains(t, err.Error(), "inner transaction")
+	})
+}
+
 func TestInterceptedTransaction_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -1629,6 +1794,7 @@ func TestInterceptedTransaction_Fee(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(0),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	assert.Equal(t, big.NewInt(0), txin.Fee())
@@ -1672,6 +1838,7 @@ func TestInterceptedTransaction_String(t *testing.T) {
 		false,
 		&hashingMocks.HasherMock{},
 		versioning.NewTxVersionChecker(0),
+		enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.RelayedTransactionsV3Flag),
 	)
 
 	expectedFormat := fmt.Sprintf(
@@ -66,6 +66,7 @@ func NewMetaTxProcessor(args ArgsNewMetaTxProcessor) (*metaTxProcessor, error) {
 		common.PenalizedTooMuchGasFlag,
 		common.ESDTFlag,
 		common.FixRelayedBaseCostFlag,
+		common.RelayedTransactionsV3Flag,
 	})
 	if err != nil {
 		return nil, err
@@ -129,6 +129,7 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		common.RelayedTransactionsV2Flag,
 		common.RelayedNonceFixFlag,
 		common.FixRelayedBaseCostFlag,
+		common.RelayedTransactionsV3Flag,
 	})
 	if err != nil {
 		return nil, err
@@ -295,8 +296,13 @@ func (txProc *txProcessor) executingFailedTransaction(
 		return nil
 	}
 
+	feePayer, err := txProc.getFeePayer(tx, acntSnd, nil)
+	if err != nil {
+		return err
+	}
+
 	txFee := txProc.economicsFee.ComputeTxFee(tx)
-	err := acntSnd.SubFromBalance(txFee)
+	err = feePayer.SubFromBalance(txFee)
 	if err != nil {
 		return err
 	}
@@ -316,7 +322,7 @@ func (txProc *txProcessor) executingFailedTransaction(
 
 	rpt := &receipt.Receipt{
 		Value:   big.NewInt(0).Set(txFee),
-		SndAddr: tx.SndAddr,
+		SndAddr: feePayer.AddressBytes(),
 		Data:    []byte(txError.Error()),
 		TxHash:  txHash,
 	}
@@ -333,6 +339,13 @@ func (txProc *txProcessor) executingFailedTransaction(
 		return err
 	}
 
+	if feePayer != acntSnd {
+		err = txProc.accounts.SaveAccount(feePayer)
+		if err != nil {
+			return err
+		}
+	}
+
 	return process.ErrFailedTransaction
 }
 
@@ -360,9 +373,14 @@ func (txProc *txProcessor) createReceiptWithReturnedGas(
 		return nil
 	}
 
+	refundReceiver := tx.SndAddr
+	if common.IsRelayedTxV3(tx) {
+		refundReceiver = tx.RelayerAddr
+	}
+
 	rpt := &receipt.Receipt{
 		Value:   big.NewInt(0).Set(refundValue),
-		SndAddr: tx.SndAddr,
+		SndAddr: refundReceiver,
 		Data:    []byte(RefundGasMessage),
 		TxHash:  txHash,
 	}
@@ -406,6 +424,11 @@ func (txProc *txProcessor) processTxFee(
 		return currentShardFee, totalCost, nil
 	}
 
+	feePayer, err := txProc.getFeePayer(tx, acntSnd, acntDst)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	moveBalanceFee := txProc.economicsFee.ComputeMoveBalanceFee(tx)
 	totalCost := txProc.economicsFee.ComputeTxFee(tx)
 
@@ -417,12 +440,20 @@ func (txProc *txProcessor) processTxFee(
 	if dstShardTxType != process.MoveBalance ||
 		(!txProc.enableEpochsHandler.IsFlagEnabled(common.MetaProtectionFlag) && isCrossShardSCCall) {
 
-		err := acntSnd.SubFromBalance(totalCost)
+		err = feePayer.SubFromBalance(totalCost)
 		if err != nil {
 			return nil, nil, err
 		}
 	} else {
-		err := acntSnd.SubFromBalance(moveBalanceFee)
+		err = feePayer.SubFromBalance(moveBalanceFee)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// the sender and the receiver are saved by the caller
+	if feePayer != acntSnd && feePayer != acntDst {
+		err = txProc.accounts.SaveAccount(feePayer)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -720,6 +751,9 @@ func (txProc *txProcessor) processRelayedTx(
 	if !bytes.Equal(userTx.SndAddr, tx.RcvAddr) {
 		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver)
 	}
+	if common.IsRelayedTxV3(userTx) {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrMultipleRelayedTxTypesIsNotAllowed)
+	}
 
 	if userTx.Value.Cmp(tx.Value) < 0 {
 		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrRelayedTxValueHigherThenUserTxValue)
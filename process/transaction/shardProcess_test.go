@@ -2653,6 +2653,197 @@ func TestTxProcessor_ProcessRelayedTransactionDisabled(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestTxProcessor_ProcessRelayedTransactionV3(t *testing.T) {
+	t.Parallel()
+
+	relayerAddr := []byte("RLY")
+	createTx := func() *transaction.Transaction {
+		return &transaction.Transaction{
+			Nonce:       4,
+			SndAddr:     []byte("SRC"),
+			RcvAddr:     []byte("DST"),
+			RelayerAddr: relayerAddr,
+			Value:       big.NewInt(61),
+			GasPrice:    2,
+			GasLimit:    8,
+		}
+	}
+	type testSetup struct {
+		acntSrc     state.UserAccountHandler
+		acntDst     state.UserAccountHandler
+		acntRelayer state.UserAccountHandler
+		savedAcnts  map[string]int
+		receipts    []data.TransactionHandler
+		badTxs      []data.TransactionHandler
+		args        txproc.ArgsNewTxProcessor
+	}
+	createSetup := func(tx *transaction.Transaction, senderFunds int64, relayerFunds int64) *testSetup {
+		setup := &testSetup{
+			acntSrc:     createUserAcc(tx.SndAddr),
+			acntDst:     createUserAcc(tx.RcvAddr),
+			acntRelayer: createUserAcc(relayerAddr),
+			savedAcnts:  make(map[string]int),
+		}
+		setup.acntSrc.IncreaseNonce(4)
+		_ = setup.acntSrc.AddToBalance(big.NewInt(senderFunds))
+		_ = setup.acntRelayer.AddToBalance(big.NewInt(relayerFunds))
+
+		adb := createAccountStub(tx.SndAddr, tx.RcvAddr, setup.acntSrc, setup.acntDst)
+		adb.LoadAccountCalled = func(address []byte) (vmcommon.AccountHandler, error) {
+			switch string(address) {
+			case string(tx.SndAddr):
+				return setup.acntSrc, nil
+			case string(tx.RcvAddr):
+				return setup.acntDst, nil
+			case string(relayerAddr):
+				return setup.acntRelayer, nil
+			}
+			return nil, errors.New("failure")
+		}
+		adb.SaveAccountCalled = func(account vmcommon.AccountHandler) error {
+			setup.savedAcnts[string(account.AddressBytes())]++
+			return nil
+		}
+
+		setup.args = createArgsForTxProcessor()
+		setup.args.Accounts = adb
+		setup.args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(
+			common.PenalizedTooMuchGasFlag,
+			common.FixRelayedBaseCostFlag,
+			common.RelayedTransactionsV3Flag,
+		)
+		setup.args.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+			ComputeMoveBalanceFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+				return big.NewInt(10)
+			},
+			ComputeTxFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+				return big.NewInt(16)
+			},
+		}
+		setup.args.ReceiptForwarder = &mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler, key []byte) error {
+				setup.receipts = append(setup.receipts, txs...)
+				return nil
+			},
+		}
+		setup.args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler, key []byte) error {
+				setup.badTxs = append(setup.badTxs, txs...)
+				return nil
+			},
+		}
+
+		return setup
+	}
+
+	t.Run("move balance should charge the fee to the relayer and the value to the sender", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		setup := createSetup(tx, 61, 16)
+		txProc, _ := txproc.NewTxProcessor(setup.args)
+
+		returnCode, err := txProc.ProcessTransaction(tx)
+		require.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, returnCode)
+		assert.Equal(t, uint64(5), setup.acntSrc.GetNonce())
+		assert.Equal(t, big.NewInt(0), setup.acntSrc.GetBalance())
+		assert.Equal(t, big.NewInt(61), setup.acntDst.GetBalance())
+		assert.Equal(t, uint64(0), setup.acntRelayer.GetNonce())
+		assert.Equal(t, big.NewInt(6), setup.acntRelayer.GetBalance())
+		assert.Equal(t, 1, setup.savedAcnts[string(relayerAddr)])
+
+		// the unused gas is returned to the relayer
+		require.Len(t, setup.receipts, 1)
+		assert.Equal(t, relayerAddr, setup.receipts[0].GetSndAddr())
+		assert.Equal(t, big.NewInt(6), setup.receipts[0].GetValue())
+	})
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		setup := createSetup(tx, 61, 16)
+		setup.args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.PenalizedTooMuchGasFlag)
+		txProc, _ := txproc.NewTxProcessor(setup.args)
+
+		_, err := txProc.ProcessTransaction(tx)
+		assert.Equal(t, process.ErrRelayedTxV3Disabled, err)
+		assert.Equal(t, big.NewInt(16), setup.acntRelayer.GetBalance())
+	})
+	t.Run("guarded relayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		setup := createSetup(tx, 61, 16)
+		setup.acntRelayer.SetCodeMetadata((&vmcommon.CodeMetadata{Guarded: true}).ToBytes())
+		txProc, _ := txproc.NewTxProcessor(setup.args)
+
+		_, err := txProc.ProcessTransaction(tx)
+		assert.Equal(t, process.ErrGuardedRelayerNotAllowed, err)
+		assert.Equal(t, big.NewInt(16), setup.acntRelayer.GetBalance())
+	})
+	t.Run("relayed tx v3 carrying a relayed tx should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		setup := createSetup(tx, 61, 16)
+		setup.args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return process.RelayedTx, process.RelayedTx
+			},
+		}
+		txProc, _ := txproc.NewTxProcessor(setup.args)
+
+		_, err := txProc.ProcessTransaction(tx)
+		assert.Equal(t, process.ErrMultipleRelayedTxTypesIsNotAllowed, err)
+	})
+	t.Run("relayer without funds for the fee should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		setup := createSetup(tx, 100, 15)
+		txProc, _ := txproc.NewTxProcessor(setup.args)
+
+		_, err := txProc.ProcessTransaction(tx)
+		assert.ErrorIs(t, err, process.ErrInsufficientFee)
+		assert.Equal(t, big.NewInt(100), setup.acntSrc.GetBalance())
+		assert.Equal(t, big.NewInt(15), setup.acntRelayer.GetBalance())
+	})
+	t.Run("sender without funds for the value should charge the fee to the relayer", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		setup := createSetup(tx, 60, 100)
+		txProc, _ := txproc.NewTxProcessor(setup.args)
+
+		_, err := txProc.ProcessTransaction(tx)
+		assert.Equal(t, process.ErrFailedTransaction, err)
+		assert.Equal(t, uint64(5), setup.acntSrc.GetNonce())
+		assert.Equal(t, big.NewInt(60), setup.acntSrc.GetBalance())
+		assert.Equal(t, big.NewInt(84), setup.acntRelayer.GetBalance())
+		assert.Equal(t, 1, setup.savedAcnts[string(relayerAddr)])
+		assert.Equal(t, 1, setup.savedAcnts[string(tx.SndAddr)])
+		require.Len(t, setup.badTxs, 1)
+		require.Len(t, setup.receipts, 1)
+		assert.Equal(t, relayerAddr, setup.receipts[0].GetSndAddr())
+		assert.Equal(t, big.NewInt(16), setup.receipts[0].GetValue())
+	})
+	t.Run("relayer being the receiver should reuse the loaded account", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createTx()
+		tx.RelayerAddr = tx.RcvAddr
+		setup := createSetup(tx, 61, 0)
+		_ = setup.acntDst.AddToBalance(big.NewInt(16))
+		txProc, _ := txproc.NewTxProcessor(setup.args)
+
+		_, err := txProc.ProcessTransaction(tx)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(67), setup.acntDst.GetBalance())
+		assert.Equal(t, 1, setup.savedAcnts[string(tx.RcvAddr)])
+	})
+}
+
 func TestTxProcessor_ConsumeMoveBalanceWithUserTx(t *testing.T) {
 	t.Parallel()
 
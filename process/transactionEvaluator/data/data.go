@@ -1,12 +1,30 @@
 package data
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 // SimulationResultsWithVMOutput is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResultsWithVMOutput struct {
 	transaction.SimulationResults
-	VMOutput *vmcommon.VMOutput `json:"-"`
+	VMOutput       *vmcommon.VMOutput      `json:"-"`
+	GasUnits       uint64                  `json:"gasUnits,omitempty"`
+	ExecutionTrace *tracing.ExecutionTrace `json:"executionTrace,omitempty"`
+}
+
+// TransactionReplayData holds everything needed to re-execute a historical transaction against the state it was
+// executed on: the root hash of the previous block, the scheduled transactions of the previous block, executed on top
+// of that root hash, and the transactions executed before the target one in its own block, in execution order
+type TransactionReplayData struct {
+	Transaction                         *transaction.Transaction
+	PrecedingTransactions               []*transaction.Transaction
+	Header                              data.HeaderHandler
+	PreviousHeader                      data.HeaderHandler
+	PreviousHeaderScheduledTransactions []*transaction.Transaction
+	RootHash                            []byte
+	RootHashEpoch                       core.OptionalUint32
 }
@@ -28,3 +28,18 @@ var ErrNilHasher = errors.New("nil hasher provided")
 
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
+
+// ErrEmptyTransactionsSequence signals that an empty sequence of transactions has been provided
+var ErrEmptyTransactionsSequence = errors.New("empty transactions sequence")
+
+// ErrNilExecutionTraceCollector signals that a nil execution trace collector has been provided
+var ErrNilExecutionTraceCollector = errors.New("nil execution trace collector")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrNilAccountFactory signals that a nil account factory has been provided
+var ErrNilAccountFactory = errors.New("nil account factory")
+
+// ErrNilTransactionReplayData signals that nil transaction replay data has been provided
+var ErrNilTransactionReplayData = errors.New("nil transaction replay data")
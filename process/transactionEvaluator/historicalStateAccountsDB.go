@@ -0,0 +1,149 @@
+package transactionEvaluator
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// historicalStateAccountsDB is a wrapper over an accounts adapter which, on request, serves the accounts from a past
+// root hash instead of the current state. It works read-only, the writes being handled by the simulation accounts db
+type historicalStateAccountsDB struct {
+	state.AccountsAdapter
+	accountsRepository state.AccountsRepository
+	accountFactory     state.AccountFactory
+	mutHistoricalState sync.RWMutex
+	queryOptions       *api.AccountQueryOptions
+}
+
+// NewHistoricalStateAccountsDB creates a new accounts adapter able to temporarily serve a past state
+func NewHistoricalStateAccountsDB(
+	accountsDB state.AccountsAdapter,
+	accountsRepository state.AccountsRepository,
+	accountFactory state.AccountFactory,
+) (*historicalStateAccountsDB, error) {
+	if check.IfNil(accountsDB) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(accountsRepository) {
+		return nil, ErrNilAccountsRepository
+	}
+	if check.IfNil(accountFactory) {
+		return nil, ErrNilAccountFactory
+	}
+
+	return &historicalStateAccountsDB{
+		AccountsAdapter:    accountsDB,
+		accountsRepository: accountsRepository,
+		accountFactory:     accountFactory,
+	}, nil
+}
+
+// SetHistoricalState will make all the next reads to be done on the state identified by the provided root hash,
+// until ResetHistoricalState is called
+func (adb *historicalStateAccountsDB) SetHistoricalState(rootHashHolder common.RootHashHolder) error {
+	if check.IfNil(rootHashHolder) {
+		return state.ErrNilRootHashHolder
+	}
+	if len(rootHashHolder.GetRootHash()) == 0 {
+		return state.ErrNilRootHash
+	}
+
+	adb.mutHistoricalState.Lock()
+	adb.queryOptions = &api.AccountQueryOptions{
+		BlockRootHash: rootHashHolder.GetRootHash(),
+		HintEpoch:     rootHashHolder.GetEpoch(),
+	}
+	adb.mutHistoricalState.Unlock()
+
+	return nil
+}
+
+// ResetHistoricalState will make all the next reads to be done on the current state
+func (adb *historicalStateAccountsDB) ResetHistoricalState() {
+	adb.mutHistoricalState.Lock()
+	adb.queryOptions = nil
+	adb.mutHistoricalState.Unlock()
+}
+
+func (adb *historicalStateAccountsDB) getQueryOptions() (api.AccountQueryOptions, bool) {
+	adb.mutHistoricalState.RLock()
+	defer adb.mutHistoricalState.RUnlock()
+
+	if adb.queryOptions == nil {
+		return api.AccountQueryOptions{}, false
+	}
+
+	return *adb.queryOptions, true
+}
+
+// GetExistingAccount returns the account from the historical state, if set, otherwise from the current state
+func (adb *historicalStateAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	options, isHistorical := adb.getQueryOptions()
+	if !isHistorical {
+		return adb.AccountsAdapter.GetExistingAccount(address)
+	}
+
+	account, _, err := adb.accountsRepository.GetAccountWithBlockInfo(address, options)
+	if isAccountNotFoundAtBlock(err) {
+		return nil, state.ErrAccNotFound
+	}
+
+	return account, err
+}
+
+// LoadAccount returns the account from the historical state, if set, otherwise from the current state.
+// A new account is created if the address is not found in the historical state
+func (adb *historicalStateAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	options, isHistorical := adb.getQueryOptions()
+	if !isHistorical {
+		return adb.AccountsAdapter.LoadAccount(address)
+	}
+
+	account, _, err := adb.accountsRepository.GetAccountWithBlockInfo(address, options)
+	if isAccountNotFoundAtBlock(err) {
+		return adb.accountFactory.CreateAccount(address)
+	}
+
+	return account, err
+}
+
+// GetCode returns the code from the historical state, if set, otherwise from the current state
+func (adb *historicalStateAccountsDB) GetCode(codeHash []byte) []byte {
+	options, isHistorical := adb.getQueryOptions()
+	if !isHistorical {
+		return adb.AccountsAdapter.GetCode(codeHash)
+	}
+
+	code, _, err := adb.accountsRepository.GetCodeWithBlockInfo(codeHash, options)
+	if err != nil {
+		log.Warn("historicalStateAccountsDB.GetCode", "error", err)
+	}
+
+	return code
+}
+
+// RootHash returns the historical root hash, if set, otherwise the current one
+func (adb *historicalStateAccountsDB) RootHash() ([]byte, error) {
+	options, isHistorical := adb.getQueryOptions()
+	if !isHistorical {
+		return adb.AccountsAdapter.RootHash()
+	}
+
+	return options.BlockRootHash, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adb *historicalStateAccountsDB) IsInterfaceNil() bool {
+	return adb == nil
+}
+
+func isAccountNotFoundAtBlock(err error) bool {
+	var errAccountNotFound *state.ErrAccountNotFoundAtBlock
+	return errors.As(err, &errAccountNotFound)
+}
@@ -0,0 +1,171 @@
+package transactionEvaluator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/state"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHistoricalStateAccountsDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		adb, err := NewHistoricalStateAccountsDB(nil, &stateMock.AccountsRepositoryStub{}, &stateMock.AccountsFactoryStub{})
+		require.True(t, check.IfNil(adb))
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		adb, err := NewHistoricalStateAccountsDB(&stateMock.AccountsStub{}, nil, &stateMock.AccountsFactoryStub{})
+		require.True(t, check.IfNil(adb))
+		require.Equal(t, ErrNilAccountsRepository, err)
+	})
+	t.Run("nil account factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		adb, err := NewHistoricalStateAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, nil)
+		require.True(t, check.IfNil(adb))
+		require.Equal(t, ErrNilAccountFactory, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		adb, err := NewHistoricalStateAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &stateMock.AccountsFactoryStub{})
+		require.False(t, check.IfNil(adb))
+		require.NoError(t, err)
+	})
+}
+
+func TestHistoricalStateAccountsDB_SetHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := NewHistoricalStateAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &stateMock.AccountsFactoryStub{})
+
+	err := adb.SetHistoricalState(nil)
+	require.Equal(t, state.ErrNilRootHashHolder, err)
+
+	err = adb.SetHistoricalState(holders.NewDefaultRootHashesHolder(nil))
+	require.Equal(t, state.ErrNilRootHash, err)
+
+	err = adb.SetHistoricalState(holders.NewDefaultRootHashesHolder([]byte("root hash")))
+	require.NoError(t, err)
+}
+
+func TestHistoricalStateAccountsDB_ReadsShouldBeServedFromTheSelectedState(t *testing.T) {
+	t.Parallel()
+
+	currentAccount := &stateMock.UserAccountStub{Address: []byte("current")}
+	historicalAccount := &stateMock.UserAccountStub{Address: []byte("historical")}
+	newAccount := &stateMock.UserAccountStub{Address: []byte("new")}
+	missingAddress := []byte("missing")
+	rootHash := []byte("historical root hash")
+	epoch := core.OptionalUint32{Value: 37, HasValue: true}
+
+	accDb := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return currentAccount, nil
+		},
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return currentAccount, nil
+		},
+		GetCodeCalled: func(_ []byte) []byte {
+			return []byte("current code")
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("current root hash"), nil
+		},
+	}
+	checkOptions := func(options api.AccountQueryOptions) {
+		require.Equal(t, rootHash, options.BlockRootHash)
+		require.Equal(t, epoch, options.HintEpoch)
+	}
+	repository := &stateMock.AccountsRepositoryStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			checkOptions(options)
+			if string(address) == string(missingAddress) {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(holders.NewBlockInfo(nil, 0, rootHash))
+			}
+
+			return historicalAccount, nil, nil
+		},
+		GetCodeWithBlockInfoCalled: func(_ []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error) {
+			checkOptions(options)
+			return []byte("historical code"), nil, nil
+		},
+	}
+	factory := &stateMock.AccountsFactoryStub{
+		CreateAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return newAccount, nil
+		},
+	}
+	adb, _ := NewHistoricalStateAccountsDB(accDb, repository, factory)
+
+	account, err := adb.GetExistingAccount([]byte("address"))
+	require.NoError(t, err)
+	require.Equal(t, currentAccount, account)
+
+	err = adb.SetHistoricalState(holders.NewRootHashHolder(rootHash, epoch))
+	require.NoError(t, err)
+
+	account, err = adb.GetExistingAccount([]byte("address"))
+	require.NoError(t, err)
+	require.Equal(t, historicalAccount, account)
+
+	account, err = adb.GetExistingAccount(missingAddress)
+	require.Equal(t, state.ErrAccNotFound, err)
+	require.Nil(t, account)
+
+	account, err = adb.LoadAccount([]byte("address"))
+	require.NoError(t, err)
+	require.Equal(t, historicalAccount, account)
+
+	account, err = adb.LoadAccount(missingAddress)
+	require.NoError(t, err)
+	require.Equal(t, newAccount, account)
+
+	require.Equal(t, []byte("historical code"), adb.GetCode([]byte("code hash")))
+	currentRootHash, _ := adb.RootHash()
+	require.Equal(t, rootHash, currentRootHash)
+
+	adb.ResetHistoricalState()
+
+	account, err = adb.LoadAccount([]byte("address"))
+	require.NoError(t, err)
+	require.Equal(t, currentAccount, account)
+	require.Equal(t, []byte("current code"), adb.GetCode([]byte("code hash")))
+	currentRootHash, _ = adb.RootHash()
+	require.Equal(t, []byte("current root hash"), currentRootHash)
+}
+
+func TestHistoricalStateAccountsDB_GetExistingAccountErrorShouldBePropagated(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	repository := &stateMock.AccountsRepositoryStub{
+		GetAccountWithBlockInfoCalled: func(_ []byte, _ api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			return nil, nil, expectedErr
+		},
+	}
+	adb, _ := NewHistoricalStateAccountsDB(&stateMock.AccountsStub{}, repository, &stateMock.AccountsFactoryStub{})
+	_ = adb.SetHistoricalState(holders.NewDefaultRootHashesHolder([]byte("root hash")))
+
+	account, err := adb.GetExistingAccount([]byte("address"))
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, account)
+
+	account, err = adb.LoadAccount([]byte("address"))
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, account)
+}
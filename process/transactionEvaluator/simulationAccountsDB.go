@@ -6,6 +6,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/stateOverrides"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -14,19 +16,29 @@ import (
 type simulationAccountsDB struct {
 	mutex            sync.RWMutex
 	cachedAccounts   map[string]vmcommon.AccountHandler
+	overriddenCodes  map[string][]byte
 	originalAccounts state.AccountsAdapter
+	overridesApplier stateOverrides.AccountOverridesApplier
 }
 
 // NewSimulationAccountsDB returns a new instance of simulationAccountsDB
-func NewSimulationAccountsDB(accountsDB state.AccountsAdapter) (*simulationAccountsDB, error) {
+func NewSimulationAccountsDB(
+	accountsDB state.AccountsAdapter,
+	overridesApplier stateOverrides.AccountOverridesApplier,
+) (*simulationAccountsDB, error) {
 	if check.IfNil(accountsDB) {
 		return nil, ErrNilAccountsAdapter
 	}
+	if check.IfNil(overridesApplier) {
+		return nil, stateOverrides.ErrNilOverridesApplier
+	}
 
 	return &simulationAccountsDB{
 		mutex:            sync.RWMutex{},
 		cachedAccounts:   make(map[string]vmcommon.AccountHandler),
+		overriddenCodes:  make(map[string][]byte),
 		originalAccounts: accountsDB,
+		overridesApplier: overridesApplier,
 	}, nil
 }
 
@@ -42,6 +54,13 @@ func (r *simulationAccountsDB) StartSnapshotIfNeeded() error {
 
 // GetCode returns the code for the given account
 func (r *simulationAccountsDB) GetCode(codeHash []byte) []byte {
+	r.mutex.RLock()
+	code, ok := r.overriddenCodes[string(codeHash)]
+	r.mutex.RUnlock()
+	if ok {
+		return code
+	}
+
 	return r.originalAccounts.GetCode(codeHash)
 }
 
@@ -177,13 +196,73 @@ func (r *simulationAccountsDB) IsInterfaceNil() bool {
 	return r == nil
 }
 
-// CleanCache will clean the internal map with the cached accounts
+// CleanCache will clean the internal map with the cached accounts, together with all the state overrides
 func (r *simulationAccountsDB) CleanCache() {
 	r.mutex.Lock()
 	r.cachedAccounts = make(map[string]vmcommon.AccountHandler)
+	r.overriddenCodes = make(map[string][]byte)
 	r.mutex.Unlock()
 }
 
+// SetStateOverrides will load the overridden accounts, apply the overrides on them and keep them in the internal
+// cache, so the next simulations will see the overridden values until the cache is cleaned
+func (r *simulationAccountsDB) SetStateOverrides(overrides common.StateOverrides) error {
+	for address, override := range overrides {
+		account, err := r.LoadAccount([]byte(address))
+		if err != nil {
+			return err
+		}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		codeHash, err := r.overridesApplier.ApplyOverride(userAccount, override)
+		if err != nil {
+			return err
+		}
+
+		r.addToCache(userAccount)
+		if len(codeHash) > 0 {
+			r.mutex.Lock()
+			r.overriddenCodes[string(codeHash)] = override.Code
+			r.mutex.Unlock()
+		}
+	}
+
+	return nil
+}
+
+// CleanStateOverrides will remove all the state overrides together with all the cached accounts
+func (r *simulationAccountsDB) CleanStateOverrides() {
+	r.CleanCache()
+}
+
+// SetHistoricalState will clean the cached accounts and will make the next simulations read the state identified by
+// the provided root hash, if the original accounts adapter supports it
+func (r *simulationAccountsDB) SetHistoricalState(rootHashHolder common.RootHashHolder) error {
+	historicalStateHandler, ok := r.originalAccounts.(process.HistoricalStateHandler)
+	if !ok {
+		return process.ErrHistoricalStateNotSupported
+	}
+
+	r.CleanCache()
+
+	return historicalStateHandler.SetHistoricalState(rootHashHolder)
+}
+
+// ResetHistoricalState will clean the cached accounts and will make the next simulations read the current state
+func (r *simulationAccountsDB) ResetHistoricalState() {
+	historicalStateHandler, ok := r.originalAccounts.(process.HistoricalStateHandler)
+	if !ok {
+		return
+	}
+
+	r.CleanCache()
+	historicalStateHandler.ResetHistoricalState()
+}
+
 func (r *simulationAccountsDB) addToCache(account vmcommon.AccountHandler) {
 	r.mutex.Lock()
 	r.cachedAccounts[string(account.AddressBytes())] = account
@@ -2,12 +2,18 @@ package transactionEvaluator
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/stateOverrides"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/parsers"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
@@ -18,15 +24,23 @@ import (
 func TestNewReadOnlyAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
 	t.Parallel()
 
-	simAccountsDB, err := NewSimulationAccountsDB(nil)
+	simAccountsDB, err := NewSimulationAccountsDB(nil, &stateMock.AccountOverridesApplierStub{})
 	require.True(t, check.IfNil(simAccountsDB))
 	require.Equal(t, ErrNilAccountsAdapter, err)
 }
 
+func TestNewReadOnlyAccountsDB_NilOverridesApplierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, nil)
+	require.True(t, check.IfNil(simAccountsDB))
+	require.Equal(t, stateOverrides.ErrNilOverridesApplier, err)
+}
+
 func TestNewReadOnlyAccountsDB(t *testing.T) {
 	t.Parallel()
 
-	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{})
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountOverridesApplierStub{})
 	require.False(t, check.IfNil(simAccountsDB))
 	require.NoError(t, err)
 }
@@ -71,7 +85,7 @@ func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 		},
 	}
 
-	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountOverridesApplierStub{})
 	require.NotNil(t, simAccountsDB)
 
 	err := simAccountsDB.SaveAccount(nil)
@@ -124,7 +138,7 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		},
 	}
 
-	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountOverridesApplierStub{})
 	require.NotNil(t, simAccountsDB)
 
 	actualAcc, err := simAccountsDB.GetExistingAccount(nil)
@@ -155,3 +169,140 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = allLeaves.ErrChan.ReadFromChanNonBlocking()
 	require.NoError(t, err)
 }
+
+func TestSimulationAccountsDB_SetStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	overriddenCode := []byte("overridden code")
+	overriddenCodeHash := []byte("overridden code hash")
+	originalCode := []byte("original code")
+
+	t.Run("load account fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return nil, expectedErr
+			},
+		}
+		simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountOverridesApplierStub{})
+
+		err := simAccountsDB.SetStateOverrides(common.StateOverrides{
+			string(address): &common.AccountStateOverride{},
+		})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("apply override fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Address: address}, nil
+			},
+		}
+		applier := &stateMock.AccountOverridesApplierStub{
+			ApplyOverrideCalled: func(_ state.UserAccountHandler, _ *common.AccountStateOverride) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		simAccountsDB, _ := NewSimulationAccountsDB(accDb, applier)
+
+		err := simAccountsDB.SetStateOverrides(common.StateOverrides{
+			string(address): &common.AccountStateOverride{},
+		})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should serve the overridden account and code until cleaned", func(t *testing.T) {
+		t.Parallel()
+
+		loadAccountCalls := 0
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				loadAccountCalls++
+				return &stateMock.UserAccountStub{Address: address}, nil
+			},
+			GetCodeCalled: func(_ []byte) []byte {
+				return originalCode
+			},
+		}
+		applier := &stateMock.AccountOverridesApplierStub{
+			ApplyOverrideCalled: func(account state.UserAccountHandler, override *common.AccountStateOverride) ([]byte, error) {
+				require.Equal(t, address, account.AddressBytes())
+				require.Equal(t, overriddenCode, override.Code)
+				return overriddenCodeHash, nil
+			},
+		}
+		simAccountsDB, _ := NewSimulationAccountsDB(accDb, applier)
+
+		err := simAccountsDB.SetStateOverrides(common.StateOverrides{
+			string(address): &common.AccountStateOverride{Code: overriddenCode},
+		})
+		require.NoError(t, err)
+		require.Equal(t, overriddenCode, simAccountsDB.GetCode(overriddenCodeHash))
+
+		_, err = simAccountsDB.LoadAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, 1, loadAccountCalls)
+
+		simAccountsDB.CleanStateOverrides()
+		require.Equal(t, originalCode, simAccountsDB.GetCode(overriddenCodeHash))
+
+		_, err = simAccountsDB.LoadAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, 2, loadAccountCalls)
+	})
+}
+
+func TestSimulationAccountsDB_SetHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("original accounts db without historical state support should error", func(t *testing.T) {
+		t.Parallel()
+
+		simAccountsDB, _ := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountOverridesApplierStub{})
+
+		err := simAccountsDB.SetHistoricalState(holders.NewDefaultRootHashesHolder([]byte("root hash")))
+		require.Equal(t, process.ErrHistoricalStateNotSupported, err)
+
+		simAccountsDB.ResetHistoricalState()
+	})
+	t.Run("should read from the historical state until reset", func(t *testing.T) {
+		t.Parallel()
+
+		address := []byte("address")
+		rootHash := []byte("historical root hash")
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Address: address, Balance: big.NewInt(1)}, nil
+			},
+		}
+		repository := &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(_ []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				require.Equal(t, rootHash, options.BlockRootHash)
+				return &stateMock.UserAccountStub{Address: address, Balance: big.NewInt(2)}, nil, nil
+			},
+		}
+		historicalAccountsDB, _ := NewHistoricalStateAccountsDB(accDb, repository, &stateMock.AccountsFactoryStub{})
+		simAccountsDB, _ := NewSimulationAccountsDB(historicalAccountsDB, &stateMock.AccountOverridesApplierStub{})
+
+		account, err := simAccountsDB.LoadAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1), account.(state.UserAccountHandler).GetBalance())
+
+		err = simAccountsDB.SetHistoricalState(holders.NewDefaultRootHashesHolder(rootHash))
+		require.NoError(t, err)
+
+		account, err = simAccountsDB.LoadAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(2), account.(state.UserAccountHandler).GetBalance())
+
+		simAccountsDB.ResetHistoricalState()
+
+		account, err = simAccountsDB.LoadAccount(address)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1), account.(state.UserAccountHandler).GetBalance())
+	})
+}
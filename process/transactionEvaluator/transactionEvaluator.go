@@ -1,6 +1,7 @@
 package transactionEvaluator
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -12,6 +13,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
@@ -19,6 +22,8 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const dummySignature = "01010101"
@@ -34,6 +39,7 @@ type ArgsApiTransactionEvaluator struct {
 	ShardCoordinator    sharding.Coordinator
 	EnableEpochsHandler common.EnableEpochsHandler
 	BlockChain          data.ChainHandler
+	ExecutionTracer     ExecutionTraceCollector
 }
 
 type apiTransactionEvaluator struct {
@@ -44,6 +50,7 @@ type apiTransactionEvaluator struct {
 	txSimulator         facade.TransactionSimulatorProcessor
 	enableEpochsHandler common.EnableEpochsHandler
 	blockChain          data.ChainHandler
+	executionTracer     ExecutionTraceCollector
 	mutExecution        sync.RWMutex
 }
 
@@ -70,6 +77,9 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, ErrNilExecutionTraceCollector
+	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.CleanUpInformativeSCRsFlag,
 	})
@@ -85,40 +95,256 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 		shardCoordinator:    args.ShardCoordinator,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		blockChain:          args.BlockChain,
+		executionTracer:     args.ExecutionTracer,
 	}
 
 	return tce, nil
 }
 
-// SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+// SimulateTransactionExecution will simulate a transaction's execution, on top of the optionally provided state
+// overrides, and will return the results
+func (ate *apiTransactionEvaluator) SimulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	ctx, span := tracing.StartSpan(ctx, "transactionEvaluator.SimulateTransactionExecution")
+	results, err := ate.simulateTransactionExecution(ctx, tx, stateOverrides)
+	tracing.EndSpan(span, err)
+
+	return results, err
+}
+
+func (ate *apiTransactionEvaluator) simulateTransactionExecution(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.cleanStateOverrides(stateOverrides)
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+	// the simulations are executed one at a time, so the time spent waiting for the previous ones is part of the latency
+	trace.SpanFromContext(ctx).AddEvent("execution lock acquired")
+
+	err := ate.setStateOverrides(stateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHeader := ate.getCurrentBlockHeader()
+
+	return ate.processTx(ctx, tx, currentHeader)
+}
+
+func (ate *apiTransactionEvaluator) processTx(ctx context.Context, tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+	_, span := tracing.StartSpan(ctx, "txSimulator.ProcessTx")
+	results, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+	tracing.EndSpan(span, err)
+
+	return results, err
+}
+
+// TraceTransactionExecution will simulate a transaction's execution, on top of the optionally provided state
+// overrides, and will return the results together with the call tree of the triggered smart contract executions
+func (ate *apiTransactionEvaluator) TraceTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*txSimData.SimulationResultsWithVMOutput, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.cleanStateOverrides(stateOverrides)
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	err := ate.setStateOverrides(stateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHeader := ate.getCurrentBlockHeader()
+
+	ate.executionTracer.StartTracing()
+	results, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+	trace := ate.executionTracer.StopTracing()
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		results = &txSimData.SimulationResultsWithVMOutput{}
+	}
+
+	results.ExecutionTrace = trace
+
+	return results, nil
+}
+
+// ReplayTransaction will re-execute a historical transaction, with tracing, against the state it was executed on.
+// The state of the previous block is loaded, the scheduled transactions of the previous block and the transactions
+// executed before the target one in its block are replayed first. All the changes are discarded afterwards
+func (ate *apiTransactionEvaluator) ReplayTransaction(replayData *txSimData.TransactionReplayData) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if replayData == nil || replayData.Transaction == nil || check.IfNil(replayData.Header) {
+		return nil, ErrNilTransactionReplayData
+	}
+	if len(replayData.PreviousHeaderScheduledTransactions) > 0 && check.IfNil(replayData.PreviousHeader) {
+		return nil, ErrNilTransactionReplayData
+	}
+
+	historicalStateHandler, ok := ate.accounts.(process.HistoricalStateHandler)
+	if !ok {
+		return nil, process.ErrHistoricalStateNotSupported
+	}
+
+	ate.mutExecution.Lock()
+	defer func() {
+		historicalStateHandler.ResetHistoricalState()
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	rootHashHolder := holders.NewRootHashHolder(replayData.RootHash, replayData.RootHashEpoch)
+	err := historicalStateHandler.SetHistoricalState(rootHashHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	for index, tx := range replayData.PreviousHeaderScheduledTransactions {
+		_, err = ate.txSimulator.ProcessTx(tx, replayData.PreviousHeader)
+		if err != nil {
+			return nil, fmt.Errorf("%w while replaying the previous block scheduled transaction with index %d", err, index)
+		}
+	}
+
+	for index, tx := range replayData.PrecedingTransactions {
+		_, err = ate.txSimulator.ProcessTx(tx, replayData.Header)
+		if err != nil {
+			return nil, fmt.Errorf("%w while replaying the preceding transaction with index %d", err, index)
+		}
+	}
+
+	ate.executionTracer.StartTracing()
+	results, err := ate.txSimulator.ProcessTx(replayData.Transaction, replayData.Header)
+	trace := ate.executionTracer.StopTracing()
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		results = &txSimData.SimulationResultsWithVMOutput{}
+	}
+
+	results.ExecutionTrace = trace
+
+	return results, nil
+}
+
+// SimulateTransactionsSequence will simulate the execution of the provided ordered transactions, on top of the
+// optionally provided state overrides, each one of them seeing the state changes produced by the previous ones.
+// The state overrides are applied once, before the first transaction. All the changes are discarded after the last
+// transaction
+func (ate *apiTransactionEvaluator) SimulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+	ctx, span := tracing.StartSpan(ctx, "transactionEvaluator.SimulateTransactionsSequence", attribute.Int("numTxs", len(txs)))
+	results, err := ate.simulateTransactionsSequence(ctx, txs, stateOverrides)
+	tracing.EndSpan(span, err)
+
+	return results, err
+}
+
+func (ate *apiTransactionEvaluator) simulateTransactionsSequence(ctx context.Context, txs []*transaction.Transaction, stateOverrides common.StateOverrides) ([]*txSimData.SimulationResultsWithVMOutput, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyTransactionsSequence
+	}
+
 	ate.mutExecution.Lock()
 	defer func() {
+		ate.cleanStateOverrides(stateOverrides)
 		ate.accounts.CleanCache()
 		ate.mutExecution.Unlock()
 	}()
+	trace.SpanFromContext(ctx).AddEvent("execution lock acquired")
+
+	err := ate.setStateOverrides(stateOverrides)
+	if err != nil {
+		return nil, err
+	}
 
 	currentHeader := ate.getCurrentBlockHeader()
+	results := make([]*txSimData.SimulationResultsWithVMOutput, 0, len(txs))
+	for idx, tx := range txs {
+		res, err := ate.processTx(ctx, tx, currentHeader)
+		if err != nil {
+			return nil, fmt.Errorf("%w for transaction at index %d", err, idx)
+		}
+
+		res.GasUnits = ate.computeSimulatedGasUnits(tx, res)
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (ate *apiTransactionEvaluator) computeSimulatedGasUnits(tx *transaction.Transaction, res *txSimData.SimulationResultsWithVMOutput) uint64 {
+	if res.FailReason != "" {
+		return 0
+	}
+	if res.VMOutput == nil {
+		return ate.feeHandler.ComputeGasLimit(tx)
+	}
+	if res.VMOutput.ReturnCode != vmcommon.Ok {
+		return 0
+	}
+
+	return ate.computeGasUnitsBasedOnVMOutput(tx, res.VMOutput)
+}
+
+func (ate *apiTransactionEvaluator) setStateOverrides(overrides common.StateOverrides) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	overridesHandler, ok := ate.accounts.(process.StateOverridesHandler)
+	if !ok {
+		return process.ErrStateOverridesNotSupported
+	}
+
+	return overridesHandler.SetStateOverrides(overrides)
+}
+
+func (ate *apiTransactionEvaluator) cleanStateOverrides(overrides common.StateOverrides) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	overridesHandler, ok := ate.accounts.(process.StateOverridesHandler)
+	if ok {
+		overridesHandler.CleanStateOverrides()
+	}
+}
+
+// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume, on top of the optionally
+// provided state overrides
+func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "transactionEvaluator.ComputeTransactionGasLimit")
+	cost, err := ate.computeTransactionGasLimit(ctx, tx, stateOverrides)
+	tracing.EndSpan(span, err)
 
-	return ate.txSimulator.ProcessTx(tx, currentHeader)
+	return cost, err
 }
 
-// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
-func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (ate *apiTransactionEvaluator) computeTransactionGasLimit(ctx context.Context, tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
 	ate.mutExecution.Lock()
 	defer func() {
+		ate.cleanStateOverrides(stateOverrides)
 		ate.accounts.CleanCache()
 		ate.mutExecution.Unlock()
 	}()
+	trace.SpanFromContext(ctx).AddEvent("execution lock acquired")
+
+	err := ate.setStateOverrides(stateOverrides)
+	if err != nil {
+		return nil, err
+	}
 
 	txTypeOnSender, txTypeOnDestination := ate.txTypeHandler.ComputeTransactionType(tx)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("txType", txTypeOnSender.String()))
 	if txTypeOnSender == process.MoveBalance && txTypeOnDestination == process.MoveBalance {
 		return ate.computeMoveBalanceCost(tx), nil
 	}
 
 	switch txTypeOnSender {
 	case process.SCDeployment, process.SCInvoking, process.BuiltInFunctionCall, process.MoveBalance:
-		return ate.simulateTransactionCost(tx, txTypeOnSender)
+		return ate.simulateTransactionCost(ctx, tx, txTypeOnSender)
 	case process.RelayedTx, process.RelayedTxV2:
 		// TODO implement in the next PR
 		return &transaction.CostResponse{
@@ -142,7 +368,7 @@ func (ate *apiTransactionEvaluator) computeMoveBalanceCost(tx *transaction.Trans
 	}
 }
 
-func (ate *apiTransactionEvaluator) simulateTransactionCost(tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, error) {
+func (ate *apiTransactionEvaluator) simulateTransactionCost(ctx context.Context, tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, error) {
 	err := ate.addMissingFieldsIfNeeded(tx)
 	if err != nil {
 		return nil, err
@@ -150,7 +376,7 @@ func (ate *apiTransactionEvaluator) simulateTransactionCost(tx *transaction.Tran
 
 	costResponse := &transaction.CostResponse{}
 	currentHeader := ate.getCurrentBlockHeader()
-	res, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+	res, err := ate.processTx(ctx, tx, currentHeader)
 	if err != nil {
 		costResponse.ReturnMessage = err.Error()
 		return costResponse, nil
@@ -250,12 +476,19 @@ func (ate *apiTransactionEvaluator) getTxGasLimit(tx *transaction.Transaction) (
 	selfShardID := ate.shardCoordinator.SelfId()
 	maxGasLimitPerBlock := ate.feeHandler.MaxGasLimitPerBlock(selfShardID) - 1
 
-	senderShardID := ate.shardCoordinator.ComputeId(tx.SndAddr)
-	if ate.shardCoordinator.SelfId() != senderShardID {
+	// for relayed transactions v3 the relayer pays the fee, so its balance limits the gas
+	feePayerAddress := tx.SndAddr
+	isRelayedV3 := common.IsRelayedTxV3(tx)
+	if isRelayedV3 {
+		feePayerAddress = tx.RelayerAddr
+	}
+
+	feePayerShardID := ate.shardCoordinator.ComputeId(feePayerAddress)
+	if ate.shardCoordinator.SelfId() != feePayerShardID {
 		return maxGasLimitPerBlock, nil
 	}
 
-	accountHandler, err := ate.accounts.LoadAccount(tx.SndAddr)
+	accountHandler, err := ate.accounts.LoadAccount(feePayerAddress)
 	if err != nil {
 		return 0, err
 	}
@@ -273,6 +506,13 @@ func (ate *apiTransactionEvaluator) getTxGasLimit(tx *transaction.Transaction) (
 	tx.GasLimit = maxGasLimitPerBlock
 	txFee := ate.feeHandler.ComputeTxFee(tx)
 	if txFee.Cmp(accountSenderBalance) > 0 && big.NewInt(0).Cmp(accountSenderBalance) != 0 {
+		if isRelayedV3 {
+			// the value is paid by the sender, the relayer's balance only covers the fee
+			txWithoutValue := *tx
+			txWithoutValue.Value = big.NewInt(0)
+			return ate.feeHandler.ComputeGasLimitBasedOnBalance(&txWithoutValue, accountSenderBalance)
+		}
+
 		return ate.feeHandler.ComputeGasLimitBasedOnBalance(tx, accountSenderBalance)
 	}
 
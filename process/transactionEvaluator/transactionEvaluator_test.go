@@ -1,6 +1,8 @@
 package transactionEvaluator
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
 	"math"
 	"math/big"
@@ -12,15 +14,22 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func createArgs() ArgsApiTransactionEvaluator {
@@ -32,6 +41,7 @@ func createArgs() ArgsApiTransactionEvaluator {
 		ShardCoordinator:    &mock.ShardCoordinatorStub{},
 		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
 		BlockChain:          &testscommon.ChainHandlerMock{},
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 }
 
@@ -97,6 +107,17 @@ func TestTransactionEvaluator_InvalidEnableEpochsHandlerShouldErr(t *testing.T)
 	require.True(t, errors.Is(err, core.ErrInvalidEnableEpochsHandler))
 }
 
+func TestTransactionEvaluator_NilExecutionTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.ExecutionTracer = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilExecutionTraceCollector, err)
+}
+
 func TestTransactionEvaluator_Ok(t *testing.T) {
 	t.Parallel()
 
@@ -140,7 +161,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 	require.Nil(t, err)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -178,7 +199,7 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -214,7 +235,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -245,7 +266,7 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, localErr.Error(), cost.ReturnMessage)
 }
@@ -276,7 +297,7 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 	require.Nil(t, err)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, process.ErrNilVMOutput.Error(), cost.ReturnMessage)
 }
@@ -311,7 +332,7 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.True(t, strings.Contains(cost.ReturnMessage, vmcommon.UserError.String()))
 }
@@ -328,7 +349,7 @@ func TestTransactionEvaluator_RelayedTxShouldErr(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, "cannot compute cost of the relayed transaction", cost.ReturnMessage)
 }
@@ -371,11 +392,483 @@ func TestApiTransactionEvaluator_SimulateTransactionExecution(t *testing.T) {
 
 	tx := &transaction.Transaction{}
 
-	_, err = tce.SimulateTransactionExecution(tx)
+	_, err = tce.SimulateTransactionExecution(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.True(t, called)
 }
 
+func TestApiTransactionEvaluator_ShouldRecordTheSpansUnderTheProvidedContext(t *testing.T) {
+	// not parallel, as the tracer provider is set as the global one
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	args := createArgs()
+	args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+			return process.SCInvoking, process.SCInvoking
+		},
+	}
+	args.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+		MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+			return math.MaxUint64
+		},
+	}
+	args.TxSimulator = &mock.TransactionSimulatorStub{
+		ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+			return &txSimData.SimulationResultsWithVMOutput{
+				VMOutput: &vmcommon.VMOutput{ReturnCode: vmcommon.Ok},
+			}, nil
+		},
+	}
+	args.Accounts = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
+		},
+	}
+	tce, _ := NewAPITransactionEvaluator(args)
+
+	ctx, requestSpan := otel.Tracer("test").Start(context.Background(), "request")
+	_, err := tce.SimulateTransactionExecution(ctx, &transaction.Transaction{}, nil)
+	require.Nil(t, err)
+	_, err = tce.ComputeTransactionGasLimit(ctx, &transaction.Transaction{}, nil)
+	require.Nil(t, err)
+	_, err = tce.SimulateTransactionsSequence(ctx, []*transaction.Transaction{{Nonce: 0}, {Nonce: 1}}, nil)
+	require.Nil(t, err)
+	requestSpan.End()
+
+	spansByName := make(map[string]sdktrace.ReadOnlySpan)
+	processTxParents := make([]trace.SpanID, 0)
+	for _, span := range recorder.Ended() {
+		spansByName[span.Name()] = span
+		if span.Name() == "txSimulator.ProcessTx" {
+			processTxParents = append(processTxParents, span.Parent().SpanID())
+		}
+	}
+
+	simulateSpan := spansByName["transactionEvaluator.SimulateTransactionExecution"]
+	require.NotNil(t, simulateSpan)
+	require.Equal(t, requestSpan.SpanContext().SpanID(), simulateSpan.Parent().SpanID())
+	require.Equal(t, "execution lock acquired", simulateSpan.Events()[0].Name)
+
+	costSpan := spansByName["transactionEvaluator.ComputeTransactionGasLimit"]
+	require.NotNil(t, costSpan)
+	require.Equal(t, requestSpan.SpanContext().SpanID(), costSpan.Parent().SpanID())
+	require.Equal(t, "execution lock acquired", costSpan.Events()[0].Name)
+
+	sequenceSpan := spansByName["transactionEvaluator.SimulateTransactionsSequence"]
+	require.NotNil(t, sequenceSpan)
+	require.Equal(t, requestSpan.SpanContext().SpanID(), sequenceSpan.Parent().SpanID())
+	require.Equal(t, "execution lock acquired", sequenceSpan.Events()[0].Name)
+
+	expectedProcessTxParents := []trace.SpanID{
+		simulateSpan.SpanContext().SpanID(),
+		costSpan.SpanContext().SpanID(),
+		sequenceSpan.SpanContext().SpanID(),
+		sequenceSpan.SpanContext().SpanID(),
+	}
+	require.Equal(t, expectedProcessTxParents, processTxParents)
+}
+
+func TestApiTransactionEvaluator_SimulateTransactionExecutionWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	overrides := common.StateOverrides{
+		"address": &common.AccountStateOverride{Balance: big.NewInt(100)},
+	}
+
+	t.Run("accounts adapter not supporting overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		_, err := tce.SimulateTransactionExecution(context.Background(), &transaction.Transaction{}, overrides)
+		require.Equal(t, process.ErrStateOverridesNotSupported, err)
+	})
+	t.Run("should apply the overrides before the simulation", func(t *testing.T) {
+		t.Parallel()
+
+		overrideApplied := false
+		applier := &stateMock.AccountOverridesApplierStub{
+			ApplyOverrideCalled: func(_ state.UserAccountHandler, override *common.AccountStateOverride) ([]byte, error) {
+				overrideApplied = true
+				require.Equal(t, big.NewInt(100), override.Balance)
+				return nil, nil
+			},
+		}
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Address: address}, nil
+			},
+		}
+
+		args := createArgs()
+		args.Accounts, _ = NewSimulationAccountsDB(accDb, applier)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.True(t, overrideApplied)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		_, err := tce.SimulateTransactionExecution(context.Background(), &transaction.Transaction{}, overrides)
+		require.NoError(t, err)
+		require.True(t, overrideApplied)
+	})
+}
+
+func TestApiTransactionEvaluator_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("simulation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		results, err := tce.TraceTransactionExecution(&transaction.Transaction{}, nil)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+	})
+	t.Run("should attach the recorded trace", func(t *testing.T) {
+		t.Parallel()
+
+		contractAddress := []byte("contract-address")
+		tracer, _ := tracing.NewExecutionTracer(testscommon.NewPubkeyConverterMock(32), 10)
+		args := createArgs()
+		args.ExecutionTracer = tracer
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				tracer.OnContractCallStart(&vmcommon.ContractCallInput{
+					RecipientAddr: contractAddress,
+					Function:      "foo",
+				})
+				tracer.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		results, err := tce.TraceTransactionExecution(&transaction.Transaction{}, nil)
+		require.Nil(t, err)
+		require.NotNil(t, results.ExecutionTrace)
+		require.Equal(t, 1, len(results.ExecutionTrace.Calls))
+		require.Equal(t, "foo", results.ExecutionTrace.Calls[0].Function)
+		require.Equal(t, hex.EncodeToString(contractAddress), results.ExecutionTrace.Calls[0].Callee)
+	})
+}
+
+func TestApiTransactionEvaluator_ReplayTransaction(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	createReplayData := func() *txSimData.TransactionReplayData {
+		return &txSimData.TransactionReplayData{
+			Transaction:           &transaction.Transaction{Nonce: 2},
+			PrecedingTransactions: []*transaction.Transaction{{Nonce: 0}, {Nonce: 1}},
+			Header:                &block.Header{Nonce: 37},
+			RootHash:              rootHash,
+		}
+	}
+	createHistoricalAccounts := func() state.AccountsAdapterWithClean {
+		historicalAccountsDB, _ := NewHistoricalStateAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &stateMock.AccountsFactoryStub{})
+		simAccountsDB, _ := NewSimulationAccountsDB(historicalAccountsDB, &stateMock.AccountOverridesApplierStub{})
+
+		return simAccountsDB
+	}
+
+	t.Run("nil replay data should error", func(t *testing.T) {
+		t.Parallel()
+
+		tce, _ := NewAPITransactionEvaluator(createArgs())
+
+		results, err := tce.ReplayTransaction(nil)
+		require.Equal(t, ErrNilTransactionReplayData, err)
+		require.Nil(t, results)
+
+		replayData := createReplayData()
+		replayData.Header = nil
+		results, err = tce.ReplayTransaction(replayData)
+		require.Equal(t, ErrNilTransactionReplayData, err)
+		require.Nil(t, results)
+
+		replayData = createReplayData()
+		replayData.PreviousHeaderScheduledTransactions = []*transaction.Transaction{{Nonce: 0}}
+		results, err = tce.ReplayTransaction(replayData)
+		require.Equal(t, ErrNilTransactionReplayData, err)
+		require.Nil(t, results)
+	})
+	t.Run("accounts without historical state support should error", func(t *testing.T) {
+		t.Parallel()
+
+		tce, _ := NewAPITransactionEvaluator(createArgs())
+
+		results, err := tce.ReplayTransaction(createReplayData())
+		require.Equal(t, process.ErrHistoricalStateNotSupported, err)
+		require.Nil(t, results)
+	})
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Accounts = createHistoricalAccounts()
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		replayData := createReplayData()
+		replayData.RootHash = nil
+		results, err := tce.ReplayTransaction(replayData)
+		require.Equal(t, state.ErrNilRootHash, err)
+		require.Nil(t, results)
+	})
+	t.Run("preceding transaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.Accounts = createHistoricalAccounts()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				if tx.Nonce == 1 {
+					return nil, expectedErr
+				}
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		results, err := tce.ReplayTransaction(createReplayData())
+		require.True(t, errors.Is(err, expectedErr))
+		require.True(t, strings.Contains(err.Error(), "index 1"))
+		require.Nil(t, results)
+	})
+	t.Run("should replay the preceding transactions and trace the target one", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, _ := tracing.NewExecutionTracer(testscommon.NewPubkeyConverterMock(32), 10)
+		args := createArgs()
+		args.Accounts = createHistoricalAccounts()
+		args.ExecutionTracer = tracer
+		processedNonces := make([]uint64, 0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, header data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, uint64(37), header.GetNonce())
+				processedNonces = append(processedNonces, tx.Nonce)
+
+				tracer.OnContractCallStart(&vmcommon.ContractCallInput{Function: "foo"})
+				tracer.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		results, err := tce.ReplayTransaction(createReplayData())
+		require.Nil(t, err)
+		require.Equal(t, []uint64{0, 1, 2}, processedNonces)
+		require.NotNil(t, results.ExecutionTrace)
+		require.Equal(t, 1, len(results.ExecutionTrace.Calls))
+		require.Equal(t, "foo", results.ExecutionTrace.Calls[0].Function)
+	})
+	t.Run("should replay the previous block scheduled transactions with the previous header first", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Accounts = createHistoricalAccounts()
+		type processedTx struct {
+			nonce       uint64
+			headerNonce uint64
+		}
+		processedTxs := make([]processedTx, 0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, header data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				processedTxs = append(processedTxs, processedTx{nonce: tx.Nonce, headerNonce: header.GetNonce()})
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		replayData := createReplayData()
+		replayData.PreviousHeader = &block.Header{Nonce: 36}
+		replayData.PreviousHeaderScheduledTransactions = []*transaction.Transaction{{Nonce: 10}, {Nonce: 11}}
+		_, err := tce.ReplayTransaction(replayData)
+		require.Nil(t, err)
+
+		expectedProcessedTxs := []processedTx{
+			{nonce: 10, headerNonce: 36},
+			{nonce: 11, headerNonce: 36},
+			{nonce: 0, headerNonce: 37},
+			{nonce: 1, headerNonce: 37},
+			{nonce: 2, headerNonce: 37},
+		}
+		require.Equal(t, expectedProcessedTxs, processedTxs)
+	})
+}
+
+func TestApiTransactionEvaluator_SimulateTransactionsSequence(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty sequence should error", func(t *testing.T) {
+		t.Parallel()
+
+		tce, _ := NewAPITransactionEvaluator(createArgs())
+		results, err := tce.SimulateTransactionsSequence(context.Background(), nil, nil)
+		require.Nil(t, results)
+		require.Equal(t, ErrEmptyTransactionsSequence, err)
+	})
+	t.Run("simulator error should stop the sequence", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				numCalls++
+				if numCalls == 2 {
+					return nil, expectedErr
+				}
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		txs := []*transaction.Transaction{{Nonce: 0}, {Nonce: 1}, {Nonce: 2}}
+		results, err := tce.SimulateTransactionsSequence(context.Background(), txs, nil)
+		require.Nil(t, results)
+		require.True(t, errors.Is(err, expectedErr))
+		require.True(t, strings.Contains(err.Error(), "index 1"))
+		require.Equal(t, 2, numCalls)
+	})
+	t.Run("should simulate all transactions in order", func(t *testing.T) {
+		t.Parallel()
+
+		expectedNonce := uint64(1000)
+		moveBalanceGas := uint64(50000)
+		args := createArgs()
+		args.BlockChain = &testscommon.ChainHandlerMock{}
+		_ = args.BlockChain.SetCurrentBlockHeaderAndRootHash(&block.Header{Nonce: expectedNonce}, []byte("test"))
+		args.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+			ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+				return moveBalanceGas
+			},
+		}
+
+		processedNonces := make([]uint64, 0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, expectedNonce, currentHeader.GetNonce())
+				processedNonces = append(processedNonces, tx.Nonce)
+
+				switch tx.Nonce {
+				case 0:
+					return &txSimData.SimulationResultsWithVMOutput{}, nil
+				case 1:
+					return &txSimData.SimulationResultsWithVMOutput{
+						VMOutput: &vmcommon.VMOutput{
+							ReturnCode:   vmcommon.Ok,
+							GasRemaining: 4000,
+						},
+					}, nil
+				default:
+					return &txSimData.SimulationResultsWithVMOutput{
+						SimulationResults: transaction.SimulationResults{
+							FailReason: "failed",
+						},
+					}, nil
+				}
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		txs := []*transaction.Transaction{
+			{Nonce: 0},
+			{Nonce: 1, GasLimit: 10000},
+			{Nonce: 2},
+		}
+		results, err := tce.SimulateTransactionsSequence(context.Background(), txs, nil)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{0, 1, 2}, processedNonces)
+		require.Equal(t, 3, len(results))
+		require.Equal(t, moveBalanceGas, results[0].GasUnits)
+		require.Equal(t, uint64(6000), results[1].GasUnits)
+		require.Equal(t, uint64(0), results[2].GasUnits)
+		require.Equal(t, "failed", results[2].FailReason)
+	})
+	t.Run("accounts adapter not supporting overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		overrides := common.StateOverrides{
+			"address": &common.AccountStateOverride{Balance: big.NewInt(100)},
+		}
+		results, err := tce.SimulateTransactionsSequence(context.Background(), []*transaction.Transaction{{}}, overrides)
+		require.Nil(t, results)
+		require.Equal(t, process.ErrStateOverridesNotSupported, err)
+	})
+	t.Run("should apply the overrides once, before the first transaction", func(t *testing.T) {
+		t.Parallel()
+
+		numOverridesApplied := 0
+		applier := &stateMock.AccountOverridesApplierStub{
+			ApplyOverrideCalled: func(_ state.UserAccountHandler, override *common.AccountStateOverride) ([]byte, error) {
+				numOverridesApplied++
+				require.Equal(t, big.NewInt(100), override.Balance)
+				return nil, nil
+			},
+		}
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{Address: address}, nil
+			},
+		}
+
+		args := createArgs()
+		args.Accounts, _ = NewSimulationAccountsDB(accDb, applier)
+		numProcessed := 0
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, 1, numOverridesApplied)
+				numProcessed++
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		overrides := common.StateOverrides{
+			"address": &common.AccountStateOverride{Balance: big.NewInt(100)},
+		}
+		txs := []*transaction.Transaction{{Nonce: 0}, {Nonce: 1}, {Nonce: 2}}
+		results, err := tce.SimulateTransactionsSequence(context.Background(), txs, overrides)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(results))
+		require.Equal(t, 3, numProcessed)
+		require.Equal(t, 1, numOverridesApplied)
+	})
+}
+
 func TestApiTransactionEvaluator_ComputeTransactionGasLimit(t *testing.T) {
 	t.Parallel()
 
@@ -403,11 +896,53 @@ func TestApiTransactionEvaluator_ComputeTransactionGasLimit(t *testing.T) {
 
 	tx := &transaction.Transaction{}
 
-	_, err = tce.ComputeTransactionGasLimit(tx)
+	_, err = tce.ComputeTransactionGasLimit(context.Background(), tx, nil)
 	require.Nil(t, err)
 	require.True(t, called)
 }
 
+func TestApiTransactionEvaluator_GetTxGasLimitRelayedTxV3ShouldUseRelayerBalance(t *testing.T) {
+	t.Parallel()
+
+	senderAddress := []byte("sender")
+	relayerAddress := []byte("relayer")
+	relayerBalance := big.NewInt(1000)
+	expectedGasLimit := uint64(500)
+
+	args := createArgs()
+	args.FeeHandler = &economicsmocks.EconomicsHandlerStub{
+		MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+			return 10001
+		},
+		ComputeTxFeeCalled: func(_ data.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(10000)
+		},
+		ComputeGasLimitBasedOnBalanceCalled: func(tx data.TransactionWithFeeHandler, balance *big.Int) (uint64, error) {
+			require.Equal(t, relayerBalance, balance)
+			require.Equal(t, big.NewInt(0), tx.GetValue())
+			return expectedGasLimit, nil
+		},
+	}
+	args.Accounts = &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			require.Equal(t, relayerAddress, address)
+			return &stateMock.UserAccountStub{Balance: relayerBalance}, nil
+		},
+	}
+	tce, err := NewAPITransactionEvaluator(args)
+	require.Nil(t, err)
+
+	tx := &transaction.Transaction{
+		SndAddr:     senderAddress,
+		RelayerAddr: relayerAddress,
+		Value:       big.NewInt(100),
+	}
+	gasLimit, err := tce.getTxGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, expectedGasLimit, gasLimit)
+	require.Equal(t, big.NewInt(100), tx.Value)
+}
+
 func TestApiTransactionEvaluator_GetCurrentHeader(t *testing.T) {
 	t.Parallel()
 
@@ -11,12 +11,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/storage"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var log = logger.GetOrCreate("txsSender")
@@ -46,6 +49,12 @@ type txsSender struct {
 	txSentCounter uint32
 }
 
+// pendingTransaction is a transaction waiting in the accumulator, together with the trace of the request which sent it
+type pendingTransaction struct {
+	tx           *transaction.Transaction
+	traceContext trace.SpanContext
+}
+
 // NewTxsSenderWithAccumulator creates a new instance of TxsSenderHandler, which initializes internally an accumulator.NewTimeAccumulator
 func NewTxsSenderWithAccumulator(args ArgsTxsSenderWithAccumulator) (*txsSender, error) {
 	if check.IfNil(args.Marshaller) {
@@ -88,19 +97,25 @@ func NewTxsSenderWithAccumulator(args ArgsTxsSenderWithAccumulator) (*txsSender,
 }
 
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
-func (ts *txsSender) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
+func (ts *txsSender) SendBulkTransactions(ctx context.Context, txs []*transaction.Transaction) (uint64, error) {
 	if len(txs) == 0 {
 		return 0, process.ErrNoTxToProcess
 	}
 
-	ts.addTransactionsToSendPipe(txs)
+	ctx, span := tracing.StartSpan(ctx, "txsSender.SendBulkTransactions", attribute.Int("numTxs", len(txs)))
+	defer span.End()
+
+	ts.addTransactionsToSendPipe(txs, trace.SpanContextFromContext(ctx))
 
 	return uint64(len(txs)), nil
 }
 
-func (ts *txsSender) addTransactionsToSendPipe(txs []*transaction.Transaction) {
+func (ts *txsSender) addTransactionsToSendPipe(txs []*transaction.Transaction, traceContext trace.SpanContext) {
 	for _, tx := range txs {
-		ts.txAccumulator.AddData(tx)
+		ts.txAccumulator.AddData(&pendingTransaction{
+			tx:           tx,
+			traceContext: traceContext,
+		})
 	}
 }
 
@@ -125,15 +140,33 @@ func (ts *txsSender) sendTxObjsFromChannel(objs []interface{}) {
 	}
 
 	txs := make([]*transaction.Transaction, 0, len(objs))
+	links := make([]trace.Link, 0)
+	linkedSpans := make(map[trace.SpanID]struct{})
 	for _, obj := range objs {
-		tx, ok := obj.(*transaction.Transaction)
+		pendingTx, ok := obj.(*pendingTransaction)
 		if !ok {
 			continue
 		}
 
-		txs = append(txs, tx)
+		txs = append(txs, pendingTx.tx)
+
+		_, isLinked := linkedSpans[pendingTx.traceContext.SpanID()]
+		if pendingTx.traceContext.IsValid() && !isLinked {
+			linkedSpans[pendingTx.traceContext.SpanID()] = struct{}{}
+			links = append(links, trace.Link{SpanContext: pendingTx.traceContext})
+		}
 	}
 
+	// the broadcast happens after the requests which sent the transactions ended, so it is traced on its own,
+	// linked to the spans of those requests
+	_, span := tracing.Tracer().Start(
+		context.Background(),
+		"txsSender.broadcastTransactions",
+		trace.WithLinks(links...),
+		trace.WithAttributes(attribute.Int("numTxs", len(txs))),
+	)
+	defer span.End()
+
 	atomic.AddUint32(&ts.txSentCounter, uint32(len(txs)))
 	ts.sendBulkTransactions(txs)
 }
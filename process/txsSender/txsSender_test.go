@@ -1,6 +1,7 @@
 package txsSender
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -192,7 +193,7 @@ func TestTxsSender_SendBulkTransactions(t *testing.T) {
 	}
 	sender, _ := NewTxsSenderWithAccumulator(args)
 
-	numTxs, err := sender.SendBulkTransactions(txsToSend)
+	numTxs, err := sender.SendBulkTransactions(context.Background(), txsToSend)
 	assert.Equal(t, len(txsToSend), int(numTxs))
 	assert.Nil(t, err)
 
@@ -289,7 +290,7 @@ func TestTxsSender_sendFromTxAccumulatorSendOneTxOneSCRExpectOnlyTxToBeSent(t *t
 		require.Nil(t, err)
 	}()
 
-	txsHandler.txAccumulator.AddData(tx)
+	txsHandler.txAccumulator.AddData(&pendingTransaction{tx: tx})
 	txsHandler.txAccumulator.AddData(scr)
 
 	time.Sleep(20 * time.Millisecond)
@@ -418,7 +419,7 @@ func TestTxsSender_SendBulkTransactionsNoTxToProcessExpectError(t *testing.T) {
 	args := generateMockArgsTxsSender()
 	txsHandler, _ := NewTxsSenderWithAccumulator(args)
 
-	numOfProcessedTxs, err := txsHandler.SendBulkTransactions([]*transaction.Transaction{})
+	numOfProcessedTxs, err := txsHandler.SendBulkTransactions(context.Background(), []*transaction.Transaction{})
 	assert.Equal(t, uint64(0), numOfProcessedTxs)
 	assert.Equal(t, process.ErrNoTxToProcess, err)
 }
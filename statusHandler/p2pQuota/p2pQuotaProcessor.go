@@ -121,6 +121,23 @@ func (pqp *p2pQuotaProcessor) AddQuota(
 	pqp.mutStatistics.Unlock()
 }
 
+// SetLiveQuota outputs the limits currently applied on each connected peer
+func (pqp *p2pQuotaProcessor) SetLiveQuota(maxNumMessages uint32, maxTotalSize uint64) {
+	pqp.handler.SetUInt64Value(pqp.getMetric(common.MetricP2PPeerMaxNumMessages), uint64(maxNumMessages))
+	pqp.handler.SetUInt64Value(pqp.getMetric(common.MetricP2PPeerMaxSizeMessages), maxTotalSize)
+}
+
+// SetLiveTopicQuota outputs the limit currently applied on each connected peer for the provided topic
+func (pqp *p2pQuotaProcessor) SetLiveTopicQuota(topic string, maxNumMessages uint32) {
+	pqp.handler.SetUInt64Value(pqp.getMetric(common.MetricP2PTopicMaxNumMessages+"_"+topic), uint64(maxNumMessages))
+}
+
+// SetAdaptiveStatus outputs the node load and the quota percent computed by the adaptive antiflood mode
+func (pqp *p2pQuotaProcessor) SetAdaptiveStatus(loadPercent uint64, quotaPercent uint32) {
+	pqp.handler.SetUInt64Value(pqp.getMetric(common.MetricP2PNodeLoadPercent), loadPercent)
+	pqp.handler.SetUInt64Value(pqp.getMetric(common.MetricP2PQuotaPercent), uint64(quotaPercent))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pqp *p2pQuotaProcessor) IsInterfaceNil() bool {
 	return pqp == nil
@@ -155,6 +155,44 @@ func checkPeakPeerMetrics(
 	assert.Equal(t, value, sizeProcessed)
 }
 
+func TestP2PQuotaProcessor_SetLiveQuotaShouldOutputTheMetrics(t *testing.T) {
+	t.Parallel()
+
+	identifier := "identifier"
+	status := statusHandlerMock.NewAppStatusHandlerMock()
+	pqp, _ := p2pQuota.NewP2PQuotaProcessor(status, identifier)
+
+	pqp.SetLiveQuota(10, 2000)
+
+	assert.Equal(t, uint64(10), status.GetUint64(common.MetricP2PPeerMaxNumMessages+"_"+identifier))
+	assert.Equal(t, uint64(2000), status.GetUint64(common.MetricP2PPeerMaxSizeMessages+"_"+identifier))
+}
+
+func TestP2PQuotaProcessor_SetLiveTopicQuotaShouldOutputTheMetric(t *testing.T) {
+	t.Parallel()
+
+	identifier := "identifier"
+	status := statusHandlerMock.NewAppStatusHandlerMock()
+	pqp, _ := p2pQuota.NewP2PQuotaProcessor(status, identifier)
+
+	pqp.SetLiveTopicQuota("topic", 15)
+
+	assert.Equal(t, uint64(15), status.GetUint64(common.MetricP2PTopicMaxNumMessages+"_topic_"+identifier))
+}
+
+func TestP2PQuotaProcessor_SetAdaptiveStatusShouldOutputTheMetrics(t *testing.T) {
+	t.Parallel()
+
+	identifier := "identifier"
+	status := statusHandlerMock.NewAppStatusHandlerMock()
+	pqp, _ := p2pQuota.NewP2PQuotaProcessor(status, identifier)
+
+	pqp.SetAdaptiveStatus(90, 70)
+
+	assert.Equal(t, uint64(90), status.GetUint64(common.MetricP2PNodeLoadPercent+"_"+identifier))
+	assert.Equal(t, uint64(70), status.GetUint64(common.MetricP2PQuotaPercent+"_"+identifier))
+}
+
 func checkNumReceivers(
 	t *testing.T,
 	status *statusHandlerMock.AppStatusHandlerMock,
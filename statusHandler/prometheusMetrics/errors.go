@@ -0,0 +1,12 @@
+package prometheusMetrics
+
+import "errors"
+
+// ErrMetricRegistrationFailed signals that a metric could not be registered
+var ErrMetricRegistrationFailed = errors.New("metric registration failed")
+
+// ErrMetricTypeMismatch signals that a metric with the same name, but of a different type, was already registered
+var ErrMetricTypeMismatch = errors.New("metric already registered with a different type")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry has been provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
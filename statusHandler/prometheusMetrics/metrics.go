@@ -0,0 +1,72 @@
+package prometheusMetrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type counterMetric struct {
+	name       string
+	counterVec *prometheus.CounterVec
+}
+
+// Add increments the counter identified by the provided label values. Negative values are ignored
+func (cm *counterMetric) Add(value float64, labelValues ...string) {
+	if value < 0 {
+		return
+	}
+
+	counter, err := cm.counterVec.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		log.Warn("counterMetric.Add", "metric", cm.name, "error", err)
+		return
+	}
+
+	counter.Add(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cm *counterMetric) IsInterfaceNil() bool {
+	return cm == nil
+}
+
+type gaugeMetric struct {
+	name     string
+	gaugeVec *prometheus.GaugeVec
+}
+
+// Set sets the value of the gauge identified by the provided label values
+func (gm *gaugeMetric) Set(value float64, labelValues ...string) {
+	gauge, err := gm.gaugeVec.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		log.Warn("gaugeMetric.Set", "metric", gm.name, "error", err)
+		return
+	}
+
+	gauge.Set(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gm *gaugeMetric) IsInterfaceNil() bool {
+	return gm == nil
+}
+
+type histogramMetric struct {
+	name         string
+	histogramVec *prometheus.HistogramVec
+}
+
+// Observe adds an observation to the histogram identified by the provided label values
+func (hm *histogramMetric) Observe(value float64, labelValues ...string) {
+	histogram, err := hm.histogramVec.GetMetricWithLabelValues(labelValues...)
+	if err != nil {
+		log.Warn("histogramMetric.Observe", "metric", hm.name, "error", err)
+		return
+	}
+
+	histogram.Observe(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hm *histogramMetric) IsInterfaceNil() bool {
+	return hm == nil
+}
@@ -0,0 +1,89 @@
+package prometheusMetrics
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+const (
+	p2pReceivedMessagesMetric  = "erd_p2p_received_messages_total"
+	p2pReceivedBytesMetric     = "erd_p2p_received_bytes_total"
+	p2pMessageProcessingMetric = "erd_p2p_message_processing_duration_seconds"
+	topicLabel                 = "topic"
+	resultLabel                = "result"
+	resultLabelAccepted        = "accepted"
+	resultLabelRejected        = "rejected"
+)
+
+type p2pMessagesMetrics struct {
+	receivedMessages  common.CounterMetric
+	receivedBytes     common.CounterMetric
+	processingTimings common.HistogramMetric
+}
+
+// NewP2PMessagesMetrics creates a component that counts the messages received by the interceptors, by topic and by
+// processing result. It should be set on the interceptors as message metrics handler
+func NewP2PMessagesMetrics(registry common.MetricsRegistry) (*p2pMessagesMetrics, error) {
+	if check.IfNil(registry) {
+		return nil, ErrNilMetricsRegistry
+	}
+
+	receivedMessages, err := registry.RegisterCounter(
+		p2pReceivedMessagesMetric,
+		"Number of p2p messages received by the interceptors, by topic and result",
+		topicLabel, resultLabel,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	receivedBytes, err := registry.RegisterCounter(
+		p2pReceivedBytesMetric,
+		"Number of bytes received by the interceptors, by topic",
+		topicLabel,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	processingTimings, err := registry.RegisterHistogram(
+		p2pMessageProcessingMetric,
+		"Time spent by the interceptors to process the received messages, including the intercepted data processing, by topic",
+		nil,
+		topicLabel,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p2pMessagesMetrics{
+		receivedMessages:  receivedMessages,
+		receivedBytes:     receivedBytes,
+		processingTimings: processingTimings,
+	}, nil
+}
+
+// ObserveMessage updates the metrics of the provided topic. It is called by the interceptors after the message was
+// completely processed, so the observed duration is the whole processing time
+func (pmm *p2pMessagesMetrics) ObserveMessage(topic string, message p2p.MessageP2P, processingDuration time.Duration, err error) {
+	result := resultLabelAccepted
+	if err != nil {
+		result = resultLabelRejected
+	}
+
+	pmm.receivedMessages.Add(1, topic, result)
+	pmm.processingTimings.Observe(processingDuration.Seconds(), topic)
+	if check.IfNil(message) {
+		return
+	}
+
+	pmm.receivedBytes.Add(float64(len(message.Data())), topic)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pmm *p2pMessagesMetrics) IsInterfaceNil() bool {
+	return pmm == nil
+}
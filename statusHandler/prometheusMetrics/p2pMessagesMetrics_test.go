@@ -0,0 +1,51 @@
+package prometheusMetrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewP2PMessagesMetrics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		pmm, err := NewP2PMessagesMetrics(nil)
+		assert.Equal(t, ErrNilMetricsRegistry, err)
+		assert.True(t, check.IfNil(pmm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pmm, err := NewP2PMessagesMetrics(NewPrometheusRegistry())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(pmm))
+	})
+}
+
+func TestP2PMessagesMetrics_ObserveMessage(t *testing.T) {
+	t.Parallel()
+
+	registry := NewPrometheusRegistry()
+	pmm, _ := NewP2PMessagesMetrics(registry)
+
+	message := &p2pmocks.P2PMessageMock{DataField: []byte("data")}
+	pmm.ObserveMessage("transactions", message, time.Millisecond, nil)
+	pmm.ObserveMessage("transactions", message, time.Millisecond, errors.New("expected error"))
+	pmm.ObserveMessage("transactions", nil, time.Millisecond, errors.New("nil message"))
+
+	metrics, err := registry.PrometheusString()
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(metrics, `erd_p2p_received_messages_total{result="accepted",topic="transactions"} 1`))
+	assert.True(t, strings.Contains(metrics, `erd_p2p_received_messages_total{result="rejected",topic="transactions"} 2`))
+	assert.True(t, strings.Contains(metrics, `erd_p2p_received_bytes_total{topic="transactions"} 8`))
+	assert.True(t, strings.Contains(metrics, `erd_p2p_message_processing_duration_seconds_count{topic="transactions"} 3`))
+}
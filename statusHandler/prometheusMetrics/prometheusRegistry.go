@@ -0,0 +1,121 @@
+package prometheusMetrics
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+var log = logger.GetOrCreate("statusHandler/prometheusMetrics")
+
+type prometheusRegistry struct {
+	registry *prometheus.Registry
+	gatherer prometheus.Gatherer
+}
+
+// NewPrometheusRegistry creates a metrics registry backed by a dedicated Prometheus registry. Besides the metrics
+// registered by the node components, the exported metrics contain the ones found in the default Prometheus registry,
+// such as the Go runtime, the process and the libp2p metrics
+func NewPrometheusRegistry() *prometheusRegistry {
+	registry := prometheus.NewRegistry()
+
+	return &prometheusRegistry{
+		registry: registry,
+		gatherer: prometheus.Gatherers{registry, prometheus.DefaultGatherer},
+	}
+}
+
+// RegisterCounter registers a counter with the provided label names. Registering the same counter twice returns the
+// already registered one, so multiple instances of a component can share it
+func (pr *prometheusRegistry) RegisterCounter(name string, help string, labelNames ...string) (common.CounterMetric, error) {
+	collector, err := pr.register(prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labelNames))
+	if err != nil {
+		return nil, err
+	}
+
+	counterVec, ok := collector.(*prometheus.CounterVec)
+	if !ok {
+		return nil, fmt.Errorf("%w for metric %s", ErrMetricTypeMismatch, name)
+	}
+
+	return &counterMetric{name: name, counterVec: counterVec}, nil
+}
+
+// RegisterGauge registers a gauge with the provided label names. Registering the same gauge twice returns the already
+// registered one, so multiple instances of a component can share it
+func (pr *prometheusRegistry) RegisterGauge(name string, help string, labelNames ...string) (common.GaugeMetric, error) {
+	collector, err := pr.register(prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labelNames))
+	if err != nil {
+		return nil, err
+	}
+
+	gaugeVec, ok := collector.(*prometheus.GaugeVec)
+	if !ok {
+		return nil, fmt.Errorf("%w for metric %s", ErrMetricTypeMismatch, name)
+	}
+
+	return &gaugeMetric{name: name, gaugeVec: gaugeVec}, nil
+}
+
+// RegisterHistogram registers a histogram with the provided buckets and label names. Nil buckets means the default
+// Prometheus buckets, suitable for durations measured in seconds. Registering the same histogram twice returns the
+// already registered one, so multiple instances of a component can share it
+func (pr *prometheusRegistry) RegisterHistogram(name string, help string, buckets []float64, labelNames ...string) (common.HistogramMetric, error) {
+	opts := prometheus.HistogramOpts{
+		Name:    name,
+		Help:    help,
+		Buckets: buckets,
+	}
+	collector, err := pr.register(prometheus.NewHistogramVec(opts, labelNames))
+	if err != nil {
+		return nil, err
+	}
+
+	histogramVec, ok := collector.(*prometheus.HistogramVec)
+	if !ok {
+		return nil, fmt.Errorf("%w for metric %s", ErrMetricTypeMismatch, name)
+	}
+
+	return &histogramMetric{name: name, histogramVec: histogramVec}, nil
+}
+
+func (pr *prometheusRegistry) register(collector prometheus.Collector) (prometheus.Collector, error) {
+	err := pr.registry.Register(collector)
+	if err == nil {
+		return collector, nil
+	}
+
+	alreadyRegisteredErr, ok := err.(prometheus.AlreadyRegisteredError)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMetricRegistrationFailed, err.Error())
+	}
+
+	return alreadyRegisteredErr.ExistingCollector, nil
+}
+
+// PrometheusString returns all the metrics in the Prometheus text exposition format
+func (pr *prometheusRegistry) PrometheusString() (string, error) {
+	metricFamilies, err := pr.gatherer.Gather()
+	if err != nil {
+		return "", err
+	}
+
+	buff := bytes.Buffer{}
+	for _, metricFamily := range metricFamilies {
+		_, err = expfmt.MetricFamilyToText(&buff, metricFamily)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return buff.String(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *prometheusRegistry) IsInterfaceNil() bool {
+	return pr == nil
+}
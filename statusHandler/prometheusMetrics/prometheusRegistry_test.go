@@ -0,0 +1,107 @@
+package prometheusMetrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrometheusRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := NewPrometheusRegistry()
+	assert.False(t, check.IfNil(registry))
+}
+
+func TestPrometheusRegistry_RegisterCounter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid name should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewPrometheusRegistry()
+		counter, err := registry.RegisterCounter("invalid name", "help", "label")
+		assert.True(t, errors.Is(err, ErrMetricRegistrationFailed))
+		assert.True(t, check.IfNil(counter))
+	})
+	t.Run("same name with a different type should error", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewPrometheusRegistry()
+		_, err := registry.RegisterGauge("erd_metric", "help", "label")
+		require.Nil(t, err)
+
+		counter, err := registry.RegisterCounter("erd_metric", "help", "label")
+		assert.True(t, errors.Is(err, ErrMetricTypeMismatch))
+		assert.True(t, check.IfNil(counter))
+	})
+	t.Run("registering twice should return the same counter", func(t *testing.T) {
+		t.Parallel()
+
+		registry := NewPrometheusRegistry()
+		first, err := registry.RegisterCounter("erd_counter_total", "help", "topic", "result")
+		require.Nil(t, err)
+		second, err := registry.RegisterCounter("erd_counter_total", "help", "topic", "result")
+		require.Nil(t, err)
+
+		first.Add(2, "transactions", "accepted")
+		second.Add(1, "transactions", "accepted")
+		first.Add(-1, "transactions", "accepted")
+		// wrong number of label values should be ignored
+		first.Add(1, "transactions")
+
+		metrics, err := registry.PrometheusString()
+		require.Nil(t, err)
+		assert.True(t, strings.Contains(metrics, "# TYPE erd_counter_total counter\n"))
+		assert.True(t, strings.Contains(metrics, `erd_counter_total{result="accepted",topic="transactions"} 3`))
+	})
+}
+
+func TestPrometheusRegistry_RegisterGauge(t *testing.T) {
+	t.Parallel()
+
+	registry := NewPrometheusRegistry()
+	gauge, err := registry.RegisterGauge("erd_gauge", "help")
+	require.Nil(t, err)
+
+	gauge.Set(5)
+	gauge.Set(4)
+	gauge.Set(4, "unexpected label value")
+
+	metrics, err := registry.PrometheusString()
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(metrics, "# TYPE erd_gauge gauge\nerd_gauge 4\n"))
+}
+
+func TestPrometheusRegistry_RegisterHistogram(t *testing.T) {
+	t.Parallel()
+
+	registry := NewPrometheusRegistry()
+	histogram, err := registry.RegisterHistogram("erd_duration_seconds", "help", []float64{0.1, 1}, "subround")
+	require.Nil(t, err)
+
+	histogram.Observe(0.05, "(START_ROUND)")
+	histogram.Observe(0.5, "(START_ROUND)")
+	histogram.Observe(5, "(START_ROUND)")
+
+	metrics, err := registry.PrometheusString()
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(metrics, "# TYPE erd_duration_seconds histogram\n"))
+	assert.True(t, strings.Contains(metrics, `erd_duration_seconds_bucket{subround="(START_ROUND)",le="0.1"} 1`))
+	assert.True(t, strings.Contains(metrics, `erd_duration_seconds_bucket{subround="(START_ROUND)",le="1"} 2`))
+	assert.True(t, strings.Contains(metrics, `erd_duration_seconds_bucket{subround="(START_ROUND)",le="+Inf"} 3`))
+	assert.True(t, strings.Contains(metrics, `erd_duration_seconds_count{subround="(START_ROUND)"} 3`))
+}
+
+func TestPrometheusRegistry_PrometheusStringShouldContainTheDefaultRegistryMetrics(t *testing.T) {
+	t.Parallel()
+
+	registry := NewPrometheusRegistry()
+	metrics, err := registry.PrometheusString()
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(metrics, "go_goroutines"))
+}
@@ -379,6 +379,10 @@ func (sm *statusMetrics) EnableEpochsMetrics() (map[string]interface{}, error) {
 	enableEpochsMetrics[common.MetricCryptoOpcodesV2EnableEpoch] = sm.uint64Metrics[common.MetricCryptoOpcodesV2EnableEpoch]
 	enableEpochsMetrics[common.MetricMultiESDTNFTTransferAndExecuteByUserEnableEpoch] = sm.uint64Metrics[common.MetricMultiESDTNFTTransferAndExecuteByUserEnableEpoch]
 	enableEpochsMetrics[common.MetricFixRelayedMoveBalanceToNonPayableSCEnableEpoch] = sm.uint64Metrics[common.MetricFixRelayedMoveBalanceToNonPayableSCEnableEpoch]
+	enableEpochsMetrics[common.MetricRelayedTransactionsV3EnableEpoch] = sm.uint64Metrics[common.MetricRelayedTransactionsV3EnableEpoch]
+	enableEpochsMetrics[common.MetricGovernanceParameterChangesEnableEpoch] = sm.uint64Metrics[common.MetricGovernanceParameterChangesEnableEpoch]
+	enableEpochsMetrics[common.MetricDelegationLiquidStakingEnableEpoch] = sm.uint64Metrics[common.MetricDelegationLiquidStakingEnableEpoch]
+	enableEpochsMetrics[common.MetricESDTSupplyPolicyEnableEpoch] = sm.uint64Metrics[common.MetricESDTSupplyPolicyEnableEpoch]
 
 	numNodesChangeConfig := sm.uint64Metrics[common.MetricMaxNodesChangeEnableEpoch+"_count"]
 
@@ -402,6 +402,10 @@ func TestStatusMetrics_EnableEpochMetrics(t *testing.T) {
 	sm.SetUInt64Value(common.MetricCryptoOpcodesV2EnableEpoch, uint64(4))
 	sm.SetUInt64Value(common.MetricMultiESDTNFTTransferAndExecuteByUserEnableEpoch, uint64(4))
 	sm.SetUInt64Value(common.MetricFixRelayedMoveBalanceToNonPayableSCEnableEpoch, uint64(4))
+	sm.SetUInt64Value(common.MetricRelayedTransactionsV3EnableEpoch, uint64(4))
+	sm.SetUInt64Value(common.MetricGovernanceParameterChangesEnableEpoch, uint64(4))
+	sm.SetUInt64Value(common.MetricDelegationLiquidStakingEnableEpoch, uint64(4))
+	sm.SetUInt64Value(common.MetricESDTSupplyPolicyEnableEpoch, uint64(4))
 
 	maxNodesChangeConfig := []map[string]uint64{
 		{
@@ -533,6 +537,10 @@ func TestStatusMetrics_EnableEpochMetrics(t *testing.T) {
 		common.MetricCryptoOpcodesV2EnableEpoch:                               uint64(4),
 		common.MetricMultiESDTNFTTransferAndExecuteByUserEnableEpoch:          uint64(4),
 		common.MetricFixRelayedMoveBalanceToNonPayableSCEnableEpoch:           uint64(4),
+		common.MetricRelayedTransactionsV3EnableEpoch:                         uint64(4),
+		common.MetricGovernanceParameterChangesEnableEpoch:                    uint64(4),
+		common.MetricDelegationLiquidStakingEnableEpoch:                       uint64(4),
+		common.MetricESDTSupplyPolicyEnableEpoch:                              uint64(4),
 
 		common.MetricMaxNodesChangeEnableEpoch: []map[string]interface{}{
 			{
@@ -524,6 +524,13 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	governanceIndexUnit, err := psf.createStaticStorageUnit(psf.generalConfig.DbLookupExtensions.GovernanceIndexStorageConfig, shardID, emptyDBPathSuffix)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.GovernanceIndexStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.GovernanceIndexUnit, governanceIndexUnit)
+
 	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
 }
 
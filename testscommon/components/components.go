@@ -328,6 +328,7 @@ func GetNetworkFactoryArgs() networkComp.NetworkComponentsFactoryArgs {
 		},
 		Syncer:           &p2pFactory.LocalSyncTimer{},
 		CryptoComponents: cryptoCompMock,
+		PathManager:      &testscommon.PathManagerStub{},
 	}
 }
 
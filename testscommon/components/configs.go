@@ -177,7 +177,8 @@ func GetGeneralConfig() config.Config {
 			Type: "bls",
 		},
 		ValidatorStatistics: config.ValidatorStatisticsConfig{
-			CacheRefreshIntervalInSec: uint32(100),
+			CacheRefreshIntervalInSec:     uint32(100),
+			PerformanceReportMaxNumEpochs: 30,
 		},
 		SoftwareVersionConfig: config.SoftwareVersionConfig{
 			PollingIntervalInMinutes: 30,
@@ -86,10 +86,11 @@ func GetDefaultCryptoComponents() *mock.CryptoComponentsMock {
 // GetDefaultNetworkComponents -
 func GetDefaultNetworkComponents() *mock.NetworkComponentsMock {
 	return &mock.NetworkComponentsMock{
-		Messenger:       &p2pmocks.MessengerStub{},
-		InputAntiFlood:  &mock.P2PAntifloodHandlerStub{},
-		OutputAntiFlood: &mock.P2PAntifloodHandlerStub{},
-		PeerBlackList:   &mock.PeerBlackListHandlerStub{},
+		Messenger:                  &p2pmocks.MessengerStub{},
+		InputAntiFlood:             &mock.P2PAntifloodHandlerStub{},
+		OutputAntiFlood:            &mock.P2PAntifloodHandlerStub{},
+		PeerBlackList:              &mock.PeerBlackListHandlerStub{},
+		PeerReputationHandlerField: &testscommon.PeerReputationHandlerStub{},
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/governance"
 )
 
 // HistoryRepositoryStub -
@@ -17,6 +18,9 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetGovernanceProposalsCalled       func() ([]*governance.Proposal, error)
+	GetGovernanceProposalCalled        func(nonce uint64) (*governance.Proposal, error)
+	GetGovernanceProposalVotesCalled   func(nonce uint64) ([]*governance.Vote, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -89,6 +93,33 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (hp *HistoryRepositoryStub) GetGovernanceProposals() ([]*governance.Proposal, error) {
+	if hp.GetGovernanceProposalsCalled != nil {
+		return hp.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (hp *HistoryRepositoryStub) GetGovernanceProposal(nonce uint64) (*governance.Proposal, error) {
+	if hp.GetGovernanceProposalCalled != nil {
+		return hp.GetGovernanceProposalCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposalVotes -
+func (hp *HistoryRepositoryStub) GetGovernanceProposalVotes(nonce uint64) ([]*governance.Vote, error) {
+	if hp.GetGovernanceProposalVotesCalled != nil {
+		return hp.GetGovernanceProposalVotesCalled(nonce)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -12,6 +12,7 @@ type EpochStartSystemSCStub struct {
 	ProcessSystemSmartContractCalled func(validatorsInfo state.ShardValidatorsInfoMapHandler, header data.HeaderHandler) error
 	ProcessDelegationRewardsCalled   func(miniBlocks block.MiniBlockSlice, txCache epochStart.TransactionCacher) error
 	ToggleUnStakeUnBondCalled        func(value bool) error
+	GetEconomicsParametersCalled     func() ([]byte, error)
 }
 
 // ToggleUnStakeUnBond -
@@ -44,6 +45,14 @@ func (e *EpochStartSystemSCStub) ProcessDelegationRewards(
 	return nil
 }
 
+// GetEconomicsParameters -
+func (e *EpochStartSystemSCStub) GetEconomicsParameters() ([]byte, error) {
+	if e.GetEconomicsParametersCalled != nil {
+		return e.GetEconomicsParametersCalled()
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (e *EpochStartSystemSCStub) IsInterfaceNil() bool {
 	return e == nil
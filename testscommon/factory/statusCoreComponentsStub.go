@@ -17,6 +17,7 @@ type StatusCoreComponentsStub struct {
 	StatusMetricsField           external.StatusMetricsHandler
 	PersistentStatusHandlerField factory.PersistentStatusHandler
 	StateStatsHandlerField       common.StateStatisticsHandler
+	MetricsRegistryField         common.MetricsRegistry
 }
 
 // Create -
@@ -77,6 +78,11 @@ func (stub *StatusCoreComponentsStub) StateStatsHandler() common.StateStatistics
 	return stub.StateStatsHandlerField
 }
 
+// MetricsRegistry -
+func (stub *StatusCoreComponentsStub) MetricsRegistry() common.MetricsRegistry {
+	return stub.MetricsRegistryField
+}
+
 // IsInterfaceNil -
 func (stub *StatusCoreComponentsStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -47,7 +47,8 @@ func GetGeneralConfig() config.Config {
 			Type: "bls",
 		},
 		ValidatorStatistics: config.ValidatorStatisticsConfig{
-			CacheRefreshIntervalInSec: uint32(100),
+			CacheRefreshIntervalInSec:     uint32(100),
+			PerformanceReportMaxNumEpochs: 30,
 		},
 		GeneralSettings: config.GeneralSettingsConfig{
 			StartInEpochEnabled:                  true,
@@ -0,0 +1,75 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GuardianCoSignerStub -
+type GuardianCoSignerStub struct {
+	GetRegistrationChallengeCalled func(address []byte) (*common.GuardianRegistrationChallenge, error)
+	RegisterAccountCalled          func(address []byte, signature []byte) (*common.GuardianRegistration, error)
+	VerifyCodeCalled               func(address []byte, code string) error
+	SetSpendingPolicyCalled        func(address []byte, code string, policy *common.GuardianSpendingPolicy) error
+	CoSignTransactionCalled        func(tx *transaction.Transaction, code string) ([]byte, error)
+	CloseCalled                    func() error
+}
+
+// GetRegistrationChallenge -
+func (stub *GuardianCoSignerStub) GetRegistrationChallenge(address []byte) (*common.GuardianRegistrationChallenge, error) {
+	if stub.GetRegistrationChallengeCalled != nil {
+		return stub.GetRegistrationChallengeCalled(address)
+	}
+
+	return &common.GuardianRegistrationChallenge{}, nil
+}
+
+// RegisterAccount -
+func (stub *GuardianCoSignerStub) RegisterAccount(address []byte, signature []byte) (*common.GuardianRegistration, error) {
+	if stub.RegisterAccountCalled != nil {
+		return stub.RegisterAccountCalled(address, signature)
+	}
+
+	return &common.GuardianRegistration{}, nil
+}
+
+// VerifyCode -
+func (stub *GuardianCoSignerStub) VerifyCode(address []byte, code string) error {
+	if stub.VerifyCodeCalled != nil {
+		return stub.VerifyCodeCalled(address, code)
+	}
+
+	return nil
+}
+
+// SetSpendingPolicy -
+func (stub *GuardianCoSignerStub) SetSpendingPolicy(address []byte, code string, policy *common.GuardianSpendingPolicy) error {
+	if stub.SetSpendingPolicyCalled != nil {
+		return stub.SetSpendingPolicyCalled(address, code, policy)
+	}
+
+	return nil
+}
+
+// CoSignTransaction -
+func (stub *GuardianCoSignerStub) CoSignTransaction(tx *transaction.Transaction, code string) ([]byte, error) {
+	if stub.CoSignTransactionCalled != nil {
+		return stub.CoSignTransactionCalled(tx, code)
+	}
+
+	return nil, nil
+}
+
+// Close -
+func (stub *GuardianCoSignerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *GuardianCoSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
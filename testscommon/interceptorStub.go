@@ -10,6 +10,8 @@ import (
 type InterceptorStub struct {
 	ProcessReceivedMessageCalled     func(message p2p.MessageP2P) error
 	SetInterceptedDebugHandlerCalled func(debugger process.InterceptedDebugger) error
+	SetMessageTracerCalled           func(tracer process.P2PMessageTracer) error
+	SetMessageMetricsCalled          func(handler process.P2PMessagesMetricsHandler) error
 	RegisterHandlerCalled            func(handler func(topic string, hash []byte, data interface{}))
 	CloseCalled                      func() error
 }
@@ -32,6 +34,24 @@ func (is *InterceptorStub) SetInterceptedDebugHandler(debugger process.Intercept
 	return nil
 }
 
+// SetMessageTracer -
+func (is *InterceptorStub) SetMessageTracer(tracer process.P2PMessageTracer) error {
+	if is.SetMessageTracerCalled != nil {
+		return is.SetMessageTracerCalled(tracer)
+	}
+
+	return nil
+}
+
+// SetMessageMetrics -
+func (is *InterceptorStub) SetMessageMetrics(handler process.P2PMessagesMetricsHandler) error {
+	if is.SetMessageMetricsCalled != nil {
+		return is.SetMessageMetricsCalled(handler)
+	}
+
+	return nil
+}
+
 // RegisterHandler -
 func (is *InterceptorStub) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	if is.RegisterHandlerCalled != nil {
@@ -0,0 +1,85 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// MetricsRegistryStub -
+type MetricsRegistryStub struct {
+	RegisterCounterCalled   func(name string, help string, labelNames ...string) (common.CounterMetric, error)
+	RegisterGaugeCalled     func(name string, help string, labelNames ...string) (common.GaugeMetric, error)
+	RegisterHistogramCalled func(name string, help string, buckets []float64, labelNames ...string) (common.HistogramMetric, error)
+	PrometheusStringCalled  func() (string, error)
+}
+
+// RegisterCounter -
+func (stub *MetricsRegistryStub) RegisterCounter(name string, help string, labelNames ...string) (common.CounterMetric, error) {
+	if stub.RegisterCounterCalled != nil {
+		return stub.RegisterCounterCalled(name, help, labelNames...)
+	}
+
+	return &MetricStub{}, nil
+}
+
+// RegisterGauge -
+func (stub *MetricsRegistryStub) RegisterGauge(name string, help string, labelNames ...string) (common.GaugeMetric, error) {
+	if stub.RegisterGaugeCalled != nil {
+		return stub.RegisterGaugeCalled(name, help, labelNames...)
+	}
+
+	return &MetricStub{}, nil
+}
+
+// RegisterHistogram -
+func (stub *MetricsRegistryStub) RegisterHistogram(name string, help string, buckets []float64, labelNames ...string) (common.HistogramMetric, error) {
+	if stub.RegisterHistogramCalled != nil {
+		return stub.RegisterHistogramCalled(name, help, buckets, labelNames...)
+	}
+
+	return &MetricStub{}, nil
+}
+
+// PrometheusString -
+func (stub *MetricsRegistryStub) PrometheusString() (string, error) {
+	if stub.PrometheusStringCalled != nil {
+		return stub.PrometheusStringCalled()
+	}
+
+	return "", nil
+}
+
+// IsInterfaceNil -
+func (stub *MetricsRegistryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// MetricStub -
+type MetricStub struct {
+	AddCalled     func(value float64, labelValues ...string)
+	SetCalled     func(value float64, labelValues ...string)
+	ObserveCalled func(value float64, labelValues ...string)
+}
+
+// Add -
+func (stub *MetricStub) Add(value float64, labelValues ...string) {
+	if stub.AddCalled != nil {
+		stub.AddCalled(value, labelValues...)
+	}
+}
+
+// Set -
+func (stub *MetricStub) Set(value float64, labelValues ...string) {
+	if stub.SetCalled != nil {
+		stub.SetCalled(value, labelValues...)
+	}
+}
+
+// Observe -
+func (stub *MetricStub) Observe(value float64, labelValues ...string) {
+	if stub.ObserveCalled != nil {
+		stub.ObserveCalled(value, labelValues...)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *MetricStub) IsInterfaceNil() bool {
+	return stub == nil
+}
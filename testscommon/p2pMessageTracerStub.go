@@ -0,0 +1,64 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+// P2PMessageTracerStub -
+type P2PMessageTracerStub struct {
+	TraceMessageCalled func(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error)
+	GetTracesCalled    func() []*common.P2PMessageTrace
+	SubscribeCalled    func() (uint64, <-chan *common.P2PMessageTrace)
+	UnsubscribeCalled  func(id uint64)
+	CloseCalled        func() error
+}
+
+// TraceMessage -
+func (stub *P2PMessageTracerStub) TraceMessage(topic string, message p2p.MessageP2P, fromConnectedPeer core.PeerID, arrivalTime time.Time, err error) {
+	if stub.TraceMessageCalled != nil {
+		stub.TraceMessageCalled(topic, message, fromConnectedPeer, arrivalTime, err)
+	}
+}
+
+// GetTraces -
+func (stub *P2PMessageTracerStub) GetTraces() []*common.P2PMessageTrace {
+	if stub.GetTracesCalled != nil {
+		return stub.GetTracesCalled()
+	}
+
+	return nil
+}
+
+// Subscribe -
+func (stub *P2PMessageTracerStub) Subscribe() (uint64, <-chan *common.P2PMessageTrace) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled()
+	}
+
+	return 0, make(chan *common.P2PMessageTrace)
+}
+
+// Unsubscribe -
+func (stub *P2PMessageTracerStub) Unsubscribe(id uint64) {
+	if stub.UnsubscribeCalled != nil {
+		stub.UnsubscribeCalled(id)
+	}
+}
+
+// Close -
+func (stub *P2PMessageTracerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *P2PMessageTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
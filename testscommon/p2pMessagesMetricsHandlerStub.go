@@ -0,0 +1,24 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+// P2PMessagesMetricsHandlerStub -
+type P2PMessagesMetricsHandlerStub struct {
+	ObserveMessageCalled func(topic string, message p2p.MessageP2P, processingDuration time.Duration, err error)
+}
+
+// ObserveMessage -
+func (stub *P2PMessagesMetricsHandlerStub) ObserveMessage(topic string, message p2p.MessageP2P, processingDuration time.Duration, err error) {
+	if stub.ObserveMessageCalled != nil {
+		stub.ObserveMessageCalled(topic, message, processingDuration, err)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *P2PMessagesMetricsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,103 @@
+package testscommon
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// PeerReputationHandlerStub -
+type PeerReputationHandlerStub struct {
+	RecordPeerIDEventCalled    func(pid core.PeerID, source string, reason string, scoreDelta float64, banDuration time.Duration)
+	RecordPublicKeyEventCalled func(pk []byte, source string, reason string, scoreDelta float64, banDuration time.Duration)
+	IsPeerIDDeniedCalled       func(pid core.PeerID) bool
+	IsPublicKeyDeniedCalled    func(pk []byte) bool
+	IsAddressDeniedCalled      func(address string) bool
+	IsPeerIDAllowedCalled      func(pid core.PeerID) bool
+	IsPublicKeyAllowedCalled   func(pk []byte) bool
+	GetPeersReputationCalled   func() []*common.PeerReputationAPIResponse
+	CloseCalled                func() error
+}
+
+// RecordPeerIDEvent -
+func (stub *PeerReputationHandlerStub) RecordPeerIDEvent(pid core.PeerID, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+	if stub.RecordPeerIDEventCalled != nil {
+		stub.RecordPeerIDEventCalled(pid, source, reason, scoreDelta, banDuration)
+	}
+}
+
+// RecordPublicKeyEvent -
+func (stub *PeerReputationHandlerStub) RecordPublicKeyEvent(pk []byte, source string, reason string, scoreDelta float64, banDuration time.Duration) {
+	if stub.RecordPublicKeyEventCalled != nil {
+		stub.RecordPublicKeyEventCalled(pk, source, reason, scoreDelta, banDuration)
+	}
+}
+
+// IsPeerIDDenied -
+func (stub *PeerReputationHandlerStub) IsPeerIDDenied(pid core.PeerID) bool {
+	if stub.IsPeerIDDeniedCalled != nil {
+		return stub.IsPeerIDDeniedCalled(pid)
+	}
+
+	return false
+}
+
+// IsPublicKeyDenied -
+func (stub *PeerReputationHandlerStub) IsPublicKeyDenied(pk []byte) bool {
+	if stub.IsPublicKeyDeniedCalled != nil {
+		return stub.IsPublicKeyDeniedCalled(pk)
+	}
+
+	return false
+}
+
+// IsAddressDenied -
+func (stub *PeerReputationHandlerStub) IsAddressDenied(address string) bool {
+	if stub.IsAddressDeniedCalled != nil {
+		return stub.IsAddressDeniedCalled(address)
+	}
+
+	return false
+}
+
+// IsPeerIDAllowed -
+func (stub *PeerReputationHandlerStub) IsPeerIDAllowed(pid core.PeerID) bool {
+	if stub.IsPeerIDAllowedCalled != nil {
+		return stub.IsPeerIDAllowedCalled(pid)
+	}
+
+	return false
+}
+
+// IsPublicKeyAllowed -
+func (stub *PeerReputationHandlerStub) IsPublicKeyAllowed(pk []byte) bool {
+	if stub.IsPublicKeyAllowedCalled != nil {
+		return stub.IsPublicKeyAllowedCalled(pk)
+	}
+
+	return false
+}
+
+// GetPeersReputation -
+func (stub *PeerReputationHandlerStub) GetPeersReputation() []*common.PeerReputationAPIResponse {
+	if stub.GetPeersReputationCalled != nil {
+		return stub.GetPeersReputationCalled()
+	}
+
+	return make([]*common.PeerReputationAPIResponse, 0)
+}
+
+// Close -
+func (stub *PeerReputationHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeerReputationHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
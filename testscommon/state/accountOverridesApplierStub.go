@@ -0,0 +1,25 @@
+package state
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// AccountOverridesApplierStub -
+type AccountOverridesApplierStub struct {
+	ApplyOverrideCalled func(account state.UserAccountHandler, override *common.AccountStateOverride) ([]byte, error)
+}
+
+// ApplyOverride -
+func (stub *AccountOverridesApplierStub) ApplyOverride(account state.UserAccountHandler, override *common.AccountStateOverride) ([]byte, error) {
+	if stub.ApplyOverrideCalled != nil {
+		return stub.ApplyOverrideCalled(account, override)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *AccountOverridesApplierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
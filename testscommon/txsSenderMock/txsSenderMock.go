@@ -1,6 +1,8 @@
 package txsSenderMock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
@@ -10,7 +12,7 @@ type TxsSenderHandlerMock struct {
 }
 
 // SendBulkTransactions -
-func (tsm *TxsSenderHandlerMock) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
+func (tsm *TxsSenderHandlerMock) SendBulkTransactions(_ context.Context, txs []*transaction.Transaction) (uint64, error) {
 	if tsm.SendBulkTransactionsCalled != nil {
 		return tsm.SendBulkTransactionsCalled(txs)
 	}
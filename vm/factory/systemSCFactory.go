@@ -240,6 +240,7 @@ func (scf *systemSCFactory) createGovernanceContract() (vm.SystemSmartContract,
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
 		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
 		ValidatorSCAddress:     vm.ValidatorSCAddress,
+		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		EnableEpochsHandler:    scf.enableEpochsHandler,
 		UnBondPeriodInEpochs:   scf.systemSCConfig.StakingSystemSCConfig.UnBondPeriodInEpochs,
 		OwnerAddress:           ownerAddress,
@@ -267,6 +268,7 @@ func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract,
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
 		AddTokensAddress:       addTokensAddress,
+		ESDTSCAddress:          vm.ESDTSCAddress,
 		EnableEpochsHandler:    scf.enableEpochsHandler,
 	}
 	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
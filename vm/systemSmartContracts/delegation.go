@@ -53,6 +53,7 @@ type delegation struct {
 	endOfEpochAddr         []byte
 	governanceSCAddr       []byte
 	addTokensAddr          []byte
+	esdtSCAddr             []byte
 	gasCost                vm.GasCost
 	marshalizer            marshal.Marshalizer
 	minServiceFee          uint64
@@ -77,6 +78,7 @@ type ArgsNewDelegation struct {
 	EndOfEpochAddress      []byte
 	GovernanceSCAddress    []byte
 	AddTokensAddress       []byte
+	ESDTSCAddress          []byte
 	GasCost                vm.GasCost
 	Marshalizer            marshal.Marshalizer
 	EnableEpochsHandler    common.EnableEpochsHandler
@@ -114,6 +116,9 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 	if len(args.AddTokensAddress) < 1 {
 		return nil, fmt.Errorf("%w for add tokens address", vm.ErrInvalidAddress)
 	}
+	if len(args.ESDTSCAddress) < 1 {
+		return nil, fmt.Errorf("%w for esdt sc address", vm.ErrInvalidAddress)
+	}
 	if check.IfNil(args.EnableEpochsHandler) {
 		return nil, vm.ErrNilEnableEpochsHandler
 	}
@@ -128,6 +133,8 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		common.StakingV2FlagAfterEpoch,
 		common.FixDelegationChangeOwnerOnAccountFlag,
 		common.MultiClaimOnDelegationFlag,
+		common.GovernanceParameterChangesFlag,
+		common.DelegationLiquidStakingFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -147,6 +154,7 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		endOfEpochAddr:         args.EndOfEpochAddress,
 		governanceSCAddr:       args.GovernanceSCAddress,
 		addTokensAddr:          args.AddTokensAddress,
+		esdtSCAddr:             args.ESDTSCAddress,
 		enableEpochsHandler:    args.EnableEpochsHandler,
 	}
 
@@ -285,6 +293,16 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.changeOwner(args)
 	case "synchronizeOwner":
 		return d.synchronizeOwner(args)
+	case "enableLiquidStaking":
+		return d.enableLiquidStaking(args)
+	case "delegateLiquid":
+		return d.delegateLiquid(args)
+	case redeemLiquidStakingToken:
+		return d.redeemLiquidStakingToken(args)
+	case "getLiquidStakingData":
+		return d.getLiquidStakingData(args)
+	case "getLiquidStakingValue":
+		return d.getLiquidStakingValue(args)
 	}
 
 	d.eei.AddReturnMessage(args.Function + " is an unknown function")
@@ -730,7 +748,7 @@ func (d *delegation) makeStakeArgsIfAutomaticActivation(
 		return nil
 	}
 
-	maxNodesToStake := big.NewInt(0).Div(globalFund.TotalActive, d.nodePrice).Uint64()
+	maxNodesToStake := big.NewInt(0).Div(globalFund.TotalActive, d.getNodePrice()).Uint64()
 	numStakedNodes := uint64(len(status.StakedKeys) + len(status.UnStakedKeys))
 	if maxNodesToStake <= numStakedNodes {
 		return nil
@@ -1198,7 +1216,7 @@ func (d *delegation) stakeNodes(args *vmcommon.ContractCallInput) vmcommon.Retur
 	}
 
 	numNodesToStake := big.NewInt(int64(len(args.Arguments) + len(status.StakedKeys)))
-	stakeValue := big.NewInt(0).Mul(d.nodePrice, numNodesToStake)
+	stakeValue := big.NewInt(0).Mul(d.getNodePrice(), numNodesToStake)
 
 	if globalFund.TotalActive.Cmp(stakeValue) < 0 {
 		d.eei.AddReturnMessage("not enough in total active to stake")
@@ -2968,6 +2986,20 @@ func (d *delegation) executeStakeAndUpdateStatus(
 	return vmcommon.Ok
 }
 
+// getNodePrice returns the node price changed through governance, if any, otherwise the configured one
+func (d *delegation) getNodePrice() *big.Int {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		return d.nodePrice
+	}
+
+	nodePrice := getGovernanceNodePrice(d.eei, d.validatorSCAddr)
+	if nodePrice == nil {
+		return d.nodePrice
+	}
+
+	return nodePrice
+}
+
 func (d *delegation) executeOnValidatorSC(address []byte, function string, args [][]byte, value *big.Int) (*vmcommon.VMOutput, error) {
 	validatorCall := function
 	for _, key := range args {
@@ -0,0 +1,490 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// The liquid staking mode pools the stake of all the liquid delegators under the delegation contract's own address,
+// which no user can ever call from. Every delegateLiquid call mints shares of the pool as a fungible ESDT, valued
+// at the exchange rate between the pool stake and the total number of shares. The pool rewards are redelegated
+// before each operation, so the exchange rate grows with the rewards. Holders redeem their shares by burning them
+// with ESDTBurn, after which the ESDT SC calls redeemLiquidStakingToken and the redeemed value is unDelegated in
+// the name of the holder, to be withdrawn as any other unStaked fund.
+
+const delegationLiquidTokenKey = "liquidStakingTokenID"
+const delegationLiquidSharesKey = "liquidStakingShares"
+const liquidStakingTokenDecimals = 18
+
+func (d *delegation) enableLiquidStaking(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if !d.isOwner(args.CallerAddr) {
+		d.eei.AddReturnMessage("only owner can call this method")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected token name and ticker")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(d.getLiquidStakingToken()) > 0 {
+		d.eei.AddReturnMessage("liquid staking is already enabled")
+		return vmcommon.UserError
+	}
+
+	txData := "registerLiquidStakingToken@" + hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(args.Arguments[1]) +
+		"@" + hex.EncodeToString(big.NewInt(liquidStakingTokenDecimals).Bytes())
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.esdtSCAddr, args.RecipientAddr, args.CallValue, []byte(txData))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+	if len(vmOutput.ReturnData) == 0 {
+		d.eei.AddReturnMessage("liquid staking token was not issued")
+		return vmcommon.UserError
+	}
+	tokenID := vmOutput.ReturnData[0]
+
+	vmOutput, err = d.eei.ExecuteOnDestContext(d.delegationMgrSCAddress, args.RecipientAddr, big.NewInt(0), []byte("registerLiquidStakingContract"))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	d.eei.SetStorage([]byte(delegationLiquidTokenKey), tokenID)
+	d.eei.Finish(tokenID)
+	d.createAndAddLogEntry(args, tokenID)
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) delegateLiquid(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	tokenID := d.getLiquidStakingToken()
+	if len(tokenID) == 0 {
+		d.eei.AddReturnMessage("liquid staking is not enabled")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	delegationManagement, err := getDelegationManagement(d.eei, d.marshalizer, d.delegationMgrSCAddress)
+	if err != nil {
+		d.eei.AddReturnMessage("error getting minimum delegation amount " + err.Error())
+		return vmcommon.UserError
+	}
+	minDelegationAmount := delegationManagement.MinDelegationAmount
+	if args.CallValue.Cmp(minDelegationAmount) < 0 {
+		d.eei.AddReturnMessage("delegate value must be higher than minDelegationAmount " + minDelegationAmount.String())
+		return vmcommon.UserError
+	}
+	err = d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	pool, returnCode := d.compoundLiquidStakingRewards(args.RecipientAddr)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	poolStake, err := d.getActiveFundValue(pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	totalShares := d.getLiquidStakingShares()
+	shares := big.NewInt(0).Set(args.CallValue)
+	if totalShares.Cmp(zero) > 0 && poolStake.Cmp(zero) > 0 {
+		shares.Mul(args.CallValue, totalShares)
+		shares.Div(shares, poolStake)
+	}
+	if shares.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("delegate value too small for a liquid staking share")
+		return vmcommon.UserError
+	}
+
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	returnCode = d.finishDelegateUser(globalFund, pool, dConfig, dStatus, args.RecipientAddr,
+		args.RecipientAddr, args.CallValue, args.CallValue, false, true)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	d.saveLiquidStakingShares(totalShares.Add(totalShares, shares))
+
+	txData := "mintLiquidStakingToken@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(shares.Bytes()) +
+		"@" + hex.EncodeToString(args.CallerAddr)
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.esdtSCAddr, args.RecipientAddr, big.NewInt(0), []byte(txData))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	d.createAndAddLogEntry(args, args.CallValue.Bytes(), shares.Bytes(), tokenID)
+
+	return vmcommon.Ok
+}
+
+// format: redeemLiquidStakingToken@holder@shares, callable by the ESDT SC only, after the shares were burnt
+func (d *delegation) redeemLiquidStakingToken(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, d.esdtSCAddr) {
+		d.eei.AddReturnMessage(args.Function + " can be called by the ESDT SC only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	holder := args.Arguments[0]
+	shares := big.NewInt(0).SetBytes(args.Arguments[1])
+	totalShares := d.getLiquidStakingShares()
+	if shares.Cmp(zero) <= 0 || shares.Cmp(totalShares) > 0 {
+		d.eei.AddReturnMessage("invalid number of liquid staking shares")
+		return vmcommon.UserError
+	}
+
+	pool, returnCode := d.compoundLiquidStakingRewards(args.RecipientAddr)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(pool.ActiveFund) == 0 {
+		d.eei.AddReturnMessage("liquid staking pool is empty")
+		return vmcommon.UserError
+	}
+	poolFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	valueToUnDelegate := big.NewInt(0).Mul(shares, poolFund.Value)
+	valueToUnDelegate.Div(valueToUnDelegate, totalShares)
+	if valueToUnDelegate.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("shares value too small to redeem")
+		return vmcommon.UserError
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	returnData, returnCode := d.executeOnValidatorSCWithValueInArgs(args.RecipientAddr, "unStakeTokens", valueToUnDelegate)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	actualUnStake, err := d.resolveUnStakedUnBondResponse(returnData, valueToUnDelegate)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	poolFund.Value.Sub(poolFund.Value, actualUnStake)
+	err = d.saveFund(pool.ActiveFund, poolFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if poolFund.Value.Cmp(zero) == 0 {
+		pool.ActiveFund = nil
+	}
+
+	returnCode = d.addUnStakedFundForHolder(holder, actualUnStake)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	globalFund.TotalActive.Sub(globalFund.TotalActive, actualUnStake)
+	globalFund.TotalUnStaked.Add(globalFund.TotalUnStaked, actualUnStake)
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	err = d.saveDelegatorData(args.RecipientAddr, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.saveLiquidStakingShares(totalShares.Sub(totalShares, shares))
+	d.createAndAddLogEntryCustom(args.Function, holder, actualUnStake.Bytes(), shares.Bytes(), globalFund.TotalActive.Bytes())
+
+	return vmcommon.Ok
+}
+
+// addUnStakedFundForHolder credits the redeemed value to the holder as an unStaked fund, so it can be withdrawn
+// after the unBond period through the regular withdraw endpoint
+func (d *delegation) addUnStakedFundForHolder(holder []byte, value *big.Int) vmcommon.ReturnCode {
+	isNew, delegator, err := d.getOrCreateDelegatorData(holder)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		delegator.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+
+		dStatus, errGet := d.getDelegationStatus()
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		dStatus.NumUsers++
+		err = d.saveDelegationStatus(dStatus)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	err = d.addNewUnStakedFund(holder, delegator, value)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(delegator.UnStakedFunds) > maxNumOfUnStakedFunds {
+		d.eei.AddReturnMessage("number of unDelegate limit reached, withDraw required")
+		return vmcommon.UserError
+	}
+
+	err = d.saveDelegatorData(holder, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// compoundLiquidStakingRewards redelegates the rewards of the liquid staking pool, which is kept under the contract's
+// address, and returns the updated pool delegator data
+func (d *delegation) compoundLiquidStakingRewards(scAddress []byte) (*DelegatorData, vmcommon.ReturnCode) {
+	isNew, pool, err := d.getOrCreateDelegatorData(scAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if isNew {
+		pool.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+		return pool, vmcommon.Ok
+	}
+
+	err = d.computeAndUpdateRewards(scAddress, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if pool.UnClaimedRewards.Cmp(zero) == 0 {
+		return pool, vmcommon.Ok
+	}
+
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	dStatus, err := d.getDelegationStatus()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	rewards := big.NewInt(0).Set(pool.UnClaimedRewards)
+	pool.TotalCumulatedRewards.Add(pool.TotalCumulatedRewards, rewards)
+	pool.UnClaimedRewards.SetUint64(0)
+	globalFund.TotalActive.Add(globalFund.TotalActive, rewards)
+
+	err = d.addToActiveFund(scAddress, pool, rewards, dStatus, false)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	returnCode := d.executeStakeAndUpdateStatus(dConfig, dStatus, globalFund, rewards, scAddress)
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+
+	err = d.saveDelegatorData(scAddress, pool)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	return pool, vmcommon.Ok
+}
+
+// getLiquidStakingData returns the liquid staking token, the total number of shares and the pool stake, including
+// the rewards not yet redelegated
+func (d *delegation) getLiquidStakingData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	returnCode := d.checkArgumentsForGeneralViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	poolValue, err := d.computeLiquidStakingPoolValue(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(d.getLiquidStakingToken())
+	d.eei.Finish(d.getLiquidStakingShares().Bytes())
+	d.eei.Finish(poolValue.Bytes())
+
+	return vmcommon.Ok
+}
+
+// getLiquidStakingValue returns the current value of the provided number of shares
+func (d *delegation) getLiquidStakingValue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+
+	totalShares := d.getLiquidStakingShares()
+	if totalShares.Cmp(zero) == 0 {
+		d.eei.Finish(big.NewInt(0).Bytes())
+		return vmcommon.Ok
+	}
+
+	poolValue, err := d.computeLiquidStakingPoolValue(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	value := big.NewInt(0).SetBytes(args.Arguments[0])
+	value.Mul(value, poolValue)
+	value.Div(value, totalShares)
+	d.eei.Finish(value.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) computeLiquidStakingPoolValue(scAddress []byte) (*big.Int, error) {
+	_, pool, err := d.getOrCreateDelegatorData(scAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	poolValue, err := d.getActiveFundValue(pool)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingRewards, err := d.computeRewards(pool.RewardsCheckpoint, false, poolValue)
+	if err != nil {
+		return nil, err
+	}
+
+	poolValue.Add(poolValue, pendingRewards)
+	poolValue.Add(poolValue, pool.UnClaimedRewards)
+
+	return poolValue, nil
+}
+
+func (d *delegation) getActiveFundValue(delegator *DelegatorData) (*big.Int, error) {
+	if len(delegator.ActiveFund) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	fund, err := d.getFund(delegator.ActiveFund)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).Set(fund.Value), nil
+}
+
+func (d *delegation) getLiquidStakingToken() []byte {
+	return d.eei.GetStorage([]byte(delegationLiquidTokenKey))
+}
+
+func (d *delegation) getLiquidStakingShares() *big.Int {
+	return big.NewInt(0).SetBytes(d.eei.GetStorage([]byte(delegationLiquidSharesKey)))
+}
+
+func (d *delegation) saveLiquidStakingShares(shares *big.Int) {
+	d.eei.SetStorage([]byte(delegationLiquidSharesKey), shares.Bytes())
+}
@@ -0,0 +1,306 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/mock"
+	"github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var liquidTokenID = []byte("LQD-abcdef")
+
+func createLiquidStakingDelegationAndEEI(esdtCalls *[]*vmcommon.ContractCallInput) (*delegation, *vmContext) {
+	args := createMockArgumentsForDelegation()
+	enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	enableEpochsHandler.AddActiveFlags(common.DelegationLiquidStakingFlag)
+
+	eei, _ := NewVMContext(VMContextArgs{
+		BlockChainHook: &mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return 2
+			},
+		},
+		CryptoHook:          hooks.NewVMCryptoHook(),
+		InputParser:         parsers.NewCallArgsParser(),
+		ValidatorAccountsDB: &stateMock.AccountsStub{},
+		UserAccountsDB:      &stateMock.AccountsStub{},
+		ChanceComputer:      &mock.RaterMock{},
+		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.MultiClaimOnDelegationFlag),
+	})
+	esdtStub := &mock.SystemSCStub{ExecuteCalled: func(input *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+		*esdtCalls = append(*esdtCalls, input)
+		if input.Function == "registerLiquidStakingToken" {
+			eei.Finish(liquidTokenID)
+		}
+		return vmcommon.Ok
+	}}
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		if bytes.Equal(key, vm.ESDTSCAddress) {
+			return esdtStub, nil
+		}
+		return &mock.SystemSCStub{ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			return vmcommon.Ok
+		}}, nil
+	}})
+
+	args.Eei = eei
+	d, _ := NewDelegationSystemSC(args)
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+
+	eei.SetSCAddress([]byte("addr"))
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(0),
+		TotalUnStaked: big.NewInt(0),
+	})
+
+	return d, eei
+}
+
+func enableLiquidStakingForTest(t *testing.T, d *delegation, eei *vmContext) {
+	vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("LiquidToken"), []byte("LQD")})
+	require.Equal(t, vmcommon.Ok, d.Execute(vmInput))
+	eei.output = make([][]byte, 0)
+}
+
+func TestDelegationSystemSC_EnableLiquidStaking(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+		enableEpochsHandler, _ := d.enableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+		enableEpochsHandler.RemoveActiveFlags(common.DelegationLiquidStakingFlag)
+
+		vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("LiquidToken"), []byte("LQD")})
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, "enableLiquidStaking is an unknown function", eei.returnMessage)
+	})
+	t.Run("caller not owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+
+		vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("LiquidToken"), []byte("LQD")})
+		vmInput.CallerAddr = []byte("not owner")
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, "only owner can call this method", eei.returnMessage)
+	})
+	t.Run("wrong number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, _ := createLiquidStakingDelegationAndEEI(&esdtCalls)
+
+		vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("LiquidToken")})
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.FunctionWrongSignature, returnCode)
+	})
+	t.Run("should work and fail on the second call", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+
+		enableLiquidStakingForTest(t, d, eei)
+		assert.Equal(t, liquidTokenID, d.getLiquidStakingToken())
+		require.Equal(t, 1, len(esdtCalls))
+		assert.Equal(t, "registerLiquidStakingToken", esdtCalls[0].Function)
+		assert.Equal(t, [][]byte{[]byte("LiquidToken"), []byte("LQD"), big.NewInt(liquidStakingTokenDecimals).Bytes()}, esdtCalls[0].Arguments)
+
+		vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("LiquidToken"), []byte("LQD")})
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, "liquid staking is already enabled", eei.returnMessage)
+	})
+}
+
+func TestDelegationSystemSC_DelegateLiquid(t *testing.T) {
+	t.Parallel()
+
+	t.Run("liquid staking not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+
+		vmInput := getDefaultVmInputForFunc("delegateLiquid", [][]byte{})
+		vmInput.CallValue = big.NewInt(100)
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, "liquid staking is not enabled", eei.returnMessage)
+	})
+	t.Run("value below min delegation amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+		enableLiquidStakingForTest(t, d, eei)
+
+		vmInput := getDefaultVmInputForFunc("delegateLiquid", [][]byte{})
+		vmInput.CallValue = big.NewInt(5)
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.True(t, strings.Contains(eei.returnMessage, "delegate value must be higher than minDelegationAmount"))
+	})
+	t.Run("should mint shares at the pool exchange rate", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+		enableLiquidStakingForTest(t, d, eei)
+
+		vmInput := getDefaultVmInputForFunc("delegateLiquid", [][]byte{})
+		vmInput.CallerAddr = []byte("delegator1")
+		vmInput.CallValue = big.NewInt(100)
+		returnCode := d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, returnCode)
+		assert.Equal(t, big.NewInt(100), d.getLiquidStakingShares())
+
+		require.Equal(t, 2, len(esdtCalls))
+		assert.Equal(t, "mintLiquidStakingToken", esdtCalls[1].Function)
+		assert.Equal(t, [][]byte{liquidTokenID, big.NewInt(100).Bytes(), []byte("delegator1")}, esdtCalls[1].Arguments)
+
+		_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+		poolFund, _ := d.getFund(pool.ActiveFund)
+		assert.Equal(t, big.NewInt(100), poolFund.Value)
+		globalFund, _ := d.getGlobalFundData()
+		assert.Equal(t, big.NewInt(100), globalFund.TotalActive)
+
+		// the pool doubled its value, so the next delegator receives half the shares
+		poolFund.Value = big.NewInt(200)
+		_ = d.saveFund(pool.ActiveFund, poolFund)
+
+		vmInput.CallerAddr = []byte("delegator2")
+		vmInput.CallValue = big.NewInt(50)
+		returnCode = d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, returnCode)
+		assert.Equal(t, big.NewInt(125), d.getLiquidStakingShares())
+		assert.Equal(t, [][]byte{liquidTokenID, big.NewInt(25).Bytes(), []byte("delegator2")}, esdtCalls[2].Arguments)
+
+		_, pool, _ = d.getOrCreateDelegatorData([]byte("addr"))
+		poolFund, _ = d.getFund(pool.ActiveFund)
+		assert.Equal(t, big.NewInt(250), poolFund.Value)
+	})
+}
+
+func TestDelegationSystemSC_RedeemLiquidStakingToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("caller not ESDT SC should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+
+		vmInput := getDefaultVmInputForFunc(redeemLiquidStakingToken, [][]byte{[]byte("holder"), big.NewInt(10).Bytes()})
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, redeemLiquidStakingToken+" can be called by the ESDT SC only", eei.returnMessage)
+	})
+	t.Run("more shares than issued should error", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+		d.saveLiquidStakingShares(big.NewInt(10))
+
+		vmInput := getDefaultVmInputForFunc(redeemLiquidStakingToken, [][]byte{[]byte("holder"), big.NewInt(11).Bytes()})
+		vmInput.CallerAddr = vm.ESDTSCAddress
+		returnCode := d.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, "invalid number of liquid staking shares", eei.returnMessage)
+	})
+	t.Run("should unDelegate the shares value for the holder", func(t *testing.T) {
+		t.Parallel()
+
+		esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+		d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+		enableLiquidStakingForTest(t, d, eei)
+
+		vmInput := getDefaultVmInputForFunc("delegateLiquid", [][]byte{})
+		vmInput.CallerAddr = []byte("delegator1")
+		vmInput.CallValue = big.NewInt(100)
+		require.Equal(t, vmcommon.Ok, d.Execute(vmInput))
+
+		_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+		poolFund, _ := d.getFund(pool.ActiveFund)
+		poolFund.Value = big.NewInt(200)
+		_ = d.saveFund(pool.ActiveFund, poolFund)
+
+		vmInput = getDefaultVmInputForFunc(redeemLiquidStakingToken, [][]byte{[]byte("holder"), big.NewInt(40).Bytes()})
+		vmInput.CallerAddr = vm.ESDTSCAddress
+		returnCode := d.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, returnCode)
+
+		assert.Equal(t, big.NewInt(60), d.getLiquidStakingShares())
+		poolFund, _ = d.getFund(pool.ActiveFund)
+		assert.Equal(t, big.NewInt(120), poolFund.Value)
+
+		_, holder, _ := d.getOrCreateDelegatorData([]byte("holder"))
+		require.Equal(t, 1, len(holder.UnStakedFunds))
+		unStakedFund, _ := d.getFund(holder.UnStakedFunds[0])
+		assert.Equal(t, big.NewInt(80), unStakedFund.Value)
+		assert.Equal(t, []byte("holder"), unStakedFund.Address)
+
+		dStatus, _ := d.getDelegationStatus()
+		assert.Equal(t, uint64(1), dStatus.NumUsers)
+		globalFund, _ := d.getGlobalFundData()
+		assert.Equal(t, big.NewInt(80), globalFund.TotalUnStaked)
+	})
+}
+
+func TestDelegationSystemSC_GetLiquidStakingValue(t *testing.T) {
+	t.Parallel()
+
+	esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+	d, eei := createLiquidStakingDelegationAndEEI(&esdtCalls)
+
+	vmInput := getDefaultVmInputForFunc("getLiquidStakingValue", [][]byte{big.NewInt(10).Bytes()})
+	returnCode := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, returnCode)
+	assert.Equal(t, big.NewInt(0).Bytes(), eei.output[0])
+
+	enableLiquidStakingForTest(t, d, eei)
+	vmInput = getDefaultVmInputForFunc("delegateLiquid", [][]byte{})
+	vmInput.CallValue = big.NewInt(100)
+	require.Equal(t, vmcommon.Ok, d.Execute(vmInput))
+
+	_, pool, _ := d.getOrCreateDelegatorData([]byte("addr"))
+	poolFund, _ := d.getFund(pool.ActiveFund)
+	poolFund.Value = big.NewInt(300)
+	_ = d.saveFund(pool.ActiveFund, poolFund)
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getLiquidStakingValue", [][]byte{big.NewInt(10).Bytes()})
+	returnCode = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, returnCode)
+	assert.Equal(t, big.NewInt(30).Bytes(), eei.output[0])
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getLiquidStakingData", [][]byte{})
+	returnCode = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, returnCode)
+	assert.Equal(t, [][]byte{liquidTokenID, big.NewInt(100).Bytes(), big.NewInt(300).Bytes()}, eei.output)
+}
@@ -19,6 +19,7 @@ import (
 
 const delegationManagementKey = "delegationManagement"
 const delegationContractsList = "delegationContracts"
+const liquidStakingContractsList = "liquidStakingContracts"
 
 var nextAddressAdd = big.NewInt(1 << 24)
 
@@ -80,6 +81,7 @@ func NewDelegationManagerSystemSC(args ArgsNewDelegationManager) (*delegationMan
 		common.ValidatorToDelegationFlag,
 		common.FixDelegationChangeOwnerOnAccountFlag,
 		common.MultiClaimOnDelegationFlag,
+		common.DelegationLiquidStakingFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -157,6 +159,10 @@ func (d *delegationManager) Execute(args *vmcommon.ContractCallInput) vmcommon.R
 		return d.claimMulti(args)
 	case "reDelegateMulti":
 		return d.reDelegateMulti(args)
+	case "registerLiquidStakingContract":
+		return d.registerLiquidStakingContract(args)
+	case "getAllLiquidStakingContracts":
+		return d.getAllLiquidStakingContracts(args)
 	}
 
 	d.eei.AddReturnMessage("invalid function to call")
@@ -507,6 +513,79 @@ func (d *delegationManager) getAllContractAddresses(args *vmcommon.ContractCallI
 	return vmcommon.Ok
 }
 
+// registerLiquidStakingContract is called by the delegation contracts which issued a liquid staking token
+func (d *delegationManager) registerLiquidStakingContract(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		d.eei.AddReturnMessage("wrong number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationMgrOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	contractList, err := getDelegationContractList(d.eei, d.marshalizer, d.delegationMgrSCAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !isAddressInList(contractList.Addresses, args.CallerAddr) {
+		d.eei.AddReturnMessage("caller is not a delegation contract")
+		return vmcommon.UserError
+	}
+
+	liquidStakingList, err := d.getLiquidStakingContractList()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isAddressInList(liquidStakingList.Addresses, args.CallerAddr) {
+		d.eei.AddReturnMessage("liquid staking contract already registered")
+		return vmcommon.UserError
+	}
+
+	liquidStakingList.Addresses = append(liquidStakingList.Addresses, args.CallerAddr)
+	err = d.saveLiquidStakingContractList(liquidStakingList)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegationManager) getAllLiquidStakingContracts(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		d.eei.AddReturnMessage("invalid function to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, d.delegationMgrSCAddress) {
+		d.eei.AddReturnMessage(vm.ErrInvalidCaller.Error())
+		return vmcommon.UserError
+	}
+
+	liquidStakingList, err := d.getLiquidStakingContractList()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, address := range liquidStakingList.Addresses {
+		d.eei.Finish(address)
+	}
+
+	return vmcommon.Ok
+}
+
 func (d *delegationManager) getContractConfig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !bytes.Equal(args.CallerAddr, d.delegationMgrSCAddress) {
 		d.eei.AddReturnMessage(vm.ErrInvalidCaller.Error())
@@ -689,6 +768,40 @@ func (d *delegationManager) saveDelegationContractList(list *DelegationContractL
 	return nil
 }
 
+func (d *delegationManager) getLiquidStakingContractList() (*DelegationContractList, error) {
+	contractList := &DelegationContractList{Addresses: make([][]byte, 0)}
+	marshaledData := d.eei.GetStorage([]byte(liquidStakingContractsList))
+	if len(marshaledData) == 0 {
+		return contractList, nil
+	}
+
+	err := d.marshalizer.Unmarshal(contractList, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	return contractList, nil
+}
+
+func (d *delegationManager) saveLiquidStakingContractList(list *DelegationContractList) error {
+	marshaledData, err := d.marshalizer.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(liquidStakingContractsList), marshaledData)
+	return nil
+}
+
+func isAddressInList(addresses [][]byte, address []byte) bool {
+	for _, existing := range addresses {
+		if bytes.Equal(existing, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // SetNewGasCost is called whenever a gas cost was changed
 func (d *delegationManager) SetNewGasCost(gasCost vm.GasCost) {
 	d.mutExecution.Lock()
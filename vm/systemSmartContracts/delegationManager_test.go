@@ -1298,3 +1298,48 @@ func TestDelegationManager_CorrectOwnerOnAccount(t *testing.T) {
 		assert.True(t, updateCalled)
 	})
 }
+
+func TestDelegationManagerSystemSC_RegisterLiquidStakingContract(t *testing.T) {
+	t.Parallel()
+
+	addr1 := []byte("addr1")
+	args := createMockArgumentsForDelegationManager()
+	epochsHandler := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	eei := createDefaultEei()
+	args.Eei = eei
+
+	dm, _ := NewDelegationManagerSystemSC(args)
+	eei.SetSCAddress(dm.delegationMgrSCAddress)
+	_ = dm.saveDelegationContractList(&DelegationContractList{Addresses: [][]byte{addr1}})
+	vmInput := getDefaultVmInputForDelegationManager("registerLiquidStakingContract", [][]byte{})
+
+	output := dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "invalid function to call", eei.returnMessage)
+
+	epochsHandler.AddActiveFlags(common.DelegationLiquidStakingFlag)
+	eei.returnMessage = ""
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "caller is not a delegation contract", eei.returnMessage)
+
+	vmInput.CallerAddr = addr1
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	eei.returnMessage = ""
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, "liquid staking contract already registered", eei.returnMessage)
+
+	vmInput = getDefaultVmInputForDelegationManager("getAllLiquidStakingContracts", [][]byte{})
+	eei.returnMessage = ""
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrInvalidCaller.Error(), eei.returnMessage)
+
+	vmInput.CallerAddr = dm.delegationMgrSCAddress
+	output = dm.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{addr1}, eei.output)
+}
@@ -47,6 +47,7 @@ func createMockArgumentsForDelegation() ArgsNewDelegation {
 		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
 		AddTokensAddress:       bytes.Repeat([]byte{1}, 32),
+		ESDTSCAddress:          vm.ESDTSCAddress,
 		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(
 			common.DelegationSmartContractFlag,
 			common.StakingV2FlagAfterEpoch,
@@ -217,6 +218,18 @@ func TestNewDelegationSystemSC_InvalidDelegationMgrSCAddrShouldErr(t *testing.T)
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestNewDelegationSystemSC_InvalidESDTSCAddrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := fmt.Errorf("%w for esdt sc address", vm.ErrInvalidAddress)
+	args := createMockArgumentsForDelegation()
+	args.ESDTSCAddress = []byte{}
+
+	d, err := NewDelegationSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNewDelegationSystemSC_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
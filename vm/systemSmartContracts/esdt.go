@@ -102,6 +102,7 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		common.ESDTNFTCreateOnMultiShardFlag,
 		common.NFTStopCreateFlag,
 		common.DynamicESDTFlag,
+		common.DelegationLiquidStakingFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -168,6 +169,14 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.burn(args)
 	case "mint":
 		return e.mint(args)
+	case "registerLiquidStakingToken":
+		return e.registerLiquidStakingToken(args)
+	case "mintLiquidStakingToken":
+		return e.mintLiquidStakingToken(args)
+	case "setSupplyPolicy":
+		return e.setSupplyPolicy(args)
+	case "allocateMintAllowance":
+		return e.allocateMintAllowance(args)
 	case "freeze":
 		return e.toggleFreeze(args, core.BuiltInFunctionESDTFreeze)
 	case "unFreeze":
@@ -883,6 +892,13 @@ func getStringFromBool(val bool) string {
 }
 
 func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if e.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) && len(args.Arguments) == 2 {
+		delegationAddress := e.getLiquidStakingTokenOwner(args.Arguments[0])
+		if len(delegationAddress) > 0 {
+			return e.burnLiquidStakingToken(args, delegationAddress)
+		}
+	}
+
 	if !e.enableEpochsHandler.IsFlagEnabled(common.GlobalMintBurnFlag) {
 		e.eei.AddReturnMessage("global burn is no more enabled, use local burn")
 		return vmcommon.UserError
@@ -962,9 +978,14 @@ func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("token is not mintable")
 		return vmcommon.UserError
 	}
+	err := e.checkAndAllocateGlobalMint(args.Arguments[0], mintValue)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
 
 	token.MintedValue.Add(token.MintedValue, mintValue)
-	err := e.saveToken(args.Arguments[0], token)
+	err = e.saveToken(args.Arguments[0], token)
 	if err != nil {
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
@@ -1373,6 +1394,7 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	e.eei.Finish([]byte("CanTransferNFTCreateRole-" + getStringFromBool(esdtToken.CanTransferNFTCreateRole)))
 	e.eei.Finish([]byte("NFTCreateStopped-" + getStringFromBool(esdtToken.NFTCreateStopped)))
 	e.eei.Finish([]byte(fmt.Sprintf("NumWiped-%d", esdtToken.NumWiped)))
+	e.finishSupplyPolicyProperties(args.Arguments[0])
 
 	return vmcommon.Ok
 }
@@ -1943,6 +1965,7 @@ func (e *esdt) setSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return returnCode
 	}
 
+	e.initMintAllowanceOnLocalMintRole(args.Arguments[0], args.Arguments[1], args.Arguments[2:])
 	returnCode = e.prepareAndSendRoleChangeData(args.Arguments[0], args.Arguments[1], args.Arguments[2:], properties)
 	if returnCode != vmcommon.Ok {
 		return returnCode
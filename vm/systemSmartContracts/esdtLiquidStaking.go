@@ -0,0 +1,188 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const liquidStakingTokenPrefix = "liquidStakingToken"
+const redeemLiquidStakingToken = "redeemLiquidStakingToken"
+
+// format: registerLiquidStakingToken@tokenName@ticker@numOfDecimals
+// the caller must be a delegation contract, which becomes the owner of the new fungible token
+func (e *esdt) registerLiquidStakingToken(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	returnCode := e.checkBasicCreateArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) != 3 {
+		e.eei.AddReturnMessage("arguments length mismatch")
+		return vmcommon.FunctionWrongSignature
+	}
+	if !e.isDelegationContract(args.CallerAddr) {
+		e.eei.AddReturnMessage("caller is not a delegation contract")
+		return vmcommon.UserError
+	}
+
+	numOfDecimals := uint32(big.NewInt(0).SetBytes(args.Arguments[2]).Uint64())
+	if numOfDecimals < minNumberOfDecimals || numOfDecimals > maxNumberOfDecimals {
+		e.eei.AddReturnMessage(fmt.Errorf("%w, minimum: %d, maximum: %d, provided: %d",
+			vm.ErrInvalidNumberOfDecimals,
+			minNumberOfDecimals,
+			maxNumberOfDecimals,
+			numOfDecimals,
+		).Error())
+		return vmcommon.UserError
+	}
+
+	tokenIdentifier, token, err := e.createNewToken(
+		args.CallerAddr,
+		args.Arguments[0],
+		args.Arguments[1],
+		big.NewInt(0),
+		numOfDecimals,
+		[][]byte{},
+		[]byte(core.FungibleESDT))
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	// the supply is managed by the delegation contract only, so the token properties are frozen
+	token.Mintable = true
+	token.Burnable = true
+	token.Upgradable = false
+	token.CanChangeOwner = false
+	token.CanAddSpecialRoles = false
+	err = e.saveToken(tokenIdentifier, token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	e.eei.SetStorage(liquidStakingTokenKey(tokenIdentifier), args.CallerAddr)
+
+	e.eei.Finish(tokenIdentifier)
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.CallerAddr,
+		Topics:     [][]byte{tokenIdentifier, args.Arguments[0], args.Arguments[1], []byte(core.FungibleESDT), big.NewInt(int64(numOfDecimals)).Bytes()},
+	}
+	e.eei.AddLogEntry(logEntry)
+
+	return vmcommon.Ok
+}
+
+// format: mintLiquidStakingToken@tokenID@value@destination
+// can be called only by the delegation contract owning the liquid staking token
+func (e *esdt) mintLiquidStakingToken(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.DelegationLiquidStakingFlag) {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) != 3 {
+		e.eei.AddReturnMessage("arguments length mismatch")
+		return vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !bytes.Equal(e.getLiquidStakingTokenOwner(args.Arguments[0]), args.CallerAddr) {
+		e.eei.AddReturnMessage("token is not a liquid staking token")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[1]) > core.MaxLenForESDTIssueMint {
+		e.eei.AddReturnMessage(fmt.Sprintf("max length for esdt mint is %d", core.MaxLenForESDTIssueMint))
+		return vmcommon.UserError
+	}
+	mintValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if mintValue.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("negative or zero mint value")
+		return vmcommon.UserError
+	}
+	if !e.isAddressValid(args.Arguments[2]) {
+		e.eei.AddReturnMessage("destination address is invalid")
+		return vmcommon.UserError
+	}
+
+	token.MintedValue.Add(token.MintedValue, mintValue)
+	err := e.saveToken(args.Arguments[0], token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(mintValue.Bytes())
+	e.eei.Transfer(args.Arguments[2], e.esdtSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+
+	return vmcommon.Ok
+}
+
+// burnLiquidStakingToken handles the ESDTBurn of a liquid staking token: the tokens were already removed from the
+// caller's account on its shard, so the owning delegation contract is asked to redeem them for the caller
+func (e *esdt) burnLiquidStakingToken(args *vmcommon.ContractCallInput, delegationAddress []byte) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+	burntValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if burntValue.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("negative or 0 value to burn")
+		return vmcommon.UserError
+	}
+	token, err := e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	// on failure the tokens are sent back to the caller, as for any failed cross shard ESDT call
+	txData := redeemLiquidStakingToken + "@" + hex.EncodeToString(args.CallerAddr) + "@" + hex.EncodeToString(burntValue.Bytes())
+	vmOutput, err := e.eei.ExecuteOnDestContext(delegationAddress, e.esdtSCAddress, big.NewInt(0), []byte(txData))
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		e.eei.AddReturnMessage("liquid staking token could not be redeemed")
+		return vmOutput.ReturnCode
+	}
+
+	token.BurntValue.Add(token.BurntValue, burntValue)
+	err = e.saveToken(args.Arguments[0], token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) getLiquidStakingTokenOwner(tokenID []byte) []byte {
+	return e.eei.GetStorage(liquidStakingTokenKey(tokenID))
+}
+
+func (e *esdt) isDelegationContract(address []byte) bool {
+	contractList, err := getDelegationContractList(e.eei, e.marshalizer, vm.DelegationManagerSCAddress)
+	if err != nil {
+		return false
+	}
+
+	return isAddressInList(contractList.Addresses, address)
+}
+
+func liquidStakingTokenKey(tokenID []byte) []byte {
+	return append([]byte(liquidStakingTokenPrefix), tokenID...)
+}
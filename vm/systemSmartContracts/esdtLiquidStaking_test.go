@@ -0,0 +1,195 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/mock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var liquidDelegationAddress = bytes.Repeat([]byte{7}, 32)
+
+func createESDTForLiquidStaking(delegationReturnCode vmcommon.ReturnCode, delegationCalls *[]*vmcommon.ContractCallInput) (*esdt, *vmContext) {
+	args := createMockArgumentsForESDT()
+	enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	enableEpochsHandler.AddActiveFlags(common.DelegationLiquidStakingFlag)
+
+	eeiArgs := createDefaultEeiArgs()
+	eeiArgs.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.MultiClaimOnDelegationFlag)
+	eei, _ := NewVMContext(eeiArgs)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(input *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			*delegationCalls = append(*delegationCalls, input)
+			return delegationReturnCode
+		}}, nil
+	}})
+	args.Eei = eei
+
+	contractList := &DelegationContractList{Addresses: [][]byte{liquidDelegationAddress}}
+	marshaledData, _ := args.Marshalizer.Marshal(contractList)
+	eei.SetStorageForAddress(vm.DelegationManagerSCAddress, []byte(delegationContractsList), marshaledData)
+
+	e, _ := NewESDTSmartContract(args)
+	eei.SetSCAddress(e.esdtSCAddress)
+
+	return e, eei
+}
+
+func createRegisterLiquidStakingTokenInput(e *esdt, caller []byte) *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForFunc("registerLiquidStakingToken", [][]byte{[]byte("LiquidToken"), []byte("LQD"), big.NewInt(18).Bytes()})
+	vmInput.CallerAddr = caller
+	vmInput.CallValue, _ = big.NewInt(0).SetString(e.baseIssuingCost.String(), 10)
+	vmInput.GasProvided = e.gasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei := e.eei.(*vmContext)
+	eei.gasRemaining = vmInput.GasProvided
+
+	return vmInput
+}
+
+func TestEsdt_RegisterLiquidStakingToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		delegationCalls := make([]*vmcommon.ContractCallInput, 0)
+		e, eei := createESDTForLiquidStaking(vmcommon.Ok, &delegationCalls)
+		enableEpochsHandler, _ := e.enableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+		enableEpochsHandler.RemoveActiveFlags(common.DelegationLiquidStakingFlag)
+
+		returnCode := e.Execute(createRegisterLiquidStakingTokenInput(e, liquidDelegationAddress))
+		assert.Equal(t, vmcommon.FunctionNotFound, returnCode)
+		assert.Equal(t, "invalid method to call", eei.returnMessage)
+	})
+	t.Run("caller not a delegation contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		delegationCalls := make([]*vmcommon.ContractCallInput, 0)
+		e, eei := createESDTForLiquidStaking(vmcommon.Ok, &delegationCalls)
+
+		returnCode := e.Execute(createRegisterLiquidStakingTokenInput(e, bytes.Repeat([]byte{8}, 32)))
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, "caller is not a delegation contract", eei.returnMessage)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		delegationCalls := make([]*vmcommon.ContractCallInput, 0)
+		e, eei := createESDTForLiquidStaking(vmcommon.Ok, &delegationCalls)
+
+		returnCode := e.Execute(createRegisterLiquidStakingTokenInput(e, liquidDelegationAddress))
+		require.Equal(t, vmcommon.Ok, returnCode)
+		require.Equal(t, 1, len(eei.output))
+
+		tokenID := eei.output[0]
+		assert.True(t, bytes.HasPrefix(tokenID, []byte("LQD-")))
+		assert.Equal(t, liquidDelegationAddress, e.getLiquidStakingTokenOwner(tokenID))
+
+		token, err := e.getExistingToken(tokenID)
+		require.Nil(t, err)
+		assert.Equal(t, liquidDelegationAddress, token.OwnerAddress)
+		assert.True(t, token.Burnable)
+		assert.False(t, token.Upgradable)
+		assert.False(t, token.CanChangeOwner)
+		assert.False(t, token.CanAddSpecialRoles)
+	})
+}
+
+func TestEsdt_MintLiquidStakingToken(t *testing.T) {
+	t.Parallel()
+
+	destination := bytes.Repeat([]byte{9}, 32)
+
+	t.Run("token not a liquid staking token should error", func(t *testing.T) {
+		t.Parallel()
+
+		delegationCalls := make([]*vmcommon.ContractCallInput, 0)
+		e, eei := createESDTForLiquidStaking(vmcommon.Ok, &delegationCalls)
+		_ = e.saveToken([]byte("TKN-abcdef"), &ESDTDataV2{
+			OwnerAddress: liquidDelegationAddress,
+			MintedValue:  big.NewInt(0),
+		})
+
+		vmInput := getDefaultVmInputForFunc("mintLiquidStakingToken", [][]byte{[]byte("TKN-abcdef"), big.NewInt(10).Bytes(), destination})
+		vmInput.CallerAddr = liquidDelegationAddress
+		returnCode := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Equal(t, "token is not a liquid staking token", eei.returnMessage)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		delegationCalls := make([]*vmcommon.ContractCallInput, 0)
+		e, eei := createESDTForLiquidStaking(vmcommon.Ok, &delegationCalls)
+		require.Equal(t, vmcommon.Ok, e.Execute(createRegisterLiquidStakingTokenInput(e, liquidDelegationAddress)))
+		tokenID := eei.output[0]
+
+		vmInput := getDefaultVmInputForFunc("mintLiquidStakingToken", [][]byte{tokenID, big.NewInt(10).Bytes(), destination})
+		vmInput.CallerAddr = liquidDelegationAddress
+		returnCode := e.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, returnCode)
+
+		token, _ := e.getExistingToken(tokenID)
+		assert.Equal(t, big.NewInt(10), token.MintedValue)
+
+		expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(big.NewInt(10).Bytes())
+		outputTransfer := eei.outputAccounts[string(destination)].OutputTransfers[0]
+		assert.Equal(t, []byte(expectedData), outputTransfer.Data)
+	})
+}
+
+func TestEsdt_BurnLiquidStakingToken(t *testing.T) {
+	t.Parallel()
+
+	holder := bytes.Repeat([]byte{9}, 32)
+
+	t.Run("redeem failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		delegationCalls := make([]*vmcommon.ContractCallInput, 0)
+		e, eei := createESDTForLiquidStaking(vmcommon.UserError, &delegationCalls)
+		tokenID := []byte("LQD-abcdef")
+		_ = e.saveToken(tokenID, &ESDTDataV2{BurntValue: big.NewInt(0)})
+		eei.SetStorage(liquidStakingTokenKey(tokenID), liquidDelegationAddress)
+
+		vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTBurn, [][]byte{tokenID, big.NewInt(10).Bytes()})
+		vmInput.CallerAddr = holder
+		returnCode := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, returnCode)
+		assert.Contains(t, eei.returnMessage, "liquid staking token could not be redeemed")
+
+		token, _ := e.getExistingToken(tokenID)
+		assert.Equal(t, big.NewInt(0), token.BurntValue)
+	})
+	t.Run("should redeem on the delegation contract", func(t *testing.T) {
+		t.Parallel()
+
+		delegationCalls := make([]*vmcommon.ContractCallInput, 0)
+		e, eei := createESDTForLiquidStaking(vmcommon.Ok, &delegationCalls)
+		tokenID := []byte("LQD-abcdef")
+		_ = e.saveToken(tokenID, &ESDTDataV2{BurntValue: big.NewInt(0)})
+		eei.SetStorage(liquidStakingTokenKey(tokenID), liquidDelegationAddress)
+
+		vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTBurn, [][]byte{tokenID, big.NewInt(10).Bytes()})
+		vmInput.CallerAddr = holder
+		returnCode := e.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, returnCode)
+
+		require.Equal(t, 1, len(delegationCalls))
+		assert.Equal(t, redeemLiquidStakingToken, delegationCalls[0].Function)
+		assert.Equal(t, liquidDelegationAddress, delegationCalls[0].RecipientAddr)
+		assert.Equal(t, [][]byte{holder, big.NewInt(10).Bytes()}, delegationCalls[0].Arguments)
+
+		token, _ := e.getExistingToken(tokenID)
+		assert.Equal(t, big.NewInt(10), token.BurntValue)
+	})
+}
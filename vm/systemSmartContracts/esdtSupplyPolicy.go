@@ -0,0 +1,327 @@
+package systemSmartContracts
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const supplyPolicyPrefix = "supplyPolicy"
+const mintAllocatedPrefix = "mintAllocated"
+const mintScheduleEpochLength = 4
+
+type mintScheduleEntry struct {
+	epoch uint32
+	cap   *big.Int
+}
+
+type supplyPolicy struct {
+	maxSupply *big.Int
+	schedule  []*mintScheduleEntry
+}
+
+// setSupplyPolicy commits a fungible token to an immutable max supply and, optionally, to a schedule of supply caps
+// unlocked at given epochs. The max supply bounds the value minted through the metachain together with all the
+// local mint allowances, so the policy can be set only while no address holds the local mint role
+//
+//	args.Arguments[0] - token identifier
+//	args.Arguments[1] - max supply
+//	args.Arguments[2...] - optional pairs of epoch and supply cap, with increasing epochs
+func (e *esdt) setSupplyPolicy(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTSupplyPolicyFlag) {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 2 || len(args.Arguments)%2 != 0 {
+		e.eei.AddReturnMessage("invalid number of arguments, expected token, max supply and pairs of epoch and cap")
+		return vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	tokenID := args.Arguments[0]
+	if string(token.TokenType) != core.FungibleESDT {
+		e.eei.AddReturnMessage("supply policies are available only for fungible tokens")
+		return vmcommon.UserError
+	}
+	if e.getSupplyPolicy(tokenID) != nil {
+		e.eei.AddReturnMessage("supply policy already set")
+		return vmcommon.UserError
+	}
+	if checkIfDefinedRoleExistsInToken(token, []byte(core.ESDTRoleLocalMint)) {
+		e.eei.AddReturnMessage("supply policy can not be set while addresses hold the local mint role")
+		return vmcommon.UserError
+	}
+
+	policy, err := createSupplyPolicy(args.Arguments[1:], token.MintedValue)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	e.eei.SetStorage(supplyPolicyKey(tokenID), encodeSupplyPolicy(policy))
+	e.eei.SetStorage(mintAllocatedKey(tokenID), token.MintedValue.Bytes())
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.CallerAddr,
+		Topics:     args.Arguments,
+	}
+	e.eei.AddLogEntry(logEntry)
+
+	return vmcommon.Ok
+}
+
+// allocateMintAllowance increases the value an address holding the local mint role can mint on its shard, within
+// the supply cap unlocked at the current epoch
+//
+//	args.Arguments[0] - token identifier
+//	args.Arguments[1] - address
+//	args.Arguments[2] - value
+func (e *esdt) allocateMintAllowance(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTSupplyPolicyFlag) {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) != 3 {
+		e.eei.AddReturnMessage("invalid number of arguments, expected token, address and value")
+		return vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	tokenID, address := args.Arguments[0], args.Arguments[1]
+	policy := e.getSupplyPolicy(tokenID)
+	if policy == nil {
+		e.eei.AddReturnMessage("token has no supply policy")
+		return vmcommon.UserError
+	}
+	esdtRoles, isNew := getRolesForAddress(token, address)
+	if isNew || getRoleIndex(esdtRoles, []byte(core.ESDTRoleLocalMint)) < 0 {
+		e.eei.AddReturnMessage("address does not hold the local mint role")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[2]) > core.MaxLenForESDTIssueMint {
+		e.eei.AddReturnMessage(fmt.Sprintf("max length for esdt mint is %d", core.MaxLenForESDTIssueMint))
+		return vmcommon.UserError
+	}
+	value := big.NewInt(0).SetBytes(args.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("negative or zero allowance value")
+		return vmcommon.UserError
+	}
+
+	err := e.allocateSupply(tokenID, policy, value)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	e.sendMintAllowance(tokenID, address, value, policy)
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(args.Function),
+		Address:    args.CallerAddr,
+		Topics:     args.Arguments,
+	}
+	e.eei.AddLogEntry(logEntry)
+
+	return vmcommon.Ok
+}
+
+// checkAndAllocateGlobalMint accounts a global mint against the supply policy of the token, if any
+func (e *esdt) checkAndAllocateGlobalMint(tokenID []byte, value *big.Int) error {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTSupplyPolicyFlag) {
+		return nil
+	}
+	policy := e.getSupplyPolicy(tokenID)
+	if policy == nil {
+		return nil
+	}
+
+	return e.allocateSupply(tokenID, policy, value)
+}
+
+// initMintAllowanceOnLocalMintRole makes the local mint of a token with a supply policy depend on the allowance of
+// the address. The allowance is sent before the role, so that the address can not mint in between
+func (e *esdt) initMintAllowanceOnLocalMintRole(tokenID []byte, address []byte, roles [][]byte) {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTSupplyPolicyFlag) {
+		return
+	}
+	if !isDefinedRoleInArgs(roles, []byte(core.ESDTRoleLocalMint)) {
+		return
+	}
+	policy := e.getSupplyPolicy(tokenID)
+	if policy == nil {
+		return
+	}
+
+	e.sendMintAllowance(tokenID, address, big.NewInt(0), policy)
+}
+
+func (e *esdt) allocateSupply(tokenID []byte, policy *supplyPolicy, value *big.Int) error {
+	allocated := e.getMintAllocated(tokenID)
+	allocated.Add(allocated, value)
+
+	unlocked := policy.unlockedCap(e.eei.BlockChainHook().CurrentEpoch())
+	if allocated.Cmp(unlocked) > 0 {
+		return fmt.Errorf("%w, supply cap of %s exceeded", vm.ErrInvalidArgument, unlocked.String())
+	}
+
+	e.eei.SetStorage(mintAllocatedKey(tokenID), allocated.Bytes())
+
+	return nil
+}
+
+func (e *esdt) sendMintAllowance(tokenID []byte, address []byte, value *big.Int, policy *supplyPolicy) {
+	txData := common.BuiltInFunctionESDTSetLocalMintAllowance + "@" + hex.EncodeToString(tokenID) + "@" +
+		hex.EncodeToString(value.Bytes()) + "@" + hex.EncodeToString(policy.maxSupply.Bytes())
+	e.eei.Transfer(address, e.esdtSCAddress, big.NewInt(0), []byte(txData), 0)
+}
+
+func (e *esdt) finishSupplyPolicyProperties(tokenID []byte) {
+	if !e.enableEpochsHandler.IsFlagEnabled(common.ESDTSupplyPolicyFlag) {
+		return
+	}
+	policy := e.getSupplyPolicy(tokenID)
+	if policy == nil {
+		return
+	}
+
+	schedule := make([]string, 0, len(policy.schedule))
+	for _, entry := range policy.schedule {
+		schedule = append(schedule, fmt.Sprintf("%d:%s", entry.epoch, entry.cap.String()))
+	}
+
+	e.eei.Finish([]byte("MaxSupply-" + policy.maxSupply.String()))
+	e.eei.Finish([]byte("MintSchedule-" + strings.Join(schedule, ",")))
+	e.eei.Finish([]byte("MintAllowanceAllocated-" + e.getMintAllocated(tokenID).String()))
+}
+
+func (e *esdt) getSupplyPolicy(tokenID []byte) *supplyPolicy {
+	data := e.eei.GetStorage(supplyPolicyKey(tokenID))
+	if len(data) == 0 {
+		return nil
+	}
+
+	return decodeSupplyPolicy(data)
+}
+
+func (e *esdt) getMintAllocated(tokenID []byte) *big.Int {
+	return big.NewInt(0).SetBytes(e.eei.GetStorage(mintAllocatedKey(tokenID)))
+}
+
+// unlockedCap returns the max supply if there is no schedule, otherwise the cap of the last reached schedule entry
+func (p *supplyPolicy) unlockedCap(epoch uint32) *big.Int {
+	if len(p.schedule) == 0 {
+		return p.maxSupply
+	}
+
+	unlocked := big.NewInt(0)
+	for _, entry := range p.schedule {
+		if entry.epoch > epoch {
+			break
+		}
+		unlocked = entry.cap
+	}
+
+	return unlocked
+}
+
+func createSupplyPolicy(arguments [][]byte, mintedValue *big.Int) (*supplyPolicy, error) {
+	for _, arg := range arguments {
+		if len(arg) > core.MaxLenForESDTIssueMint {
+			return nil, fmt.Errorf("%w, max length for supply values is %d", vm.ErrInvalidArgument, core.MaxLenForESDTIssueMint)
+		}
+	}
+
+	policy := &supplyPolicy{
+		maxSupply: big.NewInt(0).SetBytes(arguments[0]),
+		schedule:  make([]*mintScheduleEntry, 0, len(arguments)/2),
+	}
+	if policy.maxSupply.Cmp(zero) <= 0 || policy.maxSupply.Cmp(mintedValue) < 0 {
+		return nil, fmt.Errorf("%w, max supply must be positive and not lower than the minted value", vm.ErrInvalidArgument)
+	}
+
+	for i := 1; i < len(arguments); i += 2 {
+		epochValue := big.NewInt(0).SetBytes(arguments[i])
+		if !epochValue.IsUint64() || epochValue.Uint64() > uint64(^uint32(0)) {
+			return nil, fmt.Errorf("%w, invalid schedule epoch", vm.ErrInvalidArgument)
+		}
+
+		entry := &mintScheduleEntry{
+			epoch: uint32(epochValue.Uint64()),
+			cap:   big.NewInt(0).SetBytes(arguments[i+1]),
+		}
+		if entry.cap.Cmp(policy.maxSupply) > 0 {
+			return nil, fmt.Errorf("%w, schedule cap higher than the max supply", vm.ErrInvalidArgument)
+		}
+
+		numEntries := len(policy.schedule)
+		if numEntries > 0 {
+			previous := policy.schedule[numEntries-1]
+			if entry.epoch <= previous.epoch || entry.cap.Cmp(previous.cap) < 0 {
+				return nil, fmt.Errorf("%w, schedule epochs must increase and caps must not decrease", vm.ErrInvalidArgument)
+			}
+		}
+
+		policy.schedule = append(policy.schedule, entry)
+	}
+
+	return policy, nil
+}
+
+// the policy is encoded as the length prefixed max supply followed by the schedule entries, each one being the
+// epoch followed by the length prefixed cap. All values are at most core.MaxLenForESDTIssueMint bytes long
+func encodeSupplyPolicy(policy *supplyPolicy) []byte {
+	maxSupply := policy.maxSupply.Bytes()
+	data := append([]byte{byte(len(maxSupply))}, maxSupply...)
+	for _, entry := range policy.schedule {
+		supplyCap := entry.cap.Bytes()
+		data = binary.BigEndian.AppendUint32(data, entry.epoch)
+		data = append(data, byte(len(supplyCap)))
+		data = append(data, supplyCap...)
+	}
+
+	return data
+}
+
+func decodeSupplyPolicy(data []byte) *supplyPolicy {
+	maxSupplyEnd := 1 + int(data[0])
+	policy := &supplyPolicy{
+		maxSupply: big.NewInt(0).SetBytes(data[1:maxSupplyEnd]),
+		schedule:  make([]*mintScheduleEntry, 0),
+	}
+
+	for i := maxSupplyEnd; i+mintScheduleEpochLength < len(data); {
+		epoch := binary.BigEndian.Uint32(data[i : i+mintScheduleEpochLength])
+		capStart := i + mintScheduleEpochLength + 1
+		capEnd := capStart + int(data[i+mintScheduleEpochLength])
+		policy.schedule = append(policy.schedule, &mintScheduleEntry{
+			epoch: epoch,
+			cap:   big.NewInt(0).SetBytes(data[capStart:capEnd]),
+		})
+		i = capEnd
+	}
+
+	return policy
+}
+
+func supplyPolicyKey(tokenID []byte) []byte {
+	return append([]byte(supplyPolicyPrefix), tokenID...)
+}
+
+func mintAllocatedKey(tokenID []byte) []byte {
+	return append([]byte(mintAllocatedPrefix), tokenID...)
+}
@@ -0,0 +1,303 @@
+package systemSmartContracts
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/mock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createESDTWithSupplyPolicyFlag(token *ESDTDataV2, currentEpoch uint32) (*esdt, *vmContext, ArgsNewESDTSmartContract) {
+	eeiArgs := createDefaultEeiArgs()
+	eeiArgs.BlockChainHook = &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	eei, _ := NewVMContext(eeiArgs)
+
+	args := createMockArgumentsForESDT()
+	args.Eei = eei
+	enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	enableEpochsHandler.AddActiveFlags(common.ESDTSupplyPolicyFlag)
+
+	marshalledData, _ := args.Marshalizer.Marshal(token)
+	eei.storageUpdate[string(eei.scAddress)] = map[string][]byte{"token": marshalledData}
+
+	e, _ := NewESDTSmartContract(args)
+
+	return e, eei, args
+}
+
+func createFungibleTokenForSupplyPolicy() *ESDTDataV2 {
+	return &ESDTDataV2{
+		TokenName:          []byte("token"),
+		TokenType:          []byte(core.FungibleESDT),
+		OwnerAddress:       []byte("owner"),
+		Mintable:           true,
+		CanAddSpecialRoles: true,
+		MintedValue:        big.NewInt(100),
+	}
+}
+
+func TestEsdt_SetSupplyPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, args := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+		enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+		enableEpochsHandler.RemoveActiveFlags(common.ESDTSupplyPolicyFlag)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(1000).Bytes()})
+		assert.Equal(t, vmcommon.FunctionNotFound, e.Execute(vmInput))
+		assert.Equal(t, "invalid method to call", eei.returnMessage)
+	})
+	t.Run("invalid number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(1000).Bytes(), {1}})
+		assert.Equal(t, vmcommon.FunctionWrongSignature, e.Execute(vmInput))
+	})
+	t.Run("not owner should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, _, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(1000).Bytes()})
+		vmInput.CallerAddr = []byte("not owner")
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+	})
+	t.Run("non fungible token should error", func(t *testing.T) {
+		t.Parallel()
+
+		token := createFungibleTokenForSupplyPolicy()
+		token.TokenType = []byte(core.SemiFungibleESDT)
+		e, eei, _ := createESDTWithSupplyPolicyFlag(token, 0)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(1000).Bytes()})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Equal(t, "supply policies are available only for fungible tokens", eei.returnMessage)
+	})
+	t.Run("local mint role holders should error", func(t *testing.T) {
+		t.Parallel()
+
+		token := createFungibleTokenForSupplyPolicy()
+		token.SpecialRoles = []*ESDTRoles{{Address: []byte("minter"), Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}}
+		e, eei, _ := createESDTWithSupplyPolicyFlag(token, 0)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(1000).Bytes()})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Equal(t, "supply policy can not be set while addresses hold the local mint role", eei.returnMessage)
+	})
+	t.Run("max supply lower than the minted value should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(99).Bytes()})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Contains(t, eei.returnMessage, vm.ErrInvalidArgument.Error())
+	})
+	t.Run("decreasing schedule should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{
+			[]byte("token"), big.NewInt(1000).Bytes(),
+			{10}, big.NewInt(500).Bytes(),
+			{20}, big.NewInt(400).Bytes(),
+		})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Contains(t, eei.returnMessage, "schedule epochs must increase and caps must not decrease")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{
+			[]byte("token"), big.NewInt(1000).Bytes(),
+			{10}, big.NewInt(500).Bytes(),
+		})
+		require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+		policy := e.getSupplyPolicy([]byte("token"))
+		require.NotNil(t, policy)
+		assert.Equal(t, big.NewInt(1000), policy.maxSupply)
+		require.Len(t, policy.schedule, 1)
+		assert.Equal(t, uint32(10), policy.schedule[0].epoch)
+		assert.Equal(t, big.NewInt(500), policy.schedule[0].cap)
+		assert.Equal(t, big.NewInt(100), e.getMintAllocated([]byte("token")))
+		require.Len(t, eei.logs, 1)
+		assert.Equal(t, []byte("setSupplyPolicy"), eei.logs[0].Identifier)
+
+		eei.returnMessage = ""
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Equal(t, "supply policy already set", eei.returnMessage)
+	})
+}
+
+func TestEsdt_AllocateMintAllowance(t *testing.T) {
+	t.Parallel()
+
+	minter := []byte("minter")
+	setPolicy := func(e *esdt) {
+		vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{
+			[]byte("token"), big.NewInt(1000).Bytes(),
+			{10}, big.NewInt(500).Bytes(),
+		})
+		_ = e.Execute(vmInput)
+	}
+	createTokenWithMinter := func() *ESDTDataV2 {
+		token := createFungibleTokenForSupplyPolicy()
+		token.SpecialRoles = []*ESDTRoles{{Address: minter, Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}}
+		return token
+	}
+
+	t.Run("no supply policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, _ := createESDTWithSupplyPolicyFlag(createTokenWithMinter(), 10)
+
+		vmInput := getDefaultVmInputForFunc("allocateMintAllowance", [][]byte{[]byte("token"), minter, {10}})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Equal(t, "token has no supply policy", eei.returnMessage)
+	})
+	t.Run("address without local mint role should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 10)
+		setPolicy(e)
+
+		vmInput := getDefaultVmInputForFunc("allocateMintAllowance", [][]byte{[]byte("token"), minter, {10}})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Equal(t, "address does not hold the local mint role", eei.returnMessage)
+	})
+	t.Run("schedule cap exceeded should error", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 9)
+		setPolicy(e)
+
+		token, _ := e.getExistingToken([]byte("token"))
+		token.SpecialRoles = []*ESDTRoles{{Address: minter, Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}}
+		_ = e.saveToken([]byte("token"), token)
+
+		vmInput := getDefaultVmInputForFunc("allocateMintAllowance", [][]byte{[]byte("token"), minter, {10}})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Contains(t, eei.returnMessage, "supply cap of 0 exceeded")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 10)
+		setPolicy(e)
+
+		token, _ := e.getExistingToken([]byte("token"))
+		token.SpecialRoles = []*ESDTRoles{{Address: minter, Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}}
+		_ = e.saveToken([]byte("token"), token)
+
+		value := big.NewInt(400)
+		vmInput := getDefaultVmInputForFunc("allocateMintAllowance", [][]byte{[]byte("token"), minter, value.Bytes()})
+		require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+		assert.Equal(t, big.NewInt(500), e.getMintAllocated([]byte("token")))
+
+		outputTransfers := eei.outputAccounts[string(minter)].OutputTransfers
+		require.Len(t, outputTransfers, 1)
+		expectedData := common.BuiltInFunctionESDTSetLocalMintAllowance + "@" + hex.EncodeToString([]byte("token")) +
+			"@" + hex.EncodeToString(value.Bytes()) + "@" + hex.EncodeToString(big.NewInt(1000).Bytes())
+		assert.Equal(t, []byte(expectedData), outputTransfers[0].Data)
+
+		vmInput = getDefaultVmInputForFunc("allocateMintAllowance", [][]byte{[]byte("token"), minter, {1}})
+		assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+		assert.Contains(t, eei.returnMessage, "supply cap of 500 exceeded")
+	})
+}
+
+func TestEsdt_MintWithSupplyPolicy(t *testing.T) {
+	t.Parallel()
+
+	e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+	vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(1000).Bytes()})
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+	vmInput = getDefaultVmInputForFunc("mint", [][]byte{[]byte("token"), big.NewInt(900).Bytes()})
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+	assert.Equal(t, big.NewInt(1000), e.getMintAllocated([]byte("token")))
+
+	vmInput = getDefaultVmInputForFunc("mint", [][]byte{[]byte("token"), {1}})
+	assert.Equal(t, vmcommon.UserError, e.Execute(vmInput))
+	assert.Contains(t, eei.returnMessage, "supply cap of 1000 exceeded")
+}
+
+func TestEsdt_SetSpecialRoleLocalMintWithSupplyPolicy(t *testing.T) {
+	t.Parallel()
+
+	e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+	vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{[]byte("token"), big.NewInt(1000).Bytes()})
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+	minter := []byte("mintr")
+	vmInput = getDefaultVmInputForFunc("setSpecialRole", [][]byte{[]byte("token"), minter, []byte(core.ESDTRoleLocalMint)})
+	vmInput.GasProvided = 50000000
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+	outputTransfers := eei.outputAccounts[string(minter)].OutputTransfers
+	require.Len(t, outputTransfers, 2)
+	expectedData := common.BuiltInFunctionESDTSetLocalMintAllowance + "@" + hex.EncodeToString([]byte("token")) +
+		"@@" + hex.EncodeToString(big.NewInt(1000).Bytes())
+	assert.Equal(t, []byte(expectedData), outputTransfers[0].Data)
+}
+
+func TestEsdt_GetTokenPropertiesWithSupplyPolicy(t *testing.T) {
+	t.Parallel()
+
+	e, eei, _ := createESDTWithSupplyPolicyFlag(createFungibleTokenForSupplyPolicy(), 0)
+	vmInput := getDefaultVmInputForFunc("setSupplyPolicy", [][]byte{
+		[]byte("token"), big.NewInt(1000).Bytes(),
+		{10}, big.NewInt(500).Bytes(),
+		{20}, big.NewInt(1000).Bytes(),
+	})
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+	eei.output = make([][]byte, 0)
+	vmInput = getDefaultVmInputForFunc("getTokenProperties", [][]byte{[]byte("token")})
+	require.Equal(t, vmcommon.Ok, e.Execute(vmInput))
+
+	numOutputs := len(eei.output)
+	require.True(t, numOutputs > 3)
+	assert.Equal(t, []byte("MaxSupply-1000"), eei.output[numOutputs-3])
+	assert.Equal(t, []byte("MintSchedule-10:500,20:1000"), eei.output[numOutputs-2])
+	assert.Equal(t, []byte("MintAllowanceAllocated-100"), eei.output[numOutputs-1])
+}
+
+func TestSupplyPolicy_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	policy := &supplyPolicy{
+		maxSupply: big.NewInt(1000),
+		schedule: []*mintScheduleEntry{
+			{epoch: 10, cap: big.NewInt(500)},
+			{epoch: 300, cap: big.NewInt(1000)},
+		},
+	}
+
+	decoded := decodeSupplyPolicy(encodeSupplyPolicy(policy))
+	assert.Equal(t, policy, decoded)
+	assert.Equal(t, big.NewInt(0), decoded.unlockedCap(9))
+	assert.Equal(t, big.NewInt(500), decoded.unlockedCap(299))
+	assert.Equal(t, big.NewInt(1000), decoded.unlockedCap(300))
+}
@@ -38,6 +38,7 @@ type ArgsNewGovernanceContract struct {
 	GovernanceSCAddress    []byte
 	DelegationMgrSCAddress []byte
 	ValidatorSCAddress     []byte
+	EndOfEpochAddress      []byte
 	OwnerAddress           []byte
 	UnBondPeriodInEpochs   uint32
 	EnableEpochsHandler    common.EnableEpochsHandler
@@ -51,6 +52,7 @@ type governanceContract struct {
 	governanceSCAddress    []byte
 	delegationMgrSCAddress []byte
 	validatorSCAddress     []byte
+	endOfEpochAddress      []byte
 	marshalizer            marshal.Marshalizer
 	hasher                 hashing.Hasher
 	governanceConfig       config.GovernanceSystemSCConfig
@@ -75,6 +77,7 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.GovernanceFlag,
+		common.GovernanceParameterChangesFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -97,6 +100,9 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	if len(args.OwnerAddress) < 1 {
 		return nil, fmt.Errorf("%w for change config address", vm.ErrInvalidAddress)
 	}
+	if len(args.EndOfEpochAddress) < 1 {
+		return nil, vm.ErrInvalidEndOfEpochAccessAddress
+	}
 
 	g := &governanceContract{
 		eei:                    args.Eei,
@@ -106,6 +112,7 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 		governanceSCAddress:    args.GovernanceSCAddress,
 		delegationMgrSCAddress: args.DelegationMgrSCAddress,
 		validatorSCAddress:     args.ValidatorSCAddress,
+		endOfEpochAddress:      args.EndOfEpochAddress,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
 		governanceConfig:       args.GovernanceConfig,
@@ -143,6 +150,14 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.initV2(args)
 	case "proposal":
 		return g.proposal(args)
+	case "parameterProposal":
+		return g.parameterProposal(args)
+	case "executePendingParameterChanges":
+		return g.executePendingParameterChanges(args)
+	case "viewPendingParameterChanges":
+		return g.viewPendingParameterChanges(args)
+	case "viewEconomicsParameters":
+		return g.viewEconomicsParameters(args)
 	case "vote":
 		return g.vote(args)
 	case "delegateVote":
@@ -293,6 +308,12 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		g.eei.AddReturnMessage("invalid number of arguments, expected 3")
 		return vmcommon.FunctionWrongSignature
 	}
+
+	return g.createProposal(args)
+}
+
+// createProposal saves a new general proposal out of the first 3 arguments: commit hash, start and end vote epochs
+func (g *governanceContract) createProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	generalConfig, err := g.getConfig()
 	if err != nil {
 		g.eei.AddReturnMessage(err.Error())
@@ -351,10 +372,12 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 	nonceKey := append([]byte(noncePrefix), nonceAsBytes...)
 	g.eei.SetStorage(nonceKey, commitHash)
 
+	// the parameter changes of a parameter proposal, if any, follow the start and end vote epochs
+	topics := [][]byte{nonceAsBytes, commitHash, args.Arguments[1], args.Arguments[2]}
 	logEntry := &vmcommon.LogEntry{
 		Identifier: []byte(args.Function),
 		Address:    args.CallerAddr,
-		Topics:     [][]byte{nonceAsBytes, commitHash, args.Arguments[1], args.Arguments[2]},
+		Topics:     append(topics, args.Arguments[3:]...),
 	}
 	g.eei.AddLogEntry(logEntry)
 
@@ -642,6 +665,10 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 		return vmcommon.UserError
 	}
 
+	if generalProposal.Passed {
+		g.scheduleParameterChanges(generalProposal)
+	}
+
 	tokensToReturn := big.NewInt(0).Set(generalProposal.ProposalCost)
 	if !generalProposal.Passed {
 		tokensToReturn.Sub(tokensToReturn, baseConfig.LostProposalFee)
@@ -0,0 +1,384 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const parameterChangesPrefix = "pc_"
+const pendingParameterChangesKey = "pendingParameterChanges"
+const parameterChangesSeparator = "@"
+const pendingEntryLength = 12
+const changeNodePriceFunction = "changeNodePrice"
+const getNodePriceFunction = "getNodePrice"
+
+// minGasPriceModifier is the smallest gas price modifier accepted by the economics data
+const minGasPriceModifier = 0.00000001
+const economicsParametersKey = "economicsParameters"
+
+// whitelisted protocol parameters that can be changed through a parameter proposal
+const (
+	governanceProposalFeeParameter      = "governanceProposalFee"
+	governanceLostProposalFeeParameter  = "governanceLostProposalFee"
+	governanceMinQuorumParameter        = "governanceMinQuorum"
+	governanceMinPassThresholdParameter = "governanceMinPassThreshold"
+	governanceMinVetoThresholdParameter = "governanceMinVetoThreshold"
+	stakingNodePriceParameter           = "stakingNodePrice"
+	economicsGasPriceModifierParameter  = common.GasPriceModifierParameter
+)
+
+type pendingParameterChange struct {
+	nonce          uint64
+	executionEpoch uint32
+}
+
+// parameterProposal creates a new proposal which, once passed, changes whitelisted protocol parameters
+//
+//	args.Arguments[0] - commit hash
+//	args.Arguments[1] - start vote epoch
+//	args.Arguments[2] - end vote epoch
+//	args.Arguments[3...] - pairs of parameter name and new value, as string
+func (g *governanceContract) parameterProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) < 5 || len(args.Arguments)%2 == 0 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected commit hash, start epoch, end epoch and pairs of parameter and value")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	changes := args.Arguments[3:]
+	err = checkParameterChanges(changes)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	returnCode := g.createProposal(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	commitHash := args.Arguments[0]
+	key := append([]byte(parameterChangesPrefix), commitHash...)
+	g.eei.SetStorage(key, bytes.Join(changes, []byte(parameterChangesSeparator)))
+
+	return vmcommon.Ok
+}
+
+// executePendingParameterChanges applies the parameter changes of passed proposals which became due
+//
+//	args.Arguments[0] - current epoch
+func (g *governanceContract) executePendingParameterChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, g.endOfEpochAddress) {
+		g.eei.AddReturnMessage("only end of epoch address can call")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 1")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	epoch := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	pending := g.getPendingParameterChanges()
+	stillPending := make([]*pendingParameterChange, 0, len(pending))
+	for _, entry := range pending {
+		if uint64(entry.executionEpoch) > epoch {
+			stillPending = append(stillPending, entry)
+			continue
+		}
+
+		g.executeParameterChanges(entry.nonce)
+	}
+
+	g.savePendingParameterChanges(stillPending)
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) executeParameterChanges(nonce uint64) {
+	proposal, err := g.getProposalFromNonce(big.NewInt(0).SetUint64(nonce))
+	if err != nil {
+		log.Warn("governanceContract.executeParameterChanges: proposal not found", "nonce", nonce, "error", err)
+		return
+	}
+
+	changes := g.getParameterChanges(proposal.CommitHash)
+	err = g.applyParameterChanges(changes)
+	if err != nil {
+		log.Warn("governanceContract.executeParameterChanges: changes not applied",
+			"nonce", nonce, "commit hash", string(proposal.CommitHash), "error", err)
+	}
+
+	topics := [][]byte{big.NewInt(0).SetUint64(nonce).Bytes(), proposal.CommitHash, boolToSlice(err == nil)}
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte("executeParameterChanges"),
+		Address:    g.governanceSCAddress,
+		Topics:     append(topics, changes...),
+	}
+	g.eei.AddLogEntry(logEntry)
+}
+
+// applyParameterChanges validates all the changes before applying any of them. The staking node price is changed
+// first, as a failed call on the validator contract has its changes reverted, then the governance config and the
+// economics parameters, which can no longer fail, are saved
+func (g *governanceContract) applyParameterChanges(changes [][]byte) error {
+	err := checkParameterChanges(changes)
+	if err != nil {
+		return err
+	}
+
+	scConfig, err := g.getConfig()
+	if err != nil {
+		return err
+	}
+
+	var nodePrice []byte
+	economicsParameters := g.getEconomicsParameters()
+	economicsParametersChanged := false
+	governanceConfigChanged := false
+	for i := 0; i < len(changes); i += 2 {
+		name, value := string(changes[i]), changes[i+1]
+		switch name {
+		case stakingNodePriceParameter:
+			nodePrice = value
+			continue
+		case economicsGasPriceModifierParameter:
+			economicsParameters = setEconomicsParameter(economicsParameters, changes[i], value)
+			economicsParametersChanged = true
+			continue
+		case governanceProposalFeeParameter:
+			scConfig.ProposalFee, _ = big.NewInt(0).SetString(string(value), conversionBase)
+		case governanceLostProposalFeeParameter:
+			scConfig.LostProposalFee, _ = big.NewInt(0).SetString(string(value), conversionBase)
+		case governanceMinQuorumParameter:
+			scConfig.MinQuorum, _ = convertDecimalToPercentage(value)
+		case governanceMinPassThresholdParameter:
+			scConfig.MinPassThreshold, _ = convertDecimalToPercentage(value)
+		case governanceMinVetoThresholdParameter:
+			scConfig.MinVetoThreshold, _ = convertDecimalToPercentage(value)
+		default:
+			continue
+		}
+		governanceConfigChanged = true
+	}
+
+	var configData []byte
+	if governanceConfigChanged {
+		if scConfig.ProposalFee.Cmp(scConfig.LostProposalFee) < 0 {
+			return fmt.Errorf("%w proposal fee is smaller than lost proposal fee", vm.ErrIncorrectConfig)
+		}
+
+		configData, err = g.marshalizer.Marshal(scConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(nodePrice) > 0 {
+		err = g.changeStakingNodePrice(nodePrice)
+		if err != nil {
+			return err
+		}
+	}
+
+	if governanceConfigChanged {
+		g.eei.SetStorage([]byte(governanceConfigKey), configData)
+		g.baseProposalCost.Set(scConfig.ProposalFee)
+	}
+	if economicsParametersChanged {
+		g.eei.SetStorage([]byte(economicsParametersKey), bytes.Join(economicsParameters, []byte(common.EconomicsParametersSeparator)))
+	}
+
+	return nil
+}
+
+// getEconomicsParameters returns the pairs of name and value of the economics parameters changed through governance
+func (g *governanceContract) getEconomicsParameters() [][]byte {
+	data := g.eei.GetStorage([]byte(economicsParametersKey))
+	if len(data) == 0 {
+		return make([][]byte, 0)
+	}
+
+	return bytes.Split(data, []byte(common.EconomicsParametersSeparator))
+}
+
+func setEconomicsParameter(parameters [][]byte, name []byte, value []byte) [][]byte {
+	for i := 0; i < len(parameters); i += 2 {
+		if bytes.Equal(parameters[i], name) {
+			parameters[i+1] = value
+			return parameters
+		}
+	}
+
+	return append(parameters, name, value)
+}
+
+func (g *governanceContract) changeStakingNodePrice(value []byte) error {
+	nodePrice, _ := big.NewInt(0).SetString(string(value), conversionBase)
+	txData := changeNodePriceFunction + "@" + hex.EncodeToString(nodePrice.Bytes())
+	vmOutput, err := g.eei.ExecuteOnDestContext(g.validatorSCAddress, g.governanceSCAddress, big.NewInt(0), []byte(txData))
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%s returned %s: %s", changeNodePriceFunction, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return nil
+}
+
+// scheduleParameterChanges marks the changes of a passed parameter proposal to be applied at the start of the
+// first epoch greater or equal to the current epoch plus the configured delay
+func (g *governanceContract) scheduleParameterChanges(proposal *GeneralProposal) {
+	if !g.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		return
+	}
+	if len(g.getParameterChanges(proposal.CommitHash)) == 0 {
+		return
+	}
+
+	executionEpoch := g.eei.BlockChainHook().CurrentEpoch() + g.governanceConfig.Active.ParameterChangeDelayInEpochs
+	pending := g.getPendingParameterChanges()
+	pending = append(pending, &pendingParameterChange{
+		nonce:          proposal.Nonce,
+		executionEpoch: executionEpoch,
+	})
+	g.savePendingParameterChanges(pending)
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte("scheduleParameterChanges"),
+		Address:    g.governanceSCAddress,
+		Topics:     [][]byte{big.NewInt(0).SetUint64(proposal.Nonce).Bytes(), proposal.CommitHash, big.NewInt(int64(executionEpoch)).Bytes()},
+	}
+	g.eei.AddLogEntry(logEntry)
+}
+
+func (g *governanceContract) getParameterChanges(commitHash []byte) [][]byte {
+	key := append([]byte(parameterChangesPrefix), commitHash...)
+	data := g.eei.GetStorage(key)
+	if len(data) == 0 {
+		return nil
+	}
+
+	return bytes.Split(data, []byte(parameterChangesSeparator))
+}
+
+func (g *governanceContract) getPendingParameterChanges() []*pendingParameterChange {
+	data := g.eei.GetStorage([]byte(pendingParameterChangesKey))
+	pending := make([]*pendingParameterChange, 0, len(data)/pendingEntryLength)
+	for i := 0; i+pendingEntryLength <= len(data); i += pendingEntryLength {
+		pending = append(pending, &pendingParameterChange{
+			nonce:          binary.BigEndian.Uint64(data[i : i+8]),
+			executionEpoch: binary.BigEndian.Uint32(data[i+8 : i+pendingEntryLength]),
+		})
+	}
+
+	return pending
+}
+
+func (g *governanceContract) savePendingParameterChanges(pending []*pendingParameterChange) {
+	data := make([]byte, 0, len(pending)*pendingEntryLength)
+	for _, entry := range pending {
+		data = binary.BigEndian.AppendUint64(data, entry.nonce)
+		data = binary.BigEndian.AppendUint32(data, entry.executionEpoch)
+	}
+
+	g.eei.SetStorage([]byte(pendingParameterChangesKey), data)
+}
+
+// viewPendingParameterChanges returns the nonce and the execution epoch of every scheduled parameter proposal
+func (g *governanceContract) viewPendingParameterChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := g.checkViewFuncArguments(args, 0)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, entry := range g.getPendingParameterChanges() {
+		g.eei.Finish(big.NewInt(0).SetUint64(entry.nonce).Bytes())
+		g.eei.Finish(big.NewInt(int64(entry.executionEpoch)).Bytes())
+	}
+
+	return vmcommon.Ok
+}
+
+// viewEconomicsParameters returns the pairs of name and value of the economics parameters changed through governance
+func (g *governanceContract) viewEconomicsParameters(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := g.checkViewFuncArguments(args, 0)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, data := range g.getEconomicsParameters() {
+		g.eei.Finish(data)
+	}
+
+	return vmcommon.Ok
+}
+
+func checkParameterChanges(changes [][]byte) error {
+	seen := make(map[string]struct{}, len(changes)/2)
+	for i := 0; i < len(changes); i += 2 {
+		name := string(changes[i])
+		if _, exists := seen[name]; exists {
+			return fmt.Errorf("%w, duplicated parameter %s", vm.ErrInvalidArgument, name)
+		}
+		seen[name] = struct{}{}
+
+		err := checkParameterValue(name, changes[i+1])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkParameterValue(name string, value []byte) error {
+	switch name {
+	case governanceProposalFeeParameter, stakingNodePriceParameter:
+		converted, ok := big.NewInt(0).SetString(string(value), conversionBase)
+		if !ok || converted.Cmp(zero) <= 0 {
+			return fmt.Errorf("%w, invalid value for %s", vm.ErrInvalidArgument, name)
+		}
+	case governanceLostProposalFeeParameter:
+		converted, ok := big.NewInt(0).SetString(string(value), conversionBase)
+		if !ok || converted.Cmp(zero) < 0 {
+			return fmt.Errorf("%w, invalid value for %s", vm.ErrInvalidArgument, name)
+		}
+	case governanceMinQuorumParameter, governanceMinPassThresholdParameter, governanceMinVetoThresholdParameter:
+		_, err := convertDecimalToPercentage(value)
+		if err != nil {
+			return fmt.Errorf("%w, invalid value for %s", err, name)
+		}
+	case economicsGasPriceModifierParameter:
+		modifier, err := strconv.ParseFloat(string(value), 64)
+		if err != nil || !(modifier >= minGasPriceModifier && modifier <= 1) {
+			return fmt.Errorf("%w, invalid value for %s", vm.ErrInvalidArgument, name)
+		}
+	default:
+		return fmt.Errorf("%w, parameter %s can not be changed through governance", vm.ErrInvalidArgument, name)
+	}
+
+	return nil
+}
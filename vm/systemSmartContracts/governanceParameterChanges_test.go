@@ -0,0 +1,320 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/vm"
+	"github.com/multiversx/mx-chain-go/vm/mock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/stretchr/testify/require"
+)
+
+func createGovernanceWithParameterChanges() (*governanceContract, *mock.BlockChainHookStub, *vmContext, *[]*vmcommon.ContractCallInput) {
+	gsc, blockChainHook, eeiHandler := createGovernanceBlockChainHookStubContextHandler()
+	gsc.enableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub).AddActiveFlags(common.GovernanceParameterChangesFlag)
+	gsc.governanceConfig.Active.ParameterChangeDelayInEpochs = 2
+
+	eei := eeiHandler.(*vmContext)
+	eei.inputParser = parsers.NewCallArgsParser()
+	destinationCalls := make([]*vmcommon.ContractCallInput, 0)
+	systemSCContainerStub := &mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			destinationCalls = append(destinationCalls, args)
+			return vmcommon.Ok
+		}}, nil
+	}}
+	_ = eei.SetSystemSCContainer(systemSCContainerStub)
+
+	return gsc, blockChainHook, eei, &destinationCalls
+}
+
+func createParameterProposalInput(callerAddress []byte, commitHash []byte, changes ...[]byte) *vmcommon.ContractCallInput {
+	args := [][]byte{commitHash, big.NewInt(50).Bytes(), big.NewInt(55).Bytes()}
+	args = append(args, changes...)
+
+	return createVMInput(big.NewInt(500), "parameterProposal", callerAddress, vm.GovernanceSCAddress, args)
+}
+
+func TestGovernanceContract_ParameterProposal(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	commitHash := bytes.Repeat([]byte("a"), commitHashLength)
+
+	t.Run("flag not active should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		callInput := createParameterProposalInput(callerAddress, commitHash, []byte(governanceMinQuorumParameter), []byte("3000"))
+
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Equal(t, "invalid method to call", eei.GetReturnMessage())
+	})
+	t.Run("invalid number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei, _ := createGovernanceWithParameterChanges()
+
+		retCode := gsc.Execute(createParameterProposalInput(callerAddress, commitHash))
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+		require.True(t, strings.Contains(eei.GetReturnMessage(), "invalid number of arguments"))
+
+		retCode = gsc.Execute(createParameterProposalInput(callerAddress, commitHash, []byte(governanceMinQuorumParameter)))
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+	})
+	t.Run("parameter not whitelisted should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei, _ := createGovernanceWithParameterChanges()
+		callInput := createParameterProposalInput(callerAddress, commitHash, []byte("gasPriceModifier"), []byte("1"))
+
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.True(t, strings.Contains(eei.GetReturnMessage(), "can not be changed through governance"))
+	})
+	t.Run("duplicated parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei, _ := createGovernanceWithParameterChanges()
+		callInput := createParameterProposalInput(callerAddress, commitHash,
+			[]byte(governanceMinQuorumParameter), []byte("3000"),
+			[]byte(governanceMinQuorumParameter), []byte("4000"),
+		)
+
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.True(t, strings.Contains(eei.GetReturnMessage(), "duplicated parameter"))
+	})
+	t.Run("invalid values should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidChanges := [][]byte{
+			[]byte(governanceProposalFeeParameter), []byte("0"),
+			[]byte(governanceLostProposalFeeParameter), []byte("-1"),
+			[]byte(governanceMinQuorumParameter), []byte("10001"),
+			[]byte(governanceMinPassThresholdParameter), []byte("abc"),
+			[]byte(governanceMinVetoThresholdParameter), []byte("0"),
+			[]byte(stakingNodePriceParameter), []byte("not a number"),
+			[]byte(economicsGasPriceModifierParameter), []byte("0"),
+			[]byte(economicsGasPriceModifierParameter), []byte("1.01"),
+			[]byte(economicsGasPriceModifierParameter), []byte("NaN"),
+		}
+		for i := 0; i < len(invalidChanges); i += 2 {
+			gsc, _, eei, _ := createGovernanceWithParameterChanges()
+			callInput := createParameterProposalInput(callerAddress, commitHash, invalidChanges[i], invalidChanges[i+1])
+
+			retCode := gsc.Execute(callInput)
+			require.Equal(t, vmcommon.UserError, retCode)
+			require.True(t, strings.Contains(eei.GetReturnMessage(), "invalid value for "+string(invalidChanges[i])))
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei, _ := createGovernanceWithParameterChanges()
+		callInput := createParameterProposalInput(callerAddress, commitHash,
+			[]byte(governanceMinQuorumParameter), []byte("3000"),
+			[]byte(stakingNodePriceParameter), []byte("2500"),
+		)
+
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		proposal, err := gsc.getProposalFromNonce(big.NewInt(1))
+		require.Nil(t, err)
+		require.Equal(t, commitHash, proposal.CommitHash)
+		require.Equal(t, [][]byte{
+			[]byte(governanceMinQuorumParameter), []byte("3000"),
+			[]byte(stakingNodePriceParameter), []byte("2500"),
+		}, gsc.getParameterChanges(commitHash))
+
+		logs := eei.GetLogs()
+		require.Len(t, logs, 1)
+		require.Equal(t, []byte("parameterProposal"), logs[0].Identifier)
+		require.Equal(t, []byte(governanceMinQuorumParameter), logs[0].Topics[4])
+		require.Equal(t, []byte("2500"), logs[0].Topics[7])
+	})
+}
+
+func TestGovernanceContract_ParameterProposalVoteCloseExecute(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	commitHash := bytes.Repeat([]byte("a"), commitHashLength)
+
+	gsc, blockchainHook, eei, destinationCalls := createGovernanceWithParameterChanges()
+	callInput := createParameterProposalInput(callerAddress, commitHash,
+		[]byte(governanceMinQuorumParameter), []byte("3000"),
+		[]byte(governanceProposalFeeParameter), []byte("600"),
+		[]byte(stakingNodePriceParameter), []byte("2500"),
+		[]byte(economicsGasPriceModifierParameter), []byte("0.5"),
+	)
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	currentEpoch := uint32(52)
+	blockchainHook.CurrentEpochCalled = func() uint32 {
+		return currentEpoch
+	}
+
+	callInput = createVMInput(big.NewInt(0), "vote", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes(), []byte("yes")})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	currentEpoch = 56
+	callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, []*pendingParameterChange{{nonce: 1, executionEpoch: 58}}, gsc.getPendingParameterChanges())
+
+	eei.output = make([][]byte, 0)
+	callInput = createVMInput(big.NewInt(0), "viewPendingParameterChanges", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{big.NewInt(1).Bytes(), big.NewInt(58).Bytes()}, eei.output)
+
+	callInput = createVMInput(big.NewInt(0), "executePendingParameterChanges", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(58).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.True(t, strings.Contains(eei.GetReturnMessage(), "only end of epoch address can call"))
+
+	callInput = createVMInput(big.NewInt(0), "executePendingParameterChanges", vm.EndOfEpochAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(57).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Len(t, gsc.getPendingParameterChanges(), 1)
+	require.Empty(t, *destinationCalls)
+
+	callInput.Arguments = [][]byte{big.NewInt(58).Bytes()}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Empty(t, gsc.getPendingParameterChanges())
+
+	scConfig, _ := gsc.getConfig()
+	require.Equal(t, float32(0.3), scConfig.MinQuorum)
+	require.Equal(t, big.NewInt(600), scConfig.ProposalFee)
+	require.Equal(t, big.NewInt(600), gsc.baseProposalCost)
+
+	require.Len(t, *destinationCalls, 1)
+	validatorCall := (*destinationCalls)[0]
+	require.Equal(t, vm.ValidatorSCAddress, validatorCall.RecipientAddr)
+	require.Equal(t, vm.GovernanceSCAddress, validatorCall.CallerAddr)
+	require.Equal(t, changeNodePriceFunction, validatorCall.Function)
+	require.Equal(t, [][]byte{big.NewInt(2500).Bytes()}, validatorCall.Arguments)
+
+	eei.output = make([][]byte, 0)
+	callInput = createVMInput(big.NewInt(0), "viewEconomicsParameters", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{[]byte(economicsGasPriceModifierParameter), []byte("0.5")}, eei.output)
+
+	logs := eei.GetLogs()
+	lastLog := logs[len(logs)-1]
+	require.Equal(t, []byte("executeParameterChanges"), lastLog.Identifier)
+	require.Equal(t, boolToSlice(true), lastLog.Topics[2])
+}
+
+func TestGovernanceContract_RejectedParameterProposalIsNotScheduled(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	commitHash := bytes.Repeat([]byte("a"), commitHashLength)
+
+	gsc, blockchainHook, _, _ := createGovernanceWithParameterChanges()
+	callInput := createParameterProposalInput(callerAddress, commitHash, []byte(governanceMinQuorumParameter), []byte("3000"))
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	blockchainHook.CurrentEpochCalled = func() uint32 {
+		return 56
+	}
+	callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	proposal, _ := gsc.getProposalFromNonce(big.NewInt(1))
+	require.False(t, proposal.Passed)
+	require.Empty(t, gsc.getPendingParameterChanges())
+}
+
+func TestGovernanceContract_ApplyParameterChangesInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	gsc, _, _, destinationCalls := createGovernanceWithParameterChanges()
+	changes := [][]byte{
+		[]byte(governanceLostProposalFeeParameter), []byte("1000"),
+		[]byte(stakingNodePriceParameter), []byte("2500"),
+	}
+
+	err := gsc.applyParameterChanges(changes)
+	require.ErrorIs(t, err, vm.ErrIncorrectConfig)
+	require.Empty(t, *destinationCalls)
+
+	scConfig, _ := gsc.getConfig()
+	require.Equal(t, big.NewInt(1), scConfig.LostProposalFee)
+}
+
+func TestGovernanceContract_ApplyParameterChangesNodePriceFailureShouldNotApplyAnyChange(t *testing.T) {
+	t.Parallel()
+
+	gsc, _, eei, _ := createGovernanceWithParameterChanges()
+	systemSCContainerStub := &mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			return vmcommon.UserError
+		}}, nil
+	}}
+	_ = eei.SetSystemSCContainer(systemSCContainerStub)
+
+	changes := [][]byte{
+		[]byte(governanceMinQuorumParameter), []byte("3000"),
+		[]byte(economicsGasPriceModifierParameter), []byte("0.5"),
+		[]byte(stakingNodePriceParameter), []byte("2500"),
+	}
+	scConfigBefore, _ := gsc.getConfig()
+
+	err := gsc.applyParameterChanges(changes)
+	require.NotNil(t, err)
+
+	scConfig, _ := gsc.getConfig()
+	require.Equal(t, scConfigBefore, scConfig)
+	require.Empty(t, gsc.getEconomicsParameters())
+}
+
+func TestGovernanceContract_ApplyParameterChangesEconomicsParameters(t *testing.T) {
+	t.Parallel()
+
+	gsc, _, _, destinationCalls := createGovernanceWithParameterChanges()
+
+	err := gsc.applyParameterChanges([][]byte{[]byte(economicsGasPriceModifierParameter), []byte("0.5")})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte(economicsGasPriceModifierParameter), []byte("0.5")}, gsc.getEconomicsParameters())
+
+	err = gsc.applyParameterChanges([][]byte{[]byte(economicsGasPriceModifierParameter), []byte("0.25")})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte(economicsGasPriceModifierParameter), []byte("0.25")}, gsc.getEconomicsParameters())
+
+	err = gsc.applyParameterChanges([][]byte{[]byte(economicsGasPriceModifierParameter), []byte("2")})
+	require.ErrorIs(t, err, vm.ErrInvalidArgument)
+	require.Equal(t, [][]byte{[]byte(economicsGasPriceModifierParameter), []byte("0.25")}, gsc.getEconomicsParameters())
+	require.Empty(t, *destinationCalls)
+}
+
+func TestGovernanceContract_PendingParameterChangesEncoding(t *testing.T) {
+	t.Parallel()
+
+	gsc, _, _, _ := createGovernanceWithParameterChanges()
+	require.Empty(t, gsc.getPendingParameterChanges())
+
+	pending := []*pendingParameterChange{
+		{nonce: 1, executionEpoch: 10},
+		{nonce: 1 << 40, executionEpoch: 1 << 30},
+	}
+	gsc.savePendingParameterChanges(pending)
+	require.Equal(t, pending, gsc.getPendingParameterChanges())
+}
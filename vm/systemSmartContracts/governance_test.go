@@ -56,6 +56,7 @@ func createArgsWithEEI(eei vm.SystemEI) ArgsNewGovernanceContract {
 		GovernanceSCAddress:    vm.GovernanceSCAddress,
 		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
 		ValidatorSCAddress:     vm.ValidatorSCAddress,
+		EndOfEpochAddress:      vm.EndOfEpochAddress,
 		OwnerAddress:           bytes.Repeat([]byte{1}, 32),
 		UnBondPeriodInEpochs:   10,
 		EnableEpochsHandler:    enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag),
@@ -230,6 +231,17 @@ func TestNewGovernanceContract_InvalidGovernanceAddress(t *testing.T) {
 	require.True(t, errors.Is(err, vm.ErrInvalidAddress))
 }
 
+func TestNewGovernanceContract_InvalidEndOfEpochAddress(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.EndOfEpochAddress = nil
+
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, gsc)
+	require.Equal(t, vm.ErrInvalidEndOfEpochAccessAddress, err)
+}
+
 func TestGovernanceContract_SetNewGasCost(t *testing.T) {
 	args := createMockGovernanceArgs()
 
@@ -21,6 +21,7 @@ import (
 
 const unJailedFunds = "unJailFunds"
 const unStakeUnBondPauseKey = "unStakeUnBondPause"
+const governanceNodePriceKey = "governanceNodePrice"
 const minPercentage = 0.0001
 const numberOfNodesTooHigh = "number of nodes too high, no new nodes activated"
 
@@ -122,6 +123,7 @@ func NewValidatorSmartContract(
 		common.MultiClaimOnDelegationFlag,
 		common.DelegationManagerFlag,
 		common.UnBondTokensV2Flag,
+		common.GovernanceParameterChangesFlag,
 	})
 	if err != nil {
 		return nil, err
@@ -235,6 +237,10 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.get(args)
 	case "setConfig":
 		return v.setConfig(args)
+	case changeNodePriceFunction:
+		return v.changeNodePrice(args)
+	case getNodePriceFunction:
+		return v.getNodePrice(args)
 	case "changeRewardAddress":
 		return v.changeRewardAddress(args)
 	case "unJail":
@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/vm"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -47,7 +48,89 @@ func (v *validatorSC) setConfig(args *vmcommon.ContractCallInput) vmcommon.Retur
 	return vmcommon.Ok
 }
 
+// changeNodePrice sets the node price decided by a governance parameter proposal. The new price overrides the
+// one from the base and epoch configs
+//
+//	args.Arguments[0] - new node price
+func (v *validatorSC) changeNodePrice(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, v.governanceSCAddress) {
+		v.eei.AddReturnMessage("only governance address can call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		v.eei.AddReturnMessage("invalid number of arguments, expected 1")
+		return vmcommon.UserError
+	}
+
+	nodePrice := big.NewInt(0).SetBytes(args.Arguments[0])
+	if nodePrice.Cmp(zero) <= 0 {
+		v.eei.AddReturnMessage(fmt.Errorf("%w, value is %v", vm.ErrInvalidNodePrice, nodePrice).Error())
+		return vmcommon.UserError
+	}
+
+	v.eei.SetStorage([]byte(governanceNodePriceKey), nodePrice.Bytes())
+
+	return vmcommon.Ok
+}
+
+func getGovernanceNodePrice(eei vm.SystemEI, validatorSCAddress []byte) *big.Int {
+	data := eei.GetStorageFromAddress(validatorSCAddress, []byte(governanceNodePriceKey))
+	if len(data) == 0 {
+		return nil
+	}
+
+	return big.NewInt(0).SetBytes(data)
+}
+
+// getNodePrice returns the node price in use in the current epoch, including the one decided through governance
+func (v *validatorSC) getNodePrice(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		v.eei.AddReturnMessage("number of arguments must be equal to 0")
+		return vmcommon.UserError
+	}
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	validatorConfig := v.getConfig(v.eei.BlockChainHook().CurrentEpoch())
+	v.eei.Finish(validatorConfig.NodePrice.Bytes())
+
+	return vmcommon.Ok
+}
+
 func (v *validatorSC) getConfig(epoch uint32) ValidatorConfig {
+	validatorConfig := v.getEpochConfig(epoch)
+	if !v.enableEpochsHandler.IsFlagEnabled(common.GovernanceParameterChangesFlag) {
+		return validatorConfig
+	}
+
+	nodePrice := getGovernanceNodePrice(v.eei, v.validatorSCAddress)
+	if nodePrice != nil {
+		validatorConfig.NodePrice = nodePrice
+	}
+
+	return validatorConfig
+}
+
+func (v *validatorSC) getEpochConfig(epoch uint32) ValidatorConfig {
 	epochKey := big.NewInt(int64(epoch)).Bytes()
 	configData := v.eei.GetStorage(epochKey)
 	if len(configData) == 0 {
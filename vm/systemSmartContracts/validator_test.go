@@ -3090,6 +3090,64 @@ func TestValidatorStakingSC_SetConfig(t *testing.T) {
 	require.Equal(t, minStakeValue, validatorConfig.MinStakeValue)
 }
 
+func TestValidatorStakingSC_ChangeNodePrice(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	args := createMockArgumentsForValidatorSC()
+	eei := createVmContextWithStakingSc(big.NewInt(1000), uint64(10), blockChainHook)
+	eei.SetSCAddress(args.ValidatorSCAddress)
+	args.Eei = eei
+	enableEpochsHandler, _ := args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub)
+	sc, _ := NewValidatorSmartContract(args)
+
+	newNodePrice := big.NewInt(2500)
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "changeNodePrice"
+	arguments.CallerAddr = vm.GovernanceSCAddress
+	arguments.Arguments = [][]byte{newNodePrice.Bytes()}
+
+	retCode := sc.Execute(arguments)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "invalid method to call", eei.GetReturnMessage())
+
+	enableEpochsHandler.AddActiveFlags(common.GovernanceParameterChangesFlag)
+	eei.CleanCache()
+	arguments.CallerAddr = []byte("owner")
+	retCode = sc.Execute(arguments)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "only governance address can call", eei.GetReturnMessage())
+
+	eei.CleanCache()
+	arguments.CallerAddr = vm.GovernanceSCAddress
+	arguments.Arguments = [][]byte{big.NewInt(0).Bytes()}
+	retCode = sc.Execute(arguments)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.True(t, strings.Contains(eei.GetReturnMessage(), vm.ErrInvalidNodePrice.Error()))
+
+	eei.CleanCache()
+	arguments.Arguments = [][]byte{newNodePrice.Bytes()}
+	retCode = sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	validatorConfig := sc.getConfig(1)
+	require.Equal(t, newNodePrice, validatorConfig.NodePrice)
+	require.Equal(t, big.NewInt(1000), sc.baseConfig.NodePrice)
+	require.Equal(t, sc.baseConfig.MinStep, validatorConfig.MinStep)
+
+	eei.output = make([][]byte, 0)
+	arguments.Function = "getNodePrice"
+	arguments.CallerAddr = vm.EndOfEpochAddress
+	arguments.Arguments = [][]byte{}
+	retCode = sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{newNodePrice.Bytes()}, eei.output)
+
+	enableEpochsHandler.RemoveActiveFlags(common.GovernanceParameterChangesFlag)
+	validatorConfig = sc.getConfig(1)
+	require.Equal(t, big.NewInt(1000), validatorConfig.NodePrice)
+}
+
 func TestValidatorStakingSC_SetConfig_InvalidParameters(t *testing.T) {
 	t.Parallel()
 